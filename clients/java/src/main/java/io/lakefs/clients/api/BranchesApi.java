@@ -27,13 +27,25 @@ import com.google.gson.reflect.TypeToken;
 import java.io.IOException;
 
 
+import io.lakefs.clients.api.model.BranchComparison;
+import io.lakefs.clients.api.model.BranchComparisonList;
 import io.lakefs.clients.api.model.BranchCreation;
+import io.lakefs.clients.api.model.BranchReflogList;
+import io.lakefs.clients.api.model.BranchRestoreCreation;
+import io.lakefs.clients.api.model.CherryPickCreation;
+import io.lakefs.clients.api.model.Commit;
 import io.lakefs.clients.api.model.DiffList;
 import io.lakefs.clients.api.model.Error;
+import io.lakefs.clients.api.model.RebaseCreation;
+import io.lakefs.clients.api.model.RebaseResult;
 import io.lakefs.clients.api.model.Ref;
 import io.lakefs.clients.api.model.RefList;
 import io.lakefs.clients.api.model.ResetCreation;
 import io.lakefs.clients.api.model.RevertCreation;
+import io.lakefs.clients.api.model.Stash;
+import io.lakefs.clients.api.model.StashConflicts;
+import io.lakefs.clients.api.model.StashCreation;
+import io.lakefs.clients.api.model.StashList;
 
 import java.lang.reflect.Type;
 import java.util.ArrayList;
@@ -60,30 +72,1520 @@ public class BranchesApi {
         this.localVarApiClient = apiClient;
     }
 
+    /**
+     * Build call for cherryPick
+     * @param repository  (required)
+     * @param branch  (required)
+     * @param cherryPickCreation  (required)
+     * @param _callback Callback for upload/download progress
+     * @return Call to execute
+     * @throws ApiException If fail to serialize the request body object
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 201 </td><td> cherry-pick successful </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 409 </td><td> conflict </td><td>  -  </td></tr>
+        <tr><td> 412 </td><td> precondition failed (e.g. a pre-commit hook returned a failure) </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public okhttp3.Call cherryPickCall(String repository, String branch, CherryPickCreation cherryPickCreation, final ApiCallback _callback) throws ApiException {
+        Object localVarPostBody = cherryPickCreation;
+
+        // create path and map variables
+        String localVarPath = "/repositories/{repository}/branches/{branch}/cherry-pick"
+            .replaceAll("\\{" + "repository" + "\\}", localVarApiClient.escapeString(repository.toString()))
+            .replaceAll("\\{" + "branch" + "\\}", localVarApiClient.escapeString(branch.toString()));
+
+        List<Pair> localVarQueryParams = new ArrayList<Pair>();
+        List<Pair> localVarCollectionQueryParams = new ArrayList<Pair>();
+        Map<String, String> localVarHeaderParams = new HashMap<String, String>();
+        Map<String, String> localVarCookieParams = new HashMap<String, String>();
+        Map<String, Object> localVarFormParams = new HashMap<String, Object>();
+
+        final String[] localVarAccepts = {
+            "application/json"
+        };
+        final String localVarAccept = localVarApiClient.selectHeaderAccept(localVarAccepts);
+        if (localVarAccept != null) {
+            localVarHeaderParams.put("Accept", localVarAccept);
+        }
+
+        final String[] localVarContentTypes = {
+            "application/json"
+        };
+        final String localVarContentType = localVarApiClient.selectHeaderContentType(localVarContentTypes);
+        localVarHeaderParams.put("Content-Type", localVarContentType);
+
+        String[] localVarAuthNames = new String[] { "basic_auth", "cookie_auth", "jwt_token" };
+        return localVarApiClient.buildCall(localVarPath, "POST", localVarQueryParams, localVarCollectionQueryParams, localVarPostBody, localVarHeaderParams, localVarCookieParams, localVarFormParams, localVarAuthNames, _callback);
+    }
+
+    @SuppressWarnings("rawtypes")
+    private okhttp3.Call cherryPickValidateBeforeCall(String repository, String branch, CherryPickCreation cherryPickCreation, final ApiCallback _callback) throws ApiException {
+        
+        // verify the required parameter 'repository' is set
+        if (repository == null) {
+            throw new ApiException("Missing the required parameter 'repository' when calling cherryPick(Async)");
+        }
+        
+        // verify the required parameter 'branch' is set
+        if (branch == null) {
+            throw new ApiException("Missing the required parameter 'branch' when calling cherryPick(Async)");
+        }
+        
+        // verify the required parameter 'cherryPickCreation' is set
+        if (cherryPickCreation == null) {
+            throw new ApiException("Missing the required parameter 'cherryPickCreation' when calling cherryPick(Async)");
+        }
+        
+
+        okhttp3.Call localVarCall = cherryPickCall(repository, branch, cherryPickCreation, _callback);
+        return localVarCall;
+
+    }
+
+    /**
+     * apply the changes of a commit on top of a branch
+     * 
+     * @param repository  (required)
+     * @param branch  (required)
+     * @param cherryPickCreation  (required)
+     * @return Commit
+     * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 201 </td><td> cherry-pick successful </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 409 </td><td> conflict </td><td>  -  </td></tr>
+        <tr><td> 412 </td><td> precondition failed (e.g. a pre-commit hook returned a failure) </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public Commit cherryPick(String repository, String branch, CherryPickCreation cherryPickCreation) throws ApiException {
+        ApiResponse<Commit> localVarResp = cherryPickWithHttpInfo(repository, branch, cherryPickCreation);
+        return localVarResp.getData();
+    }
+
+    /**
+     * apply the changes of a commit on top of a branch
+     * 
+     * @param repository  (required)
+     * @param branch  (required)
+     * @param cherryPickCreation  (required)
+     * @return ApiResponse&lt;Commit&gt;
+     * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 201 </td><td> cherry-pick successful </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 409 </td><td> conflict </td><td>  -  </td></tr>
+        <tr><td> 412 </td><td> precondition failed (e.g. a pre-commit hook returned a failure) </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public ApiResponse<Commit> cherryPickWithHttpInfo(String repository, String branch, CherryPickCreation cherryPickCreation) throws ApiException {
+        okhttp3.Call localVarCall = cherryPickValidateBeforeCall(repository, branch, cherryPickCreation, null);
+        Type localVarReturnType = new TypeToken<Commit>(){}.getType();
+        return localVarApiClient.execute(localVarCall, localVarReturnType);
+    }
+
+    /**
+     * apply the changes of a commit on top of a branch (asynchronously)
+     * 
+     * @param repository  (required)
+     * @param branch  (required)
+     * @param cherryPickCreation  (required)
+     * @param _callback The callback to be executed when the API call finishes
+     * @return The request call
+     * @throws ApiException If fail to process the API call, e.g. serializing the request body object
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 201 </td><td> cherry-pick successful </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 409 </td><td> conflict </td><td>  -  </td></tr>
+        <tr><td> 412 </td><td> precondition failed (e.g. a pre-commit hook returned a failure) </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public okhttp3.Call cherryPickAsync(String repository, String branch, CherryPickCreation cherryPickCreation, final ApiCallback<Commit> _callback) throws ApiException {
+
+        okhttp3.Call localVarCall = cherryPickValidateBeforeCall(repository, branch, cherryPickCreation, _callback);
+        Type localVarReturnType = new TypeToken<Commit>(){}.getType();
+        localVarApiClient.executeAsync(localVarCall, localVarReturnType, _callback);
+        return localVarCall;
+    }
+    /**
+     * Build call for compareAllBranches
+     * @param repository  (required)
+     * @param baseRef a reference (could be either a branch or a commit ID) to compare the branches to (required)
+     * @param prefix return items prefixed with this value (optional)
+     * @param after return items after this value (optional)
+     * @param amount how many items to return (optional, default to 100)
+     * @param _callback Callback for upload/download progress
+     * @return Call to execute
+     * @throws ApiException If fail to serialize the request body object
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> branch comparisons </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public okhttp3.Call compareAllBranchesCall(String repository, String baseRef, String prefix, String after, Integer amount, final ApiCallback _callback) throws ApiException {
+        Object localVarPostBody = null;
+
+        // create path and map variables
+        String localVarPath = "/repositories/{repository}/refs/{baseRef}/compare"
+            .replaceAll("\\{" + "repository" + "\\}", localVarApiClient.escapeString(repository.toString()))
+            .replaceAll("\\{" + "baseRef" + "\\}", localVarApiClient.escapeString(baseRef.toString()));
+
+        List<Pair> localVarQueryParams = new ArrayList<Pair>();
+        List<Pair> localVarCollectionQueryParams = new ArrayList<Pair>();
+        Map<String, String> localVarHeaderParams = new HashMap<String, String>();
+        Map<String, String> localVarCookieParams = new HashMap<String, String>();
+        Map<String, Object> localVarFormParams = new HashMap<String, Object>();
+
+        if (prefix != null) {
+            localVarQueryParams.addAll(localVarApiClient.parameterToPair("prefix", prefix));
+        }
+
+        if (after != null) {
+            localVarQueryParams.addAll(localVarApiClient.parameterToPair("after", after));
+        }
+
+        if (amount != null) {
+            localVarQueryParams.addAll(localVarApiClient.parameterToPair("amount", amount));
+        }
+
+        final String[] localVarAccepts = {
+            "application/json"
+        };
+        final String localVarAccept = localVarApiClient.selectHeaderAccept(localVarAccepts);
+        if (localVarAccept != null) {
+            localVarHeaderParams.put("Accept", localVarAccept);
+        }
+
+        final String[] localVarContentTypes = {
+            
+        };
+        final String localVarContentType = localVarApiClient.selectHeaderContentType(localVarContentTypes);
+        localVarHeaderParams.put("Content-Type", localVarContentType);
+
+        String[] localVarAuthNames = new String[] { "basic_auth", "cookie_auth", "jwt_token" };
+        return localVarApiClient.buildCall(localVarPath, "GET", localVarQueryParams, localVarCollectionQueryParams, localVarPostBody, localVarHeaderParams, localVarCookieParams, localVarFormParams, localVarAuthNames, _callback);
+    }
+
+    @SuppressWarnings("rawtypes")
+    private okhttp3.Call compareAllBranchesValidateBeforeCall(String repository, String baseRef, String prefix, String after, Integer amount, final ApiCallback _callback) throws ApiException {
+        
+        // verify the required parameter 'repository' is set
+        if (repository == null) {
+            throw new ApiException("Missing the required parameter 'repository' when calling compareAllBranches(Async)");
+        }
+        
+        // verify the required parameter 'baseRef' is set
+        if (baseRef == null) {
+            throw new ApiException("Missing the required parameter 'baseRef' when calling compareAllBranches(Async)");
+        }
+        
+
+        okhttp3.Call localVarCall = compareAllBranchesCall(repository, baseRef, prefix, after, amount, _callback);
+        return localVarCall;
+
+    }
+
+    /**
+     * compare all branches of the repository to a base reference
+     * 
+     * @param repository  (required)
+     * @param baseRef a reference (could be either a branch or a commit ID) to compare the branches to (required)
+     * @param prefix return items prefixed with this value (optional)
+     * @param after return items after this value (optional)
+     * @param amount how many items to return (optional, default to 100)
+     * @return BranchComparisonList
+     * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> branch comparisons </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public BranchComparisonList compareAllBranches(String repository, String baseRef, String prefix, String after, Integer amount) throws ApiException {
+        ApiResponse<BranchComparisonList> localVarResp = compareAllBranchesWithHttpInfo(repository, baseRef, prefix, after, amount);
+        return localVarResp.getData();
+    }
+
+    /**
+     * compare all branches of the repository to a base reference
+     * 
+     * @param repository  (required)
+     * @param baseRef a reference (could be either a branch or a commit ID) to compare the branches to (required)
+     * @param prefix return items prefixed with this value (optional)
+     * @param after return items after this value (optional)
+     * @param amount how many items to return (optional, default to 100)
+     * @return ApiResponse&lt;BranchComparisonList&gt;
+     * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> branch comparisons </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public ApiResponse<BranchComparisonList> compareAllBranchesWithHttpInfo(String repository, String baseRef, String prefix, String after, Integer amount) throws ApiException {
+        okhttp3.Call localVarCall = compareAllBranchesValidateBeforeCall(repository, baseRef, prefix, after, amount, null);
+        Type localVarReturnType = new TypeToken<BranchComparisonList>(){}.getType();
+        return localVarApiClient.execute(localVarCall, localVarReturnType);
+    }
+
+    /**
+     * compare all branches of the repository to a base reference (asynchronously)
+     * 
+     * @param repository  (required)
+     * @param baseRef a reference (could be either a branch or a commit ID) to compare the branches to (required)
+     * @param prefix return items prefixed with this value (optional)
+     * @param after return items after this value (optional)
+     * @param amount how many items to return (optional, default to 100)
+     * @param _callback The callback to be executed when the API call finishes
+     * @return The request call
+     * @throws ApiException If fail to process the API call, e.g. serializing the request body object
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> branch comparisons </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public okhttp3.Call compareAllBranchesAsync(String repository, String baseRef, String prefix, String after, Integer amount, final ApiCallback<BranchComparisonList> _callback) throws ApiException {
+
+        okhttp3.Call localVarCall = compareAllBranchesValidateBeforeCall(repository, baseRef, prefix, after, amount, _callback);
+        Type localVarReturnType = new TypeToken<BranchComparisonList>(){}.getType();
+        localVarApiClient.executeAsync(localVarCall, localVarReturnType, _callback);
+        return localVarCall;
+    }
+    /**
+     * Build call for compareBranches
+     * @param repository  (required)
+     * @param baseRef a reference (could be either a branch or a commit ID) to compare the branch to (required)
+     * @param branch  (required)
+     * @param _callback Callback for upload/download progress
+     * @return Call to execute
+     * @throws ApiException If fail to serialize the request body object
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> branch comparison </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public okhttp3.Call compareBranchesCall(String repository, String baseRef, String branch, final ApiCallback _callback) throws ApiException {
+        Object localVarPostBody = null;
+
+        // create path and map variables
+        String localVarPath = "/repositories/{repository}/refs/{baseRef}/compare/{branch}"
+            .replaceAll("\\{" + "repository" + "\\}", localVarApiClient.escapeString(repository.toString()))
+            .replaceAll("\\{" + "baseRef" + "\\}", localVarApiClient.escapeString(baseRef.toString()))
+            .replaceAll("\\{" + "branch" + "\\}", localVarApiClient.escapeString(branch.toString()));
+
+        List<Pair> localVarQueryParams = new ArrayList<Pair>();
+        List<Pair> localVarCollectionQueryParams = new ArrayList<Pair>();
+        Map<String, String> localVarHeaderParams = new HashMap<String, String>();
+        Map<String, String> localVarCookieParams = new HashMap<String, String>();
+        Map<String, Object> localVarFormParams = new HashMap<String, Object>();
+
+        final String[] localVarAccepts = {
+            "application/json"
+        };
+        final String localVarAccept = localVarApiClient.selectHeaderAccept(localVarAccepts);
+        if (localVarAccept != null) {
+            localVarHeaderParams.put("Accept", localVarAccept);
+        }
+
+        final String[] localVarContentTypes = {
+            
+        };
+        final String localVarContentType = localVarApiClient.selectHeaderContentType(localVarContentTypes);
+        localVarHeaderParams.put("Content-Type", localVarContentType);
+
+        String[] localVarAuthNames = new String[] { "basic_auth", "cookie_auth", "jwt_token" };
+        return localVarApiClient.buildCall(localVarPath, "GET", localVarQueryParams, localVarCollectionQueryParams, localVarPostBody, localVarHeaderParams, localVarCookieParams, localVarFormParams, localVarAuthNames, _callback);
+    }
+
+    @SuppressWarnings("rawtypes")
+    private okhttp3.Call compareBranchesValidateBeforeCall(String repository, String baseRef, String branch, final ApiCallback _callback) throws ApiException {
+        
+        // verify the required parameter 'repository' is set
+        if (repository == null) {
+            throw new ApiException("Missing the required parameter 'repository' when calling compareBranches(Async)");
+        }
+        
+        // verify the required parameter 'baseRef' is set
+        if (baseRef == null) {
+            throw new ApiException("Missing the required parameter 'baseRef' when calling compareBranches(Async)");
+        }
+        
+        // verify the required parameter 'branch' is set
+        if (branch == null) {
+            throw new ApiException("Missing the required parameter 'branch' when calling compareBranches(Async)");
+        }
+        
+
+        okhttp3.Call localVarCall = compareBranchesCall(repository, baseRef, branch, _callback);
+        return localVarCall;
+
+    }
+
+    /**
+     * compare a branch to a base reference
+     * Returns the merge base of the branch and the base reference, how many commits each has that the other does not, and how many objects were added, removed and changed by the commits of the branch since the merge base. Uncommitted changes are not compared. 
+     * @param repository  (required)
+     * @param baseRef a reference (could be either a branch or a commit ID) to compare the branch to (required)
+     * @param branch  (required)
+     * @return BranchComparison
+     * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> branch comparison </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public BranchComparison compareBranches(String repository, String baseRef, String branch) throws ApiException {
+        ApiResponse<BranchComparison> localVarResp = compareBranchesWithHttpInfo(repository, baseRef, branch);
+        return localVarResp.getData();
+    }
+
+    /**
+     * compare a branch to a base reference
+     * Returns the merge base of the branch and the base reference, how many commits each has that the other does not, and how many objects were added, removed and changed by the commits of the branch since the merge base. Uncommitted changes are not compared. 
+     * @param repository  (required)
+     * @param baseRef a reference (could be either a branch or a commit ID) to compare the branch to (required)
+     * @param branch  (required)
+     * @return ApiResponse&lt;BranchComparison&gt;
+     * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> branch comparison </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public ApiResponse<BranchComparison> compareBranchesWithHttpInfo(String repository, String baseRef, String branch) throws ApiException {
+        okhttp3.Call localVarCall = compareBranchesValidateBeforeCall(repository, baseRef, branch, null);
+        Type localVarReturnType = new TypeToken<BranchComparison>(){}.getType();
+        return localVarApiClient.execute(localVarCall, localVarReturnType);
+    }
+
+    /**
+     * compare a branch to a base reference (asynchronously)
+     * Returns the merge base of the branch and the base reference, how many commits each has that the other does not, and how many objects were added, removed and changed by the commits of the branch since the merge base. Uncommitted changes are not compared. 
+     * @param repository  (required)
+     * @param baseRef a reference (could be either a branch or a commit ID) to compare the branch to (required)
+     * @param branch  (required)
+     * @param _callback The callback to be executed when the API call finishes
+     * @return The request call
+     * @throws ApiException If fail to process the API call, e.g. serializing the request body object
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> branch comparison </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public okhttp3.Call compareBranchesAsync(String repository, String baseRef, String branch, final ApiCallback<BranchComparison> _callback) throws ApiException {
+
+        okhttp3.Call localVarCall = compareBranchesValidateBeforeCall(repository, baseRef, branch, _callback);
+        Type localVarReturnType = new TypeToken<BranchComparison>(){}.getType();
+        localVarApiClient.executeAsync(localVarCall, localVarReturnType, _callback);
+        return localVarCall;
+    }
     /**
      * Build call for createBranch
      * @param repository  (required)
-     * @param branchCreation  (required)
+     * @param branchCreation  (required)
+     * @param _callback Callback for upload/download progress
+     * @return Call to execute
+     * @throws ApiException If fail to serialize the request body object
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 201 </td><td> reference </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 409 </td><td> Resource Conflicts With Target </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public okhttp3.Call createBranchCall(String repository, BranchCreation branchCreation, final ApiCallback _callback) throws ApiException {
+        Object localVarPostBody = branchCreation;
+
+        // create path and map variables
+        String localVarPath = "/repositories/{repository}/branches"
+            .replaceAll("\\{" + "repository" + "\\}", localVarApiClient.escapeString(repository.toString()));
+
+        List<Pair> localVarQueryParams = new ArrayList<Pair>();
+        List<Pair> localVarCollectionQueryParams = new ArrayList<Pair>();
+        Map<String, String> localVarHeaderParams = new HashMap<String, String>();
+        Map<String, String> localVarCookieParams = new HashMap<String, String>();
+        Map<String, Object> localVarFormParams = new HashMap<String, Object>();
+
+        final String[] localVarAccepts = {
+            "text/html", "application/json"
+        };
+        final String localVarAccept = localVarApiClient.selectHeaderAccept(localVarAccepts);
+        if (localVarAccept != null) {
+            localVarHeaderParams.put("Accept", localVarAccept);
+        }
+
+        final String[] localVarContentTypes = {
+            "application/json"
+        };
+        final String localVarContentType = localVarApiClient.selectHeaderContentType(localVarContentTypes);
+        localVarHeaderParams.put("Content-Type", localVarContentType);
+
+        String[] localVarAuthNames = new String[] { "basic_auth", "cookie_auth", "jwt_token" };
+        return localVarApiClient.buildCall(localVarPath, "POST", localVarQueryParams, localVarCollectionQueryParams, localVarPostBody, localVarHeaderParams, localVarCookieParams, localVarFormParams, localVarAuthNames, _callback);
+    }
+
+    @SuppressWarnings("rawtypes")
+    private okhttp3.Call createBranchValidateBeforeCall(String repository, BranchCreation branchCreation, final ApiCallback _callback) throws ApiException {
+        
+        // verify the required parameter 'repository' is set
+        if (repository == null) {
+            throw new ApiException("Missing the required parameter 'repository' when calling createBranch(Async)");
+        }
+        
+        // verify the required parameter 'branchCreation' is set
+        if (branchCreation == null) {
+            throw new ApiException("Missing the required parameter 'branchCreation' when calling createBranch(Async)");
+        }
+        
+
+        okhttp3.Call localVarCall = createBranchCall(repository, branchCreation, _callback);
+        return localVarCall;
+
+    }
+
+    /**
+     * create branch
+     * 
+     * @param repository  (required)
+     * @param branchCreation  (required)
+     * @return String
+     * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 201 </td><td> reference </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 409 </td><td> Resource Conflicts With Target </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public String createBranch(String repository, BranchCreation branchCreation) throws ApiException {
+        ApiResponse<String> localVarResp = createBranchWithHttpInfo(repository, branchCreation);
+        return localVarResp.getData();
+    }
+
+    /**
+     * create branch
+     * 
+     * @param repository  (required)
+     * @param branchCreation  (required)
+     * @return ApiResponse&lt;String&gt;
+     * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 201 </td><td> reference </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 409 </td><td> Resource Conflicts With Target </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public ApiResponse<String> createBranchWithHttpInfo(String repository, BranchCreation branchCreation) throws ApiException {
+        okhttp3.Call localVarCall = createBranchValidateBeforeCall(repository, branchCreation, null);
+        Type localVarReturnType = new TypeToken<String>(){}.getType();
+        return localVarApiClient.execute(localVarCall, localVarReturnType);
+    }
+
+    /**
+     * create branch (asynchronously)
+     * 
+     * @param repository  (required)
+     * @param branchCreation  (required)
+     * @param _callback The callback to be executed when the API call finishes
+     * @return The request call
+     * @throws ApiException If fail to process the API call, e.g. serializing the request body object
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 201 </td><td> reference </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 409 </td><td> Resource Conflicts With Target </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public okhttp3.Call createBranchAsync(String repository, BranchCreation branchCreation, final ApiCallback<String> _callback) throws ApiException {
+
+        okhttp3.Call localVarCall = createBranchValidateBeforeCall(repository, branchCreation, _callback);
+        Type localVarReturnType = new TypeToken<String>(){}.getType();
+        localVarApiClient.executeAsync(localVarCall, localVarReturnType, _callback);
+        return localVarCall;
+    }
+    /**
+     * Build call for deleteBranch
+     * @param repository  (required)
+     * @param branch  (required)
+     * @param _callback Callback for upload/download progress
+     * @return Call to execute
+     * @throws ApiException If fail to serialize the request body object
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 204 </td><td> branch deleted successfully </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public okhttp3.Call deleteBranchCall(String repository, String branch, final ApiCallback _callback) throws ApiException {
+        Object localVarPostBody = null;
+
+        // create path and map variables
+        String localVarPath = "/repositories/{repository}/branches/{branch}"
+            .replaceAll("\\{" + "repository" + "\\}", localVarApiClient.escapeString(repository.toString()))
+            .replaceAll("\\{" + "branch" + "\\}", localVarApiClient.escapeString(branch.toString()));
+
+        List<Pair> localVarQueryParams = new ArrayList<Pair>();
+        List<Pair> localVarCollectionQueryParams = new ArrayList<Pair>();
+        Map<String, String> localVarHeaderParams = new HashMap<String, String>();
+        Map<String, String> localVarCookieParams = new HashMap<String, String>();
+        Map<String, Object> localVarFormParams = new HashMap<String, Object>();
+
+        final String[] localVarAccepts = {
+            "application/json"
+        };
+        final String localVarAccept = localVarApiClient.selectHeaderAccept(localVarAccepts);
+        if (localVarAccept != null) {
+            localVarHeaderParams.put("Accept", localVarAccept);
+        }
+
+        final String[] localVarContentTypes = {
+            
+        };
+        final String localVarContentType = localVarApiClient.selectHeaderContentType(localVarContentTypes);
+        localVarHeaderParams.put("Content-Type", localVarContentType);
+
+        String[] localVarAuthNames = new String[] { "basic_auth", "cookie_auth", "jwt_token" };
+        return localVarApiClient.buildCall(localVarPath, "DELETE", localVarQueryParams, localVarCollectionQueryParams, localVarPostBody, localVarHeaderParams, localVarCookieParams, localVarFormParams, localVarAuthNames, _callback);
+    }
+
+    @SuppressWarnings("rawtypes")
+    private okhttp3.Call deleteBranchValidateBeforeCall(String repository, String branch, final ApiCallback _callback) throws ApiException {
+        
+        // verify the required parameter 'repository' is set
+        if (repository == null) {
+            throw new ApiException("Missing the required parameter 'repository' when calling deleteBranch(Async)");
+        }
+        
+        // verify the required parameter 'branch' is set
+        if (branch == null) {
+            throw new ApiException("Missing the required parameter 'branch' when calling deleteBranch(Async)");
+        }
+        
+
+        okhttp3.Call localVarCall = deleteBranchCall(repository, branch, _callback);
+        return localVarCall;
+
+    }
+
+    /**
+     * delete branch
+     * 
+     * @param repository  (required)
+     * @param branch  (required)
+     * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 204 </td><td> branch deleted successfully </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public void deleteBranch(String repository, String branch) throws ApiException {
+        deleteBranchWithHttpInfo(repository, branch);
+    }
+
+    /**
+     * delete branch
+     * 
+     * @param repository  (required)
+     * @param branch  (required)
+     * @return ApiResponse&lt;Void&gt;
+     * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 204 </td><td> branch deleted successfully </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public ApiResponse<Void> deleteBranchWithHttpInfo(String repository, String branch) throws ApiException {
+        okhttp3.Call localVarCall = deleteBranchValidateBeforeCall(repository, branch, null);
+        return localVarApiClient.execute(localVarCall);
+    }
+
+    /**
+     * delete branch (asynchronously)
+     * 
+     * @param repository  (required)
+     * @param branch  (required)
+     * @param _callback The callback to be executed when the API call finishes
+     * @return The request call
+     * @throws ApiException If fail to process the API call, e.g. serializing the request body object
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 204 </td><td> branch deleted successfully </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public okhttp3.Call deleteBranchAsync(String repository, String branch, final ApiCallback<Void> _callback) throws ApiException {
+
+        okhttp3.Call localVarCall = deleteBranchValidateBeforeCall(repository, branch, _callback);
+        localVarApiClient.executeAsync(localVarCall, _callback);
+        return localVarCall;
+    }
+    /**
+     * Build call for diffBranch
+     * @param repository  (required)
+     * @param branch  (required)
+     * @param after return items after this value (optional)
+     * @param amount how many items to return (optional, default to 100)
+     * @param prefix return items prefixed with this value (optional)
+     * @param delimiter delimiter used to group common prefixes by (optional)
+     * @param _callback Callback for upload/download progress
+     * @return Call to execute
+     * @throws ApiException If fail to serialize the request body object
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> diff of branch uncommitted changes </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public okhttp3.Call diffBranchCall(String repository, String branch, String after, Integer amount, String prefix, String delimiter, final ApiCallback _callback) throws ApiException {
+        Object localVarPostBody = null;
+
+        // create path and map variables
+        String localVarPath = "/repositories/{repository}/branches/{branch}/diff"
+            .replaceAll("\\{" + "repository" + "\\}", localVarApiClient.escapeString(repository.toString()))
+            .replaceAll("\\{" + "branch" + "\\}", localVarApiClient.escapeString(branch.toString()));
+
+        List<Pair> localVarQueryParams = new ArrayList<Pair>();
+        List<Pair> localVarCollectionQueryParams = new ArrayList<Pair>();
+        Map<String, String> localVarHeaderParams = new HashMap<String, String>();
+        Map<String, String> localVarCookieParams = new HashMap<String, String>();
+        Map<String, Object> localVarFormParams = new HashMap<String, Object>();
+
+        if (after != null) {
+            localVarQueryParams.addAll(localVarApiClient.parameterToPair("after", after));
+        }
+
+        if (amount != null) {
+            localVarQueryParams.addAll(localVarApiClient.parameterToPair("amount", amount));
+        }
+
+        if (prefix != null) {
+            localVarQueryParams.addAll(localVarApiClient.parameterToPair("prefix", prefix));
+        }
+
+        if (delimiter != null) {
+            localVarQueryParams.addAll(localVarApiClient.parameterToPair("delimiter", delimiter));
+        }
+
+        final String[] localVarAccepts = {
+            "application/json"
+        };
+        final String localVarAccept = localVarApiClient.selectHeaderAccept(localVarAccepts);
+        if (localVarAccept != null) {
+            localVarHeaderParams.put("Accept", localVarAccept);
+        }
+
+        final String[] localVarContentTypes = {
+            
+        };
+        final String localVarContentType = localVarApiClient.selectHeaderContentType(localVarContentTypes);
+        localVarHeaderParams.put("Content-Type", localVarContentType);
+
+        String[] localVarAuthNames = new String[] { "basic_auth", "cookie_auth", "jwt_token" };
+        return localVarApiClient.buildCall(localVarPath, "GET", localVarQueryParams, localVarCollectionQueryParams, localVarPostBody, localVarHeaderParams, localVarCookieParams, localVarFormParams, localVarAuthNames, _callback);
+    }
+
+    @SuppressWarnings("rawtypes")
+    private okhttp3.Call diffBranchValidateBeforeCall(String repository, String branch, String after, Integer amount, String prefix, String delimiter, final ApiCallback _callback) throws ApiException {
+        
+        // verify the required parameter 'repository' is set
+        if (repository == null) {
+            throw new ApiException("Missing the required parameter 'repository' when calling diffBranch(Async)");
+        }
+        
+        // verify the required parameter 'branch' is set
+        if (branch == null) {
+            throw new ApiException("Missing the required parameter 'branch' when calling diffBranch(Async)");
+        }
+        
+
+        okhttp3.Call localVarCall = diffBranchCall(repository, branch, after, amount, prefix, delimiter, _callback);
+        return localVarCall;
+
+    }
+
+    /**
+     * diff branch
+     * 
+     * @param repository  (required)
+     * @param branch  (required)
+     * @param after return items after this value (optional)
+     * @param amount how many items to return (optional, default to 100)
+     * @param prefix return items prefixed with this value (optional)
+     * @param delimiter delimiter used to group common prefixes by (optional)
+     * @return DiffList
+     * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> diff of branch uncommitted changes </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public DiffList diffBranch(String repository, String branch, String after, Integer amount, String prefix, String delimiter) throws ApiException {
+        ApiResponse<DiffList> localVarResp = diffBranchWithHttpInfo(repository, branch, after, amount, prefix, delimiter);
+        return localVarResp.getData();
+    }
+
+    /**
+     * diff branch
+     * 
+     * @param repository  (required)
+     * @param branch  (required)
+     * @param after return items after this value (optional)
+     * @param amount how many items to return (optional, default to 100)
+     * @param prefix return items prefixed with this value (optional)
+     * @param delimiter delimiter used to group common prefixes by (optional)
+     * @return ApiResponse&lt;DiffList&gt;
+     * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> diff of branch uncommitted changes </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public ApiResponse<DiffList> diffBranchWithHttpInfo(String repository, String branch, String after, Integer amount, String prefix, String delimiter) throws ApiException {
+        okhttp3.Call localVarCall = diffBranchValidateBeforeCall(repository, branch, after, amount, prefix, delimiter, null);
+        Type localVarReturnType = new TypeToken<DiffList>(){}.getType();
+        return localVarApiClient.execute(localVarCall, localVarReturnType);
+    }
+
+    /**
+     * diff branch (asynchronously)
+     * 
+     * @param repository  (required)
+     * @param branch  (required)
+     * @param after return items after this value (optional)
+     * @param amount how many items to return (optional, default to 100)
+     * @param prefix return items prefixed with this value (optional)
+     * @param delimiter delimiter used to group common prefixes by (optional)
+     * @param _callback The callback to be executed when the API call finishes
+     * @return The request call
+     * @throws ApiException If fail to process the API call, e.g. serializing the request body object
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> diff of branch uncommitted changes </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public okhttp3.Call diffBranchAsync(String repository, String branch, String after, Integer amount, String prefix, String delimiter, final ApiCallback<DiffList> _callback) throws ApiException {
+
+        okhttp3.Call localVarCall = diffBranchValidateBeforeCall(repository, branch, after, amount, prefix, delimiter, _callback);
+        Type localVarReturnType = new TypeToken<DiffList>(){}.getType();
+        localVarApiClient.executeAsync(localVarCall, localVarReturnType, _callback);
+        return localVarCall;
+    }
+    /**
+     * Build call for getBranch
+     * @param repository  (required)
+     * @param branch  (required)
+     * @param _callback Callback for upload/download progress
+     * @return Call to execute
+     * @throws ApiException If fail to serialize the request body object
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> branch </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public okhttp3.Call getBranchCall(String repository, String branch, final ApiCallback _callback) throws ApiException {
+        Object localVarPostBody = null;
+
+        // create path and map variables
+        String localVarPath = "/repositories/{repository}/branches/{branch}"
+            .replaceAll("\\{" + "repository" + "\\}", localVarApiClient.escapeString(repository.toString()))
+            .replaceAll("\\{" + "branch" + "\\}", localVarApiClient.escapeString(branch.toString()));
+
+        List<Pair> localVarQueryParams = new ArrayList<Pair>();
+        List<Pair> localVarCollectionQueryParams = new ArrayList<Pair>();
+        Map<String, String> localVarHeaderParams = new HashMap<String, String>();
+        Map<String, String> localVarCookieParams = new HashMap<String, String>();
+        Map<String, Object> localVarFormParams = new HashMap<String, Object>();
+
+        final String[] localVarAccepts = {
+            "application/json"
+        };
+        final String localVarAccept = localVarApiClient.selectHeaderAccept(localVarAccepts);
+        if (localVarAccept != null) {
+            localVarHeaderParams.put("Accept", localVarAccept);
+        }
+
+        final String[] localVarContentTypes = {
+            
+        };
+        final String localVarContentType = localVarApiClient.selectHeaderContentType(localVarContentTypes);
+        localVarHeaderParams.put("Content-Type", localVarContentType);
+
+        String[] localVarAuthNames = new String[] { "basic_auth", "cookie_auth", "jwt_token" };
+        return localVarApiClient.buildCall(localVarPath, "GET", localVarQueryParams, localVarCollectionQueryParams, localVarPostBody, localVarHeaderParams, localVarCookieParams, localVarFormParams, localVarAuthNames, _callback);
+    }
+
+    @SuppressWarnings("rawtypes")
+    private okhttp3.Call getBranchValidateBeforeCall(String repository, String branch, final ApiCallback _callback) throws ApiException {
+        
+        // verify the required parameter 'repository' is set
+        if (repository == null) {
+            throw new ApiException("Missing the required parameter 'repository' when calling getBranch(Async)");
+        }
+        
+        // verify the required parameter 'branch' is set
+        if (branch == null) {
+            throw new ApiException("Missing the required parameter 'branch' when calling getBranch(Async)");
+        }
+        
+
+        okhttp3.Call localVarCall = getBranchCall(repository, branch, _callback);
+        return localVarCall;
+
+    }
+
+    /**
+     * get branch
+     * 
+     * @param repository  (required)
+     * @param branch  (required)
+     * @return Ref
+     * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> branch </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public Ref getBranch(String repository, String branch) throws ApiException {
+        ApiResponse<Ref> localVarResp = getBranchWithHttpInfo(repository, branch);
+        return localVarResp.getData();
+    }
+
+    /**
+     * get branch
+     * 
+     * @param repository  (required)
+     * @param branch  (required)
+     * @return ApiResponse&lt;Ref&gt;
+     * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> branch </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public ApiResponse<Ref> getBranchWithHttpInfo(String repository, String branch) throws ApiException {
+        okhttp3.Call localVarCall = getBranchValidateBeforeCall(repository, branch, null);
+        Type localVarReturnType = new TypeToken<Ref>(){}.getType();
+        return localVarApiClient.execute(localVarCall, localVarReturnType);
+    }
+
+    /**
+     * get branch (asynchronously)
+     * 
+     * @param repository  (required)
+     * @param branch  (required)
+     * @param _callback The callback to be executed when the API call finishes
+     * @return The request call
+     * @throws ApiException If fail to process the API call, e.g. serializing the request body object
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> branch </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public okhttp3.Call getBranchAsync(String repository, String branch, final ApiCallback<Ref> _callback) throws ApiException {
+
+        okhttp3.Call localVarCall = getBranchValidateBeforeCall(repository, branch, _callback);
+        Type localVarReturnType = new TypeToken<Ref>(){}.getType();
+        localVarApiClient.executeAsync(localVarCall, localVarReturnType, _callback);
+        return localVarCall;
+    }
+    /**
+     * Build call for listBranchReflog
+     * @param repository  (required)
+     * @param branch  (required)
+     * @param after return items after this value (optional)
+     * @param amount how many items to return (optional, default to 100)
+     * @param _callback Callback for upload/download progress
+     * @return Call to execute
+     * @throws ApiException If fail to serialize the request body object
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> branch reflog </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public okhttp3.Call listBranchReflogCall(String repository, String branch, String after, Integer amount, final ApiCallback _callback) throws ApiException {
+        Object localVarPostBody = null;
+
+        // create path and map variables
+        String localVarPath = "/repositories/{repository}/branches/{branch}/reflog"
+            .replaceAll("\\{" + "repository" + "\\}", localVarApiClient.escapeString(repository.toString()))
+            .replaceAll("\\{" + "branch" + "\\}", localVarApiClient.escapeString(branch.toString()));
+
+        List<Pair> localVarQueryParams = new ArrayList<Pair>();
+        List<Pair> localVarCollectionQueryParams = new ArrayList<Pair>();
+        Map<String, String> localVarHeaderParams = new HashMap<String, String>();
+        Map<String, String> localVarCookieParams = new HashMap<String, String>();
+        Map<String, Object> localVarFormParams = new HashMap<String, Object>();
+
+        if (after != null) {
+            localVarQueryParams.addAll(localVarApiClient.parameterToPair("after", after));
+        }
+
+        if (amount != null) {
+            localVarQueryParams.addAll(localVarApiClient.parameterToPair("amount", amount));
+        }
+
+        final String[] localVarAccepts = {
+            "application/json"
+        };
+        final String localVarAccept = localVarApiClient.selectHeaderAccept(localVarAccepts);
+        if (localVarAccept != null) {
+            localVarHeaderParams.put("Accept", localVarAccept);
+        }
+
+        final String[] localVarContentTypes = {
+            
+        };
+        final String localVarContentType = localVarApiClient.selectHeaderContentType(localVarContentTypes);
+        localVarHeaderParams.put("Content-Type", localVarContentType);
+
+        String[] localVarAuthNames = new String[] { "basic_auth", "cookie_auth", "jwt_token" };
+        return localVarApiClient.buildCall(localVarPath, "GET", localVarQueryParams, localVarCollectionQueryParams, localVarPostBody, localVarHeaderParams, localVarCookieParams, localVarFormParams, localVarAuthNames, _callback);
+    }
+
+    @SuppressWarnings("rawtypes")
+    private okhttp3.Call listBranchReflogValidateBeforeCall(String repository, String branch, String after, Integer amount, final ApiCallback _callback) throws ApiException {
+        
+        // verify the required parameter 'repository' is set
+        if (repository == null) {
+            throw new ApiException("Missing the required parameter 'repository' when calling listBranchReflog(Async)");
+        }
+        
+        // verify the required parameter 'branch' is set
+        if (branch == null) {
+            throw new ApiException("Missing the required parameter 'branch' when calling listBranchReflog(Async)");
+        }
+        
+
+        okhttp3.Call localVarCall = listBranchReflogCall(repository, branch, after, amount, _callback);
+        return localVarCall;
+
+    }
+
+    /**
+     * list the changes of the branch head, latest first
+     * Lists the changes of the branch head made by creating, committing to, merging into, resetting, restoring and deleting the branch, latest first. The reflog of a deleted branch is kept. Pagination is by entry index, pass the index of the last entry returned as &#39;after&#39;. 
+     * @param repository  (required)
+     * @param branch  (required)
+     * @param after return items after this value (optional)
+     * @param amount how many items to return (optional, default to 100)
+     * @return BranchReflogList
+     * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> branch reflog </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public BranchReflogList listBranchReflog(String repository, String branch, String after, Integer amount) throws ApiException {
+        ApiResponse<BranchReflogList> localVarResp = listBranchReflogWithHttpInfo(repository, branch, after, amount);
+        return localVarResp.getData();
+    }
+
+    /**
+     * list the changes of the branch head, latest first
+     * Lists the changes of the branch head made by creating, committing to, merging into, resetting, restoring and deleting the branch, latest first. The reflog of a deleted branch is kept. Pagination is by entry index, pass the index of the last entry returned as &#39;after&#39;. 
+     * @param repository  (required)
+     * @param branch  (required)
+     * @param after return items after this value (optional)
+     * @param amount how many items to return (optional, default to 100)
+     * @return ApiResponse&lt;BranchReflogList&gt;
+     * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> branch reflog </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public ApiResponse<BranchReflogList> listBranchReflogWithHttpInfo(String repository, String branch, String after, Integer amount) throws ApiException {
+        okhttp3.Call localVarCall = listBranchReflogValidateBeforeCall(repository, branch, after, amount, null);
+        Type localVarReturnType = new TypeToken<BranchReflogList>(){}.getType();
+        return localVarApiClient.execute(localVarCall, localVarReturnType);
+    }
+
+    /**
+     * list the changes of the branch head, latest first (asynchronously)
+     * Lists the changes of the branch head made by creating, committing to, merging into, resetting, restoring and deleting the branch, latest first. The reflog of a deleted branch is kept. Pagination is by entry index, pass the index of the last entry returned as &#39;after&#39;. 
+     * @param repository  (required)
+     * @param branch  (required)
+     * @param after return items after this value (optional)
+     * @param amount how many items to return (optional, default to 100)
+     * @param _callback The callback to be executed when the API call finishes
+     * @return The request call
+     * @throws ApiException If fail to process the API call, e.g. serializing the request body object
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> branch reflog </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public okhttp3.Call listBranchReflogAsync(String repository, String branch, String after, Integer amount, final ApiCallback<BranchReflogList> _callback) throws ApiException {
+
+        okhttp3.Call localVarCall = listBranchReflogValidateBeforeCall(repository, branch, after, amount, _callback);
+        Type localVarReturnType = new TypeToken<BranchReflogList>(){}.getType();
+        localVarApiClient.executeAsync(localVarCall, localVarReturnType, _callback);
+        return localVarCall;
+    }
+    /**
+     * Build call for listBranches
+     * @param repository  (required)
+     * @param prefix return items prefixed with this value (optional)
+     * @param after return items after this value (optional)
+     * @param amount how many items to return (optional, default to 100)
+     * @param _callback Callback for upload/download progress
+     * @return Call to execute
+     * @throws ApiException If fail to serialize the request body object
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> branch list </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public okhttp3.Call listBranchesCall(String repository, String prefix, String after, Integer amount, final ApiCallback _callback) throws ApiException {
+        Object localVarPostBody = null;
+
+        // create path and map variables
+        String localVarPath = "/repositories/{repository}/branches"
+            .replaceAll("\\{" + "repository" + "\\}", localVarApiClient.escapeString(repository.toString()));
+
+        List<Pair> localVarQueryParams = new ArrayList<Pair>();
+        List<Pair> localVarCollectionQueryParams = new ArrayList<Pair>();
+        Map<String, String> localVarHeaderParams = new HashMap<String, String>();
+        Map<String, String> localVarCookieParams = new HashMap<String, String>();
+        Map<String, Object> localVarFormParams = new HashMap<String, Object>();
+
+        if (prefix != null) {
+            localVarQueryParams.addAll(localVarApiClient.parameterToPair("prefix", prefix));
+        }
+
+        if (after != null) {
+            localVarQueryParams.addAll(localVarApiClient.parameterToPair("after", after));
+        }
+
+        if (amount != null) {
+            localVarQueryParams.addAll(localVarApiClient.parameterToPair("amount", amount));
+        }
+
+        final String[] localVarAccepts = {
+            "application/json"
+        };
+        final String localVarAccept = localVarApiClient.selectHeaderAccept(localVarAccepts);
+        if (localVarAccept != null) {
+            localVarHeaderParams.put("Accept", localVarAccept);
+        }
+
+        final String[] localVarContentTypes = {
+            
+        };
+        final String localVarContentType = localVarApiClient.selectHeaderContentType(localVarContentTypes);
+        localVarHeaderParams.put("Content-Type", localVarContentType);
+
+        String[] localVarAuthNames = new String[] { "basic_auth", "cookie_auth", "jwt_token" };
+        return localVarApiClient.buildCall(localVarPath, "GET", localVarQueryParams, localVarCollectionQueryParams, localVarPostBody, localVarHeaderParams, localVarCookieParams, localVarFormParams, localVarAuthNames, _callback);
+    }
+
+    @SuppressWarnings("rawtypes")
+    private okhttp3.Call listBranchesValidateBeforeCall(String repository, String prefix, String after, Integer amount, final ApiCallback _callback) throws ApiException {
+        
+        // verify the required parameter 'repository' is set
+        if (repository == null) {
+            throw new ApiException("Missing the required parameter 'repository' when calling listBranches(Async)");
+        }
+        
+
+        okhttp3.Call localVarCall = listBranchesCall(repository, prefix, after, amount, _callback);
+        return localVarCall;
+
+    }
+
+    /**
+     * list branches
+     * 
+     * @param repository  (required)
+     * @param prefix return items prefixed with this value (optional)
+     * @param after return items after this value (optional)
+     * @param amount how many items to return (optional, default to 100)
+     * @return RefList
+     * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> branch list </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public RefList listBranches(String repository, String prefix, String after, Integer amount) throws ApiException {
+        ApiResponse<RefList> localVarResp = listBranchesWithHttpInfo(repository, prefix, after, amount);
+        return localVarResp.getData();
+    }
+
+    /**
+     * list branches
+     * 
+     * @param repository  (required)
+     * @param prefix return items prefixed with this value (optional)
+     * @param after return items after this value (optional)
+     * @param amount how many items to return (optional, default to 100)
+     * @return ApiResponse&lt;RefList&gt;
+     * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> branch list </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public ApiResponse<RefList> listBranchesWithHttpInfo(String repository, String prefix, String after, Integer amount) throws ApiException {
+        okhttp3.Call localVarCall = listBranchesValidateBeforeCall(repository, prefix, after, amount, null);
+        Type localVarReturnType = new TypeToken<RefList>(){}.getType();
+        return localVarApiClient.execute(localVarCall, localVarReturnType);
+    }
+
+    /**
+     * list branches (asynchronously)
+     * 
+     * @param repository  (required)
+     * @param prefix return items prefixed with this value (optional)
+     * @param after return items after this value (optional)
+     * @param amount how many items to return (optional, default to 100)
+     * @param _callback The callback to be executed when the API call finishes
+     * @return The request call
+     * @throws ApiException If fail to process the API call, e.g. serializing the request body object
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> branch list </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public okhttp3.Call listBranchesAsync(String repository, String prefix, String after, Integer amount, final ApiCallback<RefList> _callback) throws ApiException {
+
+        okhttp3.Call localVarCall = listBranchesValidateBeforeCall(repository, prefix, after, amount, _callback);
+        Type localVarReturnType = new TypeToken<RefList>(){}.getType();
+        localVarApiClient.executeAsync(localVarCall, localVarReturnType, _callback);
+        return localVarCall;
+    }
+    /**
+     * Build call for listStashes
+     * @param repository  (required)
+     * @param branch  (required)
+     * @param after return items after this value (optional)
+     * @param amount how many items to return (optional, default to 100)
+     * @param _callback Callback for upload/download progress
+     * @return Call to execute
+     * @throws ApiException If fail to serialize the request body object
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> stash list </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public okhttp3.Call listStashesCall(String repository, String branch, String after, Integer amount, final ApiCallback _callback) throws ApiException {
+        Object localVarPostBody = null;
+
+        // create path and map variables
+        String localVarPath = "/repositories/{repository}/branches/{branch}/stashes"
+            .replaceAll("\\{" + "repository" + "\\}", localVarApiClient.escapeString(repository.toString()))
+            .replaceAll("\\{" + "branch" + "\\}", localVarApiClient.escapeString(branch.toString()));
+
+        List<Pair> localVarQueryParams = new ArrayList<Pair>();
+        List<Pair> localVarCollectionQueryParams = new ArrayList<Pair>();
+        Map<String, String> localVarHeaderParams = new HashMap<String, String>();
+        Map<String, String> localVarCookieParams = new HashMap<String, String>();
+        Map<String, Object> localVarFormParams = new HashMap<String, Object>();
+
+        if (after != null) {
+            localVarQueryParams.addAll(localVarApiClient.parameterToPair("after", after));
+        }
+
+        if (amount != null) {
+            localVarQueryParams.addAll(localVarApiClient.parameterToPair("amount", amount));
+        }
+
+        final String[] localVarAccepts = {
+            "application/json"
+        };
+        final String localVarAccept = localVarApiClient.selectHeaderAccept(localVarAccepts);
+        if (localVarAccept != null) {
+            localVarHeaderParams.put("Accept", localVarAccept);
+        }
+
+        final String[] localVarContentTypes = {
+            
+        };
+        final String localVarContentType = localVarApiClient.selectHeaderContentType(localVarContentTypes);
+        localVarHeaderParams.put("Content-Type", localVarContentType);
+
+        String[] localVarAuthNames = new String[] { "basic_auth", "cookie_auth", "jwt_token" };
+        return localVarApiClient.buildCall(localVarPath, "GET", localVarQueryParams, localVarCollectionQueryParams, localVarPostBody, localVarHeaderParams, localVarCookieParams, localVarFormParams, localVarAuthNames, _callback);
+    }
+
+    @SuppressWarnings("rawtypes")
+    private okhttp3.Call listStashesValidateBeforeCall(String repository, String branch, String after, Integer amount, final ApiCallback _callback) throws ApiException {
+        
+        // verify the required parameter 'repository' is set
+        if (repository == null) {
+            throw new ApiException("Missing the required parameter 'repository' when calling listStashes(Async)");
+        }
+        
+        // verify the required parameter 'branch' is set
+        if (branch == null) {
+            throw new ApiException("Missing the required parameter 'branch' when calling listStashes(Async)");
+        }
+        
+
+        okhttp3.Call localVarCall = listStashesCall(repository, branch, after, amount, _callback);
+        return localVarCall;
+
+    }
+
+    /**
+     * list the stashes of a branch
+     * 
+     * @param repository  (required)
+     * @param branch  (required)
+     * @param after return items after this value (optional)
+     * @param amount how many items to return (optional, default to 100)
+     * @return StashList
+     * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> stash list </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public StashList listStashes(String repository, String branch, String after, Integer amount) throws ApiException {
+        ApiResponse<StashList> localVarResp = listStashesWithHttpInfo(repository, branch, after, amount);
+        return localVarResp.getData();
+    }
+
+    /**
+     * list the stashes of a branch
+     * 
+     * @param repository  (required)
+     * @param branch  (required)
+     * @param after return items after this value (optional)
+     * @param amount how many items to return (optional, default to 100)
+     * @return ApiResponse&lt;StashList&gt;
+     * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> stash list </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public ApiResponse<StashList> listStashesWithHttpInfo(String repository, String branch, String after, Integer amount) throws ApiException {
+        okhttp3.Call localVarCall = listStashesValidateBeforeCall(repository, branch, after, amount, null);
+        Type localVarReturnType = new TypeToken<StashList>(){}.getType();
+        return localVarApiClient.execute(localVarCall, localVarReturnType);
+    }
+
+    /**
+     * list the stashes of a branch (asynchronously)
+     * 
+     * @param repository  (required)
+     * @param branch  (required)
+     * @param after return items after this value (optional)
+     * @param amount how many items to return (optional, default to 100)
+     * @param _callback The callback to be executed when the API call finishes
+     * @return The request call
+     * @throws ApiException If fail to process the API call, e.g. serializing the request body object
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> stash list </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public okhttp3.Call listStashesAsync(String repository, String branch, String after, Integer amount, final ApiCallback<StashList> _callback) throws ApiException {
+
+        okhttp3.Call localVarCall = listStashesValidateBeforeCall(repository, branch, after, amount, _callback);
+        Type localVarReturnType = new TypeToken<StashList>(){}.getType();
+        localVarApiClient.executeAsync(localVarCall, localVarReturnType, _callback);
+        return localVarCall;
+    }
+    /**
+     * Build call for rebaseBranch
+     * @param repository  (required)
+     * @param branch  (required)
+     * @param rebaseCreation  (required)
      * @param _callback Callback for upload/download progress
      * @return Call to execute
      * @throws ApiException If fail to serialize the request body object
      * @http.response.details
      <table summary="Response Details" border="1">
         <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
-        <tr><td> 201 </td><td> reference </td><td>  -  </td></tr>
+        <tr><td> 200 </td><td> rebase completed </td><td>  -  </td></tr>
         <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
         <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
         <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
-        <tr><td> 409 </td><td> Resource Conflicts With Target </td><td>  -  </td></tr>
+        <tr><td> 409 </td><td> conflict </td><td>  -  </td></tr>
         <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
      </table>
      */
-    public okhttp3.Call createBranchCall(String repository, BranchCreation branchCreation, final ApiCallback _callback) throws ApiException {
-        Object localVarPostBody = branchCreation;
+    public okhttp3.Call rebaseBranchCall(String repository, String branch, RebaseCreation rebaseCreation, final ApiCallback _callback) throws ApiException {
+        Object localVarPostBody = rebaseCreation;
 
         // create path and map variables
-        String localVarPath = "/repositories/{repository}/branches"
-            .replaceAll("\\{" + "repository" + "\\}", localVarApiClient.escapeString(repository.toString()));
+        String localVarPath = "/repositories/{repository}/branches/{branch}/rebase"
+            .replaceAll("\\{" + "repository" + "\\}", localVarApiClient.escapeString(repository.toString()))
+            .replaceAll("\\{" + "branch" + "\\}", localVarApiClient.escapeString(branch.toString()));
 
         List<Pair> localVarQueryParams = new ArrayList<Pair>();
         List<Pair> localVarCollectionQueryParams = new ArrayList<Pair>();
@@ -92,7 +1594,7 @@ public class BranchesApi {
         Map<String, Object> localVarFormParams = new HashMap<String, Object>();
 
         final String[] localVarAccepts = {
-            "text/html", "application/json"
+            "application/json"
         };
         final String localVarAccept = localVarApiClient.selectHeaderAccept(localVarAccepts);
         if (localVarAccept != null) {
@@ -110,115 +1612,124 @@ public class BranchesApi {
     }
 
     @SuppressWarnings("rawtypes")
-    private okhttp3.Call createBranchValidateBeforeCall(String repository, BranchCreation branchCreation, final ApiCallback _callback) throws ApiException {
+    private okhttp3.Call rebaseBranchValidateBeforeCall(String repository, String branch, RebaseCreation rebaseCreation, final ApiCallback _callback) throws ApiException {
         
         // verify the required parameter 'repository' is set
         if (repository == null) {
-            throw new ApiException("Missing the required parameter 'repository' when calling createBranch(Async)");
+            throw new ApiException("Missing the required parameter 'repository' when calling rebaseBranch(Async)");
         }
         
-        // verify the required parameter 'branchCreation' is set
-        if (branchCreation == null) {
-            throw new ApiException("Missing the required parameter 'branchCreation' when calling createBranch(Async)");
+        // verify the required parameter 'branch' is set
+        if (branch == null) {
+            throw new ApiException("Missing the required parameter 'branch' when calling rebaseBranch(Async)");
+        }
+        
+        // verify the required parameter 'rebaseCreation' is set
+        if (rebaseCreation == null) {
+            throw new ApiException("Missing the required parameter 'rebaseCreation' when calling rebaseBranch(Async)");
         }
         
 
-        okhttp3.Call localVarCall = createBranchCall(repository, branchCreation, _callback);
+        okhttp3.Call localVarCall = rebaseBranchCall(repository, branch, rebaseCreation, _callback);
         return localVarCall;
 
     }
 
     /**
-     * create branch
-     * 
+     * replay the commits of a branch on top of another ref
+     * Replays, in order, the commits of the branch that are not reachable from the given ref on top of it, producing linear history. Merge commits are not replayed. Requires no uncommitted changes on the branch. The rebase stops on the first conflicting commit, leaving the branch unchanged. 
      * @param repository  (required)
-     * @param branchCreation  (required)
-     * @return String
+     * @param branch  (required)
+     * @param rebaseCreation  (required)
+     * @return RebaseResult
      * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
      * @http.response.details
      <table summary="Response Details" border="1">
         <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
-        <tr><td> 201 </td><td> reference </td><td>  -  </td></tr>
+        <tr><td> 200 </td><td> rebase completed </td><td>  -  </td></tr>
         <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
         <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
         <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
-        <tr><td> 409 </td><td> Resource Conflicts With Target </td><td>  -  </td></tr>
+        <tr><td> 409 </td><td> conflict </td><td>  -  </td></tr>
         <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
      </table>
      */
-    public String createBranch(String repository, BranchCreation branchCreation) throws ApiException {
-        ApiResponse<String> localVarResp = createBranchWithHttpInfo(repository, branchCreation);
+    public RebaseResult rebaseBranch(String repository, String branch, RebaseCreation rebaseCreation) throws ApiException {
+        ApiResponse<RebaseResult> localVarResp = rebaseBranchWithHttpInfo(repository, branch, rebaseCreation);
         return localVarResp.getData();
     }
 
     /**
-     * create branch
-     * 
+     * replay the commits of a branch on top of another ref
+     * Replays, in order, the commits of the branch that are not reachable from the given ref on top of it, producing linear history. Merge commits are not replayed. Requires no uncommitted changes on the branch. The rebase stops on the first conflicting commit, leaving the branch unchanged. 
      * @param repository  (required)
-     * @param branchCreation  (required)
-     * @return ApiResponse&lt;String&gt;
+     * @param branch  (required)
+     * @param rebaseCreation  (required)
+     * @return ApiResponse&lt;RebaseResult&gt;
      * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
      * @http.response.details
      <table summary="Response Details" border="1">
         <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
-        <tr><td> 201 </td><td> reference </td><td>  -  </td></tr>
+        <tr><td> 200 </td><td> rebase completed </td><td>  -  </td></tr>
         <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
         <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
         <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
-        <tr><td> 409 </td><td> Resource Conflicts With Target </td><td>  -  </td></tr>
+        <tr><td> 409 </td><td> conflict </td><td>  -  </td></tr>
         <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
      </table>
      */
-    public ApiResponse<String> createBranchWithHttpInfo(String repository, BranchCreation branchCreation) throws ApiException {
-        okhttp3.Call localVarCall = createBranchValidateBeforeCall(repository, branchCreation, null);
-        Type localVarReturnType = new TypeToken<String>(){}.getType();
+    public ApiResponse<RebaseResult> rebaseBranchWithHttpInfo(String repository, String branch, RebaseCreation rebaseCreation) throws ApiException {
+        okhttp3.Call localVarCall = rebaseBranchValidateBeforeCall(repository, branch, rebaseCreation, null);
+        Type localVarReturnType = new TypeToken<RebaseResult>(){}.getType();
         return localVarApiClient.execute(localVarCall, localVarReturnType);
     }
 
     /**
-     * create branch (asynchronously)
-     * 
+     * replay the commits of a branch on top of another ref (asynchronously)
+     * Replays, in order, the commits of the branch that are not reachable from the given ref on top of it, producing linear history. Merge commits are not replayed. Requires no uncommitted changes on the branch. The rebase stops on the first conflicting commit, leaving the branch unchanged. 
      * @param repository  (required)
-     * @param branchCreation  (required)
+     * @param branch  (required)
+     * @param rebaseCreation  (required)
      * @param _callback The callback to be executed when the API call finishes
      * @return The request call
      * @throws ApiException If fail to process the API call, e.g. serializing the request body object
      * @http.response.details
      <table summary="Response Details" border="1">
         <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
-        <tr><td> 201 </td><td> reference </td><td>  -  </td></tr>
+        <tr><td> 200 </td><td> rebase completed </td><td>  -  </td></tr>
         <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
         <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
         <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
-        <tr><td> 409 </td><td> Resource Conflicts With Target </td><td>  -  </td></tr>
+        <tr><td> 409 </td><td> conflict </td><td>  -  </td></tr>
         <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
      </table>
      */
-    public okhttp3.Call createBranchAsync(String repository, BranchCreation branchCreation, final ApiCallback<String> _callback) throws ApiException {
+    public okhttp3.Call rebaseBranchAsync(String repository, String branch, RebaseCreation rebaseCreation, final ApiCallback<RebaseResult> _callback) throws ApiException {
 
-        okhttp3.Call localVarCall = createBranchValidateBeforeCall(repository, branchCreation, _callback);
-        Type localVarReturnType = new TypeToken<String>(){}.getType();
+        okhttp3.Call localVarCall = rebaseBranchValidateBeforeCall(repository, branch, rebaseCreation, _callback);
+        Type localVarReturnType = new TypeToken<RebaseResult>(){}.getType();
         localVarApiClient.executeAsync(localVarCall, localVarReturnType, _callback);
         return localVarCall;
     }
     /**
-     * Build call for deleteBranch
+     * Build call for resetBranch
      * @param repository  (required)
      * @param branch  (required)
+     * @param resetCreation  (required)
      * @param _callback Callback for upload/download progress
      * @return Call to execute
      * @throws ApiException If fail to serialize the request body object
      * @http.response.details
      <table summary="Response Details" border="1">
         <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
-        <tr><td> 204 </td><td> branch deleted successfully </td><td>  -  </td></tr>
+        <tr><td> 204 </td><td> reset successful </td><td>  -  </td></tr>
         <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
         <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
         <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
      </table>
      */
-    public okhttp3.Call deleteBranchCall(String repository, String branch, final ApiCallback _callback) throws ApiException {
-        Object localVarPostBody = null;
+    public okhttp3.Call resetBranchCall(String repository, String branch, ResetCreation resetCreation, final ApiCallback _callback) throws ApiException {
+        Object localVarPostBody = resetCreation;
 
         // create path and map variables
         String localVarPath = "/repositories/{repository}/branches/{branch}"
@@ -240,122 +1751,128 @@ public class BranchesApi {
         }
 
         final String[] localVarContentTypes = {
-            
+            "application/json"
         };
         final String localVarContentType = localVarApiClient.selectHeaderContentType(localVarContentTypes);
         localVarHeaderParams.put("Content-Type", localVarContentType);
 
         String[] localVarAuthNames = new String[] { "basic_auth", "cookie_auth", "jwt_token" };
-        return localVarApiClient.buildCall(localVarPath, "DELETE", localVarQueryParams, localVarCollectionQueryParams, localVarPostBody, localVarHeaderParams, localVarCookieParams, localVarFormParams, localVarAuthNames, _callback);
+        return localVarApiClient.buildCall(localVarPath, "PUT", localVarQueryParams, localVarCollectionQueryParams, localVarPostBody, localVarHeaderParams, localVarCookieParams, localVarFormParams, localVarAuthNames, _callback);
     }
 
     @SuppressWarnings("rawtypes")
-    private okhttp3.Call deleteBranchValidateBeforeCall(String repository, String branch, final ApiCallback _callback) throws ApiException {
+    private okhttp3.Call resetBranchValidateBeforeCall(String repository, String branch, ResetCreation resetCreation, final ApiCallback _callback) throws ApiException {
         
         // verify the required parameter 'repository' is set
         if (repository == null) {
-            throw new ApiException("Missing the required parameter 'repository' when calling deleteBranch(Async)");
+            throw new ApiException("Missing the required parameter 'repository' when calling resetBranch(Async)");
         }
         
         // verify the required parameter 'branch' is set
         if (branch == null) {
-            throw new ApiException("Missing the required parameter 'branch' when calling deleteBranch(Async)");
+            throw new ApiException("Missing the required parameter 'branch' when calling resetBranch(Async)");
+        }
+        
+        // verify the required parameter 'resetCreation' is set
+        if (resetCreation == null) {
+            throw new ApiException("Missing the required parameter 'resetCreation' when calling resetBranch(Async)");
         }
         
 
-        okhttp3.Call localVarCall = deleteBranchCall(repository, branch, _callback);
+        okhttp3.Call localVarCall = resetBranchCall(repository, branch, resetCreation, _callback);
         return localVarCall;
 
     }
 
     /**
-     * delete branch
+     * reset branch
      * 
      * @param repository  (required)
      * @param branch  (required)
+     * @param resetCreation  (required)
      * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
      * @http.response.details
      <table summary="Response Details" border="1">
         <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
-        <tr><td> 204 </td><td> branch deleted successfully </td><td>  -  </td></tr>
+        <tr><td> 204 </td><td> reset successful </td><td>  -  </td></tr>
         <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
         <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
         <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
      </table>
      */
-    public void deleteBranch(String repository, String branch) throws ApiException {
-        deleteBranchWithHttpInfo(repository, branch);
+    public void resetBranch(String repository, String branch, ResetCreation resetCreation) throws ApiException {
+        resetBranchWithHttpInfo(repository, branch, resetCreation);
     }
 
     /**
-     * delete branch
+     * reset branch
      * 
      * @param repository  (required)
      * @param branch  (required)
+     * @param resetCreation  (required)
      * @return ApiResponse&lt;Void&gt;
      * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
      * @http.response.details
      <table summary="Response Details" border="1">
         <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
-        <tr><td> 204 </td><td> branch deleted successfully </td><td>  -  </td></tr>
+        <tr><td> 204 </td><td> reset successful </td><td>  -  </td></tr>
         <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
         <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
         <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
      </table>
      */
-    public ApiResponse<Void> deleteBranchWithHttpInfo(String repository, String branch) throws ApiException {
-        okhttp3.Call localVarCall = deleteBranchValidateBeforeCall(repository, branch, null);
+    public ApiResponse<Void> resetBranchWithHttpInfo(String repository, String branch, ResetCreation resetCreation) throws ApiException {
+        okhttp3.Call localVarCall = resetBranchValidateBeforeCall(repository, branch, resetCreation, null);
         return localVarApiClient.execute(localVarCall);
     }
 
     /**
-     * delete branch (asynchronously)
+     * reset branch (asynchronously)
      * 
      * @param repository  (required)
      * @param branch  (required)
+     * @param resetCreation  (required)
      * @param _callback The callback to be executed when the API call finishes
      * @return The request call
      * @throws ApiException If fail to process the API call, e.g. serializing the request body object
      * @http.response.details
      <table summary="Response Details" border="1">
         <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
-        <tr><td> 204 </td><td> branch deleted successfully </td><td>  -  </td></tr>
+        <tr><td> 204 </td><td> reset successful </td><td>  -  </td></tr>
         <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
         <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
         <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
      </table>
      */
-    public okhttp3.Call deleteBranchAsync(String repository, String branch, final ApiCallback<Void> _callback) throws ApiException {
+    public okhttp3.Call resetBranchAsync(String repository, String branch, ResetCreation resetCreation, final ApiCallback<Void> _callback) throws ApiException {
 
-        okhttp3.Call localVarCall = deleteBranchValidateBeforeCall(repository, branch, _callback);
+        okhttp3.Call localVarCall = resetBranchValidateBeforeCall(repository, branch, resetCreation, _callback);
         localVarApiClient.executeAsync(localVarCall, _callback);
         return localVarCall;
     }
     /**
-     * Build call for diffBranch
+     * Build call for restoreBranch
      * @param repository  (required)
      * @param branch  (required)
-     * @param after return items after this value (optional)
-     * @param amount how many items to return (optional, default to 100)
-     * @param prefix return items prefixed with this value (optional)
-     * @param delimiter delimiter used to group common prefixes by (optional)
+     * @param branchRestoreCreation  (required)
      * @param _callback Callback for upload/download progress
      * @return Call to execute
      * @throws ApiException If fail to serialize the request body object
      * @http.response.details
      <table summary="Response Details" border="1">
         <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
-        <tr><td> 200 </td><td> diff of branch uncommitted changes </td><td>  -  </td></tr>
+        <tr><td> 200 </td><td> branch restored </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
         <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
         <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
         <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
      </table>
      */
-    public okhttp3.Call diffBranchCall(String repository, String branch, String after, Integer amount, String prefix, String delimiter, final ApiCallback _callback) throws ApiException {
-        Object localVarPostBody = null;
+    public okhttp3.Call restoreBranchCall(String repository, String branch, BranchRestoreCreation branchRestoreCreation, final ApiCallback _callback) throws ApiException {
+        Object localVarPostBody = branchRestoreCreation;
 
         // create path and map variables
-        String localVarPath = "/repositories/{repository}/branches/{branch}/diff"
+        String localVarPath = "/repositories/{repository}/branches/{branch}/restore"
             .replaceAll("\\{" + "repository" + "\\}", localVarApiClient.escapeString(repository.toString()))
             .replaceAll("\\{" + "branch" + "\\}", localVarApiClient.escapeString(branch.toString()));
 
@@ -365,21 +1882,151 @@ public class BranchesApi {
         Map<String, String> localVarCookieParams = new HashMap<String, String>();
         Map<String, Object> localVarFormParams = new HashMap<String, Object>();
 
-        if (after != null) {
-            localVarQueryParams.addAll(localVarApiClient.parameterToPair("after", after));
+        final String[] localVarAccepts = {
+            "application/json"
+        };
+        final String localVarAccept = localVarApiClient.selectHeaderAccept(localVarAccepts);
+        if (localVarAccept != null) {
+            localVarHeaderParams.put("Accept", localVarAccept);
         }
 
-        if (amount != null) {
-            localVarQueryParams.addAll(localVarApiClient.parameterToPair("amount", amount));
-        }
+        final String[] localVarContentTypes = {
+            "application/json"
+        };
+        final String localVarContentType = localVarApiClient.selectHeaderContentType(localVarContentTypes);
+        localVarHeaderParams.put("Content-Type", localVarContentType);
 
-        if (prefix != null) {
-            localVarQueryParams.addAll(localVarApiClient.parameterToPair("prefix", prefix));
-        }
+        String[] localVarAuthNames = new String[] { "basic_auth", "cookie_auth", "jwt_token" };
+        return localVarApiClient.buildCall(localVarPath, "POST", localVarQueryParams, localVarCollectionQueryParams, localVarPostBody, localVarHeaderParams, localVarCookieParams, localVarFormParams, localVarAuthNames, _callback);
+    }
 
-        if (delimiter != null) {
-            localVarQueryParams.addAll(localVarApiClient.parameterToPair("delimiter", delimiter));
+    @SuppressWarnings("rawtypes")
+    private okhttp3.Call restoreBranchValidateBeforeCall(String repository, String branch, BranchRestoreCreation branchRestoreCreation, final ApiCallback _callback) throws ApiException {
+        
+        // verify the required parameter 'repository' is set
+        if (repository == null) {
+            throw new ApiException("Missing the required parameter 'repository' when calling restoreBranch(Async)");
+        }
+        
+        // verify the required parameter 'branch' is set
+        if (branch == null) {
+            throw new ApiException("Missing the required parameter 'branch' when calling restoreBranch(Async)");
+        }
+        
+        // verify the required parameter 'branchRestoreCreation' is set
+        if (branchRestoreCreation == null) {
+            throw new ApiException("Missing the required parameter 'branchRestoreCreation' when calling restoreBranch(Async)");
         }
+        
+
+        okhttp3.Call localVarCall = restoreBranchCall(repository, branch, branchRestoreCreation, _callback);
+        return localVarCall;
+
+    }
+
+    /**
+     * point a branch back at a previous head from its reflog
+     * Points the branch at the commit it pointed to after the given branch reflog entry, recreating the branch if it was deleted. Requires no uncommitted changes on an existing branch. 
+     * @param repository  (required)
+     * @param branch  (required)
+     * @param branchRestoreCreation  (required)
+     * @return Ref
+     * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> branch restored </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public Ref restoreBranch(String repository, String branch, BranchRestoreCreation branchRestoreCreation) throws ApiException {
+        ApiResponse<Ref> localVarResp = restoreBranchWithHttpInfo(repository, branch, branchRestoreCreation);
+        return localVarResp.getData();
+    }
+
+    /**
+     * point a branch back at a previous head from its reflog
+     * Points the branch at the commit it pointed to after the given branch reflog entry, recreating the branch if it was deleted. Requires no uncommitted changes on an existing branch. 
+     * @param repository  (required)
+     * @param branch  (required)
+     * @param branchRestoreCreation  (required)
+     * @return ApiResponse&lt;Ref&gt;
+     * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> branch restored </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public ApiResponse<Ref> restoreBranchWithHttpInfo(String repository, String branch, BranchRestoreCreation branchRestoreCreation) throws ApiException {
+        okhttp3.Call localVarCall = restoreBranchValidateBeforeCall(repository, branch, branchRestoreCreation, null);
+        Type localVarReturnType = new TypeToken<Ref>(){}.getType();
+        return localVarApiClient.execute(localVarCall, localVarReturnType);
+    }
+
+    /**
+     * point a branch back at a previous head from its reflog (asynchronously)
+     * Points the branch at the commit it pointed to after the given branch reflog entry, recreating the branch if it was deleted. Requires no uncommitted changes on an existing branch. 
+     * @param repository  (required)
+     * @param branch  (required)
+     * @param branchRestoreCreation  (required)
+     * @param _callback The callback to be executed when the API call finishes
+     * @return The request call
+     * @throws ApiException If fail to process the API call, e.g. serializing the request body object
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> branch restored </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public okhttp3.Call restoreBranchAsync(String repository, String branch, BranchRestoreCreation branchRestoreCreation, final ApiCallback<Ref> _callback) throws ApiException {
+
+        okhttp3.Call localVarCall = restoreBranchValidateBeforeCall(repository, branch, branchRestoreCreation, _callback);
+        Type localVarReturnType = new TypeToken<Ref>(){}.getType();
+        localVarApiClient.executeAsync(localVarCall, localVarReturnType, _callback);
+        return localVarCall;
+    }
+    /**
+     * Build call for revertBranch
+     * @param repository  (required)
+     * @param branch  (required)
+     * @param revertCreation  (required)
+     * @param _callback Callback for upload/download progress
+     * @return Call to execute
+     * @throws ApiException If fail to serialize the request body object
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 204 </td><td> revert successful </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public okhttp3.Call revertBranchCall(String repository, String branch, RevertCreation revertCreation, final ApiCallback _callback) throws ApiException {
+        Object localVarPostBody = revertCreation;
+
+        // create path and map variables
+        String localVarPath = "/repositories/{repository}/branches/{branch}/revert"
+            .replaceAll("\\{" + "repository" + "\\}", localVarApiClient.escapeString(repository.toString()))
+            .replaceAll("\\{" + "branch" + "\\}", localVarApiClient.escapeString(branch.toString()));
+
+        List<Pair> localVarQueryParams = new ArrayList<Pair>();
+        List<Pair> localVarCollectionQueryParams = new ArrayList<Pair>();
+        Map<String, String> localVarHeaderParams = new HashMap<String, String>();
+        Map<String, String> localVarCookieParams = new HashMap<String, String>();
+        Map<String, Object> localVarFormParams = new HashMap<String, Object>();
 
         final String[] localVarAccepts = {
             "application/json"
@@ -390,136 +2037,132 @@ public class BranchesApi {
         }
 
         final String[] localVarContentTypes = {
-            
+            "application/json"
         };
         final String localVarContentType = localVarApiClient.selectHeaderContentType(localVarContentTypes);
         localVarHeaderParams.put("Content-Type", localVarContentType);
 
         String[] localVarAuthNames = new String[] { "basic_auth", "cookie_auth", "jwt_token" };
-        return localVarApiClient.buildCall(localVarPath, "GET", localVarQueryParams, localVarCollectionQueryParams, localVarPostBody, localVarHeaderParams, localVarCookieParams, localVarFormParams, localVarAuthNames, _callback);
+        return localVarApiClient.buildCall(localVarPath, "POST", localVarQueryParams, localVarCollectionQueryParams, localVarPostBody, localVarHeaderParams, localVarCookieParams, localVarFormParams, localVarAuthNames, _callback);
     }
 
     @SuppressWarnings("rawtypes")
-    private okhttp3.Call diffBranchValidateBeforeCall(String repository, String branch, String after, Integer amount, String prefix, String delimiter, final ApiCallback _callback) throws ApiException {
+    private okhttp3.Call revertBranchValidateBeforeCall(String repository, String branch, RevertCreation revertCreation, final ApiCallback _callback) throws ApiException {
         
         // verify the required parameter 'repository' is set
         if (repository == null) {
-            throw new ApiException("Missing the required parameter 'repository' when calling diffBranch(Async)");
+            throw new ApiException("Missing the required parameter 'repository' when calling revertBranch(Async)");
         }
         
         // verify the required parameter 'branch' is set
         if (branch == null) {
-            throw new ApiException("Missing the required parameter 'branch' when calling diffBranch(Async)");
+            throw new ApiException("Missing the required parameter 'branch' when calling revertBranch(Async)");
+        }
+        
+        // verify the required parameter 'revertCreation' is set
+        if (revertCreation == null) {
+            throw new ApiException("Missing the required parameter 'revertCreation' when calling revertBranch(Async)");
         }
         
 
-        okhttp3.Call localVarCall = diffBranchCall(repository, branch, after, amount, prefix, delimiter, _callback);
+        okhttp3.Call localVarCall = revertBranchCall(repository, branch, revertCreation, _callback);
         return localVarCall;
 
     }
 
     /**
-     * diff branch
+     * revert
      * 
      * @param repository  (required)
      * @param branch  (required)
-     * @param after return items after this value (optional)
-     * @param amount how many items to return (optional, default to 100)
-     * @param prefix return items prefixed with this value (optional)
-     * @param delimiter delimiter used to group common prefixes by (optional)
-     * @return DiffList
+     * @param revertCreation  (required)
      * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
      * @http.response.details
      <table summary="Response Details" border="1">
         <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
-        <tr><td> 200 </td><td> diff of branch uncommitted changes </td><td>  -  </td></tr>
+        <tr><td> 204 </td><td> revert successful </td><td>  -  </td></tr>
         <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
         <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
         <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
      </table>
      */
-    public DiffList diffBranch(String repository, String branch, String after, Integer amount, String prefix, String delimiter) throws ApiException {
-        ApiResponse<DiffList> localVarResp = diffBranchWithHttpInfo(repository, branch, after, amount, prefix, delimiter);
-        return localVarResp.getData();
+    public void revertBranch(String repository, String branch, RevertCreation revertCreation) throws ApiException {
+        revertBranchWithHttpInfo(repository, branch, revertCreation);
     }
 
     /**
-     * diff branch
+     * revert
      * 
      * @param repository  (required)
      * @param branch  (required)
-     * @param after return items after this value (optional)
-     * @param amount how many items to return (optional, default to 100)
-     * @param prefix return items prefixed with this value (optional)
-     * @param delimiter delimiter used to group common prefixes by (optional)
-     * @return ApiResponse&lt;DiffList&gt;
+     * @param revertCreation  (required)
+     * @return ApiResponse&lt;Void&gt;
      * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
      * @http.response.details
      <table summary="Response Details" border="1">
         <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
-        <tr><td> 200 </td><td> diff of branch uncommitted changes </td><td>  -  </td></tr>
+        <tr><td> 204 </td><td> revert successful </td><td>  -  </td></tr>
         <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
         <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
         <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
      </table>
      */
-    public ApiResponse<DiffList> diffBranchWithHttpInfo(String repository, String branch, String after, Integer amount, String prefix, String delimiter) throws ApiException {
-        okhttp3.Call localVarCall = diffBranchValidateBeforeCall(repository, branch, after, amount, prefix, delimiter, null);
-        Type localVarReturnType = new TypeToken<DiffList>(){}.getType();
-        return localVarApiClient.execute(localVarCall, localVarReturnType);
+    public ApiResponse<Void> revertBranchWithHttpInfo(String repository, String branch, RevertCreation revertCreation) throws ApiException {
+        okhttp3.Call localVarCall = revertBranchValidateBeforeCall(repository, branch, revertCreation, null);
+        return localVarApiClient.execute(localVarCall);
     }
 
     /**
-     * diff branch (asynchronously)
+     * revert (asynchronously)
      * 
      * @param repository  (required)
      * @param branch  (required)
-     * @param after return items after this value (optional)
-     * @param amount how many items to return (optional, default to 100)
-     * @param prefix return items prefixed with this value (optional)
-     * @param delimiter delimiter used to group common prefixes by (optional)
+     * @param revertCreation  (required)
      * @param _callback The callback to be executed when the API call finishes
      * @return The request call
      * @throws ApiException If fail to process the API call, e.g. serializing the request body object
      * @http.response.details
      <table summary="Response Details" border="1">
         <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
-        <tr><td> 200 </td><td> diff of branch uncommitted changes </td><td>  -  </td></tr>
+        <tr><td> 204 </td><td> revert successful </td><td>  -  </td></tr>
         <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
         <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
         <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
      </table>
      */
-    public okhttp3.Call diffBranchAsync(String repository, String branch, String after, Integer amount, String prefix, String delimiter, final ApiCallback<DiffList> _callback) throws ApiException {
+    public okhttp3.Call revertBranchAsync(String repository, String branch, RevertCreation revertCreation, final ApiCallback<Void> _callback) throws ApiException {
 
-        okhttp3.Call localVarCall = diffBranchValidateBeforeCall(repository, branch, after, amount, prefix, delimiter, _callback);
-        Type localVarReturnType = new TypeToken<DiffList>(){}.getType();
-        localVarApiClient.executeAsync(localVarCall, localVarReturnType, _callback);
+        okhttp3.Call localVarCall = revertBranchValidateBeforeCall(repository, branch, revertCreation, _callback);
+        localVarApiClient.executeAsync(localVarCall, _callback);
         return localVarCall;
     }
     /**
-     * Build call for getBranch
+     * Build call for stashApply
      * @param repository  (required)
      * @param branch  (required)
+     * @param stash  (required)
      * @param _callback Callback for upload/download progress
      * @return Call to execute
      * @throws ApiException If fail to serialize the request body object
      * @http.response.details
      <table summary="Response Details" border="1">
         <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
-        <tr><td> 200 </td><td> branch </td><td>  -  </td></tr>
+        <tr><td> 204 </td><td> stash applied </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
         <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
         <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 409 </td><td> conflict </td><td>  -  </td></tr>
         <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
      </table>
      */
-    public okhttp3.Call getBranchCall(String repository, String branch, final ApiCallback _callback) throws ApiException {
+    public okhttp3.Call stashApplyCall(String repository, String branch, String stash, final ApiCallback _callback) throws ApiException {
         Object localVarPostBody = null;
 
         // create path and map variables
-        String localVarPath = "/repositories/{repository}/branches/{branch}"
+        String localVarPath = "/repositories/{repository}/branches/{branch}/stashes/{stash}/apply"
             .replaceAll("\\{" + "repository" + "\\}", localVarApiClient.escapeString(repository.toString()))
-            .replaceAll("\\{" + "branch" + "\\}", localVarApiClient.escapeString(branch.toString()));
+            .replaceAll("\\{" + "branch" + "\\}", localVarApiClient.escapeString(branch.toString()))
+            .replaceAll("\\{" + "stash" + "\\}", localVarApiClient.escapeString(stash.toString()));
 
         List<Pair> localVarQueryParams = new ArrayList<Pair>();
         List<Pair> localVarCollectionQueryParams = new ArrayList<Pair>();
@@ -542,119 +2185,130 @@ public class BranchesApi {
         localVarHeaderParams.put("Content-Type", localVarContentType);
 
         String[] localVarAuthNames = new String[] { "basic_auth", "cookie_auth", "jwt_token" };
-        return localVarApiClient.buildCall(localVarPath, "GET", localVarQueryParams, localVarCollectionQueryParams, localVarPostBody, localVarHeaderParams, localVarCookieParams, localVarFormParams, localVarAuthNames, _callback);
+        return localVarApiClient.buildCall(localVarPath, "POST", localVarQueryParams, localVarCollectionQueryParams, localVarPostBody, localVarHeaderParams, localVarCookieParams, localVarFormParams, localVarAuthNames, _callback);
     }
 
     @SuppressWarnings("rawtypes")
-    private okhttp3.Call getBranchValidateBeforeCall(String repository, String branch, final ApiCallback _callback) throws ApiException {
+    private okhttp3.Call stashApplyValidateBeforeCall(String repository, String branch, String stash, final ApiCallback _callback) throws ApiException {
         
         // verify the required parameter 'repository' is set
         if (repository == null) {
-            throw new ApiException("Missing the required parameter 'repository' when calling getBranch(Async)");
+            throw new ApiException("Missing the required parameter 'repository' when calling stashApply(Async)");
         }
         
         // verify the required parameter 'branch' is set
         if (branch == null) {
-            throw new ApiException("Missing the required parameter 'branch' when calling getBranch(Async)");
+            throw new ApiException("Missing the required parameter 'branch' when calling stashApply(Async)");
+        }
+        
+        // verify the required parameter 'stash' is set
+        if (stash == null) {
+            throw new ApiException("Missing the required parameter 'stash' when calling stashApply(Async)");
         }
         
 
-        okhttp3.Call localVarCall = getBranchCall(repository, branch, _callback);
+        okhttp3.Call localVarCall = stashApplyCall(repository, branch, stash, _callback);
         return localVarCall;
 
     }
 
     /**
-     * get branch
-     * 
+     * stage the changes of a stash on a branch
+     * Stages the changes of the stash on the branch, keeping the stash. Fails without staging anything when a path of the stash was changed differently on the branch since. 
      * @param repository  (required)
      * @param branch  (required)
-     * @return Ref
+     * @param stash  (required)
      * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
      * @http.response.details
      <table summary="Response Details" border="1">
         <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
-        <tr><td> 200 </td><td> branch </td><td>  -  </td></tr>
+        <tr><td> 204 </td><td> stash applied </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
         <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
         <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 409 </td><td> conflict </td><td>  -  </td></tr>
         <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
      </table>
      */
-    public Ref getBranch(String repository, String branch) throws ApiException {
-        ApiResponse<Ref> localVarResp = getBranchWithHttpInfo(repository, branch);
-        return localVarResp.getData();
+    public void stashApply(String repository, String branch, String stash) throws ApiException {
+        stashApplyWithHttpInfo(repository, branch, stash);
     }
 
     /**
-     * get branch
-     * 
+     * stage the changes of a stash on a branch
+     * Stages the changes of the stash on the branch, keeping the stash. Fails without staging anything when a path of the stash was changed differently on the branch since. 
      * @param repository  (required)
      * @param branch  (required)
-     * @return ApiResponse&lt;Ref&gt;
+     * @param stash  (required)
+     * @return ApiResponse&lt;Void&gt;
      * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
      * @http.response.details
      <table summary="Response Details" border="1">
         <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
-        <tr><td> 200 </td><td> branch </td><td>  -  </td></tr>
+        <tr><td> 204 </td><td> stash applied </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
         <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
         <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 409 </td><td> conflict </td><td>  -  </td></tr>
         <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
      </table>
      */
-    public ApiResponse<Ref> getBranchWithHttpInfo(String repository, String branch) throws ApiException {
-        okhttp3.Call localVarCall = getBranchValidateBeforeCall(repository, branch, null);
-        Type localVarReturnType = new TypeToken<Ref>(){}.getType();
-        return localVarApiClient.execute(localVarCall, localVarReturnType);
+    public ApiResponse<Void> stashApplyWithHttpInfo(String repository, String branch, String stash) throws ApiException {
+        okhttp3.Call localVarCall = stashApplyValidateBeforeCall(repository, branch, stash, null);
+        return localVarApiClient.execute(localVarCall);
     }
 
     /**
-     * get branch (asynchronously)
-     * 
+     * stage the changes of a stash on a branch (asynchronously)
+     * Stages the changes of the stash on the branch, keeping the stash. Fails without staging anything when a path of the stash was changed differently on the branch since. 
      * @param repository  (required)
      * @param branch  (required)
+     * @param stash  (required)
      * @param _callback The callback to be executed when the API call finishes
      * @return The request call
      * @throws ApiException If fail to process the API call, e.g. serializing the request body object
      * @http.response.details
      <table summary="Response Details" border="1">
         <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
-        <tr><td> 200 </td><td> branch </td><td>  -  </td></tr>
+        <tr><td> 204 </td><td> stash applied </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
         <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
         <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 409 </td><td> conflict </td><td>  -  </td></tr>
         <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
      </table>
      */
-    public okhttp3.Call getBranchAsync(String repository, String branch, final ApiCallback<Ref> _callback) throws ApiException {
+    public okhttp3.Call stashApplyAsync(String repository, String branch, String stash, final ApiCallback<Void> _callback) throws ApiException {
 
-        okhttp3.Call localVarCall = getBranchValidateBeforeCall(repository, branch, _callback);
-        Type localVarReturnType = new TypeToken<Ref>(){}.getType();
-        localVarApiClient.executeAsync(localVarCall, localVarReturnType, _callback);
+        okhttp3.Call localVarCall = stashApplyValidateBeforeCall(repository, branch, stash, _callback);
+        localVarApiClient.executeAsync(localVarCall, _callback);
         return localVarCall;
     }
     /**
-     * Build call for listBranches
+     * Build call for stashDrop
      * @param repository  (required)
-     * @param prefix return items prefixed with this value (optional)
-     * @param after return items after this value (optional)
-     * @param amount how many items to return (optional, default to 100)
+     * @param branch  (required)
+     * @param stash  (required)
      * @param _callback Callback for upload/download progress
      * @return Call to execute
      * @throws ApiException If fail to serialize the request body object
      * @http.response.details
      <table summary="Response Details" border="1">
         <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
-        <tr><td> 200 </td><td> branch list </td><td>  -  </td></tr>
+        <tr><td> 204 </td><td> stash dropped </td><td>  -  </td></tr>
         <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
         <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
         <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
      </table>
      */
-    public okhttp3.Call listBranchesCall(String repository, String prefix, String after, Integer amount, final ApiCallback _callback) throws ApiException {
+    public okhttp3.Call stashDropCall(String repository, String branch, String stash, final ApiCallback _callback) throws ApiException {
         Object localVarPostBody = null;
 
         // create path and map variables
-        String localVarPath = "/repositories/{repository}/branches"
-            .replaceAll("\\{" + "repository" + "\\}", localVarApiClient.escapeString(repository.toString()));
+        String localVarPath = "/repositories/{repository}/branches/{branch}/stashes/{stash}"
+            .replaceAll("\\{" + "repository" + "\\}", localVarApiClient.escapeString(repository.toString()))
+            .replaceAll("\\{" + "branch" + "\\}", localVarApiClient.escapeString(branch.toString()))
+            .replaceAll("\\{" + "stash" + "\\}", localVarApiClient.escapeString(stash.toString()));
 
         List<Pair> localVarQueryParams = new ArrayList<Pair>();
         List<Pair> localVarCollectionQueryParams = new ArrayList<Pair>();
@@ -662,18 +2316,6 @@ public class BranchesApi {
         Map<String, String> localVarCookieParams = new HashMap<String, String>();
         Map<String, Object> localVarFormParams = new HashMap<String, Object>();
 
-        if (prefix != null) {
-            localVarQueryParams.addAll(localVarApiClient.parameterToPair("prefix", prefix));
-        }
-
-        if (after != null) {
-            localVarQueryParams.addAll(localVarApiClient.parameterToPair("after", after));
-        }
-
-        if (amount != null) {
-            localVarQueryParams.addAll(localVarApiClient.parameterToPair("amount", amount));
-        }
-
         final String[] localVarAccepts = {
             "application/json"
         };
@@ -689,120 +2331,126 @@ public class BranchesApi {
         localVarHeaderParams.put("Content-Type", localVarContentType);
 
         String[] localVarAuthNames = new String[] { "basic_auth", "cookie_auth", "jwt_token" };
-        return localVarApiClient.buildCall(localVarPath, "GET", localVarQueryParams, localVarCollectionQueryParams, localVarPostBody, localVarHeaderParams, localVarCookieParams, localVarFormParams, localVarAuthNames, _callback);
+        return localVarApiClient.buildCall(localVarPath, "DELETE", localVarQueryParams, localVarCollectionQueryParams, localVarPostBody, localVarHeaderParams, localVarCookieParams, localVarFormParams, localVarAuthNames, _callback);
     }
 
     @SuppressWarnings("rawtypes")
-    private okhttp3.Call listBranchesValidateBeforeCall(String repository, String prefix, String after, Integer amount, final ApiCallback _callback) throws ApiException {
+    private okhttp3.Call stashDropValidateBeforeCall(String repository, String branch, String stash, final ApiCallback _callback) throws ApiException {
         
         // verify the required parameter 'repository' is set
         if (repository == null) {
-            throw new ApiException("Missing the required parameter 'repository' when calling listBranches(Async)");
+            throw new ApiException("Missing the required parameter 'repository' when calling stashDrop(Async)");
+        }
+        
+        // verify the required parameter 'branch' is set
+        if (branch == null) {
+            throw new ApiException("Missing the required parameter 'branch' when calling stashDrop(Async)");
+        }
+        
+        // verify the required parameter 'stash' is set
+        if (stash == null) {
+            throw new ApiException("Missing the required parameter 'stash' when calling stashDrop(Async)");
         }
         
 
-        okhttp3.Call localVarCall = listBranchesCall(repository, prefix, after, amount, _callback);
+        okhttp3.Call localVarCall = stashDropCall(repository, branch, stash, _callback);
         return localVarCall;
 
     }
 
     /**
-     * list branches
+     * delete a stash and its changes
      * 
      * @param repository  (required)
-     * @param prefix return items prefixed with this value (optional)
-     * @param after return items after this value (optional)
-     * @param amount how many items to return (optional, default to 100)
-     * @return RefList
+     * @param branch  (required)
+     * @param stash  (required)
      * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
      * @http.response.details
      <table summary="Response Details" border="1">
         <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
-        <tr><td> 200 </td><td> branch list </td><td>  -  </td></tr>
+        <tr><td> 204 </td><td> stash dropped </td><td>  -  </td></tr>
         <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
         <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
         <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
      </table>
      */
-    public RefList listBranches(String repository, String prefix, String after, Integer amount) throws ApiException {
-        ApiResponse<RefList> localVarResp = listBranchesWithHttpInfo(repository, prefix, after, amount);
-        return localVarResp.getData();
+    public void stashDrop(String repository, String branch, String stash) throws ApiException {
+        stashDropWithHttpInfo(repository, branch, stash);
     }
 
     /**
-     * list branches
+     * delete a stash and its changes
      * 
      * @param repository  (required)
-     * @param prefix return items prefixed with this value (optional)
-     * @param after return items after this value (optional)
-     * @param amount how many items to return (optional, default to 100)
-     * @return ApiResponse&lt;RefList&gt;
+     * @param branch  (required)
+     * @param stash  (required)
+     * @return ApiResponse&lt;Void&gt;
      * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
      * @http.response.details
      <table summary="Response Details" border="1">
         <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
-        <tr><td> 200 </td><td> branch list </td><td>  -  </td></tr>
+        <tr><td> 204 </td><td> stash dropped </td><td>  -  </td></tr>
         <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
         <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
         <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
      </table>
      */
-    public ApiResponse<RefList> listBranchesWithHttpInfo(String repository, String prefix, String after, Integer amount) throws ApiException {
-        okhttp3.Call localVarCall = listBranchesValidateBeforeCall(repository, prefix, after, amount, null);
-        Type localVarReturnType = new TypeToken<RefList>(){}.getType();
-        return localVarApiClient.execute(localVarCall, localVarReturnType);
+    public ApiResponse<Void> stashDropWithHttpInfo(String repository, String branch, String stash) throws ApiException {
+        okhttp3.Call localVarCall = stashDropValidateBeforeCall(repository, branch, stash, null);
+        return localVarApiClient.execute(localVarCall);
     }
 
     /**
-     * list branches (asynchronously)
+     * delete a stash and its changes (asynchronously)
      * 
      * @param repository  (required)
-     * @param prefix return items prefixed with this value (optional)
-     * @param after return items after this value (optional)
-     * @param amount how many items to return (optional, default to 100)
+     * @param branch  (required)
+     * @param stash  (required)
      * @param _callback The callback to be executed when the API call finishes
      * @return The request call
      * @throws ApiException If fail to process the API call, e.g. serializing the request body object
      * @http.response.details
      <table summary="Response Details" border="1">
         <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
-        <tr><td> 200 </td><td> branch list </td><td>  -  </td></tr>
+        <tr><td> 204 </td><td> stash dropped </td><td>  -  </td></tr>
         <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
         <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
         <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
      </table>
      */
-    public okhttp3.Call listBranchesAsync(String repository, String prefix, String after, Integer amount, final ApiCallback<RefList> _callback) throws ApiException {
+    public okhttp3.Call stashDropAsync(String repository, String branch, String stash, final ApiCallback<Void> _callback) throws ApiException {
 
-        okhttp3.Call localVarCall = listBranchesValidateBeforeCall(repository, prefix, after, amount, _callback);
-        Type localVarReturnType = new TypeToken<RefList>(){}.getType();
-        localVarApiClient.executeAsync(localVarCall, localVarReturnType, _callback);
+        okhttp3.Call localVarCall = stashDropValidateBeforeCall(repository, branch, stash, _callback);
+        localVarApiClient.executeAsync(localVarCall, _callback);
         return localVarCall;
     }
     /**
-     * Build call for resetBranch
+     * Build call for stashPop
      * @param repository  (required)
      * @param branch  (required)
-     * @param resetCreation  (required)
+     * @param stash  (required)
      * @param _callback Callback for upload/download progress
      * @return Call to execute
      * @throws ApiException If fail to serialize the request body object
      * @http.response.details
      <table summary="Response Details" border="1">
         <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
-        <tr><td> 204 </td><td> reset successful </td><td>  -  </td></tr>
+        <tr><td> 204 </td><td> stash popped </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
         <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
         <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 409 </td><td> conflict </td><td>  -  </td></tr>
         <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
      </table>
      */
-    public okhttp3.Call resetBranchCall(String repository, String branch, ResetCreation resetCreation, final ApiCallback _callback) throws ApiException {
-        Object localVarPostBody = resetCreation;
+    public okhttp3.Call stashPopCall(String repository, String branch, String stash, final ApiCallback _callback) throws ApiException {
+        Object localVarPostBody = null;
 
         // create path and map variables
-        String localVarPath = "/repositories/{repository}/branches/{branch}"
+        String localVarPath = "/repositories/{repository}/branches/{branch}/stashes/{stash}/pop"
             .replaceAll("\\{" + "repository" + "\\}", localVarApiClient.escapeString(repository.toString()))
-            .replaceAll("\\{" + "branch" + "\\}", localVarApiClient.escapeString(branch.toString()));
+            .replaceAll("\\{" + "branch" + "\\}", localVarApiClient.escapeString(branch.toString()))
+            .replaceAll("\\{" + "stash" + "\\}", localVarApiClient.escapeString(stash.toString()));
 
         List<Pair> localVarQueryParams = new ArrayList<Pair>();
         List<Pair> localVarCollectionQueryParams = new ArrayList<Pair>();
@@ -819,127 +2467,135 @@ public class BranchesApi {
         }
 
         final String[] localVarContentTypes = {
-            "application/json"
+            
         };
         final String localVarContentType = localVarApiClient.selectHeaderContentType(localVarContentTypes);
         localVarHeaderParams.put("Content-Type", localVarContentType);
 
         String[] localVarAuthNames = new String[] { "basic_auth", "cookie_auth", "jwt_token" };
-        return localVarApiClient.buildCall(localVarPath, "PUT", localVarQueryParams, localVarCollectionQueryParams, localVarPostBody, localVarHeaderParams, localVarCookieParams, localVarFormParams, localVarAuthNames, _callback);
+        return localVarApiClient.buildCall(localVarPath, "POST", localVarQueryParams, localVarCollectionQueryParams, localVarPostBody, localVarHeaderParams, localVarCookieParams, localVarFormParams, localVarAuthNames, _callback);
     }
 
     @SuppressWarnings("rawtypes")
-    private okhttp3.Call resetBranchValidateBeforeCall(String repository, String branch, ResetCreation resetCreation, final ApiCallback _callback) throws ApiException {
+    private okhttp3.Call stashPopValidateBeforeCall(String repository, String branch, String stash, final ApiCallback _callback) throws ApiException {
         
         // verify the required parameter 'repository' is set
         if (repository == null) {
-            throw new ApiException("Missing the required parameter 'repository' when calling resetBranch(Async)");
+            throw new ApiException("Missing the required parameter 'repository' when calling stashPop(Async)");
         }
         
         // verify the required parameter 'branch' is set
         if (branch == null) {
-            throw new ApiException("Missing the required parameter 'branch' when calling resetBranch(Async)");
+            throw new ApiException("Missing the required parameter 'branch' when calling stashPop(Async)");
         }
         
-        // verify the required parameter 'resetCreation' is set
-        if (resetCreation == null) {
-            throw new ApiException("Missing the required parameter 'resetCreation' when calling resetBranch(Async)");
+        // verify the required parameter 'stash' is set
+        if (stash == null) {
+            throw new ApiException("Missing the required parameter 'stash' when calling stashPop(Async)");
         }
         
 
-        okhttp3.Call localVarCall = resetBranchCall(repository, branch, resetCreation, _callback);
+        okhttp3.Call localVarCall = stashPopCall(repository, branch, stash, _callback);
         return localVarCall;
 
     }
 
     /**
-     * reset branch
-     * 
+     * stage the changes of a stash on a branch and delete the stash
+     * Stages the changes of the stash on the branch and deletes the stash. Fails without staging anything when a path of the stash was changed differently on the branch since. 
      * @param repository  (required)
      * @param branch  (required)
-     * @param resetCreation  (required)
+     * @param stash  (required)
      * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
      * @http.response.details
      <table summary="Response Details" border="1">
         <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
-        <tr><td> 204 </td><td> reset successful </td><td>  -  </td></tr>
+        <tr><td> 204 </td><td> stash popped </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
         <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
         <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 409 </td><td> conflict </td><td>  -  </td></tr>
         <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
      </table>
      */
-    public void resetBranch(String repository, String branch, ResetCreation resetCreation) throws ApiException {
-        resetBranchWithHttpInfo(repository, branch, resetCreation);
+    public void stashPop(String repository, String branch, String stash) throws ApiException {
+        stashPopWithHttpInfo(repository, branch, stash);
     }
 
     /**
-     * reset branch
-     * 
+     * stage the changes of a stash on a branch and delete the stash
+     * Stages the changes of the stash on the branch and deletes the stash. Fails without staging anything when a path of the stash was changed differently on the branch since. 
      * @param repository  (required)
      * @param branch  (required)
-     * @param resetCreation  (required)
+     * @param stash  (required)
      * @return ApiResponse&lt;Void&gt;
      * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
      * @http.response.details
      <table summary="Response Details" border="1">
         <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
-        <tr><td> 204 </td><td> reset successful </td><td>  -  </td></tr>
+        <tr><td> 204 </td><td> stash popped </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
         <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
         <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 409 </td><td> conflict </td><td>  -  </td></tr>
         <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
      </table>
      */
-    public ApiResponse<Void> resetBranchWithHttpInfo(String repository, String branch, ResetCreation resetCreation) throws ApiException {
-        okhttp3.Call localVarCall = resetBranchValidateBeforeCall(repository, branch, resetCreation, null);
+    public ApiResponse<Void> stashPopWithHttpInfo(String repository, String branch, String stash) throws ApiException {
+        okhttp3.Call localVarCall = stashPopValidateBeforeCall(repository, branch, stash, null);
         return localVarApiClient.execute(localVarCall);
     }
 
     /**
-     * reset branch (asynchronously)
-     * 
+     * stage the changes of a stash on a branch and delete the stash (asynchronously)
+     * Stages the changes of the stash on the branch and deletes the stash. Fails without staging anything when a path of the stash was changed differently on the branch since. 
      * @param repository  (required)
      * @param branch  (required)
-     * @param resetCreation  (required)
+     * @param stash  (required)
      * @param _callback The callback to be executed when the API call finishes
      * @return The request call
      * @throws ApiException If fail to process the API call, e.g. serializing the request body object
      * @http.response.details
      <table summary="Response Details" border="1">
         <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
-        <tr><td> 204 </td><td> reset successful </td><td>  -  </td></tr>
+        <tr><td> 204 </td><td> stash popped </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
         <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
         <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 409 </td><td> conflict </td><td>  -  </td></tr>
         <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
      </table>
      */
-    public okhttp3.Call resetBranchAsync(String repository, String branch, ResetCreation resetCreation, final ApiCallback<Void> _callback) throws ApiException {
+    public okhttp3.Call stashPopAsync(String repository, String branch, String stash, final ApiCallback<Void> _callback) throws ApiException {
 
-        okhttp3.Call localVarCall = resetBranchValidateBeforeCall(repository, branch, resetCreation, _callback);
+        okhttp3.Call localVarCall = stashPopValidateBeforeCall(repository, branch, stash, _callback);
         localVarApiClient.executeAsync(localVarCall, _callback);
         return localVarCall;
     }
     /**
-     * Build call for revertBranch
+     * Build call for stashPush
      * @param repository  (required)
      * @param branch  (required)
-     * @param revertCreation  (required)
+     * @param stashCreation  (required)
      * @param _callback Callback for upload/download progress
      * @return Call to execute
      * @throws ApiException If fail to serialize the request body object
      * @http.response.details
      <table summary="Response Details" border="1">
         <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
-        <tr><td> 204 </td><td> revert successful </td><td>  -  </td></tr>
+        <tr><td> 201 </td><td> stash created </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
         <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
         <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 409 </td><td> stash already exists </td><td>  -  </td></tr>
         <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
      </table>
      */
-    public okhttp3.Call revertBranchCall(String repository, String branch, RevertCreation revertCreation, final ApiCallback _callback) throws ApiException {
-        Object localVarPostBody = revertCreation;
+    public okhttp3.Call stashPushCall(String repository, String branch, StashCreation stashCreation, final ApiCallback _callback) throws ApiException {
+        Object localVarPostBody = stashCreation;
 
         // create path and map variables
-        String localVarPath = "/repositories/{repository}/branches/{branch}/revert"
+        String localVarPath = "/repositories/{repository}/branches/{branch}/stashes"
             .replaceAll("\\{" + "repository" + "\\}", localVarApiClient.escapeString(repository.toString()))
             .replaceAll("\\{" + "branch" + "\\}", localVarApiClient.escapeString(branch.toString()));
 
@@ -968,93 +2624,103 @@ public class BranchesApi {
     }
 
     @SuppressWarnings("rawtypes")
-    private okhttp3.Call revertBranchValidateBeforeCall(String repository, String branch, RevertCreation revertCreation, final ApiCallback _callback) throws ApiException {
+    private okhttp3.Call stashPushValidateBeforeCall(String repository, String branch, StashCreation stashCreation, final ApiCallback _callback) throws ApiException {
         
         // verify the required parameter 'repository' is set
         if (repository == null) {
-            throw new ApiException("Missing the required parameter 'repository' when calling revertBranch(Async)");
+            throw new ApiException("Missing the required parameter 'repository' when calling stashPush(Async)");
         }
         
         // verify the required parameter 'branch' is set
         if (branch == null) {
-            throw new ApiException("Missing the required parameter 'branch' when calling revertBranch(Async)");
+            throw new ApiException("Missing the required parameter 'branch' when calling stashPush(Async)");
         }
         
-        // verify the required parameter 'revertCreation' is set
-        if (revertCreation == null) {
-            throw new ApiException("Missing the required parameter 'revertCreation' when calling revertBranch(Async)");
+        // verify the required parameter 'stashCreation' is set
+        if (stashCreation == null) {
+            throw new ApiException("Missing the required parameter 'stashCreation' when calling stashPush(Async)");
         }
         
 
-        okhttp3.Call localVarCall = revertBranchCall(repository, branch, revertCreation, _callback);
+        okhttp3.Call localVarCall = stashPushCall(repository, branch, stashCreation, _callback);
         return localVarCall;
 
     }
 
     /**
-     * revert
-     * 
+     * move the uncommitted changes of a branch aside
+     * Moves the uncommitted changes of the branch to a new stash, leaving the branch with no uncommitted changes. 
      * @param repository  (required)
      * @param branch  (required)
-     * @param revertCreation  (required)
+     * @param stashCreation  (required)
+     * @return Stash
      * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
      * @http.response.details
      <table summary="Response Details" border="1">
         <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
-        <tr><td> 204 </td><td> revert successful </td><td>  -  </td></tr>
+        <tr><td> 201 </td><td> stash created </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
         <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
         <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 409 </td><td> stash already exists </td><td>  -  </td></tr>
         <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
      </table>
      */
-    public void revertBranch(String repository, String branch, RevertCreation revertCreation) throws ApiException {
-        revertBranchWithHttpInfo(repository, branch, revertCreation);
+    public Stash stashPush(String repository, String branch, StashCreation stashCreation) throws ApiException {
+        ApiResponse<Stash> localVarResp = stashPushWithHttpInfo(repository, branch, stashCreation);
+        return localVarResp.getData();
     }
 
     /**
-     * revert
-     * 
+     * move the uncommitted changes of a branch aside
+     * Moves the uncommitted changes of the branch to a new stash, leaving the branch with no uncommitted changes. 
      * @param repository  (required)
      * @param branch  (required)
-     * @param revertCreation  (required)
-     * @return ApiResponse&lt;Void&gt;
+     * @param stashCreation  (required)
+     * @return ApiResponse&lt;Stash&gt;
      * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
      * @http.response.details
      <table summary="Response Details" border="1">
         <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
-        <tr><td> 204 </td><td> revert successful </td><td>  -  </td></tr>
+        <tr><td> 201 </td><td> stash created </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
         <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
         <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 409 </td><td> stash already exists </td><td>  -  </td></tr>
         <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
      </table>
      */
-    public ApiResponse<Void> revertBranchWithHttpInfo(String repository, String branch, RevertCreation revertCreation) throws ApiException {
-        okhttp3.Call localVarCall = revertBranchValidateBeforeCall(repository, branch, revertCreation, null);
-        return localVarApiClient.execute(localVarCall);
+    public ApiResponse<Stash> stashPushWithHttpInfo(String repository, String branch, StashCreation stashCreation) throws ApiException {
+        okhttp3.Call localVarCall = stashPushValidateBeforeCall(repository, branch, stashCreation, null);
+        Type localVarReturnType = new TypeToken<Stash>(){}.getType();
+        return localVarApiClient.execute(localVarCall, localVarReturnType);
     }
 
     /**
-     * revert (asynchronously)
-     * 
+     * move the uncommitted changes of a branch aside (asynchronously)
+     * Moves the uncommitted changes of the branch to a new stash, leaving the branch with no uncommitted changes. 
      * @param repository  (required)
      * @param branch  (required)
-     * @param revertCreation  (required)
+     * @param stashCreation  (required)
      * @param _callback The callback to be executed when the API call finishes
      * @return The request call
      * @throws ApiException If fail to process the API call, e.g. serializing the request body object
      * @http.response.details
      <table summary="Response Details" border="1">
         <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
-        <tr><td> 204 </td><td> revert successful </td><td>  -  </td></tr>
+        <tr><td> 201 </td><td> stash created </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
         <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
         <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 409 </td><td> stash already exists </td><td>  -  </td></tr>
         <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
      </table>
      */
-    public okhttp3.Call revertBranchAsync(String repository, String branch, RevertCreation revertCreation, final ApiCallback<Void> _callback) throws ApiException {
+    public okhttp3.Call stashPushAsync(String repository, String branch, StashCreation stashCreation, final ApiCallback<Stash> _callback) throws ApiException {
 
-        okhttp3.Call localVarCall = revertBranchValidateBeforeCall(repository, branch, revertCreation, _callback);
-        localVarApiClient.executeAsync(localVarCall, _callback);
+        okhttp3.Call localVarCall = stashPushValidateBeforeCall(repository, branch, stashCreation, _callback);
+        Type localVarReturnType = new TypeToken<Stash>(){}.getType();
+        localVarApiClient.executeAsync(localVarCall, localVarReturnType, _callback);
         return localVarCall;
     }
 }
@@ -30,6 +30,7 @@ import java.io.IOException;
 import io.lakefs.clients.api.model.Commit;
 import io.lakefs.clients.api.model.CommitCreation;
 import io.lakefs.clients.api.model.CommitList;
+import io.lakefs.clients.api.model.CommitSignatureCreation;
 import io.lakefs.clients.api.model.Error;
 
 import java.lang.reflect.Type;
@@ -501,4 +502,331 @@ public class CommitsApi {
         localVarApiClient.executeAsync(localVarCall, localVarReturnType, _callback);
         return localVarCall;
     }
+    /**
+     * Build call for searchCommits
+     * @param repository  (required)
+     * @param after return items after this value (optional)
+     * @param amount how many items to return (optional, default to 100)
+     * @param metadata list of metadata key/value pairs in the form key&#x3D;value, commits must match all of them (optional)
+     * @param committer  (optional)
+     * @param message substring of the commit message, ignoring case (optional)
+     * @param since Unix Epoch in seconds, return commits created at or after it (optional)
+     * @param until Unix Epoch in seconds, return commits created before it (optional)
+     * @param _callback Callback for upload/download progress
+     * @return Call to execute
+     * @throws ApiException If fail to serialize the request body object
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> commits </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public okhttp3.Call searchCommitsCall(String repository, String after, Integer amount, List<String> metadata, String committer, String message, Long since, Long until, final ApiCallback _callback) throws ApiException {
+        Object localVarPostBody = null;
+
+        // create path and map variables
+        String localVarPath = "/repositories/{repository}/commits"
+            .replaceAll("\\{" + "repository" + "\\}", localVarApiClient.escapeString(repository.toString()));
+
+        List<Pair> localVarQueryParams = new ArrayList<Pair>();
+        List<Pair> localVarCollectionQueryParams = new ArrayList<Pair>();
+        Map<String, String> localVarHeaderParams = new HashMap<String, String>();
+        Map<String, String> localVarCookieParams = new HashMap<String, String>();
+        Map<String, Object> localVarFormParams = new HashMap<String, Object>();
+
+        if (after != null) {
+            localVarQueryParams.addAll(localVarApiClient.parameterToPair("after", after));
+        }
+
+        if (amount != null) {
+            localVarQueryParams.addAll(localVarApiClient.parameterToPair("amount", amount));
+        }
+
+        if (metadata != null) {
+            localVarCollectionQueryParams.addAll(localVarApiClient.parameterToPairs("multi", "metadata", metadata));
+        }
+
+        if (committer != null) {
+            localVarQueryParams.addAll(localVarApiClient.parameterToPair("committer", committer));
+        }
+
+        if (message != null) {
+            localVarQueryParams.addAll(localVarApiClient.parameterToPair("message", message));
+        }
+
+        if (since != null) {
+            localVarQueryParams.addAll(localVarApiClient.parameterToPair("since", since));
+        }
+
+        if (until != null) {
+            localVarQueryParams.addAll(localVarApiClient.parameterToPair("until", until));
+        }
+
+        final String[] localVarAccepts = {
+            "application/json"
+        };
+        final String localVarAccept = localVarApiClient.selectHeaderAccept(localVarAccepts);
+        if (localVarAccept != null) {
+            localVarHeaderParams.put("Accept", localVarAccept);
+        }
+
+        final String[] localVarContentTypes = {
+            
+        };
+        final String localVarContentType = localVarApiClient.selectHeaderContentType(localVarContentTypes);
+        localVarHeaderParams.put("Content-Type", localVarContentType);
+
+        String[] localVarAuthNames = new String[] { "basic_auth", "cookie_auth", "jwt_token" };
+        return localVarApiClient.buildCall(localVarPath, "GET", localVarQueryParams, localVarCollectionQueryParams, localVarPostBody, localVarHeaderParams, localVarCookieParams, localVarFormParams, localVarAuthNames, _callback);
+    }
+
+    @SuppressWarnings("rawtypes")
+    private okhttp3.Call searchCommitsValidateBeforeCall(String repository, String after, Integer amount, List<String> metadata, String committer, String message, Long since, Long until, final ApiCallback _callback) throws ApiException {
+        
+        // verify the required parameter 'repository' is set
+        if (repository == null) {
+            throw new ApiException("Missing the required parameter 'repository' when calling searchCommits(Async)");
+        }
+        
+
+        okhttp3.Call localVarCall = searchCommitsCall(repository, after, amount, metadata, committer, message, since, until, _callback);
+        return localVarCall;
+
+    }
+
+    /**
+     * search commits of the repository by metadata, committer, message and creation date, latest first
+     * 
+     * @param repository  (required)
+     * @param after return items after this value (optional)
+     * @param amount how many items to return (optional, default to 100)
+     * @param metadata list of metadata key/value pairs in the form key&#x3D;value, commits must match all of them (optional)
+     * @param committer  (optional)
+     * @param message substring of the commit message, ignoring case (optional)
+     * @param since Unix Epoch in seconds, return commits created at or after it (optional)
+     * @param until Unix Epoch in seconds, return commits created before it (optional)
+     * @return CommitList
+     * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> commits </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public CommitList searchCommits(String repository, String after, Integer amount, List<String> metadata, String committer, String message, Long since, Long until) throws ApiException {
+        ApiResponse<CommitList> localVarResp = searchCommitsWithHttpInfo(repository, after, amount, metadata, committer, message, since, until);
+        return localVarResp.getData();
+    }
+
+    /**
+     * search commits of the repository by metadata, committer, message and creation date, latest first
+     * 
+     * @param repository  (required)
+     * @param after return items after this value (optional)
+     * @param amount how many items to return (optional, default to 100)
+     * @param metadata list of metadata key/value pairs in the form key&#x3D;value, commits must match all of them (optional)
+     * @param committer  (optional)
+     * @param message substring of the commit message, ignoring case (optional)
+     * @param since Unix Epoch in seconds, return commits created at or after it (optional)
+     * @param until Unix Epoch in seconds, return commits created before it (optional)
+     * @return ApiResponse&lt;CommitList&gt;
+     * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> commits </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public ApiResponse<CommitList> searchCommitsWithHttpInfo(String repository, String after, Integer amount, List<String> metadata, String committer, String message, Long since, Long until) throws ApiException {
+        okhttp3.Call localVarCall = searchCommitsValidateBeforeCall(repository, after, amount, metadata, committer, message, since, until, null);
+        Type localVarReturnType = new TypeToken<CommitList>(){}.getType();
+        return localVarApiClient.execute(localVarCall, localVarReturnType);
+    }
+
+    /**
+     * search commits of the repository by metadata, committer, message and creation date, latest first (asynchronously)
+     * 
+     * @param repository  (required)
+     * @param after return items after this value (optional)
+     * @param amount how many items to return (optional, default to 100)
+     * @param metadata list of metadata key/value pairs in the form key&#x3D;value, commits must match all of them (optional)
+     * @param committer  (optional)
+     * @param message substring of the commit message, ignoring case (optional)
+     * @param since Unix Epoch in seconds, return commits created at or after it (optional)
+     * @param until Unix Epoch in seconds, return commits created before it (optional)
+     * @param _callback The callback to be executed when the API call finishes
+     * @return The request call
+     * @throws ApiException If fail to process the API call, e.g. serializing the request body object
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> commits </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public okhttp3.Call searchCommitsAsync(String repository, String after, Integer amount, List<String> metadata, String committer, String message, Long since, Long until, final ApiCallback<CommitList> _callback) throws ApiException {
+
+        okhttp3.Call localVarCall = searchCommitsValidateBeforeCall(repository, after, amount, metadata, committer, message, since, until, _callback);
+        Type localVarReturnType = new TypeToken<CommitList>(){}.getType();
+        localVarApiClient.executeAsync(localVarCall, localVarReturnType, _callback);
+        return localVarCall;
+    }
+    /**
+     * Build call for signCommit
+     * @param repository  (required)
+     * @param commitId  (required)
+     * @param commitSignatureCreation  (required)
+     * @param _callback Callback for upload/download progress
+     * @return Call to execute
+     * @throws ApiException If fail to serialize the request body object
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 204 </td><td> signature stored </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public okhttp3.Call signCommitCall(String repository, String commitId, CommitSignatureCreation commitSignatureCreation, final ApiCallback _callback) throws ApiException {
+        Object localVarPostBody = commitSignatureCreation;
+
+        // create path and map variables
+        String localVarPath = "/repositories/{repository}/commits/{commitId}/signature"
+            .replaceAll("\\{" + "repository" + "\\}", localVarApiClient.escapeString(repository.toString()))
+            .replaceAll("\\{" + "commitId" + "\\}", localVarApiClient.escapeString(commitId.toString()));
+
+        List<Pair> localVarQueryParams = new ArrayList<Pair>();
+        List<Pair> localVarCollectionQueryParams = new ArrayList<Pair>();
+        Map<String, String> localVarHeaderParams = new HashMap<String, String>();
+        Map<String, String> localVarCookieParams = new HashMap<String, String>();
+        Map<String, Object> localVarFormParams = new HashMap<String, Object>();
+
+        final String[] localVarAccepts = {
+            "application/json"
+        };
+        final String localVarAccept = localVarApiClient.selectHeaderAccept(localVarAccepts);
+        if (localVarAccept != null) {
+            localVarHeaderParams.put("Accept", localVarAccept);
+        }
+
+        final String[] localVarContentTypes = {
+            "application/json"
+        };
+        final String localVarContentType = localVarApiClient.selectHeaderContentType(localVarContentTypes);
+        localVarHeaderParams.put("Content-Type", localVarContentType);
+
+        String[] localVarAuthNames = new String[] { "basic_auth", "cookie_auth", "jwt_token" };
+        return localVarApiClient.buildCall(localVarPath, "PUT", localVarQueryParams, localVarCollectionQueryParams, localVarPostBody, localVarHeaderParams, localVarCookieParams, localVarFormParams, localVarAuthNames, _callback);
+    }
+
+    @SuppressWarnings("rawtypes")
+    private okhttp3.Call signCommitValidateBeforeCall(String repository, String commitId, CommitSignatureCreation commitSignatureCreation, final ApiCallback _callback) throws ApiException {
+        
+        // verify the required parameter 'repository' is set
+        if (repository == null) {
+            throw new ApiException("Missing the required parameter 'repository' when calling signCommit(Async)");
+        }
+        
+        // verify the required parameter 'commitId' is set
+        if (commitId == null) {
+            throw new ApiException("Missing the required parameter 'commitId' when calling signCommit(Async)");
+        }
+        
+        // verify the required parameter 'commitSignatureCreation' is set
+        if (commitSignatureCreation == null) {
+            throw new ApiException("Missing the required parameter 'commitSignatureCreation' when calling signCommit(Async)");
+        }
+        
+
+        okhttp3.Call localVarCall = signCommitCall(repository, commitId, commitSignatureCreation, _callback);
+        return localVarCall;
+
+    }
+
+    /**
+     * store a signature of the commit made by a signing key of its committer
+     * 
+     * @param repository  (required)
+     * @param commitId  (required)
+     * @param commitSignatureCreation  (required)
+     * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 204 </td><td> signature stored </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public void signCommit(String repository, String commitId, CommitSignatureCreation commitSignatureCreation) throws ApiException {
+        signCommitWithHttpInfo(repository, commitId, commitSignatureCreation);
+    }
+
+    /**
+     * store a signature of the commit made by a signing key of its committer
+     * 
+     * @param repository  (required)
+     * @param commitId  (required)
+     * @param commitSignatureCreation  (required)
+     * @return ApiResponse&lt;Void&gt;
+     * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 204 </td><td> signature stored </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public ApiResponse<Void> signCommitWithHttpInfo(String repository, String commitId, CommitSignatureCreation commitSignatureCreation) throws ApiException {
+        okhttp3.Call localVarCall = signCommitValidateBeforeCall(repository, commitId, commitSignatureCreation, null);
+        return localVarApiClient.execute(localVarCall);
+    }
+
+    /**
+     * store a signature of the commit made by a signing key of its committer (asynchronously)
+     * 
+     * @param repository  (required)
+     * @param commitId  (required)
+     * @param commitSignatureCreation  (required)
+     * @param _callback The callback to be executed when the API call finishes
+     * @return The request call
+     * @throws ApiException If fail to process the API call, e.g. serializing the request body object
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 204 </td><td> signature stored </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public okhttp3.Call signCommitAsync(String repository, String commitId, CommitSignatureCreation commitSignatureCreation, final ApiCallback<Void> _callback) throws ApiException {
+
+        okhttp3.Call localVarCall = signCommitValidateBeforeCall(repository, commitId, commitSignatureCreation, _callback);
+        localVarApiClient.executeAsync(localVarCall, _callback);
+        return localVarCall;
+    }
 }
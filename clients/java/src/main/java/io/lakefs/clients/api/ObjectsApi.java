@@ -33,6 +33,7 @@ import io.lakefs.clients.api.model.ObjectErrorList;
 import io.lakefs.clients.api.model.ObjectStageCreation;
 import io.lakefs.clients.api.model.ObjectStats;
 import io.lakefs.clients.api.model.ObjectStatsList;
+import io.lakefs.clients.api.model.ObjectVersionList;
 import io.lakefs.clients.api.model.PathList;
 import io.lakefs.clients.api.model.UnderlyingObjectProperties;
 
@@ -823,6 +824,181 @@ public class ObjectsApi {
         localVarApiClient.executeAsync(localVarCall, localVarReturnType, _callback);
         return localVarCall;
     }
+    /**
+     * Build call for objectHistory
+     * @param repository  (required)
+     * @param ref a reference (could be either a branch or a commit ID) (required)
+     * @param path relative to the branch (required)
+     * @param firstParent follow only the first parent of merge commits (optional, default to false)
+     * @param after return items after this value (optional)
+     * @param amount how many items to return (optional, default to 100)
+     * @param _callback Callback for upload/download progress
+     * @return Call to execute
+     * @throws ApiException If fail to serialize the request body object
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> object versions </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public okhttp3.Call objectHistoryCall(String repository, String ref, String path, Boolean firstParent, String after, Integer amount, final ApiCallback _callback) throws ApiException {
+        Object localVarPostBody = null;
+
+        // create path and map variables
+        String localVarPath = "/repositories/{repository}/refs/{ref}/objects/history"
+            .replaceAll("\\{" + "repository" + "\\}", localVarApiClient.escapeString(repository.toString()))
+            .replaceAll("\\{" + "ref" + "\\}", localVarApiClient.escapeString(ref.toString()));
+
+        List<Pair> localVarQueryParams = new ArrayList<Pair>();
+        List<Pair> localVarCollectionQueryParams = new ArrayList<Pair>();
+        Map<String, String> localVarHeaderParams = new HashMap<String, String>();
+        Map<String, String> localVarCookieParams = new HashMap<String, String>();
+        Map<String, Object> localVarFormParams = new HashMap<String, Object>();
+
+        if (path != null) {
+            localVarQueryParams.addAll(localVarApiClient.parameterToPair("path", path));
+        }
+
+        if (firstParent != null) {
+            localVarQueryParams.addAll(localVarApiClient.parameterToPair("first_parent", firstParent));
+        }
+
+        if (after != null) {
+            localVarQueryParams.addAll(localVarApiClient.parameterToPair("after", after));
+        }
+
+        if (amount != null) {
+            localVarQueryParams.addAll(localVarApiClient.parameterToPair("amount", amount));
+        }
+
+        final String[] localVarAccepts = {
+            "application/json"
+        };
+        final String localVarAccept = localVarApiClient.selectHeaderAccept(localVarAccepts);
+        if (localVarAccept != null) {
+            localVarHeaderParams.put("Accept", localVarAccept);
+        }
+
+        final String[] localVarContentTypes = {
+            
+        };
+        final String localVarContentType = localVarApiClient.selectHeaderContentType(localVarContentTypes);
+        localVarHeaderParams.put("Content-Type", localVarContentType);
+
+        String[] localVarAuthNames = new String[] { "basic_auth", "cookie_auth", "jwt_token" };
+        return localVarApiClient.buildCall(localVarPath, "GET", localVarQueryParams, localVarCollectionQueryParams, localVarPostBody, localVarHeaderParams, localVarCookieParams, localVarFormParams, localVarAuthNames, _callback);
+    }
+
+    @SuppressWarnings("rawtypes")
+    private okhttp3.Call objectHistoryValidateBeforeCall(String repository, String ref, String path, Boolean firstParent, String after, Integer amount, final ApiCallback _callback) throws ApiException {
+        
+        // verify the required parameter 'repository' is set
+        if (repository == null) {
+            throw new ApiException("Missing the required parameter 'repository' when calling objectHistory(Async)");
+        }
+        
+        // verify the required parameter 'ref' is set
+        if (ref == null) {
+            throw new ApiException("Missing the required parameter 'ref' when calling objectHistory(Async)");
+        }
+        
+        // verify the required parameter 'path' is set
+        if (path == null) {
+            throw new ApiException("Missing the required parameter 'path' when calling objectHistory(Async)");
+        }
+        
+
+        okhttp3.Call localVarCall = objectHistoryCall(repository, ref, path, firstParent, after, amount, _callback);
+        return localVarCall;
+
+    }
+
+    /**
+     * list the versions of an object, latest first
+     * 
+     * @param repository  (required)
+     * @param ref a reference (could be either a branch or a commit ID) (required)
+     * @param path relative to the branch (required)
+     * @param firstParent follow only the first parent of merge commits (optional, default to false)
+     * @param after return items after this value (optional)
+     * @param amount how many items to return (optional, default to 100)
+     * @return ObjectVersionList
+     * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> object versions </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public ObjectVersionList objectHistory(String repository, String ref, String path, Boolean firstParent, String after, Integer amount) throws ApiException {
+        ApiResponse<ObjectVersionList> localVarResp = objectHistoryWithHttpInfo(repository, ref, path, firstParent, after, amount);
+        return localVarResp.getData();
+    }
+
+    /**
+     * list the versions of an object, latest first
+     * 
+     * @param repository  (required)
+     * @param ref a reference (could be either a branch or a commit ID) (required)
+     * @param path relative to the branch (required)
+     * @param firstParent follow only the first parent of merge commits (optional, default to false)
+     * @param after return items after this value (optional)
+     * @param amount how many items to return (optional, default to 100)
+     * @return ApiResponse&lt;ObjectVersionList&gt;
+     * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> object versions </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public ApiResponse<ObjectVersionList> objectHistoryWithHttpInfo(String repository, String ref, String path, Boolean firstParent, String after, Integer amount) throws ApiException {
+        okhttp3.Call localVarCall = objectHistoryValidateBeforeCall(repository, ref, path, firstParent, after, amount, null);
+        Type localVarReturnType = new TypeToken<ObjectVersionList>(){}.getType();
+        return localVarApiClient.execute(localVarCall, localVarReturnType);
+    }
+
+    /**
+     * list the versions of an object, latest first (asynchronously)
+     * 
+     * @param repository  (required)
+     * @param ref a reference (could be either a branch or a commit ID) (required)
+     * @param path relative to the branch (required)
+     * @param firstParent follow only the first parent of merge commits (optional, default to false)
+     * @param after return items after this value (optional)
+     * @param amount how many items to return (optional, default to 100)
+     * @param _callback The callback to be executed when the API call finishes
+     * @return The request call
+     * @throws ApiException If fail to process the API call, e.g. serializing the request body object
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> object versions </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public okhttp3.Call objectHistoryAsync(String repository, String ref, String path, Boolean firstParent, String after, Integer amount, final ApiCallback<ObjectVersionList> _callback) throws ApiException {
+
+        okhttp3.Call localVarCall = objectHistoryValidateBeforeCall(repository, ref, path, firstParent, after, amount, _callback);
+        Type localVarReturnType = new TypeToken<ObjectVersionList>(){}.getType();
+        localVarApiClient.executeAsync(localVarCall, localVarReturnType, _callback);
+        return localVarCall;
+    }
     /**
      * Build call for stageObject
      * @param repository  (required)
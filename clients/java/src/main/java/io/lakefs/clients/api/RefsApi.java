@@ -29,8 +29,10 @@ import java.io.IOException;
 
 import io.lakefs.clients.api.model.CommitList;
 import io.lakefs.clients.api.model.DiffList;
+import io.lakefs.clients.api.model.DiffSummaryList;
 import io.lakefs.clients.api.model.Error;
 import io.lakefs.clients.api.model.Merge;
+import io.lakefs.clients.api.model.MergePreview;
 import io.lakefs.clients.api.model.MergeResult;
 import io.lakefs.clients.api.model.RefsDump;
 
@@ -251,6 +253,170 @@ public class RefsApi {
         localVarApiClient.executeAsync(localVarCall, localVarReturnType, _callback);
         return localVarCall;
     }
+    /**
+     * Build call for diffSummary
+     * @param repository  (required)
+     * @param leftRef a reference (could be either a branch or a commit ID) (required)
+     * @param rightRef a reference (could be either a branch or a commit ID) to compare against (required)
+     * @param depth number of path elements of the prefixes changes are counted by, 0 counts all changes together (optional, default to 1)
+     * @param type  (optional, default to three_dot)
+     * @param _callback Callback for upload/download progress
+     * @return Call to execute
+     * @throws ApiException If fail to serialize the request body object
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> diff summary </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public okhttp3.Call diffSummaryCall(String repository, String leftRef, String rightRef, Integer depth, String type, final ApiCallback _callback) throws ApiException {
+        Object localVarPostBody = null;
+
+        // create path and map variables
+        String localVarPath = "/repositories/{repository}/refs/{leftRef}/diff/{rightRef}/summary"
+            .replaceAll("\\{" + "repository" + "\\}", localVarApiClient.escapeString(repository.toString()))
+            .replaceAll("\\{" + "leftRef" + "\\}", localVarApiClient.escapeString(leftRef.toString()))
+            .replaceAll("\\{" + "rightRef" + "\\}", localVarApiClient.escapeString(rightRef.toString()));
+
+        List<Pair> localVarQueryParams = new ArrayList<Pair>();
+        List<Pair> localVarCollectionQueryParams = new ArrayList<Pair>();
+        Map<String, String> localVarHeaderParams = new HashMap<String, String>();
+        Map<String, String> localVarCookieParams = new HashMap<String, String>();
+        Map<String, Object> localVarFormParams = new HashMap<String, Object>();
+
+        if (depth != null) {
+            localVarQueryParams.addAll(localVarApiClient.parameterToPair("depth", depth));
+        }
+
+        if (type != null) {
+            localVarQueryParams.addAll(localVarApiClient.parameterToPair("type", type));
+        }
+
+        final String[] localVarAccepts = {
+            "application/json"
+        };
+        final String localVarAccept = localVarApiClient.selectHeaderAccept(localVarAccepts);
+        if (localVarAccept != null) {
+            localVarHeaderParams.put("Accept", localVarAccept);
+        }
+
+        final String[] localVarContentTypes = {
+            
+        };
+        final String localVarContentType = localVarApiClient.selectHeaderContentType(localVarContentTypes);
+        localVarHeaderParams.put("Content-Type", localVarContentType);
+
+        String[] localVarAuthNames = new String[] { "basic_auth", "cookie_auth", "jwt_token" };
+        return localVarApiClient.buildCall(localVarPath, "GET", localVarQueryParams, localVarCollectionQueryParams, localVarPostBody, localVarHeaderParams, localVarCookieParams, localVarFormParams, localVarAuthNames, _callback);
+    }
+
+    @SuppressWarnings("rawtypes")
+    private okhttp3.Call diffSummaryValidateBeforeCall(String repository, String leftRef, String rightRef, Integer depth, String type, final ApiCallback _callback) throws ApiException {
+        
+        // verify the required parameter 'repository' is set
+        if (repository == null) {
+            throw new ApiException("Missing the required parameter 'repository' when calling diffSummary(Async)");
+        }
+        
+        // verify the required parameter 'leftRef' is set
+        if (leftRef == null) {
+            throw new ApiException("Missing the required parameter 'leftRef' when calling diffSummary(Async)");
+        }
+        
+        // verify the required parameter 'rightRef' is set
+        if (rightRef == null) {
+            throw new ApiException("Missing the required parameter 'rightRef' when calling diffSummary(Async)");
+        }
+        
+
+        okhttp3.Call localVarCall = diffSummaryCall(repository, leftRef, rightRef, depth, type, _callback);
+        return localVarCall;
+
+    }
+
+    /**
+     * count the changes between references by prefix
+     * Counts the objects added, removed and changed between the commits of the references, and their size, by path prefix. Uncommitted changes are not counted. 
+     * @param repository  (required)
+     * @param leftRef a reference (could be either a branch or a commit ID) (required)
+     * @param rightRef a reference (could be either a branch or a commit ID) to compare against (required)
+     * @param depth number of path elements of the prefixes changes are counted by, 0 counts all changes together (optional, default to 1)
+     * @param type  (optional, default to three_dot)
+     * @return DiffSummaryList
+     * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> diff summary </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public DiffSummaryList diffSummary(String repository, String leftRef, String rightRef, Integer depth, String type) throws ApiException {
+        ApiResponse<DiffSummaryList> localVarResp = diffSummaryWithHttpInfo(repository, leftRef, rightRef, depth, type);
+        return localVarResp.getData();
+    }
+
+    /**
+     * count the changes between references by prefix
+     * Counts the objects added, removed and changed between the commits of the references, and their size, by path prefix. Uncommitted changes are not counted. 
+     * @param repository  (required)
+     * @param leftRef a reference (could be either a branch or a commit ID) (required)
+     * @param rightRef a reference (could be either a branch or a commit ID) to compare against (required)
+     * @param depth number of path elements of the prefixes changes are counted by, 0 counts all changes together (optional, default to 1)
+     * @param type  (optional, default to three_dot)
+     * @return ApiResponse&lt;DiffSummaryList&gt;
+     * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> diff summary </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public ApiResponse<DiffSummaryList> diffSummaryWithHttpInfo(String repository, String leftRef, String rightRef, Integer depth, String type) throws ApiException {
+        okhttp3.Call localVarCall = diffSummaryValidateBeforeCall(repository, leftRef, rightRef, depth, type, null);
+        Type localVarReturnType = new TypeToken<DiffSummaryList>(){}.getType();
+        return localVarApiClient.execute(localVarCall, localVarReturnType);
+    }
+
+    /**
+     * count the changes between references by prefix (asynchronously)
+     * Counts the objects added, removed and changed between the commits of the references, and their size, by path prefix. Uncommitted changes are not counted. 
+     * @param repository  (required)
+     * @param leftRef a reference (could be either a branch or a commit ID) (required)
+     * @param rightRef a reference (could be either a branch or a commit ID) to compare against (required)
+     * @param depth number of path elements of the prefixes changes are counted by, 0 counts all changes together (optional, default to 1)
+     * @param type  (optional, default to three_dot)
+     * @param _callback The callback to be executed when the API call finishes
+     * @return The request call
+     * @throws ApiException If fail to process the API call, e.g. serializing the request body object
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> diff summary </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public okhttp3.Call diffSummaryAsync(String repository, String leftRef, String rightRef, Integer depth, String type, final ApiCallback<DiffSummaryList> _callback) throws ApiException {
+
+        okhttp3.Call localVarCall = diffSummaryValidateBeforeCall(repository, leftRef, rightRef, depth, type, _callback);
+        Type localVarReturnType = new TypeToken<DiffSummaryList>(){}.getType();
+        localVarApiClient.executeAsync(localVarCall, localVarReturnType, _callback);
+        return localVarCall;
+    }
     /**
      * Build call for dumpRefs
      * @param repository  (required)
@@ -705,6 +871,170 @@ public class RefsApi {
         localVarApiClient.executeAsync(localVarCall, localVarReturnType, _callback);
         return localVarCall;
     }
+    /**
+     * Build call for mergePreview
+     * @param repository  (required)
+     * @param sourceRef source ref (required)
+     * @param destinationBranch destination branch name (required)
+     * @param strategy merge strategy to preview, \&quot;dest-wins\&quot; or \&quot;source-wins\&quot; (optional)
+     * @param strategyRule merge strategy rules to preview, in order, each formatted as \&quot;&lt;pattern&gt;&#x3D;&lt;strategy&gt;\&quot; (optional)
+     * @param _callback Callback for upload/download progress
+     * @return Call to execute
+     * @throws ApiException If fail to serialize the request body object
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> merge preview </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public okhttp3.Call mergePreviewCall(String repository, String sourceRef, String destinationBranch, String strategy, List<String> strategyRule, final ApiCallback _callback) throws ApiException {
+        Object localVarPostBody = null;
+
+        // create path and map variables
+        String localVarPath = "/repositories/{repository}/refs/{sourceRef}/merge/{destinationBranch}/preview"
+            .replaceAll("\\{" + "repository" + "\\}", localVarApiClient.escapeString(repository.toString()))
+            .replaceAll("\\{" + "sourceRef" + "\\}", localVarApiClient.escapeString(sourceRef.toString()))
+            .replaceAll("\\{" + "destinationBranch" + "\\}", localVarApiClient.escapeString(destinationBranch.toString()));
+
+        List<Pair> localVarQueryParams = new ArrayList<Pair>();
+        List<Pair> localVarCollectionQueryParams = new ArrayList<Pair>();
+        Map<String, String> localVarHeaderParams = new HashMap<String, String>();
+        Map<String, String> localVarCookieParams = new HashMap<String, String>();
+        Map<String, Object> localVarFormParams = new HashMap<String, Object>();
+
+        if (strategy != null) {
+            localVarQueryParams.addAll(localVarApiClient.parameterToPair("strategy", strategy));
+        }
+
+        if (strategyRule != null) {
+            localVarCollectionQueryParams.addAll(localVarApiClient.parameterToPairs("multi", "strategy_rule", strategyRule));
+        }
+
+        final String[] localVarAccepts = {
+            "application/json"
+        };
+        final String localVarAccept = localVarApiClient.selectHeaderAccept(localVarAccepts);
+        if (localVarAccept != null) {
+            localVarHeaderParams.put("Accept", localVarAccept);
+        }
+
+        final String[] localVarContentTypes = {
+            
+        };
+        final String localVarContentType = localVarApiClient.selectHeaderContentType(localVarContentTypes);
+        localVarHeaderParams.put("Content-Type", localVarContentType);
+
+        String[] localVarAuthNames = new String[] { "basic_auth", "cookie_auth", "jwt_token" };
+        return localVarApiClient.buildCall(localVarPath, "GET", localVarQueryParams, localVarCollectionQueryParams, localVarPostBody, localVarHeaderParams, localVarCookieParams, localVarFormParams, localVarAuthNames, _callback);
+    }
+
+    @SuppressWarnings("rawtypes")
+    private okhttp3.Call mergePreviewValidateBeforeCall(String repository, String sourceRef, String destinationBranch, String strategy, List<String> strategyRule, final ApiCallback _callback) throws ApiException {
+        
+        // verify the required parameter 'repository' is set
+        if (repository == null) {
+            throw new ApiException("Missing the required parameter 'repository' when calling mergePreview(Async)");
+        }
+        
+        // verify the required parameter 'sourceRef' is set
+        if (sourceRef == null) {
+            throw new ApiException("Missing the required parameter 'sourceRef' when calling mergePreview(Async)");
+        }
+        
+        // verify the required parameter 'destinationBranch' is set
+        if (destinationBranch == null) {
+            throw new ApiException("Missing the required parameter 'destinationBranch' when calling mergePreview(Async)");
+        }
+        
+
+        okhttp3.Call localVarCall = mergePreviewCall(repository, sourceRef, destinationBranch, strategy, strategyRule, _callback);
+        return localVarCall;
+
+    }
+
+    /**
+     * preview the outcome of merging references, without merging
+     * 
+     * @param repository  (required)
+     * @param sourceRef source ref (required)
+     * @param destinationBranch destination branch name (required)
+     * @param strategy merge strategy to preview, \&quot;dest-wins\&quot; or \&quot;source-wins\&quot; (optional)
+     * @param strategyRule merge strategy rules to preview, in order, each formatted as \&quot;&lt;pattern&gt;&#x3D;&lt;strategy&gt;\&quot; (optional)
+     * @return MergePreview
+     * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> merge preview </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public MergePreview mergePreview(String repository, String sourceRef, String destinationBranch, String strategy, List<String> strategyRule) throws ApiException {
+        ApiResponse<MergePreview> localVarResp = mergePreviewWithHttpInfo(repository, sourceRef, destinationBranch, strategy, strategyRule);
+        return localVarResp.getData();
+    }
+
+    /**
+     * preview the outcome of merging references, without merging
+     * 
+     * @param repository  (required)
+     * @param sourceRef source ref (required)
+     * @param destinationBranch destination branch name (required)
+     * @param strategy merge strategy to preview, \&quot;dest-wins\&quot; or \&quot;source-wins\&quot; (optional)
+     * @param strategyRule merge strategy rules to preview, in order, each formatted as \&quot;&lt;pattern&gt;&#x3D;&lt;strategy&gt;\&quot; (optional)
+     * @return ApiResponse&lt;MergePreview&gt;
+     * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> merge preview </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public ApiResponse<MergePreview> mergePreviewWithHttpInfo(String repository, String sourceRef, String destinationBranch, String strategy, List<String> strategyRule) throws ApiException {
+        okhttp3.Call localVarCall = mergePreviewValidateBeforeCall(repository, sourceRef, destinationBranch, strategy, strategyRule, null);
+        Type localVarReturnType = new TypeToken<MergePreview>(){}.getType();
+        return localVarApiClient.execute(localVarCall, localVarReturnType);
+    }
+
+    /**
+     * preview the outcome of merging references, without merging (asynchronously)
+     * 
+     * @param repository  (required)
+     * @param sourceRef source ref (required)
+     * @param destinationBranch destination branch name (required)
+     * @param strategy merge strategy to preview, \&quot;dest-wins\&quot; or \&quot;source-wins\&quot; (optional)
+     * @param strategyRule merge strategy rules to preview, in order, each formatted as \&quot;&lt;pattern&gt;&#x3D;&lt;strategy&gt;\&quot; (optional)
+     * @param _callback The callback to be executed when the API call finishes
+     * @return The request call
+     * @throws ApiException If fail to process the API call, e.g. serializing the request body object
+     * @http.response.details
+     <table summary="Response Details" border="1">
+        <tr><td> Status Code </td><td> Description </td><td> Response Headers </td></tr>
+        <tr><td> 200 </td><td> merge preview </td><td>  -  </td></tr>
+        <tr><td> 400 </td><td> Validation Error </td><td>  -  </td></tr>
+        <tr><td> 401 </td><td> Unauthorized </td><td>  -  </td></tr>
+        <tr><td> 404 </td><td> Resource Not Found </td><td>  -  </td></tr>
+        <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
+     </table>
+     */
+    public okhttp3.Call mergePreviewAsync(String repository, String sourceRef, String destinationBranch, String strategy, List<String> strategyRule, final ApiCallback<MergePreview> _callback) throws ApiException {
+
+        okhttp3.Call localVarCall = mergePreviewValidateBeforeCall(repository, sourceRef, destinationBranch, strategy, strategyRule, _callback);
+        Type localVarReturnType = new TypeToken<MergePreview>(){}.getType();
+        localVarApiClient.executeAsync(localVarCall, localVarReturnType, _callback);
+        return localVarCall;
+    }
     /**
      * Build call for restoreRefs
      * @param repository  (required)
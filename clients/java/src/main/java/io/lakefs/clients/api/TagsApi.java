@@ -28,9 +28,9 @@ import java.io.IOException;
 
 
 import io.lakefs.clients.api.model.Error;
-import io.lakefs.clients.api.model.Ref;
-import io.lakefs.clients.api.model.RefList;
+import io.lakefs.clients.api.model.Tag;
 import io.lakefs.clients.api.model.TagCreation;
+import io.lakefs.clients.api.model.TagList;
 
 import java.lang.reflect.Type;
 import java.util.ArrayList;
@@ -130,7 +130,7 @@ public class TagsApi {
      * 
      * @param repository  (required)
      * @param tagCreation  (required)
-     * @return Ref
+     * @return Tag
      * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
      * @http.response.details
      <table summary="Response Details" border="1">
@@ -143,8 +143,8 @@ public class TagsApi {
         <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
      </table>
      */
-    public Ref createTag(String repository, TagCreation tagCreation) throws ApiException {
-        ApiResponse<Ref> localVarResp = createTagWithHttpInfo(repository, tagCreation);
+    public Tag createTag(String repository, TagCreation tagCreation) throws ApiException {
+        ApiResponse<Tag> localVarResp = createTagWithHttpInfo(repository, tagCreation);
         return localVarResp.getData();
     }
 
@@ -153,7 +153,7 @@ public class TagsApi {
      * 
      * @param repository  (required)
      * @param tagCreation  (required)
-     * @return ApiResponse&lt;Ref&gt;
+     * @return ApiResponse&lt;Tag&gt;
      * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
      * @http.response.details
      <table summary="Response Details" border="1">
@@ -166,9 +166,9 @@ public class TagsApi {
         <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
      </table>
      */
-    public ApiResponse<Ref> createTagWithHttpInfo(String repository, TagCreation tagCreation) throws ApiException {
+    public ApiResponse<Tag> createTagWithHttpInfo(String repository, TagCreation tagCreation) throws ApiException {
         okhttp3.Call localVarCall = createTagValidateBeforeCall(repository, tagCreation, null);
-        Type localVarReturnType = new TypeToken<Ref>(){}.getType();
+        Type localVarReturnType = new TypeToken<Tag>(){}.getType();
         return localVarApiClient.execute(localVarCall, localVarReturnType);
     }
 
@@ -191,10 +191,10 @@ public class TagsApi {
         <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
      </table>
      */
-    public okhttp3.Call createTagAsync(String repository, TagCreation tagCreation, final ApiCallback<Ref> _callback) throws ApiException {
+    public okhttp3.Call createTagAsync(String repository, TagCreation tagCreation, final ApiCallback<Tag> _callback) throws ApiException {
 
         okhttp3.Call localVarCall = createTagValidateBeforeCall(repository, tagCreation, _callback);
-        Type localVarReturnType = new TypeToken<Ref>(){}.getType();
+        Type localVarReturnType = new TypeToken<Tag>(){}.getType();
         localVarApiClient.executeAsync(localVarCall, localVarReturnType, _callback);
         return localVarCall;
     }
@@ -400,7 +400,7 @@ public class TagsApi {
      * 
      * @param repository  (required)
      * @param tag  (required)
-     * @return Ref
+     * @return Tag
      * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
      * @http.response.details
      <table summary="Response Details" border="1">
@@ -411,8 +411,8 @@ public class TagsApi {
         <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
      </table>
      */
-    public Ref getTag(String repository, String tag) throws ApiException {
-        ApiResponse<Ref> localVarResp = getTagWithHttpInfo(repository, tag);
+    public Tag getTag(String repository, String tag) throws ApiException {
+        ApiResponse<Tag> localVarResp = getTagWithHttpInfo(repository, tag);
         return localVarResp.getData();
     }
 
@@ -421,7 +421,7 @@ public class TagsApi {
      * 
      * @param repository  (required)
      * @param tag  (required)
-     * @return ApiResponse&lt;Ref&gt;
+     * @return ApiResponse&lt;Tag&gt;
      * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
      * @http.response.details
      <table summary="Response Details" border="1">
@@ -432,9 +432,9 @@ public class TagsApi {
         <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
      </table>
      */
-    public ApiResponse<Ref> getTagWithHttpInfo(String repository, String tag) throws ApiException {
+    public ApiResponse<Tag> getTagWithHttpInfo(String repository, String tag) throws ApiException {
         okhttp3.Call localVarCall = getTagValidateBeforeCall(repository, tag, null);
-        Type localVarReturnType = new TypeToken<Ref>(){}.getType();
+        Type localVarReturnType = new TypeToken<Tag>(){}.getType();
         return localVarApiClient.execute(localVarCall, localVarReturnType);
     }
 
@@ -455,10 +455,10 @@ public class TagsApi {
         <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
      </table>
      */
-    public okhttp3.Call getTagAsync(String repository, String tag, final ApiCallback<Ref> _callback) throws ApiException {
+    public okhttp3.Call getTagAsync(String repository, String tag, final ApiCallback<Tag> _callback) throws ApiException {
 
         okhttp3.Call localVarCall = getTagValidateBeforeCall(repository, tag, _callback);
-        Type localVarReturnType = new TypeToken<Ref>(){}.getType();
+        Type localVarReturnType = new TypeToken<Tag>(){}.getType();
         localVarApiClient.executeAsync(localVarCall, localVarReturnType, _callback);
         return localVarCall;
     }
@@ -544,7 +544,7 @@ public class TagsApi {
      * @param prefix return items prefixed with this value (optional)
      * @param after return items after this value (optional)
      * @param amount how many items to return (optional, default to 100)
-     * @return RefList
+     * @return TagList
      * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
      * @http.response.details
      <table summary="Response Details" border="1">
@@ -555,8 +555,8 @@ public class TagsApi {
         <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
      </table>
      */
-    public RefList listTags(String repository, String prefix, String after, Integer amount) throws ApiException {
-        ApiResponse<RefList> localVarResp = listTagsWithHttpInfo(repository, prefix, after, amount);
+    public TagList listTags(String repository, String prefix, String after, Integer amount) throws ApiException {
+        ApiResponse<TagList> localVarResp = listTagsWithHttpInfo(repository, prefix, after, amount);
         return localVarResp.getData();
     }
 
@@ -567,7 +567,7 @@ public class TagsApi {
      * @param prefix return items prefixed with this value (optional)
      * @param after return items after this value (optional)
      * @param amount how many items to return (optional, default to 100)
-     * @return ApiResponse&lt;RefList&gt;
+     * @return ApiResponse&lt;TagList&gt;
      * @throws ApiException If fail to call the API, e.g. server error or cannot deserialize the response body
      * @http.response.details
      <table summary="Response Details" border="1">
@@ -578,9 +578,9 @@ public class TagsApi {
         <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
      </table>
      */
-    public ApiResponse<RefList> listTagsWithHttpInfo(String repository, String prefix, String after, Integer amount) throws ApiException {
+    public ApiResponse<TagList> listTagsWithHttpInfo(String repository, String prefix, String after, Integer amount) throws ApiException {
         okhttp3.Call localVarCall = listTagsValidateBeforeCall(repository, prefix, after, amount, null);
-        Type localVarReturnType = new TypeToken<RefList>(){}.getType();
+        Type localVarReturnType = new TypeToken<TagList>(){}.getType();
         return localVarApiClient.execute(localVarCall, localVarReturnType);
     }
 
@@ -603,10 +603,10 @@ public class TagsApi {
         <tr><td> 0 </td><td> Internal Server Error </td><td>  -  </td></tr>
      </table>
      */
-    public okhttp3.Call listTagsAsync(String repository, String prefix, String after, Integer amount, final ApiCallback<RefList> _callback) throws ApiException {
+    public okhttp3.Call listTagsAsync(String repository, String prefix, String after, Integer amount, final ApiCallback<TagList> _callback) throws ApiException {
 
         okhttp3.Call localVarCall = listTagsValidateBeforeCall(repository, prefix, after, amount, _callback);
-        Type localVarReturnType = new TypeToken<RefList>(){}.getType();
+        Type localVarReturnType = new TypeToken<TagList>(){}.getType();
         localVarApiClient.executeAsync(localVarCall, localVarReturnType, _callback);
         return localVarCall;
     }
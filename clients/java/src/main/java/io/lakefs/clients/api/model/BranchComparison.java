@@ -0,0 +1,330 @@
+/*
+ * lakeFS API
+ * lakeFS HTTP API
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+package io.lakefs.clients.api.model;
+
+import java.util.Objects;
+import java.util.Arrays;
+import com.google.gson.TypeAdapter;
+import com.google.gson.annotations.JsonAdapter;
+import com.google.gson.annotations.SerializedName;
+import com.google.gson.stream.JsonReader;
+import com.google.gson.stream.JsonWriter;
+import io.swagger.annotations.ApiModel;
+import io.swagger.annotations.ApiModelProperty;
+import java.io.IOException;
+
+/**
+ * BranchComparison
+ */
+@javax.annotation.Generated(value = "org.openapitools.codegen.languages.JavaClientCodegen")
+public class BranchComparison {
+  public static final String SERIALIZED_NAME_BRANCH = "branch";
+  @SerializedName(SERIALIZED_NAME_BRANCH)
+  private String branch;
+
+  public static final String SERIALIZED_NAME_COMMIT_ID = "commit_id";
+  @SerializedName(SERIALIZED_NAME_COMMIT_ID)
+  private String commitId;
+
+  public static final String SERIALIZED_NAME_BASE_COMMIT_ID = "base_commit_id";
+  @SerializedName(SERIALIZED_NAME_BASE_COMMIT_ID)
+  private String baseCommitId;
+
+  public static final String SERIALIZED_NAME_MERGE_BASE_ID = "merge_base_id";
+  @SerializedName(SERIALIZED_NAME_MERGE_BASE_ID)
+  private String mergeBaseId;
+
+  public static final String SERIALIZED_NAME_AHEAD = "ahead";
+  @SerializedName(SERIALIZED_NAME_AHEAD)
+  private Integer ahead;
+
+  public static final String SERIALIZED_NAME_BEHIND = "behind";
+  @SerializedName(SERIALIZED_NAME_BEHIND)
+  private Integer behind;
+
+  public static final String SERIALIZED_NAME_ADDED = "added";
+  @SerializedName(SERIALIZED_NAME_ADDED)
+  private Integer added;
+
+  public static final String SERIALIZED_NAME_REMOVED = "removed";
+  @SerializedName(SERIALIZED_NAME_REMOVED)
+  private Integer removed;
+
+  public static final String SERIALIZED_NAME_CHANGED = "changed";
+  @SerializedName(SERIALIZED_NAME_CHANGED)
+  private Integer changed;
+
+
+  public BranchComparison branch(String branch) {
+    
+    this.branch = branch;
+    return this;
+  }
+
+   /**
+   * Get branch
+   * @return branch
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "")
+
+  public String getBranch() {
+    return branch;
+  }
+
+
+  public void setBranch(String branch) {
+    this.branch = branch;
+  }
+
+
+  public BranchComparison commitId(String commitId) {
+    
+    this.commitId = commitId;
+    return this;
+  }
+
+   /**
+   * Get commitId
+   * @return commitId
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "")
+
+  public String getCommitId() {
+    return commitId;
+  }
+
+
+  public void setCommitId(String commitId) {
+    this.commitId = commitId;
+  }
+
+
+  public BranchComparison baseCommitId(String baseCommitId) {
+    
+    this.baseCommitId = baseCommitId;
+    return this;
+  }
+
+   /**
+   * Get baseCommitId
+   * @return baseCommitId
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "")
+
+  public String getBaseCommitId() {
+    return baseCommitId;
+  }
+
+
+  public void setBaseCommitId(String baseCommitId) {
+    this.baseCommitId = baseCommitId;
+  }
+
+
+  public BranchComparison mergeBaseId(String mergeBaseId) {
+    
+    this.mergeBaseId = mergeBaseId;
+    return this;
+  }
+
+   /**
+   * best common ancestor of the branch and the base, not set when they share no history
+   * @return mergeBaseId
+  **/
+  @javax.annotation.Nullable
+  @ApiModelProperty(value = "best common ancestor of the branch and the base, not set when they share no history")
+
+  public String getMergeBaseId() {
+    return mergeBaseId;
+  }
+
+
+  public void setMergeBaseId(String mergeBaseId) {
+    this.mergeBaseId = mergeBaseId;
+  }
+
+
+  public BranchComparison ahead(Integer ahead) {
+    
+    this.ahead = ahead;
+    return this;
+  }
+
+   /**
+   * number of commits of the branch that are not in the base
+   * @return ahead
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "number of commits of the branch that are not in the base")
+
+  public Integer getAhead() {
+    return ahead;
+  }
+
+
+  public void setAhead(Integer ahead) {
+    this.ahead = ahead;
+  }
+
+
+  public BranchComparison behind(Integer behind) {
+    
+    this.behind = behind;
+    return this;
+  }
+
+   /**
+   * number of commits of the base that are not in the branch
+   * @return behind
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "number of commits of the base that are not in the branch")
+
+  public Integer getBehind() {
+    return behind;
+  }
+
+
+  public void setBehind(Integer behind) {
+    this.behind = behind;
+  }
+
+
+  public BranchComparison added(Integer added) {
+    
+    this.added = added;
+    return this;
+  }
+
+   /**
+   * number of objects added on the branch since the merge base
+   * @return added
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "number of objects added on the branch since the merge base")
+
+  public Integer getAdded() {
+    return added;
+  }
+
+
+  public void setAdded(Integer added) {
+    this.added = added;
+  }
+
+
+  public BranchComparison removed(Integer removed) {
+    
+    this.removed = removed;
+    return this;
+  }
+
+   /**
+   * number of objects removed on the branch since the merge base
+   * @return removed
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "number of objects removed on the branch since the merge base")
+
+  public Integer getRemoved() {
+    return removed;
+  }
+
+
+  public void setRemoved(Integer removed) {
+    this.removed = removed;
+  }
+
+
+  public BranchComparison changed(Integer changed) {
+    
+    this.changed = changed;
+    return this;
+  }
+
+   /**
+   * number of objects changed on the branch since the merge base
+   * @return changed
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "number of objects changed on the branch since the merge base")
+
+  public Integer getChanged() {
+    return changed;
+  }
+
+
+  public void setChanged(Integer changed) {
+    this.changed = changed;
+  }
+
+
+  @Override
+  public boolean equals(Object o) {
+    if (this == o) {
+      return true;
+    }
+    if (o == null || getClass() != o.getClass()) {
+      return false;
+    }
+    BranchComparison branchComparison = (BranchComparison) o;
+    return Objects.equals(this.branch, branchComparison.branch) &&
+        Objects.equals(this.commitId, branchComparison.commitId) &&
+        Objects.equals(this.baseCommitId, branchComparison.baseCommitId) &&
+        Objects.equals(this.mergeBaseId, branchComparison.mergeBaseId) &&
+        Objects.equals(this.ahead, branchComparison.ahead) &&
+        Objects.equals(this.behind, branchComparison.behind) &&
+        Objects.equals(this.added, branchComparison.added) &&
+        Objects.equals(this.removed, branchComparison.removed) &&
+        Objects.equals(this.changed, branchComparison.changed);
+  }
+
+  @Override
+  public int hashCode() {
+    return Objects.hash(branch, commitId, baseCommitId, mergeBaseId, ahead, behind, added, removed, changed);
+  }
+
+  @Override
+  public String toString() {
+    StringBuilder sb = new StringBuilder();
+    sb.append("class BranchComparison {\n");
+    sb.append("    branch: ").append(toIndentedString(branch)).append("\n");
+    sb.append("    commitId: ").append(toIndentedString(commitId)).append("\n");
+    sb.append("    baseCommitId: ").append(toIndentedString(baseCommitId)).append("\n");
+    sb.append("    mergeBaseId: ").append(toIndentedString(mergeBaseId)).append("\n");
+    sb.append("    ahead: ").append(toIndentedString(ahead)).append("\n");
+    sb.append("    behind: ").append(toIndentedString(behind)).append("\n");
+    sb.append("    added: ").append(toIndentedString(added)).append("\n");
+    sb.append("    removed: ").append(toIndentedString(removed)).append("\n");
+    sb.append("    changed: ").append(toIndentedString(changed)).append("\n");
+    sb.append("}");
+    return sb.toString();
+  }
+
+  /**
+   * Convert the given object to string with each line indented by 4 spaces
+   * (except the first line).
+   */
+  private String toIndentedString(Object o) {
+    if (o == null) {
+      return "null";
+    }
+    return o.toString().replace("\n", "\n    ");
+  }
+
+}
+
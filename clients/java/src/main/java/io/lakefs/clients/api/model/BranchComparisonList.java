@@ -0,0 +1,136 @@
+/*
+ * lakeFS API
+ * lakeFS HTTP API
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+package io.lakefs.clients.api.model;
+
+import java.util.Objects;
+import java.util.Arrays;
+import com.google.gson.TypeAdapter;
+import com.google.gson.annotations.JsonAdapter;
+import com.google.gson.annotations.SerializedName;
+import com.google.gson.stream.JsonReader;
+import com.google.gson.stream.JsonWriter;
+import io.lakefs.clients.api.model.BranchComparison;
+import io.lakefs.clients.api.model.Pagination;
+import io.swagger.annotations.ApiModel;
+import io.swagger.annotations.ApiModelProperty;
+import java.io.IOException;
+import java.util.ArrayList;
+import java.util.List;
+
+/**
+ * BranchComparisonList
+ */
+@javax.annotation.Generated(value = "org.openapitools.codegen.languages.JavaClientCodegen")
+public class BranchComparisonList {
+  public static final String SERIALIZED_NAME_PAGINATION = "pagination";
+  @SerializedName(SERIALIZED_NAME_PAGINATION)
+  private Pagination pagination;
+
+  public static final String SERIALIZED_NAME_RESULTS = "results";
+  @SerializedName(SERIALIZED_NAME_RESULTS)
+  private List<BranchComparison> results = new ArrayList<BranchComparison>();
+
+
+  public BranchComparisonList pagination(Pagination pagination) {
+    
+    this.pagination = pagination;
+    return this;
+  }
+
+   /**
+   * Get pagination
+   * @return pagination
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "")
+
+  public Pagination getPagination() {
+    return pagination;
+  }
+
+
+  public void setPagination(Pagination pagination) {
+    this.pagination = pagination;
+  }
+
+
+  public BranchComparisonList results(List<BranchComparison> results) {
+    
+    this.results = results;
+    return this;
+  }
+
+  public BranchComparisonList addResultsItem(BranchComparison resultsItem) {
+    this.results.add(resultsItem);
+    return this;
+  }
+
+   /**
+   * Get results
+   * @return results
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "")
+
+  public List<BranchComparison> getResults() {
+    return results;
+  }
+
+
+  public void setResults(List<BranchComparison> results) {
+    this.results = results;
+  }
+
+
+  @Override
+  public boolean equals(Object o) {
+    if (this == o) {
+      return true;
+    }
+    if (o == null || getClass() != o.getClass()) {
+      return false;
+    }
+    BranchComparisonList branchComparisonList = (BranchComparisonList) o;
+    return Objects.equals(this.pagination, branchComparisonList.pagination) &&
+        Objects.equals(this.results, branchComparisonList.results);
+  }
+
+  @Override
+  public int hashCode() {
+    return Objects.hash(pagination, results);
+  }
+
+  @Override
+  public String toString() {
+    StringBuilder sb = new StringBuilder();
+    sb.append("class BranchComparisonList {\n");
+    sb.append("    pagination: ").append(toIndentedString(pagination)).append("\n");
+    sb.append("    results: ").append(toIndentedString(results)).append("\n");
+    sb.append("}");
+    return sb.toString();
+  }
+
+  /**
+   * Convert the given object to string with each line indented by 4 spaces
+   * (except the first line).
+   */
+  private String toIndentedString(Object o) {
+    if (o == null) {
+      return "null";
+    }
+    return o.toString().replace("\n", "\n    ");
+  }
+
+}
+
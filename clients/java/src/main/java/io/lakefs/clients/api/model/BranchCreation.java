@@ -37,6 +37,14 @@ public class BranchCreation {
   @SerializedName(SERIALIZED_NAME_SOURCE)
   private String source;
 
+  public static final String SERIALIZED_NAME_EXPIRES_AT = "expires_at";
+  @SerializedName(SERIALIZED_NAME_EXPIRES_AT)
+  private Long expiresAt;
+
+  public static final String SERIALIZED_NAME_IDLE_TTL = "idle_ttl";
+  @SerializedName(SERIALIZED_NAME_IDLE_TTL)
+  private Long idleTtl;
+
 
   public BranchCreation name(String name) {
     
@@ -84,6 +92,53 @@ public class BranchCreation {
   }
 
 
+  public BranchCreation expiresAt(Long expiresAt) {
+    
+    this.expiresAt = expiresAt;
+    return this;
+  }
+
+   /**
+   * Unix Epoch in seconds at which the branch expires and is deleted
+   * @return expiresAt
+  **/
+  @javax.annotation.Nullable
+  @ApiModelProperty(value = "Unix Epoch in seconds at which the branch expires and is deleted")
+
+  public Long getExpiresAt() {
+    return expiresAt;
+  }
+
+
+  public void setExpiresAt(Long expiresAt) {
+    this.expiresAt = expiresAt;
+  }
+
+
+  public BranchCreation idleTtl(Long idleTtl) {
+    
+    this.idleTtl = idleTtl;
+    return this;
+  }
+
+   /**
+   * period in seconds after the latest change of the branch head at which the branch expires and is deleted
+   * minimum: 1
+   * @return idleTtl
+  **/
+  @javax.annotation.Nullable
+  @ApiModelProperty(value = "period in seconds after the latest change of the branch head at which the branch expires and is deleted")
+
+  public Long getIdleTtl() {
+    return idleTtl;
+  }
+
+
+  public void setIdleTtl(Long idleTtl) {
+    this.idleTtl = idleTtl;
+  }
+
+
   @Override
   public boolean equals(Object o) {
     if (this == o) {
@@ -94,12 +149,14 @@ public class BranchCreation {
     }
     BranchCreation branchCreation = (BranchCreation) o;
     return Objects.equals(this.name, branchCreation.name) &&
-        Objects.equals(this.source, branchCreation.source);
+        Objects.equals(this.source, branchCreation.source) &&
+        Objects.equals(this.expiresAt, branchCreation.expiresAt) &&
+        Objects.equals(this.idleTtl, branchCreation.idleTtl);
   }
 
   @Override
   public int hashCode() {
-    return Objects.hash(name, source);
+    return Objects.hash(name, source, expiresAt, idleTtl);
   }
 
   @Override
@@ -108,6 +165,8 @@ public class BranchCreation {
     sb.append("class BranchCreation {\n");
     sb.append("    name: ").append(toIndentedString(name)).append("\n");
     sb.append("    source: ").append(toIndentedString(source)).append("\n");
+    sb.append("    expiresAt: ").append(toIndentedString(expiresAt)).append("\n");
+    sb.append("    idleTtl: ").append(toIndentedString(idleTtl)).append("\n");
     sb.append("}");
     return sb.toString();
   }
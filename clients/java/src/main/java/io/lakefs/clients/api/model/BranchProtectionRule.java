@@ -23,6 +23,8 @@ import com.google.gson.stream.JsonWriter;
 import io.swagger.annotations.ApiModel;
 import io.swagger.annotations.ApiModelProperty;
 import java.io.IOException;
+import java.util.ArrayList;
+import java.util.List;
 
 /**
  * BranchProtectionRule
@@ -33,6 +35,75 @@ public class BranchProtectionRule {
   @SerializedName(SERIALIZED_NAME_PATTERN)
   private String pattern;
 
+  /**
+   * Gets or Sets blockedActions
+   */
+  @JsonAdapter(BlockedActionsEnum.Adapter.class)
+  public enum BlockedActionsEnum {
+    STAGING_WRITE("staging_write"),
+    
+    COMMIT("commit"),
+    
+    DELETE("delete"),
+    
+    RESET("reset"),
+    
+    REVERT("revert"),
+    
+    UPDATE_POINTER("update_pointer"),
+    
+    MERGE("merge");
+
+    private String value;
+
+    BlockedActionsEnum(String value) {
+      this.value = value;
+    }
+
+    public String getValue() {
+      return value;
+    }
+
+    @Override
+    public String toString() {
+      return String.valueOf(value);
+    }
+
+    public static BlockedActionsEnum fromValue(String value) {
+      for (BlockedActionsEnum b : BlockedActionsEnum.values()) {
+        if (b.value.equals(value)) {
+          return b;
+        }
+      }
+      throw new IllegalArgumentException("Unexpected value '" + value + "'");
+    }
+
+    public static class Adapter extends TypeAdapter<BlockedActionsEnum> {
+      @Override
+      public void write(final JsonWriter jsonWriter, final BlockedActionsEnum enumeration) throws IOException {
+        jsonWriter.value(enumeration.getValue());
+      }
+
+      @Override
+      public BlockedActionsEnum read(final JsonReader jsonReader) throws IOException {
+        String value =  jsonReader.nextString();
+        return BlockedActionsEnum.fromValue(value);
+      }
+    }
+  }
+
+  public static final String SERIALIZED_NAME_BLOCKED_ACTIONS = "blocked_actions";
+  @SerializedName(SERIALIZED_NAME_BLOCKED_ACTIONS)
+  private List<BlockedActionsEnum> blockedActions = null;
+
+  public static final String SERIALIZED_NAME_MERGE_SOURCE_PATTERN = "merge_source_pattern";
+  @SerializedName(SERIALIZED_NAME_MERGE_SOURCE_PATTERN)
+  private String mergeSourcePattern;
+
+  public static final String SERIALIZED_NAME_REQUIRE_SIGNED_COMMITS = "require_signed_commits";
+  @SerializedName(SERIALIZED_NAME_REQUIRE_SIGNED_COMMITS)
+  private Boolean requireSignedCommits;
+
 
   public BranchProtectionRule pattern(String pattern) {
     
@@ -57,6 +128,83 @@ public class BranchProtectionRule {
   }
 
 
+  public BranchProtectionRule blockedActions(List<BlockedActionsEnum> blockedActions) {
+    
+    this.blockedActions = blockedActions;
+    return this;
+  }
+
+  public BranchProtectionRule addBlockedActionsItem(BlockedActionsEnum blockedActionsItem) {
+    if (this.blockedActions == null) {
+      this.blockedActions = new ArrayList<BlockedActionsEnum>();
+    }
+    this.blockedActions.add(blockedActionsItem);
+    return this;
+  }
+
+   /**
+   * actions blocked on matching branches, defaults to staging_write and commit
+   * @return blockedActions
+  **/
+  @javax.annotation.Nullable
+  @ApiModelProperty(value = "actions blocked on matching branches, defaults to staging_write and commit")
+
+  public List<BlockedActionsEnum> getBlockedActions() {
+    return blockedActions;
+  }
+
+
+  public void setBlockedActions(List<BlockedActionsEnum> blockedActions) {
+    this.blockedActions = blockedActions;
+  }
+
+
+  public BranchProtectionRule mergeSourcePattern(String mergeSourcePattern) {
+    
+    this.mergeSourcePattern = mergeSourcePattern;
+    return this;
+  }
+
+   /**
+   * fnmatch pattern, when set merges into matching branches are allowed only from source branches matching it
+   * @return mergeSourcePattern
+  **/
+  @javax.annotation.Nullable
+  @ApiModelProperty(example = "release_*", value = "fnmatch pattern, when set merges into matching branches are allowed only from source branches matching it")
+
+  public String getMergeSourcePattern() {
+    return mergeSourcePattern;
+  }
+
+
+  public void setMergeSourcePattern(String mergeSourcePattern) {
+    this.mergeSourcePattern = mergeSourcePattern;
+  }
+
+
+  public BranchProtectionRule requireSignedCommits(Boolean requireSignedCommits) {
+    
+    this.requireSignedCommits = requireSignedCommits;
+    return this;
+  }
+
+   /**
+   * when set matching branches may point only to commits with a verified signature
+   * @return requireSignedCommits
+  **/
+  @javax.annotation.Nullable
+  @ApiModelProperty(value = "when set matching branches may point only to commits with a verified signature")
+
+  public Boolean getRequireSignedCommits() {
+    return requireSignedCommits;
+  }
+
+
+  public void setRequireSignedCommits(Boolean requireSignedCommits) {
+    this.requireSignedCommits = requireSignedCommits;
+  }
+
+
   @Override
   public boolean equals(Object o) {
     if (this == o) {
@@ -66,12 +214,15 @@ public class BranchProtectionRule {
       return false;
     }
     BranchProtectionRule branchProtectionRule = (BranchProtectionRule) o;
-    return Objects.equals(this.pattern, branchProtectionRule.pattern);
+    return Objects.equals(this.pattern, branchProtectionRule.pattern) &&
+        Objects.equals(this.blockedActions, branchProtectionRule.blockedActions) &&
+        Objects.equals(this.mergeSourcePattern, branchProtectionRule.mergeSourcePattern) &&
+        Objects.equals(this.requireSignedCommits, branchProtectionRule.requireSignedCommits);
   }
 
   @Override
   public int hashCode() {
-    return Objects.hash(pattern);
+    return Objects.hash(pattern, blockedActions, mergeSourcePattern, requireSignedCommits);
   }
 
   @Override
@@ -79,6 +230,9 @@ public class BranchProtectionRule {
     StringBuilder sb = new StringBuilder();
     sb.append("class BranchProtectionRule {\n");
     sb.append("    pattern: ").append(toIndentedString(pattern)).append("\n");
+    sb.append("    blockedActions: ").append(toIndentedString(blockedActions)).append("\n");
+    sb.append("    mergeSourcePattern: ").append(toIndentedString(mergeSourcePattern)).append("\n");
+    sb.append("    requireSignedCommits: ").append(toIndentedString(requireSignedCommits)).append("\n");
     sb.append("}");
     return sb.toString();
   }
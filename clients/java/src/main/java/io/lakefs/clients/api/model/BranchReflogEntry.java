@@ -0,0 +1,306 @@
+/*
+ * lakeFS API
+ * lakeFS HTTP API
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+package io.lakefs.clients.api.model;
+
+import java.util.Objects;
+import java.util.Arrays;
+import com.google.gson.TypeAdapter;
+import com.google.gson.annotations.JsonAdapter;
+import com.google.gson.annotations.SerializedName;
+import com.google.gson.stream.JsonReader;
+import com.google.gson.stream.JsonWriter;
+import io.swagger.annotations.ApiModel;
+import io.swagger.annotations.ApiModelProperty;
+import java.io.IOException;
+
+/**
+ * BranchReflogEntry
+ */
+@javax.annotation.Generated(value = "org.openapitools.codegen.languages.JavaClientCodegen")
+public class BranchReflogEntry {
+  public static final String SERIALIZED_NAME_INDEX = "index";
+  @SerializedName(SERIALIZED_NAME_INDEX)
+  private Integer index;
+
+  public static final String SERIALIZED_NAME_OLD_COMMIT_ID = "old_commit_id";
+  @SerializedName(SERIALIZED_NAME_OLD_COMMIT_ID)
+  private String oldCommitId;
+
+  public static final String SERIALIZED_NAME_NEW_COMMIT_ID = "new_commit_id";
+  @SerializedName(SERIALIZED_NAME_NEW_COMMIT_ID)
+  private String newCommitId;
+
+  /**
+   * Gets or Sets operation
+   */
+  @JsonAdapter(OperationEnum.Adapter.class)
+  public enum OperationEnum {
+    CREATE("create"),
+    
+    DELETE("delete"),
+    
+    UPDATE("update"),
+    
+    COMMIT("commit"),
+    
+    MERGE("merge"),
+    
+    REVERT("revert"),
+    
+    CHERRY_PICK("cherry-pick"),
+    
+    REBASE("rebase"),
+    
+    RESTORE("restore"),
+    
+    LOAD("load");
+
+    private String value;
+
+    OperationEnum(String value) {
+      this.value = value;
+    }
+
+    public String getValue() {
+      return value;
+    }
+
+    @Override
+    public String toString() {
+      return String.valueOf(value);
+    }
+
+    public static OperationEnum fromValue(String value) {
+      for (OperationEnum b : OperationEnum.values()) {
+        if (b.value.equals(value)) {
+          return b;
+        }
+      }
+      throw new IllegalArgumentException("Unexpected value '" + value + "'");
+    }
+
+    public static class Adapter extends TypeAdapter<OperationEnum> {
+      @Override
+      public void write(final JsonWriter jsonWriter, final OperationEnum enumeration) throws IOException {
+        jsonWriter.value(enumeration.getValue());
+      }
+
+      @Override
+      public OperationEnum read(final JsonReader jsonReader) throws IOException {
+        String value =  jsonReader.nextString();
+        return OperationEnum.fromValue(value);
+      }
+    }
+  }
+
+  public static final String SERIALIZED_NAME_OPERATION = "operation";
+  @SerializedName(SERIALIZED_NAME_OPERATION)
+  private OperationEnum operation;
+
+  public static final String SERIALIZED_NAME_USER = "user";
+  @SerializedName(SERIALIZED_NAME_USER)
+  private String user;
+
+  public static final String SERIALIZED_NAME_CREATION_DATE = "creation_date";
+  @SerializedName(SERIALIZED_NAME_CREATION_DATE)
+  private Long creationDate;
+
+
+  public BranchReflogEntry index(Integer index) {
+    
+    this.index = index;
+    return this;
+  }
+
+   /**
+   * position of the entry in the branch reflog, the latest entry is at index 0
+   * @return index
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "position of the entry in the branch reflog, the latest entry is at index 0")
+
+  public Integer getIndex() {
+    return index;
+  }
+
+
+  public void setIndex(Integer index) {
+    this.index = index;
+  }
+
+
+  public BranchReflogEntry oldCommitId(String oldCommitId) {
+    
+    this.oldCommitId = oldCommitId;
+    return this;
+  }
+
+   /**
+   * the commit the branch pointed to before the change, empty when the change created the branch
+   * @return oldCommitId
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "the commit the branch pointed to before the change, empty when the change created the branch")
+
+  public String getOldCommitId() {
+    return oldCommitId;
+  }
+
+
+  public void setOldCommitId(String oldCommitId) {
+    this.oldCommitId = oldCommitId;
+  }
+
+
+  public BranchReflogEntry newCommitId(String newCommitId) {
+    
+    this.newCommitId = newCommitId;
+    return this;
+  }
+
+   /**
+   * the commit the branch pointed to after the change, empty when the change deleted the branch
+   * @return newCommitId
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "the commit the branch pointed to after the change, empty when the change deleted the branch")
+
+  public String getNewCommitId() {
+    return newCommitId;
+  }
+
+
+  public void setNewCommitId(String newCommitId) {
+    this.newCommitId = newCommitId;
+  }
+
+
+  public BranchReflogEntry operation(OperationEnum operation) {
+    
+    this.operation = operation;
+    return this;
+  }
+
+   /**
+   * the operation that changed the branch head
+   * @return operation
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "the operation that changed the branch head")
+
+  public OperationEnum getOperation() {
+    return operation;
+  }
+
+
+  public void setOperation(OperationEnum operation) {
+    this.operation = operation;
+  }
+
+
+  public BranchReflogEntry user(String user) {
+    
+    this.user = user;
+    return this;
+  }
+
+   /**
+   * the user that changed the branch head, empty when unknown
+   * @return user
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "the user that changed the branch head, empty when unknown")
+
+  public String getUser() {
+    return user;
+  }
+
+
+  public void setUser(String user) {
+    this.user = user;
+  }
+
+
+  public BranchReflogEntry creationDate(Long creationDate) {
+    
+    this.creationDate = creationDate;
+    return this;
+  }
+
+   /**
+   * Get creationDate
+   * @return creationDate
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "")
+
+  public Long getCreationDate() {
+    return creationDate;
+  }
+
+
+  public void setCreationDate(Long creationDate) {
+    this.creationDate = creationDate;
+  }
+
+
+  @Override
+  public boolean equals(Object o) {
+    if (this == o) {
+      return true;
+    }
+    if (o == null || getClass() != o.getClass()) {
+      return false;
+    }
+    BranchReflogEntry branchReflogEntry = (BranchReflogEntry) o;
+    return Objects.equals(this.index, branchReflogEntry.index) &&
+        Objects.equals(this.oldCommitId, branchReflogEntry.oldCommitId) &&
+        Objects.equals(this.newCommitId, branchReflogEntry.newCommitId) &&
+        Objects.equals(this.operation, branchReflogEntry.operation) &&
+        Objects.equals(this.user, branchReflogEntry.user) &&
+        Objects.equals(this.creationDate, branchReflogEntry.creationDate);
+  }
+
+  @Override
+  public int hashCode() {
+    return Objects.hash(index, oldCommitId, newCommitId, operation, user, creationDate);
+  }
+
+  @Override
+  public String toString() {
+    StringBuilder sb = new StringBuilder();
+    sb.append("class BranchReflogEntry {\n");
+    sb.append("    index: ").append(toIndentedString(index)).append("\n");
+    sb.append("    oldCommitId: ").append(toIndentedString(oldCommitId)).append("\n");
+    sb.append("    newCommitId: ").append(toIndentedString(newCommitId)).append("\n");
+    sb.append("    operation: ").append(toIndentedString(operation)).append("\n");
+    sb.append("    user: ").append(toIndentedString(user)).append("\n");
+    sb.append("    creationDate: ").append(toIndentedString(creationDate)).append("\n");
+    sb.append("}");
+    return sb.toString();
+  }
+
+  /**
+   * Convert the given object to string with each line indented by 4 spaces
+   * (except the first line).
+   */
+  private String toIndentedString(Object o) {
+    if (o == null) {
+      return "null";
+    }
+    return o.toString().replace("\n", "\n    ");
+  }
+
+}
+
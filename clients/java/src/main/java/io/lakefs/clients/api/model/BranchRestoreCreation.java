@@ -0,0 +1,99 @@
+/*
+ * lakeFS API
+ * lakeFS HTTP API
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+package io.lakefs.clients.api.model;
+
+import java.util.Objects;
+import java.util.Arrays;
+import com.google.gson.TypeAdapter;
+import com.google.gson.annotations.JsonAdapter;
+import com.google.gson.annotations.SerializedName;
+import com.google.gson.stream.JsonReader;
+import com.google.gson.stream.JsonWriter;
+import io.swagger.annotations.ApiModel;
+import io.swagger.annotations.ApiModelProperty;
+import java.io.IOException;
+
+/**
+ * BranchRestoreCreation
+ */
+@javax.annotation.Generated(value = "org.openapitools.codegen.languages.JavaClientCodegen")
+public class BranchRestoreCreation {
+  public static final String SERIALIZED_NAME_INDEX = "index";
+  @SerializedName(SERIALIZED_NAME_INDEX)
+  private Integer index;
+
+
+  public BranchRestoreCreation index(Integer index) {
+    
+    this.index = index;
+    return this;
+  }
+
+   /**
+   * the branch reflog entry to restore the branch to, the branch will point to the commit it pointed to after that entry
+   * minimum: 0
+   * @return index
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "the branch reflog entry to restore the branch to, the branch will point to the commit it pointed to after that entry")
+
+  public Integer getIndex() {
+    return index;
+  }
+
+
+  public void setIndex(Integer index) {
+    this.index = index;
+  }
+
+
+  @Override
+  public boolean equals(Object o) {
+    if (this == o) {
+      return true;
+    }
+    if (o == null || getClass() != o.getClass()) {
+      return false;
+    }
+    BranchRestoreCreation branchRestoreCreation = (BranchRestoreCreation) o;
+    return Objects.equals(this.index, branchRestoreCreation.index);
+  }
+
+  @Override
+  public int hashCode() {
+    return Objects.hash(index);
+  }
+
+  @Override
+  public String toString() {
+    StringBuilder sb = new StringBuilder();
+    sb.append("class BranchRestoreCreation {\n");
+    sb.append("    index: ").append(toIndentedString(index)).append("\n");
+    sb.append("}");
+    return sb.toString();
+  }
+
+  /**
+   * Convert the given object to string with each line indented by 4 spaces
+   * (except the first line).
+   */
+  private String toIndentedString(Object o) {
+    if (o == null) {
+      return "null";
+    }
+    return o.toString().replace("\n", "\n    ");
+  }
+
+}
+
@@ -20,6 +20,7 @@ import com.google.gson.annotations.JsonAdapter;
 import com.google.gson.annotations.SerializedName;
 import com.google.gson.stream.JsonReader;
 import com.google.gson.stream.JsonWriter;
+import io.lakefs.clients.api.model.CommitSignature;
 import io.swagger.annotations.ApiModel;
 import io.swagger.annotations.ApiModelProperty;
 import java.io.IOException;
@@ -61,6 +62,10 @@ public class Commit {
   @SerializedName(SERIALIZED_NAME_METADATA)
   private Map<String, String> metadata = null;
 
+  public static final String SERIALIZED_NAME_SIGNATURE = "signature";
+  @SerializedName(SERIALIZED_NAME_SIGNATURE)
+  private CommitSignature signature;
+
 
   public Commit id(String id) {
     
@@ -236,6 +241,29 @@ public class Commit {
   }
 
 
+  public Commit signature(CommitSignature signature) {
+    
+    this.signature = signature;
+    return this;
+  }
+
+   /**
+   * Get signature
+   * @return signature
+  **/
+  @javax.annotation.Nullable
+  @ApiModelProperty(value = "")
+
+  public CommitSignature getSignature() {
+    return signature;
+  }
+
+
+  public void setSignature(CommitSignature signature) {
+    this.signature = signature;
+  }
+
+
   @Override
   public boolean equals(Object o) {
     if (this == o) {
@@ -251,12 +279,13 @@ public class Commit {
         Objects.equals(this.message, commit.message) &&
         Objects.equals(this.creationDate, commit.creationDate) &&
         Objects.equals(this.metaRangeId, commit.metaRangeId) &&
-        Objects.equals(this.metadata, commit.metadata);
+        Objects.equals(this.metadata, commit.metadata) &&
+        Objects.equals(this.signature, commit.signature);
   }
 
   @Override
   public int hashCode() {
-    return Objects.hash(id, parents, committer, message, creationDate, metaRangeId, metadata);
+    return Objects.hash(id, parents, committer, message, creationDate, metaRangeId, metadata, signature);
   }
 
   @Override
@@ -270,6 +299,7 @@ public class Commit {
     sb.append("    creationDate: ").append(toIndentedString(creationDate)).append("\n");
     sb.append("    metaRangeId: ").append(toIndentedString(metaRangeId)).append("\n");
     sb.append("    metadata: ").append(toIndentedString(metadata)).append("\n");
+    sb.append("    signature: ").append(toIndentedString(signature)).append("\n");
     sb.append("}");
     return sb.toString();
   }
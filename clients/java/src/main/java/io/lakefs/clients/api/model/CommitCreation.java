@@ -23,6 +23,7 @@ import com.google.gson.stream.JsonWriter;
 import io.swagger.annotations.ApiModel;
 import io.swagger.annotations.ApiModelProperty;
 import java.io.IOException;
+import java.util.ArrayList;
 import java.util.HashMap;
 import java.util.List;
 import java.util.Map;
@@ -44,6 +45,10 @@ public class CommitCreation {
   @SerializedName(SERIALIZED_NAME_DATE)
   private Long date;
 
+  public static final String SERIALIZED_NAME_PREFIXES = "prefixes";
+  @SerializedName(SERIALIZED_NAME_PREFIXES)
+  private List<String> prefixes = null;
+
 
   public CommitCreation message(String message) {
     
@@ -122,6 +127,37 @@ public class CommitCreation {
   }
 
 
+  public CommitCreation prefixes(List<String> prefixes) {
+    
+    this.prefixes = prefixes;
+    return this;
+  }
+
+  public CommitCreation addPrefixesItem(String prefixesItem) {
+    if (this.prefixes == null) {
+      this.prefixes = new ArrayList<String>();
+    }
+    this.prefixes.add(prefixesItem);
+    return this;
+  }
+
+   /**
+   * commit only the uncommitted changes under these prefixes (a path commits that object), the rest stay uncommitted. All uncommitted changes are committed when missing or empty. 
+   * @return prefixes
+  **/
+  @javax.annotation.Nullable
+  @ApiModelProperty(value = "commit only the uncommitted changes under these prefixes (a path commits that object), the rest stay uncommitted. All uncommitted changes are committed when missing or empty. ")
+
+  public List<String> getPrefixes() {
+    return prefixes;
+  }
+
+
+  public void setPrefixes(List<String> prefixes) {
+    this.prefixes = prefixes;
+  }
+
+
   @Override
   public boolean equals(Object o) {
     if (this == o) {
@@ -133,12 +169,13 @@ public class CommitCreation {
     CommitCreation commitCreation = (CommitCreation) o;
     return Objects.equals(this.message, commitCreation.message) &&
         Objects.equals(this.metadata, commitCreation.metadata) &&
-        Objects.equals(this.date, commitCreation.date);
+        Objects.equals(this.date, commitCreation.date) &&
+        Objects.equals(this.prefixes, commitCreation.prefixes);
   }
 
   @Override
   public int hashCode() {
-    return Objects.hash(message, metadata, date);
+    return Objects.hash(message, metadata, date, prefixes);
   }
 
   @Override
@@ -148,6 +185,7 @@ public class CommitCreation {
     sb.append("    message: ").append(toIndentedString(message)).append("\n");
     sb.append("    metadata: ").append(toIndentedString(metadata)).append("\n");
     sb.append("    date: ").append(toIndentedString(date)).append("\n");
+    sb.append("    prefixes: ").append(toIndentedString(prefixes)).append("\n");
     sb.append("}");
     return sb.toString();
   }
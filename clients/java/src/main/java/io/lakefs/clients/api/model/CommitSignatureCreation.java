@@ -0,0 +1,127 @@
+/*
+ * lakeFS API
+ * lakeFS HTTP API
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+package io.lakefs.clients.api.model;
+
+import java.util.Objects;
+import java.util.Arrays;
+import com.google.gson.TypeAdapter;
+import com.google.gson.annotations.JsonAdapter;
+import com.google.gson.annotations.SerializedName;
+import com.google.gson.stream.JsonReader;
+import com.google.gson.stream.JsonWriter;
+import io.swagger.annotations.ApiModel;
+import io.swagger.annotations.ApiModelProperty;
+import java.io.IOException;
+
+/**
+ * CommitSignatureCreation
+ */
+@javax.annotation.Generated(value = "org.openapitools.codegen.languages.JavaClientCodegen")
+public class CommitSignatureCreation {
+  public static final String SERIALIZED_NAME_KEY_ID = "key_id";
+  @SerializedName(SERIALIZED_NAME_KEY_ID)
+  private String keyId;
+
+  public static final String SERIALIZED_NAME_SIGNATURE = "signature";
+  @SerializedName(SERIALIZED_NAME_SIGNATURE)
+  private byte[] signature;
+
+
+  public CommitSignatureCreation keyId(String keyId) {
+    
+    this.keyId = keyId;
+    return this;
+  }
+
+   /**
+   * ID of the signing key of the committer that made the signature
+   * @return keyId
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "ID of the signing key of the committer that made the signature")
+
+  public String getKeyId() {
+    return keyId;
+  }
+
+
+  public void setKeyId(String keyId) {
+    this.keyId = keyId;
+  }
+
+
+  public CommitSignatureCreation signature(byte[] signature) {
+    
+    this.signature = signature;
+    return this;
+  }
+
+   /**
+   * signature over the commit identity (the commit ID decoded from hex) in SSH wire format, or a raw 64 bytes signature for ed25519 keys 
+   * @return signature
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "signature over the commit identity (the commit ID decoded from hex) in SSH wire format, or a raw 64 bytes signature for ed25519 keys ")
+
+  public byte[] getSignature() {
+    return signature;
+  }
+
+
+  public void setSignature(byte[] signature) {
+    this.signature = signature;
+  }
+
+
+  @Override
+  public boolean equals(Object o) {
+    if (this == o) {
+      return true;
+    }
+    if (o == null || getClass() != o.getClass()) {
+      return false;
+    }
+    CommitSignatureCreation commitSignatureCreation = (CommitSignatureCreation) o;
+    return Objects.equals(this.keyId, commitSignatureCreation.keyId) &&
+        Arrays.equals(this.signature, commitSignatureCreation.signature);
+  }
+
+  @Override
+  public int hashCode() {
+    return Objects.hash(keyId, Arrays.hashCode(signature));
+  }
+
+  @Override
+  public String toString() {
+    StringBuilder sb = new StringBuilder();
+    sb.append("class CommitSignatureCreation {\n");
+    sb.append("    keyId: ").append(toIndentedString(keyId)).append("\n");
+    sb.append("    signature: ").append(toIndentedString(signature)).append("\n");
+    sb.append("}");
+    return sb.toString();
+  }
+
+  /**
+   * Convert the given object to string with each line indented by 4 spaces
+   * (except the first line).
+   */
+  private String toIndentedString(Object o) {
+    if (o == null) {
+      return "null";
+    }
+    return o.toString().replace("\n", "\n    ");
+  }
+
+}
+
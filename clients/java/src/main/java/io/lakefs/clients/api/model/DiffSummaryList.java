@@ -0,0 +1,106 @@
+/*
+ * lakeFS API
+ * lakeFS HTTP API
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+package io.lakefs.clients.api.model;
+
+import java.util.Objects;
+import java.util.Arrays;
+import com.google.gson.TypeAdapter;
+import com.google.gson.annotations.JsonAdapter;
+import com.google.gson.annotations.SerializedName;
+import com.google.gson.stream.JsonReader;
+import com.google.gson.stream.JsonWriter;
+import io.lakefs.clients.api.model.PrefixDiffSummary;
+import io.swagger.annotations.ApiModel;
+import io.swagger.annotations.ApiModelProperty;
+import java.io.IOException;
+import java.util.ArrayList;
+import java.util.List;
+
+/**
+ * DiffSummaryList
+ */
+@javax.annotation.Generated(value = "org.openapitools.codegen.languages.JavaClientCodegen")
+public class DiffSummaryList {
+  public static final String SERIALIZED_NAME_RESULTS = "results";
+  @SerializedName(SERIALIZED_NAME_RESULTS)
+  private List<PrefixDiffSummary> results = new ArrayList<PrefixDiffSummary>();
+
+
+  public DiffSummaryList results(List<PrefixDiffSummary> results) {
+    
+    this.results = results;
+    return this;
+  }
+
+  public DiffSummaryList addResultsItem(PrefixDiffSummary resultsItem) {
+    this.results.add(resultsItem);
+    return this;
+  }
+
+   /**
+   * changes by prefix, sorted by prefix
+   * @return results
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "changes by prefix, sorted by prefix")
+
+  public List<PrefixDiffSummary> getResults() {
+    return results;
+  }
+
+
+  public void setResults(List<PrefixDiffSummary> results) {
+    this.results = results;
+  }
+
+
+  @Override
+  public boolean equals(Object o) {
+    if (this == o) {
+      return true;
+    }
+    if (o == null || getClass() != o.getClass()) {
+      return false;
+    }
+    DiffSummaryList diffSummaryList = (DiffSummaryList) o;
+    return Objects.equals(this.results, diffSummaryList.results);
+  }
+
+  @Override
+  public int hashCode() {
+    return Objects.hash(results);
+  }
+
+  @Override
+  public String toString() {
+    StringBuilder sb = new StringBuilder();
+    sb.append("class DiffSummaryList {\n");
+    sb.append("    results: ").append(toIndentedString(results)).append("\n");
+    sb.append("}");
+    return sb.toString();
+  }
+
+  /**
+   * Convert the given object to string with each line indented by 4 spaces
+   * (except the first line).
+   */
+  private String toIndentedString(Object o) {
+    if (o == null) {
+      return "null";
+    }
+    return o.toString().replace("\n", "\n    ");
+  }
+
+}
+
@@ -0,0 +1,156 @@
+/*
+ * lakeFS API
+ * lakeFS HTTP API
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+package io.lakefs.clients.api.model;
+
+import java.util.Objects;
+import java.util.Arrays;
+import com.google.gson.TypeAdapter;
+import com.google.gson.annotations.JsonAdapter;
+import com.google.gson.annotations.SerializedName;
+import com.google.gson.stream.JsonReader;
+import com.google.gson.stream.JsonWriter;
+import io.swagger.annotations.ApiModel;
+import io.swagger.annotations.ApiModelProperty;
+import java.io.IOException;
+
+/**
+ * GarbageCollectionRulePreview
+ */
+@javax.annotation.Generated(value = "org.openapitools.codegen.languages.JavaClientCodegen")
+public class GarbageCollectionRulePreview {
+  public static final String SERIALIZED_NAME_PREFIX = "prefix";
+  @SerializedName(SERIALIZED_NAME_PREFIX)
+  private String prefix;
+
+  public static final String SERIALIZED_NAME_RETENTION_DAYS = "retention_days";
+  @SerializedName(SERIALIZED_NAME_RETENTION_DAYS)
+  private Integer retentionDays;
+
+  public static final String SERIALIZED_NAME_EXPIRED_OBJECTS = "expired_objects";
+  @SerializedName(SERIALIZED_NAME_EXPIRED_OBJECTS)
+  private Integer expiredObjects;
+
+
+  public GarbageCollectionRulePreview prefix(String prefix) {
+    
+    this.prefix = prefix;
+    return this;
+  }
+
+   /**
+   * prefix of the rule, empty for the default rule
+   * @return prefix
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "prefix of the rule, empty for the default rule")
+
+  public String getPrefix() {
+    return prefix;
+  }
+
+
+  public void setPrefix(String prefix) {
+    this.prefix = prefix;
+  }
+
+
+  public GarbageCollectionRulePreview retentionDays(Integer retentionDays) {
+    
+    this.retentionDays = retentionDays;
+    return this;
+  }
+
+   /**
+   * Get retentionDays
+   * @return retentionDays
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "")
+
+  public Integer getRetentionDays() {
+    return retentionDays;
+  }
+
+
+  public void setRetentionDays(Integer retentionDays) {
+    this.retentionDays = retentionDays;
+  }
+
+
+  public GarbageCollectionRulePreview expiredObjects(Integer expiredObjects) {
+    
+    this.expiredObjects = expiredObjects;
+    return this;
+  }
+
+   /**
+   * estimated number of objects the rule would expire
+   * @return expiredObjects
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "estimated number of objects the rule would expire")
+
+  public Integer getExpiredObjects() {
+    return expiredObjects;
+  }
+
+
+  public void setExpiredObjects(Integer expiredObjects) {
+    this.expiredObjects = expiredObjects;
+  }
+
+
+  @Override
+  public boolean equals(Object o) {
+    if (this == o) {
+      return true;
+    }
+    if (o == null || getClass() != o.getClass()) {
+      return false;
+    }
+    GarbageCollectionRulePreview garbageCollectionRulePreview = (GarbageCollectionRulePreview) o;
+    return Objects.equals(this.prefix, garbageCollectionRulePreview.prefix) &&
+        Objects.equals(this.retentionDays, garbageCollectionRulePreview.retentionDays) &&
+        Objects.equals(this.expiredObjects, garbageCollectionRulePreview.expiredObjects);
+  }
+
+  @Override
+  public int hashCode() {
+    return Objects.hash(prefix, retentionDays, expiredObjects);
+  }
+
+  @Override
+  public String toString() {
+    StringBuilder sb = new StringBuilder();
+    sb.append("class GarbageCollectionRulePreview {\n");
+    sb.append("    prefix: ").append(toIndentedString(prefix)).append("\n");
+    sb.append("    retentionDays: ").append(toIndentedString(retentionDays)).append("\n");
+    sb.append("    expiredObjects: ").append(toIndentedString(expiredObjects)).append("\n");
+    sb.append("}");
+    return sb.toString();
+  }
+
+  /**
+   * Convert the given object to string with each line indented by 4 spaces
+   * (except the first line).
+   */
+  private String toIndentedString(Object o) {
+    if (o == null) {
+      return "null";
+    }
+    return o.toString().replace("\n", "\n    ");
+  }
+
+}
+
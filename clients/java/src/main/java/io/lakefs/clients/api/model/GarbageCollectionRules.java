@@ -20,6 +20,7 @@ import com.google.gson.annotations.JsonAdapter;
 import com.google.gson.annotations.SerializedName;
 import com.google.gson.stream.JsonReader;
 import com.google.gson.stream.JsonWriter;
+import io.lakefs.clients.api.model.GarbageCollectionPrefixRule;
 import io.lakefs.clients.api.model.GarbageCollectionRule;
 import io.swagger.annotations.ApiModel;
 import io.swagger.annotations.ApiModelProperty;
@@ -40,6 +41,14 @@ public class GarbageCollectionRules {
   @SerializedName(SERIALIZED_NAME_BRANCHES)
   private List<GarbageCollectionRule> branches = new ArrayList<GarbageCollectionRule>();
 
+  public static final String SERIALIZED_NAME_PREFIXES = "prefixes";
+  @SerializedName(SERIALIZED_NAME_PREFIXES)
+  private List<GarbageCollectionPrefixRule> prefixes = null;
+
+  public static final String SERIALIZED_NAME_PINNED_TAG_PATTERNS = "pinned_tag_patterns";
+  @SerializedName(SERIALIZED_NAME_PINNED_TAG_PATTERNS)
+  private List<String> pinnedTagPatterns = null;
+
 
   public GarbageCollectionRules defaultRetentionDays(Integer defaultRetentionDays) {
     
@@ -92,6 +101,68 @@ public class GarbageCollectionRules {
   }
 
 
+  public GarbageCollectionRules prefixes(List<GarbageCollectionPrefixRule> prefixes) {
+    
+    this.prefixes = prefixes;
+    return this;
+  }
+
+  public GarbageCollectionRules addPrefixesItem(GarbageCollectionPrefixRule prefixesItem) {
+    if (this.prefixes == null) {
+      this.prefixes = new ArrayList<GarbageCollectionPrefixRule>();
+    }
+    this.prefixes.add(prefixesItem);
+    return this;
+  }
+
+   /**
+   * objects are retained by the rule of the longest prefix of their path on all branches, instead of the default and branch rules
+   * @return prefixes
+  **/
+  @javax.annotation.Nullable
+  @ApiModelProperty(value = "objects are retained by the rule of the longest prefix of their path on all branches, instead of the default and branch rules")
+
+  public List<GarbageCollectionPrefixRule> getPrefixes() {
+    return prefixes;
+  }
+
+
+  public void setPrefixes(List<GarbageCollectionPrefixRule> prefixes) {
+    this.prefixes = prefixes;
+  }
+
+
+  public GarbageCollectionRules pinnedTagPatterns(List<String> pinnedTagPatterns) {
+    
+    this.pinnedTagPatterns = pinnedTagPatterns;
+    return this;
+  }
+
+  public GarbageCollectionRules addPinnedTagPatternsItem(String pinnedTagPatternsItem) {
+    if (this.pinnedTagPatterns == null) {
+      this.pinnedTagPatterns = new ArrayList<String>();
+    }
+    this.pinnedTagPatterns.add(pinnedTagPatternsItem);
+    return this;
+  }
+
+   /**
+   * commits reachable from tags matching any of these patterns are never expired
+   * @return pinnedTagPatterns
+  **/
+  @javax.annotation.Nullable
+  @ApiModelProperty(example = "[release-*]", value = "commits reachable from tags matching any of these patterns are never expired")
+
+  public List<String> getPinnedTagPatterns() {
+    return pinnedTagPatterns;
+  }
+
+
+  public void setPinnedTagPatterns(List<String> pinnedTagPatterns) {
+    this.pinnedTagPatterns = pinnedTagPatterns;
+  }
+
+
   @Override
   public boolean equals(Object o) {
     if (this == o) {
@@ -102,12 +173,14 @@ public class GarbageCollectionRules {
     }
     GarbageCollectionRules garbageCollectionRules = (GarbageCollectionRules) o;
     return Objects.equals(this.defaultRetentionDays, garbageCollectionRules.defaultRetentionDays) &&
-        Objects.equals(this.branches, garbageCollectionRules.branches);
+        Objects.equals(this.branches, garbageCollectionRules.branches) &&
+        Objects.equals(this.prefixes, garbageCollectionRules.prefixes) &&
+        Objects.equals(this.pinnedTagPatterns, garbageCollectionRules.pinnedTagPatterns);
   }
 
   @Override
   public int hashCode() {
-    return Objects.hash(defaultRetentionDays, branches);
+    return Objects.hash(defaultRetentionDays, branches, prefixes, pinnedTagPatterns);
   }
 
   @Override
@@ -116,6 +189,8 @@ public class GarbageCollectionRules {
     sb.append("class GarbageCollectionRules {\n");
     sb.append("    defaultRetentionDays: ").append(toIndentedString(defaultRetentionDays)).append("\n");
     sb.append("    branches: ").append(toIndentedString(branches)).append("\n");
+    sb.append("    prefixes: ").append(toIndentedString(prefixes)).append("\n");
+    sb.append("    pinnedTagPatterns: ").append(toIndentedString(pinnedTagPatterns)).append("\n");
     sb.append("}");
     return sb.toString();
   }
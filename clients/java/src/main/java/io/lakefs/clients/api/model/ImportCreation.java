@@ -0,0 +1,196 @@
+/*
+ * lakeFS API
+ * lakeFS HTTP API
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+package io.lakefs.clients.api.model;
+
+import java.util.Objects;
+import java.util.Arrays;
+import com.google.gson.TypeAdapter;
+import com.google.gson.annotations.JsonAdapter;
+import com.google.gson.annotations.SerializedName;
+import com.google.gson.stream.JsonReader;
+import com.google.gson.stream.JsonWriter;
+import io.swagger.annotations.ApiModel;
+import io.swagger.annotations.ApiModelProperty;
+import java.io.IOException;
+import java.util.HashMap;
+import java.util.List;
+import java.util.Map;
+
+/**
+ * ImportCreation
+ */
+@javax.annotation.Generated(value = "org.openapitools.codegen.languages.JavaClientCodegen")
+public class ImportCreation {
+  public static final String SERIALIZED_NAME_SOURCE = "source";
+  @SerializedName(SERIALIZED_NAME_SOURCE)
+  private String source;
+
+  public static final String SERIALIZED_NAME_DESTINATION = "destination";
+  @SerializedName(SERIALIZED_NAME_DESTINATION)
+  private String destination;
+
+  public static final String SERIALIZED_NAME_MESSAGE = "message";
+  @SerializedName(SERIALIZED_NAME_MESSAGE)
+  private String message;
+
+  public static final String SERIALIZED_NAME_METADATA = "metadata";
+  @SerializedName(SERIALIZED_NAME_METADATA)
+  private Map<String, String> metadata = null;
+
+
+  public ImportCreation source(String source) {
+    
+    this.source = source;
+    return this;
+  }
+
+   /**
+   * object store prefix to import the objects under, e.g. s3://bucket/path/
+   * @return source
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "object store prefix to import the objects under, e.g. s3://bucket/path/")
+
+  public String getSource() {
+    return source;
+  }
+
+
+  public void setSource(String source) {
+    this.source = source;
+  }
+
+
+  public ImportCreation destination(String destination) {
+    
+    this.destination = destination;
+    return this;
+  }
+
+   /**
+   * path on the branch to import the objects to, the objects under it are replaced
+   * @return destination
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "path on the branch to import the objects to, the objects under it are replaced")
+
+  public String getDestination() {
+    return destination;
+  }
+
+
+  public void setDestination(String destination) {
+    this.destination = destination;
+  }
+
+
+  public ImportCreation message(String message) {
+    
+    this.message = message;
+    return this;
+  }
+
+   /**
+   * message of the import commit
+   * @return message
+  **/
+  @javax.annotation.Nullable
+  @ApiModelProperty(value = "message of the import commit")
+
+  public String getMessage() {
+    return message;
+  }
+
+
+  public void setMessage(String message) {
+    this.message = message;
+  }
+
+
+  public ImportCreation metadata(Map<String, String> metadata) {
+    
+    this.metadata = metadata;
+    return this;
+  }
+
+  public ImportCreation putMetadataItem(String key, String metadataItem) {
+    if (this.metadata == null) {
+      this.metadata = new HashMap<String, String>();
+    }
+    this.metadata.put(key, metadataItem);
+    return this;
+  }
+
+   /**
+   * Get metadata
+   * @return metadata
+  **/
+  @javax.annotation.Nullable
+  @ApiModelProperty(value = "")
+
+  public Map<String, String> getMetadata() {
+    return metadata;
+  }
+
+
+  public void setMetadata(Map<String, String> metadata) {
+    this.metadata = metadata;
+  }
+
+
+  @Override
+  public boolean equals(Object o) {
+    if (this == o) {
+      return true;
+    }
+    if (o == null || getClass() != o.getClass()) {
+      return false;
+    }
+    ImportCreation importCreation = (ImportCreation) o;
+    return Objects.equals(this.source, importCreation.source) &&
+        Objects.equals(this.destination, importCreation.destination) &&
+        Objects.equals(this.message, importCreation.message) &&
+        Objects.equals(this.metadata, importCreation.metadata);
+  }
+
+  @Override
+  public int hashCode() {
+    return Objects.hash(source, destination, message, metadata);
+  }
+
+  @Override
+  public String toString() {
+    StringBuilder sb = new StringBuilder();
+    sb.append("class ImportCreation {\n");
+    sb.append("    source: ").append(toIndentedString(source)).append("\n");
+    sb.append("    destination: ").append(toIndentedString(destination)).append("\n");
+    sb.append("    message: ").append(toIndentedString(message)).append("\n");
+    sb.append("    metadata: ").append(toIndentedString(metadata)).append("\n");
+    sb.append("}");
+    return sb.toString();
+  }
+
+  /**
+   * Convert the given object to string with each line indented by 4 spaces
+   * (except the first line).
+   */
+  private String toIndentedString(Object o) {
+    if (o == null) {
+      return "null";
+    }
+    return o.toString().replace("\n", "\n    ");
+  }
+
+}
+
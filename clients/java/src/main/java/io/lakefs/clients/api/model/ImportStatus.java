@@ -0,0 +1,272 @@
+/*
+ * lakeFS API
+ * lakeFS HTTP API
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+package io.lakefs.clients.api.model;
+
+import java.util.Objects;
+import java.util.Arrays;
+import com.google.gson.TypeAdapter;
+import com.google.gson.annotations.JsonAdapter;
+import com.google.gson.annotations.SerializedName;
+import com.google.gson.stream.JsonReader;
+import com.google.gson.stream.JsonWriter;
+import io.swagger.annotations.ApiModel;
+import io.swagger.annotations.ApiModelProperty;
+import java.io.IOException;
+
+/**
+ * ImportStatus
+ */
+@javax.annotation.Generated(value = "org.openapitools.codegen.languages.JavaClientCodegen")
+public class ImportStatus {
+  public static final String SERIALIZED_NAME_ID = "id";
+  @SerializedName(SERIALIZED_NAME_ID)
+  private String id;
+
+  public static final String SERIALIZED_NAME_COMPLETED = "completed";
+  @SerializedName(SERIALIZED_NAME_COMPLETED)
+  private Boolean completed;
+
+  public static final String SERIALIZED_NAME_IMPORTED_OBJECTS = "imported_objects";
+  @SerializedName(SERIALIZED_NAME_IMPORTED_OBJECTS)
+  private Long importedObjects;
+
+  public static final String SERIALIZED_NAME_METARANGE_ID = "metarange_id";
+  @SerializedName(SERIALIZED_NAME_METARANGE_ID)
+  private String metarangeId;
+
+  public static final String SERIALIZED_NAME_COMMIT_ID = "commit_id";
+  @SerializedName(SERIALIZED_NAME_COMMIT_ID)
+  private String commitId;
+
+  public static final String SERIALIZED_NAME_ERROR = "error";
+  @SerializedName(SERIALIZED_NAME_ERROR)
+  private String error;
+
+  public static final String SERIALIZED_NAME_UPDATE_TIME = "update_time";
+  @SerializedName(SERIALIZED_NAME_UPDATE_TIME)
+  private Long updateTime;
+
+
+  public ImportStatus id(String id) {
+    
+    this.id = id;
+    return this;
+  }
+
+   /**
+   * Get id
+   * @return id
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "")
+
+  public String getId() {
+    return id;
+  }
+
+
+  public void setId(String id) {
+    this.id = id;
+  }
+
+
+  public ImportStatus completed(Boolean completed) {
+    
+    this.completed = completed;
+    return this;
+  }
+
+   /**
+   * Get completed
+   * @return completed
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "")
+
+  public Boolean getCompleted() {
+    return completed;
+  }
+
+
+  public void setCompleted(Boolean completed) {
+    this.completed = completed;
+  }
+
+
+  public ImportStatus importedObjects(Long importedObjects) {
+    
+    this.importedObjects = importedObjects;
+    return this;
+  }
+
+   /**
+   * number of objects listed so far
+   * @return importedObjects
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "number of objects listed so far")
+
+  public Long getImportedObjects() {
+    return importedObjects;
+  }
+
+
+  public void setImportedObjects(Long importedObjects) {
+    this.importedObjects = importedObjects;
+  }
+
+
+  public ImportStatus metarangeId(String metarangeId) {
+    
+    this.metarangeId = metarangeId;
+    return this;
+  }
+
+   /**
+   * Get metarangeId
+   * @return metarangeId
+  **/
+  @javax.annotation.Nullable
+  @ApiModelProperty(value = "")
+
+  public String getMetarangeId() {
+    return metarangeId;
+  }
+
+
+  public void setMetarangeId(String metarangeId) {
+    this.metarangeId = metarangeId;
+  }
+
+
+  public ImportStatus commitId(String commitId) {
+    
+    this.commitId = commitId;
+    return this;
+  }
+
+   /**
+   * the commit that added the objects to the branch, set once the import succeeded
+   * @return commitId
+  **/
+  @javax.annotation.Nullable
+  @ApiModelProperty(value = "the commit that added the objects to the branch, set once the import succeeded")
+
+  public String getCommitId() {
+    return commitId;
+  }
+
+
+  public void setCommitId(String commitId) {
+    this.commitId = commitId;
+  }
+
+
+  public ImportStatus error(String error) {
+    
+    this.error = error;
+    return this;
+  }
+
+   /**
+   * set once the import failed or was canceled
+   * @return error
+  **/
+  @javax.annotation.Nullable
+  @ApiModelProperty(value = "set once the import failed or was canceled")
+
+  public String getError() {
+    return error;
+  }
+
+
+  public void setError(String error) {
+    this.error = error;
+  }
+
+
+  public ImportStatus updateTime(Long updateTime) {
+    
+    this.updateTime = updateTime;
+    return this;
+  }
+
+   /**
+   * Get updateTime
+   * @return updateTime
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "")
+
+  public Long getUpdateTime() {
+    return updateTime;
+  }
+
+
+  public void setUpdateTime(Long updateTime) {
+    this.updateTime = updateTime;
+  }
+
+
+  @Override
+  public boolean equals(Object o) {
+    if (this == o) {
+      return true;
+    }
+    if (o == null || getClass() != o.getClass()) {
+      return false;
+    }
+    ImportStatus importStatus = (ImportStatus) o;
+    return Objects.equals(this.id, importStatus.id) &&
+        Objects.equals(this.completed, importStatus.completed) &&
+        Objects.equals(this.importedObjects, importStatus.importedObjects) &&
+        Objects.equals(this.metarangeId, importStatus.metarangeId) &&
+        Objects.equals(this.commitId, importStatus.commitId) &&
+        Objects.equals(this.error, importStatus.error) &&
+        Objects.equals(this.updateTime, importStatus.updateTime);
+  }
+
+  @Override
+  public int hashCode() {
+    return Objects.hash(id, completed, importedObjects, metarangeId, commitId, error, updateTime);
+  }
+
+  @Override
+  public String toString() {
+    StringBuilder sb = new StringBuilder();
+    sb.append("class ImportStatus {\n");
+    sb.append("    id: ").append(toIndentedString(id)).append("\n");
+    sb.append("    completed: ").append(toIndentedString(completed)).append("\n");
+    sb.append("    importedObjects: ").append(toIndentedString(importedObjects)).append("\n");
+    sb.append("    metarangeId: ").append(toIndentedString(metarangeId)).append("\n");
+    sb.append("    commitId: ").append(toIndentedString(commitId)).append("\n");
+    sb.append("    error: ").append(toIndentedString(error)).append("\n");
+    sb.append("    updateTime: ").append(toIndentedString(updateTime)).append("\n");
+    sb.append("}");
+    return sb.toString();
+  }
+
+  /**
+   * Convert the given object to string with each line indented by 4 spaces
+   * (except the first line).
+   */
+  private String toIndentedString(Object o) {
+    if (o == null) {
+      return "null";
+    }
+    return o.toString().replace("\n", "\n    ");
+  }
+
+}
+
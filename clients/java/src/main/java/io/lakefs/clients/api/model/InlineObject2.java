@@ -25,16 +25,16 @@ import io.swagger.annotations.ApiModelProperty;
 import java.io.IOException;
 
 /**
- * InlineObject1
+ * InlineObject2
  */
 @javax.annotation.Generated(value = "org.openapitools.codegen.languages.JavaClientCodegen")
-public class InlineObject1 {
+public class InlineObject2 {
   public static final String SERIALIZED_NAME_PATTERN = "pattern";
   @SerializedName(SERIALIZED_NAME_PATTERN)
   private String pattern;
 
 
-  public InlineObject1 pattern(String pattern) {
+  public InlineObject2 pattern(String pattern) {
     
     this.pattern = pattern;
     return this;
@@ -65,8 +65,8 @@ public class InlineObject1 {
     if (o == null || getClass() != o.getClass()) {
       return false;
     }
-    InlineObject1 inlineObject1 = (InlineObject1) o;
-    return Objects.equals(this.pattern, inlineObject1.pattern);
+    InlineObject2 inlineObject2 = (InlineObject2) o;
+    return Objects.equals(this.pattern, inlineObject2.pattern);
   }
 
   @Override
@@ -77,7 +77,7 @@ public class InlineObject1 {
   @Override
   public String toString() {
     StringBuilder sb = new StringBuilder();
-    sb.append("class InlineObject1 {\n");
+    sb.append("class InlineObject2 {\n");
     sb.append("    pattern: ").append(toIndentedString(pattern)).append("\n");
     sb.append("}");
     return sb.toString();
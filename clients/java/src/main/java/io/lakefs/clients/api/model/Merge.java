@@ -20,9 +20,11 @@ import com.google.gson.annotations.JsonAdapter;
 import com.google.gson.annotations.SerializedName;
 import com.google.gson.stream.JsonReader;
 import com.google.gson.stream.JsonWriter;
+import io.lakefs.clients.api.model.MergeStrategyRule;
 import io.swagger.annotations.ApiModel;
 import io.swagger.annotations.ApiModelProperty;
 import java.io.IOException;
+import java.util.ArrayList;
 import java.util.HashMap;
 import java.util.List;
 import java.util.Map;
@@ -44,6 +46,63 @@ public class Merge {
   @SerializedName(SERIALIZED_NAME_STRATEGY)
   private String strategy;
 
+  /**
+   * Gets or Sets mode
+   */
+  @JsonAdapter(ModeEnum.Adapter.class)
+  public enum ModeEnum {
+    FF("ff"),
+    
+    FF_ONLY("ff-only"),
+    
+    SQUASH("squash");
+
+    private String value;
+
+    ModeEnum(String value) {
+      this.value = value;
+    }
+
+    public String getValue() {
+      return value;
+    }
+
+    @Override
+    public String toString() {
+      return String.valueOf(value);
+    }
+
+    public static ModeEnum fromValue(String value) {
+      for (ModeEnum b : ModeEnum.values()) {
+        if (b.value.equals(value)) {
+          return b;
+        }
+      }
+      throw new IllegalArgumentException("Unexpected value '" + value + "'");
+    }
+
+    public static class Adapter extends TypeAdapter<ModeEnum> {
+      @Override
+      public void write(final JsonWriter jsonWriter, final ModeEnum enumeration) throws IOException {
+        jsonWriter.value(enumeration.getValue());
+      }
+
+      @Override
+      public ModeEnum read(final JsonReader jsonReader) throws IOException {
+        String value =  jsonReader.nextString();
+        return ModeEnum.fromValue(value);
+      }
+    }
+  }
+
+  public static final String SERIALIZED_NAME_MODE = "mode";
+  @SerializedName(SERIALIZED_NAME_MODE)
+  private ModeEnum mode;
+
+  public static final String SERIALIZED_NAME_STRATEGY_RULES = "strategy_rules";
+  @SerializedName(SERIALIZED_NAME_STRATEGY_RULES)
+  private List<MergeStrategyRule> strategyRules = null;
+
 
   public Merge message(String message) {
     
@@ -106,11 +165,11 @@ public class Merge {
   }
 
    /**
-   * In case of a merge conflict, this option will force the merge process to automatically favor changes from the dest branch (&#39;dest-wins&#39;) or from the source branch(&#39;source-wins&#39;). In case no selection is made, the merge process will fail in case of a conflict
+   * In case of a merge conflict, this option will force the merge process to automatically favor changes from the dest branch (&#39;dest-wins&#39;) or from the source branch(&#39;source-wins&#39;). In case no selection is made, the merge process will fail in case of a conflict. A strategy given here takes precedence over the repository merge strategy rules, which are then not applied.
    * @return strategy
   **/
   @javax.annotation.Nullable
-  @ApiModelProperty(value = "In case of a merge conflict, this option will force the merge process to automatically favor changes from the dest branch ('dest-wins') or from the source branch('source-wins'). In case no selection is made, the merge process will fail in case of a conflict")
+  @ApiModelProperty(value = "In case of a merge conflict, this option will force the merge process to automatically favor changes from the dest branch ('dest-wins') or from the source branch('source-wins'). In case no selection is made, the merge process will fail in case of a conflict. A strategy given here takes precedence over the repository merge strategy rules, which are then not applied.")
 
   public String getStrategy() {
     return strategy;
@@ -122,6 +181,60 @@ public class Merge {
   }
 
 
+  public Merge mode(ModeEnum mode) {
+    
+    this.mode = mode;
+    return this;
+  }
+
+   /**
+   * How the merge is recorded on the destination branch. &#39;ff&#39; moves the branch to the source commit when the destination is an ancestor of the source and creates a merge commit otherwise, &#39;ff-only&#39; fails unless such a fast-forward is possible, &#39;squash&#39; creates a commit with the merged content and the destination as its only parent. By default a merge commit is always created.
+   * @return mode
+  **/
+  @javax.annotation.Nullable
+  @ApiModelProperty(value = "How the merge is recorded on the destination branch. 'ff' moves the branch to the source commit when the destination is an ancestor of the source and creates a merge commit otherwise, 'ff-only' fails unless such a fast-forward is possible, 'squash' creates a commit with the merged content and the destination as its only parent. By default a merge commit is always created.")
+
+  public ModeEnum getMode() {
+    return mode;
+  }
+
+
+  public void setMode(ModeEnum mode) {
+    this.mode = mode;
+  }
+
+
+  public Merge strategyRules(List<MergeStrategyRule> strategyRules) {
+    
+    this.strategyRules = strategyRules;
+    return this;
+  }
+
+  public Merge addStrategyRulesItem(MergeStrategyRule strategyRulesItem) {
+    if (this.strategyRules == null) {
+      this.strategyRules = new ArrayList<MergeStrategyRule>();
+    }
+    this.strategyRules.add(strategyRulesItem);
+    return this;
+  }
+
+   /**
+   * Rules that select the strategy of conflicting paths, applied in order before the repository merge strategy rules. Paths that match no rule use &#39;strategy&#39;. The repository merge strategy rules apply only when &#39;strategy&#39; is not set.
+   * @return strategyRules
+  **/
+  @javax.annotation.Nullable
+  @ApiModelProperty(value = "Rules that select the strategy of conflicting paths, applied in order before the repository merge strategy rules. Paths that match no rule use 'strategy'. The repository merge strategy rules apply only when 'strategy' is not set.")
+
+  public List<MergeStrategyRule> getStrategyRules() {
+    return strategyRules;
+  }
+
+
+  public void setStrategyRules(List<MergeStrategyRule> strategyRules) {
+    this.strategyRules = strategyRules;
+  }
+
+
   @Override
   public boolean equals(Object o) {
     if (this == o) {
@@ -133,12 +246,14 @@ public class Merge {
     Merge merge = (Merge) o;
     return Objects.equals(this.message, merge.message) &&
         Objects.equals(this.metadata, merge.metadata) &&
-        Objects.equals(this.strategy, merge.strategy);
+        Objects.equals(this.strategy, merge.strategy) &&
+        Objects.equals(this.mode, merge.mode) &&
+        Objects.equals(this.strategyRules, merge.strategyRules);
   }
 
   @Override
   public int hashCode() {
-    return Objects.hash(message, metadata, strategy);
+    return Objects.hash(message, metadata, strategy, mode, strategyRules);
   }
 
   @Override
@@ -148,6 +263,8 @@ public class Merge {
     sb.append("    message: ").append(toIndentedString(message)).append("\n");
     sb.append("    metadata: ").append(toIndentedString(metadata)).append("\n");
     sb.append("    strategy: ").append(toIndentedString(strategy)).append("\n");
+    sb.append("    mode: ").append(toIndentedString(mode)).append("\n");
+    sb.append("    strategyRules: ").append(toIndentedString(strategyRules)).append("\n");
     sb.append("}");
     return sb.toString();
   }
@@ -0,0 +1,186 @@
+/*
+ * lakeFS API
+ * lakeFS HTTP API
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+package io.lakefs.clients.api.model;
+
+import java.util.Objects;
+import java.util.Arrays;
+import com.google.gson.TypeAdapter;
+import com.google.gson.annotations.JsonAdapter;
+import com.google.gson.annotations.SerializedName;
+import com.google.gson.stream.JsonReader;
+import com.google.gson.stream.JsonWriter;
+import io.lakefs.clients.api.model.MergeConflictEntry;
+import io.swagger.annotations.ApiModel;
+import io.swagger.annotations.ApiModelProperty;
+import java.io.IOException;
+
+/**
+ * MergeConflict
+ */
+@javax.annotation.Generated(value = "org.openapitools.codegen.languages.JavaClientCodegen")
+public class MergeConflict {
+  public static final String SERIALIZED_NAME_PATH = "path";
+  @SerializedName(SERIALIZED_NAME_PATH)
+  private String path;
+
+  public static final String SERIALIZED_NAME_SOURCE = "source";
+  @SerializedName(SERIALIZED_NAME_SOURCE)
+  private MergeConflictEntry source;
+
+  public static final String SERIALIZED_NAME_DESTINATION = "destination";
+  @SerializedName(SERIALIZED_NAME_DESTINATION)
+  private MergeConflictEntry destination;
+
+  public static final String SERIALIZED_NAME_BASE = "base";
+  @SerializedName(SERIALIZED_NAME_BASE)
+  private MergeConflictEntry base;
+
+
+  public MergeConflict path(String path) {
+    
+    this.path = path;
+    return this;
+  }
+
+   /**
+   * Get path
+   * @return path
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "")
+
+  public String getPath() {
+    return path;
+  }
+
+
+  public void setPath(String path) {
+    this.path = path;
+  }
+
+
+  public MergeConflict source(MergeConflictEntry source) {
+    
+    this.source = source;
+    return this;
+  }
+
+   /**
+   * Get source
+   * @return source
+  **/
+  @javax.annotation.Nullable
+  @ApiModelProperty(value = "")
+
+  public MergeConflictEntry getSource() {
+    return source;
+  }
+
+
+  public void setSource(MergeConflictEntry source) {
+    this.source = source;
+  }
+
+
+  public MergeConflict destination(MergeConflictEntry destination) {
+    
+    this.destination = destination;
+    return this;
+  }
+
+   /**
+   * Get destination
+   * @return destination
+  **/
+  @javax.annotation.Nullable
+  @ApiModelProperty(value = "")
+
+  public MergeConflictEntry getDestination() {
+    return destination;
+  }
+
+
+  public void setDestination(MergeConflictEntry destination) {
+    this.destination = destination;
+  }
+
+
+  public MergeConflict base(MergeConflictEntry base) {
+    
+    this.base = base;
+    return this;
+  }
+
+   /**
+   * Get base
+   * @return base
+  **/
+  @javax.annotation.Nullable
+  @ApiModelProperty(value = "")
+
+  public MergeConflictEntry getBase() {
+    return base;
+  }
+
+
+  public void setBase(MergeConflictEntry base) {
+    this.base = base;
+  }
+
+
+  @Override
+  public boolean equals(Object o) {
+    if (this == o) {
+      return true;
+    }
+    if (o == null || getClass() != o.getClass()) {
+      return false;
+    }
+    MergeConflict mergeConflict = (MergeConflict) o;
+    return Objects.equals(this.path, mergeConflict.path) &&
+        Objects.equals(this.source, mergeConflict.source) &&
+        Objects.equals(this.destination, mergeConflict.destination) &&
+        Objects.equals(this.base, mergeConflict.base);
+  }
+
+  @Override
+  public int hashCode() {
+    return Objects.hash(path, source, destination, base);
+  }
+
+  @Override
+  public String toString() {
+    StringBuilder sb = new StringBuilder();
+    sb.append("class MergeConflict {\n");
+    sb.append("    path: ").append(toIndentedString(path)).append("\n");
+    sb.append("    source: ").append(toIndentedString(source)).append("\n");
+    sb.append("    destination: ").append(toIndentedString(destination)).append("\n");
+    sb.append("    base: ").append(toIndentedString(base)).append("\n");
+    sb.append("}");
+    return sb.toString();
+  }
+
+  /**
+   * Convert the given object to string with each line indented by 4 spaces
+   * (except the first line).
+   */
+  private String toIndentedString(Object o) {
+    if (o == null) {
+      return "null";
+    }
+    return o.toString().replace("\n", "\n    ");
+  }
+
+}
+
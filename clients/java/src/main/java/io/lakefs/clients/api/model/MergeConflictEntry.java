@@ -0,0 +1,186 @@
+/*
+ * lakeFS API
+ * lakeFS HTTP API
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+package io.lakefs.clients.api.model;
+
+import java.util.Objects;
+import java.util.Arrays;
+import com.google.gson.TypeAdapter;
+import com.google.gson.annotations.JsonAdapter;
+import com.google.gson.annotations.SerializedName;
+import com.google.gson.stream.JsonReader;
+import com.google.gson.stream.JsonWriter;
+import io.swagger.annotations.ApiModel;
+import io.swagger.annotations.ApiModelProperty;
+import java.io.IOException;
+
+/**
+ * the object found on one side of a merge conflict. Missing if the object does not exist on that side.
+ */
+@ApiModel(description = "the object found on one side of a merge conflict. Missing if the object does not exist on that side.")
+@javax.annotation.Generated(value = "org.openapitools.codegen.languages.JavaClientCodegen")
+public class MergeConflictEntry {
+  public static final String SERIALIZED_NAME_IDENTITY = "identity";
+  @SerializedName(SERIALIZED_NAME_IDENTITY)
+  private String identity;
+
+  public static final String SERIALIZED_NAME_PHYSICAL_ADDRESS = "physical_address";
+  @SerializedName(SERIALIZED_NAME_PHYSICAL_ADDRESS)
+  private String physicalAddress;
+
+  public static final String SERIALIZED_NAME_CHECKSUM = "checksum";
+  @SerializedName(SERIALIZED_NAME_CHECKSUM)
+  private String checksum;
+
+  public static final String SERIALIZED_NAME_SIZE_BYTES = "size_bytes";
+  @SerializedName(SERIALIZED_NAME_SIZE_BYTES)
+  private Long sizeBytes;
+
+
+  public MergeConflictEntry identity(String identity) {
+    
+    this.identity = identity;
+    return this;
+  }
+
+   /**
+   * Get identity
+   * @return identity
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "")
+
+  public String getIdentity() {
+    return identity;
+  }
+
+
+  public void setIdentity(String identity) {
+    this.identity = identity;
+  }
+
+
+  public MergeConflictEntry physicalAddress(String physicalAddress) {
+    
+    this.physicalAddress = physicalAddress;
+    return this;
+  }
+
+   /**
+   * Get physicalAddress
+   * @return physicalAddress
+  **/
+  @javax.annotation.Nullable
+  @ApiModelProperty(value = "")
+
+  public String getPhysicalAddress() {
+    return physicalAddress;
+  }
+
+
+  public void setPhysicalAddress(String physicalAddress) {
+    this.physicalAddress = physicalAddress;
+  }
+
+
+  public MergeConflictEntry checksum(String checksum) {
+    
+    this.checksum = checksum;
+    return this;
+  }
+
+   /**
+   * Get checksum
+   * @return checksum
+  **/
+  @javax.annotation.Nullable
+  @ApiModelProperty(value = "")
+
+  public String getChecksum() {
+    return checksum;
+  }
+
+
+  public void setChecksum(String checksum) {
+    this.checksum = checksum;
+  }
+
+
+  public MergeConflictEntry sizeBytes(Long sizeBytes) {
+    
+    this.sizeBytes = sizeBytes;
+    return this;
+  }
+
+   /**
+   * Get sizeBytes
+   * @return sizeBytes
+  **/
+  @javax.annotation.Nullable
+  @ApiModelProperty(value = "")
+
+  public Long getSizeBytes() {
+    return sizeBytes;
+  }
+
+
+  public void setSizeBytes(Long sizeBytes) {
+    this.sizeBytes = sizeBytes;
+  }
+
+
+  @Override
+  public boolean equals(Object o) {
+    if (this == o) {
+      return true;
+    }
+    if (o == null || getClass() != o.getClass()) {
+      return false;
+    }
+    MergeConflictEntry mergeConflictEntry = (MergeConflictEntry) o;
+    return Objects.equals(this.identity, mergeConflictEntry.identity) &&
+        Objects.equals(this.physicalAddress, mergeConflictEntry.physicalAddress) &&
+        Objects.equals(this.checksum, mergeConflictEntry.checksum) &&
+        Objects.equals(this.sizeBytes, mergeConflictEntry.sizeBytes);
+  }
+
+  @Override
+  public int hashCode() {
+    return Objects.hash(identity, physicalAddress, checksum, sizeBytes);
+  }
+
+  @Override
+  public String toString() {
+    StringBuilder sb = new StringBuilder();
+    sb.append("class MergeConflictEntry {\n");
+    sb.append("    identity: ").append(toIndentedString(identity)).append("\n");
+    sb.append("    physicalAddress: ").append(toIndentedString(physicalAddress)).append("\n");
+    sb.append("    checksum: ").append(toIndentedString(checksum)).append("\n");
+    sb.append("    sizeBytes: ").append(toIndentedString(sizeBytes)).append("\n");
+    sb.append("}");
+    return sb.toString();
+  }
+
+  /**
+   * Convert the given object to string with each line indented by 4 spaces
+   * (except the first line).
+   */
+  private String toIndentedString(Object o) {
+    if (o == null) {
+      return "null";
+    }
+    return o.toString().replace("\n", "\n    ");
+  }
+
+}
+
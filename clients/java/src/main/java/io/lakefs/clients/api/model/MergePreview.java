@@ -0,0 +1,286 @@
+/*
+ * lakeFS API
+ * lakeFS HTTP API
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+package io.lakefs.clients.api.model;
+
+import java.util.Objects;
+import java.util.Arrays;
+import com.google.gson.TypeAdapter;
+import com.google.gson.annotations.JsonAdapter;
+import com.google.gson.annotations.SerializedName;
+import com.google.gson.stream.JsonReader;
+import com.google.gson.stream.JsonWriter;
+import io.lakefs.clients.api.model.MergeConflict;
+import io.lakefs.clients.api.model.MergePreviewSummary;
+import io.swagger.annotations.ApiModel;
+import io.swagger.annotations.ApiModelProperty;
+import java.io.IOException;
+import java.util.ArrayList;
+import java.util.List;
+
+/**
+ * MergePreview
+ */
+@javax.annotation.Generated(value = "org.openapitools.codegen.languages.JavaClientCodegen")
+public class MergePreview {
+  public static final String SERIALIZED_NAME_SOURCE_COMMIT_ID = "source_commit_id";
+  @SerializedName(SERIALIZED_NAME_SOURCE_COMMIT_ID)
+  private String sourceCommitId;
+
+  public static final String SERIALIZED_NAME_DESTINATION_COMMIT_ID = "destination_commit_id";
+  @SerializedName(SERIALIZED_NAME_DESTINATION_COMMIT_ID)
+  private String destinationCommitId;
+
+  public static final String SERIALIZED_NAME_BASE_COMMIT_ID = "base_commit_id";
+  @SerializedName(SERIALIZED_NAME_BASE_COMMIT_ID)
+  private String baseCommitId;
+
+  public static final String SERIALIZED_NAME_FAST_FORWARD = "fast_forward";
+  @SerializedName(SERIALIZED_NAME_FAST_FORWARD)
+  private Boolean fastForward;
+
+  public static final String SERIALIZED_NAME_SUMMARY = "summary";
+  @SerializedName(SERIALIZED_NAME_SUMMARY)
+  private List<MergePreviewSummary> summary = new ArrayList<MergePreviewSummary>();
+
+  public static final String SERIALIZED_NAME_CONFLICTS = "conflicts";
+  @SerializedName(SERIALIZED_NAME_CONFLICTS)
+  private List<MergeConflict> conflicts = new ArrayList<MergeConflict>();
+
+  public static final String SERIALIZED_NAME_CONFLICTS_TRUNCATED = "conflicts_truncated";
+  @SerializedName(SERIALIZED_NAME_CONFLICTS_TRUNCATED)
+  private Boolean conflictsTruncated;
+
+
+  public MergePreview sourceCommitId(String sourceCommitId) {
+    
+    this.sourceCommitId = sourceCommitId;
+    return this;
+  }
+
+   /**
+   * Get sourceCommitId
+   * @return sourceCommitId
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "")
+
+  public String getSourceCommitId() {
+    return sourceCommitId;
+  }
+
+
+  public void setSourceCommitId(String sourceCommitId) {
+    this.sourceCommitId = sourceCommitId;
+  }
+
+
+  public MergePreview destinationCommitId(String destinationCommitId) {
+    
+    this.destinationCommitId = destinationCommitId;
+    return this;
+  }
+
+   /**
+   * Get destinationCommitId
+   * @return destinationCommitId
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "")
+
+  public String getDestinationCommitId() {
+    return destinationCommitId;
+  }
+
+
+  public void setDestinationCommitId(String destinationCommitId) {
+    this.destinationCommitId = destinationCommitId;
+  }
+
+
+  public MergePreview baseCommitId(String baseCommitId) {
+    
+    this.baseCommitId = baseCommitId;
+    return this;
+  }
+
+   /**
+   * Get baseCommitId
+   * @return baseCommitId
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "")
+
+  public String getBaseCommitId() {
+    return baseCommitId;
+  }
+
+
+  public void setBaseCommitId(String baseCommitId) {
+    this.baseCommitId = baseCommitId;
+  }
+
+
+  public MergePreview fastForward(Boolean fastForward) {
+    
+    this.fastForward = fastForward;
+    return this;
+  }
+
+   /**
+   * true if the destination is an ancestor of the source
+   * @return fastForward
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "true if the destination is an ancestor of the source")
+
+  public Boolean getFastForward() {
+    return fastForward;
+  }
+
+
+  public void setFastForward(Boolean fastForward) {
+    this.fastForward = fastForward;
+  }
+
+
+  public MergePreview summary(List<MergePreviewSummary> summary) {
+    
+    this.summary = summary;
+    return this;
+  }
+
+  public MergePreview addSummaryItem(MergePreviewSummary summaryItem) {
+    this.summary.add(summaryItem);
+    return this;
+  }
+
+   /**
+   * changes the merge applies on the destination, by top-level prefix
+   * @return summary
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "changes the merge applies on the destination, by top-level prefix")
+
+  public List<MergePreviewSummary> getSummary() {
+    return summary;
+  }
+
+
+  public void setSummary(List<MergePreviewSummary> summary) {
+    this.summary = summary;
+  }
+
+
+  public MergePreview conflicts(List<MergeConflict> conflicts) {
+    
+    this.conflicts = conflicts;
+    return this;
+  }
+
+  public MergePreview addConflictsItem(MergeConflict conflictsItem) {
+    this.conflicts.add(conflictsItem);
+    return this;
+  }
+
+   /**
+   * Get conflicts
+   * @return conflicts
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "")
+
+  public List<MergeConflict> getConflicts() {
+    return conflicts;
+  }
+
+
+  public void setConflicts(List<MergeConflict> conflicts) {
+    this.conflicts = conflicts;
+  }
+
+
+  public MergePreview conflictsTruncated(Boolean conflictsTruncated) {
+    
+    this.conflictsTruncated = conflictsTruncated;
+    return this;
+  }
+
+   /**
+   * true if there may be more conflicts than the ones listed
+   * @return conflictsTruncated
+  **/
+  @javax.annotation.Nullable
+  @ApiModelProperty(value = "true if there may be more conflicts than the ones listed")
+
+  public Boolean getConflictsTruncated() {
+    return conflictsTruncated;
+  }
+
+
+  public void setConflictsTruncated(Boolean conflictsTruncated) {
+    this.conflictsTruncated = conflictsTruncated;
+  }
+
+
+  @Override
+  public boolean equals(Object o) {
+    if (this == o) {
+      return true;
+    }
+    if (o == null || getClass() != o.getClass()) {
+      return false;
+    }
+    MergePreview mergePreview = (MergePreview) o;
+    return Objects.equals(this.sourceCommitId, mergePreview.sourceCommitId) &&
+        Objects.equals(this.destinationCommitId, mergePreview.destinationCommitId) &&
+        Objects.equals(this.baseCommitId, mergePreview.baseCommitId) &&
+        Objects.equals(this.fastForward, mergePreview.fastForward) &&
+        Objects.equals(this.summary, mergePreview.summary) &&
+        Objects.equals(this.conflicts, mergePreview.conflicts) &&
+        Objects.equals(this.conflictsTruncated, mergePreview.conflictsTruncated);
+  }
+
+  @Override
+  public int hashCode() {
+    return Objects.hash(sourceCommitId, destinationCommitId, baseCommitId, fastForward, summary, conflicts, conflictsTruncated);
+  }
+
+  @Override
+  public String toString() {
+    StringBuilder sb = new StringBuilder();
+    sb.append("class MergePreview {\n");
+    sb.append("    sourceCommitId: ").append(toIndentedString(sourceCommitId)).append("\n");
+    sb.append("    destinationCommitId: ").append(toIndentedString(destinationCommitId)).append("\n");
+    sb.append("    baseCommitId: ").append(toIndentedString(baseCommitId)).append("\n");
+    sb.append("    fastForward: ").append(toIndentedString(fastForward)).append("\n");
+    sb.append("    summary: ").append(toIndentedString(summary)).append("\n");
+    sb.append("    conflicts: ").append(toIndentedString(conflicts)).append("\n");
+    sb.append("    conflictsTruncated: ").append(toIndentedString(conflictsTruncated)).append("\n");
+    sb.append("}");
+    return sb.toString();
+  }
+
+  /**
+   * Convert the given object to string with each line indented by 4 spaces
+   * (except the first line).
+   */
+  private String toIndentedString(Object o) {
+    if (o == null) {
+      return "null";
+    }
+    return o.toString().replace("\n", "\n    ");
+  }
+
+}
+
@@ -20,10 +20,13 @@ import com.google.gson.annotations.JsonAdapter;
 import com.google.gson.annotations.SerializedName;
 import com.google.gson.stream.JsonReader;
 import com.google.gson.stream.JsonWriter;
+import io.lakefs.clients.api.model.MergeConflict;
 import io.lakefs.clients.api.model.MergeResultSummary;
 import io.swagger.annotations.ApiModel;
 import io.swagger.annotations.ApiModelProperty;
 import java.io.IOException;
+import java.util.ArrayList;
+import java.util.List;
 
 /**
  * MergeResult
@@ -38,6 +41,14 @@ public class MergeResult {
   @SerializedName(SERIALIZED_NAME_REFERENCE)
   private String reference;
 
+  public static final String SERIALIZED_NAME_CONFLICTS = "conflicts";
+  @SerializedName(SERIALIZED_NAME_CONFLICTS)
+  private List<MergeConflict> conflicts = null;
+
+  public static final String SERIALIZED_NAME_CONFLICTS_TRUNCATED = "conflicts_truncated";
+  @SerializedName(SERIALIZED_NAME_CONFLICTS_TRUNCATED)
+  private Boolean conflictsTruncated;
+
 
   public MergeResult summary(MergeResultSummary summary) {
     
@@ -85,6 +96,60 @@ public class MergeResult {
   }
 
 
+  public MergeResult conflicts(List<MergeConflict> conflicts) {
+    
+    this.conflicts = conflicts;
+    return this;
+  }
+
+  public MergeResult addConflictsItem(MergeConflict conflictsItem) {
+    if (this.conflicts == null) {
+      this.conflicts = new ArrayList<MergeConflict>();
+    }
+    this.conflicts.add(conflictsItem);
+    return this;
+  }
+
+   /**
+   * paths that could not be merged, returned when the merge failed due to conflicts
+   * @return conflicts
+  **/
+  @javax.annotation.Nullable
+  @ApiModelProperty(value = "paths that could not be merged, returned when the merge failed due to conflicts")
+
+  public List<MergeConflict> getConflicts() {
+    return conflicts;
+  }
+
+
+  public void setConflicts(List<MergeConflict> conflicts) {
+    this.conflicts = conflicts;
+  }
+
+
+  public MergeResult conflictsTruncated(Boolean conflictsTruncated) {
+    
+    this.conflictsTruncated = conflictsTruncated;
+    return this;
+  }
+
+   /**
+   * true if there may be more conflicts than the ones listed
+   * @return conflictsTruncated
+  **/
+  @javax.annotation.Nullable
+  @ApiModelProperty(value = "true if there may be more conflicts than the ones listed")
+
+  public Boolean getConflictsTruncated() {
+    return conflictsTruncated;
+  }
+
+
+  public void setConflictsTruncated(Boolean conflictsTruncated) {
+    this.conflictsTruncated = conflictsTruncated;
+  }
+
+
   @Override
   public boolean equals(Object o) {
     if (this == o) {
@@ -95,12 +160,14 @@ public class MergeResult {
     }
     MergeResult mergeResult = (MergeResult) o;
     return Objects.equals(this.summary, mergeResult.summary) &&
-        Objects.equals(this.reference, mergeResult.reference);
+        Objects.equals(this.reference, mergeResult.reference) &&
+        Objects.equals(this.conflicts, mergeResult.conflicts) &&
+        Objects.equals(this.conflictsTruncated, mergeResult.conflictsTruncated);
   }
 
   @Override
   public int hashCode() {
-    return Objects.hash(summary, reference);
+    return Objects.hash(summary, reference, conflicts, conflictsTruncated);
   }
 
   @Override
@@ -109,6 +176,8 @@ public class MergeResult {
     sb.append("class MergeResult {\n");
     sb.append("    summary: ").append(toIndentedString(summary)).append("\n");
     sb.append("    reference: ").append(toIndentedString(reference)).append("\n");
+    sb.append("    conflicts: ").append(toIndentedString(conflicts)).append("\n");
+    sb.append("    conflictsTruncated: ").append(toIndentedString(conflictsTruncated)).append("\n");
     sb.append("}");
     return sb.toString();
   }
@@ -0,0 +1,174 @@
+/*
+ * lakeFS API
+ * lakeFS HTTP API
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+package io.lakefs.clients.api.model;
+
+import java.util.Objects;
+import java.util.Arrays;
+import com.google.gson.TypeAdapter;
+import com.google.gson.annotations.JsonAdapter;
+import com.google.gson.annotations.SerializedName;
+import com.google.gson.stream.JsonReader;
+import com.google.gson.stream.JsonWriter;
+import io.swagger.annotations.ApiModel;
+import io.swagger.annotations.ApiModelProperty;
+import java.io.IOException;
+
+/**
+ * MergeStrategyRule
+ */
+@javax.annotation.Generated(value = "org.openapitools.codegen.languages.JavaClientCodegen")
+public class MergeStrategyRule {
+  public static final String SERIALIZED_NAME_PATTERN = "pattern";
+  @SerializedName(SERIALIZED_NAME_PATTERN)
+  private String pattern;
+
+  /**
+   * Gets or Sets strategy
+   */
+  @JsonAdapter(StrategyEnum.Adapter.class)
+  public enum StrategyEnum {
+    DEST_WINS("dest-wins"),
+    
+    SOURCE_WINS("source-wins");
+
+    private String value;
+
+    StrategyEnum(String value) {
+      this.value = value;
+    }
+
+    public String getValue() {
+      return value;
+    }
+
+    @Override
+    public String toString() {
+      return String.valueOf(value);
+    }
+
+    public static StrategyEnum fromValue(String value) {
+      for (StrategyEnum b : StrategyEnum.values()) {
+        if (b.value.equals(value)) {
+          return b;
+        }
+      }
+      throw new IllegalArgumentException("Unexpected value '" + value + "'");
+    }
+
+    public static class Adapter extends TypeAdapter<StrategyEnum> {
+      @Override
+      public void write(final JsonWriter jsonWriter, final StrategyEnum enumeration) throws IOException {
+        jsonWriter.value(enumeration.getValue());
+      }
+
+      @Override
+      public StrategyEnum read(final JsonReader jsonReader) throws IOException {
+        String value =  jsonReader.nextString();
+        return StrategyEnum.fromValue(value);
+      }
+    }
+  }
+
+  public static final String SERIALIZED_NAME_STRATEGY = "strategy";
+  @SerializedName(SERIALIZED_NAME_STRATEGY)
+  private StrategyEnum strategy;
+
+
+  public MergeStrategyRule pattern(String pattern) {
+    
+    this.pattern = pattern;
+    return this;
+  }
+
+   /**
+   * path prefix, or a glob pattern when it contains any of &#39;*&#39;, &#39;?&#39;, &#39;[&#39; or &#39;{&#39; (&#39;*&#39; does not match &#39;/&#39;, &#39;**&#39; does)
+   * @return pattern
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(example = "raw/", required = true, value = "path prefix, or a glob pattern when it contains any of '*', '?', '[' or '{' ('*' does not match '/', '**' does)")
+
+  public String getPattern() {
+    return pattern;
+  }
+
+
+  public void setPattern(String pattern) {
+    this.pattern = pattern;
+  }
+
+
+  public MergeStrategyRule strategy(StrategyEnum strategy) {
+    
+    this.strategy = strategy;
+    return this;
+  }
+
+   /**
+   * strategy used to resolve conflicts on matching paths
+   * @return strategy
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "strategy used to resolve conflicts on matching paths")
+
+  public StrategyEnum getStrategy() {
+    return strategy;
+  }
+
+
+  public void setStrategy(StrategyEnum strategy) {
+    this.strategy = strategy;
+  }
+
+
+  @Override
+  public boolean equals(Object o) {
+    if (this == o) {
+      return true;
+    }
+    if (o == null || getClass() != o.getClass()) {
+      return false;
+    }
+    MergeStrategyRule mergeStrategyRule = (MergeStrategyRule) o;
+    return Objects.equals(this.pattern, mergeStrategyRule.pattern) &&
+        Objects.equals(this.strategy, mergeStrategyRule.strategy);
+  }
+
+  @Override
+  public int hashCode() {
+    return Objects.hash(pattern, strategy);
+  }
+
+  @Override
+  public String toString() {
+    StringBuilder sb = new StringBuilder();
+    sb.append("class MergeStrategyRule {\n");
+    sb.append("    pattern: ").append(toIndentedString(pattern)).append("\n");
+    sb.append("    strategy: ").append(toIndentedString(strategy)).append("\n");
+    sb.append("}");
+    return sb.toString();
+  }
+
+  /**
+   * Convert the given object to string with each line indented by 4 spaces
+   * (except the first line).
+   */
+  private String toIndentedString(Object o) {
+    if (o == null) {
+      return "null";
+    }
+    return o.toString().replace("\n", "\n    ");
+  }
+
+}
+
@@ -0,0 +1,106 @@
+/*
+ * lakeFS API
+ * lakeFS HTTP API
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+package io.lakefs.clients.api.model;
+
+import java.util.Objects;
+import java.util.Arrays;
+import com.google.gson.TypeAdapter;
+import com.google.gson.annotations.JsonAdapter;
+import com.google.gson.annotations.SerializedName;
+import com.google.gson.stream.JsonReader;
+import com.google.gson.stream.JsonWriter;
+import io.lakefs.clients.api.model.MergeStrategyRule;
+import io.swagger.annotations.ApiModel;
+import io.swagger.annotations.ApiModelProperty;
+import java.io.IOException;
+import java.util.ArrayList;
+import java.util.List;
+
+/**
+ * MergeStrategyRules
+ */
+@javax.annotation.Generated(value = "org.openapitools.codegen.languages.JavaClientCodegen")
+public class MergeStrategyRules {
+  public static final String SERIALIZED_NAME_RULES = "rules";
+  @SerializedName(SERIALIZED_NAME_RULES)
+  private List<MergeStrategyRule> rules = new ArrayList<MergeStrategyRule>();
+
+
+  public MergeStrategyRules rules(List<MergeStrategyRule> rules) {
+    
+    this.rules = rules;
+    return this;
+  }
+
+  public MergeStrategyRules addRulesItem(MergeStrategyRule rulesItem) {
+    this.rules.add(rulesItem);
+    return this;
+  }
+
+   /**
+   * rules in the order they are applied, the first rule matching a path selects its strategy
+   * @return rules
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "rules in the order they are applied, the first rule matching a path selects its strategy")
+
+  public List<MergeStrategyRule> getRules() {
+    return rules;
+  }
+
+
+  public void setRules(List<MergeStrategyRule> rules) {
+    this.rules = rules;
+  }
+
+
+  @Override
+  public boolean equals(Object o) {
+    if (this == o) {
+      return true;
+    }
+    if (o == null || getClass() != o.getClass()) {
+      return false;
+    }
+    MergeStrategyRules mergeStrategyRules = (MergeStrategyRules) o;
+    return Objects.equals(this.rules, mergeStrategyRules.rules);
+  }
+
+  @Override
+  public int hashCode() {
+    return Objects.hash(rules);
+  }
+
+  @Override
+  public String toString() {
+    StringBuilder sb = new StringBuilder();
+    sb.append("class MergeStrategyRules {\n");
+    sb.append("    rules: ").append(toIndentedString(rules)).append("\n");
+    sb.append("}");
+    return sb.toString();
+  }
+
+  /**
+   * Convert the given object to string with each line indented by 4 spaces
+   * (except the first line).
+   */
+  private String toIndentedString(Object o) {
+    if (o == null) {
+      return "null";
+    }
+    return o.toString().replace("\n", "\n    ");
+  }
+
+}
+
@@ -0,0 +1,321 @@
+/*
+ * lakeFS API
+ * lakeFS HTTP API
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+package io.lakefs.clients.api.model;
+
+import java.util.Objects;
+import java.util.Arrays;
+import com.google.gson.TypeAdapter;
+import com.google.gson.annotations.JsonAdapter;
+import com.google.gson.annotations.SerializedName;
+import com.google.gson.stream.JsonReader;
+import com.google.gson.stream.JsonWriter;
+import io.swagger.annotations.ApiModel;
+import io.swagger.annotations.ApiModelProperty;
+import java.io.IOException;
+
+/**
+ * ObjectVersion
+ */
+@javax.annotation.Generated(value = "org.openapitools.codegen.languages.JavaClientCodegen")
+public class ObjectVersion {
+  public static final String SERIALIZED_NAME_COMMIT_ID = "commit_id";
+  @SerializedName(SERIALIZED_NAME_COMMIT_ID)
+  private String commitId;
+
+  public static final String SERIALIZED_NAME_CREATION_DATE = "creation_date";
+  @SerializedName(SERIALIZED_NAME_CREATION_DATE)
+  private Long creationDate;
+
+  public static final String SERIALIZED_NAME_COMMITTER = "committer";
+  @SerializedName(SERIALIZED_NAME_COMMITTER)
+  private String committer;
+
+  /**
+   * Gets or Sets type
+   */
+  @JsonAdapter(TypeEnum.Adapter.class)
+  public enum TypeEnum {
+    ADDED("added"),
+    
+    REMOVED("removed"),
+    
+    CHANGED("changed");
+
+    private String value;
+
+    TypeEnum(String value) {
+      this.value = value;
+    }
+
+    public String getValue() {
+      return value;
+    }
+
+    @Override
+    public String toString() {
+      return String.valueOf(value);
+    }
+
+    public static TypeEnum fromValue(String value) {
+      for (TypeEnum b : TypeEnum.values()) {
+        if (b.value.equals(value)) {
+          return b;
+        }
+      }
+      throw new IllegalArgumentException("Unexpected value '" + value + "'");
+    }
+
+    public static class Adapter extends TypeAdapter<TypeEnum> {
+      @Override
+      public void write(final JsonWriter jsonWriter, final TypeEnum enumeration) throws IOException {
+        jsonWriter.value(enumeration.getValue());
+      }
+
+      @Override
+      public TypeEnum read(final JsonReader jsonReader) throws IOException {
+        String value =  jsonReader.nextString();
+        return TypeEnum.fromValue(value);
+      }
+    }
+  }
+
+  public static final String SERIALIZED_NAME_TYPE = "type";
+  @SerializedName(SERIALIZED_NAME_TYPE)
+  private TypeEnum type;
+
+  public static final String SERIALIZED_NAME_PHYSICAL_ADDRESS = "physical_address";
+  @SerializedName(SERIALIZED_NAME_PHYSICAL_ADDRESS)
+  private String physicalAddress;
+
+  public static final String SERIALIZED_NAME_CHECKSUM = "checksum";
+  @SerializedName(SERIALIZED_NAME_CHECKSUM)
+  private String checksum;
+
+  public static final String SERIALIZED_NAME_SIZE_BYTES = "size_bytes";
+  @SerializedName(SERIALIZED_NAME_SIZE_BYTES)
+  private Long sizeBytes;
+
+
+  public ObjectVersion commitId(String commitId) {
+    
+    this.commitId = commitId;
+    return this;
+  }
+
+   /**
+   * Get commitId
+   * @return commitId
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "")
+
+  public String getCommitId() {
+    return commitId;
+  }
+
+
+  public void setCommitId(String commitId) {
+    this.commitId = commitId;
+  }
+
+
+  public ObjectVersion creationDate(Long creationDate) {
+    
+    this.creationDate = creationDate;
+    return this;
+  }
+
+   /**
+   * Unix Epoch in seconds
+   * @return creationDate
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "Unix Epoch in seconds")
+
+  public Long getCreationDate() {
+    return creationDate;
+  }
+
+
+  public void setCreationDate(Long creationDate) {
+    this.creationDate = creationDate;
+  }
+
+
+  public ObjectVersion committer(String committer) {
+    
+    this.committer = committer;
+    return this;
+  }
+
+   /**
+   * Get committer
+   * @return committer
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "")
+
+  public String getCommitter() {
+    return committer;
+  }
+
+
+  public void setCommitter(String committer) {
+    this.committer = committer;
+  }
+
+
+  public ObjectVersion type(TypeEnum type) {
+    
+    this.type = type;
+    return this;
+  }
+
+   /**
+   * Get type
+   * @return type
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "")
+
+  public TypeEnum getType() {
+    return type;
+  }
+
+
+  public void setType(TypeEnum type) {
+    this.type = type;
+  }
+
+
+  public ObjectVersion physicalAddress(String physicalAddress) {
+    
+    this.physicalAddress = physicalAddress;
+    return this;
+  }
+
+   /**
+   * not set when the commit removed the object
+   * @return physicalAddress
+  **/
+  @javax.annotation.Nullable
+  @ApiModelProperty(value = "not set when the commit removed the object")
+
+  public String getPhysicalAddress() {
+    return physicalAddress;
+  }
+
+
+  public void setPhysicalAddress(String physicalAddress) {
+    this.physicalAddress = physicalAddress;
+  }
+
+
+  public ObjectVersion checksum(String checksum) {
+    
+    this.checksum = checksum;
+    return this;
+  }
+
+   /**
+   * Get checksum
+   * @return checksum
+  **/
+  @javax.annotation.Nullable
+  @ApiModelProperty(value = "")
+
+  public String getChecksum() {
+    return checksum;
+  }
+
+
+  public void setChecksum(String checksum) {
+    this.checksum = checksum;
+  }
+
+
+  public ObjectVersion sizeBytes(Long sizeBytes) {
+    
+    this.sizeBytes = sizeBytes;
+    return this;
+  }
+
+   /**
+   * Get sizeBytes
+   * @return sizeBytes
+  **/
+  @javax.annotation.Nullable
+  @ApiModelProperty(value = "")
+
+  public Long getSizeBytes() {
+    return sizeBytes;
+  }
+
+
+  public void setSizeBytes(Long sizeBytes) {
+    this.sizeBytes = sizeBytes;
+  }
+
+
+  @Override
+  public boolean equals(Object o) {
+    if (this == o) {
+      return true;
+    }
+    if (o == null || getClass() != o.getClass()) {
+      return false;
+    }
+    ObjectVersion objectVersion = (ObjectVersion) o;
+    return Objects.equals(this.commitId, objectVersion.commitId) &&
+        Objects.equals(this.creationDate, objectVersion.creationDate) &&
+        Objects.equals(this.committer, objectVersion.committer) &&
+        Objects.equals(this.type, objectVersion.type) &&
+        Objects.equals(this.physicalAddress, objectVersion.physicalAddress) &&
+        Objects.equals(this.checksum, objectVersion.checksum) &&
+        Objects.equals(this.sizeBytes, objectVersion.sizeBytes);
+  }
+
+  @Override
+  public int hashCode() {
+    return Objects.hash(commitId, creationDate, committer, type, physicalAddress, checksum, sizeBytes);
+  }
+
+  @Override
+  public String toString() {
+    StringBuilder sb = new StringBuilder();
+    sb.append("class ObjectVersion {\n");
+    sb.append("    commitId: ").append(toIndentedString(commitId)).append("\n");
+    sb.append("    creationDate: ").append(toIndentedString(creationDate)).append("\n");
+    sb.append("    committer: ").append(toIndentedString(committer)).append("\n");
+    sb.append("    type: ").append(toIndentedString(type)).append("\n");
+    sb.append("    physicalAddress: ").append(toIndentedString(physicalAddress)).append("\n");
+    sb.append("    checksum: ").append(toIndentedString(checksum)).append("\n");
+    sb.append("    sizeBytes: ").append(toIndentedString(sizeBytes)).append("\n");
+    sb.append("}");
+    return sb.toString();
+  }
+
+  /**
+   * Convert the given object to string with each line indented by 4 spaces
+   * (except the first line).
+   */
+  private String toIndentedString(Object o) {
+    if (o == null) {
+      return "null";
+    }
+    return o.toString().replace("\n", "\n    ");
+  }
+
+}
+
@@ -0,0 +1,272 @@
+/*
+ * lakeFS API
+ * lakeFS HTTP API
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+package io.lakefs.clients.api.model;
+
+import java.util.Objects;
+import java.util.Arrays;
+import com.google.gson.TypeAdapter;
+import com.google.gson.annotations.JsonAdapter;
+import com.google.gson.annotations.SerializedName;
+import com.google.gson.stream.JsonReader;
+import com.google.gson.stream.JsonWriter;
+import io.swagger.annotations.ApiModel;
+import io.swagger.annotations.ApiModelProperty;
+import java.io.IOException;
+
+/**
+ * PrefixDiffSummary
+ */
+@javax.annotation.Generated(value = "org.openapitools.codegen.languages.JavaClientCodegen")
+public class PrefixDiffSummary {
+  public static final String SERIALIZED_NAME_PREFIX = "prefix";
+  @SerializedName(SERIALIZED_NAME_PREFIX)
+  private String prefix;
+
+  public static final String SERIALIZED_NAME_ADDED = "added";
+  @SerializedName(SERIALIZED_NAME_ADDED)
+  private Integer added;
+
+  public static final String SERIALIZED_NAME_REMOVED = "removed";
+  @SerializedName(SERIALIZED_NAME_REMOVED)
+  private Integer removed;
+
+  public static final String SERIALIZED_NAME_CHANGED = "changed";
+  @SerializedName(SERIALIZED_NAME_CHANGED)
+  private Integer changed;
+
+  public static final String SERIALIZED_NAME_ADDED_BYTES = "added_bytes";
+  @SerializedName(SERIALIZED_NAME_ADDED_BYTES)
+  private Long addedBytes;
+
+  public static final String SERIALIZED_NAME_REMOVED_BYTES = "removed_bytes";
+  @SerializedName(SERIALIZED_NAME_REMOVED_BYTES)
+  private Long removedBytes;
+
+  public static final String SERIALIZED_NAME_CHANGED_BYTES = "changed_bytes";
+  @SerializedName(SERIALIZED_NAME_CHANGED_BYTES)
+  private Long changedBytes;
+
+
+  public PrefixDiffSummary prefix(String prefix) {
+    
+    this.prefix = prefix;
+    return this;
+  }
+
+   /**
+   * Get prefix
+   * @return prefix
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "")
+
+  public String getPrefix() {
+    return prefix;
+  }
+
+
+  public void setPrefix(String prefix) {
+    this.prefix = prefix;
+  }
+
+
+  public PrefixDiffSummary added(Integer added) {
+    
+    this.added = added;
+    return this;
+  }
+
+   /**
+   * Get added
+   * @return added
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "")
+
+  public Integer getAdded() {
+    return added;
+  }
+
+
+  public void setAdded(Integer added) {
+    this.added = added;
+  }
+
+
+  public PrefixDiffSummary removed(Integer removed) {
+    
+    this.removed = removed;
+    return this;
+  }
+
+   /**
+   * Get removed
+   * @return removed
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "")
+
+  public Integer getRemoved() {
+    return removed;
+  }
+
+
+  public void setRemoved(Integer removed) {
+    this.removed = removed;
+  }
+
+
+  public PrefixDiffSummary changed(Integer changed) {
+    
+    this.changed = changed;
+    return this;
+  }
+
+   /**
+   * Get changed
+   * @return changed
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "")
+
+  public Integer getChanged() {
+    return changed;
+  }
+
+
+  public void setChanged(Integer changed) {
+    this.changed = changed;
+  }
+
+
+  public PrefixDiffSummary addedBytes(Long addedBytes) {
+    
+    this.addedBytes = addedBytes;
+    return this;
+  }
+
+   /**
+   * total size of the added objects
+   * @return addedBytes
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "total size of the added objects")
+
+  public Long getAddedBytes() {
+    return addedBytes;
+  }
+
+
+  public void setAddedBytes(Long addedBytes) {
+    this.addedBytes = addedBytes;
+  }
+
+
+  public PrefixDiffSummary removedBytes(Long removedBytes) {
+    
+    this.removedBytes = removedBytes;
+    return this;
+  }
+
+   /**
+   * total size of the removed objects
+   * @return removedBytes
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "total size of the removed objects")
+
+  public Long getRemovedBytes() {
+    return removedBytes;
+  }
+
+
+  public void setRemovedBytes(Long removedBytes) {
+    this.removedBytes = removedBytes;
+  }
+
+
+  public PrefixDiffSummary changedBytes(Long changedBytes) {
+    
+    this.changedBytes = changedBytes;
+    return this;
+  }
+
+   /**
+   * size difference of the changed objects, negative when they shrank
+   * @return changedBytes
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "size difference of the changed objects, negative when they shrank")
+
+  public Long getChangedBytes() {
+    return changedBytes;
+  }
+
+
+  public void setChangedBytes(Long changedBytes) {
+    this.changedBytes = changedBytes;
+  }
+
+
+  @Override
+  public boolean equals(Object o) {
+    if (this == o) {
+      return true;
+    }
+    if (o == null || getClass() != o.getClass()) {
+      return false;
+    }
+    PrefixDiffSummary prefixDiffSummary = (PrefixDiffSummary) o;
+    return Objects.equals(this.prefix, prefixDiffSummary.prefix) &&
+        Objects.equals(this.added, prefixDiffSummary.added) &&
+        Objects.equals(this.removed, prefixDiffSummary.removed) &&
+        Objects.equals(this.changed, prefixDiffSummary.changed) &&
+        Objects.equals(this.addedBytes, prefixDiffSummary.addedBytes) &&
+        Objects.equals(this.removedBytes, prefixDiffSummary.removedBytes) &&
+        Objects.equals(this.changedBytes, prefixDiffSummary.changedBytes);
+  }
+
+  @Override
+  public int hashCode() {
+    return Objects.hash(prefix, added, removed, changed, addedBytes, removedBytes, changedBytes);
+  }
+
+  @Override
+  public String toString() {
+    StringBuilder sb = new StringBuilder();
+    sb.append("class PrefixDiffSummary {\n");
+    sb.append("    prefix: ").append(toIndentedString(prefix)).append("\n");
+    sb.append("    added: ").append(toIndentedString(added)).append("\n");
+    sb.append("    removed: ").append(toIndentedString(removed)).append("\n");
+    sb.append("    changed: ").append(toIndentedString(changed)).append("\n");
+    sb.append("    addedBytes: ").append(toIndentedString(addedBytes)).append("\n");
+    sb.append("    removedBytes: ").append(toIndentedString(removedBytes)).append("\n");
+    sb.append("    changedBytes: ").append(toIndentedString(changedBytes)).append("\n");
+    sb.append("}");
+    return sb.toString();
+  }
+
+  /**
+   * Convert the given object to string with each line indented by 4 spaces
+   * (except the first line).
+   */
+  private String toIndentedString(Object o) {
+    if (o == null) {
+      return "null";
+    }
+    return o.toString().replace("\n", "\n    ");
+  }
+
+}
+
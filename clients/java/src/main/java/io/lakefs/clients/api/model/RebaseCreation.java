@@ -0,0 +1,98 @@
+/*
+ * lakeFS API
+ * lakeFS HTTP API
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+package io.lakefs.clients.api.model;
+
+import java.util.Objects;
+import java.util.Arrays;
+import com.google.gson.TypeAdapter;
+import com.google.gson.annotations.JsonAdapter;
+import com.google.gson.annotations.SerializedName;
+import com.google.gson.stream.JsonReader;
+import com.google.gson.stream.JsonWriter;
+import io.swagger.annotations.ApiModel;
+import io.swagger.annotations.ApiModelProperty;
+import java.io.IOException;
+
+/**
+ * RebaseCreation
+ */
+@javax.annotation.Generated(value = "org.openapitools.codegen.languages.JavaClientCodegen")
+public class RebaseCreation {
+  public static final String SERIALIZED_NAME_ONTO = "onto";
+  @SerializedName(SERIALIZED_NAME_ONTO)
+  private String onto;
+
+
+  public RebaseCreation onto(String onto) {
+    
+    this.onto = onto;
+    return this;
+  }
+
+   /**
+   * the ref to replay the commits of the branch on
+   * @return onto
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "the ref to replay the commits of the branch on")
+
+  public String getOnto() {
+    return onto;
+  }
+
+
+  public void setOnto(String onto) {
+    this.onto = onto;
+  }
+
+
+  @Override
+  public boolean equals(Object o) {
+    if (this == o) {
+      return true;
+    }
+    if (o == null || getClass() != o.getClass()) {
+      return false;
+    }
+    RebaseCreation rebaseCreation = (RebaseCreation) o;
+    return Objects.equals(this.onto, rebaseCreation.onto);
+  }
+
+  @Override
+  public int hashCode() {
+    return Objects.hash(onto);
+  }
+
+  @Override
+  public String toString() {
+    StringBuilder sb = new StringBuilder();
+    sb.append("class RebaseCreation {\n");
+    sb.append("    onto: ").append(toIndentedString(onto)).append("\n");
+    sb.append("}");
+    return sb.toString();
+  }
+
+  /**
+   * Convert the given object to string with each line indented by 4 spaces
+   * (except the first line).
+   */
+  private String toIndentedString(Object o) {
+    if (o == null) {
+      return "null";
+    }
+    return o.toString().replace("\n", "\n    ");
+  }
+
+}
+
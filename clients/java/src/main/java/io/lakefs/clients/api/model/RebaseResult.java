@@ -0,0 +1,196 @@
+/*
+ * lakeFS API
+ * lakeFS HTTP API
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+package io.lakefs.clients.api.model;
+
+import java.util.Objects;
+import java.util.Arrays;
+import com.google.gson.TypeAdapter;
+import com.google.gson.annotations.JsonAdapter;
+import com.google.gson.annotations.SerializedName;
+import com.google.gson.stream.JsonReader;
+import com.google.gson.stream.JsonWriter;
+import io.lakefs.clients.api.model.MergeConflict;
+import io.swagger.annotations.ApiModel;
+import io.swagger.annotations.ApiModelProperty;
+import java.io.IOException;
+import java.util.ArrayList;
+import java.util.List;
+
+/**
+ * RebaseResult
+ */
+@javax.annotation.Generated(value = "org.openapitools.codegen.languages.JavaClientCodegen")
+public class RebaseResult {
+  public static final String SERIALIZED_NAME_REFERENCE = "reference";
+  @SerializedName(SERIALIZED_NAME_REFERENCE)
+  private String reference;
+
+  public static final String SERIALIZED_NAME_CONFLICT_COMMIT_ID = "conflict_commit_id";
+  @SerializedName(SERIALIZED_NAME_CONFLICT_COMMIT_ID)
+  private String conflictCommitId;
+
+  public static final String SERIALIZED_NAME_CONFLICTS = "conflicts";
+  @SerializedName(SERIALIZED_NAME_CONFLICTS)
+  private List<MergeConflict> conflicts = null;
+
+  public static final String SERIALIZED_NAME_CONFLICTS_TRUNCATED = "conflicts_truncated";
+  @SerializedName(SERIALIZED_NAME_CONFLICTS_TRUNCATED)
+  private Boolean conflictsTruncated;
+
+
+  public RebaseResult reference(String reference) {
+    
+    this.reference = reference;
+    return this;
+  }
+
+   /**
+   * the commit the branch points to after the rebase, empty when the rebase failed
+   * @return reference
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "the commit the branch points to after the rebase, empty when the rebase failed")
+
+  public String getReference() {
+    return reference;
+  }
+
+
+  public void setReference(String reference) {
+    this.reference = reference;
+  }
+
+
+  public RebaseResult conflictCommitId(String conflictCommitId) {
+    
+    this.conflictCommitId = conflictCommitId;
+    return this;
+  }
+
+   /**
+   * the commit whose changes conflict with the new base, returned when the rebase failed due to conflicts
+   * @return conflictCommitId
+  **/
+  @javax.annotation.Nullable
+  @ApiModelProperty(value = "the commit whose changes conflict with the new base, returned when the rebase failed due to conflicts")
+
+  public String getConflictCommitId() {
+    return conflictCommitId;
+  }
+
+
+  public void setConflictCommitId(String conflictCommitId) {
+    this.conflictCommitId = conflictCommitId;
+  }
+
+
+  public RebaseResult conflicts(List<MergeConflict> conflicts) {
+    
+    this.conflicts = conflicts;
+    return this;
+  }
+
+  public RebaseResult addConflictsItem(MergeConflict conflictsItem) {
+    if (this.conflicts == null) {
+      this.conflicts = new ArrayList<MergeConflict>();
+    }
+    this.conflicts.add(conflictsItem);
+    return this;
+  }
+
+   /**
+   * paths that could not be replayed, returned when the rebase failed due to conflicts
+   * @return conflicts
+  **/
+  @javax.annotation.Nullable
+  @ApiModelProperty(value = "paths that could not be replayed, returned when the rebase failed due to conflicts")
+
+  public List<MergeConflict> getConflicts() {
+    return conflicts;
+  }
+
+
+  public void setConflicts(List<MergeConflict> conflicts) {
+    this.conflicts = conflicts;
+  }
+
+
+  public RebaseResult conflictsTruncated(Boolean conflictsTruncated) {
+    
+    this.conflictsTruncated = conflictsTruncated;
+    return this;
+  }
+
+   /**
+   * true if there may be more conflicts than the ones listed
+   * @return conflictsTruncated
+  **/
+  @javax.annotation.Nullable
+  @ApiModelProperty(value = "true if there may be more conflicts than the ones listed")
+
+  public Boolean getConflictsTruncated() {
+    return conflictsTruncated;
+  }
+
+
+  public void setConflictsTruncated(Boolean conflictsTruncated) {
+    this.conflictsTruncated = conflictsTruncated;
+  }
+
+
+  @Override
+  public boolean equals(Object o) {
+    if (this == o) {
+      return true;
+    }
+    if (o == null || getClass() != o.getClass()) {
+      return false;
+    }
+    RebaseResult rebaseResult = (RebaseResult) o;
+    return Objects.equals(this.reference, rebaseResult.reference) &&
+        Objects.equals(this.conflictCommitId, rebaseResult.conflictCommitId) &&
+        Objects.equals(this.conflicts, rebaseResult.conflicts) &&
+        Objects.equals(this.conflictsTruncated, rebaseResult.conflictsTruncated);
+  }
+
+  @Override
+  public int hashCode() {
+    return Objects.hash(reference, conflictCommitId, conflicts, conflictsTruncated);
+  }
+
+  @Override
+  public String toString() {
+    StringBuilder sb = new StringBuilder();
+    sb.append("class RebaseResult {\n");
+    sb.append("    reference: ").append(toIndentedString(reference)).append("\n");
+    sb.append("    conflictCommitId: ").append(toIndentedString(conflictCommitId)).append("\n");
+    sb.append("    conflicts: ").append(toIndentedString(conflicts)).append("\n");
+    sb.append("    conflictsTruncated: ").append(toIndentedString(conflictsTruncated)).append("\n");
+    sb.append("}");
+    return sb.toString();
+  }
+
+  /**
+   * Convert the given object to string with each line indented by 4 spaces
+   * (except the first line).
+   */
+  private String toIndentedString(Object o) {
+    if (o == null) {
+      return "null";
+    }
+    return o.toString().replace("\n", "\n    ");
+  }
+
+}
+
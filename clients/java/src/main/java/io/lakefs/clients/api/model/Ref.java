@@ -37,6 +37,14 @@ public class Ref {
   @SerializedName(SERIALIZED_NAME_COMMIT_ID)
   private String commitId;
 
+  public static final String SERIALIZED_NAME_EXPIRES_AT = "expires_at";
+  @SerializedName(SERIALIZED_NAME_EXPIRES_AT)
+  private Long expiresAt;
+
+  public static final String SERIALIZED_NAME_IDLE_TTL = "idle_ttl";
+  @SerializedName(SERIALIZED_NAME_IDLE_TTL)
+  private Long idleTtl;
+
 
   public Ref id(String id) {
     
@@ -84,6 +92,52 @@ public class Ref {
   }
 
 
+  public Ref expiresAt(Long expiresAt) {
+    
+    this.expiresAt = expiresAt;
+    return this;
+  }
+
+   /**
+   * Unix Epoch in seconds at which an ephemeral branch expires
+   * @return expiresAt
+  **/
+  @javax.annotation.Nullable
+  @ApiModelProperty(value = "Unix Epoch in seconds at which an ephemeral branch expires")
+
+  public Long getExpiresAt() {
+    return expiresAt;
+  }
+
+
+  public void setExpiresAt(Long expiresAt) {
+    this.expiresAt = expiresAt;
+  }
+
+
+  public Ref idleTtl(Long idleTtl) {
+    
+    this.idleTtl = idleTtl;
+    return this;
+  }
+
+   /**
+   * period in seconds after the latest change of its head at which an ephemeral branch expires
+   * @return idleTtl
+  **/
+  @javax.annotation.Nullable
+  @ApiModelProperty(value = "period in seconds after the latest change of its head at which an ephemeral branch expires")
+
+  public Long getIdleTtl() {
+    return idleTtl;
+  }
+
+
+  public void setIdleTtl(Long idleTtl) {
+    this.idleTtl = idleTtl;
+  }
+
+
   @Override
   public boolean equals(Object o) {
     if (this == o) {
@@ -94,12 +148,14 @@ public class Ref {
     }
     Ref ref = (Ref) o;
     return Objects.equals(this.id, ref.id) &&
-        Objects.equals(this.commitId, ref.commitId);
+        Objects.equals(this.commitId, ref.commitId) &&
+        Objects.equals(this.expiresAt, ref.expiresAt) &&
+        Objects.equals(this.idleTtl, ref.idleTtl);
   }
 
   @Override
   public int hashCode() {
-    return Objects.hash(id, commitId);
+    return Objects.hash(id, commitId, expiresAt, idleTtl);
   }
 
   @Override
@@ -108,6 +164,8 @@ public class Ref {
     sb.append("class Ref {\n");
     sb.append("    id: ").append(toIndentedString(id)).append("\n");
     sb.append("    commitId: ").append(toIndentedString(commitId)).append("\n");
+    sb.append("    expiresAt: ").append(toIndentedString(expiresAt)).append("\n");
+    sb.append("    idleTtl: ").append(toIndentedString(idleTtl)).append("\n");
     sb.append("}");
     return sb.toString();
   }
@@ -0,0 +1,128 @@
+/*
+ * lakeFS API
+ * lakeFS HTTP API
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+package io.lakefs.clients.api.model;
+
+import java.util.Objects;
+import java.util.Arrays;
+import com.google.gson.TypeAdapter;
+import com.google.gson.annotations.JsonAdapter;
+import com.google.gson.annotations.SerializedName;
+import com.google.gson.stream.JsonReader;
+import com.google.gson.stream.JsonWriter;
+import io.swagger.annotations.ApiModel;
+import io.swagger.annotations.ApiModelProperty;
+import java.io.IOException;
+
+/**
+ * RepositoryRename
+ */
+@javax.annotation.Generated(value = "org.openapitools.codegen.languages.JavaClientCodegen")
+public class RepositoryRename {
+  public static final String SERIALIZED_NAME_NAME = "name";
+  @SerializedName(SERIALIZED_NAME_NAME)
+  private String name;
+
+  public static final String SERIALIZED_NAME_ALIAS_PERIOD = "alias_period";
+  @SerializedName(SERIALIZED_NAME_ALIAS_PERIOD)
+  private Long aliasPeriod;
+
+
+  public RepositoryRename name(String name) {
+    
+    this.name = name;
+    return this;
+  }
+
+   /**
+   * new name of the repository
+   * @return name
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "new name of the repository")
+
+  public String getName() {
+    return name;
+  }
+
+
+  public void setName(String name) {
+    this.name = name;
+  }
+
+
+  public RepositoryRename aliasPeriod(Long aliasPeriod) {
+    
+    this.aliasPeriod = aliasPeriod;
+    return this;
+  }
+
+   /**
+   * period in seconds during which the current name keeps resolving to the repository in the S3 gateway
+   * minimum: 0
+   * @return aliasPeriod
+  **/
+  @javax.annotation.Nullable
+  @ApiModelProperty(value = "period in seconds during which the current name keeps resolving to the repository in the S3 gateway")
+
+  public Long getAliasPeriod() {
+    return aliasPeriod;
+  }
+
+
+  public void setAliasPeriod(Long aliasPeriod) {
+    this.aliasPeriod = aliasPeriod;
+  }
+
+
+  @Override
+  public boolean equals(Object o) {
+    if (this == o) {
+      return true;
+    }
+    if (o == null || getClass() != o.getClass()) {
+      return false;
+    }
+    RepositoryRename repositoryRename = (RepositoryRename) o;
+    return Objects.equals(this.name, repositoryRename.name) &&
+        Objects.equals(this.aliasPeriod, repositoryRename.aliasPeriod);
+  }
+
+  @Override
+  public int hashCode() {
+    return Objects.hash(name, aliasPeriod);
+  }
+
+  @Override
+  public String toString() {
+    StringBuilder sb = new StringBuilder();
+    sb.append("class RepositoryRename {\n");
+    sb.append("    name: ").append(toIndentedString(name)).append("\n");
+    sb.append("    aliasPeriod: ").append(toIndentedString(aliasPeriod)).append("\n");
+    sb.append("}");
+    return sb.toString();
+  }
+
+  /**
+   * Convert the given object to string with each line indented by 4 spaces
+   * (except the first line).
+   */
+  private String toIndentedString(Object o) {
+    if (o == null) {
+      return "null";
+    }
+    return o.toString().replace("\n", "\n    ");
+  }
+
+}
+
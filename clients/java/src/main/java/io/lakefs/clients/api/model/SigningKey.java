@@ -0,0 +1,185 @@
+/*
+ * lakeFS API
+ * lakeFS HTTP API
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+package io.lakefs.clients.api.model;
+
+import java.util.Objects;
+import java.util.Arrays;
+import com.google.gson.TypeAdapter;
+import com.google.gson.annotations.JsonAdapter;
+import com.google.gson.annotations.SerializedName;
+import com.google.gson.stream.JsonReader;
+import com.google.gson.stream.JsonWriter;
+import io.swagger.annotations.ApiModel;
+import io.swagger.annotations.ApiModelProperty;
+import java.io.IOException;
+
+/**
+ * SigningKey
+ */
+@javax.annotation.Generated(value = "org.openapitools.codegen.languages.JavaClientCodegen")
+public class SigningKey {
+  public static final String SERIALIZED_NAME_KEY_ID = "key_id";
+  @SerializedName(SERIALIZED_NAME_KEY_ID)
+  private String keyId;
+
+  public static final String SERIALIZED_NAME_KEY_TYPE = "key_type";
+  @SerializedName(SERIALIZED_NAME_KEY_TYPE)
+  private String keyType;
+
+  public static final String SERIALIZED_NAME_PUBLIC_KEY = "public_key";
+  @SerializedName(SERIALIZED_NAME_PUBLIC_KEY)
+  private String publicKey;
+
+  public static final String SERIALIZED_NAME_CREATION_DATE = "creation_date";
+  @SerializedName(SERIALIZED_NAME_CREATION_DATE)
+  private Long creationDate;
+
+
+  public SigningKey keyId(String keyId) {
+    
+    this.keyId = keyId;
+    return this;
+  }
+
+   /**
+   * SHA256 fingerprint of the public key
+   * @return keyId
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "SHA256 fingerprint of the public key")
+
+  public String getKeyId() {
+    return keyId;
+  }
+
+
+  public void setKeyId(String keyId) {
+    this.keyId = keyId;
+  }
+
+
+  public SigningKey keyType(String keyType) {
+    
+    this.keyType = keyType;
+    return this;
+  }
+
+   /**
+   * Get keyType
+   * @return keyType
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "")
+
+  public String getKeyType() {
+    return keyType;
+  }
+
+
+  public void setKeyType(String keyType) {
+    this.keyType = keyType;
+  }
+
+
+  public SigningKey publicKey(String publicKey) {
+    
+    this.publicKey = publicKey;
+    return this;
+  }
+
+   /**
+   * Get publicKey
+   * @return publicKey
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "")
+
+  public String getPublicKey() {
+    return publicKey;
+  }
+
+
+  public void setPublicKey(String publicKey) {
+    this.publicKey = publicKey;
+  }
+
+
+  public SigningKey creationDate(Long creationDate) {
+    
+    this.creationDate = creationDate;
+    return this;
+  }
+
+   /**
+   * Unix Epoch in seconds
+   * @return creationDate
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "Unix Epoch in seconds")
+
+  public Long getCreationDate() {
+    return creationDate;
+  }
+
+
+  public void setCreationDate(Long creationDate) {
+    this.creationDate = creationDate;
+  }
+
+
+  @Override
+  public boolean equals(Object o) {
+    if (this == o) {
+      return true;
+    }
+    if (o == null || getClass() != o.getClass()) {
+      return false;
+    }
+    SigningKey signingKey = (SigningKey) o;
+    return Objects.equals(this.keyId, signingKey.keyId) &&
+        Objects.equals(this.keyType, signingKey.keyType) &&
+        Objects.equals(this.publicKey, signingKey.publicKey) &&
+        Objects.equals(this.creationDate, signingKey.creationDate);
+  }
+
+  @Override
+  public int hashCode() {
+    return Objects.hash(keyId, keyType, publicKey, creationDate);
+  }
+
+  @Override
+  public String toString() {
+    StringBuilder sb = new StringBuilder();
+    sb.append("class SigningKey {\n");
+    sb.append("    keyId: ").append(toIndentedString(keyId)).append("\n");
+    sb.append("    keyType: ").append(toIndentedString(keyType)).append("\n");
+    sb.append("    publicKey: ").append(toIndentedString(publicKey)).append("\n");
+    sb.append("    creationDate: ").append(toIndentedString(creationDate)).append("\n");
+    sb.append("}");
+    return sb.toString();
+  }
+
+  /**
+   * Convert the given object to string with each line indented by 4 spaces
+   * (except the first line).
+   */
+  private String toIndentedString(Object o) {
+    if (o == null) {
+      return "null";
+    }
+    return o.toString().replace("\n", "\n    ");
+  }
+
+}
+
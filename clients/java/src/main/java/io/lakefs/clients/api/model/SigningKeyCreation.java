@@ -0,0 +1,174 @@
+/*
+ * lakeFS API
+ * lakeFS HTTP API
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+package io.lakefs.clients.api.model;
+
+import java.util.Objects;
+import java.util.Arrays;
+import com.google.gson.TypeAdapter;
+import com.google.gson.annotations.JsonAdapter;
+import com.google.gson.annotations.SerializedName;
+import com.google.gson.stream.JsonReader;
+import com.google.gson.stream.JsonWriter;
+import io.swagger.annotations.ApiModel;
+import io.swagger.annotations.ApiModelProperty;
+import java.io.IOException;
+
+/**
+ * SigningKeyCreation
+ */
+@javax.annotation.Generated(value = "org.openapitools.codegen.languages.JavaClientCodegen")
+public class SigningKeyCreation {
+  /**
+   * Gets or Sets keyType
+   */
+  @JsonAdapter(KeyTypeEnum.Adapter.class)
+  public enum KeyTypeEnum {
+    ED25519("ed25519"),
+    
+    SSH("ssh");
+
+    private String value;
+
+    KeyTypeEnum(String value) {
+      this.value = value;
+    }
+
+    public String getValue() {
+      return value;
+    }
+
+    @Override
+    public String toString() {
+      return String.valueOf(value);
+    }
+
+    public static KeyTypeEnum fromValue(String value) {
+      for (KeyTypeEnum b : KeyTypeEnum.values()) {
+        if (b.value.equals(value)) {
+          return b;
+        }
+      }
+      throw new IllegalArgumentException("Unexpected value '" + value + "'");
+    }
+
+    public static class Adapter extends TypeAdapter<KeyTypeEnum> {
+      @Override
+      public void write(final JsonWriter jsonWriter, final KeyTypeEnum enumeration) throws IOException {
+        jsonWriter.value(enumeration.getValue());
+      }
+
+      @Override
+      public KeyTypeEnum read(final JsonReader jsonReader) throws IOException {
+        String value =  jsonReader.nextString();
+        return KeyTypeEnum.fromValue(value);
+      }
+    }
+  }
+
+  public static final String SERIALIZED_NAME_KEY_TYPE = "key_type";
+  @SerializedName(SERIALIZED_NAME_KEY_TYPE)
+  private KeyTypeEnum keyType;
+
+  public static final String SERIALIZED_NAME_PUBLIC_KEY = "public_key";
+  @SerializedName(SERIALIZED_NAME_PUBLIC_KEY)
+  private String publicKey;
+
+
+  public SigningKeyCreation keyType(KeyTypeEnum keyType) {
+    
+    this.keyType = keyType;
+    return this;
+  }
+
+   /**
+   * Get keyType
+   * @return keyType
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "")
+
+  public KeyTypeEnum getKeyType() {
+    return keyType;
+  }
+
+
+  public void setKeyType(KeyTypeEnum keyType) {
+    this.keyType = keyType;
+  }
+
+
+  public SigningKeyCreation publicKey(String publicKey) {
+    
+    this.publicKey = publicKey;
+    return this;
+  }
+
+   /**
+   * base64 encoded ed25519 public key, or an SSH public key in authorized_keys format
+   * @return publicKey
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "base64 encoded ed25519 public key, or an SSH public key in authorized_keys format")
+
+  public String getPublicKey() {
+    return publicKey;
+  }
+
+
+  public void setPublicKey(String publicKey) {
+    this.publicKey = publicKey;
+  }
+
+
+  @Override
+  public boolean equals(Object o) {
+    if (this == o) {
+      return true;
+    }
+    if (o == null || getClass() != o.getClass()) {
+      return false;
+    }
+    SigningKeyCreation signingKeyCreation = (SigningKeyCreation) o;
+    return Objects.equals(this.keyType, signingKeyCreation.keyType) &&
+        Objects.equals(this.publicKey, signingKeyCreation.publicKey);
+  }
+
+  @Override
+  public int hashCode() {
+    return Objects.hash(keyType, publicKey);
+  }
+
+  @Override
+  public String toString() {
+    StringBuilder sb = new StringBuilder();
+    sb.append("class SigningKeyCreation {\n");
+    sb.append("    keyType: ").append(toIndentedString(keyType)).append("\n");
+    sb.append("    publicKey: ").append(toIndentedString(publicKey)).append("\n");
+    sb.append("}");
+    return sb.toString();
+  }
+
+  /**
+   * Convert the given object to string with each line indented by 4 spaces
+   * (except the first line).
+   */
+  private String toIndentedString(Object o) {
+    if (o == null) {
+      return "null";
+    }
+    return o.toString().replace("\n", "\n    ");
+  }
+
+}
+
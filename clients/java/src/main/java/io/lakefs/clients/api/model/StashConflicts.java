@@ -0,0 +1,135 @@
+/*
+ * lakeFS API
+ * lakeFS HTTP API
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+package io.lakefs.clients.api.model;
+
+import java.util.Objects;
+import java.util.Arrays;
+import com.google.gson.TypeAdapter;
+import com.google.gson.annotations.JsonAdapter;
+import com.google.gson.annotations.SerializedName;
+import com.google.gson.stream.JsonReader;
+import com.google.gson.stream.JsonWriter;
+import io.lakefs.clients.api.model.MergeConflict;
+import io.swagger.annotations.ApiModel;
+import io.swagger.annotations.ApiModelProperty;
+import java.io.IOException;
+import java.util.ArrayList;
+import java.util.List;
+
+/**
+ * StashConflicts
+ */
+@javax.annotation.Generated(value = "org.openapitools.codegen.languages.JavaClientCodegen")
+public class StashConflicts {
+  public static final String SERIALIZED_NAME_CONFLICTS = "conflicts";
+  @SerializedName(SERIALIZED_NAME_CONFLICTS)
+  private List<MergeConflict> conflicts = new ArrayList<MergeConflict>();
+
+  public static final String SERIALIZED_NAME_CONFLICTS_TRUNCATED = "conflicts_truncated";
+  @SerializedName(SERIALIZED_NAME_CONFLICTS_TRUNCATED)
+  private Boolean conflictsTruncated;
+
+
+  public StashConflicts conflicts(List<MergeConflict> conflicts) {
+    
+    this.conflicts = conflicts;
+    return this;
+  }
+
+  public StashConflicts addConflictsItem(MergeConflict conflictsItem) {
+    this.conflicts.add(conflictsItem);
+    return this;
+  }
+
+   /**
+   * paths changed on the branch since the changes were stashed
+   * @return conflicts
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(required = true, value = "paths changed on the branch since the changes were stashed")
+
+  public List<MergeConflict> getConflicts() {
+    return conflicts;
+  }
+
+
+  public void setConflicts(List<MergeConflict> conflicts) {
+    this.conflicts = conflicts;
+  }
+
+
+  public StashConflicts conflictsTruncated(Boolean conflictsTruncated) {
+    
+    this.conflictsTruncated = conflictsTruncated;
+    return this;
+  }
+
+   /**
+   * true if there may be more conflicts than the ones listed
+   * @return conflictsTruncated
+  **/
+  @javax.annotation.Nullable
+  @ApiModelProperty(value = "true if there may be more conflicts than the ones listed")
+
+  public Boolean getConflictsTruncated() {
+    return conflictsTruncated;
+  }
+
+
+  public void setConflictsTruncated(Boolean conflictsTruncated) {
+    this.conflictsTruncated = conflictsTruncated;
+  }
+
+
+  @Override
+  public boolean equals(Object o) {
+    if (this == o) {
+      return true;
+    }
+    if (o == null || getClass() != o.getClass()) {
+      return false;
+    }
+    StashConflicts stashConflicts = (StashConflicts) o;
+    return Objects.equals(this.conflicts, stashConflicts.conflicts) &&
+        Objects.equals(this.conflictsTruncated, stashConflicts.conflictsTruncated);
+  }
+
+  @Override
+  public int hashCode() {
+    return Objects.hash(conflicts, conflictsTruncated);
+  }
+
+  @Override
+  public String toString() {
+    StringBuilder sb = new StringBuilder();
+    sb.append("class StashConflicts {\n");
+    sb.append("    conflicts: ").append(toIndentedString(conflicts)).append("\n");
+    sb.append("    conflictsTruncated: ").append(toIndentedString(conflictsTruncated)).append("\n");
+    sb.append("}");
+    return sb.toString();
+  }
+
+  /**
+   * Convert the given object to string with each line indented by 4 spaces
+   * (except the first line).
+   */
+  private String toIndentedString(Object o) {
+    if (o == null) {
+      return "null";
+    }
+    return o.toString().replace("\n", "\n    ");
+  }
+
+}
+
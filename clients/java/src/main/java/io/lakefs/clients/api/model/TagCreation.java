@@ -23,6 +23,9 @@ import com.google.gson.stream.JsonWriter;
 import io.swagger.annotations.ApiModel;
 import io.swagger.annotations.ApiModelProperty;
 import java.io.IOException;
+import java.util.HashMap;
+import java.util.List;
+import java.util.Map;
 
 /**
  * TagCreation
@@ -37,6 +40,14 @@ public class TagCreation {
   @SerializedName(SERIALIZED_NAME_REF)
   private String ref;
 
+  public static final String SERIALIZED_NAME_MESSAGE = "message";
+  @SerializedName(SERIALIZED_NAME_MESSAGE)
+  private String message;
+
+  public static final String SERIALIZED_NAME_METADATA = "metadata";
+  @SerializedName(SERIALIZED_NAME_METADATA)
+  private Map<String, String> metadata = null;
+
 
   public TagCreation id(String id) {
     
@@ -84,6 +95,60 @@ public class TagCreation {
   }
 
 
+  public TagCreation message(String message) {
+    
+    this.message = message;
+    return this;
+  }
+
+   /**
+   * create an annotated tag with this message
+   * @return message
+  **/
+  @javax.annotation.Nullable
+  @ApiModelProperty(value = "create an annotated tag with this message")
+
+  public String getMessage() {
+    return message;
+  }
+
+
+  public void setMessage(String message) {
+    this.message = message;
+  }
+
+
+  public TagCreation metadata(Map<String, String> metadata) {
+    
+    this.metadata = metadata;
+    return this;
+  }
+
+  public TagCreation putMetadataItem(String key, String metadataItem) {
+    if (this.metadata == null) {
+      this.metadata = new HashMap<String, String>();
+    }
+    this.metadata.put(key, metadataItem);
+    return this;
+  }
+
+   /**
+   * create an annotated tag with this metadata
+   * @return metadata
+  **/
+  @javax.annotation.Nullable
+  @ApiModelProperty(value = "create an annotated tag with this metadata")
+
+  public Map<String, String> getMetadata() {
+    return metadata;
+  }
+
+
+  public void setMetadata(Map<String, String> metadata) {
+    this.metadata = metadata;
+  }
+
+
   @Override
   public boolean equals(Object o) {
     if (this == o) {
@@ -94,12 +159,14 @@ public class TagCreation {
     }
     TagCreation tagCreation = (TagCreation) o;
     return Objects.equals(this.id, tagCreation.id) &&
-        Objects.equals(this.ref, tagCreation.ref);
+        Objects.equals(this.ref, tagCreation.ref) &&
+        Objects.equals(this.message, tagCreation.message) &&
+        Objects.equals(this.metadata, tagCreation.metadata);
   }
 
   @Override
   public int hashCode() {
-    return Objects.hash(id, ref);
+    return Objects.hash(id, ref, message, metadata);
   }
 
   @Override
@@ -108,6 +175,8 @@ public class TagCreation {
     sb.append("class TagCreation {\n");
     sb.append("    id: ").append(toIndentedString(id)).append("\n");
     sb.append("    ref: ").append(toIndentedString(ref)).append("\n");
+    sb.append("    message: ").append(toIndentedString(message)).append("\n");
+    sb.append("    metadata: ").append(toIndentedString(metadata)).append("\n");
     sb.append("}");
     return sb.toString();
   }
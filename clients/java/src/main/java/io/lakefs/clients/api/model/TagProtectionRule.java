@@ -0,0 +1,98 @@
+/*
+ * lakeFS API
+ * lakeFS HTTP API
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+package io.lakefs.clients.api.model;
+
+import java.util.Objects;
+import java.util.Arrays;
+import com.google.gson.TypeAdapter;
+import com.google.gson.annotations.JsonAdapter;
+import com.google.gson.annotations.SerializedName;
+import com.google.gson.stream.JsonReader;
+import com.google.gson.stream.JsonWriter;
+import io.swagger.annotations.ApiModel;
+import io.swagger.annotations.ApiModelProperty;
+import java.io.IOException;
+
+/**
+ * TagProtectionRule
+ */
+@javax.annotation.Generated(value = "org.openapitools.codegen.languages.JavaClientCodegen")
+public class TagProtectionRule {
+  public static final String SERIALIZED_NAME_PATTERN = "pattern";
+  @SerializedName(SERIALIZED_NAME_PATTERN)
+  private String pattern;
+
+
+  public TagProtectionRule pattern(String pattern) {
+    
+    this.pattern = pattern;
+    return this;
+  }
+
+   /**
+   * fnmatch pattern for the tag name, supporting * and ? wildcards. Matching tags cannot be deleted or re-created.
+   * @return pattern
+  **/
+  @javax.annotation.Nonnull
+  @ApiModelProperty(example = "v*", required = true, value = "fnmatch pattern for the tag name, supporting * and ? wildcards. Matching tags cannot be deleted or re-created.")
+
+  public String getPattern() {
+    return pattern;
+  }
+
+
+  public void setPattern(String pattern) {
+    this.pattern = pattern;
+  }
+
+
+  @Override
+  public boolean equals(Object o) {
+    if (this == o) {
+      return true;
+    }
+    if (o == null || getClass() != o.getClass()) {
+      return false;
+    }
+    TagProtectionRule tagProtectionRule = (TagProtectionRule) o;
+    return Objects.equals(this.pattern, tagProtectionRule.pattern);
+  }
+
+  @Override
+  public int hashCode() {
+    return Objects.hash(pattern);
+  }
+
+  @Override
+  public String toString() {
+    StringBuilder sb = new StringBuilder();
+    sb.append("class TagProtectionRule {\n");
+    sb.append("    pattern: ").append(toIndentedString(pattern)).append("\n");
+    sb.append("}");
+    return sb.toString();
+  }
+
+  /**
+   * Convert the given object to string with each line indented by 4 spaces
+   * (except the first line).
+   */
+  private String toIndentedString(Object o) {
+    if (o == null) {
+      return "null";
+    }
+    return o.toString().replace("\n", "\n    ");
+  }
+
+}
+
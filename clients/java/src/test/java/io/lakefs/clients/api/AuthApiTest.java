@@ -23,9 +23,13 @@ import io.lakefs.clients.api.model.Error;
 import io.lakefs.clients.api.model.Group;
 import io.lakefs.clients.api.model.GroupCreation;
 import io.lakefs.clients.api.model.GroupList;
+import io.lakefs.clients.api.model.InlineObject;
 import io.lakefs.clients.api.model.LoginInformation;
 import io.lakefs.clients.api.model.Policy;
 import io.lakefs.clients.api.model.PolicyList;
+import io.lakefs.clients.api.model.SigningKey;
+import io.lakefs.clients.api.model.SigningKeyCreation;
+import io.lakefs.clients.api.model.SigningKeyList;
 import io.lakefs.clients.api.model.User;
 import io.lakefs.clients.api.model.UserCreation;
 import io.lakefs.clients.api.model.UserList;
@@ -62,6 +66,22 @@ public class AuthApiTest {
         // TODO: test validations
     }
     
+    /**
+     * add a signing key for verifying commit signatures of the user
+     *
+     * 
+     *
+     * @throws ApiException
+     *          if the Api call fails
+     */
+    @Test
+    public void addSigningKeyTest() throws ApiException {
+        String userId = null;
+        SigningKeyCreation signingKeyCreation = null;
+                SigningKey response = api.addSigningKey(userId, signingKeyCreation);
+        // TODO: test validations
+    }
+    
     /**
      * attach policy to group
      *
@@ -216,6 +236,22 @@ public class AuthApiTest {
         // TODO: test validations
     }
     
+    /**
+     * delete signing key
+     *
+     * 
+     *
+     * @throws ApiException
+     *          if the Api call fails
+     */
+    @Test
+    public void deleteSigningKeyTest() throws ApiException {
+        String userId = null;
+        InlineObject inlineObject = null;
+                api.deleteSigningKey(userId, inlineObject);
+        // TODO: test validations
+    }
+    
     /**
      * delete user
      *
@@ -463,6 +499,24 @@ public class AuthApiTest {
         // TODO: test validations
     }
     
+    /**
+     * list user signing keys
+     *
+     * 
+     *
+     * @throws ApiException
+     *          if the Api call fails
+     */
+    @Test
+    public void listUserSigningKeysTest() throws ApiException {
+        String userId = null;
+        String prefix = null;
+        String after = null;
+        Integer amount = null;
+                SigningKeyList response = api.listUserSigningKeys(userId, prefix, after, amount);
+        // TODO: test validations
+    }
+    
     /**
      * list users
      *
@@ -14,13 +14,25 @@
 package io.lakefs.clients.api;
 
 import io.lakefs.clients.api.ApiException;
+import io.lakefs.clients.api.model.BranchComparison;
+import io.lakefs.clients.api.model.BranchComparisonList;
 import io.lakefs.clients.api.model.BranchCreation;
+import io.lakefs.clients.api.model.BranchReflogList;
+import io.lakefs.clients.api.model.BranchRestoreCreation;
+import io.lakefs.clients.api.model.CherryPickCreation;
+import io.lakefs.clients.api.model.Commit;
 import io.lakefs.clients.api.model.DiffList;
 import io.lakefs.clients.api.model.Error;
+import io.lakefs.clients.api.model.RebaseCreation;
+import io.lakefs.clients.api.model.RebaseResult;
 import io.lakefs.clients.api.model.Ref;
 import io.lakefs.clients.api.model.RefList;
 import io.lakefs.clients.api.model.ResetCreation;
 import io.lakefs.clients.api.model.RevertCreation;
+import io.lakefs.clients.api.model.Stash;
+import io.lakefs.clients.api.model.StashConflicts;
+import io.lakefs.clients.api.model.StashCreation;
+import io.lakefs.clients.api.model.StashList;
 import org.junit.Test;
 import org.junit.Ignore;
 
@@ -38,6 +50,59 @@ public class BranchesApiTest {
     private final BranchesApi api = new BranchesApi();
 
     
+    /**
+     * apply the changes of a commit on top of a branch
+     *
+     * 
+     *
+     * @throws ApiException
+     *          if the Api call fails
+     */
+    @Test
+    public void cherryPickTest() throws ApiException {
+        String repository = null;
+        String branch = null;
+        CherryPickCreation cherryPickCreation = null;
+                Commit response = api.cherryPick(repository, branch, cherryPickCreation);
+        // TODO: test validations
+    }
+    
+    /**
+     * compare all branches of the repository to a base reference
+     *
+     * 
+     *
+     * @throws ApiException
+     *          if the Api call fails
+     */
+    @Test
+    public void compareAllBranchesTest() throws ApiException {
+        String repository = null;
+        String baseRef = null;
+        String prefix = null;
+        String after = null;
+        Integer amount = null;
+                BranchComparisonList response = api.compareAllBranches(repository, baseRef, prefix, after, amount);
+        // TODO: test validations
+    }
+    
+    /**
+     * compare a branch to a base reference
+     *
+     * Returns the merge base of the branch and the base reference, how many commits each has that the other does not, and how many objects were added, removed and changed by the commits of the branch since the merge base. Uncommitted changes are not compared. 
+     *
+     * @throws ApiException
+     *          if the Api call fails
+     */
+    @Test
+    public void compareBranchesTest() throws ApiException {
+        String repository = null;
+        String baseRef = null;
+        String branch = null;
+                BranchComparison response = api.compareBranches(repository, baseRef, branch);
+        // TODO: test validations
+    }
+    
     /**
      * create branch
      *
@@ -106,6 +171,24 @@ public class BranchesApiTest {
         // TODO: test validations
     }
     
+    /**
+     * list the changes of the branch head, latest first
+     *
+     * Lists the changes of the branch head made by creating, committing to, merging into, resetting, restoring and deleting the branch, latest first. The reflog of a deleted branch is kept. Pagination is by entry index, pass the index of the last entry returned as &#39;after&#39;. 
+     *
+     * @throws ApiException
+     *          if the Api call fails
+     */
+    @Test
+    public void listBranchReflogTest() throws ApiException {
+        String repository = null;
+        String branch = null;
+        String after = null;
+        Integer amount = null;
+                BranchReflogList response = api.listBranchReflog(repository, branch, after, amount);
+        // TODO: test validations
+    }
+    
     /**
      * list branches
      *
@@ -124,6 +207,41 @@ public class BranchesApiTest {
         // TODO: test validations
     }
     
+    /**
+     * list the stashes of a branch
+     *
+     * 
+     *
+     * @throws ApiException
+     *          if the Api call fails
+     */
+    @Test
+    public void listStashesTest() throws ApiException {
+        String repository = null;
+        String branch = null;
+        String after = null;
+        Integer amount = null;
+                StashList response = api.listStashes(repository, branch, after, amount);
+        // TODO: test validations
+    }
+    
+    /**
+     * replay the commits of a branch on top of another ref
+     *
+     * Replays, in order, the commits of the branch that are not reachable from the given ref on top of it, producing linear history. Merge commits are not replayed. Requires no uncommitted changes on the branch. The rebase stops on the first conflicting commit, leaving the branch unchanged. 
+     *
+     * @throws ApiException
+     *          if the Api call fails
+     */
+    @Test
+    public void rebaseBranchTest() throws ApiException {
+        String repository = null;
+        String branch = null;
+        RebaseCreation rebaseCreation = null;
+                RebaseResult response = api.rebaseBranch(repository, branch, rebaseCreation);
+        // TODO: test validations
+    }
+    
     /**
      * reset branch
      *
@@ -141,6 +259,23 @@ public class BranchesApiTest {
         // TODO: test validations
     }
     
+    /**
+     * point a branch back at a previous head from its reflog
+     *
+     * Points the branch at the commit it pointed to after the given branch reflog entry, recreating the branch if it was deleted. Requires no uncommitted changes on an existing branch. 
+     *
+     * @throws ApiException
+     *          if the Api call fails
+     */
+    @Test
+    public void restoreBranchTest() throws ApiException {
+        String repository = null;
+        String branch = null;
+        BranchRestoreCreation branchRestoreCreation = null;
+                Ref response = api.restoreBranch(repository, branch, branchRestoreCreation);
+        // TODO: test validations
+    }
+    
     /**
      * revert
      *
@@ -158,4 +293,72 @@ public class BranchesApiTest {
         // TODO: test validations
     }
     
+    /**
+     * stage the changes of a stash on a branch
+     *
+     * Stages the changes of the stash on the branch, keeping the stash. Fails without staging anything when a path of the stash was changed differently on the branch since. 
+     *
+     * @throws ApiException
+     *          if the Api call fails
+     */
+    @Test
+    public void stashApplyTest() throws ApiException {
+        String repository = null;
+        String branch = null;
+        String stash = null;
+                api.stashApply(repository, branch, stash);
+        // TODO: test validations
+    }
+    
+    /**
+     * delete a stash and its changes
+     *
+     * 
+     *
+     * @throws ApiException
+     *          if the Api call fails
+     */
+    @Test
+    public void stashDropTest() throws ApiException {
+        String repository = null;
+        String branch = null;
+        String stash = null;
+                api.stashDrop(repository, branch, stash);
+        // TODO: test validations
+    }
+    
+    /**
+     * stage the changes of a stash on a branch and delete the stash
+     *
+     * Stages the changes of the stash on the branch and deletes the stash. Fails without staging anything when a path of the stash was changed differently on the branch since. 
+     *
+     * @throws ApiException
+     *          if the Api call fails
+     */
+    @Test
+    public void stashPopTest() throws ApiException {
+        String repository = null;
+        String branch = null;
+        String stash = null;
+                api.stashPop(repository, branch, stash);
+        // TODO: test validations
+    }
+    
+    /**
+     * move the uncommitted changes of a branch aside
+     *
+     * Moves the uncommitted changes of the branch to a new stash, leaving the branch with no uncommitted changes. 
+     *
+     * @throws ApiException
+     *          if the Api call fails
+     */
+    @Test
+    public void stashPushTest() throws ApiException {
+        String repository = null;
+        String branch = null;
+        StashCreation stashCreation = null;
+                Stash response = api.stashPush(repository, branch, stashCreation);
+        // TODO: test validations
+    }
+    
 }
@@ -17,6 +17,7 @@ import io.lakefs.clients.api.ApiException;
 import io.lakefs.clients.api.model.Commit;
 import io.lakefs.clients.api.model.CommitCreation;
 import io.lakefs.clients.api.model.CommitList;
+import io.lakefs.clients.api.model.CommitSignatureCreation;
 import io.lakefs.clients.api.model.Error;
 import org.junit.Test;
 import org.junit.Ignore;
@@ -86,4 +87,43 @@ public class CommitsApiTest {
         // TODO: test validations
     }
     
+    /**
+     * search commits of the repository by metadata, committer, message and creation date, latest first
+     *
+     * 
+     *
+     * @throws ApiException
+     *          if the Api call fails
+     */
+    @Test
+    public void searchCommitsTest() throws ApiException {
+        String repository = null;
+        String after = null;
+        Integer amount = null;
+        List<String> metadata = null;
+        String committer = null;
+        String message = null;
+        Long since = null;
+        Long until = null;
+                CommitList response = api.searchCommits(repository, after, amount, metadata, committer, message, since, until);
+        // TODO: test validations
+    }
+    
+    /**
+     * store a signature of the commit made by a signing key of its committer
+     *
+     * 
+     *
+     * @throws ApiException
+     *          if the Api call fails
+     */
+    @Test
+    public void signCommitTest() throws ApiException {
+        String repository = null;
+        String commitId = null;
+        CommitSignatureCreation commitSignatureCreation = null;
+                api.signCommit(repository, commitId, commitSignatureCreation);
+        // TODO: test validations
+    }
+    
 }
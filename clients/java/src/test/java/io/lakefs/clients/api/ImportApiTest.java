@@ -0,0 +1,89 @@
+/*
+ * lakeFS API
+ * lakeFS HTTP API
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+package io.lakefs.clients.api;
+
+import io.lakefs.clients.api.ApiException;
+import io.lakefs.clients.api.model.Error;
+import io.lakefs.clients.api.model.ImportCreation;
+import io.lakefs.clients.api.model.ImportCreationResponse;
+import io.lakefs.clients.api.model.ImportStatus;
+import org.junit.Test;
+import org.junit.Ignore;
+
+import java.util.ArrayList;
+import java.util.HashMap;
+import java.util.List;
+import java.util.Map;
+
+/**
+ * API tests for ImportApi
+ */
+@Ignore
+public class ImportApiTest {
+
+    private final ImportApi api = new ImportApi();
+
+    
+    /**
+     * cancel an import that did not complete
+     *
+     * 
+     *
+     * @throws ApiException
+     *          if the Api call fails
+     */
+    @Test
+    public void cancelImportTest() throws ApiException {
+        String repository = null;
+        String branch = null;
+        String _import = null;
+                api.cancelImport(repository, branch, _import);
+        // TODO: test validations
+    }
+    
+    /**
+     * get the status of an import
+     *
+     * 
+     *
+     * @throws ApiException
+     *          if the Api call fails
+     */
+    @Test
+    public void getImportStatusTest() throws ApiException {
+        String repository = null;
+        String branch = null;
+        String _import = null;
+                ImportStatus response = api.getImportStatus(repository, branch, _import);
+        // TODO: test validations
+    }
+    
+    /**
+     * import the objects under an object store prefix to a branch
+     *
+     * Lists the objects under the source prefix and commits them on the branch under the destination path, without copying them. The import runs in the background, poll its status to follow it. The import is recorded in the database, its status can be polled and it can be canceled through any lakeFS server. An import whose server stopped before it completed fails and commits nothing. 
+     *
+     * @throws ApiException
+     *          if the Api call fails
+     */
+    @Test
+    public void startImportTest() throws ApiException {
+        String repository = null;
+        String branch = null;
+        ImportCreation importCreation = null;
+                ImportCreationResponse response = api.startImport(repository, branch, importCreation);
+        // TODO: test validations
+    }
+    
+}
@@ -20,6 +20,7 @@ import io.lakefs.clients.api.model.ObjectErrorList;
 import io.lakefs.clients.api.model.ObjectStageCreation;
 import io.lakefs.clients.api.model.ObjectStats;
 import io.lakefs.clients.api.model.ObjectStatsList;
+import io.lakefs.clients.api.model.ObjectVersionList;
 import io.lakefs.clients.api.model.PathList;
 import io.lakefs.clients.api.model.UnderlyingObjectProperties;
 import org.junit.Test;
@@ -128,6 +129,26 @@ public class ObjectsApiTest {
         // TODO: test validations
     }
     
+    /**
+     * list the versions of an object, latest first
+     *
+     * 
+     *
+     * @throws ApiException
+     *          if the Api call fails
+     */
+    @Test
+    public void objectHistoryTest() throws ApiException {
+        String repository = null;
+        String ref = null;
+        String path = null;
+        Boolean firstParent = null;
+        String after = null;
+        Integer amount = null;
+                ObjectVersionList response = api.objectHistory(repository, ref, path, firstParent, after, amount);
+        // TODO: test validations
+    }
+    
     /**
      * stage an object&#39;s metadata for the given branch
      *
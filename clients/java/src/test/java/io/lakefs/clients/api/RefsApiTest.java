@@ -16,8 +16,10 @@ package io.lakefs.clients.api;
 import io.lakefs.clients.api.ApiException;
 import io.lakefs.clients.api.model.CommitList;
 import io.lakefs.clients.api.model.DiffList;
+import io.lakefs.clients.api.model.DiffSummaryList;
 import io.lakefs.clients.api.model.Error;
 import io.lakefs.clients.api.model.Merge;
+import io.lakefs.clients.api.model.MergePreview;
 import io.lakefs.clients.api.model.MergeResult;
 import io.lakefs.clients.api.model.RefsDump;
 import org.junit.Test;
@@ -60,6 +62,25 @@ public class RefsApiTest {
         // TODO: test validations
     }
     
+    /**
+     * count the changes between references by prefix
+     *
+     * Counts the objects added, removed and changed between the commits of the references, and their size, by path prefix. Uncommitted changes are not counted. 
+     *
+     * @throws ApiException
+     *          if the Api call fails
+     */
+    @Test
+    public void diffSummaryTest() throws ApiException {
+        String repository = null;
+        String leftRef = null;
+        String rightRef = null;
+        Integer depth = null;
+        String type = null;
+                DiffSummaryList response = api.diffSummary(repository, leftRef, rightRef, depth, type);
+        // TODO: test validations
+    }
+    
     /**
      * Dump repository refs (tags, commits, branches) to object store
      *
@@ -113,6 +134,25 @@ public class RefsApiTest {
         // TODO: test validations
     }
     
+    /**
+     * preview the outcome of merging references, without merging
+     *
+     * 
+     *
+     * @throws ApiException
+     *          if the Api call fails
+     */
+    @Test
+    public void mergePreviewTest() throws ApiException {
+        String repository = null;
+        String sourceRef = null;
+        String destinationBranch = null;
+        String strategy = null;
+        List<String> strategyRule = null;
+                MergePreview response = api.mergePreview(repository, sourceRef, destinationBranch, strategy, strategyRule);
+        // TODO: test validations
+    }
+    
     /**
      * Restore repository refs (tags, commits, branches) from object store
      *
@@ -16,10 +16,13 @@ package io.lakefs.clients.api;
 import io.lakefs.clients.api.ApiException;
 import io.lakefs.clients.api.model.BranchProtectionRule;
 import io.lakefs.clients.api.model.Error;
-import io.lakefs.clients.api.model.InlineObject1;
+import io.lakefs.clients.api.model.InlineObject2;
+import io.lakefs.clients.api.model.MergeStrategyRules;
 import io.lakefs.clients.api.model.Repository;
 import io.lakefs.clients.api.model.RepositoryCreation;
 import io.lakefs.clients.api.model.RepositoryList;
+import io.lakefs.clients.api.model.RepositoryRename;
+import io.lakefs.clients.api.model.TagProtectionRule;
 import org.junit.Test;
 import org.junit.Ignore;
 
@@ -69,6 +72,22 @@ public class RepositoriesApiTest {
         // TODO: test validations
     }
     
+    /**
+     * 
+     *
+     * 
+     *
+     * @throws ApiException
+     *          if the Api call fails
+     */
+    @Test
+    public void createTagProtectionRuleTest() throws ApiException {
+        String repository = null;
+        TagProtectionRule tagProtectionRule = null;
+                api.createTagProtectionRule(repository, tagProtectionRule);
+        // TODO: test validations
+    }
+    
     /**
      * 
      *
@@ -80,15 +99,15 @@ public class RepositoriesApiTest {
     @Test
     public void deleteBranchProtectionRuleTest() throws ApiException {
         String repository = null;
-        InlineObject1 inlineObject1 = null;
-                api.deleteBranchProtectionRule(repository, inlineObject1);
+        InlineObject2 inlineObject2 = null;
+                api.deleteBranchProtectionRule(repository, inlineObject2);
         // TODO: test validations
     }
     
     /**
      * delete repository
      *
-     * 
+     * The repository can be restored until its deletion retention period passes and it is purged. A repository with legal holds cannot be deleted.
      *
      * @throws ApiException
      *          if the Api call fails
@@ -100,6 +119,22 @@ public class RepositoriesApiTest {
         // TODO: test validations
     }
     
+    /**
+     * 
+     *
+     * 
+     *
+     * @throws ApiException
+     *          if the Api call fails
+     */
+    @Test
+    public void deleteTagProtectionRuleTest() throws ApiException {
+        String repository = null;
+        TagProtectionRule tagProtectionRule = null;
+                api.deleteTagProtectionRule(repository, tagProtectionRule);
+        // TODO: test validations
+    }
+    
     /**
      * get branch protection rules
      *
@@ -115,6 +150,21 @@ public class RepositoriesApiTest {
         // TODO: test validations
     }
     
+    /**
+     * get the merge strategy rules applied by default to merges in the repository
+     *
+     * The rules apply to merges that set no merge strategy, after the strategy rules of the merge.
+     *
+     * @throws ApiException
+     *          if the Api call fails
+     */
+    @Test
+    public void getMergeStrategyRulesTest() throws ApiException {
+        String repository = null;
+                MergeStrategyRules response = api.getMergeStrategyRules(repository);
+        // TODO: test validations
+    }
+    
     /**
      * get repository
      *
@@ -130,6 +180,21 @@ public class RepositoriesApiTest {
         // TODO: test validations
     }
     
+    /**
+     * get tag protection rules
+     *
+     * 
+     *
+     * @throws ApiException
+     *          if the Api call fails
+     */
+    @Test
+    public void getTagProtectionRulesTest() throws ApiException {
+        String repository = null;
+                List<TagProtectionRule> response = api.getTagProtectionRules(repository);
+        // TODO: test validations
+    }
+    
     /**
      * list repositories
      *
@@ -147,4 +212,51 @@ public class RepositoriesApiTest {
         // TODO: test validations
     }
     
+    /**
+     * rename repository
+     *
+     * 
+     *
+     * @throws ApiException
+     *          if the Api call fails
+     */
+    @Test
+    public void renameRepositoryTest() throws ApiException {
+        String repository = null;
+        RepositoryRename repositoryRename = null;
+                Repository response = api.renameRepository(repository, repositoryRename);
+        // TODO: test validations
+    }
+    
+    /**
+     * restore a deleted repository that was not purged yet
+     *
+     * 
+     *
+     * @throws ApiException
+     *          if the Api call fails
+     */
+    @Test
+    public void restoreRepositoryTest() throws ApiException {
+        String repository = null;
+                Repository response = api.restoreRepository(repository);
+        // TODO: test validations
+    }
+    
+    /**
+     * replace the merge strategy rules applied by default to merges in the repository
+     *
+     * 
+     *
+     * @throws ApiException
+     *          if the Api call fails
+     */
+    @Test
+    public void setMergeStrategyRulesTest() throws ApiException {
+        String repository = null;
+        MergeStrategyRules mergeStrategyRules = null;
+                api.setMergeStrategyRules(repository, mergeStrategyRules);
+        // TODO: test validations
+    }
+    
 }
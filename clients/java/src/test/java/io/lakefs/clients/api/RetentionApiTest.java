@@ -17,7 +17,11 @@ import io.lakefs.clients.api.ApiException;
 import io.lakefs.clients.api.model.Error;
 import io.lakefs.clients.api.model.GarbageCollectionPrepareRequest;
 import io.lakefs.clients.api.model.GarbageCollectionPrepareResponse;
+import io.lakefs.clients.api.model.GarbageCollectionRulePreview;
 import io.lakefs.clients.api.model.GarbageCollectionRules;
+import io.lakefs.clients.api.model.LegalHold;
+import io.lakefs.clients.api.model.LegalHoldCreation;
+import io.lakefs.clients.api.model.LegalHoldLogEntry;
 import org.junit.Test;
 import org.junit.Ignore;
 
@@ -35,6 +39,22 @@ public class RetentionApiTest {
     private final RetentionApi api = new RetentionApi();
 
     
+    /**
+     * place a legal hold on a commit or on paths in it
+     *
+     * Garbage collection keeps the objects of the held commit, or of the paths under the hold path in it, until the hold is released. 
+     *
+     * @throws ApiException
+     *          if the Api call fails
+     */
+    @Test
+    public void createLegalHoldTest() throws ApiException {
+        String repository = null;
+        LegalHoldCreation legalHoldCreation = null;
+                LegalHold response = api.createLegalHold(repository, legalHoldCreation);
+        // TODO: test validations
+    }
+    
     /**
      * 
      *
@@ -50,6 +70,52 @@ public class RetentionApiTest {
         // TODO: test validations
     }
     
+    /**
+     * get legal hold
+     *
+     * 
+     *
+     * @throws ApiException
+     *          if the Api call fails
+     */
+    @Test
+    public void getLegalHoldTest() throws ApiException {
+        String repository = null;
+        String hold = null;
+                LegalHold response = api.getLegalHold(repository, hold);
+        // TODO: test validations
+    }
+    
+    /**
+     * list the legal holds placed and released, latest first
+     *
+     * 
+     *
+     * @throws ApiException
+     *          if the Api call fails
+     */
+    @Test
+    public void listLegalHoldLogTest() throws ApiException {
+        String repository = null;
+                List<LegalHoldLogEntry> response = api.listLegalHoldLog(repository);
+        // TODO: test validations
+    }
+    
+    /**
+     * list legal holds
+     *
+     * 
+     *
+     * @throws ApiException
+     *          if the Api call fails
+     */
+    @Test
+    public void listLegalHoldsTest() throws ApiException {
+        String repository = null;
+                List<LegalHold> response = api.listLegalHolds(repository);
+        // TODO: test validations
+    }
+    
     /**
      * save lists of active and expired commits for garbage collection
      *
@@ -66,6 +132,38 @@ public class RetentionApiTest {
         // TODO: test validations
     }
     
+    /**
+     * estimate the number of objects garbage collection rules would expire
+     *
+     * 
+     *
+     * @throws ApiException
+     *          if the Api call fails
+     */
+    @Test
+    public void previewGarbageCollectionRulesTest() throws ApiException {
+        String repository = null;
+        GarbageCollectionRules garbageCollectionRules = null;
+                List<GarbageCollectionRulePreview> response = api.previewGarbageCollectionRules(repository, garbageCollectionRules);
+        // TODO: test validations
+    }
+    
+    /**
+     * release legal hold
+     *
+     * 
+     *
+     * @throws ApiException
+     *          if the Api call fails
+     */
+    @Test
+    public void releaseLegalHoldTest() throws ApiException {
+        String repository = null;
+        String hold = null;
+                api.releaseLegalHold(repository, hold);
+        // TODO: test validations
+    }
+    
     /**
      * 
      *
@@ -15,9 +15,9 @@ package io.lakefs.clients.api;
 
 import io.lakefs.clients.api.ApiException;
 import io.lakefs.clients.api.model.Error;
-import io.lakefs.clients.api.model.Ref;
-import io.lakefs.clients.api.model.RefList;
+import io.lakefs.clients.api.model.Tag;
 import io.lakefs.clients.api.model.TagCreation;
+import io.lakefs.clients.api.model.TagList;
 import org.junit.Test;
 import org.junit.Ignore;
 
@@ -47,7 +47,7 @@ public class TagsApiTest {
     public void createTagTest() throws ApiException {
         String repository = null;
         TagCreation tagCreation = null;
-                Ref response = api.createTag(repository, tagCreation);
+                Tag response = api.createTag(repository, tagCreation);
         // TODO: test validations
     }
     
@@ -79,7 +79,7 @@ public class TagsApiTest {
     public void getTagTest() throws ApiException {
         String repository = null;
         String tag = null;
-                Ref response = api.getTag(repository, tag);
+                Tag response = api.getTag(repository, tag);
         // TODO: test validations
     }
     
@@ -97,7 +97,7 @@ public class TagsApiTest {
         String prefix = null;
         String after = null;
         Integer amount = null;
-                RefList response = api.listTags(repository, prefix, after, amount);
+                TagList response = api.listTags(repository, prefix, after, amount);
         // TODO: test validations
     }
     
@@ -0,0 +1,115 @@
+/*
+ * lakeFS API
+ * lakeFS HTTP API
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+package io.lakefs.clients.api.model;
+
+import com.google.gson.TypeAdapter;
+import com.google.gson.annotations.JsonAdapter;
+import com.google.gson.annotations.SerializedName;
+import com.google.gson.stream.JsonReader;
+import com.google.gson.stream.JsonWriter;
+import io.swagger.annotations.ApiModel;
+import io.swagger.annotations.ApiModelProperty;
+import java.io.IOException;
+import org.junit.Assert;
+import org.junit.Ignore;
+import org.junit.Test;
+
+
+/**
+ * Model tests for BranchComparison
+ */
+public class BranchComparisonTest {
+    private final BranchComparison model = new BranchComparison();
+
+    /**
+     * Model tests for BranchComparison
+     */
+    @Test
+    public void testBranchComparison() {
+        // TODO: test BranchComparison
+    }
+
+    /**
+     * Test the property 'branch'
+     */
+    @Test
+    public void branchTest() {
+        // TODO: test branch
+    }
+
+    /**
+     * Test the property 'commitId'
+     */
+    @Test
+    public void commitIdTest() {
+        // TODO: test commitId
+    }
+
+    /**
+     * Test the property 'baseCommitId'
+     */
+    @Test
+    public void baseCommitIdTest() {
+        // TODO: test baseCommitId
+    }
+
+    /**
+     * Test the property 'mergeBaseId'
+     */
+    @Test
+    public void mergeBaseIdTest() {
+        // TODO: test mergeBaseId
+    }
+
+    /**
+     * Test the property 'ahead'
+     */
+    @Test
+    public void aheadTest() {
+        // TODO: test ahead
+    }
+
+    /**
+     * Test the property 'behind'
+     */
+    @Test
+    public void behindTest() {
+        // TODO: test behind
+    }
+
+    /**
+     * Test the property 'added'
+     */
+    @Test
+    public void addedTest() {
+        // TODO: test added
+    }
+
+    /**
+     * Test the property 'removed'
+     */
+    @Test
+    public void removedTest() {
+        // TODO: test removed
+    }
+
+    /**
+     * Test the property 'changed'
+     */
+    @Test
+    public void changedTest() {
+        // TODO: test changed
+    }
+
+}
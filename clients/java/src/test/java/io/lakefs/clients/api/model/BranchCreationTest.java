@@ -56,4 +56,20 @@ public class BranchCreationTest {
         // TODO: test source
     }
 
+    /**
+     * Test the property 'expiresAt'
+     */
+    @Test
+    public void expiresAtTest() {
+        // TODO: test expiresAt
+    }
+
+    /**
+     * Test the property 'idleTtl'
+     */
+    @Test
+    public void idleTtlTest() {
+        // TODO: test idleTtl
+    }
+
 }
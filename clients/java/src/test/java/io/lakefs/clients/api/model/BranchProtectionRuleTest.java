@@ -21,6 +21,8 @@ import com.google.gson.stream.JsonWriter;
 import io.swagger.annotations.ApiModel;
 import io.swagger.annotations.ApiModelProperty;
 import java.io.IOException;
+import java.util.ArrayList;
+import java.util.List;
 import org.junit.Assert;
 import org.junit.Ignore;
 import org.junit.Test;
@@ -48,4 +50,28 @@ public class BranchProtectionRuleTest {
         // TODO: test pattern
     }
 
+    /**
+     * Test the property 'blockedActions'
+     */
+    @Test
+    public void blockedActionsTest() {
+        // TODO: test blockedActions
+    }
+
+    /**
+     * Test the property 'mergeSourcePattern'
+     */
+    @Test
+    public void mergeSourcePatternTest() {
+        // TODO: test mergeSourcePattern
+    }
+
+    /**
+     * Test the property 'requireSignedCommits'
+     */
+    @Test
+    public void requireSignedCommitsTest() {
+        // TODO: test requireSignedCommits
+    }
+
 }
@@ -0,0 +1,91 @@
+/*
+ * lakeFS API
+ * lakeFS HTTP API
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+package io.lakefs.clients.api.model;
+
+import com.google.gson.TypeAdapter;
+import com.google.gson.annotations.JsonAdapter;
+import com.google.gson.annotations.SerializedName;
+import com.google.gson.stream.JsonReader;
+import com.google.gson.stream.JsonWriter;
+import io.swagger.annotations.ApiModel;
+import io.swagger.annotations.ApiModelProperty;
+import java.io.IOException;
+import org.junit.Assert;
+import org.junit.Ignore;
+import org.junit.Test;
+
+
+/**
+ * Model tests for BranchReflogEntry
+ */
+public class BranchReflogEntryTest {
+    private final BranchReflogEntry model = new BranchReflogEntry();
+
+    /**
+     * Model tests for BranchReflogEntry
+     */
+    @Test
+    public void testBranchReflogEntry() {
+        // TODO: test BranchReflogEntry
+    }
+
+    /**
+     * Test the property 'index'
+     */
+    @Test
+    public void indexTest() {
+        // TODO: test index
+    }
+
+    /**
+     * Test the property 'oldCommitId'
+     */
+    @Test
+    public void oldCommitIdTest() {
+        // TODO: test oldCommitId
+    }
+
+    /**
+     * Test the property 'newCommitId'
+     */
+    @Test
+    public void newCommitIdTest() {
+        // TODO: test newCommitId
+    }
+
+    /**
+     * Test the property 'operation'
+     */
+    @Test
+    public void operationTest() {
+        // TODO: test operation
+    }
+
+    /**
+     * Test the property 'user'
+     */
+    @Test
+    public void userTest() {
+        // TODO: test user
+    }
+
+    /**
+     * Test the property 'creationDate'
+     */
+    @Test
+    public void creationDateTest() {
+        // TODO: test creationDate
+    }
+
+}
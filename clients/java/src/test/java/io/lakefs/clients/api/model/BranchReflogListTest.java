@@ -0,0 +1,63 @@
+/*
+ * lakeFS API
+ * lakeFS HTTP API
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+package io.lakefs.clients.api.model;
+
+import com.google.gson.TypeAdapter;
+import com.google.gson.annotations.JsonAdapter;
+import com.google.gson.annotations.SerializedName;
+import com.google.gson.stream.JsonReader;
+import com.google.gson.stream.JsonWriter;
+import io.lakefs.clients.api.model.BranchReflogEntry;
+import io.lakefs.clients.api.model.Pagination;
+import io.swagger.annotations.ApiModel;
+import io.swagger.annotations.ApiModelProperty;
+import java.io.IOException;
+import java.util.ArrayList;
+import java.util.List;
+import org.junit.Assert;
+import org.junit.Ignore;
+import org.junit.Test;
+
+
+/**
+ * Model tests for BranchReflogList
+ */
+public class BranchReflogListTest {
+    private final BranchReflogList model = new BranchReflogList();
+
+    /**
+     * Model tests for BranchReflogList
+     */
+    @Test
+    public void testBranchReflogList() {
+        // TODO: test BranchReflogList
+    }
+
+    /**
+     * Test the property 'pagination'
+     */
+    @Test
+    public void paginationTest() {
+        // TODO: test pagination
+    }
+
+    /**
+     * Test the property 'results'
+     */
+    @Test
+    public void resultsTest() {
+        // TODO: test results
+    }
+
+}
@@ -0,0 +1,59 @@
+/*
+ * lakeFS API
+ * lakeFS HTTP API
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+package io.lakefs.clients.api.model;
+
+import com.google.gson.TypeAdapter;
+import com.google.gson.annotations.JsonAdapter;
+import com.google.gson.annotations.SerializedName;
+import com.google.gson.stream.JsonReader;
+import com.google.gson.stream.JsonWriter;
+import io.swagger.annotations.ApiModel;
+import io.swagger.annotations.ApiModelProperty;
+import java.io.IOException;
+import org.junit.Assert;
+import org.junit.Ignore;
+import org.junit.Test;
+
+
+/**
+ * Model tests for CherryPickCreation
+ */
+public class CherryPickCreationTest {
+    private final CherryPickCreation model = new CherryPickCreation();
+
+    /**
+     * Model tests for CherryPickCreation
+     */
+    @Test
+    public void testCherryPickCreation() {
+        // TODO: test CherryPickCreation
+    }
+
+    /**
+     * Test the property 'ref'
+     */
+    @Test
+    public void refTest() {
+        // TODO: test ref
+    }
+
+    /**
+     * Test the property 'parentNumber'
+     */
+    @Test
+    public void parentNumberTest() {
+        // TODO: test parentNumber
+    }
+
+}
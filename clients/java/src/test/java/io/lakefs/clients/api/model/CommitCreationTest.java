@@ -21,6 +21,7 @@ import com.google.gson.stream.JsonWriter;
 import io.swagger.annotations.ApiModel;
 import io.swagger.annotations.ApiModelProperty;
 import java.io.IOException;
+import java.util.ArrayList;
 import java.util.HashMap;
 import java.util.List;
 import java.util.Map;
@@ -67,4 +68,12 @@ public class CommitCreationTest {
         // TODO: test date
     }
 
+    /**
+     * Test the property 'prefixes'
+     */
+    @Test
+    public void prefixesTest() {
+        // TODO: test prefixes
+    }
+
 }
@@ -18,6 +18,7 @@ import com.google.gson.annotations.JsonAdapter;
 import com.google.gson.annotations.SerializedName;
 import com.google.gson.stream.JsonReader;
 import com.google.gson.stream.JsonWriter;
+import io.lakefs.clients.api.model.CommitSignature;
 import io.swagger.annotations.ApiModel;
 import io.swagger.annotations.ApiModelProperty;
 import java.io.IOException;
@@ -100,4 +101,12 @@ public class CommitTest {
         // TODO: test metadata
     }
 
+    /**
+     * Test the property 'signature'
+     */
+    @Test
+    public void signatureTest() {
+        // TODO: test signature
+    }
+
 }
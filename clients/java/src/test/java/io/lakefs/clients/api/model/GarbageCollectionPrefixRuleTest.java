@@ -0,0 +1,59 @@
+/*
+ * lakeFS API
+ * lakeFS HTTP API
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+package io.lakefs.clients.api.model;
+
+import com.google.gson.TypeAdapter;
+import com.google.gson.annotations.JsonAdapter;
+import com.google.gson.annotations.SerializedName;
+import com.google.gson.stream.JsonReader;
+import com.google.gson.stream.JsonWriter;
+import io.swagger.annotations.ApiModel;
+import io.swagger.annotations.ApiModelProperty;
+import java.io.IOException;
+import org.junit.Assert;
+import org.junit.Ignore;
+import org.junit.Test;
+
+
+/**
+ * Model tests for GarbageCollectionPrefixRule
+ */
+public class GarbageCollectionPrefixRuleTest {
+    private final GarbageCollectionPrefixRule model = new GarbageCollectionPrefixRule();
+
+    /**
+     * Model tests for GarbageCollectionPrefixRule
+     */
+    @Test
+    public void testGarbageCollectionPrefixRule() {
+        // TODO: test GarbageCollectionPrefixRule
+    }
+
+    /**
+     * Test the property 'prefix'
+     */
+    @Test
+    public void prefixTest() {
+        // TODO: test prefix
+    }
+
+    /**
+     * Test the property 'retentionDays'
+     */
+    @Test
+    public void retentionDaysTest() {
+        // TODO: test retentionDays
+    }
+
+}
@@ -18,6 +18,7 @@ import com.google.gson.annotations.JsonAdapter;
 import com.google.gson.annotations.SerializedName;
 import com.google.gson.stream.JsonReader;
 import com.google.gson.stream.JsonWriter;
+import io.lakefs.clients.api.model.GarbageCollectionPrefixRule;
 import io.lakefs.clients.api.model.GarbageCollectionRule;
 import io.swagger.annotations.ApiModel;
 import io.swagger.annotations.ApiModelProperty;
@@ -59,4 +60,20 @@ public class GarbageCollectionRulesTest {
         // TODO: test branches
     }
 
+    /**
+     * Test the property 'prefixes'
+     */
+    @Test
+    public void prefixesTest() {
+        // TODO: test prefixes
+    }
+
+    /**
+     * Test the property 'pinnedTagPatterns'
+     */
+    @Test
+    public void pinnedTagPatternsTest() {
+        // TODO: test pinnedTagPatterns
+    }
+
 }
@@ -0,0 +1,99 @@
+/*
+ * lakeFS API
+ * lakeFS HTTP API
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+package io.lakefs.clients.api.model;
+
+import com.google.gson.TypeAdapter;
+import com.google.gson.annotations.JsonAdapter;
+import com.google.gson.annotations.SerializedName;
+import com.google.gson.stream.JsonReader;
+import com.google.gson.stream.JsonWriter;
+import io.swagger.annotations.ApiModel;
+import io.swagger.annotations.ApiModelProperty;
+import java.io.IOException;
+import org.junit.Assert;
+import org.junit.Ignore;
+import org.junit.Test;
+
+
+/**
+ * Model tests for ImportStatus
+ */
+public class ImportStatusTest {
+    private final ImportStatus model = new ImportStatus();
+
+    /**
+     * Model tests for ImportStatus
+     */
+    @Test
+    public void testImportStatus() {
+        // TODO: test ImportStatus
+    }
+
+    /**
+     * Test the property 'id'
+     */
+    @Test
+    public void idTest() {
+        // TODO: test id
+    }
+
+    /**
+     * Test the property 'completed'
+     */
+    @Test
+    public void completedTest() {
+        // TODO: test completed
+    }
+
+    /**
+     * Test the property 'importedObjects'
+     */
+    @Test
+    public void importedObjectsTest() {
+        // TODO: test importedObjects
+    }
+
+    /**
+     * Test the property 'metarangeId'
+     */
+    @Test
+    public void metarangeIdTest() {
+        // TODO: test metarangeId
+    }
+
+    /**
+     * Test the property 'commitId'
+     */
+    @Test
+    public void commitIdTest() {
+        // TODO: test commitId
+    }
+
+    /**
+     * Test the property 'error'
+     */
+    @Test
+    public void errorTest() {
+        // TODO: test error
+    }
+
+    /**
+     * Test the property 'updateTime'
+     */
+    @Test
+    public void updateTimeTest() {
+        // TODO: test updateTime
+    }
+
+}
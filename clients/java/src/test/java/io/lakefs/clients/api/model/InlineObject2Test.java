@@ -27,17 +27,17 @@ import org.junit.Test;
 
 
 /**
- * Model tests for InlineObject1
+ * Model tests for InlineObject2
  */
-public class InlineObject1Test {
-    private final InlineObject1 model = new InlineObject1();
+public class InlineObject2Test {
+    private final InlineObject2 model = new InlineObject2();
 
     /**
-     * Model tests for InlineObject1
+     * Model tests for InlineObject2
      */
     @Test
-    public void testInlineObject1() {
-        // TODO: test InlineObject1
+    public void testInlineObject2() {
+        // TODO: test InlineObject2
     }
 
     /**
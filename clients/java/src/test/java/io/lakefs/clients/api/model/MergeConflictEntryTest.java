@@ -0,0 +1,75 @@
+/*
+ * lakeFS API
+ * lakeFS HTTP API
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+package io.lakefs.clients.api.model;
+
+import com.google.gson.TypeAdapter;
+import com.google.gson.annotations.JsonAdapter;
+import com.google.gson.annotations.SerializedName;
+import com.google.gson.stream.JsonReader;
+import com.google.gson.stream.JsonWriter;
+import io.swagger.annotations.ApiModel;
+import io.swagger.annotations.ApiModelProperty;
+import java.io.IOException;
+import org.junit.Assert;
+import org.junit.Ignore;
+import org.junit.Test;
+
+
+/**
+ * Model tests for MergeConflictEntry
+ */
+public class MergeConflictEntryTest {
+    private final MergeConflictEntry model = new MergeConflictEntry();
+
+    /**
+     * Model tests for MergeConflictEntry
+     */
+    @Test
+    public void testMergeConflictEntry() {
+        // TODO: test MergeConflictEntry
+    }
+
+    /**
+     * Test the property 'identity'
+     */
+    @Test
+    public void identityTest() {
+        // TODO: test identity
+    }
+
+    /**
+     * Test the property 'physicalAddress'
+     */
+    @Test
+    public void physicalAddressTest() {
+        // TODO: test physicalAddress
+    }
+
+    /**
+     * Test the property 'checksum'
+     */
+    @Test
+    public void checksumTest() {
+        // TODO: test checksum
+    }
+
+    /**
+     * Test the property 'sizeBytes'
+     */
+    @Test
+    public void sizeBytesTest() {
+        // TODO: test sizeBytes
+    }
+
+}
@@ -0,0 +1,76 @@
+/*
+ * lakeFS API
+ * lakeFS HTTP API
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+package io.lakefs.clients.api.model;
+
+import com.google.gson.TypeAdapter;
+import com.google.gson.annotations.JsonAdapter;
+import com.google.gson.annotations.SerializedName;
+import com.google.gson.stream.JsonReader;
+import com.google.gson.stream.JsonWriter;
+import io.lakefs.clients.api.model.MergeConflictEntry;
+import io.swagger.annotations.ApiModel;
+import io.swagger.annotations.ApiModelProperty;
+import java.io.IOException;
+import org.junit.Assert;
+import org.junit.Ignore;
+import org.junit.Test;
+
+
+/**
+ * Model tests for MergeConflict
+ */
+public class MergeConflictTest {
+    private final MergeConflict model = new MergeConflict();
+
+    /**
+     * Model tests for MergeConflict
+     */
+    @Test
+    public void testMergeConflict() {
+        // TODO: test MergeConflict
+    }
+
+    /**
+     * Test the property 'path'
+     */
+    @Test
+    public void pathTest() {
+        // TODO: test path
+    }
+
+    /**
+     * Test the property 'source'
+     */
+    @Test
+    public void sourceTest() {
+        // TODO: test source
+    }
+
+    /**
+     * Test the property 'destination'
+     */
+    @Test
+    public void destinationTest() {
+        // TODO: test destination
+    }
+
+    /**
+     * Test the property 'base'
+     */
+    @Test
+    public void baseTest() {
+        // TODO: test base
+    }
+
+}
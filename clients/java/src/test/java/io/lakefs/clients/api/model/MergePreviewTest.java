@@ -0,0 +1,103 @@
+/*
+ * lakeFS API
+ * lakeFS HTTP API
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+package io.lakefs.clients.api.model;
+
+import com.google.gson.TypeAdapter;
+import com.google.gson.annotations.JsonAdapter;
+import com.google.gson.annotations.SerializedName;
+import com.google.gson.stream.JsonReader;
+import com.google.gson.stream.JsonWriter;
+import io.lakefs.clients.api.model.MergeConflict;
+import io.lakefs.clients.api.model.MergePreviewSummary;
+import io.swagger.annotations.ApiModel;
+import io.swagger.annotations.ApiModelProperty;
+import java.io.IOException;
+import java.util.ArrayList;
+import java.util.List;
+import org.junit.Assert;
+import org.junit.Ignore;
+import org.junit.Test;
+
+
+/**
+ * Model tests for MergePreview
+ */
+public class MergePreviewTest {
+    private final MergePreview model = new MergePreview();
+
+    /**
+     * Model tests for MergePreview
+     */
+    @Test
+    public void testMergePreview() {
+        // TODO: test MergePreview
+    }
+
+    /**
+     * Test the property 'sourceCommitId'
+     */
+    @Test
+    public void sourceCommitIdTest() {
+        // TODO: test sourceCommitId
+    }
+
+    /**
+     * Test the property 'destinationCommitId'
+     */
+    @Test
+    public void destinationCommitIdTest() {
+        // TODO: test destinationCommitId
+    }
+
+    /**
+     * Test the property 'baseCommitId'
+     */
+    @Test
+    public void baseCommitIdTest() {
+        // TODO: test baseCommitId
+    }
+
+    /**
+     * Test the property 'fastForward'
+     */
+    @Test
+    public void fastForwardTest() {
+        // TODO: test fastForward
+    }
+
+    /**
+     * Test the property 'summary'
+     */
+    @Test
+    public void summaryTest() {
+        // TODO: test summary
+    }
+
+    /**
+     * Test the property 'conflicts'
+     */
+    @Test
+    public void conflictsTest() {
+        // TODO: test conflicts
+    }
+
+    /**
+     * Test the property 'conflictsTruncated'
+     */
+    @Test
+    public void conflictsTruncatedTest() {
+        // TODO: test conflictsTruncated
+    }
+
+}
@@ -18,10 +18,13 @@ import com.google.gson.annotations.JsonAdapter;
 import com.google.gson.annotations.SerializedName;
 import com.google.gson.stream.JsonReader;
 import com.google.gson.stream.JsonWriter;
+import io.lakefs.clients.api.model.MergeConflict;
 import io.lakefs.clients.api.model.MergeResultSummary;
 import io.swagger.annotations.ApiModel;
 import io.swagger.annotations.ApiModelProperty;
 import java.io.IOException;
+import java.util.ArrayList;
+import java.util.List;
 import org.junit.Assert;
 import org.junit.Ignore;
 import org.junit.Test;
@@ -57,4 +60,20 @@ public class MergeResultTest {
         // TODO: test reference
     }
 
+    /**
+     * Test the property 'conflicts'
+     */
+    @Test
+    public void conflictsTest() {
+        // TODO: test conflicts
+    }
+
+    /**
+     * Test the property 'conflictsTruncated'
+     */
+    @Test
+    public void conflictsTruncatedTest() {
+        // TODO: test conflictsTruncated
+    }
+
 }
@@ -18,9 +18,11 @@ import com.google.gson.annotations.JsonAdapter;
 import com.google.gson.annotations.SerializedName;
 import com.google.gson.stream.JsonReader;
 import com.google.gson.stream.JsonWriter;
+import io.lakefs.clients.api.model.MergeStrategyRule;
 import io.swagger.annotations.ApiModel;
 import io.swagger.annotations.ApiModelProperty;
 import java.io.IOException;
+import java.util.ArrayList;
 import java.util.HashMap;
 import java.util.List;
 import java.util.Map;
@@ -67,4 +69,20 @@ public class MergeTest {
         // TODO: test strategy
     }
 
+    /**
+     * Test the property 'mode'
+     */
+    @Test
+    public void modeTest() {
+        // TODO: test mode
+    }
+
+    /**
+     * Test the property 'strategyRules'
+     */
+    @Test
+    public void strategyRulesTest() {
+        // TODO: test strategyRules
+    }
+
 }
@@ -0,0 +1,99 @@
+/*
+ * lakeFS API
+ * lakeFS HTTP API
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+package io.lakefs.clients.api.model;
+
+import com.google.gson.TypeAdapter;
+import com.google.gson.annotations.JsonAdapter;
+import com.google.gson.annotations.SerializedName;
+import com.google.gson.stream.JsonReader;
+import com.google.gson.stream.JsonWriter;
+import io.swagger.annotations.ApiModel;
+import io.swagger.annotations.ApiModelProperty;
+import java.io.IOException;
+import org.junit.Assert;
+import org.junit.Ignore;
+import org.junit.Test;
+
+
+/**
+ * Model tests for ObjectVersion
+ */
+public class ObjectVersionTest {
+    private final ObjectVersion model = new ObjectVersion();
+
+    /**
+     * Model tests for ObjectVersion
+     */
+    @Test
+    public void testObjectVersion() {
+        // TODO: test ObjectVersion
+    }
+
+    /**
+     * Test the property 'commitId'
+     */
+    @Test
+    public void commitIdTest() {
+        // TODO: test commitId
+    }
+
+    /**
+     * Test the property 'creationDate'
+     */
+    @Test
+    public void creationDateTest() {
+        // TODO: test creationDate
+    }
+
+    /**
+     * Test the property 'committer'
+     */
+    @Test
+    public void committerTest() {
+        // TODO: test committer
+    }
+
+    /**
+     * Test the property 'type'
+     */
+    @Test
+    public void typeTest() {
+        // TODO: test type
+    }
+
+    /**
+     * Test the property 'physicalAddress'
+     */
+    @Test
+    public void physicalAddressTest() {
+        // TODO: test physicalAddress
+    }
+
+    /**
+     * Test the property 'checksum'
+     */
+    @Test
+    public void checksumTest() {
+        // TODO: test checksum
+    }
+
+    /**
+     * Test the property 'sizeBytes'
+     */
+    @Test
+    public void sizeBytesTest() {
+        // TODO: test sizeBytes
+    }
+
+}
@@ -0,0 +1,99 @@
+/*
+ * lakeFS API
+ * lakeFS HTTP API
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+package io.lakefs.clients.api.model;
+
+import com.google.gson.TypeAdapter;
+import com.google.gson.annotations.JsonAdapter;
+import com.google.gson.annotations.SerializedName;
+import com.google.gson.stream.JsonReader;
+import com.google.gson.stream.JsonWriter;
+import io.swagger.annotations.ApiModel;
+import io.swagger.annotations.ApiModelProperty;
+import java.io.IOException;
+import org.junit.Assert;
+import org.junit.Ignore;
+import org.junit.Test;
+
+
+/**
+ * Model tests for PrefixDiffSummary
+ */
+public class PrefixDiffSummaryTest {
+    private final PrefixDiffSummary model = new PrefixDiffSummary();
+
+    /**
+     * Model tests for PrefixDiffSummary
+     */
+    @Test
+    public void testPrefixDiffSummary() {
+        // TODO: test PrefixDiffSummary
+    }
+
+    /**
+     * Test the property 'prefix'
+     */
+    @Test
+    public void prefixTest() {
+        // TODO: test prefix
+    }
+
+    /**
+     * Test the property 'added'
+     */
+    @Test
+    public void addedTest() {
+        // TODO: test added
+    }
+
+    /**
+     * Test the property 'removed'
+     */
+    @Test
+    public void removedTest() {
+        // TODO: test removed
+    }
+
+    /**
+     * Test the property 'changed'
+     */
+    @Test
+    public void changedTest() {
+        // TODO: test changed
+    }
+
+    /**
+     * Test the property 'addedBytes'
+     */
+    @Test
+    public void addedBytesTest() {
+        // TODO: test addedBytes
+    }
+
+    /**
+     * Test the property 'removedBytes'
+     */
+    @Test
+    public void removedBytesTest() {
+        // TODO: test removedBytes
+    }
+
+    /**
+     * Test the property 'changedBytes'
+     */
+    @Test
+    public void changedBytesTest() {
+        // TODO: test changedBytes
+    }
+
+}
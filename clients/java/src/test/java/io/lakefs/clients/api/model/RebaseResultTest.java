@@ -0,0 +1,78 @@
+/*
+ * lakeFS API
+ * lakeFS HTTP API
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+package io.lakefs.clients.api.model;
+
+import com.google.gson.TypeAdapter;
+import com.google.gson.annotations.JsonAdapter;
+import com.google.gson.annotations.SerializedName;
+import com.google.gson.stream.JsonReader;
+import com.google.gson.stream.JsonWriter;
+import io.lakefs.clients.api.model.MergeConflict;
+import io.swagger.annotations.ApiModel;
+import io.swagger.annotations.ApiModelProperty;
+import java.io.IOException;
+import java.util.ArrayList;
+import java.util.List;
+import org.junit.Assert;
+import org.junit.Ignore;
+import org.junit.Test;
+
+
+/**
+ * Model tests for RebaseResult
+ */
+public class RebaseResultTest {
+    private final RebaseResult model = new RebaseResult();
+
+    /**
+     * Model tests for RebaseResult
+     */
+    @Test
+    public void testRebaseResult() {
+        // TODO: test RebaseResult
+    }
+
+    /**
+     * Test the property 'reference'
+     */
+    @Test
+    public void referenceTest() {
+        // TODO: test reference
+    }
+
+    /**
+     * Test the property 'conflictCommitId'
+     */
+    @Test
+    public void conflictCommitIdTest() {
+        // TODO: test conflictCommitId
+    }
+
+    /**
+     * Test the property 'conflicts'
+     */
+    @Test
+    public void conflictsTest() {
+        // TODO: test conflicts
+    }
+
+    /**
+     * Test the property 'conflictsTruncated'
+     */
+    @Test
+    public void conflictsTruncatedTest() {
+        // TODO: test conflictsTruncated
+    }
+
+}
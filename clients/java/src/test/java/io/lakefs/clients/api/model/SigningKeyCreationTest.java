@@ -0,0 +1,59 @@
+/*
+ * lakeFS API
+ * lakeFS HTTP API
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+package io.lakefs.clients.api.model;
+
+import com.google.gson.TypeAdapter;
+import com.google.gson.annotations.JsonAdapter;
+import com.google.gson.annotations.SerializedName;
+import com.google.gson.stream.JsonReader;
+import com.google.gson.stream.JsonWriter;
+import io.swagger.annotations.ApiModel;
+import io.swagger.annotations.ApiModelProperty;
+import java.io.IOException;
+import org.junit.Assert;
+import org.junit.Ignore;
+import org.junit.Test;
+
+
+/**
+ * Model tests for SigningKeyCreation
+ */
+public class SigningKeyCreationTest {
+    private final SigningKeyCreation model = new SigningKeyCreation();
+
+    /**
+     * Model tests for SigningKeyCreation
+     */
+    @Test
+    public void testSigningKeyCreation() {
+        // TODO: test SigningKeyCreation
+    }
+
+    /**
+     * Test the property 'keyType'
+     */
+    @Test
+    public void keyTypeTest() {
+        // TODO: test keyType
+    }
+
+    /**
+     * Test the property 'publicKey'
+     */
+    @Test
+    public void publicKeyTest() {
+        // TODO: test publicKey
+    }
+
+}
@@ -31,9 +31,13 @@ from lakefs_client.model.error import Error
 from lakefs_client.model.group import Group
 from lakefs_client.model.group_creation import GroupCreation
 from lakefs_client.model.group_list import GroupList
+from lakefs_client.model.inline_object import InlineObject
 from lakefs_client.model.login_information import LoginInformation
 from lakefs_client.model.policy import Policy
 from lakefs_client.model.policy_list import PolicyList
+from lakefs_client.model.signing_key import SigningKey
+from lakefs_client.model.signing_key_creation import SigningKeyCreation
+from lakefs_client.model.signing_key_list import SigningKeyList
 from lakefs_client.model.user import User
 from lakefs_client.model.user_creation import UserCreation
 from lakefs_client.model.user_list import UserList
@@ -109,6 +113,66 @@ class AuthApi(object):
             },
             api_client=api_client
         )
+        self.add_signing_key_endpoint = _Endpoint(
+            settings={
+                'response_type': (SigningKey,),
+                'auth': [
+                    'basic_auth',
+                    'cookie_auth',
+                    'jwt_token'
+                ],
+                'endpoint_path': '/auth/users/{userId}/signing_keys',
+                'operation_id': 'add_signing_key',
+                'http_method': 'POST',
+                'servers': None,
+            },
+            params_map={
+                'all': [
+                    'user_id',
+                    'signing_key_creation',
+                ],
+                'required': [
+                    'user_id',
+                    'signing_key_creation',
+                ],
+                'nullable': [
+                ],
+                'enum': [
+                ],
+                'validation': [
+                ]
+            },
+            root_map={
+                'validations': {
+                },
+                'allowed_values': {
+                },
+                'openapi_types': {
+                    'user_id':
+                        (str,),
+                    'signing_key_creation':
+                        (SigningKeyCreation,),
+                },
+                'attribute_map': {
+                    'user_id': 'userId',
+                },
+                'location_map': {
+                    'user_id': 'path',
+                    'signing_key_creation': 'body',
+                },
+                'collection_format_map': {
+                }
+            },
+            headers_map={
+                'accept': [
+                    'application/json'
+                ],
+                'content_type': [
+                    'application/json'
+                ]
+            },
+            api_client=api_client
+        )
         self.attach_policy_to_group_endpoint = _Endpoint(
             settings={
                 'response_type': None,
@@ -662,6 +726,66 @@ class AuthApi(object):
             },
             api_client=api_client
         )
+        self.delete_signing_key_endpoint = _Endpoint(
+            settings={
+                'response_type': None,
+                'auth': [
+                    'basic_auth',
+                    'cookie_auth',
+                    'jwt_token'
+                ],
+                'endpoint_path': '/auth/users/{userId}/signing_keys',
+                'operation_id': 'delete_signing_key',
+                'http_method': 'DELETE',
+                'servers': None,
+            },
+            params_map={
+                'all': [
+                    'user_id',
+                    'inline_object',
+                ],
+                'required': [
+                    'user_id',
+                    'inline_object',
+                ],
+                'nullable': [
+                ],
+                'enum': [
+                ],
+                'validation': [
+                ]
+            },
+            root_map={
+                'validations': {
+                },
+                'allowed_values': {
+                },
+                'openapi_types': {
+                    'user_id':
+                        (str,),
+                    'inline_object':
+                        (InlineObject,),
+                },
+                'attribute_map': {
+                    'user_id': 'userId',
+                },
+                'location_map': {
+                    'user_id': 'path',
+                    'inline_object': 'body',
+                },
+                'collection_format_map': {
+                }
+            },
+            headers_map={
+                'accept': [
+                    'application/json'
+                ],
+                'content_type': [
+                    'application/json'
+                ]
+            },
+            api_client=api_client
+        )
         self.delete_user_endpoint = _Endpoint(
             settings={
                 'response_type': None,
@@ -1606,6 +1730,80 @@ class AuthApi(object):
             },
             api_client=api_client
         )
+        self.list_user_signing_keys_endpoint = _Endpoint(
+            settings={
+                'response_type': (SigningKeyList,),
+                'auth': [
+                    'basic_auth',
+                    'cookie_auth',
+                    'jwt_token'
+                ],
+                'endpoint_path': '/auth/users/{userId}/signing_keys',
+                'operation_id': 'list_user_signing_keys',
+                'http_method': 'GET',
+                'servers': None,
+            },
+            params_map={
+                'all': [
+                    'user_id',
+                    'prefix',
+                    'after',
+                    'amount',
+                ],
+                'required': [
+                    'user_id',
+                ],
+                'nullable': [
+                ],
+                'enum': [
+                ],
+                'validation': [
+                    'amount',
+                ]
+            },
+            root_map={
+                'validations': {
+                    ('amount',): {
+
+                        'inclusive_maximum': 1000,
+                        'inclusive_minimum': -1,
+                    },
+                },
+                'allowed_values': {
+                },
+                'openapi_types': {
+                    'user_id':
+                        (str,),
+                    'prefix':
+                        (str,),
+                    'after':
+                        (str,),
+                    'amount':
+                        (int,),
+                },
+                'attribute_map': {
+                    'user_id': 'userId',
+                    'prefix': 'prefix',
+                    'after': 'after',
+                    'amount': 'amount',
+                },
+                'location_map': {
+                    'user_id': 'path',
+                    'prefix': 'query',
+                    'after': 'query',
+                    'amount': 'query',
+                },
+                'collection_format_map': {
+                }
+            },
+            headers_map={
+                'accept': [
+                    'application/json'
+                ],
+                'content_type': [],
+            },
+            api_client=api_client
+        )
         self.list_users_endpoint = _Endpoint(
             settings={
                 'response_type': (UserList,),
@@ -1895,6 +2093,75 @@ class AuthApi(object):
             user_id
         return self.add_group_membership_endpoint.call_with_http_info(**kwargs)
 
+    def add_signing_key(
+        self,
+        user_id,
+        signing_key_creation,
+        **kwargs
+    ):
+        """add a signing key for verifying commit signatures of the user  # noqa: E501
+
+        This method makes a synchronous HTTP request by default. To make an
+        asynchronous HTTP request, please pass async_req=True
+
+        >>> thread = api.add_signing_key(user_id, signing_key_creation, async_req=True)
+        >>> result = thread.get()
+
+        Args:
+            user_id (str):
+            signing_key_creation (SigningKeyCreation):
+
+        Keyword Args:
+            _return_http_data_only (bool): response data without head status
+                code and headers. Default is True.
+            _preload_content (bool): if False, the urllib3.HTTPResponse object
+                will be returned without reading/decoding response data.
+                Default is True.
+            _request_timeout (int/float/tuple): timeout setting for this request. If
+                one number provided, it will be total request timeout. It can also
+                be a pair (tuple) of (connection, read) timeouts.
+                Default is None.
+            _check_input_type (bool): specifies if type checking
+                should be done one the data sent to the server.
+                Default is True.
+            _check_return_type (bool): specifies if type checking
+                should be done one the data received from the server.
+                Default is True.
+            _host_index (int/None): specifies the index of the server
+                that we want to use.
+                Default is read from the configuration.
+            async_req (bool): execute request asynchronously
+
+        Returns:
+            SigningKey
+                If the method is called asynchronously, returns the request
+                thread.
+        """
+        kwargs['async_req'] = kwargs.get(
+            'async_req', False
+        )
+        kwargs['_return_http_data_only'] = kwargs.get(
+            '_return_http_data_only', True
+        )
+        kwargs['_preload_content'] = kwargs.get(
+            '_preload_content', True
+        )
+        kwargs['_request_timeout'] = kwargs.get(
+            '_request_timeout', None
+        )
+        kwargs['_check_input_type'] = kwargs.get(
+            '_check_input_type', True
+        )
+        kwargs['_check_return_type'] = kwargs.get(
+            '_check_return_type', True
+        )
+        kwargs['_host_index'] = kwargs.get('_host_index')
+        kwargs['user_id'] = \
+            user_id
+        kwargs['signing_key_creation'] = \
+            signing_key_creation
+        return self.add_signing_key_endpoint.call_with_http_info(**kwargs)
+
     def attach_policy_to_group(
         self,
         group_id,
@@ -2553,6 +2820,75 @@ class AuthApi(object):
             policy_id
         return self.delete_policy_endpoint.call_with_http_info(**kwargs)
 
+    def delete_signing_key(
+        self,
+        user_id,
+        inline_object,
+        **kwargs
+    ):
+        """delete signing key  # noqa: E501
+
+        This method makes a synchronous HTTP request by default. To make an
+        asynchronous HTTP request, please pass async_req=True
+
+        >>> thread = api.delete_signing_key(user_id, inline_object, async_req=True)
+        >>> result = thread.get()
+
+        Args:
+            user_id (str):
+            inline_object (InlineObject):
+
+        Keyword Args:
+            _return_http_data_only (bool): response data without head status
+                code and headers. Default is True.
+            _preload_content (bool): if False, the urllib3.HTTPResponse object
+                will be returned without reading/decoding response data.
+                Default is True.
+            _request_timeout (int/float/tuple): timeout setting for this request. If
+                one number provided, it will be total request timeout. It can also
+                be a pair (tuple) of (connection, read) timeouts.
+                Default is None.
+            _check_input_type (bool): specifies if type checking
+                should be done one the data sent to the server.
+                Default is True.
+            _check_return_type (bool): specifies if type checking
+                should be done one the data received from the server.
+                Default is True.
+            _host_index (int/None): specifies the index of the server
+                that we want to use.
+                Default is read from the configuration.
+            async_req (bool): execute request asynchronously
+
+        Returns:
+            None
+                If the method is called asynchronously, returns the request
+                thread.
+        """
+        kwargs['async_req'] = kwargs.get(
+            'async_req', False
+        )
+        kwargs['_return_http_data_only'] = kwargs.get(
+            '_return_http_data_only', True
+        )
+        kwargs['_preload_content'] = kwargs.get(
+            '_preload_content', True
+        )
+        kwargs['_request_timeout'] = kwargs.get(
+            '_request_timeout', None
+        )
+        kwargs['_check_input_type'] = kwargs.get(
+            '_check_input_type', True
+        )
+        kwargs['_check_return_type'] = kwargs.get(
+            '_check_return_type', True
+        )
+        kwargs['_host_index'] = kwargs.get('_host_index')
+        kwargs['user_id'] = \
+            user_id
+        kwargs['inline_object'] = \
+            inline_object
+        return self.delete_signing_key_endpoint.call_with_http_info(**kwargs)
+
     def delete_user(
         self,
         user_id,
@@ -3547,6 +3883,74 @@ class AuthApi(object):
             user_id
         return self.list_user_policies_endpoint.call_with_http_info(**kwargs)
 
+    def list_user_signing_keys(
+        self,
+        user_id,
+        **kwargs
+    ):
+        """list user signing keys  # noqa: E501
+
+        This method makes a synchronous HTTP request by default. To make an
+        asynchronous HTTP request, please pass async_req=True
+
+        >>> thread = api.list_user_signing_keys(user_id, async_req=True)
+        >>> result = thread.get()
+
+        Args:
+            user_id (str):
+
+        Keyword Args:
+            prefix (str): return items prefixed with this value. [optional]
+            after (str): return items after this value. [optional]
+            amount (int): how many items to return. [optional] if omitted the server will use the default value of 100
+            _return_http_data_only (bool): response data without head status
+                code and headers. Default is True.
+            _preload_content (bool): if False, the urllib3.HTTPResponse object
+                will be returned without reading/decoding response data.
+                Default is True.
+            _request_timeout (int/float/tuple): timeout setting for this request. If
+                one number provided, it will be total request timeout. It can also
+                be a pair (tuple) of (connection, read) timeouts.
+                Default is None.
+            _check_input_type (bool): specifies if type checking
+                should be done one the data sent to the server.
+                Default is True.
+            _check_return_type (bool): specifies if type checking
+                should be done one the data received from the server.
+                Default is True.
+            _host_index (int/None): specifies the index of the server
+                that we want to use.
+                Default is read from the configuration.
+            async_req (bool): execute request asynchronously
+
+        Returns:
+            SigningKeyList
+                If the method is called asynchronously, returns the request
+                thread.
+        """
+        kwargs['async_req'] = kwargs.get(
+            'async_req', False
+        )
+        kwargs['_return_http_data_only'] = kwargs.get(
+            '_return_http_data_only', True
+        )
+        kwargs['_preload_content'] = kwargs.get(
+            '_preload_content', True
+        )
+        kwargs['_request_timeout'] = kwargs.get(
+            '_request_timeout', None
+        )
+        kwargs['_check_input_type'] = kwargs.get(
+            '_check_input_type', True
+        )
+        kwargs['_check_return_type'] = kwargs.get(
+            '_check_return_type', True
+        )
+        kwargs['_host_index'] = kwargs.get('_host_index')
+        kwargs['user_id'] = \
+            user_id
+        return self.list_user_signing_keys_endpoint.call_with_http_info(**kwargs)
+
     def list_users(
         self,
         **kwargs
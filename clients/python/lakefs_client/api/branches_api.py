@@ -22,13 +22,25 @@ from lakefs_client.model_utils import (  # noqa: F401
     none_type,
     validate_and_convert_types
 )
+from lakefs_client.model.branch_comparison import BranchComparison
+from lakefs_client.model.branch_comparison_list import BranchComparisonList
 from lakefs_client.model.branch_creation import BranchCreation
+from lakefs_client.model.branch_reflog_list import BranchReflogList
+from lakefs_client.model.branch_restore_creation import BranchRestoreCreation
+from lakefs_client.model.cherry_pick_creation import CherryPickCreation
+from lakefs_client.model.commit import Commit
 from lakefs_client.model.diff_list import DiffList
 from lakefs_client.model.error import Error
+from lakefs_client.model.rebase_creation import RebaseCreation
+from lakefs_client.model.rebase_result import RebaseResult
 from lakefs_client.model.ref import Ref
 from lakefs_client.model.ref_list import RefList
 from lakefs_client.model.reset_creation import ResetCreation
 from lakefs_client.model.revert_creation import RevertCreation
+from lakefs_client.model.stash import Stash
+from lakefs_client.model.stash_conflicts import StashConflicts
+from lakefs_client.model.stash_creation import StashCreation
+from lakefs_client.model.stash_list import StashList
 
 
 class BranchesApi(object):
@@ -42,6 +54,217 @@ class BranchesApi(object):
         if api_client is None:
             api_client = ApiClient()
         self.api_client = api_client
+        self.cherry_pick_endpoint = _Endpoint(
+            settings={
+                'response_type': (Commit,),
+                'auth': [
+                    'basic_auth',
+                    'cookie_auth',
+                    'jwt_token'
+                ],
+                'endpoint_path': '/repositories/{repository}/branches/{branch}/cherry-pick',
+                'operation_id': 'cherry_pick',
+                'http_method': 'POST',
+                'servers': None,
+            },
+            params_map={
+                'all': [
+                    'repository',
+                    'branch',
+                    'cherry_pick_creation',
+                ],
+                'required': [
+                    'repository',
+                    'branch',
+                    'cherry_pick_creation',
+                ],
+                'nullable': [
+                ],
+                'enum': [
+                ],
+                'validation': [
+                ]
+            },
+            root_map={
+                'validations': {
+                },
+                'allowed_values': {
+                },
+                'openapi_types': {
+                    'repository':
+                        (str,),
+                    'branch':
+                        (str,),
+                    'cherry_pick_creation':
+                        (CherryPickCreation,),
+                },
+                'attribute_map': {
+                    'repository': 'repository',
+                    'branch': 'branch',
+                },
+                'location_map': {
+                    'repository': 'path',
+                    'branch': 'path',
+                    'cherry_pick_creation': 'body',
+                },
+                'collection_format_map': {
+                }
+            },
+            headers_map={
+                'accept': [
+                    'application/json'
+                ],
+                'content_type': [
+                    'application/json'
+                ]
+            },
+            api_client=api_client
+        )
+        self.compare_all_branches_endpoint = _Endpoint(
+            settings={
+                'response_type': (BranchComparisonList,),
+                'auth': [
+                    'basic_auth',
+                    'cookie_auth',
+                    'jwt_token'
+                ],
+                'endpoint_path': '/repositories/{repository}/refs/{baseRef}/compare',
+                'operation_id': 'compare_all_branches',
+                'http_method': 'GET',
+                'servers': None,
+            },
+            params_map={
+                'all': [
+                    'repository',
+                    'base_ref',
+                    'prefix',
+                    'after',
+                    'amount',
+                ],
+                'required': [
+                    'repository',
+                    'base_ref',
+                ],
+                'nullable': [
+                ],
+                'enum': [
+                ],
+                'validation': [
+                    'amount',
+                ]
+            },
+            root_map={
+                'validations': {
+                    ('amount',): {
+
+                        'inclusive_maximum': 1000,
+                        'inclusive_minimum': -1,
+                    },
+                },
+                'allowed_values': {
+                },
+                'openapi_types': {
+                    'repository':
+                        (str,),
+                    'base_ref':
+                        (str,),
+                    'prefix':
+                        (str,),
+                    'after':
+                        (str,),
+                    'amount':
+                        (int,),
+                },
+                'attribute_map': {
+                    'repository': 'repository',
+                    'base_ref': 'baseRef',
+                    'prefix': 'prefix',
+                    'after': 'after',
+                    'amount': 'amount',
+                },
+                'location_map': {
+                    'repository': 'path',
+                    'base_ref': 'path',
+                    'prefix': 'query',
+                    'after': 'query',
+                    'amount': 'query',
+                },
+                'collection_format_map': {
+                }
+            },
+            headers_map={
+                'accept': [
+                    'application/json'
+                ],
+                'content_type': [],
+            },
+            api_client=api_client
+        )
+        self.compare_branches_endpoint = _Endpoint(
+            settings={
+                'response_type': (BranchComparison,),
+                'auth': [
+                    'basic_auth',
+                    'cookie_auth',
+                    'jwt_token'
+                ],
+                'endpoint_path': '/repositories/{repository}/refs/{baseRef}/compare/{branch}',
+                'operation_id': 'compare_branches',
+                'http_method': 'GET',
+                'servers': None,
+            },
+            params_map={
+                'all': [
+                    'repository',
+                    'base_ref',
+                    'branch',
+                ],
+                'required': [
+                    'repository',
+                    'base_ref',
+                    'branch',
+                ],
+                'nullable': [
+                ],
+                'enum': [
+                ],
+                'validation': [
+                ]
+            },
+            root_map={
+                'validations': {
+                },
+                'allowed_values': {
+                },
+                'openapi_types': {
+                    'repository':
+                        (str,),
+                    'base_ref':
+                        (str,),
+                    'branch':
+                        (str,),
+                },
+                'attribute_map': {
+                    'repository': 'repository',
+                    'base_ref': 'baseRef',
+                    'branch': 'branch',
+                },
+                'location_map': {
+                    'repository': 'path',
+                    'base_ref': 'path',
+                    'branch': 'path',
+                },
+                'collection_format_map': {
+                }
+            },
+            headers_map={
+                'accept': [
+                    'application/json'
+                ],
+                'content_type': [],
+            },
+            api_client=api_client
+        )
         self.create_branch_endpoint = _Endpoint(
             settings={
                 'response_type': (str,),
@@ -306,28 +529,29 @@ class BranchesApi(object):
             },
             api_client=api_client
         )
-        self.list_branches_endpoint = _Endpoint(
+        self.list_branch_reflog_endpoint = _Endpoint(
             settings={
-                'response_type': (RefList,),
+                'response_type': (BranchReflogList,),
                 'auth': [
                     'basic_auth',
                     'cookie_auth',
                     'jwt_token'
                 ],
-                'endpoint_path': '/repositories/{repository}/branches',
-                'operation_id': 'list_branches',
+                'endpoint_path': '/repositories/{repository}/branches/{branch}/reflog',
+                'operation_id': 'list_branch_reflog',
                 'http_method': 'GET',
                 'servers': None,
             },
             params_map={
                 'all': [
                     'repository',
-                    'prefix',
+                    'branch',
                     'after',
                     'amount',
                 ],
                 'required': [
                     'repository',
+                    'branch',
                 ],
                 'nullable': [
                 ],
@@ -350,7 +574,7 @@ class BranchesApi(object):
                 'openapi_types': {
                     'repository':
                         (str,),
-                    'prefix':
+                    'branch':
                         (str,),
                     'after':
                         (str,),
@@ -359,13 +583,13 @@ class BranchesApi(object):
                 },
                 'attribute_map': {
                     'repository': 'repository',
-                    'prefix': 'prefix',
+                    'branch': 'branch',
                     'after': 'after',
                     'amount': 'amount',
                 },
                 'location_map': {
                     'repository': 'path',
-                    'prefix': 'query',
+                    'branch': 'path',
                     'after': 'query',
                     'amount': 'query',
                 },
@@ -380,58 +604,68 @@ class BranchesApi(object):
             },
             api_client=api_client
         )
-        self.reset_branch_endpoint = _Endpoint(
+        self.list_branches_endpoint = _Endpoint(
             settings={
-                'response_type': None,
+                'response_type': (RefList,),
                 'auth': [
                     'basic_auth',
                     'cookie_auth',
                     'jwt_token'
                 ],
-                'endpoint_path': '/repositories/{repository}/branches/{branch}',
-                'operation_id': 'reset_branch',
-                'http_method': 'PUT',
+                'endpoint_path': '/repositories/{repository}/branches',
+                'operation_id': 'list_branches',
+                'http_method': 'GET',
                 'servers': None,
             },
             params_map={
                 'all': [
                     'repository',
-                    'branch',
-                    'reset_creation',
+                    'prefix',
+                    'after',
+                    'amount',
                 ],
                 'required': [
                     'repository',
-                    'branch',
-                    'reset_creation',
                 ],
                 'nullable': [
                 ],
                 'enum': [
                 ],
                 'validation': [
+                    'amount',
                 ]
             },
             root_map={
                 'validations': {
+                    ('amount',): {
+
+                        'inclusive_maximum': 1000,
+                        'inclusive_minimum': -1,
+                    },
                 },
                 'allowed_values': {
                 },
                 'openapi_types': {
                     'repository':
                         (str,),
-                    'branch':
+                    'prefix':
                         (str,),
-                    'reset_creation':
-                        (ResetCreation,),
+                    'after':
+                        (str,),
+                    'amount':
+                        (int,),
                 },
                 'attribute_map': {
                     'repository': 'repository',
-                    'branch': 'branch',
+                    'prefix': 'prefix',
+                    'after': 'after',
+                    'amount': 'amount',
                 },
                 'location_map': {
                     'repository': 'path',
-                    'branch': 'path',
-                    'reset_creation': 'body',
+                    'prefix': 'query',
+                    'after': 'query',
+                    'amount': 'query',
                 },
                 'collection_format_map': {
                 }
@@ -440,96 +674,1341 @@ class BranchesApi(object):
                 'accept': [
                     'application/json'
                 ],
-                'content_type': [
-                    'application/json'
-                ]
+                'content_type': [],
             },
             api_client=api_client
         )
-        self.revert_branch_endpoint = _Endpoint(
-            settings={
-                'response_type': None,
-                'auth': [
-                    'basic_auth',
-                    'cookie_auth',
-                    'jwt_token'
-                ],
-                'endpoint_path': '/repositories/{repository}/branches/{branch}/revert',
-                'operation_id': 'revert_branch',
-                'http_method': 'POST',
-                'servers': None,
-            },
-            params_map={
-                'all': [
-                    'repository',
-                    'branch',
-                    'revert_creation',
-                ],
-                'required': [
-                    'repository',
-                    'branch',
-                    'revert_creation',
-                ],
-                'nullable': [
-                ],
-                'enum': [
-                ],
-                'validation': [
-                ]
-            },
-            root_map={
-                'validations': {
-                },
-                'allowed_values': {
-                },
-                'openapi_types': {
-                    'repository':
-                        (str,),
-                    'branch':
-                        (str,),
-                    'revert_creation':
-                        (RevertCreation,),
-                },
-                'attribute_map': {
-                    'repository': 'repository',
-                    'branch': 'branch',
-                },
-                'location_map': {
-                    'repository': 'path',
-                    'branch': 'path',
-                    'revert_creation': 'body',
-                },
-                'collection_format_map': {
-                }
-            },
-            headers_map={
-                'accept': [
-                    'application/json'
-                ],
-                'content_type': [
-                    'application/json'
-                ]
-            },
-            api_client=api_client
+        self.list_stashes_endpoint = _Endpoint(
+            settings={
+                'response_type': (StashList,),
+                'auth': [
+                    'basic_auth',
+                    'cookie_auth',
+                    'jwt_token'
+                ],
+                'endpoint_path': '/repositories/{repository}/branches/{branch}/stashes',
+                'operation_id': 'list_stashes',
+                'http_method': 'GET',
+                'servers': None,
+            },
+            params_map={
+                'all': [
+                    'repository',
+                    'branch',
+                    'after',
+                    'amount',
+                ],
+                'required': [
+                    'repository',
+                    'branch',
+                ],
+                'nullable': [
+                ],
+                'enum': [
+                ],
+                'validation': [
+                    'amount',
+                ]
+            },
+            root_map={
+                'validations': {
+                    ('amount',): {
+
+                        'inclusive_maximum': 1000,
+                        'inclusive_minimum': -1,
+                    },
+                },
+                'allowed_values': {
+                },
+                'openapi_types': {
+                    'repository':
+                        (str,),
+                    'branch':
+                        (str,),
+                    'after':
+                        (str,),
+                    'amount':
+                        (int,),
+                },
+                'attribute_map': {
+                    'repository': 'repository',
+                    'branch': 'branch',
+                    'after': 'after',
+                    'amount': 'amount',
+                },
+                'location_map': {
+                    'repository': 'path',
+                    'branch': 'path',
+                    'after': 'query',
+                    'amount': 'query',
+                },
+                'collection_format_map': {
+                }
+            },
+            headers_map={
+                'accept': [
+                    'application/json'
+                ],
+                'content_type': [],
+            },
+            api_client=api_client
+        )
+        self.rebase_branch_endpoint = _Endpoint(
+            settings={
+                'response_type': (RebaseResult,),
+                'auth': [
+                    'basic_auth',
+                    'cookie_auth',
+                    'jwt_token'
+                ],
+                'endpoint_path': '/repositories/{repository}/branches/{branch}/rebase',
+                'operation_id': 'rebase_branch',
+                'http_method': 'POST',
+                'servers': None,
+            },
+            params_map={
+                'all': [
+                    'repository',
+                    'branch',
+                    'rebase_creation',
+                ],
+                'required': [
+                    'repository',
+                    'branch',
+                    'rebase_creation',
+                ],
+                'nullable': [
+                ],
+                'enum': [
+                ],
+                'validation': [
+                ]
+            },
+            root_map={
+                'validations': {
+                },
+                'allowed_values': {
+                },
+                'openapi_types': {
+                    'repository':
+                        (str,),
+                    'branch':
+                        (str,),
+                    'rebase_creation':
+                        (RebaseCreation,),
+                },
+                'attribute_map': {
+                    'repository': 'repository',
+                    'branch': 'branch',
+                },
+                'location_map': {
+                    'repository': 'path',
+                    'branch': 'path',
+                    'rebase_creation': 'body',
+                },
+                'collection_format_map': {
+                }
+            },
+            headers_map={
+                'accept': [
+                    'application/json'
+                ],
+                'content_type': [
+                    'application/json'
+                ]
+            },
+            api_client=api_client
+        )
+        self.reset_branch_endpoint = _Endpoint(
+            settings={
+                'response_type': None,
+                'auth': [
+                    'basic_auth',
+                    'cookie_auth',
+                    'jwt_token'
+                ],
+                'endpoint_path': '/repositories/{repository}/branches/{branch}',
+                'operation_id': 'reset_branch',
+                'http_method': 'PUT',
+                'servers': None,
+            },
+            params_map={
+                'all': [
+                    'repository',
+                    'branch',
+                    'reset_creation',
+                ],
+                'required': [
+                    'repository',
+                    'branch',
+                    'reset_creation',
+                ],
+                'nullable': [
+                ],
+                'enum': [
+                ],
+                'validation': [
+                ]
+            },
+            root_map={
+                'validations': {
+                },
+                'allowed_values': {
+                },
+                'openapi_types': {
+                    'repository':
+                        (str,),
+                    'branch':
+                        (str,),
+                    'reset_creation':
+                        (ResetCreation,),
+                },
+                'attribute_map': {
+                    'repository': 'repository',
+                    'branch': 'branch',
+                },
+                'location_map': {
+                    'repository': 'path',
+                    'branch': 'path',
+                    'reset_creation': 'body',
+                },
+                'collection_format_map': {
+                }
+            },
+            headers_map={
+                'accept': [
+                    'application/json'
+                ],
+                'content_type': [
+                    'application/json'
+                ]
+            },
+            api_client=api_client
+        )
+        self.restore_branch_endpoint = _Endpoint(
+            settings={
+                'response_type': (Ref,),
+                'auth': [
+                    'basic_auth',
+                    'cookie_auth',
+                    'jwt_token'
+                ],
+                'endpoint_path': '/repositories/{repository}/branches/{branch}/restore',
+                'operation_id': 'restore_branch',
+                'http_method': 'POST',
+                'servers': None,
+            },
+            params_map={
+                'all': [
+                    'repository',
+                    'branch',
+                    'branch_restore_creation',
+                ],
+                'required': [
+                    'repository',
+                    'branch',
+                    'branch_restore_creation',
+                ],
+                'nullable': [
+                ],
+                'enum': [
+                ],
+                'validation': [
+                ]
+            },
+            root_map={
+                'validations': {
+                },
+                'allowed_values': {
+                },
+                'openapi_types': {
+                    'repository':
+                        (str,),
+                    'branch':
+                        (str,),
+                    'branch_restore_creation':
+                        (BranchRestoreCreation,),
+                },
+                'attribute_map': {
+                    'repository': 'repository',
+                    'branch': 'branch',
+                },
+                'location_map': {
+                    'repository': 'path',
+                    'branch': 'path',
+                    'branch_restore_creation': 'body',
+                },
+                'collection_format_map': {
+                }
+            },
+            headers_map={
+                'accept': [
+                    'application/json'
+                ],
+                'content_type': [
+                    'application/json'
+                ]
+            },
+            api_client=api_client
+        )
+        self.revert_branch_endpoint = _Endpoint(
+            settings={
+                'response_type': None,
+                'auth': [
+                    'basic_auth',
+                    'cookie_auth',
+                    'jwt_token'
+                ],
+                'endpoint_path': '/repositories/{repository}/branches/{branch}/revert',
+                'operation_id': 'revert_branch',
+                'http_method': 'POST',
+                'servers': None,
+            },
+            params_map={
+                'all': [
+                    'repository',
+                    'branch',
+                    'revert_creation',
+                ],
+                'required': [
+                    'repository',
+                    'branch',
+                    'revert_creation',
+                ],
+                'nullable': [
+                ],
+                'enum': [
+                ],
+                'validation': [
+                ]
+            },
+            root_map={
+                'validations': {
+                },
+                'allowed_values': {
+                },
+                'openapi_types': {
+                    'repository':
+                        (str,),
+                    'branch':
+                        (str,),
+                    'revert_creation':
+                        (RevertCreation,),
+                },
+                'attribute_map': {
+                    'repository': 'repository',
+                    'branch': 'branch',
+                },
+                'location_map': {
+                    'repository': 'path',
+                    'branch': 'path',
+                    'revert_creation': 'body',
+                },
+                'collection_format_map': {
+                }
+            },
+            headers_map={
+                'accept': [
+                    'application/json'
+                ],
+                'content_type': [
+                    'application/json'
+                ]
+            },
+            api_client=api_client
+        )
+        self.stash_apply_endpoint = _Endpoint(
+            settings={
+                'response_type': None,
+                'auth': [
+                    'basic_auth',
+                    'cookie_auth',
+                    'jwt_token'
+                ],
+                'endpoint_path': '/repositories/{repository}/branches/{branch}/stashes/{stash}/apply',
+                'operation_id': 'stash_apply',
+                'http_method': 'POST',
+                'servers': None,
+            },
+            params_map={
+                'all': [
+                    'repository',
+                    'branch',
+                    'stash',
+                ],
+                'required': [
+                    'repository',
+                    'branch',
+                    'stash',
+                ],
+                'nullable': [
+                ],
+                'enum': [
+                ],
+                'validation': [
+                ]
+            },
+            root_map={
+                'validations': {
+                },
+                'allowed_values': {
+                },
+                'openapi_types': {
+                    'repository':
+                        (str,),
+                    'branch':
+                        (str,),
+                    'stash':
+                        (str,),
+                },
+                'attribute_map': {
+                    'repository': 'repository',
+                    'branch': 'branch',
+                    'stash': 'stash',
+                },
+                'location_map': {
+                    'repository': 'path',
+                    'branch': 'path',
+                    'stash': 'path',
+                },
+                'collection_format_map': {
+                }
+            },
+            headers_map={
+                'accept': [
+                    'application/json'
+                ],
+                'content_type': [],
+            },
+            api_client=api_client
+        )
+        self.stash_drop_endpoint = _Endpoint(
+            settings={
+                'response_type': None,
+                'auth': [
+                    'basic_auth',
+                    'cookie_auth',
+                    'jwt_token'
+                ],
+                'endpoint_path': '/repositories/{repository}/branches/{branch}/stashes/{stash}',
+                'operation_id': 'stash_drop',
+                'http_method': 'DELETE',
+                'servers': None,
+            },
+            params_map={
+                'all': [
+                    'repository',
+                    'branch',
+                    'stash',
+                ],
+                'required': [
+                    'repository',
+                    'branch',
+                    'stash',
+                ],
+                'nullable': [
+                ],
+                'enum': [
+                ],
+                'validation': [
+                ]
+            },
+            root_map={
+                'validations': {
+                },
+                'allowed_values': {
+                },
+                'openapi_types': {
+                    'repository':
+                        (str,),
+                    'branch':
+                        (str,),
+                    'stash':
+                        (str,),
+                },
+                'attribute_map': {
+                    'repository': 'repository',
+                    'branch': 'branch',
+                    'stash': 'stash',
+                },
+                'location_map': {
+                    'repository': 'path',
+                    'branch': 'path',
+                    'stash': 'path',
+                },
+                'collection_format_map': {
+                }
+            },
+            headers_map={
+                'accept': [
+                    'application/json'
+                ],
+                'content_type': [],
+            },
+            api_client=api_client
+        )
+        self.stash_pop_endpoint = _Endpoint(
+            settings={
+                'response_type': None,
+                'auth': [
+                    'basic_auth',
+                    'cookie_auth',
+                    'jwt_token'
+                ],
+                'endpoint_path': '/repositories/{repository}/branches/{branch}/stashes/{stash}/pop',
+                'operation_id': 'stash_pop',
+                'http_method': 'POST',
+                'servers': None,
+            },
+            params_map={
+                'all': [
+                    'repository',
+                    'branch',
+                    'stash',
+                ],
+                'required': [
+                    'repository',
+                    'branch',
+                    'stash',
+                ],
+                'nullable': [
+                ],
+                'enum': [
+                ],
+                'validation': [
+                ]
+            },
+            root_map={
+                'validations': {
+                },
+                'allowed_values': {
+                },
+                'openapi_types': {
+                    'repository':
+                        (str,),
+                    'branch':
+                        (str,),
+                    'stash':
+                        (str,),
+                },
+                'attribute_map': {
+                    'repository': 'repository',
+                    'branch': 'branch',
+                    'stash': 'stash',
+                },
+                'location_map': {
+                    'repository': 'path',
+                    'branch': 'path',
+                    'stash': 'path',
+                },
+                'collection_format_map': {
+                }
+            },
+            headers_map={
+                'accept': [
+                    'application/json'
+                ],
+                'content_type': [],
+            },
+            api_client=api_client
+        )
+        self.stash_push_endpoint = _Endpoint(
+            settings={
+                'response_type': (Stash,),
+                'auth': [
+                    'basic_auth',
+                    'cookie_auth',
+                    'jwt_token'
+                ],
+                'endpoint_path': '/repositories/{repository}/branches/{branch}/stashes',
+                'operation_id': 'stash_push',
+                'http_method': 'POST',
+                'servers': None,
+            },
+            params_map={
+                'all': [
+                    'repository',
+                    'branch',
+                    'stash_creation',
+                ],
+                'required': [
+                    'repository',
+                    'branch',
+                    'stash_creation',
+                ],
+                'nullable': [
+                ],
+                'enum': [
+                ],
+                'validation': [
+                ]
+            },
+            root_map={
+                'validations': {
+                },
+                'allowed_values': {
+                },
+                'openapi_types': {
+                    'repository':
+                        (str,),
+                    'branch':
+                        (str,),
+                    'stash_creation':
+                        (StashCreation,),
+                },
+                'attribute_map': {
+                    'repository': 'repository',
+                    'branch': 'branch',
+                },
+                'location_map': {
+                    'repository': 'path',
+                    'branch': 'path',
+                    'stash_creation': 'body',
+                },
+                'collection_format_map': {
+                }
+            },
+            headers_map={
+                'accept': [
+                    'application/json'
+                ],
+                'content_type': [
+                    'application/json'
+                ]
+            },
+            api_client=api_client
+        )
+
+    def cherry_pick(
+        self,
+        repository,
+        branch,
+        cherry_pick_creation,
+        **kwargs
+    ):
+        """apply the changes of a commit on top of a branch  # noqa: E501
+
+        This method makes a synchronous HTTP request by default. To make an
+        asynchronous HTTP request, please pass async_req=True
+
+        >>> thread = api.cherry_pick(repository, branch, cherry_pick_creation, async_req=True)
+        >>> result = thread.get()
+
+        Args:
+            repository (str):
+            branch (str):
+            cherry_pick_creation (CherryPickCreation):
+
+        Keyword Args:
+            _return_http_data_only (bool): response data without head status
+                code and headers. Default is True.
+            _preload_content (bool): if False, the urllib3.HTTPResponse object
+                will be returned without reading/decoding response data.
+                Default is True.
+            _request_timeout (int/float/tuple): timeout setting for this request. If
+                one number provided, it will be total request timeout. It can also
+                be a pair (tuple) of (connection, read) timeouts.
+                Default is None.
+            _check_input_type (bool): specifies if type checking
+                should be done one the data sent to the server.
+                Default is True.
+            _check_return_type (bool): specifies if type checking
+                should be done one the data received from the server.
+                Default is True.
+            _host_index (int/None): specifies the index of the server
+                that we want to use.
+                Default is read from the configuration.
+            async_req (bool): execute request asynchronously
+
+        Returns:
+            Commit
+                If the method is called asynchronously, returns the request
+                thread.
+        """
+        kwargs['async_req'] = kwargs.get(
+            'async_req', False
+        )
+        kwargs['_return_http_data_only'] = kwargs.get(
+            '_return_http_data_only', True
+        )
+        kwargs['_preload_content'] = kwargs.get(
+            '_preload_content', True
+        )
+        kwargs['_request_timeout'] = kwargs.get(
+            '_request_timeout', None
+        )
+        kwargs['_check_input_type'] = kwargs.get(
+            '_check_input_type', True
+        )
+        kwargs['_check_return_type'] = kwargs.get(
+            '_check_return_type', True
+        )
+        kwargs['_host_index'] = kwargs.get('_host_index')
+        kwargs['repository'] = \
+            repository
+        kwargs['branch'] = \
+            branch
+        kwargs['cherry_pick_creation'] = \
+            cherry_pick_creation
+        return self.cherry_pick_endpoint.call_with_http_info(**kwargs)
+
+    def compare_all_branches(
+        self,
+        repository,
+        base_ref,
+        **kwargs
+    ):
+        """compare all branches of the repository to a base reference  # noqa: E501
+
+        This method makes a synchronous HTTP request by default. To make an
+        asynchronous HTTP request, please pass async_req=True
+
+        >>> thread = api.compare_all_branches(repository, base_ref, async_req=True)
+        >>> result = thread.get()
+
+        Args:
+            repository (str):
+            base_ref (str): a reference (could be either a branch or a commit ID) to compare the branches to
+
+        Keyword Args:
+            prefix (str): return items prefixed with this value. [optional]
+            after (str): return items after this value. [optional]
+            amount (int): how many items to return. [optional] if omitted the server will use the default value of 100
+            _return_http_data_only (bool): response data without head status
+                code and headers. Default is True.
+            _preload_content (bool): if False, the urllib3.HTTPResponse object
+                will be returned without reading/decoding response data.
+                Default is True.
+            _request_timeout (int/float/tuple): timeout setting for this request. If
+                one number provided, it will be total request timeout. It can also
+                be a pair (tuple) of (connection, read) timeouts.
+                Default is None.
+            _check_input_type (bool): specifies if type checking
+                should be done one the data sent to the server.
+                Default is True.
+            _check_return_type (bool): specifies if type checking
+                should be done one the data received from the server.
+                Default is True.
+            _host_index (int/None): specifies the index of the server
+                that we want to use.
+                Default is read from the configuration.
+            async_req (bool): execute request asynchronously
+
+        Returns:
+            BranchComparisonList
+                If the method is called asynchronously, returns the request
+                thread.
+        """
+        kwargs['async_req'] = kwargs.get(
+            'async_req', False
+        )
+        kwargs['_return_http_data_only'] = kwargs.get(
+            '_return_http_data_only', True
+        )
+        kwargs['_preload_content'] = kwargs.get(
+            '_preload_content', True
+        )
+        kwargs['_request_timeout'] = kwargs.get(
+            '_request_timeout', None
+        )
+        kwargs['_check_input_type'] = kwargs.get(
+            '_check_input_type', True
+        )
+        kwargs['_check_return_type'] = kwargs.get(
+            '_check_return_type', True
+        )
+        kwargs['_host_index'] = kwargs.get('_host_index')
+        kwargs['repository'] = \
+            repository
+        kwargs['base_ref'] = \
+            base_ref
+        return self.compare_all_branches_endpoint.call_with_http_info(**kwargs)
+
+    def compare_branches(
+        self,
+        repository,
+        base_ref,
+        branch,
+        **kwargs
+    ):
+        """compare a branch to a base reference  # noqa: E501
+
+        Returns the merge base of the branch and the base reference, how many commits each has that the other does not, and how many objects were added, removed and changed by the commits of the branch since the merge base. Uncommitted changes are not compared.   # noqa: E501
+        This method makes a synchronous HTTP request by default. To make an
+        asynchronous HTTP request, please pass async_req=True
+
+        >>> thread = api.compare_branches(repository, base_ref, branch, async_req=True)
+        >>> result = thread.get()
+
+        Args:
+            repository (str):
+            base_ref (str): a reference (could be either a branch or a commit ID) to compare the branch to
+            branch (str):
+
+        Keyword Args:
+            _return_http_data_only (bool): response data without head status
+                code and headers. Default is True.
+            _preload_content (bool): if False, the urllib3.HTTPResponse object
+                will be returned without reading/decoding response data.
+                Default is True.
+            _request_timeout (int/float/tuple): timeout setting for this request. If
+                one number provided, it will be total request timeout. It can also
+                be a pair (tuple) of (connection, read) timeouts.
+                Default is None.
+            _check_input_type (bool): specifies if type checking
+                should be done one the data sent to the server.
+                Default is True.
+            _check_return_type (bool): specifies if type checking
+                should be done one the data received from the server.
+                Default is True.
+            _host_index (int/None): specifies the index of the server
+                that we want to use.
+                Default is read from the configuration.
+            async_req (bool): execute request asynchronously
+
+        Returns:
+            BranchComparison
+                If the method is called asynchronously, returns the request
+                thread.
+        """
+        kwargs['async_req'] = kwargs.get(
+            'async_req', False
+        )
+        kwargs['_return_http_data_only'] = kwargs.get(
+            '_return_http_data_only', True
+        )
+        kwargs['_preload_content'] = kwargs.get(
+            '_preload_content', True
+        )
+        kwargs['_request_timeout'] = kwargs.get(
+            '_request_timeout', None
+        )
+        kwargs['_check_input_type'] = kwargs.get(
+            '_check_input_type', True
+        )
+        kwargs['_check_return_type'] = kwargs.get(
+            '_check_return_type', True
+        )
+        kwargs['_host_index'] = kwargs.get('_host_index')
+        kwargs['repository'] = \
+            repository
+        kwargs['base_ref'] = \
+            base_ref
+        kwargs['branch'] = \
+            branch
+        return self.compare_branches_endpoint.call_with_http_info(**kwargs)
+
+    def create_branch(
+        self,
+        repository,
+        branch_creation,
+        **kwargs
+    ):
+        """create branch  # noqa: E501
+
+        This method makes a synchronous HTTP request by default. To make an
+        asynchronous HTTP request, please pass async_req=True
+
+        >>> thread = api.create_branch(repository, branch_creation, async_req=True)
+        >>> result = thread.get()
+
+        Args:
+            repository (str):
+            branch_creation (BranchCreation):
+
+        Keyword Args:
+            _return_http_data_only (bool): response data without head status
+                code and headers. Default is True.
+            _preload_content (bool): if False, the urllib3.HTTPResponse object
+                will be returned without reading/decoding response data.
+                Default is True.
+            _request_timeout (int/float/tuple): timeout setting for this request. If
+                one number provided, it will be total request timeout. It can also
+                be a pair (tuple) of (connection, read) timeouts.
+                Default is None.
+            _check_input_type (bool): specifies if type checking
+                should be done one the data sent to the server.
+                Default is True.
+            _check_return_type (bool): specifies if type checking
+                should be done one the data received from the server.
+                Default is True.
+            _host_index (int/None): specifies the index of the server
+                that we want to use.
+                Default is read from the configuration.
+            async_req (bool): execute request asynchronously
+
+        Returns:
+            str
+                If the method is called asynchronously, returns the request
+                thread.
+        """
+        kwargs['async_req'] = kwargs.get(
+            'async_req', False
+        )
+        kwargs['_return_http_data_only'] = kwargs.get(
+            '_return_http_data_only', True
+        )
+        kwargs['_preload_content'] = kwargs.get(
+            '_preload_content', True
+        )
+        kwargs['_request_timeout'] = kwargs.get(
+            '_request_timeout', None
+        )
+        kwargs['_check_input_type'] = kwargs.get(
+            '_check_input_type', True
+        )
+        kwargs['_check_return_type'] = kwargs.get(
+            '_check_return_type', True
+        )
+        kwargs['_host_index'] = kwargs.get('_host_index')
+        kwargs['repository'] = \
+            repository
+        kwargs['branch_creation'] = \
+            branch_creation
+        return self.create_branch_endpoint.call_with_http_info(**kwargs)
+
+    def delete_branch(
+        self,
+        repository,
+        branch,
+        **kwargs
+    ):
+        """delete branch  # noqa: E501
+
+        This method makes a synchronous HTTP request by default. To make an
+        asynchronous HTTP request, please pass async_req=True
+
+        >>> thread = api.delete_branch(repository, branch, async_req=True)
+        >>> result = thread.get()
+
+        Args:
+            repository (str):
+            branch (str):
+
+        Keyword Args:
+            _return_http_data_only (bool): response data without head status
+                code and headers. Default is True.
+            _preload_content (bool): if False, the urllib3.HTTPResponse object
+                will be returned without reading/decoding response data.
+                Default is True.
+            _request_timeout (int/float/tuple): timeout setting for this request. If
+                one number provided, it will be total request timeout. It can also
+                be a pair (tuple) of (connection, read) timeouts.
+                Default is None.
+            _check_input_type (bool): specifies if type checking
+                should be done one the data sent to the server.
+                Default is True.
+            _check_return_type (bool): specifies if type checking
+                should be done one the data received from the server.
+                Default is True.
+            _host_index (int/None): specifies the index of the server
+                that we want to use.
+                Default is read from the configuration.
+            async_req (bool): execute request asynchronously
+
+        Returns:
+            None
+                If the method is called asynchronously, returns the request
+                thread.
+        """
+        kwargs['async_req'] = kwargs.get(
+            'async_req', False
+        )
+        kwargs['_return_http_data_only'] = kwargs.get(
+            '_return_http_data_only', True
+        )
+        kwargs['_preload_content'] = kwargs.get(
+            '_preload_content', True
+        )
+        kwargs['_request_timeout'] = kwargs.get(
+            '_request_timeout', None
+        )
+        kwargs['_check_input_type'] = kwargs.get(
+            '_check_input_type', True
+        )
+        kwargs['_check_return_type'] = kwargs.get(
+            '_check_return_type', True
+        )
+        kwargs['_host_index'] = kwargs.get('_host_index')
+        kwargs['repository'] = \
+            repository
+        kwargs['branch'] = \
+            branch
+        return self.delete_branch_endpoint.call_with_http_info(**kwargs)
+
+    def diff_branch(
+        self,
+        repository,
+        branch,
+        **kwargs
+    ):
+        """diff branch  # noqa: E501
+
+        This method makes a synchronous HTTP request by default. To make an
+        asynchronous HTTP request, please pass async_req=True
+
+        >>> thread = api.diff_branch(repository, branch, async_req=True)
+        >>> result = thread.get()
+
+        Args:
+            repository (str):
+            branch (str):
+
+        Keyword Args:
+            after (str): return items after this value. [optional]
+            amount (int): how many items to return. [optional] if omitted the server will use the default value of 100
+            prefix (str): return items prefixed with this value. [optional]
+            delimiter (str): delimiter used to group common prefixes by. [optional]
+            _return_http_data_only (bool): response data without head status
+                code and headers. Default is True.
+            _preload_content (bool): if False, the urllib3.HTTPResponse object
+                will be returned without reading/decoding response data.
+                Default is True.
+            _request_timeout (int/float/tuple): timeout setting for this request. If
+                one number provided, it will be total request timeout. It can also
+                be a pair (tuple) of (connection, read) timeouts.
+                Default is None.
+            _check_input_type (bool): specifies if type checking
+                should be done one the data sent to the server.
+                Default is True.
+            _check_return_type (bool): specifies if type checking
+                should be done one the data received from the server.
+                Default is True.
+            _host_index (int/None): specifies the index of the server
+                that we want to use.
+                Default is read from the configuration.
+            async_req (bool): execute request asynchronously
+
+        Returns:
+            DiffList
+                If the method is called asynchronously, returns the request
+                thread.
+        """
+        kwargs['async_req'] = kwargs.get(
+            'async_req', False
+        )
+        kwargs['_return_http_data_only'] = kwargs.get(
+            '_return_http_data_only', True
+        )
+        kwargs['_preload_content'] = kwargs.get(
+            '_preload_content', True
+        )
+        kwargs['_request_timeout'] = kwargs.get(
+            '_request_timeout', None
+        )
+        kwargs['_check_input_type'] = kwargs.get(
+            '_check_input_type', True
+        )
+        kwargs['_check_return_type'] = kwargs.get(
+            '_check_return_type', True
+        )
+        kwargs['_host_index'] = kwargs.get('_host_index')
+        kwargs['repository'] = \
+            repository
+        kwargs['branch'] = \
+            branch
+        return self.diff_branch_endpoint.call_with_http_info(**kwargs)
+
+    def get_branch(
+        self,
+        repository,
+        branch,
+        **kwargs
+    ):
+        """get branch  # noqa: E501
+
+        This method makes a synchronous HTTP request by default. To make an
+        asynchronous HTTP request, please pass async_req=True
+
+        >>> thread = api.get_branch(repository, branch, async_req=True)
+        >>> result = thread.get()
+
+        Args:
+            repository (str):
+            branch (str):
+
+        Keyword Args:
+            _return_http_data_only (bool): response data without head status
+                code and headers. Default is True.
+            _preload_content (bool): if False, the urllib3.HTTPResponse object
+                will be returned without reading/decoding response data.
+                Default is True.
+            _request_timeout (int/float/tuple): timeout setting for this request. If
+                one number provided, it will be total request timeout. It can also
+                be a pair (tuple) of (connection, read) timeouts.
+                Default is None.
+            _check_input_type (bool): specifies if type checking
+                should be done one the data sent to the server.
+                Default is True.
+            _check_return_type (bool): specifies if type checking
+                should be done one the data received from the server.
+                Default is True.
+            _host_index (int/None): specifies the index of the server
+                that we want to use.
+                Default is read from the configuration.
+            async_req (bool): execute request asynchronously
+
+        Returns:
+            Ref
+                If the method is called asynchronously, returns the request
+                thread.
+        """
+        kwargs['async_req'] = kwargs.get(
+            'async_req', False
+        )
+        kwargs['_return_http_data_only'] = kwargs.get(
+            '_return_http_data_only', True
+        )
+        kwargs['_preload_content'] = kwargs.get(
+            '_preload_content', True
+        )
+        kwargs['_request_timeout'] = kwargs.get(
+            '_request_timeout', None
+        )
+        kwargs['_check_input_type'] = kwargs.get(
+            '_check_input_type', True
+        )
+        kwargs['_check_return_type'] = kwargs.get(
+            '_check_return_type', True
+        )
+        kwargs['_host_index'] = kwargs.get('_host_index')
+        kwargs['repository'] = \
+            repository
+        kwargs['branch'] = \
+            branch
+        return self.get_branch_endpoint.call_with_http_info(**kwargs)
+
+    def list_branch_reflog(
+        self,
+        repository,
+        branch,
+        **kwargs
+    ):
+        """list the changes of the branch head, latest first  # noqa: E501
+
+        Lists the changes of the branch head made by creating, committing to, merging into, resetting, restoring and deleting the branch, latest first. The reflog of a deleted branch is kept. Pagination is by entry index, pass the index of the last entry returned as 'after'.   # noqa: E501
+        This method makes a synchronous HTTP request by default. To make an
+        asynchronous HTTP request, please pass async_req=True
+
+        >>> thread = api.list_branch_reflog(repository, branch, async_req=True)
+        >>> result = thread.get()
+
+        Args:
+            repository (str):
+            branch (str):
+
+        Keyword Args:
+            after (str): return items after this value. [optional]
+            amount (int): how many items to return. [optional] if omitted the server will use the default value of 100
+            _return_http_data_only (bool): response data without head status
+                code and headers. Default is True.
+            _preload_content (bool): if False, the urllib3.HTTPResponse object
+                will be returned without reading/decoding response data.
+                Default is True.
+            _request_timeout (int/float/tuple): timeout setting for this request. If
+                one number provided, it will be total request timeout. It can also
+                be a pair (tuple) of (connection, read) timeouts.
+                Default is None.
+            _check_input_type (bool): specifies if type checking
+                should be done one the data sent to the server.
+                Default is True.
+            _check_return_type (bool): specifies if type checking
+                should be done one the data received from the server.
+                Default is True.
+            _host_index (int/None): specifies the index of the server
+                that we want to use.
+                Default is read from the configuration.
+            async_req (bool): execute request asynchronously
+
+        Returns:
+            BranchReflogList
+                If the method is called asynchronously, returns the request
+                thread.
+        """
+        kwargs['async_req'] = kwargs.get(
+            'async_req', False
+        )
+        kwargs['_return_http_data_only'] = kwargs.get(
+            '_return_http_data_only', True
+        )
+        kwargs['_preload_content'] = kwargs.get(
+            '_preload_content', True
+        )
+        kwargs['_request_timeout'] = kwargs.get(
+            '_request_timeout', None
+        )
+        kwargs['_check_input_type'] = kwargs.get(
+            '_check_input_type', True
+        )
+        kwargs['_check_return_type'] = kwargs.get(
+            '_check_return_type', True
+        )
+        kwargs['_host_index'] = kwargs.get('_host_index')
+        kwargs['repository'] = \
+            repository
+        kwargs['branch'] = \
+            branch
+        return self.list_branch_reflog_endpoint.call_with_http_info(**kwargs)
+
+    def list_branches(
+        self,
+        repository,
+        **kwargs
+    ):
+        """list branches  # noqa: E501
+
+        This method makes a synchronous HTTP request by default. To make an
+        asynchronous HTTP request, please pass async_req=True
+
+        >>> thread = api.list_branches(repository, async_req=True)
+        >>> result = thread.get()
+
+        Args:
+            repository (str):
+
+        Keyword Args:
+            prefix (str): return items prefixed with this value. [optional]
+            after (str): return items after this value. [optional]
+            amount (int): how many items to return. [optional] if omitted the server will use the default value of 100
+            _return_http_data_only (bool): response data without head status
+                code and headers. Default is True.
+            _preload_content (bool): if False, the urllib3.HTTPResponse object
+                will be returned without reading/decoding response data.
+                Default is True.
+            _request_timeout (int/float/tuple): timeout setting for this request. If
+                one number provided, it will be total request timeout. It can also
+                be a pair (tuple) of (connection, read) timeouts.
+                Default is None.
+            _check_input_type (bool): specifies if type checking
+                should be done one the data sent to the server.
+                Default is True.
+            _check_return_type (bool): specifies if type checking
+                should be done one the data received from the server.
+                Default is True.
+            _host_index (int/None): specifies the index of the server
+                that we want to use.
+                Default is read from the configuration.
+            async_req (bool): execute request asynchronously
+
+        Returns:
+            RefList
+                If the method is called asynchronously, returns the request
+                thread.
+        """
+        kwargs['async_req'] = kwargs.get(
+            'async_req', False
+        )
+        kwargs['_return_http_data_only'] = kwargs.get(
+            '_return_http_data_only', True
+        )
+        kwargs['_preload_content'] = kwargs.get(
+            '_preload_content', True
+        )
+        kwargs['_request_timeout'] = kwargs.get(
+            '_request_timeout', None
+        )
+        kwargs['_check_input_type'] = kwargs.get(
+            '_check_input_type', True
+        )
+        kwargs['_check_return_type'] = kwargs.get(
+            '_check_return_type', True
+        )
+        kwargs['_host_index'] = kwargs.get('_host_index')
+        kwargs['repository'] = \
+            repository
+        return self.list_branches_endpoint.call_with_http_info(**kwargs)
+
+    def list_stashes(
+        self,
+        repository,
+        branch,
+        **kwargs
+    ):
+        """list the stashes of a branch  # noqa: E501
+
+        This method makes a synchronous HTTP request by default. To make an
+        asynchronous HTTP request, please pass async_req=True
+
+        >>> thread = api.list_stashes(repository, branch, async_req=True)
+        >>> result = thread.get()
+
+        Args:
+            repository (str):
+            branch (str):
+
+        Keyword Args:
+            after (str): return items after this value. [optional]
+            amount (int): how many items to return. [optional] if omitted the server will use the default value of 100
+            _return_http_data_only (bool): response data without head status
+                code and headers. Default is True.
+            _preload_content (bool): if False, the urllib3.HTTPResponse object
+                will be returned without reading/decoding response data.
+                Default is True.
+            _request_timeout (int/float/tuple): timeout setting for this request. If
+                one number provided, it will be total request timeout. It can also
+                be a pair (tuple) of (connection, read) timeouts.
+                Default is None.
+            _check_input_type (bool): specifies if type checking
+                should be done one the data sent to the server.
+                Default is True.
+            _check_return_type (bool): specifies if type checking
+                should be done one the data received from the server.
+                Default is True.
+            _host_index (int/None): specifies the index of the server
+                that we want to use.
+                Default is read from the configuration.
+            async_req (bool): execute request asynchronously
+
+        Returns:
+            StashList
+                If the method is called asynchronously, returns the request
+                thread.
+        """
+        kwargs['async_req'] = kwargs.get(
+            'async_req', False
+        )
+        kwargs['_return_http_data_only'] = kwargs.get(
+            '_return_http_data_only', True
+        )
+        kwargs['_preload_content'] = kwargs.get(
+            '_preload_content', True
+        )
+        kwargs['_request_timeout'] = kwargs.get(
+            '_request_timeout', None
+        )
+        kwargs['_check_input_type'] = kwargs.get(
+            '_check_input_type', True
+        )
+        kwargs['_check_return_type'] = kwargs.get(
+            '_check_return_type', True
         )
+        kwargs['_host_index'] = kwargs.get('_host_index')
+        kwargs['repository'] = \
+            repository
+        kwargs['branch'] = \
+            branch
+        return self.list_stashes_endpoint.call_with_http_info(**kwargs)
 
-    def create_branch(
+    def rebase_branch(
         self,
         repository,
-        branch_creation,
+        branch,
+        rebase_creation,
         **kwargs
     ):
-        """create branch  # noqa: E501
+        """replay the commits of a branch on top of another ref  # noqa: E501
 
+        Replays, in order, the commits of the branch that are not reachable from the given ref on top of it, producing linear history. Merge commits are not replayed. Requires no uncommitted changes on the branch. The rebase stops on the first conflicting commit, leaving the branch unchanged.   # noqa: E501
         This method makes a synchronous HTTP request by default. To make an
         asynchronous HTTP request, please pass async_req=True
 
-        >>> thread = api.create_branch(repository, branch_creation, async_req=True)
+        >>> thread = api.rebase_branch(repository, branch, rebase_creation, async_req=True)
         >>> result = thread.get()
 
         Args:
             repository (str):
-            branch_creation (BranchCreation):
+            branch (str):
+            rebase_creation (RebaseCreation):
 
         Keyword Args:
             _return_http_data_only (bool): response data without head status
@@ -553,7 +2032,7 @@ class BranchesApi(object):
             async_req (bool): execute request asynchronously
 
         Returns:
-            str
+            RebaseResult
                 If the method is called asynchronously, returns the request
                 thread.
         """
@@ -578,27 +2057,31 @@ class BranchesApi(object):
         kwargs['_host_index'] = kwargs.get('_host_index')
         kwargs['repository'] = \
             repository
-        kwargs['branch_creation'] = \
-            branch_creation
-        return self.create_branch_endpoint.call_with_http_info(**kwargs)
+        kwargs['branch'] = \
+            branch
+        kwargs['rebase_creation'] = \
+            rebase_creation
+        return self.rebase_branch_endpoint.call_with_http_info(**kwargs)
 
-    def delete_branch(
+    def reset_branch(
         self,
         repository,
         branch,
+        reset_creation,
         **kwargs
     ):
-        """delete branch  # noqa: E501
+        """reset branch  # noqa: E501
 
         This method makes a synchronous HTTP request by default. To make an
         asynchronous HTTP request, please pass async_req=True
 
-        >>> thread = api.delete_branch(repository, branch, async_req=True)
+        >>> thread = api.reset_branch(repository, branch, reset_creation, async_req=True)
         >>> result = thread.get()
 
         Args:
             repository (str):
             branch (str):
+            reset_creation (ResetCreation):
 
         Keyword Args:
             _return_http_data_only (bool): response data without head status
@@ -649,31 +2132,32 @@ class BranchesApi(object):
             repository
         kwargs['branch'] = \
             branch
-        return self.delete_branch_endpoint.call_with_http_info(**kwargs)
+        kwargs['reset_creation'] = \
+            reset_creation
+        return self.reset_branch_endpoint.call_with_http_info(**kwargs)
 
-    def diff_branch(
+    def restore_branch(
         self,
         repository,
         branch,
+        branch_restore_creation,
         **kwargs
     ):
-        """diff branch  # noqa: E501
+        """point a branch back at a previous head from its reflog  # noqa: E501
 
+        Points the branch at the commit it pointed to after the given branch reflog entry, recreating the branch if it was deleted. Requires no uncommitted changes on an existing branch.   # noqa: E501
         This method makes a synchronous HTTP request by default. To make an
         asynchronous HTTP request, please pass async_req=True
 
-        >>> thread = api.diff_branch(repository, branch, async_req=True)
+        >>> thread = api.restore_branch(repository, branch, branch_restore_creation, async_req=True)
         >>> result = thread.get()
 
         Args:
             repository (str):
             branch (str):
+            branch_restore_creation (BranchRestoreCreation):
 
         Keyword Args:
-            after (str): return items after this value. [optional]
-            amount (int): how many items to return. [optional] if omitted the server will use the default value of 100
-            prefix (str): return items prefixed with this value. [optional]
-            delimiter (str): delimiter used to group common prefixes by. [optional]
             _return_http_data_only (bool): response data without head status
                 code and headers. Default is True.
             _preload_content (bool): if False, the urllib3.HTTPResponse object
@@ -695,7 +2179,7 @@ class BranchesApi(object):
             async_req (bool): execute request asynchronously
 
         Returns:
-            DiffList
+            Ref
                 If the method is called asynchronously, returns the request
                 thread.
         """
@@ -722,25 +2206,29 @@ class BranchesApi(object):
             repository
         kwargs['branch'] = \
             branch
-        return self.diff_branch_endpoint.call_with_http_info(**kwargs)
+        kwargs['branch_restore_creation'] = \
+            branch_restore_creation
+        return self.restore_branch_endpoint.call_with_http_info(**kwargs)
 
-    def get_branch(
+    def revert_branch(
         self,
         repository,
         branch,
+        revert_creation,
         **kwargs
     ):
-        """get branch  # noqa: E501
+        """revert  # noqa: E501
 
         This method makes a synchronous HTTP request by default. To make an
         asynchronous HTTP request, please pass async_req=True
 
-        >>> thread = api.get_branch(repository, branch, async_req=True)
+        >>> thread = api.revert_branch(repository, branch, revert_creation, async_req=True)
         >>> result = thread.get()
 
         Args:
             repository (str):
             branch (str):
+            revert_creation (RevertCreation):
 
         Keyword Args:
             _return_http_data_only (bool): response data without head status
@@ -764,7 +2252,7 @@ class BranchesApi(object):
             async_req (bool): execute request asynchronously
 
         Returns:
-            Ref
+            None
                 If the method is called asynchronously, returns the request
                 thread.
         """
@@ -791,28 +2279,32 @@ class BranchesApi(object):
             repository
         kwargs['branch'] = \
             branch
-        return self.get_branch_endpoint.call_with_http_info(**kwargs)
+        kwargs['revert_creation'] = \
+            revert_creation
+        return self.revert_branch_endpoint.call_with_http_info(**kwargs)
 
-    def list_branches(
+    def stash_apply(
         self,
         repository,
+        branch,
+        stash,
         **kwargs
     ):
-        """list branches  # noqa: E501
+        """stage the changes of a stash on a branch  # noqa: E501
 
+        Stages the changes of the stash on the branch, keeping the stash. Fails without staging anything when a path of the stash was changed differently on the branch since.   # noqa: E501
         This method makes a synchronous HTTP request by default. To make an
         asynchronous HTTP request, please pass async_req=True
 
-        >>> thread = api.list_branches(repository, async_req=True)
+        >>> thread = api.stash_apply(repository, branch, stash, async_req=True)
         >>> result = thread.get()
 
         Args:
             repository (str):
+            branch (str):
+            stash (str):
 
         Keyword Args:
-            prefix (str): return items prefixed with this value. [optional]
-            after (str): return items after this value. [optional]
-            amount (int): how many items to return. [optional] if omitted the server will use the default value of 100
             _return_http_data_only (bool): response data without head status
                 code and headers. Default is True.
             _preload_content (bool): if False, the urllib3.HTTPResponse object
@@ -834,7 +2326,7 @@ class BranchesApi(object):
             async_req (bool): execute request asynchronously
 
         Returns:
-            RefList
+            None
                 If the method is called asynchronously, returns the request
                 thread.
         """
@@ -859,27 +2351,31 @@ class BranchesApi(object):
         kwargs['_host_index'] = kwargs.get('_host_index')
         kwargs['repository'] = \
             repository
-        return self.list_branches_endpoint.call_with_http_info(**kwargs)
+        kwargs['branch'] = \
+            branch
+        kwargs['stash'] = \
+            stash
+        return self.stash_apply_endpoint.call_with_http_info(**kwargs)
 
-    def reset_branch(
+    def stash_drop(
         self,
         repository,
         branch,
-        reset_creation,
+        stash,
         **kwargs
     ):
-        """reset branch  # noqa: E501
+        """delete a stash and its changes  # noqa: E501
 
         This method makes a synchronous HTTP request by default. To make an
         asynchronous HTTP request, please pass async_req=True
 
-        >>> thread = api.reset_branch(repository, branch, reset_creation, async_req=True)
+        >>> thread = api.stash_drop(repository, branch, stash, async_req=True)
         >>> result = thread.get()
 
         Args:
             repository (str):
             branch (str):
-            reset_creation (ResetCreation):
+            stash (str):
 
         Keyword Args:
             _return_http_data_only (bool): response data without head status
@@ -930,29 +2426,30 @@ class BranchesApi(object):
             repository
         kwargs['branch'] = \
             branch
-        kwargs['reset_creation'] = \
-            reset_creation
-        return self.reset_branch_endpoint.call_with_http_info(**kwargs)
+        kwargs['stash'] = \
+            stash
+        return self.stash_drop_endpoint.call_with_http_info(**kwargs)
 
-    def revert_branch(
+    def stash_pop(
         self,
         repository,
         branch,
-        revert_creation,
+        stash,
         **kwargs
     ):
-        """revert  # noqa: E501
+        """stage the changes of a stash on a branch and delete the stash  # noqa: E501
 
+        Stages the changes of the stash on the branch and deletes the stash. Fails without staging anything when a path of the stash was changed differently on the branch since.   # noqa: E501
         This method makes a synchronous HTTP request by default. To make an
         asynchronous HTTP request, please pass async_req=True
 
-        >>> thread = api.revert_branch(repository, branch, revert_creation, async_req=True)
+        >>> thread = api.stash_pop(repository, branch, stash, async_req=True)
         >>> result = thread.get()
 
         Args:
             repository (str):
             branch (str):
-            revert_creation (RevertCreation):
+            stash (str):
 
         Keyword Args:
             _return_http_data_only (bool): response data without head status
@@ -1003,7 +2500,81 @@ class BranchesApi(object):
             repository
         kwargs['branch'] = \
             branch
-        kwargs['revert_creation'] = \
-            revert_creation
-        return self.revert_branch_endpoint.call_with_http_info(**kwargs)
+        kwargs['stash'] = \
+            stash
+        return self.stash_pop_endpoint.call_with_http_info(**kwargs)
+
+    def stash_push(
+        self,
+        repository,
+        branch,
+        stash_creation,
+        **kwargs
+    ):
+        """move the uncommitted changes of a branch aside  # noqa: E501
+
+        Moves the uncommitted changes of the branch to a new stash, leaving the branch with no uncommitted changes.   # noqa: E501
+        This method makes a synchronous HTTP request by default. To make an
+        asynchronous HTTP request, please pass async_req=True
+
+        >>> thread = api.stash_push(repository, branch, stash_creation, async_req=True)
+        >>> result = thread.get()
+
+        Args:
+            repository (str):
+            branch (str):
+            stash_creation (StashCreation):
+
+        Keyword Args:
+            _return_http_data_only (bool): response data without head status
+                code and headers. Default is True.
+            _preload_content (bool): if False, the urllib3.HTTPResponse object
+                will be returned without reading/decoding response data.
+                Default is True.
+            _request_timeout (int/float/tuple): timeout setting for this request. If
+                one number provided, it will be total request timeout. It can also
+                be a pair (tuple) of (connection, read) timeouts.
+                Default is None.
+            _check_input_type (bool): specifies if type checking
+                should be done one the data sent to the server.
+                Default is True.
+            _check_return_type (bool): specifies if type checking
+                should be done one the data received from the server.
+                Default is True.
+            _host_index (int/None): specifies the index of the server
+                that we want to use.
+                Default is read from the configuration.
+            async_req (bool): execute request asynchronously
+
+        Returns:
+            Stash
+                If the method is called asynchronously, returns the request
+                thread.
+        """
+        kwargs['async_req'] = kwargs.get(
+            'async_req', False
+        )
+        kwargs['_return_http_data_only'] = kwargs.get(
+            '_return_http_data_only', True
+        )
+        kwargs['_preload_content'] = kwargs.get(
+            '_preload_content', True
+        )
+        kwargs['_request_timeout'] = kwargs.get(
+            '_request_timeout', None
+        )
+        kwargs['_check_input_type'] = kwargs.get(
+            '_check_input_type', True
+        )
+        kwargs['_check_return_type'] = kwargs.get(
+            '_check_return_type', True
+        )
+        kwargs['_host_index'] = kwargs.get('_host_index')
+        kwargs['repository'] = \
+            repository
+        kwargs['branch'] = \
+            branch
+        kwargs['stash_creation'] = \
+            stash_creation
+        return self.stash_push_endpoint.call_with_http_info(**kwargs)
 
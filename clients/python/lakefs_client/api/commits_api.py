@@ -25,6 +25,7 @@ from lakefs_client.model_utils import (  # noqa: F401
 from lakefs_client.model.commit import Commit
 from lakefs_client.model.commit_creation import CommitCreation
 from lakefs_client.model.commit_list import CommitList
+from lakefs_client.model.commit_signature_creation import CommitSignatureCreation
 from lakefs_client.model.error import Error
 
 
@@ -239,6 +240,167 @@ class CommitsApi(object):
             },
             api_client=api_client
         )
+        self.search_commits_endpoint = _Endpoint(
+            settings={
+                'response_type': (CommitList,),
+                'auth': [
+                    'basic_auth',
+                    'cookie_auth',
+                    'jwt_token'
+                ],
+                'endpoint_path': '/repositories/{repository}/commits',
+                'operation_id': 'search_commits',
+                'http_method': 'GET',
+                'servers': None,
+            },
+            params_map={
+                'all': [
+                    'repository',
+                    'after',
+                    'amount',
+                    'metadata',
+                    'committer',
+                    'message',
+                    'since',
+                    'until',
+                ],
+                'required': [
+                    'repository',
+                ],
+                'nullable': [
+                ],
+                'enum': [
+                ],
+                'validation': [
+                    'amount',
+                ]
+            },
+            root_map={
+                'validations': {
+                    ('amount',): {
+
+                        'inclusive_maximum': 1000,
+                        'inclusive_minimum': -1,
+                    },
+                },
+                'allowed_values': {
+                },
+                'openapi_types': {
+                    'repository':
+                        (str,),
+                    'after':
+                        (str,),
+                    'amount':
+                        (int,),
+                    'metadata':
+                        ([str],),
+                    'committer':
+                        (str,),
+                    'message':
+                        (str,),
+                    'since':
+                        (int,),
+                    'until':
+                        (int,),
+                },
+                'attribute_map': {
+                    'repository': 'repository',
+                    'after': 'after',
+                    'amount': 'amount',
+                    'metadata': 'metadata',
+                    'committer': 'committer',
+                    'message': 'message',
+                    'since': 'since',
+                    'until': 'until',
+                },
+                'location_map': {
+                    'repository': 'path',
+                    'after': 'query',
+                    'amount': 'query',
+                    'metadata': 'query',
+                    'committer': 'query',
+                    'message': 'query',
+                    'since': 'query',
+                    'until': 'query',
+                },
+                'collection_format_map': {
+                    'metadata': 'multi',
+                }
+            },
+            headers_map={
+                'accept': [
+                    'application/json'
+                ],
+                'content_type': [],
+            },
+            api_client=api_client
+        )
+        self.sign_commit_endpoint = _Endpoint(
+            settings={
+                'response_type': None,
+                'auth': [
+                    'basic_auth',
+                    'cookie_auth',
+                    'jwt_token'
+                ],
+                'endpoint_path': '/repositories/{repository}/commits/{commitId}/signature',
+                'operation_id': 'sign_commit',
+                'http_method': 'PUT',
+                'servers': None,
+            },
+            params_map={
+                'all': [
+                    'repository',
+                    'commit_id',
+                    'commit_signature_creation',
+                ],
+                'required': [
+                    'repository',
+                    'commit_id',
+                    'commit_signature_creation',
+                ],
+                'nullable': [
+                ],
+                'enum': [
+                ],
+                'validation': [
+                ]
+            },
+            root_map={
+                'validations': {
+                },
+                'allowed_values': {
+                },
+                'openapi_types': {
+                    'repository':
+                        (str,),
+                    'commit_id':
+                        (str,),
+                    'commit_signature_creation':
+                        (CommitSignatureCreation,),
+                },
+                'attribute_map': {
+                    'repository': 'repository',
+                    'commit_id': 'commitId',
+                },
+                'location_map': {
+                    'repository': 'path',
+                    'commit_id': 'path',
+                    'commit_signature_creation': 'body',
+                },
+                'collection_format_map': {
+                }
+            },
+            headers_map={
+                'accept': [
+                    'application/json'
+                ],
+                'content_type': [
+                    'application/json'
+                ]
+            },
+            api_client=api_client
+        )
 
     def commit(
         self,
@@ -453,3 +615,148 @@ class CommitsApi(object):
             branch
         return self.log_branch_commits_endpoint.call_with_http_info(**kwargs)
 
+    def search_commits(
+        self,
+        repository,
+        **kwargs
+    ):
+        """search commits of the repository by metadata, committer, message and creation date, latest first  # noqa: E501
+
+        This method makes a synchronous HTTP request by default. To make an
+        asynchronous HTTP request, please pass async_req=True
+
+        >>> thread = api.search_commits(repository, async_req=True)
+        >>> result = thread.get()
+
+        Args:
+            repository (str):
+
+        Keyword Args:
+            after (str): return items after this value. [optional]
+            amount (int): how many items to return. [optional] if omitted the server will use the default value of 100
+            metadata ([str]): list of metadata key/value pairs in the form key=value, commits must match all of them. [optional]
+            committer (str): [optional]
+            message (str): substring of the commit message, ignoring case. [optional]
+            since (int): Unix Epoch in seconds, return commits created at or after it. [optional]
+            until (int): Unix Epoch in seconds, return commits created before it. [optional]
+            _return_http_data_only (bool): response data without head status
+                code and headers. Default is True.
+            _preload_content (bool): if False, the urllib3.HTTPResponse object
+                will be returned without reading/decoding response data.
+                Default is True.
+            _request_timeout (int/float/tuple): timeout setting for this request. If
+                one number provided, it will be total request timeout. It can also
+                be a pair (tuple) of (connection, read) timeouts.
+                Default is None.
+            _check_input_type (bool): specifies if type checking
+                should be done one the data sent to the server.
+                Default is True.
+            _check_return_type (bool): specifies if type checking
+                should be done one the data received from the server.
+                Default is True.
+            _host_index (int/None): specifies the index of the server
+                that we want to use.
+                Default is read from the configuration.
+            async_req (bool): execute request asynchronously
+
+        Returns:
+            CommitList
+                If the method is called asynchronously, returns the request
+                thread.
+        """
+        kwargs['async_req'] = kwargs.get(
+            'async_req', False
+        )
+        kwargs['_return_http_data_only'] = kwargs.get(
+            '_return_http_data_only', True
+        )
+        kwargs['_preload_content'] = kwargs.get(
+            '_preload_content', True
+        )
+        kwargs['_request_timeout'] = kwargs.get(
+            '_request_timeout', None
+        )
+        kwargs['_check_input_type'] = kwargs.get(
+            '_check_input_type', True
+        )
+        kwargs['_check_return_type'] = kwargs.get(
+            '_check_return_type', True
+        )
+        kwargs['_host_index'] = kwargs.get('_host_index')
+        kwargs['repository'] = \
+            repository
+        return self.search_commits_endpoint.call_with_http_info(**kwargs)
+
+    def sign_commit(
+        self,
+        repository,
+        commit_id,
+        commit_signature_creation,
+        **kwargs
+    ):
+        """store a signature of the commit made by a signing key of its committer  # noqa: E501
+
+        This method makes a synchronous HTTP request by default. To make an
+        asynchronous HTTP request, please pass async_req=True
+
+        >>> thread = api.sign_commit(repository, commit_id, commit_signature_creation, async_req=True)
+        >>> result = thread.get()
+
+        Args:
+            repository (str):
+            commit_id (str):
+            commit_signature_creation (CommitSignatureCreation):
+
+        Keyword Args:
+            _return_http_data_only (bool): response data without head status
+                code and headers. Default is True.
+            _preload_content (bool): if False, the urllib3.HTTPResponse object
+                will be returned without reading/decoding response data.
+                Default is True.
+            _request_timeout (int/float/tuple): timeout setting for this request. If
+                one number provided, it will be total request timeout. It can also
+                be a pair (tuple) of (connection, read) timeouts.
+                Default is None.
+            _check_input_type (bool): specifies if type checking
+                should be done one the data sent to the server.
+                Default is True.
+            _check_return_type (bool): specifies if type checking
+                should be done one the data received from the server.
+                Default is True.
+            _host_index (int/None): specifies the index of the server
+                that we want to use.
+                Default is read from the configuration.
+            async_req (bool): execute request asynchronously
+
+        Returns:
+            None
+                If the method is called asynchronously, returns the request
+                thread.
+        """
+        kwargs['async_req'] = kwargs.get(
+            'async_req', False
+        )
+        kwargs['_return_http_data_only'] = kwargs.get(
+            '_return_http_data_only', True
+        )
+        kwargs['_preload_content'] = kwargs.get(
+            '_preload_content', True
+        )
+        kwargs['_request_timeout'] = kwargs.get(
+            '_request_timeout', None
+        )
+        kwargs['_check_input_type'] = kwargs.get(
+            '_check_input_type', True
+        )
+        kwargs['_check_return_type'] = kwargs.get(
+            '_check_return_type', True
+        )
+        kwargs['_host_index'] = kwargs.get('_host_index')
+        kwargs['repository'] = \
+            repository
+        kwargs['commit_id'] = \
+            commit_id
+        kwargs['commit_signature_creation'] = \
+            commit_signature_creation
+        return self.sign_commit_endpoint.call_with_http_info(**kwargs)
+
@@ -27,6 +27,7 @@ from lakefs_client.model.object_error_list import ObjectErrorList
 from lakefs_client.model.object_stage_creation import ObjectStageCreation
 from lakefs_client.model.object_stats import ObjectStats
 from lakefs_client.model.object_stats_list import ObjectStatsList
+from lakefs_client.model.object_version_list import ObjectVersionList
 from lakefs_client.model.path_list import PathList
 from lakefs_client.model.underlying_object_properties import UnderlyingObjectProperties
 
@@ -394,6 +395,92 @@ class ObjectsApi(object):
             },
             api_client=api_client
         )
+        self.object_history_endpoint = _Endpoint(
+            settings={
+                'response_type': (ObjectVersionList,),
+                'auth': [
+                    'basic_auth',
+                    'cookie_auth',
+                    'jwt_token'
+                ],
+                'endpoint_path': '/repositories/{repository}/refs/{ref}/objects/history',
+                'operation_id': 'object_history',
+                'http_method': 'GET',
+                'servers': None,
+            },
+            params_map={
+                'all': [
+                    'repository',
+                    'ref',
+                    'path',
+                    'first_parent',
+                    'after',
+                    'amount',
+                ],
+                'required': [
+                    'repository',
+                    'ref',
+                    'path',
+                ],
+                'nullable': [
+                ],
+                'enum': [
+                ],
+                'validation': [
+                    'amount',
+                ]
+            },
+            root_map={
+                'validations': {
+                    ('amount',): {
+
+                        'inclusive_maximum': 1000,
+                        'inclusive_minimum': -1,
+                    },
+                },
+                'allowed_values': {
+                },
+                'openapi_types': {
+                    'repository':
+                        (str,),
+                    'ref':
+                        (str,),
+                    'path':
+                        (str,),
+                    'first_parent':
+                        (bool,),
+                    'after':
+                        (str,),
+                    'amount':
+                        (int,),
+                },
+                'attribute_map': {
+                    'repository': 'repository',
+                    'ref': 'ref',
+                    'path': 'path',
+                    'first_parent': 'first_parent',
+                    'after': 'after',
+                    'amount': 'amount',
+                },
+                'location_map': {
+                    'repository': 'path',
+                    'ref': 'path',
+                    'path': 'query',
+                    'first_parent': 'query',
+                    'after': 'query',
+                    'amount': 'query',
+                },
+                'collection_format_map': {
+                }
+            },
+            headers_map={
+                'accept': [
+                    'application/json'
+                ],
+                'content_type': [],
+            },
+            api_client=api_client
+        )
         self.stage_object_endpoint = _Endpoint(
             settings={
                 'response_type': (ObjectStats,),
@@ -992,6 +1079,82 @@ class ObjectsApi(object):
             ref
         return self.list_objects_endpoint.call_with_http_info(**kwargs)
 
+    def object_history(
+        self,
+        repository,
+        ref,
+        path,
+        **kwargs
+    ):
+        """list the versions of an object, latest first  # noqa: E501
+
+        This method makes a synchronous HTTP request by default. To make an
+        asynchronous HTTP request, please pass async_req=True
+
+        >>> thread = api.object_history(repository, ref, path, async_req=True)
+        >>> result = thread.get()
+
+        Args:
+            repository (str):
+            ref (str): a reference (could be either a branch or a commit ID)
+            path (str): relative to the branch
+
+        Keyword Args:
+            first_parent (bool): follow only the first parent of merge commits. [optional] if omitted the server will use the default value of False
+            after (str): return items after this value. [optional]
+            amount (int): how many items to return. [optional] if omitted the server will use the default value of 100
+            _return_http_data_only (bool): response data without head status
+                code and headers. Default is True.
+            _preload_content (bool): if False, the urllib3.HTTPResponse object
+                will be returned without reading/decoding response data.
+                Default is True.
+            _request_timeout (int/float/tuple): timeout setting for this request. If
+                one number provided, it will be total request timeout. It can also
+                be a pair (tuple) of (connection, read) timeouts.
+                Default is None.
+            _check_input_type (bool): specifies if type checking
+                should be done one the data sent to the server.
+                Default is True.
+            _check_return_type (bool): specifies if type checking
+                should be done one the data received from the server.
+                Default is True.
+            _host_index (int/None): specifies the index of the server
+                that we want to use.
+                Default is read from the configuration.
+            async_req (bool): execute request asynchronously
+
+        Returns:
+            ObjectVersionList
+                If the method is called asynchronously, returns the request
+                thread.
+        """
+        kwargs['async_req'] = kwargs.get(
+            'async_req', False
+        )
+        kwargs['_return_http_data_only'] = kwargs.get(
+            '_return_http_data_only', True
+        )
+        kwargs['_preload_content'] = kwargs.get(
+            '_preload_content', True
+        )
+        kwargs['_request_timeout'] = kwargs.get(
+            '_request_timeout', None
+        )
+        kwargs['_check_input_type'] = kwargs.get(
+            '_check_input_type', True
+        )
+        kwargs['_check_return_type'] = kwargs.get(
+            '_check_return_type', True
+        )
+        kwargs['_host_index'] = kwargs.get('_host_index')
+        kwargs['repository'] = \
+            repository
+        kwargs['ref'] = \
+            ref
+        kwargs['path'] = \
+            path
+        return self.object_history_endpoint.call_with_http_info(**kwargs)
+
     def stage_object(
         self,
         repository,
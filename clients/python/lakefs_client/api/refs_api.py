@@ -24,8 +24,10 @@ from lakefs_client.model_utils import (  # noqa: F401
 )
 from lakefs_client.model.commit_list import CommitList
 from lakefs_client.model.diff_list import DiffList
+from lakefs_client.model.diff_summary_list import DiffSummaryList
 from lakefs_client.model.error import Error
 from lakefs_client.model.merge import Merge
+from lakefs_client.model.merge_preview import MergePreview
 from lakefs_client.model.merge_result import MergeResult
 from lakefs_client.model.refs_dump import RefsDump
 
@@ -148,6 +150,92 @@ class RefsApi(object):
             },
             api_client=api_client
         )
+        self.diff_summary_endpoint = _Endpoint(
+            settings={
+                'response_type': (DiffSummaryList,),
+                'auth': [
+                    'basic_auth',
+                    'cookie_auth',
+                    'jwt_token'
+                ],
+                'endpoint_path': '/repositories/{repository}/refs/{leftRef}/diff/{rightRef}/summary',
+                'operation_id': 'diff_summary',
+                'http_method': 'GET',
+                'servers': None,
+            },
+            params_map={
+                'all': [
+                    'repository',
+                    'left_ref',
+                    'right_ref',
+                    'depth',
+                    'type',
+                ],
+                'required': [
+                    'repository',
+                    'left_ref',
+                    'right_ref',
+                ],
+                'nullable': [
+                ],
+                'enum': [
+                    'type',
+                ],
+                'validation': [
+                    'depth',
+                ]
+            },
+            root_map={
+                'validations': {
+                    ('depth',): {
+
+                        'inclusive_minimum': 0,
+                    },
+                },
+                'allowed_values': {
+                    ('type',): {
+
+                        "TWO_DOT": "two_dot",
+                        "THREE_DOT": "three_dot"
+                    },
+                },
+                'openapi_types': {
+                    'repository':
+                        (str,),
+                    'left_ref':
+                        (str,),
+                    'right_ref':
+                        (str,),
+                    'depth':
+                        (int,),
+                    'type':
+                        (str,),
+                },
+                'attribute_map': {
+                    'repository': 'repository',
+                    'left_ref': 'leftRef',
+                    'right_ref': 'rightRef',
+                    'depth': 'depth',
+                    'type': 'type',
+                },
+                'location_map': {
+                    'repository': 'path',
+                    'left_ref': 'path',
+                    'right_ref': 'path',
+                    'depth': 'query',
+                    'type': 'query',
+                },
+                'collection_format_map': {
+                }
+            },
+            headers_map={
+                'accept': [
+                    'application/json'
+                ],
+                'content_type': [],
+            },
+            api_client=api_client
+        )
         self.dump_refs_endpoint = _Endpoint(
             settings={
                 'response_type': (RefsDump,),
@@ -359,6 +447,82 @@ class RefsApi(object):
             },
             api_client=api_client
         )
+        self.merge_preview_endpoint = _Endpoint(
+            settings={
+                'response_type': (MergePreview,),
+                'auth': [
+                    'basic_auth',
+                    'cookie_auth',
+                    'jwt_token'
+                ],
+                'endpoint_path': '/repositories/{repository}/refs/{sourceRef}/merge/{destinationBranch}/preview',
+                'operation_id': 'merge_preview',
+                'http_method': 'GET',
+                'servers': None,
+            },
+            params_map={
+                'all': [
+                    'repository',
+                    'source_ref',
+                    'destination_branch',
+                    'strategy',
+                    'strategy_rule',
+                ],
+                'required': [
+                    'repository',
+                    'source_ref',
+                    'destination_branch',
+                ],
+                'nullable': [
+                ],
+                'enum': [
+                ],
+                'validation': [
+                ]
+            },
+            root_map={
+                'validations': {
+                },
+                'allowed_values': {
+                },
+                'openapi_types': {
+                    'repository':
+                        (str,),
+                    'source_ref':
+                        (str,),
+                    'destination_branch':
+                        (str,),
+                    'strategy':
+                        (str,),
+                    'strategy_rule':
+                        ([str],),
+                },
+                'attribute_map': {
+                    'repository': 'repository',
+                    'source_ref': 'sourceRef',
+                    'destination_branch': 'destinationBranch',
+                    'strategy': 'strategy',
+                    'strategy_rule': 'strategy_rule',
+                },
+                'location_map': {
+                    'repository': 'path',
+                    'source_ref': 'path',
+                    'destination_branch': 'path',
+                    'strategy': 'query',
+                    'strategy_rule': 'query',
+                },
+                'collection_format_map': {
+                    'strategy_rule': 'multi',
+                }
+            },
+            headers_map={
+                'accept': [
+                    'application/json'
+                ],
+                'content_type': [],
+            },
+            api_client=api_client
+        )
         self.restore_refs_endpoint = _Endpoint(
             settings={
                 'response_type': None,
@@ -499,6 +663,82 @@ class RefsApi(object):
             right_ref
         return self.diff_refs_endpoint.call_with_http_info(**kwargs)
 
+    def diff_summary(
+        self,
+        repository,
+        left_ref,
+        right_ref,
+        **kwargs
+    ):
+        """count the changes between references by prefix  # noqa: E501
+
+        Counts the objects added, removed and changed between the commits of the references, and their size, by path prefix. Uncommitted changes are not counted.   # noqa: E501
+        This method makes a synchronous HTTP request by default. To make an
+        asynchronous HTTP request, please pass async_req=True
+
+        >>> thread = api.diff_summary(repository, left_ref, right_ref, async_req=True)
+        >>> result = thread.get()
+
+        Args:
+            repository (str):
+            left_ref (str): a reference (could be either a branch or a commit ID)
+            right_ref (str): a reference (could be either a branch or a commit ID) to compare against
+
+        Keyword Args:
+            depth (int): number of path elements of the prefixes changes are counted by, 0 counts all changes together. [optional] if omitted the server will use the default value of 1
+            type (str): [optional] if omitted the server will use the default value of "three_dot"
+            _return_http_data_only (bool): response data without head status
+                code and headers. Default is True.
+            _preload_content (bool): if False, the urllib3.HTTPResponse object
+                will be returned without reading/decoding response data.
+                Default is True.
+            _request_timeout (int/float/tuple): timeout setting for this request. If
+                one number provided, it will be total request timeout. It can also
+                be a pair (tuple) of (connection, read) timeouts.
+                Default is None.
+            _check_input_type (bool): specifies if type checking
+                should be done one the data sent to the server.
+                Default is True.
+            _check_return_type (bool): specifies if type checking
+                should be done one the data received from the server.
+                Default is True.
+            _host_index (int/None): specifies the index of the server
+                that we want to use.
+                Default is read from the configuration.
+            async_req (bool): execute request asynchronously
+
+        Returns:
+            DiffSummaryList
+                If the method is called asynchronously, returns the request
+                thread.
+        """
+        kwargs['async_req'] = kwargs.get(
+            'async_req', False
+        )
+        kwargs['_return_http_data_only'] = kwargs.get(
+            '_return_http_data_only', True
+        )
+        kwargs['_preload_content'] = kwargs.get(
+            '_preload_content', True
+        )
+        kwargs['_request_timeout'] = kwargs.get(
+            '_request_timeout', None
+        )
+        kwargs['_check_input_type'] = kwargs.get(
+            '_check_input_type', True
+        )
+        kwargs['_check_return_type'] = kwargs.get(
+            '_check_return_type', True
+        )
+        kwargs['_host_index'] = kwargs.get('_host_index')
+        kwargs['repository'] = \
+            repository
+        kwargs['left_ref'] = \
+            left_ref
+        kwargs['right_ref'] = \
+            right_ref
+        return self.diff_summary_endpoint.call_with_http_info(**kwargs)
+
     def dump_refs(
         self,
         repository,
@@ -711,6 +951,81 @@ class RefsApi(object):
             destination_branch
         return self.merge_into_branch_endpoint.call_with_http_info(**kwargs)
 
+    def merge_preview(
+        self,
+        repository,
+        source_ref,
+        destination_branch,
+        **kwargs
+    ):
+        """preview the outcome of merging references, without merging  # noqa: E501
+
+        This method makes a synchronous HTTP request by default. To make an
+        asynchronous HTTP request, please pass async_req=True
+
+        >>> thread = api.merge_preview(repository, source_ref, destination_branch, async_req=True)
+        >>> result = thread.get()
+
+        Args:
+            repository (str):
+            source_ref (str): source ref
+            destination_branch (str): destination branch name
+
+        Keyword Args:
+            strategy (str): merge strategy to preview, \"dest-wins\" or \"source-wins\". [optional]
+            strategy_rule ([str]): merge strategy rules to preview, in order, each formatted as \"<pattern>=<strategy>\". [optional]
+            _return_http_data_only (bool): response data without head status
+                code and headers. Default is True.
+            _preload_content (bool): if False, the urllib3.HTTPResponse object
+                will be returned without reading/decoding response data.
+                Default is True.
+            _request_timeout (int/float/tuple): timeout setting for this request. If
+                one number provided, it will be total request timeout. It can also
+                be a pair (tuple) of (connection, read) timeouts.
+                Default is None.
+            _check_input_type (bool): specifies if type checking
+                should be done one the data sent to the server.
+                Default is True.
+            _check_return_type (bool): specifies if type checking
+                should be done one the data received from the server.
+                Default is True.
+            _host_index (int/None): specifies the index of the server
+                that we want to use.
+                Default is read from the configuration.
+            async_req (bool): execute request asynchronously
+
+        Returns:
+            MergePreview
+                If the method is called asynchronously, returns the request
+                thread.
+        """
+        kwargs['async_req'] = kwargs.get(
+            'async_req', False
+        )
+        kwargs['_return_http_data_only'] = kwargs.get(
+            '_return_http_data_only', True
+        )
+        kwargs['_preload_content'] = kwargs.get(
+            '_preload_content', True
+        )
+        kwargs['_request_timeout'] = kwargs.get(
+            '_request_timeout', None
+        )
+        kwargs['_check_input_type'] = kwargs.get(
+            '_check_input_type', True
+        )
+        kwargs['_check_return_type'] = kwargs.get(
+            '_check_return_type', True
+        )
+        kwargs['_host_index'] = kwargs.get('_host_index')
+        kwargs['repository'] = \
+            repository
+        kwargs['source_ref'] = \
+            source_ref
+        kwargs['destination_branch'] = \
+            destination_branch
+        return self.merge_preview_endpoint.call_with_http_info(**kwargs)
+
     def restore_refs(
         self,
         repository,
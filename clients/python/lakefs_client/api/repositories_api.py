@@ -24,10 +24,13 @@ from lakefs_client.model_utils import (  # noqa: F401
 )
 from lakefs_client.model.branch_protection_rule import BranchProtectionRule
 from lakefs_client.model.error import Error
-from lakefs_client.model.inline_object1 import InlineObject1
+from lakefs_client.model.inline_object2 import InlineObject2
+from lakefs_client.model.merge_strategy_rules import MergeStrategyRules
 from lakefs_client.model.repository import Repository
 from lakefs_client.model.repository_creation import RepositoryCreation
 from lakefs_client.model.repository_list import RepositoryList
+from lakefs_client.model.repository_rename import RepositoryRename
+from lakefs_client.model.tag_protection_rule import TagProtectionRule
 
 
 class RepositoriesApi(object):
@@ -160,6 +163,66 @@ class RepositoriesApi(object):
             },
             api_client=api_client
         )
+        self.create_tag_protection_rule_endpoint = _Endpoint(
+            settings={
+                'response_type': None,
+                'auth': [
+                    'basic_auth',
+                    'cookie_auth',
+                    'jwt_token'
+                ],
+                'endpoint_path': '/repositories/{repository}/tag_protection',
+                'operation_id': 'create_tag_protection_rule',
+                'http_method': 'POST',
+                'servers': None,
+            },
+            params_map={
+                'all': [
+                    'repository',
+                    'tag_protection_rule',
+                ],
+                'required': [
+                    'repository',
+                    'tag_protection_rule',
+                ],
+                'nullable': [
+                ],
+                'enum': [
+                ],
+                'validation': [
+                ]
+            },
+            root_map={
+                'validations': {
+                },
+                'allowed_values': {
+                },
+                'openapi_types': {
+                    'repository':
+                        (str,),
+                    'tag_protection_rule':
+                        (TagProtectionRule,),
+                },
+                'attribute_map': {
+                    'repository': 'repository',
+                },
+                'location_map': {
+                    'repository': 'path',
+                    'tag_protection_rule': 'body',
+                },
+                'collection_format_map': {
+                }
+            },
+            headers_map={
+                'accept': [
+                    'application/json'
+                ],
+                'content_type': [
+                    'application/json'
+                ]
+            },
+            api_client=api_client
+        )
         self.delete_branch_protection_rule_endpoint = _Endpoint(
             settings={
                 'response_type': None,
@@ -176,11 +239,11 @@ class RepositoriesApi(object):
             params_map={
                 'all': [
                     'repository',
-                    'inline_object1',
+                    'inline_object2',
                 ],
                 'required': [
                     'repository',
-                    'inline_object1',
+                    'inline_object2',
                 ],
                 'nullable': [
                 ],
@@ -197,15 +260,15 @@ class RepositoriesApi(object):
                 'openapi_types': {
                     'repository':
                         (str,),
-                    'inline_object1':
-                        (InlineObject1,),
+                    'inline_object2':
+                        (InlineObject2,),
                 },
                 'attribute_map': {
                     'repository': 'repository',
                 },
                 'location_map': {
                     'repository': 'path',
-                    'inline_object1': 'body',
+                    'inline_object2': 'body',
                 },
                 'collection_format_map': {
                 }
@@ -273,6 +336,66 @@ class RepositoriesApi(object):
             },
             api_client=api_client
         )
+        self.delete_tag_protection_rule_endpoint = _Endpoint(
+            settings={
+                'response_type': None,
+                'auth': [
+                    'basic_auth',
+                    'cookie_auth',
+                    'jwt_token'
+                ],
+                'endpoint_path': '/repositories/{repository}/tag_protection',
+                'operation_id': 'delete_tag_protection_rule',
+                'http_method': 'DELETE',
+                'servers': None,
+            },
+            params_map={
+                'all': [
+                    'repository',
+                    'tag_protection_rule',
+                ],
+                'required': [
+                    'repository',
+                    'tag_protection_rule',
+                ],
+                'nullable': [
+                ],
+                'enum': [
+                ],
+                'validation': [
+                ]
+            },
+            root_map={
+                'validations': {
+                },
+                'allowed_values': {
+                },
+                'openapi_types': {
+                    'repository':
+                        (str,),
+                    'tag_protection_rule':
+                        (TagProtectionRule,),
+                },
+                'attribute_map': {
+                    'repository': 'repository',
+                },
+                'location_map': {
+                    'repository': 'path',
+                    'tag_protection_rule': 'body',
+                },
+                'collection_format_map': {
+                }
+            },
+            headers_map={
+                'accept': [
+                    'application/json'
+                ],
+                'content_type': [
+                    'application/json'
+                ]
+            },
+            api_client=api_client
+        )
         self.get_branch_protection_rules_endpoint = _Endpoint(
             settings={
                 'response_type': ([BranchProtectionRule],),
@@ -326,6 +449,59 @@ class RepositoriesApi(object):
             },
             api_client=api_client
         )
+        self.get_merge_strategy_rules_endpoint = _Endpoint(
+            settings={
+                'response_type': (MergeStrategyRules,),
+                'auth': [
+                    'basic_auth',
+                    'cookie_auth',
+                    'jwt_token'
+                ],
+                'endpoint_path': '/repositories/{repository}/merge_strategy_rules',
+                'operation_id': 'get_merge_strategy_rules',
+                'http_method': 'GET',
+                'servers': None,
+            },
+            params_map={
+                'all': [
+                    'repository',
+                ],
+                'required': [
+                    'repository',
+                ],
+                'nullable': [
+                ],
+                'enum': [
+                ],
+                'validation': [
+                ]
+            },
+            root_map={
+                'validations': {
+                },
+                'allowed_values': {
+                },
+                'openapi_types': {
+                    'repository':
+                        (str,),
+                },
+                'attribute_map': {
+                    'repository': 'repository',
+                },
+                'location_map': {
+                    'repository': 'path',
+                },
+                'collection_format_map': {
+                }
+            },
+            headers_map={
+                'accept': [
+                    'application/json'
+                ],
+                'content_type': [],
+            },
+            api_client=api_client
+        )
         self.get_repository_endpoint = _Endpoint(
             settings={
                 'response_type': (Repository,),
@@ -379,6 +555,59 @@ class RepositoriesApi(object):
             },
             api_client=api_client
         )
+        self.get_tag_protection_rules_endpoint = _Endpoint(
+            settings={
+                'response_type': ([TagProtectionRule],),
+                'auth': [
+                    'basic_auth',
+                    'cookie_auth',
+                    'jwt_token'
+                ],
+                'endpoint_path': '/repositories/{repository}/tag_protection',
+                'operation_id': 'get_tag_protection_rules',
+                'http_method': 'GET',
+                'servers': None,
+            },
+            params_map={
+                'all': [
+                    'repository',
+                ],
+                'required': [
+                    'repository',
+                ],
+                'nullable': [
+                ],
+                'enum': [
+                ],
+                'validation': [
+                ]
+            },
+            root_map={
+                'validations': {
+                },
+                'allowed_values': {
+                },
+                'openapi_types': {
+                    'repository':
+                        (str,),
+                },
+                'attribute_map': {
+                    'repository': 'repository',
+                },
+                'location_map': {
+                    'repository': 'path',
+                },
+                'collection_format_map': {
+                }
+            },
+            headers_map={
+                'accept': [
+                    'application/json'
+                ],
+                'content_type': [],
+            },
+            api_client=api_client
+        )
         self.list_repositories_endpoint = _Endpoint(
             settings={
                 'response_type': (RepositoryList,),
@@ -446,24 +675,469 @@ class RepositoriesApi(object):
             },
             api_client=api_client
         )
+        self.rename_repository_endpoint = _Endpoint(
+            settings={
+                'response_type': (Repository,),
+                'auth': [
+                    'basic_auth',
+                    'cookie_auth',
+                    'jwt_token'
+                ],
+                'endpoint_path': '/repositories/{repository}/rename',
+                'operation_id': 'rename_repository',
+                'http_method': 'POST',
+                'servers': None,
+            },
+            params_map={
+                'all': [
+                    'repository',
+                    'repository_rename',
+                ],
+                'required': [
+                    'repository',
+                    'repository_rename',
+                ],
+                'nullable': [
+                ],
+                'enum': [
+                ],
+                'validation': [
+                ]
+            },
+            root_map={
+                'validations': {
+                },
+                'allowed_values': {
+                },
+                'openapi_types': {
+                    'repository':
+                        (str,),
+                    'repository_rename':
+                        (RepositoryRename,),
+                },
+                'attribute_map': {
+                    'repository': 'repository',
+                },
+                'location_map': {
+                    'repository': 'path',
+                    'repository_rename': 'body',
+                },
+                'collection_format_map': {
+                }
+            },
+            headers_map={
+                'accept': [
+                    'application/json'
+                ],
+                'content_type': [
+                    'application/json'
+                ]
+            },
+            api_client=api_client
+        )
+        self.restore_repository_endpoint = _Endpoint(
+            settings={
+                'response_type': (Repository,),
+                'auth': [
+                    'basic_auth',
+                    'cookie_auth',
+                    'jwt_token'
+                ],
+                'endpoint_path': '/repositories/{repository}/restore',
+                'operation_id': 'restore_repository',
+                'http_method': 'POST',
+                'servers': None,
+            },
+            params_map={
+                'all': [
+                    'repository',
+                ],
+                'required': [
+                    'repository',
+                ],
+                'nullable': [
+                ],
+                'enum': [
+                ],
+                'validation': [
+                ]
+            },
+            root_map={
+                'validations': {
+                },
+                'allowed_values': {
+                },
+                'openapi_types': {
+                    'repository':
+                        (str,),
+                },
+                'attribute_map': {
+                    'repository': 'repository',
+                },
+                'location_map': {
+                    'repository': 'path',
+                },
+                'collection_format_map': {
+                }
+            },
+            headers_map={
+                'accept': [
+                    'application/json'
+                ],
+                'content_type': [],
+            },
+            api_client=api_client
+        )
+        self.set_merge_strategy_rules_endpoint = _Endpoint(
+            settings={
+                'response_type': None,
+                'auth': [
+                    'basic_auth',
+                    'cookie_auth',
+                    'jwt_token'
+                ],
+                'endpoint_path': '/repositories/{repository}/merge_strategy_rules',
+                'operation_id': 'set_merge_strategy_rules',
+                'http_method': 'PUT',
+                'servers': None,
+            },
+            params_map={
+                'all': [
+                    'repository',
+                    'merge_strategy_rules',
+                ],
+                'required': [
+                    'repository',
+                    'merge_strategy_rules',
+                ],
+                'nullable': [
+                ],
+                'enum': [
+                ],
+                'validation': [
+                ]
+            },
+            root_map={
+                'validations': {
+                },
+                'allowed_values': {
+                },
+                'openapi_types': {
+                    'repository':
+                        (str,),
+                    'merge_strategy_rules':
+                        (MergeStrategyRules,),
+                },
+                'attribute_map': {
+                    'repository': 'repository',
+                },
+                'location_map': {
+                    'repository': 'path',
+                    'merge_strategy_rules': 'body',
+                },
+                'collection_format_map': {
+                }
+            },
+            headers_map={
+                'accept': [
+                    'application/json'
+                ],
+                'content_type': [
+                    'application/json'
+                ]
+            },
+            api_client=api_client
+        )
+
+    def create_branch_protection_rule(
+        self,
+        repository,
+        branch_protection_rule,
+        **kwargs
+    ):
+        """create_branch_protection_rule  # noqa: E501
+
+        This method makes a synchronous HTTP request by default. To make an
+        asynchronous HTTP request, please pass async_req=True
+
+        >>> thread = api.create_branch_protection_rule(repository, branch_protection_rule, async_req=True)
+        >>> result = thread.get()
+
+        Args:
+            repository (str):
+            branch_protection_rule (BranchProtectionRule):
+
+        Keyword Args:
+            _return_http_data_only (bool): response data without head status
+                code and headers. Default is True.
+            _preload_content (bool): if False, the urllib3.HTTPResponse object
+                will be returned without reading/decoding response data.
+                Default is True.
+            _request_timeout (int/float/tuple): timeout setting for this request. If
+                one number provided, it will be total request timeout. It can also
+                be a pair (tuple) of (connection, read) timeouts.
+                Default is None.
+            _check_input_type (bool): specifies if type checking
+                should be done one the data sent to the server.
+                Default is True.
+            _check_return_type (bool): specifies if type checking
+                should be done one the data received from the server.
+                Default is True.
+            _host_index (int/None): specifies the index of the server
+                that we want to use.
+                Default is read from the configuration.
+            async_req (bool): execute request asynchronously
+
+        Returns:
+            None
+                If the method is called asynchronously, returns the request
+                thread.
+        """
+        kwargs['async_req'] = kwargs.get(
+            'async_req', False
+        )
+        kwargs['_return_http_data_only'] = kwargs.get(
+            '_return_http_data_only', True
+        )
+        kwargs['_preload_content'] = kwargs.get(
+            '_preload_content', True
+        )
+        kwargs['_request_timeout'] = kwargs.get(
+            '_request_timeout', None
+        )
+        kwargs['_check_input_type'] = kwargs.get(
+            '_check_input_type', True
+        )
+        kwargs['_check_return_type'] = kwargs.get(
+            '_check_return_type', True
+        )
+        kwargs['_host_index'] = kwargs.get('_host_index')
+        kwargs['repository'] = \
+            repository
+        kwargs['branch_protection_rule'] = \
+            branch_protection_rule
+        return self.create_branch_protection_rule_endpoint.call_with_http_info(**kwargs)
+
+    def create_repository(
+        self,
+        repository_creation,
+        **kwargs
+    ):
+        """create repository  # noqa: E501
+
+        This method makes a synchronous HTTP request by default. To make an
+        asynchronous HTTP request, please pass async_req=True
+
+        >>> thread = api.create_repository(repository_creation, async_req=True)
+        >>> result = thread.get()
+
+        Args:
+            repository_creation (RepositoryCreation):
+
+        Keyword Args:
+            bare (bool): If true, create a bare repository with no initial commit and branch. [optional] if omitted the server will use the default value of False
+            _return_http_data_only (bool): response data without head status
+                code and headers. Default is True.
+            _preload_content (bool): if False, the urllib3.HTTPResponse object
+                will be returned without reading/decoding response data.
+                Default is True.
+            _request_timeout (int/float/tuple): timeout setting for this request. If
+                one number provided, it will be total request timeout. It can also
+                be a pair (tuple) of (connection, read) timeouts.
+                Default is None.
+            _check_input_type (bool): specifies if type checking
+                should be done one the data sent to the server.
+                Default is True.
+            _check_return_type (bool): specifies if type checking
+                should be done one the data received from the server.
+                Default is True.
+            _host_index (int/None): specifies the index of the server
+                that we want to use.
+                Default is read from the configuration.
+            async_req (bool): execute request asynchronously
+
+        Returns:
+            Repository
+                If the method is called asynchronously, returns the request
+                thread.
+        """
+        kwargs['async_req'] = kwargs.get(
+            'async_req', False
+        )
+        kwargs['_return_http_data_only'] = kwargs.get(
+            '_return_http_data_only', True
+        )
+        kwargs['_preload_content'] = kwargs.get(
+            '_preload_content', True
+        )
+        kwargs['_request_timeout'] = kwargs.get(
+            '_request_timeout', None
+        )
+        kwargs['_check_input_type'] = kwargs.get(
+            '_check_input_type', True
+        )
+        kwargs['_check_return_type'] = kwargs.get(
+            '_check_return_type', True
+        )
+        kwargs['_host_index'] = kwargs.get('_host_index')
+        kwargs['repository_creation'] = \
+            repository_creation
+        return self.create_repository_endpoint.call_with_http_info(**kwargs)
+
+    def create_tag_protection_rule(
+        self,
+        repository,
+        tag_protection_rule,
+        **kwargs
+    ):
+        """create_tag_protection_rule  # noqa: E501
+
+        This method makes a synchronous HTTP request by default. To make an
+        asynchronous HTTP request, please pass async_req=True
+
+        >>> thread = api.create_tag_protection_rule(repository, tag_protection_rule, async_req=True)
+        >>> result = thread.get()
+
+        Args:
+            repository (str):
+            tag_protection_rule (TagProtectionRule):
+
+        Keyword Args:
+            _return_http_data_only (bool): response data without head status
+                code and headers. Default is True.
+            _preload_content (bool): if False, the urllib3.HTTPResponse object
+                will be returned without reading/decoding response data.
+                Default is True.
+            _request_timeout (int/float/tuple): timeout setting for this request. If
+                one number provided, it will be total request timeout. It can also
+                be a pair (tuple) of (connection, read) timeouts.
+                Default is None.
+            _check_input_type (bool): specifies if type checking
+                should be done one the data sent to the server.
+                Default is True.
+            _check_return_type (bool): specifies if type checking
+                should be done one the data received from the server.
+                Default is True.
+            _host_index (int/None): specifies the index of the server
+                that we want to use.
+                Default is read from the configuration.
+            async_req (bool): execute request asynchronously
+
+        Returns:
+            None
+                If the method is called asynchronously, returns the request
+                thread.
+        """
+        kwargs['async_req'] = kwargs.get(
+            'async_req', False
+        )
+        kwargs['_return_http_data_only'] = kwargs.get(
+            '_return_http_data_only', True
+        )
+        kwargs['_preload_content'] = kwargs.get(
+            '_preload_content', True
+        )
+        kwargs['_request_timeout'] = kwargs.get(
+            '_request_timeout', None
+        )
+        kwargs['_check_input_type'] = kwargs.get(
+            '_check_input_type', True
+        )
+        kwargs['_check_return_type'] = kwargs.get(
+            '_check_return_type', True
+        )
+        kwargs['_host_index'] = kwargs.get('_host_index')
+        kwargs['repository'] = \
+            repository
+        kwargs['tag_protection_rule'] = \
+            tag_protection_rule
+        return self.create_tag_protection_rule_endpoint.call_with_http_info(**kwargs)
+
+    def delete_branch_protection_rule(
+        self,
+        repository,
+        inline_object2,
+        **kwargs
+    ):
+        """delete_branch_protection_rule  # noqa: E501
+
+        This method makes a synchronous HTTP request by default. To make an
+        asynchronous HTTP request, please pass async_req=True
+
+        >>> thread = api.delete_branch_protection_rule(repository, inline_object2, async_req=True)
+        >>> result = thread.get()
+
+        Args:
+            repository (str):
+            inline_object2 (InlineObject2):
+
+        Keyword Args:
+            _return_http_data_only (bool): response data without head status
+                code and headers. Default is True.
+            _preload_content (bool): if False, the urllib3.HTTPResponse object
+                will be returned without reading/decoding response data.
+                Default is True.
+            _request_timeout (int/float/tuple): timeout setting for this request. If
+                one number provided, it will be total request timeout. It can also
+                be a pair (tuple) of (connection, read) timeouts.
+                Default is None.
+            _check_input_type (bool): specifies if type checking
+                should be done one the data sent to the server.
+                Default is True.
+            _check_return_type (bool): specifies if type checking
+                should be done one the data received from the server.
+                Default is True.
+            _host_index (int/None): specifies the index of the server
+                that we want to use.
+                Default is read from the configuration.
+            async_req (bool): execute request asynchronously
+
+        Returns:
+            None
+                If the method is called asynchronously, returns the request
+                thread.
+        """
+        kwargs['async_req'] = kwargs.get(
+            'async_req', False
+        )
+        kwargs['_return_http_data_only'] = kwargs.get(
+            '_return_http_data_only', True
+        )
+        kwargs['_preload_content'] = kwargs.get(
+            '_preload_content', True
+        )
+        kwargs['_request_timeout'] = kwargs.get(
+            '_request_timeout', None
+        )
+        kwargs['_check_input_type'] = kwargs.get(
+            '_check_input_type', True
+        )
+        kwargs['_check_return_type'] = kwargs.get(
+            '_check_return_type', True
+        )
+        kwargs['_host_index'] = kwargs.get('_host_index')
+        kwargs['repository'] = \
+            repository
+        kwargs['inline_object2'] = \
+            inline_object2
+        return self.delete_branch_protection_rule_endpoint.call_with_http_info(**kwargs)
 
-    def create_branch_protection_rule(
+    def delete_repository(
         self,
         repository,
-        branch_protection_rule,
         **kwargs
     ):
-        """create_branch_protection_rule  # noqa: E501
+        """delete repository  # noqa: E501
 
+        The repository can be restored until its deletion retention period passes and it is purged. A repository with legal holds cannot be deleted.  # noqa: E501
         This method makes a synchronous HTTP request by default. To make an
         asynchronous HTTP request, please pass async_req=True
 
-        >>> thread = api.create_branch_protection_rule(repository, branch_protection_rule, async_req=True)
+        >>> thread = api.delete_repository(repository, async_req=True)
         >>> result = thread.get()
 
         Args:
             repository (str):
-            branch_protection_rule (BranchProtectionRule):
 
         Keyword Args:
             _return_http_data_only (bool): response data without head status
@@ -512,28 +1186,27 @@ class RepositoriesApi(object):
         kwargs['_host_index'] = kwargs.get('_host_index')
         kwargs['repository'] = \
             repository
-        kwargs['branch_protection_rule'] = \
-            branch_protection_rule
-        return self.create_branch_protection_rule_endpoint.call_with_http_info(**kwargs)
+        return self.delete_repository_endpoint.call_with_http_info(**kwargs)
 
-    def create_repository(
+    def delete_tag_protection_rule(
         self,
-        repository_creation,
+        repository,
+        tag_protection_rule,
         **kwargs
     ):
-        """create repository  # noqa: E501
+        """delete_tag_protection_rule  # noqa: E501
 
         This method makes a synchronous HTTP request by default. To make an
         asynchronous HTTP request, please pass async_req=True
 
-        >>> thread = api.create_repository(repository_creation, async_req=True)
+        >>> thread = api.delete_tag_protection_rule(repository, tag_protection_rule, async_req=True)
         >>> result = thread.get()
 
         Args:
-            repository_creation (RepositoryCreation):
+            repository (str):
+            tag_protection_rule (TagProtectionRule):
 
         Keyword Args:
-            bare (bool): If true, create a bare repository with no initial commit and branch. [optional] if omitted the server will use the default value of False
             _return_http_data_only (bool): response data without head status
                 code and headers. Default is True.
             _preload_content (bool): if False, the urllib3.HTTPResponse object
@@ -555,7 +1228,7 @@ class RepositoriesApi(object):
             async_req (bool): execute request asynchronously
 
         Returns:
-            Repository
+            None
                 If the method is called asynchronously, returns the request
                 thread.
         """
@@ -578,27 +1251,27 @@ class RepositoriesApi(object):
             '_check_return_type', True
         )
         kwargs['_host_index'] = kwargs.get('_host_index')
-        kwargs['repository_creation'] = \
-            repository_creation
-        return self.create_repository_endpoint.call_with_http_info(**kwargs)
+        kwargs['repository'] = \
+            repository
+        kwargs['tag_protection_rule'] = \
+            tag_protection_rule
+        return self.delete_tag_protection_rule_endpoint.call_with_http_info(**kwargs)
 
-    def delete_branch_protection_rule(
+    def get_branch_protection_rules(
         self,
         repository,
-        inline_object1,
         **kwargs
     ):
-        """delete_branch_protection_rule  # noqa: E501
+        """get branch protection rules  # noqa: E501
 
         This method makes a synchronous HTTP request by default. To make an
         asynchronous HTTP request, please pass async_req=True
 
-        >>> thread = api.delete_branch_protection_rule(repository, inline_object1, async_req=True)
+        >>> thread = api.get_branch_protection_rules(repository, async_req=True)
         >>> result = thread.get()
 
         Args:
             repository (str):
-            inline_object1 (InlineObject1):
 
         Keyword Args:
             _return_http_data_only (bool): response data without head status
@@ -622,7 +1295,7 @@ class RepositoriesApi(object):
             async_req (bool): execute request asynchronously
 
         Returns:
-            None
+            [BranchProtectionRule]
                 If the method is called asynchronously, returns the request
                 thread.
         """
@@ -647,21 +1320,20 @@ class RepositoriesApi(object):
         kwargs['_host_index'] = kwargs.get('_host_index')
         kwargs['repository'] = \
             repository
-        kwargs['inline_object1'] = \
-            inline_object1
-        return self.delete_branch_protection_rule_endpoint.call_with_http_info(**kwargs)
+        return self.get_branch_protection_rules_endpoint.call_with_http_info(**kwargs)
 
-    def delete_repository(
+    def get_merge_strategy_rules(
         self,
         repository,
         **kwargs
     ):
-        """delete repository  # noqa: E501
+        """get the merge strategy rules applied by default to merges in the repository  # noqa: E501
 
+        The rules apply to merges that set no merge strategy, after the strategy rules of the merge.  # noqa: E501
         This method makes a synchronous HTTP request by default. To make an
         asynchronous HTTP request, please pass async_req=True
 
-        >>> thread = api.delete_repository(repository, async_req=True)
+        >>> thread = api.get_merge_strategy_rules(repository, async_req=True)
         >>> result = thread.get()
 
         Args:
@@ -689,7 +1361,7 @@ class RepositoriesApi(object):
             async_req (bool): execute request asynchronously
 
         Returns:
-            None
+            MergeStrategyRules
                 If the method is called asynchronously, returns the request
                 thread.
         """
@@ -714,19 +1386,19 @@ class RepositoriesApi(object):
         kwargs['_host_index'] = kwargs.get('_host_index')
         kwargs['repository'] = \
             repository
-        return self.delete_repository_endpoint.call_with_http_info(**kwargs)
+        return self.get_merge_strategy_rules_endpoint.call_with_http_info(**kwargs)
 
-    def get_branch_protection_rules(
+    def get_repository(
         self,
         repository,
         **kwargs
     ):
-        """get branch protection rules  # noqa: E501
+        """get repository  # noqa: E501
 
         This method makes a synchronous HTTP request by default. To make an
         asynchronous HTTP request, please pass async_req=True
 
-        >>> thread = api.get_branch_protection_rules(repository, async_req=True)
+        >>> thread = api.get_repository(repository, async_req=True)
         >>> result = thread.get()
 
         Args:
@@ -754,7 +1426,7 @@ class RepositoriesApi(object):
             async_req (bool): execute request asynchronously
 
         Returns:
-            [BranchProtectionRule]
+            Repository
                 If the method is called asynchronously, returns the request
                 thread.
         """
@@ -779,19 +1451,19 @@ class RepositoriesApi(object):
         kwargs['_host_index'] = kwargs.get('_host_index')
         kwargs['repository'] = \
             repository
-        return self.get_branch_protection_rules_endpoint.call_with_http_info(**kwargs)
+        return self.get_repository_endpoint.call_with_http_info(**kwargs)
 
-    def get_repository(
+    def get_tag_protection_rules(
         self,
         repository,
         **kwargs
     ):
-        """get repository  # noqa: E501
+        """get tag protection rules  # noqa: E501
 
         This method makes a synchronous HTTP request by default. To make an
         asynchronous HTTP request, please pass async_req=True
 
-        >>> thread = api.get_repository(repository, async_req=True)
+        >>> thread = api.get_tag_protection_rules(repository, async_req=True)
         >>> result = thread.get()
 
         Args:
@@ -819,7 +1491,7 @@ class RepositoriesApi(object):
             async_req (bool): execute request asynchronously
 
         Returns:
-            Repository
+            [TagProtectionRule]
                 If the method is called asynchronously, returns the request
                 thread.
         """
@@ -844,7 +1516,7 @@ class RepositoriesApi(object):
         kwargs['_host_index'] = kwargs.get('_host_index')
         kwargs['repository'] = \
             repository
-        return self.get_repository_endpoint.call_with_http_info(**kwargs)
+        return self.get_tag_protection_rules_endpoint.call_with_http_info(**kwargs)
 
     def list_repositories(
         self,
@@ -909,3 +1581,206 @@ class RepositoriesApi(object):
         kwargs['_host_index'] = kwargs.get('_host_index')
         return self.list_repositories_endpoint.call_with_http_info(**kwargs)
 
+    def rename_repository(
+        self,
+        repository,
+        repository_rename,
+        **kwargs
+    ):
+        """rename repository  # noqa: E501
+
+        This method makes a synchronous HTTP request by default. To make an
+        asynchronous HTTP request, please pass async_req=True
+
+        >>> thread = api.rename_repository(repository, repository_rename, async_req=True)
+        >>> result = thread.get()
+
+        Args:
+            repository (str):
+            repository_rename (RepositoryRename):
+
+        Keyword Args:
+            _return_http_data_only (bool): response data without head status
+                code and headers. Default is True.
+            _preload_content (bool): if False, the urllib3.HTTPResponse object
+                will be returned without reading/decoding response data.
+                Default is True.
+            _request_timeout (int/float/tuple): timeout setting for this request. If
+                one number provided, it will be total request timeout. It can also
+                be a pair (tuple) of (connection, read) timeouts.
+                Default is None.
+            _check_input_type (bool): specifies if type checking
+                should be done one the data sent to the server.
+                Default is True.
+            _check_return_type (bool): specifies if type checking
+                should be done one the data received from the server.
+                Default is True.
+            _host_index (int/None): specifies the index of the server
+                that we want to use.
+                Default is read from the configuration.
+            async_req (bool): execute request asynchronously
+
+        Returns:
+            Repository
+                If the method is called asynchronously, returns the request
+                thread.
+        """
+        kwargs['async_req'] = kwargs.get(
+            'async_req', False
+        )
+        kwargs['_return_http_data_only'] = kwargs.get(
+            '_return_http_data_only', True
+        )
+        kwargs['_preload_content'] = kwargs.get(
+            '_preload_content', True
+        )
+        kwargs['_request_timeout'] = kwargs.get(
+            '_request_timeout', None
+        )
+        kwargs['_check_input_type'] = kwargs.get(
+            '_check_input_type', True
+        )
+        kwargs['_check_return_type'] = kwargs.get(
+            '_check_return_type', True
+        )
+        kwargs['_host_index'] = kwargs.get('_host_index')
+        kwargs['repository'] = \
+            repository
+        kwargs['repository_rename'] = \
+            repository_rename
+        return self.rename_repository_endpoint.call_with_http_info(**kwargs)
+
+    def restore_repository(
+        self,
+        repository,
+        **kwargs
+    ):
+        """restore a deleted repository that was not purged yet  # noqa: E501
+
+        This method makes a synchronous HTTP request by default. To make an
+        asynchronous HTTP request, please pass async_req=True
+
+        >>> thread = api.restore_repository(repository, async_req=True)
+        >>> result = thread.get()
+
+        Args:
+            repository (str):
+
+        Keyword Args:
+            _return_http_data_only (bool): response data without head status
+                code and headers. Default is True.
+            _preload_content (bool): if False, the urllib3.HTTPResponse object
+                will be returned without reading/decoding response data.
+                Default is True.
+            _request_timeout (int/float/tuple): timeout setting for this request. If
+                one number provided, it will be total request timeout. It can also
+                be a pair (tuple) of (connection, read) timeouts.
+                Default is None.
+            _check_input_type (bool): specifies if type checking
+                should be done one the data sent to the server.
+                Default is True.
+            _check_return_type (bool): specifies if type checking
+                should be done one the data received from the server.
+                Default is True.
+            _host_index (int/None): specifies the index of the server
+                that we want to use.
+                Default is read from the configuration.
+            async_req (bool): execute request asynchronously
+
+        Returns:
+            Repository
+                If the method is called asynchronously, returns the request
+                thread.
+        """
+        kwargs['async_req'] = kwargs.get(
+            'async_req', False
+        )
+        kwargs['_return_http_data_only'] = kwargs.get(
+            '_return_http_data_only', True
+        )
+        kwargs['_preload_content'] = kwargs.get(
+            '_preload_content', True
+        )
+        kwargs['_request_timeout'] = kwargs.get(
+            '_request_timeout', None
+        )
+        kwargs['_check_input_type'] = kwargs.get(
+            '_check_input_type', True
+        )
+        kwargs['_check_return_type'] = kwargs.get(
+            '_check_return_type', True
+        )
+        kwargs['_host_index'] = kwargs.get('_host_index')
+        kwargs['repository'] = \
+            repository
+        return self.restore_repository_endpoint.call_with_http_info(**kwargs)
+
+    def set_merge_strategy_rules(
+        self,
+        repository,
+        merge_strategy_rules,
+        **kwargs
+    ):
+        """replace the merge strategy rules applied by default to merges in the repository  # noqa: E501
+
+        This method makes a synchronous HTTP request by default. To make an
+        asynchronous HTTP request, please pass async_req=True
+
+        >>> thread = api.set_merge_strategy_rules(repository, merge_strategy_rules, async_req=True)
+        >>> result = thread.get()
+
+        Args:
+            repository (str):
+            merge_strategy_rules (MergeStrategyRules):
+
+        Keyword Args:
+            _return_http_data_only (bool): response data without head status
+                code and headers. Default is True.
+            _preload_content (bool): if False, the urllib3.HTTPResponse object
+                will be returned without reading/decoding response data.
+                Default is True.
+            _request_timeout (int/float/tuple): timeout setting for this request. If
+                one number provided, it will be total request timeout. It can also
+                be a pair (tuple) of (connection, read) timeouts.
+                Default is None.
+            _check_input_type (bool): specifies if type checking
+                should be done one the data sent to the server.
+                Default is True.
+            _check_return_type (bool): specifies if type checking
+                should be done one the data received from the server.
+                Default is True.
+            _host_index (int/None): specifies the index of the server
+                that we want to use.
+                Default is read from the configuration.
+            async_req (bool): execute request asynchronously
+
+        Returns:
+            None
+                If the method is called asynchronously, returns the request
+                thread.
+        """
+        kwargs['async_req'] = kwargs.get(
+            'async_req', False
+        )
+        kwargs['_return_http_data_only'] = kwargs.get(
+            '_return_http_data_only', True
+        )
+        kwargs['_preload_content'] = kwargs.get(
+            '_preload_content', True
+        )
+        kwargs['_request_timeout'] = kwargs.get(
+            '_request_timeout', None
+        )
+        kwargs['_check_input_type'] = kwargs.get(
+            '_check_input_type', True
+        )
+        kwargs['_check_return_type'] = kwargs.get(
+            '_check_return_type', True
+        )
+        kwargs['_host_index'] = kwargs.get('_host_index')
+        kwargs['repository'] = \
+            repository
+        kwargs['merge_strategy_rules'] = \
+            merge_strategy_rules
+        return self.set_merge_strategy_rules_endpoint.call_with_http_info(**kwargs)
+
@@ -23,9 +23,9 @@ from lakefs_client.model_utils import (  # noqa: F401
     validate_and_convert_types
 )
 from lakefs_client.model.error import Error
-from lakefs_client.model.ref import Ref
-from lakefs_client.model.ref_list import RefList
+from lakefs_client.model.tag import Tag
 from lakefs_client.model.tag_creation import TagCreation
+from lakefs_client.model.tag_list import TagList
 
 
 class TagsApi(object):
@@ -41,7 +41,7 @@ class TagsApi(object):
         self.api_client = api_client
         self.create_tag_endpoint = _Endpoint(
             settings={
-                'response_type': (Ref,),
+                'response_type': (Tag,),
                 'auth': [
                     'basic_auth',
                     'cookie_auth',
@@ -160,7 +160,7 @@ class TagsApi(object):
         )
         self.get_tag_endpoint = _Endpoint(
             settings={
-                'response_type': (Ref,),
+                'response_type': (Tag,),
                 'auth': [
                     'basic_auth',
                     'cookie_auth',
@@ -219,7 +219,7 @@ class TagsApi(object):
         )
         self.list_tags_endpoint = _Endpoint(
             settings={
-                'response_type': (RefList,),
+                'response_type': (TagList,),
                 'auth': [
                     'basic_auth',
                     'cookie_auth',
@@ -332,7 +332,7 @@ class TagsApi(object):
             async_req (bool): execute request asynchronously
 
         Returns:
-            Ref
+            Tag
                 If the method is called asynchronously, returns the request
                 thread.
         """
@@ -470,7 +470,7 @@ class TagsApi(object):
             async_req (bool): execute request asynchronously
 
         Returns:
-            Ref
+            Tag
                 If the method is called asynchronously, returns the request
                 thread.
         """
@@ -540,7 +540,7 @@ class TagsApi(object):
             async_req (bool): execute request asynchronously
 
         Returns:
-            RefList
+            TagList
                 If the method is called asynchronously, returns the request
                 thread.
         """
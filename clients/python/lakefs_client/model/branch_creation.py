@@ -59,6 +59,9 @@ class BranchCreation(ModelNormal):
     }
 
     validations = {
+        ('idle_ttl',): {
+            'inclusive_minimum': 1,
+        },
     }
 
     @cached_property
@@ -84,6 +87,8 @@ class BranchCreation(ModelNormal):
         return {
             'name': (str,),  # noqa: E501
             'source': (str,),  # noqa: E501
+            'expires_at': (int,),  # noqa: E501
+            'idle_ttl': (int,),  # noqa: E501
         }
 
     @cached_property
@@ -94,6 +99,8 @@ class BranchCreation(ModelNormal):
     attribute_map = {
         'name': 'name',  # noqa: E501
         'source': 'source',  # noqa: E501
+        'expires_at': 'expires_at',  # noqa: E501
+        'idle_ttl': 'idle_ttl',  # noqa: E501
     }
 
     read_only_vars = {
@@ -141,6 +148,8 @@ class BranchCreation(ModelNormal):
                                 Animal class but this time we won't travel
                                 through its discriminator because we passed in
                                 _visited_composed_classes = (Animal,)
+            expires_at (int): Unix Epoch in seconds at which the branch expires and is deleted. [optional]  # noqa: E501
+            idle_ttl (int): period in seconds after the latest change of the branch head at which the branch expires and is deleted. [optional]  # noqa: E501
         """
 
         _check_type = kwargs.pop('_check_type', True)
@@ -228,6 +237,8 @@ class BranchCreation(ModelNormal):
                                 Animal class but this time we won't travel
                                 through its discriminator because we passed in
                                 _visited_composed_classes = (Animal,)
+            expires_at (int): Unix Epoch in seconds at which the branch expires and is deleted. [optional]  # noqa: E501
+            idle_ttl (int): period in seconds after the latest change of the branch head at which the branch expires and is deleted. [optional]  # noqa: E501
         """
 
         _check_type = kwargs.pop('_check_type', True)
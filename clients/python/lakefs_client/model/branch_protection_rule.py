@@ -56,6 +56,15 @@ class BranchProtectionRule(ModelNormal):
     """
 
     allowed_values = {
+        ('blocked_actions',): {
+            'STAGING_WRITE': "staging_write",
+            'COMMIT': "commit",
+            'DELETE': "delete",
+            'RESET': "reset",
+            'REVERT': "revert",
+            'UPDATE_POINTER': "update_pointer",
+            'MERGE': "merge",
+        },
     }
 
     validations = {
@@ -86,6 +95,9 @@ class BranchProtectionRule(ModelNormal):
         """
         return {
             'pattern': (str,),  # noqa: E501
+            'blocked_actions': ([str],),  # noqa: E501
+            'merge_source_pattern': (str,),  # noqa: E501
+            'require_signed_commits': (bool,),  # noqa: E501
         }
 
     @cached_property
@@ -95,6 +107,9 @@ class BranchProtectionRule(ModelNormal):
 
     attribute_map = {
         'pattern': 'pattern',  # noqa: E501
+        'blocked_actions': 'blocked_actions',  # noqa: E501
+        'merge_source_pattern': 'merge_source_pattern',  # noqa: E501
+        'require_signed_commits': 'require_signed_commits',  # noqa: E501
     }
 
     read_only_vars = {
@@ -141,6 +156,9 @@ class BranchProtectionRule(ModelNormal):
                                 Animal class but this time we won't travel
                                 through its discriminator because we passed in
                                 _visited_composed_classes = (Animal,)
+            blocked_actions ([str]): actions blocked on matching branches, defaults to staging_write and commit. [optional]  # noqa: E501
+            merge_source_pattern (str): fnmatch pattern, when set merges into matching branches are allowed only from source branches matching it. [optional]  # noqa: E501
+            require_signed_commits (bool): when set matching branches may point only to commits with a verified signature. [optional]  # noqa: E501
         """
 
         _check_type = kwargs.pop('_check_type', True)
@@ -226,6 +244,9 @@ class BranchProtectionRule(ModelNormal):
                                 Animal class but this time we won't travel
                                 through its discriminator because we passed in
                                 _visited_composed_classes = (Animal,)
+            blocked_actions ([str]): actions blocked on matching branches, defaults to staging_write and commit. [optional]  # noqa: E501
+            merge_source_pattern (str): fnmatch pattern, when set merges into matching branches are allowed only from source branches matching it. [optional]  # noqa: E501
+            require_signed_commits (bool): when set matching branches may point only to commits with a verified signature. [optional]  # noqa: E501
         """
 
         _check_type = kwargs.pop('_check_type', True)
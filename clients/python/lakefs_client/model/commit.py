@@ -30,6 +30,10 @@ from ..model_utils import OpenApiModel
 from lakefs_client.exceptions import ApiAttributeError
 
 
+def lazy_import():
+    from lakefs_client.model.commit_signature import CommitSignature
+    globals()['CommitSignature'] = CommitSignature
+
 
 class Commit(ModelNormal):
     """NOTE: This class is auto generated by OpenAPI Generator.
@@ -67,6 +71,7 @@ class Commit(ModelNormal):
         This must be a method because a model may have properties that are
         of type self, this must run after the class is loaded
         """
+        lazy_import()
         return (bool, date, datetime, dict, float, int, list, str, none_type,)  # noqa: E501
 
     _nullable = False
@@ -81,6 +86,7 @@ class Commit(ModelNormal):
             openapi_types (dict): The key is attribute name
                 and the value is attribute type.
         """
+        lazy_import()
         return {
             'id': (str,),  # noqa: E501
             'parents': ([str],),  # noqa: E501
@@ -89,6 +95,7 @@ class Commit(ModelNormal):
             'creation_date': (int,),  # noqa: E501
             'meta_range_id': (str,),  # noqa: E501
             'metadata': ({str: (str,)},),  # noqa: E501
+            'signature': (CommitSignature,),  # noqa: E501
         }
 
     @cached_property
@@ -104,6 +111,7 @@ class Commit(ModelNormal):
         'creation_date': 'creation_date',  # noqa: E501
         'meta_range_id': 'meta_range_id',  # noqa: E501
         'metadata': 'metadata',  # noqa: E501
+        'signature': 'signature',  # noqa: E501
     }
 
     read_only_vars = {
@@ -156,6 +164,7 @@ class Commit(ModelNormal):
                                 through its discriminator because we passed in
                                 _visited_composed_classes = (Animal,)
             metadata ({str: (str,)}): [optional]  # noqa: E501
+            signature (CommitSignature): [optional]  # noqa: E501
         """
 
         _check_type = kwargs.pop('_check_type', True)
@@ -252,6 +261,7 @@ class Commit(ModelNormal):
                                 through its discriminator because we passed in
                                 _visited_composed_classes = (Animal,)
             metadata ({str: (str,)}): [optional]  # noqa: E501
+            signature (CommitSignature): [optional]  # noqa: E501
         """
 
         _check_type = kwargs.pop('_check_type', True)
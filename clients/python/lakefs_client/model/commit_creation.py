@@ -85,6 +85,7 @@ class CommitCreation(ModelNormal):
             'message': (str,),  # noqa: E501
             'metadata': ({str: (str,)},),  # noqa: E501
             'date': (int,),  # noqa: E501
+            'prefixes': ([str],),  # noqa: E501
         }
 
     @cached_property
@@ -96,6 +97,7 @@ class CommitCreation(ModelNormal):
         'message': 'message',  # noqa: E501
         'metadata': 'metadata',  # noqa: E501
         'date': 'date',  # noqa: E501
+        'prefixes': 'prefixes',  # noqa: E501
     }
 
     read_only_vars = {
@@ -144,6 +146,7 @@ class CommitCreation(ModelNormal):
                                 _visited_composed_classes = (Animal,)
             metadata ({str: (str,)}): [optional]  # noqa: E501
             date (int): set date to override creation date in the commit (Unix Epoch in seconds). [optional]  # noqa: E501
+            prefixes ([str]): commit only the uncommitted changes under these prefixes (a path commits that object), the rest stay uncommitted. All uncommitted changes are committed when missing or empty. . [optional]  # noqa: E501
         """
 
         _check_type = kwargs.pop('_check_type', True)
@@ -231,6 +234,7 @@ class CommitCreation(ModelNormal):
                                 _visited_composed_classes = (Animal,)
             metadata ({str: (str,)}): [optional]  # noqa: E501
             date (int): set date to override creation date in the commit (Unix Epoch in seconds). [optional]  # noqa: E501
+            prefixes ([str]): commit only the uncommitted changes under these prefixes (a path commits that object), the rest stay uncommitted. All uncommitted changes are committed when missing or empty. . [optional]  # noqa: E501
         """
 
         _check_type = kwargs.pop('_check_type', True)
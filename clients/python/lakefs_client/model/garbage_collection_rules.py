@@ -31,7 +31,9 @@ from lakefs_client.exceptions import ApiAttributeError
 
 
 def lazy_import():
+    from lakefs_client.model.garbage_collection_prefix_rule import GarbageCollectionPrefixRule
     from lakefs_client.model.garbage_collection_rule import GarbageCollectionRule
+    globals()['GarbageCollectionPrefixRule'] = GarbageCollectionPrefixRule
     globals()['GarbageCollectionRule'] = GarbageCollectionRule
 
 
@@ -90,6 +92,8 @@ class GarbageCollectionRules(ModelNormal):
         return {
             'default_retention_days': (int,),  # noqa: E501
             'branches': ([GarbageCollectionRule],),  # noqa: E501
+            'prefixes': ([GarbageCollectionPrefixRule],),  # noqa: E501
+            'pinned_tag_patterns': ([str],),  # noqa: E501
         }
 
     @cached_property
@@ -100,6 +104,8 @@ class GarbageCollectionRules(ModelNormal):
     attribute_map = {
         'default_retention_days': 'default_retention_days',  # noqa: E501
         'branches': 'branches',  # noqa: E501
+        'prefixes': 'prefixes',  # noqa: E501
+        'pinned_tag_patterns': 'pinned_tag_patterns',  # noqa: E501
     }
 
     read_only_vars = {
@@ -147,6 +153,8 @@ class GarbageCollectionRules(ModelNormal):
                                 Animal class but this time we won't travel
                                 through its discriminator because we passed in
                                 _visited_composed_classes = (Animal,)
+            prefixes ([GarbageCollectionPrefixRule]): objects are retained by the rule of the longest prefix of their path on all branches, instead of the default and branch rules. [optional]  # noqa: E501
+            pinned_tag_patterns ([str]): commits reachable from tags matching any of these patterns are never expired. [optional]  # noqa: E501
         """
 
         _check_type = kwargs.pop('_check_type', True)
@@ -234,6 +242,8 @@ class GarbageCollectionRules(ModelNormal):
                                 Animal class but this time we won't travel
                                 through its discriminator because we passed in
                                 _visited_composed_classes = (Animal,)
+            prefixes ([GarbageCollectionPrefixRule]): objects are retained by the rule of the longest prefix of their path on all branches, instead of the default and branch rules. [optional]  # noqa: E501
+            pinned_tag_patterns ([str]): commits reachable from tags matching any of these patterns are never expired. [optional]  # noqa: E501
         """
 
         _check_type = kwargs.pop('_check_type', True)
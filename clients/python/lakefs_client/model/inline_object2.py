@@ -31,7 +31,7 @@ from lakefs_client.exceptions import ApiAttributeError
 
 
 
-class InlineObject1(ModelNormal):
+class InlineObject2(ModelNormal):
     """NOTE: This class is auto generated by OpenAPI Generator.
     Ref: https://openapi-generator.tech
 
@@ -102,7 +102,7 @@ class InlineObject1(ModelNormal):
     @classmethod
     @convert_js_args_to_python_args
     def _from_openapi_data(cls, pattern, *args, **kwargs):  # noqa: E501
-        """InlineObject1 - a model defined in OpenAPI
+        """InlineObject2 - a model defined in OpenAPI
 
         Args:
             pattern (str):
@@ -187,7 +187,7 @@ class InlineObject1(ModelNormal):
 
     @convert_js_args_to_python_args
     def __init__(self, pattern, *args, **kwargs):  # noqa: E501
-        """InlineObject1 - a model defined in OpenAPI
+        """InlineObject2 - a model defined in OpenAPI
 
         Args:
             pattern (str):
@@ -30,6 +30,10 @@ from ..model_utils import OpenApiModel
 from lakefs_client.exceptions import ApiAttributeError
 
 
+def lazy_import():
+    from lakefs_client.model.merge_strategy_rule import MergeStrategyRule
+    globals()['MergeStrategyRule'] = MergeStrategyRule
+
 
 class Merge(ModelNormal):
     """NOTE: This class is auto generated by OpenAPI Generator.
@@ -56,6 +60,11 @@ class Merge(ModelNormal):
     """
 
     allowed_values = {
+        ('mode',): {
+            'FF': "ff",
+            'FF-ONLY': "ff-only",
+            'SQUASH': "squash",
+        },
     }
 
     validations = {
@@ -67,6 +76,7 @@ class Merge(ModelNormal):
         This must be a method because a model may have properties that are
         of type self, this must run after the class is loaded
         """
+        lazy_import()
         return (bool, date, datetime, dict, float, int, list, str, none_type,)  # noqa: E501
 
     _nullable = False
@@ -81,10 +91,13 @@ class Merge(ModelNormal):
             openapi_types (dict): The key is attribute name
                 and the value is attribute type.
         """
+        lazy_import()
         return {
             'message': (str,),  # noqa: E501
             'metadata': ({str: (str,)},),  # noqa: E501
             'strategy': (str,),  # noqa: E501
+            'mode': (str,),  # noqa: E501
+            'strategy_rules': ([MergeStrategyRule],),  # noqa: E501
         }
 
     @cached_property
@@ -96,6 +109,8 @@ class Merge(ModelNormal):
         'message': 'message',  # noqa: E501
         'metadata': 'metadata',  # noqa: E501
         'strategy': 'strategy',  # noqa: E501
+        'mode': 'mode',  # noqa: E501
+        'strategy_rules': 'strategy_rules',  # noqa: E501
     }
 
     read_only_vars = {
@@ -141,7 +156,9 @@ class Merge(ModelNormal):
                                 _visited_composed_classes = (Animal,)
             message (str): [optional]  # noqa: E501
             metadata ({str: (str,)}): [optional]  # noqa: E501
-            strategy (str): In case of a merge conflict, this option will force the merge process to automatically favor changes from the dest branch ('dest-wins') or from the source branch('source-wins'). In case no selection is made, the merge process will fail in case of a conflict. [optional]  # noqa: E501
+            strategy (str): In case of a merge conflict, this option will force the merge process to automatically favor changes from the dest branch ('dest-wins') or from the source branch('source-wins'). In case no selection is made, the merge process will fail in case of a conflict. A strategy given here takes precedence over the repository merge strategy rules, which are then not applied.. [optional]  # noqa: E501
+            mode (str): How the merge is recorded on the destination branch. 'ff' moves the branch to the source commit when the destination is an ancestor of the source and creates a merge commit otherwise, 'ff-only' fails unless such a fast-forward is possible, 'squash' creates a commit with the merged content and the destination as its only parent. By default a merge commit is always created.. [optional]  # noqa: E501
+            strategy_rules ([MergeStrategyRule]): Rules that select the strategy of conflicting paths, applied in order before the repository merge strategy rules. Paths that match no rule use 'strategy'. The repository merge strategy rules apply only when 'strategy' is not set.. [optional]  # noqa: E501
         """
 
         _check_type = kwargs.pop('_check_type', True)
@@ -225,7 +242,9 @@ class Merge(ModelNormal):
                                 _visited_composed_classes = (Animal,)
             message (str): [optional]  # noqa: E501
             metadata ({str: (str,)}): [optional]  # noqa: E501
-            strategy (str): In case of a merge conflict, this option will force the merge process to automatically favor changes from the dest branch ('dest-wins') or from the source branch('source-wins'). In case no selection is made, the merge process will fail in case of a conflict. [optional]  # noqa: E501
+            strategy (str): In case of a merge conflict, this option will force the merge process to automatically favor changes from the dest branch ('dest-wins') or from the source branch('source-wins'). In case no selection is made, the merge process will fail in case of a conflict. A strategy given here takes precedence over the repository merge strategy rules, which are then not applied.. [optional]  # noqa: E501
+            mode (str): How the merge is recorded on the destination branch. 'ff' moves the branch to the source commit when the destination is an ancestor of the source and creates a merge commit otherwise, 'ff-only' fails unless such a fast-forward is possible, 'squash' creates a commit with the merged content and the destination as its only parent. By default a merge commit is always created.. [optional]  # noqa: E501
+            strategy_rules ([MergeStrategyRule]): Rules that select the strategy of conflicting paths, applied in order before the repository merge strategy rules. Paths that match no rule use 'strategy'. The repository merge strategy rules apply only when 'strategy' is not set.. [optional]  # noqa: E501
         """
 
         _check_type = kwargs.pop('_check_type', True)
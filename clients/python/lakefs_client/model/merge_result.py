@@ -31,7 +31,9 @@ from lakefs_client.exceptions import ApiAttributeError
 
 
 def lazy_import():
+    from lakefs_client.model.merge_conflict import MergeConflict
     from lakefs_client.model.merge_result_summary import MergeResultSummary
+    globals()['MergeConflict'] = MergeConflict
     globals()['MergeResultSummary'] = MergeResultSummary
 
 
@@ -90,6 +92,8 @@ class MergeResult(ModelNormal):
         return {
             'summary': (MergeResultSummary,),  # noqa: E501
             'reference': (str,),  # noqa: E501
+            'conflicts': ([MergeConflict],),  # noqa: E501
+            'conflicts_truncated': (bool,),  # noqa: E501
         }
 
     @cached_property
@@ -100,6 +104,8 @@ class MergeResult(ModelNormal):
     attribute_map = {
         'summary': 'summary',  # noqa: E501
         'reference': 'reference',  # noqa: E501
+        'conflicts': 'conflicts',  # noqa: E501
+        'conflicts_truncated': 'conflicts_truncated',  # noqa: E501
     }
 
     read_only_vars = {
@@ -147,6 +153,8 @@ class MergeResult(ModelNormal):
                                 Animal class but this time we won't travel
                                 through its discriminator because we passed in
                                 _visited_composed_classes = (Animal,)
+            conflicts ([MergeConflict]): paths that could not be merged, returned when the merge failed due to conflicts. [optional]  # noqa: E501
+            conflicts_truncated (bool): true if there may be more conflicts than the ones listed. [optional]  # noqa: E501
         """
 
         _check_type = kwargs.pop('_check_type', True)
@@ -234,6 +242,8 @@ class MergeResult(ModelNormal):
                                 Animal class but this time we won't travel
                                 through its discriminator because we passed in
                                 _visited_composed_classes = (Animal,)
+            conflicts ([MergeConflict]): paths that could not be merged, returned when the merge failed due to conflicts. [optional]  # noqa: E501
+            conflicts_truncated (bool): true if there may be more conflicts than the ones listed. [optional]  # noqa: E501
         """
 
         _check_type = kwargs.pop('_check_type', True)
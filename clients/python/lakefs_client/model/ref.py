@@ -84,6 +84,8 @@ class Ref(ModelNormal):
         return {
             'id': (str,),  # noqa: E501
             'commit_id': (str,),  # noqa: E501
+            'expires_at': (int,),  # noqa: E501
+            'idle_ttl': (int,),  # noqa: E501
         }
 
     @cached_property
@@ -94,6 +96,8 @@ class Ref(ModelNormal):
     attribute_map = {
         'id': 'id',  # noqa: E501
         'commit_id': 'commit_id',  # noqa: E501
+        'expires_at': 'expires_at',  # noqa: E501
+        'idle_ttl': 'idle_ttl',  # noqa: E501
     }
 
     read_only_vars = {
@@ -141,6 +145,8 @@ class Ref(ModelNormal):
                                 Animal class but this time we won't travel
                                 through its discriminator because we passed in
                                 _visited_composed_classes = (Animal,)
+            expires_at (int): Unix Epoch in seconds at which an ephemeral branch expires. [optional]  # noqa: E501
+            idle_ttl (int): period in seconds after the latest change of its head at which an ephemeral branch expires. [optional]  # noqa: E501
         """
 
         _check_type = kwargs.pop('_check_type', True)
@@ -228,6 +234,8 @@ class Ref(ModelNormal):
                                 Animal class but this time we won't travel
                                 through its discriminator because we passed in
                                 _visited_composed_classes = (Animal,)
+            expires_at (int): Unix Epoch in seconds at which an ephemeral branch expires. [optional]  # noqa: E501
+            idle_ttl (int): period in seconds after the latest change of its head at which an ephemeral branch expires. [optional]  # noqa: E501
         """
 
         _check_type = kwargs.pop('_check_type', True)
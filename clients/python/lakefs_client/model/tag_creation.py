@@ -84,6 +84,8 @@ class TagCreation(ModelNormal):
         return {
             'id': (str,),  # noqa: E501
             'ref': (str,),  # noqa: E501
+            'message': (str,),  # noqa: E501
+            'metadata': ({str: (str,)},),  # noqa: E501
         }
 
     @cached_property
@@ -94,6 +96,8 @@ class TagCreation(ModelNormal):
     attribute_map = {
         'id': 'id',  # noqa: E501
         'ref': 'ref',  # noqa: E501
+        'message': 'message',  # noqa: E501
+        'metadata': 'metadata',  # noqa: E501
     }
 
     read_only_vars = {
@@ -141,6 +145,8 @@ class TagCreation(ModelNormal):
                                 Animal class but this time we won't travel
                                 through its discriminator because we passed in
                                 _visited_composed_classes = (Animal,)
+            message (str): create an annotated tag with this message. [optional]  # noqa: E501
+            metadata ({str: (str,)}): create an annotated tag with this metadata. [optional]  # noqa: E501
         """
 
         _check_type = kwargs.pop('_check_type', True)
@@ -228,6 +234,8 @@ class TagCreation(ModelNormal):
                                 Animal class but this time we won't travel
                                 through its discriminator because we passed in
                                 _visited_composed_classes = (Animal,)
+            message (str): create an annotated tag with this message. [optional]  # noqa: E501
+            metadata ({str: (str,)}): create an annotated tag with this metadata. [optional]  # noqa: E501
         """
 
         _check_type = kwargs.pop('_check_type', True)
@@ -13,58 +13,99 @@ from lakefs_client.model.access_key_credentials import AccessKeyCredentials
 from lakefs_client.model.action_run import ActionRun
 from lakefs_client.model.action_run_list import ActionRunList
 from lakefs_client.model.authentication_token import AuthenticationToken
+from lakefs_client.model.branch_comparison import BranchComparison
+from lakefs_client.model.branch_comparison_list import BranchComparisonList
 from lakefs_client.model.branch_creation import BranchCreation
 from lakefs_client.model.branch_protection_rule import BranchProtectionRule
+from lakefs_client.model.branch_reflog_entry import BranchReflogEntry
+from lakefs_client.model.branch_reflog_list import BranchReflogList
+from lakefs_client.model.branch_restore_creation import BranchRestoreCreation
+from lakefs_client.model.cherry_pick_creation import CherryPickCreation
 from lakefs_client.model.commit import Commit
 from lakefs_client.model.commit_creation import CommitCreation
 from lakefs_client.model.commit_list import CommitList
+from lakefs_client.model.commit_signature import CommitSignature
+from lakefs_client.model.commit_signature_creation import CommitSignatureCreation
 from lakefs_client.model.credentials import Credentials
 from lakefs_client.model.credentials_list import CredentialsList
 from lakefs_client.model.credentials_with_secret import CredentialsWithSecret
 from lakefs_client.model.current_user import CurrentUser
 from lakefs_client.model.diff import Diff
 from lakefs_client.model.diff_list import DiffList
+from lakefs_client.model.diff_summary_list import DiffSummaryList
 from lakefs_client.model.error import Error
+from lakefs_client.model.garbage_collection_prefix_rule import GarbageCollectionPrefixRule
 from lakefs_client.model.garbage_collection_prepare_request import GarbageCollectionPrepareRequest
 from lakefs_client.model.garbage_collection_prepare_response import GarbageCollectionPrepareResponse
 from lakefs_client.model.garbage_collection_rule import GarbageCollectionRule
+from lakefs_client.model.garbage_collection_rule_preview import GarbageCollectionRulePreview
 from lakefs_client.model.garbage_collection_rules import GarbageCollectionRules
 from lakefs_client.model.group import Group
 from lakefs_client.model.group_creation import GroupCreation
 from lakefs_client.model.group_list import GroupList
 from lakefs_client.model.hook_run import HookRun
 from lakefs_client.model.hook_run_list import HookRunList
-from lakefs_client.model.inline_object1 import InlineObject1
+from lakefs_client.model.import_creation import ImportCreation
+from lakefs_client.model.import_creation_response import ImportCreationResponse
+from lakefs_client.model.import_status import ImportStatus
+from lakefs_client.model.inline_object import InlineObject
+from lakefs_client.model.inline_object2 import InlineObject2
+from lakefs_client.model.legal_hold import LegalHold
+from lakefs_client.model.legal_hold_creation import LegalHoldCreation
+from lakefs_client.model.legal_hold_log_entry import LegalHoldLogEntry
 from lakefs_client.model.login_information import LoginInformation
 from lakefs_client.model.merge import Merge
+from lakefs_client.model.merge_conflict import MergeConflict
+from lakefs_client.model.merge_conflict_entry import MergeConflictEntry
+from lakefs_client.model.merge_preview import MergePreview
+from lakefs_client.model.merge_preview_summary import MergePreviewSummary
 from lakefs_client.model.merge_result import MergeResult
 from lakefs_client.model.merge_result_summary import MergeResultSummary
+from lakefs_client.model.merge_strategy_rule import MergeStrategyRule
+from lakefs_client.model.merge_strategy_rules import MergeStrategyRules
 from lakefs_client.model.object_error import ObjectError
 from lakefs_client.model.object_error_list import ObjectErrorList
 from lakefs_client.model.object_stage_creation import ObjectStageCreation
 from lakefs_client.model.object_stats import ObjectStats
 from lakefs_client.model.object_stats_list import ObjectStatsList
 from lakefs_client.model.object_user_metadata import ObjectUserMetadata
+from lakefs_client.model.object_version import ObjectVersion
+from lakefs_client.model.object_version_list import ObjectVersionList
 from lakefs_client.model.pagination import Pagination
 from lakefs_client.model.path_list import PathList
 from lakefs_client.model.policy import Policy
 from lakefs_client.model.policy_list import PolicyList
+from lakefs_client.model.prefix_diff_summary import PrefixDiffSummary
+from lakefs_client.model.rebase_creation import RebaseCreation
+from lakefs_client.model.rebase_result import RebaseResult
 from lakefs_client.model.ref import Ref
 from lakefs_client.model.ref_list import RefList
 from lakefs_client.model.refs_dump import RefsDump
 from lakefs_client.model.repository import Repository
 from lakefs_client.model.repository_creation import RepositoryCreation
 from lakefs_client.model.repository_list import RepositoryList
+from lakefs_client.model.repository_rename import RepositoryRename
 from lakefs_client.model.reset_creation import ResetCreation
 from lakefs_client.model.revert_creation import RevertCreation
 from lakefs_client.model.setup import Setup
 from lakefs_client.model.setup_state import SetupState
+from lakefs_client.model.signing_key import SigningKey
+from lakefs_client.model.signing_key_creation import SigningKeyCreation
+from lakefs_client.model.signing_key_list import SigningKeyList
 from lakefs_client.model.staging_location import StagingLocation
 from lakefs_client.model.staging_metadata import StagingMetadata
+from lakefs_client.model.stash import Stash
+from lakefs_client.model.stash_conflicts import StashConflicts
+from lakefs_client.model.stash_creation import StashCreation
+from lakefs_client.model.stash_list import StashList
 from lakefs_client.model.statement import Statement
 from lakefs_client.model.storage_config import StorageConfig
 from lakefs_client.model.storage_uri import StorageURI
+from lakefs_client.model.tag import Tag
+from lakefs_client.model.tag_annotation import TagAnnotation
 from lakefs_client.model.tag_creation import TagCreation
+from lakefs_client.model.tag_list import TagList
+from lakefs_client.model.tag_protection_rule import TagProtectionRule
 from lakefs_client.model.underlying_object_properties import UnderlyingObjectProperties
 from lakefs_client.model.user import User
 from lakefs_client.model.user_creation import UserCreation
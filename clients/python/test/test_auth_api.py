@@ -31,6 +31,13 @@ class TestAuthApi(unittest.TestCase):
         """
         pass
 
+    def test_add_signing_key(self):
+        """Test case for add_signing_key
+
+        add a signing key for verifying commit signatures of the user  # noqa: E501
+        """
+        pass
+
     def test_attach_policy_to_group(self):
         """Test case for attach_policy_to_group
 
@@ -101,6 +108,13 @@ class TestAuthApi(unittest.TestCase):
         """
         pass
 
+    def test_delete_signing_key(self):
+        """Test case for delete_signing_key
+
+        delete signing key  # noqa: E501
+        """
+        pass
+
     def test_delete_user(self):
         """Test case for delete_user
 
@@ -206,6 +220,13 @@ class TestAuthApi(unittest.TestCase):
         """
         pass
 
+    def test_list_user_signing_keys(self):
+        """Test case for list_user_signing_keys
+
+        list user signing keys  # noqa: E501
+        """
+        pass
+
     def test_list_users(self):
         """Test case for list_users
 
@@ -0,0 +1,40 @@
+"""
+    lakeFS API
+
+    lakeFS HTTP API  # noqa: E501
+
+    The version of the OpenAPI document: 0.1.0
+    Contact: services@treeverse.io
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import sys
+import unittest
+
+import lakefs_client
+from lakefs_client.model.branch_comparison import BranchComparison
+from lakefs_client.model.pagination import Pagination
+globals()['BranchComparison'] = BranchComparison
+globals()['Pagination'] = Pagination
+from lakefs_client.model.branch_comparison_list import BranchComparisonList
+
+
+class TestBranchComparisonList(unittest.TestCase):
+    """BranchComparisonList unit test stubs"""
+
+    def setUp(self):
+        pass
+
+    def tearDown(self):
+        pass
+
+    def testBranchComparisonList(self):
+        """Test BranchComparisonList"""
+        # FIXME: construct object with mandatory attributes with example values
+        # model = BranchComparisonList()  # noqa: E501
+        pass
+
+
+if __name__ == '__main__':
+    unittest.main()
@@ -0,0 +1,36 @@
+"""
+    lakeFS API
+
+    lakeFS HTTP API  # noqa: E501
+
+    The version of the OpenAPI document: 0.1.0
+    Contact: services@treeverse.io
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import sys
+import unittest
+
+import lakefs_client
+from lakefs_client.model.branch_reflog_entry import BranchReflogEntry
+
+
+class TestBranchReflogEntry(unittest.TestCase):
+    """BranchReflogEntry unit test stubs"""
+
+    def setUp(self):
+        pass
+
+    def tearDown(self):
+        pass
+
+    def testBranchReflogEntry(self):
+        """Test BranchReflogEntry"""
+        # FIXME: construct object with mandatory attributes with example values
+        # model = BranchReflogEntry()  # noqa: E501
+        pass
+
+
+if __name__ == '__main__':
+    unittest.main()
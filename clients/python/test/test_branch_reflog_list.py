@@ -0,0 +1,40 @@
+"""
+    lakeFS API
+
+    lakeFS HTTP API  # noqa: E501
+
+    The version of the OpenAPI document: 0.1.0
+    Contact: services@treeverse.io
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import sys
+import unittest
+
+import lakefs_client
+from lakefs_client.model.branch_reflog_entry import BranchReflogEntry
+from lakefs_client.model.pagination import Pagination
+globals()['BranchReflogEntry'] = BranchReflogEntry
+globals()['Pagination'] = Pagination
+from lakefs_client.model.branch_reflog_list import BranchReflogList
+
+
+class TestBranchReflogList(unittest.TestCase):
+    """BranchReflogList unit test stubs"""
+
+    def setUp(self):
+        pass
+
+    def tearDown(self):
+        pass
+
+    def testBranchReflogList(self):
+        """Test BranchReflogList"""
+        # FIXME: construct object with mandatory attributes with example values
+        # model = BranchReflogList()  # noqa: E501
+        pass
+
+
+if __name__ == '__main__':
+    unittest.main()
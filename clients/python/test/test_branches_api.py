@@ -24,6 +24,27 @@ class TestBranchesApi(unittest.TestCase):
     def tearDown(self):
         pass
 
+    def test_cherry_pick(self):
+        """Test case for cherry_pick
+
+        apply the changes of a commit on top of a branch  # noqa: E501
+        """
+        pass
+
+    def test_compare_all_branches(self):
+        """Test case for compare_all_branches
+
+        compare all branches of the repository to a base reference  # noqa: E501
+        """
+        pass
+
+    def test_compare_branches(self):
+        """Test case for compare_branches
+
+        compare a branch to a base reference  # noqa: E501
+        """
+        pass
+
     def test_create_branch(self):
         """Test case for create_branch
 
@@ -52,6 +73,13 @@ class TestBranchesApi(unittest.TestCase):
         """
         pass
 
+    def test_list_branch_reflog(self):
+        """Test case for list_branch_reflog
+
+        list the changes of the branch head, latest first  # noqa: E501
+        """
+        pass
+
     def test_list_branches(self):
         """Test case for list_branches
 
@@ -59,6 +87,20 @@ class TestBranchesApi(unittest.TestCase):
         """
         pass
 
+    def test_list_stashes(self):
+        """Test case for list_stashes
+
+        list the stashes of a branch  # noqa: E501
+        """
+        pass
+
+    def test_rebase_branch(self):
+        """Test case for rebase_branch
+
+        replay the commits of a branch on top of another ref  # noqa: E501
+        """
+        pass
+
     def test_reset_branch(self):
         """Test case for reset_branch
 
@@ -66,6 +108,13 @@ class TestBranchesApi(unittest.TestCase):
         """
         pass
 
+    def test_restore_branch(self):
+        """Test case for restore_branch
+
+        point a branch back at a previous head from its reflog  # noqa: E501
+        """
+        pass
+
     def test_revert_branch(self):
         """Test case for revert_branch
 
@@ -73,6 +122,34 @@ class TestBranchesApi(unittest.TestCase):
         """
         pass
 
+    def test_stash_apply(self):
+        """Test case for stash_apply
+
+        stage the changes of a stash on a branch  # noqa: E501
+        """
+        pass
+
+    def test_stash_drop(self):
+        """Test case for stash_drop
+
+        delete a stash and its changes  # noqa: E501
+        """
+        pass
+
+    def test_stash_pop(self):
+        """Test case for stash_pop
+
+        stage the changes of a stash on a branch and delete the stash  # noqa: E501
+        """
+        pass
+
+    def test_stash_push(self):
+        """Test case for stash_push
+
+        move the uncommitted changes of a branch aside  # noqa: E501
+        """
+        pass
+
 
 if __name__ == '__main__':
     unittest.main()
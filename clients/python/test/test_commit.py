@@ -13,6 +13,8 @@ import sys
 import unittest
 
 import lakefs_client
+from lakefs_client.model.commit_signature import CommitSignature
+globals()['CommitSignature'] = CommitSignature
 from lakefs_client.model.commit import Commit
 
 
@@ -45,6 +45,20 @@ class TestCommitsApi(unittest.TestCase):
         """
         pass
 
+    def test_search_commits(self):
+        """Test case for search_commits
+
+        search commits of the repository by metadata, committer, message and creation date, latest first  # noqa: E501
+        """
+        pass
+
+    def test_sign_commit(self):
+        """Test case for sign_commit
+
+        store a signature of the commit made by a signing key of its committer  # noqa: E501
+        """
+        pass
+
 
 if __name__ == '__main__':
     unittest.main()
@@ -0,0 +1,38 @@
+"""
+    lakeFS API
+
+    lakeFS HTTP API  # noqa: E501
+
+    The version of the OpenAPI document: 0.1.0
+    Contact: services@treeverse.io
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import sys
+import unittest
+
+import lakefs_client
+from lakefs_client.model.prefix_diff_summary import PrefixDiffSummary
+globals()['PrefixDiffSummary'] = PrefixDiffSummary
+from lakefs_client.model.diff_summary_list import DiffSummaryList
+
+
+class TestDiffSummaryList(unittest.TestCase):
+    """DiffSummaryList unit test stubs"""
+
+    def setUp(self):
+        pass
+
+    def tearDown(self):
+        pass
+
+    def testDiffSummaryList(self):
+        """Test DiffSummaryList"""
+        # FIXME: construct object with mandatory attributes with example values
+        # model = DiffSummaryList()  # noqa: E501
+        pass
+
+
+if __name__ == '__main__':
+    unittest.main()
@@ -13,7 +13,9 @@ import sys
 import unittest
 
 import lakefs_client
+from lakefs_client.model.garbage_collection_prefix_rule import GarbageCollectionPrefixRule
 from lakefs_client.model.garbage_collection_rule import GarbageCollectionRule
+globals()['GarbageCollectionPrefixRule'] = GarbageCollectionPrefixRule
 globals()['GarbageCollectionRule'] = GarbageCollectionRule
 from lakefs_client.model.garbage_collection_rules import GarbageCollectionRules
 
@@ -0,0 +1,50 @@
+"""
+    lakeFS API
+
+    lakeFS HTTP API  # noqa: E501
+
+    The version of the OpenAPI document: 0.1.0
+    Contact: services@treeverse.io
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import unittest
+
+import lakefs_client
+from lakefs_client.api.import_api import ImportApi  # noqa: E501
+
+
+class TestImportApi(unittest.TestCase):
+    """ImportApi unit test stubs"""
+
+    def setUp(self):
+        self.api = ImportApi()  # noqa: E501
+
+    def tearDown(self):
+        pass
+
+    def test_cancel_import(self):
+        """Test case for cancel_import
+
+        cancel an import that did not complete  # noqa: E501
+        """
+        pass
+
+    def test_get_import_status(self):
+        """Test case for get_import_status
+
+        get the status of an import  # noqa: E501
+        """
+        pass
+
+    def test_start_import(self):
+        """Test case for start_import
+
+        import the objects under an object store prefix to a branch  # noqa: E501
+        """
+        pass
+
+
+if __name__ == '__main__':
+    unittest.main()
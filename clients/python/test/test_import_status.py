@@ -13,11 +13,11 @@ import sys
 import unittest
 
 import lakefs_client
-from lakefs_client.model.inline_object1 import InlineObject1
+from lakefs_client.model.import_status import ImportStatus
 
 
-class TestInlineObject1(unittest.TestCase):
-    """InlineObject1 unit test stubs"""
+class TestImportStatus(unittest.TestCase):
+    """ImportStatus unit test stubs"""
 
     def setUp(self):
         pass
@@ -25,10 +25,10 @@ class TestInlineObject1(unittest.TestCase):
     def tearDown(self):
         pass
 
-    def testInlineObject1(self):
-        """Test InlineObject1"""
+    def testImportStatus(self):
+        """Test ImportStatus"""
         # FIXME: construct object with mandatory attributes with example values
-        # model = InlineObject1()  # noqa: E501
+        # model = ImportStatus()  # noqa: E501
         pass
 
 
@@ -13,6 +13,8 @@ import sys
 import unittest
 
 import lakefs_client
+from lakefs_client.model.merge_strategy_rule import MergeStrategyRule
+globals()['MergeStrategyRule'] = MergeStrategyRule
 from lakefs_client.model.merge import Merge
 
 
@@ -0,0 +1,38 @@
+"""
+    lakeFS API
+
+    lakeFS HTTP API  # noqa: E501
+
+    The version of the OpenAPI document: 0.1.0
+    Contact: services@treeverse.io
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import sys
+import unittest
+
+import lakefs_client
+from lakefs_client.model.merge_conflict_entry import MergeConflictEntry
+globals()['MergeConflictEntry'] = MergeConflictEntry
+from lakefs_client.model.merge_conflict import MergeConflict
+
+
+class TestMergeConflict(unittest.TestCase):
+    """MergeConflict unit test stubs"""
+
+    def setUp(self):
+        pass
+
+    def tearDown(self):
+        pass
+
+    def testMergeConflict(self):
+        """Test MergeConflict"""
+        # FIXME: construct object with mandatory attributes with example values
+        # model = MergeConflict()  # noqa: E501
+        pass
+
+
+if __name__ == '__main__':
+    unittest.main()
@@ -0,0 +1,40 @@
+"""
+    lakeFS API
+
+    lakeFS HTTP API  # noqa: E501
+
+    The version of the OpenAPI document: 0.1.0
+    Contact: services@treeverse.io
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import sys
+import unittest
+
+import lakefs_client
+from lakefs_client.model.merge_conflict import MergeConflict
+from lakefs_client.model.merge_preview_summary import MergePreviewSummary
+globals()['MergeConflict'] = MergeConflict
+globals()['MergePreviewSummary'] = MergePreviewSummary
+from lakefs_client.model.merge_preview import MergePreview
+
+
+class TestMergePreview(unittest.TestCase):
+    """MergePreview unit test stubs"""
+
+    def setUp(self):
+        pass
+
+    def tearDown(self):
+        pass
+
+    def testMergePreview(self):
+        """Test MergePreview"""
+        # FIXME: construct object with mandatory attributes with example values
+        # model = MergePreview()  # noqa: E501
+        pass
+
+
+if __name__ == '__main__':
+    unittest.main()
@@ -13,7 +13,9 @@ import sys
 import unittest
 
 import lakefs_client
+from lakefs_client.model.merge_conflict import MergeConflict
 from lakefs_client.model.merge_result_summary import MergeResultSummary
+globals()['MergeConflict'] = MergeConflict
 globals()['MergeResultSummary'] = MergeResultSummary
 from lakefs_client.model.merge_result import MergeResult
 
@@ -0,0 +1,38 @@
+"""
+    lakeFS API
+
+    lakeFS HTTP API  # noqa: E501
+
+    The version of the OpenAPI document: 0.1.0
+    Contact: services@treeverse.io
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import sys
+import unittest
+
+import lakefs_client
+from lakefs_client.model.merge_strategy_rule import MergeStrategyRule
+globals()['MergeStrategyRule'] = MergeStrategyRule
+from lakefs_client.model.merge_strategy_rules import MergeStrategyRules
+
+
+class TestMergeStrategyRules(unittest.TestCase):
+    """MergeStrategyRules unit test stubs"""
+
+    def setUp(self):
+        pass
+
+    def tearDown(self):
+        pass
+
+    def testMergeStrategyRules(self):
+        """Test MergeStrategyRules"""
+        # FIXME: construct object with mandatory attributes with example values
+        # model = MergeStrategyRules()  # noqa: E501
+        pass
+
+
+if __name__ == '__main__':
+    unittest.main()
@@ -0,0 +1,40 @@
+"""
+    lakeFS API
+
+    lakeFS HTTP API  # noqa: E501
+
+    The version of the OpenAPI document: 0.1.0
+    Contact: services@treeverse.io
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import sys
+import unittest
+
+import lakefs_client
+from lakefs_client.model.object_version import ObjectVersion
+from lakefs_client.model.pagination import Pagination
+globals()['ObjectVersion'] = ObjectVersion
+globals()['Pagination'] = Pagination
+from lakefs_client.model.object_version_list import ObjectVersionList
+
+
+class TestObjectVersionList(unittest.TestCase):
+    """ObjectVersionList unit test stubs"""
+
+    def setUp(self):
+        pass
+
+    def tearDown(self):
+        pass
+
+    def testObjectVersionList(self):
+        """Test ObjectVersionList"""
+        # FIXME: construct object with mandatory attributes with example values
+        # model = ObjectVersionList()  # noqa: E501
+        pass
+
+
+if __name__ == '__main__':
+    unittest.main()
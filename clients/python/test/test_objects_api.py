@@ -59,6 +59,13 @@ class TestObjectsApi(unittest.TestCase):
         """
         pass
 
+    def test_object_history(self):
+        """Test case for object_history
+
+        list the versions of an object, latest first  # noqa: E501
+        """
+        pass
+
     def test_stage_object(self):
         """Test case for stage_object
 
@@ -0,0 +1,38 @@
+"""
+    lakeFS API
+
+    lakeFS HTTP API  # noqa: E501
+
+    The version of the OpenAPI document: 0.1.0
+    Contact: services@treeverse.io
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import sys
+import unittest
+
+import lakefs_client
+from lakefs_client.model.merge_conflict import MergeConflict
+globals()['MergeConflict'] = MergeConflict
+from lakefs_client.model.rebase_result import RebaseResult
+
+
+class TestRebaseResult(unittest.TestCase):
+    """RebaseResult unit test stubs"""
+
+    def setUp(self):
+        pass
+
+    def tearDown(self):
+        pass
+
+    def testRebaseResult(self):
+        """Test RebaseResult"""
+        # FIXME: construct object with mandatory attributes with example values
+        # model = RebaseResult()  # noqa: E501
+        pass
+
+
+if __name__ == '__main__':
+    unittest.main()
@@ -31,6 +31,13 @@ class TestRefsApi(unittest.TestCase):
         """
         pass
 
+    def test_diff_summary(self):
+        """Test case for diff_summary
+
+        count the changes between references by prefix  # noqa: E501
+        """
+        pass
+
     def test_dump_refs(self):
         """Test case for dump_refs
 
@@ -52,6 +59,13 @@ class TestRefsApi(unittest.TestCase):
         """
         pass
 
+    def test_merge_preview(self):
+        """Test case for merge_preview
+
+        preview the outcome of merging references, without merging  # noqa: E501
+        """
+        pass
+
     def test_restore_refs(self):
         """Test case for restore_refs
 
@@ -37,6 +37,12 @@ class TestRepositoriesApi(unittest.TestCase):
         """
         pass
 
+    def test_create_tag_protection_rule(self):
+        """Test case for create_tag_protection_rule
+
+        """
+        pass
+
     def test_delete_branch_protection_rule(self):
         """Test case for delete_branch_protection_rule
 
@@ -50,6 +56,12 @@ class TestRepositoriesApi(unittest.TestCase):
         """
         pass
 
+    def test_delete_tag_protection_rule(self):
+        """Test case for delete_tag_protection_rule
+
+        """
+        pass
+
     def test_get_branch_protection_rules(self):
         """Test case for get_branch_protection_rules
 
@@ -57,6 +69,13 @@ class TestRepositoriesApi(unittest.TestCase):
         """
         pass
 
+    def test_get_merge_strategy_rules(self):
+        """Test case for get_merge_strategy_rules
+
+        get the merge strategy rules applied by default to merges in the repository  # noqa: E501
+        """
+        pass
+
     def test_get_repository(self):
         """Test case for get_repository
 
@@ -64,6 +83,13 @@ class TestRepositoriesApi(unittest.TestCase):
         """
         pass
 
+    def test_get_tag_protection_rules(self):
+        """Test case for get_tag_protection_rules
+
+        get tag protection rules  # noqa: E501
+        """
+        pass
+
     def test_list_repositories(self):
         """Test case for list_repositories
 
@@ -71,6 +97,27 @@ class TestRepositoriesApi(unittest.TestCase):
         """
         pass
 
+    def test_rename_repository(self):
+        """Test case for rename_repository
+
+        rename repository  # noqa: E501
+        """
+        pass
+
+    def test_restore_repository(self):
+        """Test case for restore_repository
+
+        restore a deleted repository that was not purged yet  # noqa: E501
+        """
+        pass
+
+    def test_set_merge_strategy_rules(self):
+        """Test case for set_merge_strategy_rules
+
+        replace the merge strategy rules applied by default to merges in the repository  # noqa: E501
+        """
+        pass
+
 
 if __name__ == '__main__':
     unittest.main()
@@ -24,12 +24,40 @@ class TestRetentionApi(unittest.TestCase):
     def tearDown(self):
         pass
 
+    def test_create_legal_hold(self):
+        """Test case for create_legal_hold
+
+        place a legal hold on a commit or on paths in it  # noqa: E501
+        """
+        pass
+
     def test_get_garbage_collection_rules(self):
         """Test case for get_garbage_collection_rules
 
         """
         pass
 
+    def test_get_legal_hold(self):
+        """Test case for get_legal_hold
+
+        get legal hold  # noqa: E501
+        """
+        pass
+
+    def test_list_legal_hold_log(self):
+        """Test case for list_legal_hold_log
+
+        list the legal holds placed and released, latest first  # noqa: E501
+        """
+        pass
+
+    def test_list_legal_holds(self):
+        """Test case for list_legal_holds
+
+        list legal holds  # noqa: E501
+        """
+        pass
+
     def test_prepare_garbage_collection_commits(self):
         """Test case for prepare_garbage_collection_commits
 
@@ -37,6 +65,20 @@ class TestRetentionApi(unittest.TestCase):
         """
         pass
 
+    def test_preview_garbage_collection_rules(self):
+        """Test case for preview_garbage_collection_rules
+
+        estimate the number of objects garbage collection rules would expire  # noqa: E501
+        """
+        pass
+
+    def test_release_legal_hold(self):
+        """Test case for release_legal_hold
+
+        release legal hold  # noqa: E501
+        """
+        pass
+
     def test_set_garbage_collection_rules(self):
         """Test case for set_garbage_collection_rules
 
@@ -0,0 +1,40 @@
+"""
+    lakeFS API
+
+    lakeFS HTTP API  # noqa: E501
+
+    The version of the OpenAPI document: 0.1.0
+    Contact: services@treeverse.io
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import sys
+import unittest
+
+import lakefs_client
+from lakefs_client.model.pagination import Pagination
+from lakefs_client.model.signing_key import SigningKey
+globals()['Pagination'] = Pagination
+globals()['SigningKey'] = SigningKey
+from lakefs_client.model.signing_key_list import SigningKeyList
+
+
+class TestSigningKeyList(unittest.TestCase):
+    """SigningKeyList unit test stubs"""
+
+    def setUp(self):
+        pass
+
+    def tearDown(self):
+        pass
+
+    def testSigningKeyList(self):
+        """Test SigningKeyList"""
+        # FIXME: construct object with mandatory attributes with example values
+        # model = SigningKeyList()  # noqa: E501
+        pass
+
+
+if __name__ == '__main__':
+    unittest.main()
@@ -0,0 +1,36 @@
+"""
+    lakeFS API
+
+    lakeFS HTTP API  # noqa: E501
+
+    The version of the OpenAPI document: 0.1.0
+    Contact: services@treeverse.io
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import sys
+import unittest
+
+import lakefs_client
+from lakefs_client.model.stash import Stash
+
+
+class TestStash(unittest.TestCase):
+    """Stash unit test stubs"""
+
+    def setUp(self):
+        pass
+
+    def tearDown(self):
+        pass
+
+    def testStash(self):
+        """Test Stash"""
+        # FIXME: construct object with mandatory attributes with example values
+        # model = Stash()  # noqa: E501
+        pass
+
+
+if __name__ == '__main__':
+    unittest.main()
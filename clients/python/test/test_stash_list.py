@@ -0,0 +1,40 @@
+"""
+    lakeFS API
+
+    lakeFS HTTP API  # noqa: E501
+
+    The version of the OpenAPI document: 0.1.0
+    Contact: services@treeverse.io
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import sys
+import unittest
+
+import lakefs_client
+from lakefs_client.model.pagination import Pagination
+from lakefs_client.model.stash import Stash
+globals()['Pagination'] = Pagination
+globals()['Stash'] = Stash
+from lakefs_client.model.stash_list import StashList
+
+
+class TestStashList(unittest.TestCase):
+    """StashList unit test stubs"""
+
+    def setUp(self):
+        pass
+
+    def tearDown(self):
+        pass
+
+    def testStashList(self):
+        """Test StashList"""
+        # FIXME: construct object with mandatory attributes with example values
+        # model = StashList()  # noqa: E501
+        pass
+
+
+if __name__ == '__main__':
+    unittest.main()
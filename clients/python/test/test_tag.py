@@ -0,0 +1,38 @@
+"""
+    lakeFS API
+
+    lakeFS HTTP API  # noqa: E501
+
+    The version of the OpenAPI document: 0.1.0
+    Contact: services@treeverse.io
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import sys
+import unittest
+
+import lakefs_client
+from lakefs_client.model.tag_annotation import TagAnnotation
+globals()['TagAnnotation'] = TagAnnotation
+from lakefs_client.model.tag import Tag
+
+
+class TestTag(unittest.TestCase):
+    """Tag unit test stubs"""
+
+    def setUp(self):
+        pass
+
+    def tearDown(self):
+        pass
+
+    def testTag(self):
+        """Test Tag"""
+        # FIXME: construct object with mandatory attributes with example values
+        # model = Tag()  # noqa: E501
+        pass
+
+
+if __name__ == '__main__':
+    unittest.main()
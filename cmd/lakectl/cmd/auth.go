@@ -29,6 +29,12 @@ var credentialsCreatedTemplate = `{{ "Credentials created successfully." | green
 {{ "Keep these somewhere safe since you will not be able to see the secret key again" | yellow }}
 `
 
+var signingKeyAddedTemplate = `{{ "Signing key added successfully." | green }}
+Key ID: {{ .KeyId | bold }}
+Key Type: {{ .KeyType }}
+Creation Date: {{ .CreationDate | date }}
+`
+
 var policyDetailsTemplate = `
 ID: {{ .ID | bold }}
 Creation Date: {{  .CreationDate | date }}
@@ -284,6 +290,96 @@ var authUsersCredentialsList = &cobra.Command{
 	},
 }
 
+var authUsersSigningKeys = &cobra.Command{
+	Use:   "signing-keys",
+	Short: "Manage user signing keys used to verify commit signatures",
+}
+
+var authUsersSigningKeysAdd = &cobra.Command{
+	Use:   "add",
+	Short: "Add a user signing key",
+	Example: `lakectl auth users signing-keys add --public-key-file ~/.ssh/id_ed25519.pub
+lakectl auth users signing-keys add --id <user> --type ed25519 --public-key-file key.b64`,
+	Run: func(cmd *cobra.Command, args []string) {
+		id, _ := cmd.Flags().GetString("id")
+		keyType, _ := cmd.Flags().GetString("type")
+		publicKeyFile, _ := cmd.Flags().GetString("public-key-file")
+		publicKey, err := os.ReadFile(publicKeyFile)
+		if err != nil {
+			DieErr(err)
+		}
+		clt := getClient()
+
+		if id == "" {
+			resp, err := clt.GetCurrentUserWithResponse(cmd.Context())
+			DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusOK)
+			id = resp.JSON200.User.Id
+		}
+
+		resp, err := clt.AddSigningKeyWithResponse(cmd.Context(), id, api.AddSigningKeyJSONRequestBody{
+			KeyType:   keyType,
+			PublicKey: string(publicKey),
+		})
+		DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusCreated)
+
+		Write(signingKeyAddedTemplate, resp.JSON201)
+	},
+}
+
+var authUsersSigningKeysDelete = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete a user signing key",
+	Run: func(cmd *cobra.Command, args []string) {
+		id, _ := cmd.Flags().GetString("id")
+		keyID, _ := cmd.Flags().GetString("key-id")
+		clt := getClient()
+
+		if id == "" {
+			resp, err := clt.GetCurrentUserWithResponse(cmd.Context())
+			DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusOK)
+			id = resp.JSON200.User.Id
+		}
+		resp, err := clt.DeleteSigningKeyWithResponse(cmd.Context(), id, api.DeleteSigningKeyJSONRequestBody{
+			KeyId: keyID,
+		})
+		DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusNoContent)
+
+		Fmt("Signing key deleted successfully\n")
+	},
+}
+
+var authUsersSigningKeysList = &cobra.Command{
+	Use:   "list",
+	Short: "List user signing keys",
+	Run: func(cmd *cobra.Command, args []string) {
+		amount, _ := cmd.Flags().GetInt("amount")
+		after, _ := cmd.Flags().GetString("after")
+		id, _ := cmd.Flags().GetString("id")
+
+		clt := getClient()
+		if id == "" {
+			resp, err := clt.GetCurrentUserWithResponse(cmd.Context())
+			DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusOK)
+			id = resp.JSON200.User.Id
+		}
+
+		resp, err := clt.ListUserSigningKeysWithResponse(cmd.Context(), id, &api.ListUserSigningKeysParams{
+			After:  api.PaginationAfterPtr(after),
+			Amount: api.PaginationAmountPtr(amount),
+		})
+		DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusOK)
+
+		signingKeys := resp.JSON200.Results
+		rows := make([][]interface{}, len(signingKeys))
+		for i, k := range signingKeys {
+			ts := time.Unix(k.CreationDate, 0).String()
+			rows[i] = []interface{}{k.KeyId, k.KeyType, ts}
+		}
+		pagination := resp.JSON200.Pagination
+		PrintTable(rows, []interface{}{"Key ID", "Key Type", "Creation Date"}, &pagination, amount)
+	},
+}
+
 // groups
 var authGroups = &cobra.Command{
 	Use:   "groups",
@@ -645,12 +741,29 @@ func init() {
 	authUsersCredentials.AddCommand(authUsersCredentialsCreate)
 	authUsersCredentials.AddCommand(authUsersCredentialsDelete)
 
+	authUsersSigningKeysList.Flags().String("id", "", "user identifier (default: current user)")
+	addPaginationFlags(authUsersSigningKeysList)
+
+	authUsersSigningKeysAdd.Flags().String("id", "", "user identifier (default: current user)")
+	authUsersSigningKeysAdd.Flags().String("type", "ssh", "public key type: \"ssh\" for an SSH public key in authorized_keys format, or \"ed25519\" for a base64 encoded ed25519 public key")
+	authUsersSigningKeysAdd.Flags().String("public-key-file", "", "file containing the public key")
+	_ = authUsersSigningKeysAdd.MarkFlagRequired("public-key-file")
+
+	authUsersSigningKeysDelete.Flags().String("id", "", "user identifier (default: current user)")
+	authUsersSigningKeysDelete.Flags().String("key-id", "", "ID (SHA256 fingerprint) of the signing key to delete")
+	_ = authUsersSigningKeysDelete.MarkFlagRequired("key-id")
+
+	authUsersSigningKeys.AddCommand(authUsersSigningKeysList)
+	authUsersSigningKeys.AddCommand(authUsersSigningKeysAdd)
+	authUsersSigningKeys.AddCommand(authUsersSigningKeysDelete)
+
 	authUsers.AddCommand(authUsersCreate)
 	authUsers.AddCommand(authUsersDelete)
 	authUsers.AddCommand(authUsersList)
 	authUsers.AddCommand(authUsersPolicies)
 	authUsers.AddCommand(authUsersGroups)
 	authUsers.AddCommand(authUsersCredentials)
+	authUsers.AddCommand(authUsersSigningKeys)
 
 	authCmd.AddCommand(authUsers)
 
@@ -3,7 +3,10 @@ package cmd
 import (
 	"fmt"
 	"net/http"
+	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/treeverse/lakefs/pkg/api"
@@ -13,9 +16,14 @@ import (
 const (
 	ParentNumberFlagName = "parent-number"
 
-	branchRevertCmdArgs = 2
+	branchRevertCmdArgs     = 2
+	branchCherryPickCmdArgs = 2
+	branchRebaseCmdArgs     = 2
 )
 
+// reBranchReflogRef matches a branch reflog entry given as <branch>@{<index>}
+var reBranchReflogRef = regexp.MustCompile(`^(.+)@\{(\d+)\}$`)
+
 // branchCmd represents the branch command
 var branchCmd = &cobra.Command{
 	Use:   "branch",
@@ -24,15 +32,36 @@ var branchCmd = &cobra.Command{
 }
 
 var branchListCmd = &cobra.Command{
-	Use:     "list <repository uri>",
-	Short:   "List branches in a repository",
-	Example: "lakectl branch list lakefs://<repository>",
-	Args:    cobra.ExactArgs(1),
+	Use:   "list <repository uri>",
+	Short: "List branches in a repository",
+	Long: `List branches in a repository.
+With --compare-to, also show how many commits each branch is ahead of and behind the given ref, and how many
+objects its commits added, removed and changed since their merge base.`,
+	Example: `lakectl branch list lakefs://<repository>
+lakectl branch list lakefs://<repository> --compare-to main`,
+	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		amount := MustInt(cmd.Flags().GetInt("amount"))
 		after := MustString(cmd.Flags().GetString("after"))
+		compareTo := MustString(cmd.Flags().GetString("compare-to"))
 		u := MustParseRepoURI("repository", args[0])
 		client := getClient()
+		if compareTo != "" {
+			resp, err := client.CompareAllBranchesWithResponse(cmd.Context(), u.Repository, compareTo, &api.CompareAllBranchesParams{
+				After:  api.PaginationAfterPtr(after),
+				Amount: api.PaginationAmountPtr(amount),
+			})
+			DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusOK)
+
+			comparisons := resp.JSON200.Results
+			rows := make([][]interface{}, len(comparisons))
+			for i, row := range comparisons {
+				rows[i] = []interface{}{row.Branch, row.CommitId, row.Ahead, row.Behind, row.Added, row.Removed, row.Changed}
+			}
+			pagination := resp.JSON200.Pagination
+			PrintTable(rows, []interface{}{"Branch", "Commit ID", "Ahead", "Behind", "Added", "Removed", "Changed"}, &pagination, amount)
+			return
+		}
 		resp, err := client.ListBranchesWithResponse(cmd.Context(), u.Repository, &api.ListBranchesParams{
 			After:  api.PaginationAfterPtr(after),
 			Amount: api.PaginationAmountPtr(amount),
@@ -66,11 +95,29 @@ var branchCreateCmd = &cobra.Command{
 		if sourceURI.Repository != u.Repository {
 			Die("source branch must be in the same repository", 1)
 		}
-
-		resp, err := client.CreateBranchWithResponse(cmd.Context(), u.Repository, api.CreateBranchJSONRequestBody{
+		body := api.CreateBranchJSONRequestBody{
 			Name:   u.Ref,
 			Source: sourceURI.Ref,
-		})
+		}
+		if expiresAt := MustString(cmd.Flags().GetString("expires-at")); expiresAt != "" {
+			t, err := time.Parse(time.RFC3339, expiresAt)
+			if err != nil {
+				DieFmt("failed to parse expiry time: %s", err)
+			}
+			body.ExpiresAt = api.Int64Ptr(t.Unix())
+		}
+		idleTTL, err := cmd.Flags().GetDuration("idle-ttl")
+		if err != nil {
+			DieErr(err)
+		}
+		if idleTTL < 0 {
+			Die("idle TTL must be positive", 1)
+		}
+		if idleTTL > 0 {
+			body.IdleTtl = api.Int64Ptr(int64(idleTTL / time.Second))
+		}
+
+		resp, err := client.CreateBranchWithResponse(cmd.Context(), u.Repository, body)
 		DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusCreated)
 		Fmt("created branch '%s' %s\n", u.Ref, string(resp.Body))
 	},
@@ -129,6 +176,129 @@ var branchRevertCmd = &cobra.Command{
 	},
 }
 
+// lakectl branch cherry-pick lakefs://myrepo/main commitId
+var branchCherryPickCmd = &cobra.Command{
+	Use:   "cherry-pick <branch uri> <commit ref to cherry-pick> [<more commits>...]",
+	Short: "Apply the changes introduced by existing commits on top of a branch",
+	Long:  "The commits will be applied in left-to-right order, each as a new commit on the branch",
+	Example: `lakectl branch cherry-pick lakefs://example-repo/main commitA
+	          Apply the changes done by commitA on top of main
+		      branch cherry-pick lakefs://example-repo/main feature~2 feature~1 feature
+		      Apply the changes done by the last three commits of feature on top of main`,
+	Args: cobra.MinimumNArgs(branchCherryPickCmdArgs),
+	Run: func(cmd *cobra.Command, args []string) {
+		u := MustParseRefURI("branch", args[0])
+		Fmt("Branch: %s\n", u.String())
+		hasParentNumber := cmd.Flags().Changed(ParentNumberFlagName)
+		parentNumber, _ := cmd.Flags().GetInt(ParentNumberFlagName)
+		if hasParentNumber && parentNumber <= 0 {
+			Die("parent number must be non-negative, if specified", 1)
+		}
+		clt := getClient()
+		for i := 1; i < len(args); i++ {
+			commitRef := args[i]
+			body := api.CherryPickJSONRequestBody{
+				Ref: commitRef,
+			}
+			if hasParentNumber {
+				body.ParentNumber = &parentNumber
+			}
+			resp, err := clt.CherryPickWithResponse(cmd.Context(), u.Repository, u.Ref, body)
+			if resp != nil && resp.JSON409 != nil {
+				DieFmt("Conflict found while cherry-picking %s: %s", commitRef, resp.JSON409.Message)
+			}
+			DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusCreated)
+			Fmt("commit %s successfully cherry-picked as %s\n", commitRef, resp.JSON201.Id)
+		}
+	},
+}
+
+// lakectl branch rebase lakefs://myrepo/feature main
+var branchRebaseCmd = &cobra.Command{
+	Use:   "rebase <branch uri> <onto ref>",
+	Short: "Replay the commits of a branch on top of another ref",
+	Long: `Replay, in order, the commits of the branch that are not reachable from the given ref on top of it, producing linear history.
+Merge commits are not replayed. The branch must have no uncommitted changes.
+The rebase stops on the first conflicting commit, leaving the branch unchanged.`,
+	Example: "lakectl branch rebase lakefs://example-repo/feature main",
+	Args:    cobra.ExactArgs(branchRebaseCmdArgs),
+	Run: func(cmd *cobra.Command, args []string) {
+		u := MustParseRefURI("branch", args[0])
+		onto := args[1]
+		Fmt("Branch: %s\nOnto: %s\n", u.String(), onto)
+		clt := getClient()
+		resp, err := clt.RebaseBranchWithResponse(cmd.Context(), u.Repository, u.Ref, api.RebaseBranchJSONRequestBody{
+			Onto: onto,
+		})
+		if resp != nil && resp.JSON409 != nil {
+			result := resp.JSON409
+			if result.Conflicts != nil {
+				printMergeConflicts(*result.Conflicts, result.ConflictsTruncated != nil && *result.ConflictsTruncated)
+			}
+			DieFmt("Conflict found while replaying commit %s", api.StringValue(result.ConflictCommitId))
+		}
+		DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusOK)
+		Fmt("Branch %s rebased on %s, now at %s\n", u.Ref, onto, resp.JSON200.Reference)
+	},
+}
+
+var branchReflogCmd = &cobra.Command{
+	Use:   "reflog <branch uri>",
+	Short: "List the changes of the branch head, latest first",
+	Long: `List the changes of the branch head made by creating, committing to, merging into, resetting, restoring and deleting the branch, latest first.
+Entry n is shown as <branch>@{n}, a ref to the branch head after that change. The reflog of a deleted branch is kept.`,
+	Example: "lakectl branch reflog lakefs://example-repo/feature",
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		amount := MustInt(cmd.Flags().GetInt("amount"))
+		after := MustString(cmd.Flags().GetString("after"))
+		u := MustParseRefURI("branch", args[0])
+		client := getClient()
+		resp, err := client.ListBranchReflogWithResponse(cmd.Context(), u.Repository, u.Ref, &api.ListBranchReflogParams{
+			After:  api.PaginationAfterPtr(after),
+			Amount: api.PaginationAmountPtr(amount),
+		})
+		DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusOK)
+
+		entries := resp.JSON200.Results
+		rows := make([][]interface{}, len(entries))
+		for i, entry := range entries {
+			ts := time.Unix(entry.CreationDate, 0).String()
+			rows[i] = []interface{}{fmt.Sprintf("%s@{%d}", u.Ref, entry.Index), entry.Operation, entry.OldCommitId, entry.NewCommitId, entry.User, ts}
+		}
+
+		pagination := resp.JSON200.Pagination
+		PrintTable(rows, []interface{}{"Ref", "Operation", "Old Commit ID", "New Commit ID", "User", "Creation Date"}, &pagination, amount)
+	},
+}
+
+var branchRestoreCmd = &cobra.Command{
+	Use:   "restore <branch uri>@{<n>}",
+	Short: "Point a branch back at its head after a reflog entry",
+	Long: `Point the branch back at the commit it pointed to after entry n of its reflog, as listed by 'lakectl branch reflog'.
+A deleted branch is recreated. An existing branch must have no uncommitted changes.`,
+	Example: "lakectl branch restore lakefs://example-repo/feature@{1}",
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		u := MustParseRefURI("branch reflog entry", args[0])
+		match := reBranchReflogRef.FindStringSubmatch(u.Ref)
+		if match == nil {
+			DieFmt("Invalid branch reflog entry '%s'. Expected <branch>@{<n>}", u.Ref)
+		}
+		branch := match[1]
+		index, err := strconv.Atoi(match[2])
+		if err != nil {
+			DieFmt("Invalid branch reflog entry index '%s': %s", match[2], err)
+		}
+		clt := getClient()
+		resp, err := clt.RestoreBranchWithResponse(cmd.Context(), u.Repository, branch, api.RestoreBranchJSONRequestBody{
+			Index: index,
+		})
+		DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusOK)
+		Fmt("Branch %s restored to %s\n", branch, resp.JSON200.CommitId)
+	},
+}
+
 // lakectl branch reset lakefs://myrepo/main --commit commitId --prefix path --object path
 var branchResetCmd = &cobra.Command{
 	Use:   "reset <branch uri> [flags]",
@@ -194,7 +364,13 @@ var branchShowCmd = &cobra.Command{
 		resp, err := client.GetBranchWithResponse(cmd.Context(), u.Repository, u.Ref)
 		DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusOK)
 		branch := resp.JSON200
-		Fmt("%s\n", branch)
+		Fmt("Commit ID: %s\n", branch.CommitId)
+		if branch.ExpiresAt != nil {
+			Fmt("Expires At: %s\n", time.Unix(*branch.ExpiresAt, 0).Format(time.RFC3339))
+		}
+		if branch.IdleTtl != nil {
+			Fmt("Idle TTL: %s\n", time.Duration(*branch.IdleTtl)*time.Second)
+		}
 	},
 }
 
@@ -207,18 +383,30 @@ func init() {
 	branchCmd.AddCommand(branchShowCmd)
 	branchCmd.AddCommand(branchResetCmd)
 	branchCmd.AddCommand(branchRevertCmd)
+	branchCmd.AddCommand(branchCherryPickCmd)
+	branchCmd.AddCommand(branchRebaseCmd)
+	branchCmd.AddCommand(branchReflogCmd)
+	branchCmd.AddCommand(branchRestoreCmd)
 
 	branchListCmd.Flags().Int("amount", defaultAmountArgumentValue, "number of results to return")
 	branchListCmd.Flags().String("after", "", "show results after this value (used for pagination)")
+	branchListCmd.Flags().String("compare-to", "", "compare each branch to this ref (branch, tag or commit ID)")
+
+	branchReflogCmd.Flags().Int("amount", defaultAmountArgumentValue, "number of results to return")
+	branchReflogCmd.Flags().String("after", "", "show results after this value (used for pagination)")
 
 	branchCreateCmd.Flags().StringP("source", "s", "", "source branch uri")
 	_ = branchCreateCmd.MarkFlagRequired("source")
+	branchCreateCmd.Flags().String("expires-at", "", "create an ephemeral branch, deleted at this time (RFC3339, e.g. 2006-01-02T15:04:05Z)")
+	branchCreateCmd.Flags().Duration("idle-ttl", 0, "create an ephemeral branch, deleted once its head was not changed for this duration (e.g. 24h)")
 
 	branchResetCmd.Flags().String("prefix", "", "prefix of the objects to be reset")
 	branchResetCmd.Flags().String("object", "", "path to object to be reset")
 
 	branchRevertCmd.Flags().IntP(ParentNumberFlagName, "m", 0, "the parent number (starting from 1) of the mainline. The revert will reverse the change relative to the specified parent.")
 
+	branchCherryPickCmd.Flags().IntP(ParentNumberFlagName, "m", 0, "the parent number (starting from 1) of the mainline. The cherry-pick will apply the change relative to the specified parent.")
+
 	AssignAutoConfirmFlag(branchResetCmd.Flags())
 	AssignAutoConfirmFlag(branchRevertCmd.Flags())
 	AssignAutoConfirmFlag(branchDeleteCmd.Flags())
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/treeverse/lakefs/pkg/api"
@@ -10,6 +11,10 @@ import (
 const (
 	branchProtectAddCmdArgs    = 2
 	branchProtectDeleteCmdArgs = 2
+
+	branchProtectBlockedActionsFlagName     = "blocked-actions"
+	branchProtectMergeSourcePatternFlagName = "merge-source-pattern"
+	branchProtectRequireSignedFlagName      = "require-signed-commits"
 )
 
 var branchProtectCmd = &cobra.Command{
@@ -29,9 +34,14 @@ var branchProtectListCmd = &cobra.Command{
 		DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusOK)
 		patterns := make([][]interface{}, len(*resp.JSON200))
 		for i, rule := range *resp.JSON200 {
-			patterns[i] = []interface{}{rule.Pattern}
+			var blockedActions []string
+			if rule.BlockedActions != nil {
+				blockedActions = *rule.BlockedActions
+			}
+			requireSignedCommits := rule.RequireSignedCommits != nil && *rule.RequireSignedCommits
+			patterns[i] = []interface{}{rule.Pattern, strings.Join(blockedActions, ","), api.StringValue(rule.MergeSourcePattern), requireSignedCommits}
 		}
-		PrintTable(patterns, []interface{}{"Branch Name Pattern"}, &api.Pagination{
+		PrintTable(patterns, []interface{}{"Branch Name Pattern", "Blocked Actions", "Merge Source Pattern", "Signed Commits"}, &api.Pagination{
 			HasMore: false,
 			Results: len(patterns),
 		}, len(patterns))
@@ -39,17 +49,32 @@ var branchProtectListCmd = &cobra.Command{
 }
 
 var branchProtectAddCmd = &cobra.Command{
-	Use:     "add <repo uri> <pattern>",
-	Short:   "Add a branch protection rule",
-	Long:    "Add a branch protection rule for a given branch name pattern",
-	Example: "lakectl branch-protect add lakefs://<repository> 'stable_*'",
-	Args:    cobra.ExactArgs(branchProtectAddCmdArgs),
+	Use:   "add <repo uri> <pattern>",
+	Short: "Add a branch protection rule",
+	Long:  "Add a branch protection rule for a given branch name pattern. Blocked actions are any of staging_write, commit, delete, reset, revert, update_pointer and merge, defaulting to staging_write and commit.",
+	Example: `lakectl branch-protect add lakefs://<repository> 'stable_*'
+lakectl branch-protect add lakefs://<repository> main --blocked-actions staging_write,commit,delete,reset,revert --merge-source-pattern 'release_*'
+lakectl branch-protect add lakefs://<repository> main --require-signed-commits`,
+	Args: cobra.ExactArgs(branchProtectAddCmdArgs),
 	Run: func(cmd *cobra.Command, args []string) {
+		blockedActions := MustStringSlice(cmd.Flags().GetStringSlice(branchProtectBlockedActionsFlagName))
+		mergeSourcePattern := MustString(cmd.Flags().GetString(branchProtectMergeSourcePatternFlagName))
+		requireSignedCommits := MustBool(cmd.Flags().GetBool(branchProtectRequireSignedFlagName))
 		client := getClient()
 		u := MustParseRepoURI("repository", args[0])
-		resp, err := client.CreateBranchProtectionRuleWithResponse(cmd.Context(), u.Repository, api.CreateBranchProtectionRuleJSONRequestBody{
+		body := api.CreateBranchProtectionRuleJSONRequestBody{
 			Pattern: args[1],
-		})
+		}
+		if len(blockedActions) > 0 {
+			body.BlockedActions = &blockedActions
+		}
+		if mergeSourcePattern != "" {
+			body.MergeSourcePattern = api.StringPtr(mergeSourcePattern)
+		}
+		if requireSignedCommits {
+			body.RequireSignedCommits = &requireSignedCommits
+		}
+		resp, err := client.CreateBranchProtectionRuleWithResponse(cmd.Context(), u.Repository, body)
 		DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusNoContent)
 	},
 }
@@ -76,4 +101,8 @@ func init() {
 	branchProtectCmd.AddCommand(branchProtectAddCmd)
 	branchProtectCmd.AddCommand(branchProtectListCmd)
 	branchProtectCmd.AddCommand(branchProtectDeleteCmd)
+
+	branchProtectAddCmd.Flags().StringSlice(branchProtectBlockedActionsFlagName, nil, "actions to block on matching branches (staging_write, commit, delete, reset, revert, update_pointer, merge)")
+	branchProtectAddCmd.Flags().String(branchProtectMergeSourcePatternFlagName, "", "allow merges into matching branches only from source branches matching this pattern")
+	branchProtectAddCmd.Flags().Bool(branchProtectRequireSignedFlagName, false, "allow matching branches to point only to commits with a verified signature")
 }
@@ -0,0 +1,136 @@
+package cmd
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/treeverse/lakefs/pkg/api"
+)
+
+const branchStashCmdArgs = 2
+
+var branchStashCmd = &cobra.Command{
+	Use:   "stash",
+	Short: "Move uncommitted changes of a branch aside and restore them later",
+}
+
+var branchStashPushCmd = &cobra.Command{
+	Use:     "push <branch uri> <stash>",
+	Short:   "Move the uncommitted changes of a branch to a new stash",
+	Long:    "Move the uncommitted changes of a branch to a new stash, leaving the branch with no uncommitted changes",
+	Example: "lakectl branch stash push lakefs://example-repo/main wip",
+	Args:    cobra.ExactArgs(branchStashCmdArgs),
+	Run: func(cmd *cobra.Command, args []string) {
+		u := MustParseRefURI("branch", args[0])
+		client := getClient()
+		resp, err := client.StashPushWithResponse(cmd.Context(), u.Repository, u.Ref, api.StashPushJSONRequestBody{
+			Id: args[1],
+		})
+		DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusCreated)
+		Fmt("Uncommitted changes of %s stashed as %s\n", u.Ref, resp.JSON201.Id)
+	},
+}
+
+// printStashConflicts prints the conflicts of a failed stash apply and dies
+func printStashConflicts(conflicts *api.StashConflicts) {
+	printMergeConflicts(conflicts.Conflicts, conflicts.ConflictsTruncated != nil && *conflicts.ConflictsTruncated)
+	DieFmt("Conflicts found, paths of the stash were changed on the branch")
+}
+
+var branchStashApplyCmd = &cobra.Command{
+	Use:     "apply <branch uri> <stash>",
+	Short:   "Stage the changes of a stash on a branch, keeping the stash",
+	Long:    "Stage the changes of a stash on a branch, keeping the stash. Nothing is staged when paths of the stash were changed differently on the branch.",
+	Example: "lakectl branch stash apply lakefs://example-repo/main wip",
+	Args:    cobra.ExactArgs(branchStashCmdArgs),
+	Run: func(cmd *cobra.Command, args []string) {
+		u := MustParseRefURI("branch", args[0])
+		client := getClient()
+		resp, err := client.StashApplyWithResponse(cmd.Context(), u.Repository, u.Ref, args[1])
+		if resp != nil && resp.JSON409 != nil {
+			printStashConflicts(resp.JSON409)
+		}
+		DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusNoContent)
+		Fmt("Stash %s applied on %s\n", args[1], u.Ref)
+	},
+}
+
+var branchStashPopCmd = &cobra.Command{
+	Use:     "pop <branch uri> <stash>",
+	Short:   "Stage the changes of a stash on a branch and delete the stash",
+	Long:    "Stage the changes of a stash on a branch and delete the stash. Nothing is staged and the stash is kept when paths of the stash were changed differently on the branch.",
+	Example: "lakectl branch stash pop lakefs://example-repo/main wip",
+	Args:    cobra.ExactArgs(branchStashCmdArgs),
+	Run: func(cmd *cobra.Command, args []string) {
+		u := MustParseRefURI("branch", args[0])
+		client := getClient()
+		resp, err := client.StashPopWithResponse(cmd.Context(), u.Repository, u.Ref, args[1])
+		if resp != nil && resp.JSON409 != nil {
+			printStashConflicts(resp.JSON409)
+		}
+		DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusNoContent)
+		Fmt("Stash %s popped on %s\n", args[1], u.Ref)
+	},
+}
+
+var branchStashDropCmd = &cobra.Command{
+	Use:     "drop <branch uri> <stash>",
+	Short:   "Delete a stash and its changes",
+	Example: "lakectl branch stash drop lakefs://example-repo/main wip",
+	Args:    cobra.ExactArgs(branchStashCmdArgs),
+	Run: func(cmd *cobra.Command, args []string) {
+		u := MustParseRefURI("branch", args[0])
+		confirmation, err := Confirm(cmd.Flags(), "Are you sure you want to delete stash "+args[1])
+		if err != nil || !confirmation {
+			Die("Drop stash aborted", 1)
+			return
+		}
+		client := getClient()
+		resp, err := client.StashDropWithResponse(cmd.Context(), u.Repository, u.Ref, args[1])
+		DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusNoContent)
+	},
+}
+
+var branchStashListCmd = &cobra.Command{
+	Use:     "list <branch uri>",
+	Short:   "List the stashes of a branch",
+	Example: "lakectl branch stash list lakefs://example-repo/main",
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		amount := MustInt(cmd.Flags().GetInt("amount"))
+		after := MustString(cmd.Flags().GetString("after"))
+		u := MustParseRefURI("branch", args[0])
+		client := getClient()
+		resp, err := client.ListStashesWithResponse(cmd.Context(), u.Repository, u.Ref, &api.ListStashesParams{
+			After:  api.PaginationAfterPtr(after),
+			Amount: api.PaginationAmountPtr(amount),
+		})
+		DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusOK)
+
+		stashes := resp.JSON200.Results
+		rows := make([][]interface{}, len(stashes))
+		for i, stash := range stashes {
+			ts := time.Unix(stash.CreationDate, 0).String()
+			rows[i] = []interface{}{stash.Id, stash.CommitId, ts}
+		}
+
+		pagination := resp.JSON200.Pagination
+		PrintTable(rows, []interface{}{"Stash", "Commit ID", "Creation Date"}, &pagination, amount)
+	},
+}
+
+//nolint:gochecknoinits
+func init() {
+	branchCmd.AddCommand(branchStashCmd)
+	branchStashCmd.AddCommand(branchStashPushCmd)
+	branchStashCmd.AddCommand(branchStashApplyCmd)
+	branchStashCmd.AddCommand(branchStashPopCmd)
+	branchStashCmd.AddCommand(branchStashDropCmd)
+	branchStashCmd.AddCommand(branchStashListCmd)
+
+	AssignAutoConfirmFlag(branchStashDropCmd.Flags())
+
+	branchStashListCmd.Flags().Int("amount", defaultAmountArgumentValue, "number of results to return")
+	branchStashListCmd.Flags().String("after", "", "show results after this value (used for pagination)")
+}
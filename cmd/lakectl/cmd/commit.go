@@ -1,13 +1,18 @@
 package cmd
 
 import (
+	"context"
+	"encoding/hex"
 	"errors"
 	"net/http"
+	"os"
 	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/treeverse/lakefs/pkg/api"
+	"github.com/treeverse/lakefs/pkg/auth/keys"
 	"github.com/treeverse/lakefs/pkg/uri"
+	"golang.org/x/crypto/ssh"
 )
 
 var errInvalidKeyValueFormat = errors.New(`invalid key/value pair - should be separated by "="`)
@@ -19,6 +24,8 @@ const (
 	messageFlagName           = "message"
 	allowEmptyMessageFlagName = "allow-empty-message"
 	metaFlagName              = "meta"
+	commitPrefixFlagName      = "prefix"
+	signKeyFlagName           = "sign-key"
 	commitCreateTemplate      = `Commit for branch "{{.Branch.Ref}}" completed.
 
 ID: {{.Commit.Id|yellow}}
@@ -43,6 +50,8 @@ var commitCmd = &cobra.Command{
 		message := MustString(cmd.Flags().GetString(messageFlagName))
 		emptyMessageBool := MustBool(cmd.Flags().GetBool(allowEmptyMessageFlagName))
 		date := MustInt64(cmd.Flags().GetInt64(dateFlagName))
+		prefixes := MustSliceNonEmptyString(commitPrefixFlagName, MustStringSlice(cmd.Flags().GetStringSlice(commitPrefixFlagName)))
+		signKey := MustString(cmd.Flags().GetString(signKeyFlagName))
 
 		if strings.TrimSpace(message) == "" && !emptyMessageBool {
 			DieFmt(fmtErrEmptyMessage)
@@ -53,6 +62,11 @@ var commitCmd = &cobra.Command{
 			datePtr = nil
 		}
 
+		var prefixesPtr *[]string
+		if len(prefixes) > 0 {
+			prefixesPtr = &prefixes
+		}
+
 		branchURI := MustParseRefURI("branch", args[0])
 		Fmt("Branch: %s\n", branchURI.String())
 
@@ -65,10 +79,14 @@ var commitCmd = &cobra.Command{
 			Message:  message,
 			Metadata: &metadata,
 			Date:     datePtr,
+			Prefixes: prefixesPtr,
 		})
 		DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusCreated)
 
 		commit := resp.JSON201
+		if signKey != "" {
+			signCommit(cmd.Context(), client, branchURI.Repository, commit.Id, signKey)
+		}
 		Write(commitCreateTemplate, struct {
 			Branch *uri.URI
 			Commit *api.Commit
@@ -76,6 +94,33 @@ var commitCmd = &cobra.Command{
 	},
 }
 
+// signCommit signs the commit identity with the SSH private key in keyFile and stores the signature of the commit
+func signCommit(ctx context.Context, client api.ClientWithResponsesInterface, repository, commitID, keyFile string) {
+	privateKey, err := os.ReadFile(keyFile)
+	if err != nil {
+		DieErr(err)
+	}
+	signer, err := ssh.ParsePrivateKey(privateKey)
+	if err != nil {
+		DieFmt("Failed to parse signing key %s: %s", keyFile, err)
+	}
+	identity, err := hex.DecodeString(commitID)
+	if err != nil {
+		DieFmt("Invalid commit ID %s: %s", commitID, err)
+	}
+	signature, err := keys.Sign(signer, identity)
+	if err != nil {
+		DieErr(err)
+	}
+	keyID := keys.SigningKeyID(signer.PublicKey())
+	resp, err := client.SignCommitWithResponse(ctx, repository, commitID, api.SignCommitJSONRequestBody{
+		KeyId:     keyID,
+		Signature: signature,
+	})
+	DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusNoContent)
+	Fmt("Commit %s signed with key %s\n", commitID, keyID)
+}
+
 func getKV(cmd *cobra.Command, name string) (map[string]string, error) {
 	kvList, err := cmd.Flags().GetStringSlice(name)
 	if err != nil {
@@ -106,4 +151,6 @@ func init() {
 	}
 
 	commitCmd.Flags().StringSlice(metaFlagName, []string{}, "key value pair in the form of key=value")
+	commitCmd.Flags().StringSlice(commitPrefixFlagName, []string{}, "commit only uncommitted changes under this prefix (or path), can be repeated")
+	commitCmd.Flags().String(signKeyFlagName, "", "sign the commit with the unencrypted SSH private key in this file (ed25519, ecdsa or rsa), registered as a signing key of the committer")
 }
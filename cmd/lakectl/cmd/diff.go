@@ -19,6 +19,8 @@ const (
 	maxDiffPageSize = 1000
 
 	twoWayFlagName = "two-way"
+	statFlagName   = "stat"
+	depthFlagName  = "depth"
 	diffTypeTwoDot = "two_dot"
 )
 
@@ -40,12 +42,20 @@ var diffCmd = &cobra.Command{
 	Uncommitted changes are not shown.
 
 	lakectl diff --%s lakefs://example-repo/main lakefs://example-repo/dev$
-	Show changes between the tip of the main and the dev branch, including uncommitted changes on dev.`, twoWayFlagName, twoWayFlagName),
+	Show changes between the tip of the main and the dev branch, including uncommitted changes on dev.
+
+	lakectl diff --%s --%s 2 lakefs://example-repo/main lakefs://example-repo/dev
+	Count the objects added, removed and changed, and their size, by prefix of two path elements (for example, per
+	table directory), without listing them. Uncommitted changes are not counted.`, twoWayFlagName, twoWayFlagName, statFlagName, depthFlagName),
 
 	Args: cobra.RangeArgs(diffCmdMinArgs, diffCmdMaxArgs),
 	Run: func(cmd *cobra.Command, args []string) {
 		client := getClient()
+		stat := MustBool(cmd.Flags().GetBool(statFlagName))
 		if len(args) == diffCmdMinArgs {
+			if stat {
+				DieFmt("--%s requires two refs", statFlagName)
+			}
 			// got one arg ref: uncommitted changes diff
 			branchURI := MustParseRefURI("ref", args[0])
 			Fmt("Ref: %s\n", branchURI.String())
@@ -60,6 +70,11 @@ var diffCmd = &cobra.Command{
 		if leftRefURI.Repository != rightRefURI.Repository {
 			Die("both references must belong to the same repository", 1)
 		}
+		if stat {
+			depth := MustInt(cmd.Flags().GetInt(depthFlagName))
+			printDiffStat(cmd.Context(), client, leftRefURI.Repository, leftRefURI.Ref, rightRefURI.Ref, twoWay, depth)
+			return
+		}
 		printDiffRefs(cmd.Context(), client, leftRefURI.Repository, leftRefURI.Ref, rightRefURI.Ref, twoWay)
 	},
 }
@@ -126,6 +141,22 @@ func printDiffRefs(ctx context.Context, client api.ClientWithResponsesInterface,
 	}
 }
 
+func printDiffStat(ctx context.Context, client api.ClientWithResponsesInterface, repository string, leftRef string, rightRef string, twoDot bool, depth int) {
+	params := &api.DiffSummaryParams{Depth: &depth}
+	if twoDot {
+		params.Type = api.StringPtr(diffTypeTwoDot)
+	}
+	resp, err := client.DiffSummaryWithResponse(ctx, repository, leftRef, rightRef, params)
+	DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusOK)
+
+	summary := resp.JSON200.Results
+	rows := make([][]interface{}, len(summary))
+	for i, s := range summary {
+		rows[i] = []interface{}{s.Prefix, s.Added, s.Removed, s.Changed, s.AddedBytes, s.RemovedBytes, s.ChangedBytes}
+	}
+	PrintTable(rows, []interface{}{"Prefix", "Added", "Removed", "Changed", "Added Bytes", "Removed Bytes", "Changed Bytes"}, &api.Pagination{}, 0)
+}
+
 func FmtDiff(diff api.Diff, withDirection bool) {
 	var color text.Color
 	var action string
@@ -162,4 +193,6 @@ func FmtDiff(diff api.Diff, withDirection bool) {
 func init() {
 	rootCmd.AddCommand(diffCmd)
 	diffCmd.Flags().Bool(twoWayFlagName, false, "Use two-way diff: show difference between the given refs, regardless of a common ancestor.")
+	diffCmd.Flags().Bool(statFlagName, false, "Count the changes by prefix instead of listing them")
+	diffCmd.Flags().Int(depthFlagName, 1, "Number of path elements of the prefixes changes are counted by with --stat, 0 counts all changes together")
 }
@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/treeverse/lakefs/pkg/api"
@@ -51,6 +52,48 @@ var fsStatCmd = &cobra.Command{
 	},
 }
 
+var fsHistoryCmd = &cobra.Command{
+	Use:   "history <path uri>",
+	Short: "Show the versions of an object set by the commits of a ref, latest first",
+	Example: `lakectl fs history lakefs://<repository>/<ref>/<path>
+lakectl fs history lakefs://<repository>/<branch>/<path> --first-parent --amount 10`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		amount := MustInt(cmd.Flags().GetInt("amount"))
+		after := MustString(cmd.Flags().GetString("after"))
+		firstParent := MustBool(cmd.Flags().GetBool("first-parent"))
+		pathURI := MustParsePathURI("path", args[0])
+		client := getClient()
+		resp, err := client.ObjectHistoryWithResponse(cmd.Context(), pathURI.Repository, pathURI.Ref, &api.ObjectHistoryParams{
+			Path:        *pathURI.Path,
+			FirstParent: &firstParent,
+			After:       api.PaginationAfterPtr(after),
+			Amount:      api.PaginationAmountPtr(amount),
+		})
+		DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusOK)
+
+		versions := resp.JSON200.Results
+		rows := make([][]interface{}, len(versions))
+		for i, version := range versions {
+			var size interface{}
+			if version.SizeBytes != nil {
+				size = *version.SizeBytes
+			}
+			rows[i] = []interface{}{
+				version.CommitId,
+				time.Unix(version.CreationDate, 0).String(),
+				version.Committer,
+				version.Type,
+				size,
+				api.StringValue(version.Checksum),
+				api.StringValue(version.PhysicalAddress),
+			}
+		}
+		pagination := resp.JSON200.Pagination
+		PrintTable(rows, []interface{}{"Commit ID", "Date", "Committer", "Change", "Size", "Checksum", "Physical Address"}, &pagination, amount)
+	},
+}
+
 const fsLsTemplate = `{{ range $val := . -}}
 {{ $val.PathType|ljust 12 }}    {{ if eq $val.PathType "object" }}{{ $val.Mtime|date|ljust 29 }}    {{ $val.SizeBytes|human_bytes|ljust 12 }}{{ else }}                                            {{ end }}    {{ $val.Path|yellow }}
 {{ end -}}
@@ -402,6 +445,7 @@ var fsCmd = &cobra.Command{
 func init() {
 	rootCmd.AddCommand(fsCmd)
 	fsCmd.AddCommand(fsStatCmd)
+	fsCmd.AddCommand(fsHistoryCmd)
 	fsCmd.AddCommand(fsListCmd)
 	fsCmd.AddCommand(fsCatCmd)
 	fsCmd.AddCommand(fsUploadCmd)
@@ -426,6 +470,10 @@ func init() {
 	_ = fsStageCmd.MarkFlagRequired("size")
 	_ = fsStageCmd.MarkFlagRequired("checksum")
 
+	fsHistoryCmd.Flags().Int("amount", defaultAmountArgumentValue, "number of results to return")
+	fsHistoryCmd.Flags().String("after", "", "show results after this commit ID (used for pagination)")
+	fsHistoryCmd.Flags().Bool("first-parent", false, "follow only the first parent of merge commits")
+
 	fsListCmd.Flags().Bool("recursive", false, "list all objects under the specified prefix")
 
 	fsRmCmd.Flags().BoolP("recursive", "r", false, "recursively delete all objects under the specified path")
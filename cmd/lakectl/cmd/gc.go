@@ -16,7 +16,12 @@ const (
 Branch Rules: {{ range $branch := .Branches }}
   - Branch: {{ $branch.BranchId }}
     Retention Days: {{ $branch.RetentionDays }}{{ end }}
-`
+{{ with .Prefixes }}Prefix Rules: {{ range $prefix := . }}
+  - Prefix: {{ $prefix.Prefix }}
+    Retention Days: {{ $prefix.RetentionDays }}{{ end }}
+{{ end }}{{ with .PinnedTagPatterns }}Pinned Tag Patterns: {{ range $pattern := . }}
+  - {{ $pattern }}{{ end }}
+{{ end }}`
 
 	filenameFlagName = "filename"
 	jsonFlagName     = "json"
@@ -43,35 +48,82 @@ Example configuration file:
       "branch_id": "dev",
       "retention_days": 14
     }
-  ]
-}`,
+  ],
+  "prefixes": [
+    {
+      "prefix": "raw/",
+      "retention_days": 7
+    },
+    {
+      "prefix": "curated/",
+      "retention_days": 365
+    }
+  ],
+  "pinned_tag_patterns": ["release-*"]
+}
+Objects are retained by the rule of the longest prefix of their path on all branches, instead of the default and
+branch rules. Commits reachable from tags matching a pinned tag pattern are never expired.`,
 	Example: "lakectl gc set-config <repository uri> -f config.json",
 	Args:    cobra.ExactArgs(gcSetConfigCmdArgs),
 	Run: func(cmd *cobra.Command, args []string) {
 		u := MustParseRepoURI("repository", args[0])
-		filename := MustString(cmd.Flags().GetString(filenameFlagName))
-		var reader io.ReadCloser
-		var err error
-		if filename == "-" {
-			reader = os.Stdin
-		} else {
-			reader, err = os.Open(filename)
-			if err != nil {
-				DieErr(err)
+		body := api.SetGarbageCollectionRulesJSONRequestBody(mustReadGCRules(cmd))
+		client := getClient()
+		resp, err := client.SetGarbageCollectionRulesWithResponse(cmd.Context(), u.Repository, body)
+		DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusNoContent)
+	},
+}
+
+var gcPreviewConfigCmd = &cobra.Command{
+	Use:   "preview-config",
+	Short: "Estimate the number of objects garbage collection configuration JSON would expire",
+	Long: `Estimates the number of objects the default rule and the rule of every prefix of the garbage collection
+configuration JSON would expire, without setting it. See set-config for the configuration format.`,
+	Example: "lakectl gc preview-config <repository uri> -f config.json",
+	Args:    cobra.ExactArgs(gcSetConfigCmdArgs),
+	Run: func(cmd *cobra.Command, args []string) {
+		u := MustParseRepoURI("repository", args[0])
+		body := api.PreviewGarbageCollectionRulesJSONRequestBody(mustReadGCRules(cmd))
+		client := getClient()
+		resp, err := client.PreviewGarbageCollectionRulesWithResponse(cmd.Context(), u.Repository, body)
+		DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusOK)
+		if resp.JSON200 == nil {
+			Die("Bad response from server", 1)
+		}
+		rows := make([][]interface{}, 0, len(*resp.JSON200))
+		for _, preview := range *resp.JSON200 {
+			rule := preview.Prefix
+			if rule == "" {
+				rule = "(default)"
 			}
-			defer func() {
-				_ = reader.Close()
-			}()
+			rows = append(rows, []interface{}{rule, preview.RetentionDays, preview.ExpiredObjects})
 		}
-		var body api.SetGarbageCollectionRulesJSONRequestBody
-		err = json.NewDecoder(reader).Decode(&body)
+		PrintTable(rows, []interface{}{"Prefix", "Retention Days", "Expired Objects"}, &api.Pagination{}, len(rows))
+	},
+}
+
+// mustReadGCRules reads the garbage collection configuration JSON from the file flag, or from stdin if it is "-"
+func mustReadGCRules(cmd *cobra.Command) api.GarbageCollectionRules {
+	filename := MustString(cmd.Flags().GetString(filenameFlagName))
+	var reader io.ReadCloser
+	var err error
+	if filename == "-" {
+		reader = os.Stdin
+	} else {
+		reader, err = os.Open(filename)
 		if err != nil {
 			DieErr(err)
 		}
-		client := getClient()
-		resp, err := client.SetGarbageCollectionRulesWithResponse(cmd.Context(), u.Repository, body)
-		DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusNoContent)
-	},
+		defer func() {
+			_ = reader.Close()
+		}()
+	}
+	var rules api.GarbageCollectionRules
+	err = json.NewDecoder(reader).Decode(&rules)
+	if err != nil {
+		DieErr(err)
+	}
+	return rules
 }
 
 var gcGetConfigCmd = &cobra.Command{
@@ -97,8 +149,11 @@ var gcGetConfigCmd = &cobra.Command{
 func init() {
 	gcSetConfigCmd.Flags().StringP(filenameFlagName, "f", "", "file containing the GC configuration")
 	_ = gcSetConfigCmd.MarkFlagRequired(filenameFlagName)
+	gcPreviewConfigCmd.Flags().StringP(filenameFlagName, "f", "", "file containing the GC configuration")
+	_ = gcPreviewConfigCmd.MarkFlagRequired(filenameFlagName)
 	gcGetConfigCmd.Flags().BoolP(jsonFlagName, "p", false, "get rules as JSON")
 	rootCmd.AddCommand(gcCmd)
 	gcCmd.AddCommand(gcSetConfigCmd)
 	gcCmd.AddCommand(gcGetConfigCmd)
+	gcCmd.AddCommand(gcPreviewConfigCmd)
 }
@@ -0,0 +1,157 @@
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/treeverse/lakefs/pkg/api"
+)
+
+const (
+	importCmdArgs          = 2
+	importFromFlagName     = "from"
+	importToFlagName       = "to"
+	importNoWaitFlagName   = "no-wait"
+	importStatusPollPeriod = 2 * time.Second
+)
+
+const importStatusTemplate = `Import:           {{ .Id|yellow }}
+Completed:        {{ .Completed }}
+Imported objects: {{ .ImportedObjects }}
+{{ if .CommitId -}}
+Commit ID:        {{ .CommitId }}
+{{ end -}}
+{{ if .Error -}}
+Error:            {{ .Error }}
+{{ end -}}
+`
+
+var importCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Import objects from an object store prefix to a branch on the lakeFS server",
+	Long: `Import the objects under an object store prefix to a path on a branch, without copying them.  The lakeFS
+server lists the objects and commits them on the branch in the background, replacing the objects under the path.
+The prefix must be on the blockstore lakeFS is configured with.`,
+}
+
+var importStartCmd = &cobra.Command{
+	Use:   "start --from <object store uri> --to <path uri>",
+	Short: "Start an import and wait for it to complete",
+	Example: `lakectl import start --from s3://bucket/collections/2021/ --to lakefs://example-repo/main/collections/2021/
+	Import the objects under s3://bucket/collections/2021/ to collections/2021/ on branch main.  Interrupting the
+	command cancels the import.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		from := MustString(cmd.Flags().GetString(importFromFlagName))
+		to := MustString(cmd.Flags().GetString(importToFlagName))
+		message := MustString(cmd.Flags().GetString(messageFlagName))
+		noWait := MustBool(cmd.Flags().GetBool(importNoWaitFlagName))
+		kvPairs, err := getKV(cmd, metaFlagName)
+		if err != nil {
+			DieErr(err)
+		}
+		u := MustParsePathURI("to", to)
+		var destination string
+		if u.Path != nil {
+			destination = *u.Path
+		}
+		if destination == "" {
+			DieFmt("Invalid 'to': missing path to import to")
+		}
+
+		body := api.StartImportJSONRequestBody{
+			Source:      from,
+			Destination: destination,
+			Metadata:    &api.ImportCreation_Metadata{AdditionalProperties: kvPairs},
+		}
+		if message != "" {
+			body.Message = api.StringPtr(message)
+		}
+		client := getClient()
+		resp, err := client.StartImportWithResponse(cmd.Context(), u.Repository, u.Ref, body)
+		DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusAccepted)
+		importID := resp.JSON202.Id
+		if noWait {
+			Fmt("Import %s started\n", importID)
+			return
+		}
+
+		ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+		defer stop()
+		ticker := time.NewTicker(importStatusPollPeriod)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				// the command context is canceled too, cancel the import on its own context
+				cancelResp, err := client.CancelImportWithResponse(context.Background(), u.Repository, u.Ref, importID)
+				DieOnErrorOrUnexpectedStatusCode(cancelResp, err, http.StatusNoContent)
+				Die("\nImport canceled", 1)
+			case <-ticker.C:
+			}
+			statusResp, err := client.GetImportStatusWithResponse(ctx, u.Repository, u.Ref, importID)
+			if ctx.Err() != nil {
+				continue
+			}
+			DieOnErrorOrUnexpectedStatusCode(statusResp, err, http.StatusOK)
+			status := statusResp.JSON200
+			if !status.Completed {
+				Fmt("Imported %d objects so far...\r", status.ImportedObjects)
+				continue
+			}
+			Fmt("\n")
+			Write(importStatusTemplate, status)
+			if status.Error != nil {
+				os.Exit(1)
+			}
+			return
+		}
+	},
+}
+
+var importStatusCmd = &cobra.Command{
+	Use:     "status <branch uri> <import id>",
+	Short:   "Show the progress of an import",
+	Example: "lakectl import status lakefs://example-repo/main 9m2rqk8ecb2p1mpg6ua0",
+	Args:    cobra.ExactArgs(importCmdArgs),
+	Run: func(cmd *cobra.Command, args []string) {
+		u := MustParseRefURI("branch", args[0])
+		client := getClient()
+		resp, err := client.GetImportStatusWithResponse(cmd.Context(), u.Repository, u.Ref, args[1])
+		DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusOK)
+		Write(importStatusTemplate, resp.JSON200)
+	},
+}
+
+var importCancelCmd = &cobra.Command{
+	Use:     "cancel <branch uri> <import id>",
+	Short:   "Cancel an import that did not complete, none of its objects are committed",
+	Example: "lakectl import cancel lakefs://example-repo/main 9m2rqk8ecb2p1mpg6ua0",
+	Args:    cobra.ExactArgs(importCmdArgs),
+	Run: func(cmd *cobra.Command, args []string) {
+		u := MustParseRefURI("branch", args[0])
+		client := getClient()
+		resp, err := client.CancelImportWithResponse(cmd.Context(), u.Repository, u.Ref, args[1])
+		DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusNoContent)
+		Fmt("Import %s canceled\n", args[1])
+	},
+}
+
+//nolint:gochecknoinits
+func init() {
+	rootCmd.AddCommand(importCmd)
+	importCmd.AddCommand(importStartCmd, importStatusCmd, importCancelCmd)
+
+	f := importStartCmd.Flags()
+	f.String(importFromFlagName, "", "object store prefix to import from (e.g. \"s3://bucket/sub/path/\")")
+	_ = importStartCmd.MarkFlagRequired(importFromFlagName)
+	f.String(importToFlagName, "", "lakeFS path to import the objects to (e.g. \"lakefs://repo/branch/sub/path/\")")
+	_ = importStartCmd.MarkFlagRequired(importToFlagName)
+	f.StringP(messageFlagName, "m", "", "commit message, describes the source when empty")
+	f.StringSlice(metaFlagName, []string{}, "key value pair in the form of key=value")
+	f.Bool(importNoWaitFlagName, false, "print the import ID without waiting for the import to complete")
+}
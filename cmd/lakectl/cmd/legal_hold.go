@@ -0,0 +1,147 @@
+package cmd
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/treeverse/lakefs/pkg/api"
+)
+
+const (
+	legalHoldCmdArgs        = 2
+	legalHoldReasonFlagName = "reason"
+	legalHoldPathFlagName   = "path"
+)
+
+const legalHoldShowTemplate = `Legal hold: {{ .Id|yellow }}
+Commit ID:  {{ .CommitId }}
+{{ if .Path -}}
+Path:       {{ .Path }}
+{{ end -}}
+Reason:     {{ .Reason }}
+Created by: {{ .CreatedBy }}
+Date:       {{ .CreationDate|date }}
+`
+
+var legalHoldCmd = &cobra.Command{
+	Use:   "legal-hold",
+	Short: "Keep commits and paths in them from garbage collection",
+	Long: `Place and release legal holds on commits, or on the paths under a prefix in a commit.  Garbage collection keeps
+the held objects until the hold is released, whatever the garbage collection rules of the repository.`,
+}
+
+var legalHoldCreateCmd = &cobra.Command{
+	Use:   "create <ref uri> <hold id>",
+	Short: "Place a legal hold on the commit of a ref",
+	Example: `lakectl legal-hold create --reason "case 1234" lakefs://example-repo/main case-1234
+	Hold all objects of the commit main points to.
+
+lakectl legal-hold create --reason "case 1234" --path records/ lakefs://example-repo/a1b2c3 case-1234
+	Hold only the objects under records/ in commit a1b2c3.`,
+	Args: cobra.ExactArgs(legalHoldCmdArgs),
+	Run: func(cmd *cobra.Command, args []string) {
+		reason := MustString(cmd.Flags().GetString(legalHoldReasonFlagName))
+		path := MustString(cmd.Flags().GetString(legalHoldPathFlagName))
+		u := MustParseRefURI("ref", args[0])
+		body := api.CreateLegalHoldJSONRequestBody{
+			Id:     args[1],
+			Ref:    u.Ref,
+			Reason: reason,
+		}
+		if path != "" {
+			body.Path = api.StringPtr(path)
+		}
+		client := getClient()
+		resp, err := client.CreateLegalHoldWithResponse(cmd.Context(), u.Repository, body)
+		DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusCreated)
+		Write(legalHoldShowTemplate, resp.JSON201)
+	},
+}
+
+var legalHoldReleaseCmd = &cobra.Command{
+	Use:     "release <repository uri> <hold id>",
+	Short:   "Release a legal hold, letting garbage collection remove its objects",
+	Example: "lakectl legal-hold release lakefs://example-repo case-1234",
+	Args:    cobra.ExactArgs(legalHoldCmdArgs),
+	Run: func(cmd *cobra.Command, args []string) {
+		u := MustParseRepoURI("repository", args[0])
+		confirmation, err := Confirm(cmd.Flags(), "Are you sure you want to release legal hold "+args[1])
+		if err != nil || !confirmation {
+			Die("Release legal hold aborted", 1)
+			return
+		}
+		client := getClient()
+		resp, err := client.ReleaseLegalHoldWithResponse(cmd.Context(), u.Repository, args[1])
+		DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusNoContent)
+		Fmt("Legal hold %s released\n", args[1])
+	},
+}
+
+var legalHoldShowCmd = &cobra.Command{
+	Use:     "show <repository uri> <hold id>",
+	Short:   "Show a legal hold",
+	Example: "lakectl legal-hold show lakefs://example-repo case-1234",
+	Args:    cobra.ExactArgs(legalHoldCmdArgs),
+	Run: func(cmd *cobra.Command, args []string) {
+		u := MustParseRepoURI("repository", args[0])
+		client := getClient()
+		resp, err := client.GetLegalHoldWithResponse(cmd.Context(), u.Repository, args[1])
+		DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusOK)
+		Write(legalHoldShowTemplate, resp.JSON200)
+	},
+}
+
+var legalHoldListCmd = &cobra.Command{
+	Use:     "list <repository uri>",
+	Short:   "List the legal holds of a repository",
+	Example: "lakectl legal-hold list lakefs://example-repo",
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		u := MustParseRepoURI("repository", args[0])
+		client := getClient()
+		resp, err := client.ListLegalHoldsWithResponse(cmd.Context(), u.Repository)
+		DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusOK)
+
+		holds := *resp.JSON200
+		rows := make([][]interface{}, len(holds))
+		for i, hold := range holds {
+			ts := time.Unix(hold.CreationDate, 0).String()
+			rows[i] = []interface{}{hold.Id, hold.CommitId, hold.Path, hold.Reason, hold.CreatedBy, ts}
+		}
+		PrintTable(rows, []interface{}{"Hold", "Commit ID", "Path", "Reason", "Created By", "Creation Date"}, &api.Pagination{}, len(rows))
+	},
+}
+
+var legalHoldLogCmd = &cobra.Command{
+	Use:     "log <repository uri>",
+	Short:   "List the legal holds placed and released in a repository, latest first",
+	Example: "lakectl legal-hold log lakefs://example-repo",
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		u := MustParseRepoURI("repository", args[0])
+		client := getClient()
+		resp, err := client.ListLegalHoldLogWithResponse(cmd.Context(), u.Repository)
+		DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusOK)
+
+		entries := *resp.JSON200
+		rows := make([][]interface{}, len(entries))
+		for i, entry := range entries {
+			ts := time.Unix(entry.CreationDate, 0).String()
+			rows[i] = []interface{}{ts, entry.Operation, entry.Id, entry.CommitId, entry.Path, entry.User, entry.Reason}
+		}
+		PrintTable(rows, []interface{}{"Date", "Operation", "Hold", "Commit ID", "Path", "User", "Reason"}, &api.Pagination{}, len(rows))
+	},
+}
+
+//nolint:gochecknoinits
+func init() {
+	rootCmd.AddCommand(legalHoldCmd)
+	legalHoldCmd.AddCommand(legalHoldCreateCmd, legalHoldReleaseCmd, legalHoldShowCmd, legalHoldListCmd, legalHoldLogCmd)
+
+	legalHoldCreateCmd.Flags().String(legalHoldReasonFlagName, "", "reason for the hold")
+	_ = legalHoldCreateCmd.MarkFlagRequired(legalHoldReasonFlagName)
+	legalHoldCreateCmd.Flags().String(legalHoldPathFlagName, "", "hold only the objects under this path prefix in the commit")
+
+	AssignAutoConfirmFlag(legalHoldReleaseCmd.Flags())
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/treeverse/lakefs/pkg/api"
+	"github.com/treeverse/lakefs/pkg/uri"
 )
 
 const commitsTemplate = `{{ range $val := .Commits }}
@@ -13,6 +14,8 @@ Author:        {{ $val.Committer }}{{end}}
 Date:          {{ $val.CreationDate|date }}
 {{ if $.ShowMetaRangeID }}Meta Range ID: {{ $val.MetaRangeId }}
 {{ end -}}
+{{ if $.Verify }}Signature:     {{ if not $val.Signature }}{{ "none"|red }}{{ else if $val.Signature.Verified }}{{ "verified"|green }} ({{ $val.Signature.KeyId }}){{ else }}{{ "unverified"|red }} ({{ $val.Signature.KeyId }}){{ end }}
+{{ end -}}
 {{ if gt ($val.Parents|len) 1 -}}
 Merge:         {{ $val.Parents|join ", "|bold }}
 {{ end }}
@@ -28,44 +31,87 @@ Merge:         {{ $val.Parents|join ", "|bold }}
 var logCmd = &cobra.Command{
 	Use:   "log <branch uri>",
 	Short: "Show log of commits",
-	Long:  "Show log of commits for a given branch",
-	Args:  cobra.ExactArgs(1),
+	Long: `Show log of commits for a given branch.
+With --search, --meta or --committer, search the commits of the whole repository instead, latest first.`,
+	Example: `lakectl log lakefs://<repository>/<branch>
+lakectl log lakefs://<repository> --meta job_id=1234 --search "daily load"`,
+	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		amount := MustInt(cmd.Flags().GetInt("amount"))
 		after := MustString(cmd.Flags().GetString("after"))
 		objectsList := MustSliceNonEmptyString("objects", MustStringSlice(cmd.Flags().GetStringSlice("objects")))
 		prefixesList := MustSliceNonEmptyString("prefixes", MustStringSlice(cmd.Flags().GetStringSlice("prefixes")))
+		search := MustString(cmd.Flags().GetString("search"))
+		metadata := MustStringSlice(cmd.Flags().GetStringArray("meta"))
+		committer := MustString(cmd.Flags().GetString("committer"))
+		searchCommits := search != "" || len(metadata) > 0 || committer != ""
+		if searchCommits && (len(objectsList) > 0 || len(prefixesList) > 0) {
+			Die("--objects and --prefixes cannot be used when searching commits", 1)
+		}
 
 		pagination := api.Pagination{HasMore: true}
 		showMetaRangeID, _ := cmd.Flags().GetBool("show-meta-range-id")
+		verify := MustBool(cmd.Flags().GetBool("verify"))
 		client := getClient()
-		branchURI := MustParseRefURI("branch", args[0])
 		amountForPagination := amount
 		if amountForPagination <= 0 {
 			amountForPagination = internalPageSize
 		}
-		logCommitsParams := &api.LogCommitsParams{
-			After:  api.PaginationAfterPtr(after),
-			Amount: api.PaginationAmountPtr(amountForPagination),
-		}
-		if len(objectsList) > 0 {
-			logCommitsParams.Objects = &objectsList
-		}
-		if len(prefixesList) > 0 {
-			logCommitsParams.Prefixes = &prefixesList
+		var listCommits func(after string) *api.CommitList
+		if searchCommits {
+			repoURI, err := uri.ParseWithBaseURI(args[0], baseURI)
+			if err != nil {
+				DieFmt("Invalid 'repository': %s", err)
+			}
+			searchCommitsParams := &api.SearchCommitsParams{
+				Amount: api.PaginationAmountPtr(amountForPagination),
+			}
+			if search != "" {
+				searchCommitsParams.Message = api.StringPtr(search)
+			}
+			if len(metadata) > 0 {
+				searchCommitsParams.Metadata = &metadata
+			}
+			if committer != "" {
+				searchCommitsParams.Committer = api.StringPtr(committer)
+			}
+			listCommits = func(after string) *api.CommitList {
+				searchCommitsParams.After = api.PaginationAfterPtr(after)
+				resp, err := client.SearchCommitsWithResponse(cmd.Context(), repoURI.Repository, searchCommitsParams)
+				DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusOK)
+				return resp.JSON200
+			}
+		} else {
+			branchURI := MustParseRefURI("branch", args[0])
+			logCommitsParams := &api.LogCommitsParams{
+				Amount: api.PaginationAmountPtr(amountForPagination),
+			}
+			if len(objectsList) > 0 {
+				logCommitsParams.Objects = &objectsList
+			}
+			if len(prefixesList) > 0 {
+				logCommitsParams.Prefixes = &prefixesList
+			}
+			listCommits = func(after string) *api.CommitList {
+				logCommitsParams.After = api.PaginationAfterPtr(after)
+				resp, err := client.LogCommitsWithResponse(cmd.Context(), branchURI.Repository, branchURI.Ref, logCommitsParams)
+				DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusOK)
+				return resp.JSON200
+			}
 		}
 		for pagination.HasMore {
-			resp, err := client.LogCommitsWithResponse(cmd.Context(), branchURI.Repository, branchURI.Ref, logCommitsParams)
-			DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusOK)
-			pagination = resp.JSON200.Pagination
-			logCommitsParams.After = api.PaginationAfterPtr(pagination.NextOffset)
+			commits := listCommits(after)
+			pagination = commits.Pagination
+			after = pagination.NextOffset
 			data := struct {
 				Commits         []api.Commit
 				Pagination      *Pagination
 				ShowMetaRangeID bool
+				Verify          bool
 			}{
-				Commits:         resp.JSON200.Results,
+				Commits:         commits.Results,
 				ShowMetaRangeID: showMetaRangeID,
+				Verify:          verify,
 				Pagination: &Pagination{
 					Amount:  amount,
 					HasNext: pagination.HasMore,
@@ -87,6 +133,10 @@ func init() {
 	logCmd.Flags().Int("amount", 0, "number of results to return. By default, all results are returned")
 	logCmd.Flags().String("after", "", "show results after this value (used for pagination)")
 	logCmd.Flags().Bool("show-meta-range-id", false, "also show meta range ID")
+	logCmd.Flags().Bool("verify", false, "also show the signature of each commit and whether it is verified against the signing keys of its committer")
 	logCmd.Flags().StringSlice("objects", nil, "show results that contains changes to at least one path in that list of objects. Use comma separator to pass all objects together")
 	logCmd.Flags().StringSlice("prefixes", nil, "show results that contains changes to at least one path in that list of prefixes. Use comma separator to pass all prefixes together")
+	logCmd.Flags().String("search", "", "search the commits of the repository whose message contains this text, ignoring case")
+	logCmd.Flags().StringArray("meta", nil, "search the commits of the repository with this metadata, in the form key=value. Repeat to match several pairs")
+	logCmd.Flags().String("committer", "", "search the commits of the repository made by this committer")
 }
@@ -1,7 +1,9 @@
 package cmd
 
 import (
+	"context"
 	"net/http"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/treeverse/lakefs/pkg/api"
@@ -14,6 +16,14 @@ const (
 
 var mergeCreateTemplate = `Merged "{{.Merge.FromRef|yellow}}" into "{{.Merge.ToRef|yellow}}" to get "{{.Result.Reference|green}}".`
 
+var mergePreviewTemplate = `Merge base: {{.BaseCommitId|yellow}}
+{{if .FastForward}}Fast-forward: {{"yes"|green}}{{else}}Fast-forward: no{{end}}
+`
+
+var mergeConflictsTemplate = `{{.Table | table -}}
+{{if .Truncated}}{{"Showing only some of the conflicts." | yellow}}
+{{end}}`
+
 type FromTo struct {
 	FromRef, ToRef string
 }
@@ -29,6 +39,9 @@ var mergeCmd = &cobra.Command{
 		sourceRef := MustParseRefURI("source ref", args[0])
 		destinationRef := MustParseRefURI("destination ref", args[1])
 		strategy := MustString(cmd.Flags().GetString("strategy"))
+		mode := MustString(cmd.Flags().GetString("mode"))
+		strategyRules := parseMergeStrategyRules(MustStringSlice(cmd.Flags().GetStringArray("rule")))
+		signKey := MustString(cmd.Flags().GetString(signKeyFlagName))
 		Fmt("Source: %s\nDestination: %s\n", sourceRef.String(), destinationRef)
 		if destinationRef.Repository != sourceRef.Repository {
 			Die("both references must belong to the same repository", 1)
@@ -37,12 +50,34 @@ var mergeCmd = &cobra.Command{
 		if strategy != "dest-wins" && strategy != "source-wins" && strategy != "" {
 			Die("Invalid strategy value. Expected \"dest-wins\" or \"source-wins\"", 1)
 		}
+		if mode != "ff" && mode != "ff-only" && mode != "squash" && mode != "" {
+			Die("Invalid mode value. Expected \"ff\", \"ff-only\" or \"squash\"", 1)
+		}
 
-		resp, err := client.MergeIntoBranchWithResponse(cmd.Context(), destinationRef.Repository, sourceRef.Ref, destinationRef.Ref, api.MergeIntoBranchJSONRequestBody{Strategy: &strategy})
+		if MustBool(cmd.Flags().GetBool("dry-run")) {
+			previewMerge(cmd.Context(), client, sourceRef.Repository, sourceRef.Ref, destinationRef.Ref, strategy, strategyRules)
+			return
+		}
+
+		body := api.MergeIntoBranchJSONRequestBody{Strategy: &strategy}
+		if mode != "" {
+			body.Mode = &mode
+		}
+		if len(strategyRules) > 0 {
+			body.StrategyRules = &strategyRules
+		}
+		resp, err := client.MergeIntoBranchWithResponse(cmd.Context(), destinationRef.Repository, sourceRef.Ref, destinationRef.Ref, body)
 		if resp != nil && resp.JSON409 != nil {
+			result := resp.JSON409
+			if result.Conflicts != nil {
+				printMergeConflicts(*result.Conflicts, result.ConflictsTruncated != nil && *result.ConflictsTruncated)
+			}
 			Die("Conflict found.", 1)
 		}
 		DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusOK)
+		if signKey != "" {
+			signCommit(cmd.Context(), client, destinationRef.Repository, resp.JSON200.Reference, signKey)
+		}
 
 		Write(mergeCreateTemplate, struct {
 			Merge  FromTo
@@ -54,8 +89,106 @@ var mergeCmd = &cobra.Command{
 	},
 }
 
+// parseMergeStrategyRules parses merge strategy rules given as <pattern>=<strategy>
+func parseMergeStrategyRules(rules []string) []api.MergeStrategyRule {
+	strategyRules := make([]api.MergeStrategyRule, 0, len(rules))
+	for _, rule := range rules {
+		idx := strings.LastIndex(rule, "=")
+		if idx <= 0 {
+			DieFmt("Invalid rule '%s'. Expected <pattern>=<strategy>", rule)
+		}
+		pattern, strategy := rule[:idx], rule[idx+1:]
+		if strategy != "dest-wins" && strategy != "source-wins" {
+			DieFmt("Invalid rule '%s' strategy. Expected \"dest-wins\" or \"source-wins\"", rule)
+		}
+		strategyRules = append(strategyRules, api.MergeStrategyRule{Pattern: pattern, Strategy: strategy})
+	}
+	return strategyRules
+}
+
+func previewMerge(ctx context.Context, client api.ClientWithResponsesInterface, repository, sourceRef, destinationBranch, strategy string, strategyRules []api.MergeStrategyRule) {
+	params := &api.MergePreviewParams{}
+	if strategy != "" {
+		params.Strategy = &strategy
+	}
+	if len(strategyRules) > 0 {
+		rules := make([]string, 0, len(strategyRules))
+		for _, rule := range strategyRules {
+			rules = append(rules, rule.Pattern+"="+rule.Strategy)
+		}
+		params.StrategyRule = &rules
+	}
+	resp, err := client.MergePreviewWithResponse(ctx, repository, sourceRef, destinationBranch, params)
+	DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusOK)
+	preview := resp.JSON200
+	Write(mergePreviewTemplate, preview)
+	rows := make([][]interface{}, 0, len(preview.Summary))
+	for _, s := range preview.Summary {
+		prefix := s.Prefix
+		if prefix == "" {
+			prefix = "(root)"
+		}
+		rows = append(rows, []interface{}{prefix, s.Added, s.Removed, s.Changed, s.Conflict})
+	}
+	if len(rows) == 0 {
+		Fmt("No changes\n")
+	} else {
+		Write(resourceListTemplate, struct {
+			Table      *Table
+			Pagination *Pagination
+		}{
+			Table: &Table{
+				Headers: []interface{}{"Prefix", "Added", "Removed", "Changed", "Conflict"},
+				Rows:    rows,
+			},
+		})
+	}
+	if len(preview.Conflicts) > 0 {
+		printMergeConflicts(preview.Conflicts, preview.ConflictsTruncated != nil && *preview.ConflictsTruncated)
+		Die("Conflict found.", 1)
+	}
+}
+
+func printMergeConflicts(conflicts []api.MergeConflict, truncated bool) {
+	if len(conflicts) == 0 {
+		return
+	}
+	conflictEntryIdentity := func(entry *api.MergeConflictEntry) string {
+		if entry == nil {
+			return "(none)"
+		}
+		if entry.Checksum != nil && *entry.Checksum != "" {
+			return *entry.Checksum
+		}
+		return entry.Identity
+	}
+	rows := make([][]interface{}, 0, len(conflicts))
+	for _, conflict := range conflicts {
+		rows = append(rows, []interface{}{
+			conflict.Path,
+			conflictEntryIdentity(conflict.Source),
+			conflictEntryIdentity(conflict.Destination),
+			conflictEntryIdentity(conflict.Base),
+		})
+	}
+	Write(mergeConflictsTemplate, struct {
+		Table     *Table
+		Truncated bool
+	}{
+		Table: &Table{
+			Headers: []interface{}{"Path", "Source", "Destination", "Base"},
+			Rows:    rows,
+		},
+		Truncated: truncated,
+	})
+}
+
 //nolint:gochecknoinits
 func init() {
 	rootCmd.AddCommand(mergeCmd)
-	mergeCmd.Flags().String("strategy", "", "In case of a merge conflict, this option will force the merge process to automatically favor changes from the dest branch (\"dest-wins\") or from the source branch(\"source-wins\"). In case no selection is made, the merge process will fail in case of a conflict")
+	mergeCmd.Flags().Bool("dry-run", false, "show the merge base, the changes by top-level prefix and the conflicts of the merge, without merging")
+	mergeCmd.Flags().String("mode", "", "how to record the merge on the destination branch: \"ff\" fast-forwards the destination when possible and creates a merge commit otherwise, \"ff-only\" fails unless the destination can be fast-forwarded, \"squash\" creates a single-parent commit with the merged content. By default a merge commit is always created")
+	mergeCmd.Flags().StringArray("rule", nil, "merge strategy rule in the form of <pattern>=<strategy>, resolving conflicts on paths that match the prefix or glob pattern by \"dest-wins\" or \"source-wins\". Rules are applied in order before the repository merge strategy rules, other paths use --strategy. Can be repeated")
+	mergeCmd.Flags().String(signKeyFlagName, "", "sign the merge commit with the unencrypted SSH private key in this file, registered as a signing key of the committer")
+	mergeCmd.Flags().String("strategy", "", "In case of a merge conflict, this option will force the merge process to automatically favor changes from the dest branch (\"dest-wins\") or from the source branch(\"source-wins\"). In case no selection is made, the merge process will fail in case of a conflict. Takes precedence over the repository merge strategy rules, which are not applied when it is set")
 }
@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"net/http"
+
+	"github.com/spf13/cobra"
+	"github.com/treeverse/lakefs/pkg/api"
+)
+
+var mergeRulesCmd = &cobra.Command{
+	Use:   "merge-rules",
+	Short: "Manage the repository merge strategy rules",
+	Long:  "Manage the merge strategy rules applied by default to merges into any branch of the repository. Each rule resolves conflicts on paths that match its prefix or glob pattern by \"dest-wins\" or \"source-wins\". The rules apply to merges that give no --strategy, after their --rule rules.",
+}
+
+var mergeRulesListCmd = &cobra.Command{
+	Use:     "list <repo uri>",
+	Short:   "List the repository merge strategy rules",
+	Example: "lakectl merge-rules list lakefs://<repository>",
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		client := getClient()
+		u := MustParseRepoURI("repository", args[0])
+		resp, err := client.GetMergeStrategyRulesWithResponse(cmd.Context(), u.Repository)
+		DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusOK)
+		rows := make([][]interface{}, len(resp.JSON200.Rules))
+		for i, rule := range resp.JSON200.Rules {
+			rows[i] = []interface{}{i + 1, rule.Pattern, rule.Strategy}
+		}
+		PrintTable(rows, []interface{}{"#", "Pattern", "Strategy"}, &api.Pagination{
+			HasMore: false,
+			Results: len(rows),
+		}, len(rows))
+	},
+}
+
+var mergeRulesSetCmd = &cobra.Command{
+	Use:     "set <repo uri>",
+	Short:   "Set the repository merge strategy rules",
+	Long:    "Replace the repository merge strategy rules by the given rules, in order. Setting no rules clears them.",
+	Example: "lakectl merge-rules set lakefs://<repository> --rule 'generated/=source-wins' --rule '**/*.lock=dest-wins'",
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		client := getClient()
+		u := MustParseRepoURI("repository", args[0])
+		rules := parseMergeStrategyRules(MustStringSlice(cmd.Flags().GetStringArray("rule")))
+		resp, err := client.SetMergeStrategyRulesWithResponse(cmd.Context(), u.Repository, api.SetMergeStrategyRulesJSONRequestBody{
+			Rules: rules,
+		})
+		DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusNoContent)
+	},
+}
+
+//nolint:gochecknoinits
+func init() {
+	rootCmd.AddCommand(mergeRulesCmd)
+	mergeRulesCmd.AddCommand(mergeRulesListCmd)
+	mergeRulesCmd.AddCommand(mergeRulesSetCmd)
+	mergeRulesSetCmd.Flags().StringArray("rule", nil, "merge strategy rule in the form of <pattern>=<strategy>. Can be repeated")
+}
@@ -11,6 +11,9 @@ import (
 const (
 	DefaultBranch     = "main"
 	repoCreateCmdArgs = 2
+	repoRenameCmdArgs = 2
+
+	repoRenameAliasPeriodFlagName = "alias-period"
 )
 
 // repoCmd represents the repo command
@@ -127,7 +130,49 @@ var repoDeleteCmd = &cobra.Command{
 		}
 		resp, err := clt.DeleteRepositoryWithResponse(cmd.Context(), u.Repository)
 		DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusNoContent)
-		Fmt("Repository '%s' deleted\n", u.Repository)
+		Fmt("Repository '%s' deleted, it can be restored using 'lakectl repo restore' until it is purged\n", u.Repository)
+	},
+}
+
+var repoRestoreCmd = &cobra.Command{
+	Use:     "restore <repository uri>",
+	Short:   "Restore a deleted repository",
+	Long:    "Restore a deleted repository before its deletion retention period passes and it is purged",
+	Example: "lakectl repo restore lakefs://some-repo-name",
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		clt := getClient()
+		u := MustParseRepoURI("repository", args[0])
+		resp, err := clt.RestoreRepositoryWithResponse(cmd.Context(), u.Repository)
+		DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusOK)
+		Fmt("Repository '%s' restored\n", resp.JSON200.Id)
+	},
+}
+
+var repoRenameCmd = &cobra.Command{
+	Use:   "rename <repository uri> <new name>",
+	Short: "Rename a repository",
+	Long: `Rename a repository. Access policies referring to the repository by name are not updated.
+Use --alias-period to keep the current name working in the S3 gateway while clients move to the new name.`,
+	Example: "lakectl repo rename lakefs://some-repo-name other-repo-name --alias-period 168h",
+	Args:    cobra.ExactArgs(repoRenameCmdArgs),
+	Run: func(cmd *cobra.Command, args []string) {
+		aliasPeriod, err := cmd.Flags().GetDuration(repoRenameAliasPeriodFlagName)
+		if err != nil {
+			DieErr(err)
+		}
+		clt := getClient()
+		u := MustParseRepoURI("repository", args[0])
+		body := api.RenameRepositoryJSONRequestBody{
+			Name: args[1],
+		}
+		if aliasPeriod > 0 {
+			seconds := int64(aliasPeriod / time.Second)
+			body.AliasPeriod = &seconds
+		}
+		resp, err := clt.RenameRepositoryWithResponse(cmd.Context(), u.Repository, body)
+		DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusOK)
+		Fmt("Repository '%s' renamed to '%s'\n", u.Repository, resp.JSON200.Id)
 	},
 }
 
@@ -138,6 +183,8 @@ func init() {
 	repoCmd.AddCommand(repoCreateCmd)
 	repoCmd.AddCommand(repoCreateBareCmd)
 	repoCmd.AddCommand(repoDeleteCmd)
+	repoCmd.AddCommand(repoRestoreCmd)
+	repoCmd.AddCommand(repoRenameCmd)
 
 	repoListCmd.Flags().Int("amount", defaultAmountArgumentValue, "number of results to return")
 	repoListCmd.Flags().String("after", "", "show results after this value (used for pagination)")
@@ -147,4 +194,6 @@ func init() {
 	repoCreateBareCmd.Flags().StringP("default-branch", "d", DefaultBranch, "the default branch name of this repository (will not be created)")
 
 	AssignAutoConfirmFlag(repoDeleteCmd.Flags())
+
+	repoRenameCmd.Flags().Duration(repoRenameAliasPeriodFlagName, 0, "keep resolving the current name to the repository in the S3 gateway for this period")
 }
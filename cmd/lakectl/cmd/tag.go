@@ -9,6 +9,21 @@ import (
 
 const tagCreateRequiredArgs = 2
 
+const tagShowTemplate = `Tag:       {{ .Id|yellow }}
+Commit ID: {{ .CommitId }}
+{{ with .Annotation -}}
+Tagger:    {{ .Tagger }}
+Date:      {{ .CreationDate|date }}
+
+	{{ .Message }}
+	{{ if .Metadata }}
+	{{ range $key, $value := .Metadata.AdditionalProperties }}
+		{{ $key }} = {{ $value }}
+	{{ end -}}
+	{{ end }}
+{{ end -}}
+`
+
 // tagCmd represents the tag command
 var tagCmd = &cobra.Command{
 	Use:   "tag",
@@ -63,10 +78,12 @@ var tagListCmd = &cobra.Command{
 }
 
 var tagCreateCmd = &cobra.Command{
-	Use:     "create <tag uri> <commit uri>",
-	Short:   "Create a new tag in a repository",
-	Example: "lakectl tag create lakefs://example-repo/example-tag lakefs://example-repo/2397cc9a9d04c20a4e5739b42c1dd3d8ba655c0b3a3b974850895a13d8bf9917",
-	Args:    cobra.ExactArgs(tagCreateRequiredArgs),
+	Use:   "create <tag uri> <commit uri>",
+	Short: "Create a new tag in a repository",
+	Long:  "Create a new tag in a repository. Passing a message or metadata creates an annotated tag, which also records the tagger and the creation time.",
+	Example: `lakectl tag create lakefs://example-repo/example-tag lakefs://example-repo/2397cc9a9d04c20a4e5739b42c1dd3d8ba655c0b3a3b974850895a13d8bf9917
+lakectl tag create lakefs://example-repo/v1.0 lakefs://example-repo/main -m "release v1.0" --meta model_version=3`,
+	Args: cobra.ExactArgs(tagCreateRequiredArgs),
 	Run: func(cmd *cobra.Command, args []string) {
 		tagURI := MustParseRefURI("tag uri", args[0])
 		commitURI := MustParseRefURI("commit uri", args[1])
@@ -75,6 +92,11 @@ var tagCreateCmd = &cobra.Command{
 		client := getClient()
 		ctx := cmd.Context()
 		force, _ := cmd.Flags().GetBool("force")
+		message := MustString(cmd.Flags().GetString(messageFlagName))
+		kvPairs, err := getKV(cmd, metaFlagName)
+		if err != nil {
+			DieErr(err)
+		}
 
 		if tagURI.Repository != commitURI.Repository {
 			Die("both references must belong to the same repository", 1)
@@ -91,14 +113,20 @@ var tagCreateCmd = &cobra.Command{
 			}
 		}
 
-		resp, err := client.CreateTagWithResponse(ctx, tagURI.Repository, api.CreateTagJSONRequestBody{
+		body := api.CreateTagJSONRequestBody{
 			Id:  tagURI.Ref,
 			Ref: commitURI.Ref,
-		})
+		}
+		if message != "" {
+			body.Message = &message
+		}
+		if len(kvPairs) > 0 {
+			body.Metadata = &api.TagCreation_Metadata{AdditionalProperties: kvPairs}
+		}
+		resp, err := client.CreateTagWithResponse(ctx, tagURI.Repository, body)
 		DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusCreated)
 
-		commitID := *resp.JSON201
-		Fmt("Created tag '%s' (%s)\n", tagURI.Ref, commitID)
+		Fmt("Created tag '%s' (%s)\n", tagURI.Ref, resp.JSON201.CommitId)
 	},
 }
 
@@ -123,7 +151,7 @@ var tagDeleteCmd = &cobra.Command{
 
 var tagShowCmd = &cobra.Command{
 	Use:   "show <tag uri>",
-	Short: "Show tag's commit reference",
+	Short: "Show tag's commit reference and annotation",
 	Args:  cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		client := getClient()
@@ -133,13 +161,15 @@ var tagShowCmd = &cobra.Command{
 		ctx := cmd.Context()
 		resp, err := client.GetTagWithResponse(ctx, u.Repository, u.Ref)
 		DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusOK)
-		Fmt("%s %s", resp.JSON200.Id, resp.JSON200.CommitId)
+		Write(tagShowTemplate, resp.JSON200)
 	},
 }
 
 //nolint:gochecknoinits
 func init() {
 	tagCreateCmd.Flags().BoolP("force", "f", false, "override the tag if it exists")
+	tagCreateCmd.Flags().StringP(messageFlagName, "m", "", "annotated tag message")
+	tagCreateCmd.Flags().StringSlice(metaFlagName, []string{}, "annotated tag metadata key value pair in the form of key=value")
 
 	rootCmd.AddCommand(tagCmd)
 	tagCmd.AddCommand(tagCreateCmd, tagDeleteCmd, tagListCmd, tagShowCmd)
@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"net/http"
+
+	"github.com/spf13/cobra"
+	"github.com/treeverse/lakefs/pkg/api"
+)
+
+const (
+	tagProtectAddCmdArgs    = 2
+	tagProtectDeleteCmdArgs = 2
+)
+
+var tagProtectCmd = &cobra.Command{
+	Use:   "tag-protect",
+	Short: "Create and manage tag protection rules",
+	Long:  "Define tag protection rules to keep release tags immutable. Protected tags cannot be deleted, and therefore cannot be re-created pointing at a different commit.",
+}
+
+var tagProtectListCmd = &cobra.Command{
+	Use:     "list <repo uri>",
+	Short:   "List all tag protection rules",
+	Example: "lakectl tag-protect list lakefs://<repository>",
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		client := getClient()
+		u := MustParseRepoURI("repository", args[0])
+		resp, err := client.GetTagProtectionRulesWithResponse(cmd.Context(), u.Repository)
+		DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusOK)
+		patterns := make([][]interface{}, len(*resp.JSON200))
+		for i, rule := range *resp.JSON200 {
+			patterns[i] = []interface{}{rule.Pattern}
+		}
+		PrintTable(patterns, []interface{}{"Tag Name Pattern"}, &api.Pagination{
+			HasMore: false,
+			Results: len(patterns),
+		}, len(patterns))
+	},
+}
+
+var tagProtectAddCmd = &cobra.Command{
+	Use:     "add <repo uri> <pattern>",
+	Short:   "Add a tag protection rule",
+	Long:    "Add a tag protection rule for a given tag name pattern",
+	Example: "lakectl tag-protect add lakefs://<repository> 'v*'",
+	Args:    cobra.ExactArgs(tagProtectAddCmdArgs),
+	Run: func(cmd *cobra.Command, args []string) {
+		client := getClient()
+		u := MustParseRepoURI("repository", args[0])
+		resp, err := client.CreateTagProtectionRuleWithResponse(cmd.Context(), u.Repository, api.CreateTagProtectionRuleJSONRequestBody{
+			Pattern: args[1],
+		})
+		DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusNoContent)
+	},
+}
+
+var tagProtectDeleteCmd = &cobra.Command{
+	Use:     "delete <repo uri> <pattern>",
+	Short:   "Delete a tag protection rule",
+	Long:    "Delete a tag protection rule for a given tag name pattern",
+	Example: "lakectl tag-protect delete lakefs://<repository> 'v*'",
+	Args:    cobra.ExactArgs(tagProtectDeleteCmdArgs),
+	Run: func(cmd *cobra.Command, args []string) {
+		client := getClient()
+		u := MustParseRepoURI("repository", args[0])
+		resp, err := client.DeleteTagProtectionRuleWithResponse(cmd.Context(), u.Repository, api.DeleteTagProtectionRuleJSONRequestBody{
+			Pattern: args[1],
+		})
+		DieOnErrorOrUnexpectedStatusCode(resp, err, http.StatusNoContent)
+	},
+}
+
+//nolint:gochecknoinits
+func init() {
+	rootCmd.AddCommand(tagProtectCmd)
+	tagProtectCmd.AddCommand(tagProtectAddCmd)
+	tagProtectCmd.AddCommand(tagProtectListCmd)
+	tagProtectCmd.AddCommand(tagProtectDeleteCmd)
+}
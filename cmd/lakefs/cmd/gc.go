@@ -0,0 +1,163 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/treeverse/lakefs/pkg/catalog"
+	"github.com/treeverse/lakefs/pkg/config"
+	"github.com/treeverse/lakefs/pkg/db"
+	"github.com/treeverse/lakefs/pkg/uri"
+)
+
+const (
+	GCRunIDFlagName         = "run-id"
+	GCPreviousRunIDFlagName = "previous-run-id"
+	GCParallelismFlagName   = "parallelism"
+	GCGracePeriodFlagName   = "grace-period"
+	GCDefaultParallelism    = 10
+)
+
+var gcCmd = &cobra.Command{
+	Use:   "gc",
+	Short: "Garbage collection of objects that only expired commits reference",
+}
+
+var gcRunCmd = &cobra.Command{
+	Use:   "run <repository uri>",
+	Short: "Remove the objects that only expired commits reference",
+	Long: `Expire commits according to the repository garbage collection rules, and remove the objects in the repository
+storage namespace that no active commit or staged change references. The addresses of the objects are saved under the
+garbage collection addresses location of the repository before they are removed, so an interrupted run can be resumed
+with its run ID.`,
+	Example: `lakefs gc run --dry-run lakefs://example-repo
+	Save the addresses of the objects to remove, without removing them. Prints the run ID.
+
+lakefs gc run --run-id <run id> lakefs://example-repo
+	Remove the objects whose addresses run <run id> saved, resuming it if it was interrupted.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		flags := cmd.Flags()
+		dryRun, _ := flags.GetBool(DryRunFlagName)
+		runID, _ := flags.GetString(GCRunIDFlagName)
+		previousRunID, _ := flags.GetString(GCPreviousRunIDFlagName)
+		parallelism, _ := flags.GetInt(GCParallelismFlagName)
+
+		u := mustParseRepositoryURI(args[0])
+		ctx := cmd.Context()
+		c, closeCatalog := mustBuildGCCatalog(ctx, loadConfig())
+		defer closeCatalog()
+
+		res, err := c.RunGarbageCollection(ctx, u.Repository, catalog.GarbageCollectionParams{
+			RunID:         runID,
+			PreviousRunID: previousRunID,
+			DryRun:        dryRun,
+			Parallelism:   parallelism,
+		})
+		if res != nil {
+			fmt.Printf("Run ID: %s\nAddresses: %d, saved at %s\nRemoved: %d\n", res.RunID, res.Addresses, res.AddressesLocation, res.Removed)
+		}
+		if err != nil {
+			fmt.Printf("Garbage collection failed: %s\n", err)
+			if res != nil {
+				fmt.Printf("Resume it with --%s %s\n", GCRunIDFlagName, res.RunID)
+			}
+			os.Exit(1)
+		}
+	},
+}
+
+var gcUncommittedCmd = &cobra.Command{
+	Use:   "uncommitted <repository uri>",
+	Short: "Remove the staged objects that no staging area or commit references",
+	Long: `Remove the objects in the repository storage namespace that were staged, and that no branch, stash or commit
+references anymore.  Objects staged during the grace period are never removed, so uploads that were not staged yet
+are kept.`,
+	Example: `lakefs gc uncommitted --dry-run lakefs://example-repo
+	Count the unreferenced staged objects, without removing them.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		flags := cmd.Flags()
+		dryRun, _ := flags.GetBool(DryRunFlagName)
+		parallelism, _ := flags.GetInt(GCParallelismFlagName)
+
+		u := mustParseRepositoryURI(args[0])
+		cfg := loadConfig()
+		gracePeriod := cfg.GetUncommittedGCGracePeriod()
+		if flags.Changed(GCGracePeriodFlagName) {
+			gracePeriod, _ = flags.GetDuration(GCGracePeriodFlagName)
+		}
+		ctx := cmd.Context()
+		c, closeCatalog := mustBuildGCCatalog(ctx, cfg)
+		defer closeCatalog()
+
+		res, err := c.RemoveUncommittedGarbage(ctx, u.Repository, catalog.UncommittedGCParams{
+			GracePeriod: gracePeriod,
+			DryRun:      dryRun,
+			Parallelism: parallelism,
+		})
+		if err != nil {
+			fmt.Printf("Uncommitted garbage collection failed: %s\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Candidates: %d\nUnreferenced: %d\nRemoved: %d\n", res.Candidates, res.Unreferenced, res.Removed)
+	},
+}
+
+func mustParseRepositoryURI(s string) *uri.URI {
+	u, err := uri.Parse(s)
+	if err != nil || !u.IsRepository() {
+		fmt.Printf("Invalid 'repository': %s\n", uri.ErrInvalidRefURI)
+		os.Exit(1)
+	}
+	return u
+}
+
+// mustBuildGCCatalog returns a catalog over the database of cfg, and a function closing both
+func mustBuildGCCatalog(ctx context.Context, cfg *config.Config) (*catalog.Catalog, func()) {
+	dbParams := cfg.GetDatabaseParams()
+	dbPool := db.BuildDatabaseConnection(ctx, dbParams)
+
+	err := db.ValidateSchemaUpToDate(ctx, dbPool, dbParams)
+	if errors.Is(err, db.ErrSchemaNotCompatible) {
+		fmt.Println("Migration version mismatch, for more information see https://docs.lakefs.io/deploying-aws/upgrade.html")
+		os.Exit(1)
+	}
+	if err != nil {
+		fmt.Printf("%s\n", err)
+		os.Exit(1)
+	}
+
+	c, err := catalog.New(ctx, catalog.Config{
+		Config: cfg,
+		DB:     dbPool,
+	})
+	if err != nil {
+		fmt.Printf("Failed to create catalog: %s\n", err)
+		os.Exit(1)
+	}
+	return c, func() {
+		_ = c.Close()
+		dbPool.Close()
+	}
+}
+
+//nolint:gochecknoinits
+func init() {
+	rootCmd.AddCommand(gcCmd)
+	gcCmd.AddCommand(gcRunCmd)
+	f := gcRunCmd.Flags()
+	f.Bool(DryRunFlagName, false, "Save the addresses of the objects to remove without removing them")
+	f.String(GCRunIDFlagName, "", "Resume the run with this ID instead of expiring commits again")
+	f.String(GCPreviousRunIDFlagName, "", "ID of the last completed run, commits it expired are not expired again")
+	f.Int(GCParallelismFlagName, GCDefaultParallelism, "Number of objects removed concurrently")
+
+	gcCmd.AddCommand(gcUncommittedCmd)
+	f = gcUncommittedCmd.Flags()
+	f.Bool(DryRunFlagName, false, "Count the objects to remove without removing them")
+	f.Duration(GCGracePeriodFlagName, config.DefaultGravelerUncommittedGCGracePeriod, "Time after staging during which objects are kept, defaults to the configured grace period")
+	f.Int(GCParallelismFlagName, GCDefaultParallelism, "Number of objects removed concurrently")
+}
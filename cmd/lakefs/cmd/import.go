@@ -68,7 +68,7 @@ func runImport(cmd *cobra.Command, args []string) (statusCode int) {
 	baseCommit, _ := flags.GetString(BaseCommitFlagName)
 
 	cfg := loadConfig()
-	ctx := cmd.Context()
+	ctx := graveler.WithBranchReflogUser(cmd.Context(), CommitterName)
 	dbParams := cfg.GetDatabaseParams()
 	dbPool := db.BuildDatabaseConnection(ctx, dbParams)
 	defer dbPool.Close()
@@ -217,7 +217,7 @@ func runImport(cmd *cobra.Command, args []string) (statusCode int) {
 	if withMerge {
 		fmt.Printf("Merging import changes into lakefs://%s/%s/\n", repoName, repo.DefaultBranch)
 		msg := fmt.Sprintf(onboard.CommitMsgTemplate, stats.CommitRef)
-		commitLog, err := c.Merge(ctx, repoName, onboard.DefaultImportBranchName, repo.DefaultBranch, CommitterName, msg, nil, "")
+		commitLog, err := c.Merge(ctx, repoName, onboard.DefaultImportBranchName, repo.DefaultBranch, CommitterName, msg, nil, "", nil, "")
 		if err != nil {
 			fmt.Printf("Merge failed: %s\n", err)
 			return 1
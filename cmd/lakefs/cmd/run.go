@@ -122,16 +122,6 @@ var runCmd = &cobra.Command{
 		registerPrometheusCollector(dbPool)
 		migrator := db.NewDatabaseMigrator(dbParams)
 
-		c, err := catalog.New(ctx, catalog.Config{
-			Config: cfg,
-			DB:     dbPool,
-			LockDB: lockdbPool,
-		})
-		if err != nil {
-			logger.WithError(err).Fatal("failed to create catalog")
-		}
-		defer func() { _ = c.Close() }()
-
 		multipartsTracker := multiparts.NewTracker(dbPool)
 
 		// init authentication
@@ -157,6 +147,19 @@ var runCmd = &cobra.Command{
 		}
 		metadata := stats.NewMetadata(ctx, logger, blockstoreType, authMetadataManager, cloudMetadataProvider)
 		bufferedCollector := stats.NewBufferedCollector(metadata.InstallationID, cfg)
+
+		c, err := catalog.New(ctx, catalog.Config{
+			Config:         cfg,
+			DB:             dbPool,
+			LockDB:         lockdbPool,
+			StatsCollector: bufferedCollector,
+		})
+		if err != nil {
+			logger.WithError(err).Fatal("failed to create catalog")
+		}
+		defer func() { _ = c.Close() }()
+		c.StartBackgroundTasks(ctx, cfg)
+
 		// init block store
 		blockStore, err := factory.BuildBlockAdapter(ctx, bufferedCollector, cfg)
 		if err != nil {
@@ -178,6 +181,9 @@ var runCmd = &cobra.Command{
 		c.SetHooksHandler(actionsService)
 		defer actionsService.Stop()
 
+		// verify commit signatures with the signing keys of users
+		c.SetSignatureVerifier(authService)
+
 		auditChecker := version.NewDefaultAuditChecker(cfg.GetSecurityAuditCheckURL())
 		defer auditChecker.Close()
 		if version.Version != version.UnreleasedVersion {
@@ -18,10 +18,15 @@ type EventInfo struct {
 	CommitMessage  string            `json:"commit_message"`
 	Committer      string            `json:"committer"`
 	CommitMetadata map[string]string `json:"commit_metadata,omitempty"`
+	CommitPrefixes []string          `json:"commit_prefixes,omitempty"`
 }
 
 func marshalEventInformation(actionName, hookID string, record graveler.HookRecord) ([]byte, error) {
 	now := time.Now()
+	var prefixes []string
+	for _, prefix := range record.Prefixes {
+		prefixes = append(prefixes, prefix.String())
+	}
 	info := EventInfo{
 		EventType:      string(record.EventType),
 		EventTime:      now.UTC().Format(time.RFC3339),
@@ -33,6 +38,7 @@ func marshalEventInformation(actionName, hookID string, record graveler.HookReco
 		CommitMessage:  record.Commit.Message,
 		Committer:      record.Commit.Committer,
 		CommitMetadata: record.Commit.Metadata,
+		CommitPrefixes: prefixes,
 	}
 	return json.Marshal(info)
 }
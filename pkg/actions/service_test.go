@@ -55,6 +55,7 @@ func TestServiceRun(t *testing.T) {
 			Committer: "committer",
 			Metadata:  map[string]string{"key": "value"},
 		},
+		Prefixes: []graveler.Key{graveler.Key("public/")},
 	}
 	const actionName = "test action"
 	const webhookID = "webhook_id"
@@ -421,6 +422,13 @@ func checkEvent(t *testing.T, record graveler.HookRecord, event actions.EventInf
 	if diff := deep.Equal(event.CommitMetadata, map[string]string(record.Commit.Metadata)); diff != nil {
 		t.Errorf("Webhook post Metadata diff=%s", diff)
 	}
+	var prefixes []string
+	for _, prefix := range record.Prefixes {
+		prefixes = append(prefixes, prefix.String())
+	}
+	if diff := deep.Equal(event.CommitPrefixes, prefixes); diff != nil {
+		t.Errorf("Webhook post Prefixes diff=%s", diff)
+	}
 }
 
 func TestMissingEnvVar(t *testing.T) {
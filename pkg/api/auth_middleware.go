@@ -13,6 +13,7 @@ import (
 	"github.com/golang-jwt/jwt"
 	"github.com/treeverse/lakefs/pkg/auth"
 	"github.com/treeverse/lakefs/pkg/auth/model"
+	"github.com/treeverse/lakefs/pkg/graveler"
 	"github.com/treeverse/lakefs/pkg/logging"
 )
 
@@ -47,7 +48,10 @@ func AuthMiddleware(logger logging.Logger, swagger *openapi3.Swagger, authentica
 				return
 			}
 			if user != nil {
-				r = r.WithContext(context.WithValue(r.Context(), UserContextKey, user))
+				ctx := context.WithValue(r.Context(), UserContextKey, user)
+				// record the user on the branch head changes made by the request
+				ctx = graveler.WithBranchReflogUser(ctx, user.Username)
+				r = r.WithContext(ctx)
 			}
 			next.ServeHTTP(w, r)
 		})
@@ -13,6 +13,8 @@ import (
 	"path/filepath"
 	"reflect"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -21,6 +23,7 @@ import (
 	nanoid "github.com/matoous/go-nanoid/v2"
 	"github.com/treeverse/lakefs/pkg/actions"
 	"github.com/treeverse/lakefs/pkg/auth"
+	"github.com/treeverse/lakefs/pkg/auth/keys"
 	"github.com/treeverse/lakefs/pkg/auth/model"
 	"github.com/treeverse/lakefs/pkg/block"
 	"github.com/treeverse/lakefs/pkg/block/adapter"
@@ -960,6 +963,97 @@ func (c *Controller) DeleteCredentials(w http.ResponseWriter, r *http.Request, u
 	writeResponse(w, http.StatusNoContent, nil)
 }
 
+func (c *Controller) ListUserSigningKeys(w http.ResponseWriter, r *http.Request, userID string, params ListUserSigningKeysParams) {
+	if !c.authorize(w, r, permissions.Node{
+		Permission: permissions.Permission{
+			Action:   permissions.ListCredentialsAction,
+			Resource: permissions.UserArn(userID),
+		},
+	}) {
+		return
+	}
+	ctx := r.Context()
+	c.LogAction(ctx, "list_user_signing_keys")
+	signingKeys, paginator, err := c.Auth.ListUserSigningKeys(ctx, userID, &model.PaginationParams{
+		After:  paginationAfter(params.After),
+		Prefix: paginationPrefix(params.Prefix),
+		Amount: paginationAmount(params.Amount),
+	})
+	if handleAPIError(w, err) {
+		return
+	}
+
+	response := SigningKeyList{
+		Results: make([]SigningKey, 0, len(signingKeys)),
+		Pagination: Pagination{
+			HasMore:    paginator.NextPageToken != "",
+			NextOffset: paginator.NextPageToken,
+			Results:    paginator.Amount,
+		},
+	}
+	for _, k := range signingKeys {
+		response.Results = append(response.Results, serializeSigningKey(k))
+	}
+	writeResponse(w, http.StatusOK, response)
+}
+
+func (c *Controller) AddSigningKey(w http.ResponseWriter, r *http.Request, body AddSigningKeyJSONRequestBody, userID string) {
+	if !c.authorize(w, r, permissions.Node{
+		Permission: permissions.Permission{
+			Action:   permissions.CreateCredentialsAction,
+			Resource: permissions.UserArn(userID),
+		},
+	}) {
+		return
+	}
+	ctx := r.Context()
+	c.LogAction(ctx, "add_signing_key")
+	signingKey, err := c.Auth.AddSigningKey(ctx, userID, body.KeyType, body.PublicKey)
+	if errors.Is(err, keys.ErrUnknownSigningKeyType) || errors.Is(err, keys.ErrInvalidSigningKey) {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if errors.Is(err, db.ErrAlreadyExists) {
+		writeError(w, http.StatusConflict, "signing key already exists")
+		return
+	}
+	if handleAPIError(w, err) {
+		return
+	}
+	writeResponse(w, http.StatusCreated, serializeSigningKey(signingKey))
+}
+
+func (c *Controller) DeleteSigningKey(w http.ResponseWriter, r *http.Request, body DeleteSigningKeyJSONRequestBody, userID string) {
+	if !c.authorize(w, r, permissions.Node{
+		Permission: permissions.Permission{
+			Action:   permissions.DeleteCredentialsAction,
+			Resource: permissions.UserArn(userID),
+		},
+	}) {
+		return
+	}
+	ctx := r.Context()
+	c.LogAction(ctx, "delete_signing_key")
+	err := c.Auth.DeleteSigningKey(ctx, userID, body.KeyId)
+	if errors.Is(err, auth.ErrNotFound) {
+		writeError(w, http.StatusNotFound, "signing key not found")
+		return
+	}
+	if handleAPIError(w, err) {
+		return
+	}
+	writeResponse(w, http.StatusNoContent, nil)
+}
+
+func serializeSigningKey(k *model.SigningKey) SigningKey {
+	return SigningKey{
+		CreationDate: k.CreatedAt.Unix(),
+		KeyId:        k.KeyID,
+		KeyType:      k.KeyType,
+		PublicKey:    k.PublicKey,
+	}
+}
+
 func (c *Controller) GetCredentials(w http.ResponseWriter, r *http.Request, userID string, accessKeyID string) {
 	if !c.authorize(w, r, permissions.Node{
 		Permission: permissions.Permission{
@@ -1289,13 +1383,70 @@ func (c *Controller) DeleteRepository(w http.ResponseWriter, r *http.Request, re
 		writeError(w, http.StatusNotFound, "repository not found")
 		return
 	}
-	if err != nil {
-		writeError(w, http.StatusInternalServerError, err)
+	if handleAPIError(w, err) {
 		return
 	}
 	writeResponse(w, http.StatusNoContent, nil)
 }
 
+func (c *Controller) RestoreRepository(w http.ResponseWriter, r *http.Request, repository string) {
+	if !c.authorize(w, r, permissions.Node{
+		Permission: permissions.Permission{
+			Action:   permissions.CreateRepositoryAction,
+			Resource: permissions.RepoArn(repository),
+		},
+	}) {
+		return
+	}
+	ctx := r.Context()
+	c.LogAction(ctx, "restore_repo")
+	repo, err := c.Catalog.RestoreRepository(ctx, repository)
+	if handleAPIError(w, err) {
+		return
+	}
+	writeResponse(w, http.StatusOK, Repository{
+		CreationDate:     repo.CreationDate.Unix(),
+		DefaultBranch:    repo.DefaultBranch,
+		Id:               repo.Name,
+		StorageNamespace: repo.StorageNamespace,
+	})
+}
+
+func (c *Controller) RenameRepository(w http.ResponseWriter, r *http.Request, body RenameRepositoryJSONRequestBody, repository string) {
+	if !c.authorize(w, r, permissions.Node{
+		Type: permissions.NodeTypeAnd,
+		Nodes: []permissions.Node{
+			{
+				Permission: permissions.Permission{
+					Action:   permissions.DeleteRepositoryAction,
+					Resource: permissions.RepoArn(repository)},
+			},
+			{
+				Permission: permissions.Permission{
+					Action:   permissions.CreateRepositoryAction,
+					Resource: permissions.RepoArn(body.Name)},
+			},
+		}}) {
+		return
+	}
+	ctx := r.Context()
+	c.LogAction(ctx, "rename_repo")
+	var aliasPeriod time.Duration
+	if body.AliasPeriod != nil {
+		aliasPeriod = time.Duration(*body.AliasPeriod) * time.Second
+	}
+	repo, err := c.Catalog.RenameRepository(ctx, repository, body.Name, aliasPeriod)
+	if handleAPIError(w, err) {
+		return
+	}
+	writeResponse(w, http.StatusOK, Repository{
+		CreationDate:     repo.CreationDate.Unix(),
+		DefaultBranch:    repo.DefaultBranch,
+		Id:               repo.Name,
+		StorageNamespace: repo.StorageNamespace,
+	})
+}
+
 func (c *Controller) GetRepository(w http.ResponseWriter, r *http.Request, repository string) {
 	if !c.authorize(w, r, permissions.Node{
 		Permission: permissions.Permission{
@@ -1555,10 +1706,7 @@ func (c *Controller) ListBranches(w http.ResponseWriter, r *http.Request, reposi
 
 	refs := make([]Ref, 0, len(res))
 	for _, branch := range res {
-		refs = append(refs, Ref{
-			CommitId: branch.Reference,
-			Id:       branch.Name,
-		})
+		refs = append(refs, branchRef(branch))
 	}
 	response := RefList{
 		Results:    refs,
@@ -1567,6 +1715,21 @@ func (c *Controller) ListBranches(w http.ResponseWriter, r *http.Request, reposi
 	writeResponse(w, http.StatusOK, response)
 }
 
+// branchRef returns the Ref of branch, with the expiry of an ephemeral branch
+func branchRef(branch *catalog.Branch) Ref {
+	ref := Ref{
+		CommitId: branch.Reference,
+		Id:       branch.Name,
+	}
+	if branch.ExpiresAt != nil {
+		ref.ExpiresAt = swag.Int64(branch.ExpiresAt.Unix())
+	}
+	if branch.IdleTTL > 0 {
+		ref.IdleTtl = swag.Int64(int64(branch.IdleTTL / time.Second))
+	}
+	return ref
+}
+
 func (c *Controller) CreateBranch(w http.ResponseWriter, r *http.Request, body CreateBranchJSONRequestBody, repository string) {
 	if !c.authorize(w, r, permissions.Node{
 		Permission: permissions.Permission{
@@ -1578,7 +1741,16 @@ func (c *Controller) CreateBranch(w http.ResponseWriter, r *http.Request, body C
 	}
 	ctx := r.Context()
 	c.LogAction(ctx, "create_branch")
-	commitLog, err := c.Catalog.CreateBranch(ctx, repository, body.Name, body.Source)
+	var expiresAt *time.Time
+	if body.ExpiresAt != nil {
+		t := time.Unix(*body.ExpiresAt, 0)
+		expiresAt = &t
+	}
+	var idleTTL time.Duration
+	if body.IdleTtl != nil {
+		idleTTL = time.Duration(*body.IdleTtl) * time.Second
+	}
+	commitLog, err := c.Catalog.CreateEphemeralBranch(ctx, repository, body.Name, body.Source, expiresAt, idleTTL)
 	if handleAPIError(w, err) {
 		return
 	}
@@ -1604,7 +1776,7 @@ func (c *Controller) DeleteBranch(w http.ResponseWriter, r *http.Request, reposi
 	writeResponse(w, http.StatusNoContent, nil)
 }
 
-func (c *Controller) GetBranch(w http.ResponseWriter, r *http.Request, repository string, branch string) {
+func (c *Controller) ListBranchReflog(w http.ResponseWriter, r *http.Request, repository string, branch string, params ListBranchReflogParams) {
 	if !c.authorize(w, r, permissions.Node{
 		Permission: permissions.Permission{
 			Action:   permissions.ReadBranchAction,
@@ -1614,63 +1786,105 @@ func (c *Controller) GetBranch(w http.ResponseWriter, r *http.Request, repositor
 		return
 	}
 	ctx := r.Context()
-	c.LogAction(ctx, "get_branch")
-	reference, err := c.Catalog.GetBranchReference(ctx, repository, branch)
+	c.LogAction(ctx, "list_branch_reflog")
+
+	from := 0
+	if after := paginationAfter(params.After); after != "" {
+		index, err := strconv.Atoi(after)
+		if err != nil || index < 0 {
+			writeError(w, http.StatusBadRequest, "after must be a reflog entry index")
+			return
+		}
+		from = index + 1
+	}
+	res, hasMore, err := c.Catalog.ListBranchReflog(ctx, repository, branch, paginationAmount(params.Amount), from)
 	if handleAPIError(w, err) {
 		return
 	}
-	response := Ref{
-		CommitId: reference,
-		Id:       branch,
+
+	entries := make([]BranchReflogEntry, 0, len(res))
+	for _, entry := range res {
+		entries = append(entries, BranchReflogEntry{
+			Index:        entry.Index,
+			OldCommitId:  entry.OldReference,
+			NewCommitId:  entry.NewReference,
+			Operation:    entry.Operation,
+			User:         entry.User,
+			CreationDate: entry.CreationDate.Unix(),
+		})
 	}
-	writeResponse(w, http.StatusOK, response)
+	pagination := paginationFor(hasMore, nil, "")
+	pagination.Results = len(entries)
+	if hasMore && len(entries) > 0 {
+		pagination.NextOffset = strconv.Itoa(entries[len(entries)-1].Index)
+	}
+	writeResponse(w, http.StatusOK, BranchReflogList{
+		Results:    entries,
+		Pagination: pagination,
+	})
 }
 
-func handleAPIError(w http.ResponseWriter, err error) bool {
-	switch {
-	case errors.Is(err, catalog.ErrNotFound),
-		errors.Is(err, graveler.ErrNotFound),
-		errors.Is(err, actions.ErrNotFound),
-		errors.Is(err, auth.ErrNotFound),
-		errors.Is(err, db.ErrNotFound):
-		writeError(w, http.StatusNotFound, err)
-
-	case errors.Is(err, graveler.ErrDirtyBranch),
-		errors.Is(err, catalog.ErrNoDifferenceWasFound),
-		errors.Is(err, graveler.ErrNoChanges),
-		errors.Is(err, permissions.ErrInvalidServiceName),
-		errors.Is(err, permissions.ErrInvalidAction),
-		errors.Is(err, model.ErrValidationError),
-		errors.Is(err, graveler.ErrInvalidRef),
-		errors.Is(err, graveler.ErrInvalidValue):
-		writeError(w, http.StatusBadRequest, err)
-
-	case errors.Is(err, graveler.ErrNotUnique):
-		writeError(w, http.StatusConflict, err)
-
-	case errors.Is(err, catalog.ErrFeatureNotSupported):
-		writeError(w, http.StatusNotImplemented, err)
-
-	case errors.Is(err, graveler.ErrLockNotAcquired):
-		writeError(w, http.StatusInternalServerError, "branch is currently locked, try again later")
-
-	case errors.Is(err, adapter.ErrDataNotFound):
-		writeError(w, http.StatusGone, "No data")
-
-	case errors.Is(err, db.ErrAlreadyExists):
-		writeError(w, http.StatusBadRequest, "Already exists")
+func (c *Controller) RestoreBranch(w http.ResponseWriter, r *http.Request, body RestoreBranchJSONRequestBody, repository string, branch string) {
+	if !c.authorize(w, r, permissions.Node{
+		Type: permissions.NodeTypeAnd,
+		Nodes: []permissions.Node{
+			{
+				Permission: permissions.Permission{
+					Action:   permissions.CreateBranchAction,
+					Resource: permissions.BranchArn(repository, branch)},
+			},
+			{
+				Permission: permissions.Permission{
+					Action:   permissions.RevertBranchAction,
+					Resource: permissions.BranchArn(repository, branch)},
+			},
+		}}) {
+		return
+	}
+	ctx := r.Context()
+	c.LogAction(ctx, "restore_branch")
+	commitID, err := c.Catalog.RestoreBranch(ctx, repository, branch, body.Index)
+	if handleAPIError(w, err) {
+		return
+	}
+	writeResponse(w, http.StatusOK, Ref{
+		CommitId: commitID,
+		Id:       branch,
+	})
+}
 
-	case err != nil:
-		writeError(w, http.StatusInternalServerError, err)
+func (c *Controller) ListStashes(w http.ResponseWriter, r *http.Request, repository string, branch string, params ListStashesParams) {
+	if !c.authorize(w, r, permissions.Node{
+		Permission: permissions.Permission{
+			Action:   permissions.ReadBranchAction,
+			Resource: permissions.BranchArn(repository, branch),
+		},
+	}) {
+		return
+	}
+	ctx := r.Context()
+	c.LogAction(ctx, "list_stashes")
 
-	default:
-		return false
+	res, hasMore, err := c.Catalog.ListStashes(ctx, repository, branch, paginationAmount(params.Amount), paginationAfter(params.After))
+	if handleAPIError(w, err) {
+		return
 	}
 
-	return true
+	stashes := make([]Stash, 0, len(res))
+	for _, stash := range res {
+		stashes = append(stashes, Stash{
+			Id:           stash.ID,
+			CommitId:     stash.Reference,
+			CreationDate: stash.CreationDate.Unix(),
+		})
+	}
+	writeResponse(w, http.StatusOK, StashList{
+		Results:    stashes,
+		Pagination: paginationFor(hasMore, stashes, "Id"),
+	})
 }
 
-func (c *Controller) ResetBranch(w http.ResponseWriter, r *http.Request, body ResetBranchJSONRequestBody, repository string, branch string) {
+func (c *Controller) StashPush(w http.ResponseWriter, r *http.Request, body StashPushJSONRequestBody, repository string, branch string) {
 	if !c.authorize(w, r, permissions.Node{
 		Permission: permissions.Permission{
 			Action:   permissions.RevertBranchAction,
@@ -1680,60 +1894,333 @@ func (c *Controller) ResetBranch(w http.ResponseWriter, r *http.Request, body Re
 		return
 	}
 	ctx := r.Context()
-	c.LogAction(ctx, "reset_branch")
-
-	var err error
-	switch body.Type {
-	case entryTypeCommonPrefix:
-		err = c.Catalog.ResetEntries(ctx, repository, branch, StringValue(body.Path))
-	case "reset":
-		err = c.Catalog.ResetBranch(ctx, repository, branch)
-	case entryTypeObject:
-		err = c.Catalog.ResetEntry(ctx, repository, branch, StringValue(body.Path))
-	default:
-		writeError(w, http.StatusNotFound, "reset type not found")
-	}
+	c.LogAction(ctx, "stash_push")
+	stash, err := c.Catalog.StashPush(ctx, repository, branch, body.Id)
 	if handleAPIError(w, err) {
 		return
 	}
-	writeResponse(w, http.StatusNoContent, nil)
+	writeResponse(w, http.StatusCreated, Stash{
+		Id:           stash.ID,
+		CommitId:     stash.Reference,
+		CreationDate: stash.CreationDate.Unix(),
+	})
 }
 
-func (c *Controller) Commit(w http.ResponseWriter, r *http.Request, body CommitJSONRequestBody, repository string, branch string) {
+func (c *Controller) StashDrop(w http.ResponseWriter, r *http.Request, repository string, branch string, stash string) {
 	if !c.authorize(w, r, permissions.Node{
 		Permission: permissions.Permission{
-			Action:   permissions.CreateCommitAction,
+			Action:   permissions.RevertBranchAction,
 			Resource: permissions.BranchArn(repository, branch),
 		},
 	}) {
 		return
 	}
 	ctx := r.Context()
-	c.LogAction(ctx, "create_commit")
-	user, ok := ctx.Value(UserContextKey).(*model.User)
-	if !ok {
-		writeError(w, http.StatusUnauthorized, "missing user")
+	c.LogAction(ctx, "stash_drop")
+	err := c.Catalog.StashDrop(ctx, repository, branch, stash)
+	if handleAPIError(w, err) {
 		return
 	}
-	var metadata map[string]string
-	if body.Metadata != nil {
-		metadata = body.Metadata.AdditionalProperties
-	}
-	committer := user.Username
-	newCommit, err := c.Catalog.Commit(ctx, repository, branch, body.Message, committer, metadata, body.Date)
-	var hookAbortErr *graveler.HookAbortError
-	if errors.As(err, &hookAbortErr) {
-		c.Logger.
-			WithError(err).
-			WithField("run_id", hookAbortErr.RunID).
-			Warn("aborted by hooks")
-		writeError(w, http.StatusPreconditionFailed, err)
+	writeResponse(w, http.StatusNoContent, nil)
+}
+
+func (c *Controller) StashApply(w http.ResponseWriter, r *http.Request, repository string, branch string, stash string) {
+	if !c.authorize(w, r, permissions.Node{
+		Permission: permissions.Permission{
+			Action:   permissions.WriteObjectAction,
+			Resource: permissions.ObjectArn(repository, branch),
+		},
+	}) {
 		return
 	}
-	if handleAPIError(w, err) {
+	ctx := r.Context()
+	c.LogAction(ctx, "stash_apply")
+	err := c.Catalog.StashApply(ctx, repository, branch, stash)
+	if handleStashConflicts(w, err) || handleAPIError(w, err) {
 		return
 	}
-	newMetadata := Commit_Metadata{
+	writeResponse(w, http.StatusNoContent, nil)
+}
+
+func (c *Controller) StashPop(w http.ResponseWriter, r *http.Request, repository string, branch string, stash string) {
+	if !c.authorize(w, r, permissions.Node{
+		Type: permissions.NodeTypeAnd,
+		Nodes: []permissions.Node{
+			{
+				Permission: permissions.Permission{
+					Action:   permissions.WriteObjectAction,
+					Resource: permissions.ObjectArn(repository, branch)},
+			},
+			{
+				Permission: permissions.Permission{
+					Action:   permissions.RevertBranchAction,
+					Resource: permissions.BranchArn(repository, branch)},
+			},
+		}}) {
+		return
+	}
+	ctx := r.Context()
+	c.LogAction(ctx, "stash_pop")
+	err := c.Catalog.StashPop(ctx, repository, branch, stash)
+	if handleStashConflicts(w, err) || handleAPIError(w, err) {
+		return
+	}
+	writeResponse(w, http.StatusNoContent, nil)
+}
+
+// handleStashConflicts writes the conflicts found applying a stash, returns false when err is not a conflict
+func handleStashConflicts(w http.ResponseWriter, err error) bool {
+	var conflictsErr *catalog.MergeConflictsError
+	if !errors.As(err, &conflictsErr) {
+		return false
+	}
+	writeResponse(w, http.StatusConflict, StashConflicts{
+		Conflicts:          newMergeConflicts(conflictsErr.Conflicts),
+		ConflictsTruncated: swag.Bool(conflictsErr.Truncated),
+	})
+	return true
+}
+
+func (c *Controller) StartImport(w http.ResponseWriter, r *http.Request, body StartImportJSONRequestBody, repository string, branch string) {
+	if !c.authorize(w, r, permissions.Node{
+		Type: permissions.NodeTypeAnd,
+		Nodes: []permissions.Node{
+			{
+				Permission: permissions.Permission{
+					Action:   permissions.ImportFromStorageAction,
+					Resource: permissions.StorageNamespace(body.Source),
+				},
+			},
+			{
+				Permission: permissions.Permission{
+					Action:   permissions.CreateCommitAction,
+					Resource: permissions.BranchArn(repository, branch),
+				},
+			},
+		},
+	}) {
+		return
+	}
+	ctx := r.Context()
+	c.LogAction(ctx, "start_import")
+	user, ok := ctx.Value(UserContextKey).(*model.User)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "missing user")
+		return
+	}
+	var metadata map[string]string
+	if body.Metadata != nil {
+		metadata = body.Metadata.AdditionalProperties
+	}
+	importID, err := c.Catalog.Import(ctx, repository, branch, catalog.ImportParams{
+		Source:      body.Source,
+		Destination: body.Destination,
+		Message:     swag.StringValue(body.Message),
+		Committer:   user.Username,
+		Metadata:    metadata,
+	})
+	if handleAPIError(w, err) {
+		return
+	}
+	writeResponse(w, http.StatusAccepted, ImportCreationResponse{Id: importID})
+}
+
+func (c *Controller) GetImportStatus(w http.ResponseWriter, r *http.Request, repository string, branch string, pImport string) {
+	if !c.authorize(w, r, permissions.Node{
+		Permission: permissions.Permission{
+			Action:   permissions.ReadBranchAction,
+			Resource: permissions.BranchArn(repository, branch),
+		},
+	}) {
+		return
+	}
+	ctx := r.Context()
+	c.LogAction(ctx, "get_import_status")
+	status, err := c.Catalog.GetImportStatus(ctx, repository, branch, pImport)
+	if handleAPIError(w, err) {
+		return
+	}
+	response := ImportStatus{
+		Id:              status.ID,
+		Completed:       status.Completed,
+		ImportedObjects: status.ImportedObjects,
+		UpdateTime:      status.UpdateTime.Unix(),
+	}
+	if status.MetaRangeID != "" {
+		response.MetarangeId = swag.String(status.MetaRangeID)
+	}
+	if status.CommitID != "" {
+		response.CommitId = swag.String(status.CommitID)
+	}
+	if status.Error != nil {
+		response.Error = swag.String(status.Error.Error())
+	}
+	writeResponse(w, http.StatusOK, response)
+}
+
+func (c *Controller) CancelImport(w http.ResponseWriter, r *http.Request, repository string, branch string, pImport string) {
+	if !c.authorize(w, r, permissions.Node{
+		Permission: permissions.Permission{
+			Action:   permissions.CreateCommitAction,
+			Resource: permissions.BranchArn(repository, branch),
+		},
+	}) {
+		return
+	}
+	ctx := r.Context()
+	c.LogAction(ctx, "cancel_import")
+	err := c.Catalog.CancelImport(ctx, repository, branch, pImport)
+	if handleAPIError(w, err) {
+		return
+	}
+	writeResponse(w, http.StatusNoContent, nil)
+}
+
+func (c *Controller) GetBranch(w http.ResponseWriter, r *http.Request, repository string, branch string) {
+	if !c.authorize(w, r, permissions.Node{
+		Permission: permissions.Permission{
+			Action:   permissions.ReadBranchAction,
+			Resource: permissions.BranchArn(repository, branch),
+		},
+	}) {
+		return
+	}
+	ctx := r.Context()
+	c.LogAction(ctx, "get_branch")
+	b, err := c.Catalog.GetBranch(ctx, repository, branch)
+	if handleAPIError(w, err) {
+		return
+	}
+	writeResponse(w, http.StatusOK, branchRef(b))
+}
+
+func handleAPIError(w http.ResponseWriter, err error) bool {
+	switch {
+	case errors.Is(err, catalog.ErrNotFound),
+		errors.Is(err, graveler.ErrNotFound),
+		errors.Is(err, actions.ErrNotFound),
+		errors.Is(err, auth.ErrNotFound),
+		errors.Is(err, db.ErrNotFound):
+		writeError(w, http.StatusNotFound, err)
+
+	case errors.Is(err, graveler.ErrDirtyBranch),
+		errors.Is(err, graveler.ErrNotFastForward),
+		errors.Is(err, graveler.ErrNoMergeBase),
+		errors.Is(err, catalog.ErrNoDifferenceWasFound),
+		errors.Is(err, graveler.ErrNoChanges),
+		errors.Is(err, permissions.ErrInvalidServiceName),
+		errors.Is(err, permissions.ErrInvalidAction),
+		errors.Is(err, model.ErrValidationError),
+		errors.Is(err, graveler.ErrInvalidRef),
+		errors.Is(err, graveler.ErrInvalidValue):
+		writeError(w, http.StatusBadRequest, err)
+
+	case errors.Is(err, graveler.ErrNotUnique),
+		errors.Is(err, graveler.ErrDeleteLegalHoldRepository),
+		errors.Is(err, catalog.ErrImportCompleted):
+		writeError(w, http.StatusConflict, err)
+
+	case errors.Is(err, graveler.ErrWriteToProtectedBranch),
+		errors.Is(err, graveler.ErrCommitToProtectedBranch),
+		errors.Is(err, graveler.ErrDeleteProtectedBranch),
+		errors.Is(err, graveler.ErrResetProtectedBranch),
+		errors.Is(err, graveler.ErrRevertProtectedBranch),
+		errors.Is(err, graveler.ErrUpdateProtectedBranch),
+		errors.Is(err, graveler.ErrMergeToProtectedBranch),
+		errors.Is(err, graveler.ErrUnsignedToProtectedBranch),
+		errors.Is(err, graveler.ErrDeleteProtectedTag):
+		writeError(w, http.StatusForbidden, err)
+
+	case errors.Is(err, catalog.ErrFeatureNotSupported):
+		writeError(w, http.StatusNotImplemented, err)
+
+	case errors.Is(err, graveler.ErrLockNotAcquired):
+		writeError(w, http.StatusInternalServerError, "branch is currently locked, try again later")
+
+	case errors.Is(err, adapter.ErrDataNotFound):
+		writeError(w, http.StatusGone, "No data")
+
+	case errors.Is(err, db.ErrAlreadyExists):
+		writeError(w, http.StatusBadRequest, "Already exists")
+
+	case err != nil:
+		writeError(w, http.StatusInternalServerError, err)
+
+	default:
+		return false
+	}
+
+	return true
+}
+
+func (c *Controller) ResetBranch(w http.ResponseWriter, r *http.Request, body ResetBranchJSONRequestBody, repository string, branch string) {
+	if !c.authorize(w, r, permissions.Node{
+		Permission: permissions.Permission{
+			Action:   permissions.RevertBranchAction,
+			Resource: permissions.BranchArn(repository, branch),
+		},
+	}) {
+		return
+	}
+	ctx := r.Context()
+	c.LogAction(ctx, "reset_branch")
+
+	var err error
+	switch body.Type {
+	case entryTypeCommonPrefix:
+		err = c.Catalog.ResetEntries(ctx, repository, branch, StringValue(body.Path))
+	case "reset":
+		err = c.Catalog.ResetBranch(ctx, repository, branch)
+	case entryTypeObject:
+		err = c.Catalog.ResetEntry(ctx, repository, branch, StringValue(body.Path))
+	default:
+		writeError(w, http.StatusNotFound, "reset type not found")
+	}
+	if handleAPIError(w, err) {
+		return
+	}
+	writeResponse(w, http.StatusNoContent, nil)
+}
+
+func (c *Controller) Commit(w http.ResponseWriter, r *http.Request, body CommitJSONRequestBody, repository string, branch string) {
+	if !c.authorize(w, r, permissions.Node{
+		Permission: permissions.Permission{
+			Action:   permissions.CreateCommitAction,
+			Resource: permissions.BranchArn(repository, branch),
+		},
+	}) {
+		return
+	}
+	ctx := r.Context()
+	c.LogAction(ctx, "create_commit")
+	user, ok := ctx.Value(UserContextKey).(*model.User)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "missing user")
+		return
+	}
+	var metadata map[string]string
+	if body.Metadata != nil {
+		metadata = body.Metadata.AdditionalProperties
+	}
+	committer := user.Username
+	var prefixes []string
+	if body.Prefixes != nil {
+		prefixes = *body.Prefixes
+	}
+	newCommit, err := c.Catalog.Commit(ctx, repository, branch, body.Message, committer, metadata, body.Date, prefixes)
+	var hookAbortErr *graveler.HookAbortError
+	if errors.As(err, &hookAbortErr) {
+		c.Logger.
+			WithError(err).
+			WithField("run_id", hookAbortErr.RunID).
+			Warn("aborted by hooks")
+		writeError(w, http.StatusPreconditionFailed, err)
+		return
+	}
+	if handleAPIError(w, err) {
+		return
+	}
+	newMetadata := Commit_Metadata{
 		AdditionalProperties: map[string]string(newCommit.Metadata),
 	}
 	response := Commit{
@@ -2026,6 +2513,89 @@ func (c *Controller) RevertBranch(w http.ResponseWriter, r *http.Request, body R
 	writeResponse(w, http.StatusNoContent, nil)
 }
 
+func (c *Controller) CherryPick(w http.ResponseWriter, r *http.Request, body CherryPickJSONRequestBody, repository string, branch string) {
+	if !c.authorize(w, r, permissions.Node{
+		Permission: permissions.Permission{
+			Action:   permissions.CreateCommitAction,
+			Resource: permissions.BranchArn(repository, branch),
+		},
+	}) {
+		return
+	}
+	ctx := r.Context()
+	c.LogAction(ctx, "cherry_pick")
+	user, ok := ctx.Value(UserContextKey).(*model.User)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "user not found")
+		return
+	}
+	var parentNumber int
+	if body.ParentNumber != nil {
+		parentNumber = *body.ParentNumber
+	}
+	newCommit, err := c.Catalog.CherryPick(ctx, repository, branch, catalog.CherryPickParams{
+		Reference:    body.Ref,
+		Committer:    user.Username,
+		ParentNumber: parentNumber,
+	})
+
+	var hookAbortErr *graveler.HookAbortError
+	switch {
+	case errors.As(err, &hookAbortErr):
+		c.Logger.WithError(err).WithField("run_id", hookAbortErr.RunID).Warn("aborted by hooks")
+		writeError(w, http.StatusPreconditionFailed, err)
+		return
+	case errors.Is(err, graveler.ErrConflictFound):
+		writeError(w, http.StatusConflict, err)
+		return
+	}
+	if handleAPIError(w, err) {
+		return
+	}
+	newMetadata := Commit_Metadata{
+		AdditionalProperties: map[string]string(newCommit.Metadata),
+	}
+	response := Commit{
+		Committer:    newCommit.Committer,
+		CreationDate: newCommit.CreationDate.Unix(),
+		Id:           newCommit.Reference,
+		Message:      newCommit.Message,
+		MetaRangeId:  newCommit.MetaRangeID,
+		Metadata:     &newMetadata,
+		Parents:      newCommit.Parents,
+	}
+	writeResponse(w, http.StatusCreated, response)
+}
+
+func (c *Controller) RebaseBranch(w http.ResponseWriter, r *http.Request, body RebaseBranchJSONRequestBody, repository string, branch string) {
+	if !c.authorize(w, r, permissions.Node{
+		Permission: permissions.Permission{
+			Action:   permissions.CreateCommitAction,
+			Resource: permissions.BranchArn(repository, branch),
+		},
+	}) {
+		return
+	}
+	ctx := r.Context()
+	c.LogAction(ctx, "rebase_branch")
+	res, err := c.Catalog.Rebase(ctx, repository, branch, body.Onto)
+	var rebaseConflictErr *catalog.RebaseConflictError
+	if errors.As(err, &rebaseConflictErr) {
+		result := RebaseResult{ConflictCommitId: swag.String(rebaseConflictErr.CommitID)}
+		if len(rebaseConflictErr.Conflicts) > 0 {
+			conflicts := newMergeConflicts(rebaseConflictErr.Conflicts)
+			result.Conflicts = &conflicts
+			result.ConflictsTruncated = swag.Bool(rebaseConflictErr.Truncated)
+		}
+		writeResponse(w, http.StatusConflict, result)
+		return
+	}
+	if handleAPIError(w, err) {
+		return
+	}
+	writeResponse(w, http.StatusOK, RebaseResult{Reference: res})
+}
+
 func (c *Controller) GetCommit(w http.ResponseWriter, r *http.Request, repository string, commitID string) {
 	if !c.authorize(w, r, permissions.Node{
 		Permission: permissions.Permission{
@@ -2057,131 +2627,468 @@ func (c *Controller) GetCommit(w http.ResponseWriter, r *http.Request, repositor
 		MetaRangeId:  commit.MetaRangeID,
 		Metadata:     &metadata,
 		Parents:      commit.Parents,
+		Signature:    serializeCommitSignature(commit.Signature),
+	}
+	writeResponse(w, http.StatusOK, response)
+}
+
+func serializeCommitSignature(signature *catalog.CommitSignature) *CommitSignature {
+	if signature == nil {
+		return nil
+	}
+	return &CommitSignature{
+		KeyId:    signature.KeyID,
+		Verified: signature.Verified,
+	}
+}
+
+func (c *Controller) SignCommit(w http.ResponseWriter, r *http.Request, body SignCommitJSONRequestBody, repository string, commitID string) {
+	if !c.authorize(w, r, permissions.Node{
+		Permission: permissions.Permission{
+			Action:   permissions.CreateCommitAction,
+			Resource: permissions.RepoArn(repository),
+		},
+	}) {
+		return
+	}
+	ctx := r.Context()
+	c.LogAction(ctx, "sign_commit")
+	err := c.Catalog.SignCommit(ctx, repository, commitID, body.KeyId, body.Signature)
+	if handleAPIError(w, err) {
+		return
+	}
+	writeResponse(w, http.StatusNoContent, nil)
+}
+
+func (c *Controller) GetGarbageCollectionRules(w http.ResponseWriter, r *http.Request, repository string) {
+	if !c.authorize(w, r, permissions.Node{
+		Permission: permissions.Permission{
+			Action:   permissions.GetGarbageCollectionRulesAction,
+			Resource: permissions.RepoArn(repository),
+		},
+	}) {
+		return
+	}
+	ctx := r.Context()
+	rules, err := c.Catalog.GetGarbageCollectionRules(ctx, repository)
+	if handleAPIError(w, err) {
+		return
+	}
+	resp := GarbageCollectionRules{}
+	resp.DefaultRetentionDays = int(rules.DefaultRetentionDays)
+	for branchID, retentionDays := range rules.BranchRetentionDays {
+		resp.Branches = append(resp.Branches, GarbageCollectionRule{BranchId: branchID, RetentionDays: int(retentionDays)})
+	}
+	if len(rules.PrefixRetentionDays) > 0 {
+		prefixes := make([]GarbageCollectionPrefixRule, 0, len(rules.PrefixRetentionDays))
+		for prefix, retentionDays := range rules.PrefixRetentionDays {
+			prefixes = append(prefixes, GarbageCollectionPrefixRule{Prefix: prefix, RetentionDays: int(retentionDays)})
+		}
+		sort.Slice(prefixes, func(i, j int) bool {
+			return prefixes[i].Prefix < prefixes[j].Prefix
+		})
+		resp.Prefixes = &prefixes
+	}
+	if len(rules.PinnedTagPatterns) > 0 {
+		resp.PinnedTagPatterns = &rules.PinnedTagPatterns
+	}
+	writeResponse(w, http.StatusOK, resp)
+}
+
+// garbageCollectionRulesFromAPI converts the API garbage collection rules to graveler rules
+func garbageCollectionRulesFromAPI(body GarbageCollectionRules) (*graveler.GarbageCollectionRules, error) {
+	rules := &graveler.GarbageCollectionRules{
+		DefaultRetentionDays: int32(body.DefaultRetentionDays),
+		BranchRetentionDays:  make(map[string]int32),
+	}
+	for _, rule := range body.Branches {
+		rules.BranchRetentionDays[rule.BranchId] = int32(rule.RetentionDays)
+	}
+	if body.Prefixes != nil {
+		rules.PrefixRetentionDays = make(map[string]int32, len(*body.Prefixes))
+		for _, rule := range *body.Prefixes {
+			if _, ok := rules.PrefixRetentionDays[rule.Prefix]; ok {
+				return nil, fmt.Errorf("%w: duplicate prefix %s", graveler.ErrInvalidGarbageCollectionRule, rule.Prefix)
+			}
+			rules.PrefixRetentionDays[rule.Prefix] = int32(rule.RetentionDays)
+		}
+	}
+	if body.PinnedTagPatterns != nil {
+		rules.PinnedTagPatterns = *body.PinnedTagPatterns
+	}
+	return rules, nil
+}
+
+func (c *Controller) SetGarbageCollectionRules(w http.ResponseWriter, r *http.Request, body SetGarbageCollectionRulesJSONRequestBody, repository string) {
+	if !c.authorize(w, r, permissions.Node{
+		Permission: permissions.Permission{
+			Action:   permissions.SetGarbageCollectionRulesAction,
+			Resource: permissions.RepoArn(repository),
+		},
+	}) {
+		return
+	}
+	ctx := r.Context()
+	rules, err := garbageCollectionRulesFromAPI(GarbageCollectionRules(body))
+	if handleAPIError(w, err) {
+		return
+	}
+	err = c.Catalog.SetGarbageCollectionRules(ctx, repository, rules)
+	if handleAPIError(w, err) {
+		return
+	}
+	writeResponse(w, http.StatusNoContent, nil)
+}
+
+func (c *Controller) PreviewGarbageCollectionRules(w http.ResponseWriter, r *http.Request, body PreviewGarbageCollectionRulesJSONRequestBody, repository string) {
+	if !c.authorize(w, r, permissions.Node{
+		Permission: permissions.Permission{
+			Action:   permissions.GetGarbageCollectionRulesAction,
+			Resource: permissions.RepoArn(repository),
+		},
+	}) {
+		return
+	}
+	ctx := r.Context()
+	rules, err := garbageCollectionRulesFromAPI(GarbageCollectionRules(body))
+	if handleAPIError(w, err) {
+		return
+	}
+	previews, err := c.Catalog.PreviewGarbageCollectionRules(ctx, repository, rules)
+	if handleAPIError(w, err) {
+		return
+	}
+	resp := make([]GarbageCollectionRulePreview, 0, len(previews))
+	for _, preview := range previews {
+		resp = append(resp, GarbageCollectionRulePreview{
+			Prefix:         preview.Prefix,
+			RetentionDays:  preview.RetentionDays,
+			ExpiredObjects: preview.ExpiredObjects,
+		})
+	}
+	writeResponse(w, http.StatusOK, resp)
+}
+
+func (c *Controller) PrepareGarbageCollectionCommits(w http.ResponseWriter, r *http.Request, body PrepareGarbageCollectionCommitsJSONRequestBody, repository string) {
+	if !c.authorize(w, r, permissions.Node{
+		Permission: permissions.Permission{
+			Action:   permissions.PrepareGarbageCollectionCommitsAction,
+			Resource: permissions.RepoArn(repository),
+		},
+	}) {
+		return
+	}
+	ctx := r.Context()
+	c.LogAction(ctx, "prepare_garbage_collection_commits")
+	gcRUnMetadata, err := c.Catalog.PrepareExpiredCommits(ctx, repository, swag.StringValue(body.PreviousRunId))
+	if handleAPIError(w, err) {
+		return
+	}
+	writeResponse(w, http.StatusCreated, GarbageCollectionPrepareResponse{
+		GcCommitsLocation:   gcRUnMetadata.CommitsCsvLocation,
+		GcAddressesLocation: gcRUnMetadata.AddressLocation,
+		RunId:               gcRUnMetadata.RunId,
+	})
+}
+
+func (c *Controller) ListLegalHolds(w http.ResponseWriter, r *http.Request, repository string) {
+	if !c.authorize(w, r, permissions.Node{
+		Permission: permissions.Permission{
+			Action:   permissions.GetLegalHoldsAction,
+			Resource: permissions.RepoArn(repository),
+		},
+	}) {
+		return
+	}
+	ctx := r.Context()
+	c.LogAction(ctx, "list_legal_holds")
+	holds, err := c.Catalog.ListLegalHolds(ctx, repository)
+	if handleAPIError(w, err) {
+		return
+	}
+	resp := make([]LegalHold, 0, len(holds))
+	for _, hold := range holds {
+		resp = append(resp, legalHoldToAPI(hold))
+	}
+	writeResponse(w, http.StatusOK, resp)
+}
+
+func (c *Controller) CreateLegalHold(w http.ResponseWriter, r *http.Request, body CreateLegalHoldJSONRequestBody, repository string) {
+	if !c.authorize(w, r, permissions.Node{
+		Permission: permissions.Permission{
+			Action:   permissions.CreateLegalHoldAction,
+			Resource: permissions.RepoArn(repository),
+		},
+	}) {
+		return
+	}
+	ctx := r.Context()
+	c.LogAction(ctx, "create_legal_hold")
+	user, ok := ctx.Value(UserContextKey).(*model.User)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "user not found")
+		return
+	}
+	hold, err := c.Catalog.CreateLegalHold(ctx, repository, body.Id, body.Ref, swag.StringValue(body.Path), body.Reason, user.Username)
+	if handleAPIError(w, err) {
+		return
+	}
+	writeResponse(w, http.StatusCreated, legalHoldToAPI(hold))
+}
+
+func (c *Controller) GetLegalHold(w http.ResponseWriter, r *http.Request, repository string, hold string) {
+	if !c.authorize(w, r, permissions.Node{
+		Permission: permissions.Permission{
+			Action:   permissions.GetLegalHoldsAction,
+			Resource: permissions.RepoArn(repository),
+		},
+	}) {
+		return
+	}
+	ctx := r.Context()
+	c.LogAction(ctx, "get_legal_hold")
+	legalHold, err := c.Catalog.GetLegalHold(ctx, repository, hold)
+	if handleAPIError(w, err) {
+		return
+	}
+	writeResponse(w, http.StatusOK, legalHoldToAPI(legalHold))
+}
+
+func (c *Controller) ReleaseLegalHold(w http.ResponseWriter, r *http.Request, repository string, hold string) {
+	if !c.authorize(w, r, permissions.Node{
+		Permission: permissions.Permission{
+			Action:   permissions.ReleaseLegalHoldAction,
+			Resource: permissions.RepoArn(repository),
+		},
+	}) {
+		return
+	}
+	ctx := r.Context()
+	c.LogAction(ctx, "release_legal_hold")
+	user, ok := ctx.Value(UserContextKey).(*model.User)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "user not found")
+		return
+	}
+	err := c.Catalog.ReleaseLegalHold(ctx, repository, hold, user.Username)
+	if handleAPIError(w, err) {
+		return
+	}
+	writeResponse(w, http.StatusNoContent, nil)
+}
+
+func (c *Controller) ListLegalHoldLog(w http.ResponseWriter, r *http.Request, repository string) {
+	if !c.authorize(w, r, permissions.Node{
+		Permission: permissions.Permission{
+			Action:   permissions.GetLegalHoldsAction,
+			Resource: permissions.RepoArn(repository),
+		},
+	}) {
+		return
+	}
+	ctx := r.Context()
+	c.LogAction(ctx, "list_legal_hold_log")
+	entries, err := c.Catalog.ListLegalHoldLog(ctx, repository)
+	if handleAPIError(w, err) {
+		return
+	}
+	resp := make([]LegalHoldLogEntry, 0, len(entries))
+	for _, entry := range entries {
+		resp = append(resp, LegalHoldLogEntry{
+			Id:           entry.ID,
+			Operation:    entry.Operation,
+			CommitId:     entry.CommitID,
+			Path:         entry.Path,
+			Reason:       entry.Reason,
+			User:         entry.User,
+			CreationDate: entry.CreationDate.Unix(),
+		})
+	}
+	writeResponse(w, http.StatusOK, resp)
+}
+
+func legalHoldToAPI(hold *catalog.LegalHold) LegalHold {
+	return LegalHold{
+		Id:           hold.ID,
+		CommitId:     hold.CommitID,
+		Path:         hold.Path,
+		Reason:       hold.Reason,
+		CreatedBy:    hold.CreatedBy,
+		CreationDate: hold.CreationDate.Unix(),
+	}
+}
+
+func (c *Controller) GetBranchProtectionRules(w http.ResponseWriter, r *http.Request, repository string) {
+	if !c.authorize(w, r, permissions.Node{
+		Permission: permissions.Permission{
+			Action:   permissions.GetBranchProtectionRulesAction,
+			Resource: permissions.RepoArn(repository),
+		},
+	}) {
+		return
+	}
+	ctx := r.Context()
+	rules, err := c.Catalog.GetBranchProtectionRules(ctx, repository)
+	if handleAPIError(w, err) {
+		return
 	}
-	writeResponse(w, http.StatusOK, response)
+	resp := make([]*BranchProtectionRule, 0, len(rules.BranchPatternToBlockedActions))
+	for pattern, blockedActions := range rules.BranchPatternToBlockedActions {
+		actions := make([]string, 0, len(blockedActions.GetValue()))
+		for _, action := range blockedActions.GetValue() {
+			actions = append(actions, strings.ToLower(action.String()))
+		}
+		rule := &BranchProtectionRule{
+			Pattern:        pattern,
+			BlockedActions: &actions,
+		}
+		if blockedActions.GetMergeSourcePattern() != "" {
+			rule.MergeSourcePattern = StringPtr(blockedActions.GetMergeSourcePattern())
+		}
+		if blockedActions.GetRequireSignedCommits() {
+			rule.RequireSignedCommits = swag.Bool(true)
+		}
+		resp = append(resp, rule)
+	}
+	writeResponse(w, http.StatusOK, resp)
 }
 
-func (c *Controller) GetGarbageCollectionRules(w http.ResponseWriter, r *http.Request, repository string) {
+func (c *Controller) DeleteBranchProtectionRule(w http.ResponseWriter, r *http.Request, body DeleteBranchProtectionRuleJSONRequestBody, repository string) {
 	if !c.authorize(w, r, permissions.Node{
 		Permission: permissions.Permission{
-			Action:   permissions.GetGarbageCollectionRulesAction,
+			Action:   permissions.SetBranchProtectionRulesAction,
 			Resource: permissions.RepoArn(repository),
 		},
 	}) {
 		return
 	}
 	ctx := r.Context()
-	rules, err := c.Catalog.GetGarbageCollectionRules(ctx, repository)
+	err := c.Catalog.DeleteBranchProtectionRule(ctx, repository, body.Pattern)
 	if handleAPIError(w, err) {
 		return
 	}
-	resp := GarbageCollectionRules{}
-	resp.DefaultRetentionDays = int(rules.DefaultRetentionDays)
-	for branchID, retentionDays := range rules.BranchRetentionDays {
-		resp.Branches = append(resp.Branches, GarbageCollectionRule{BranchId: branchID, RetentionDays: int(retentionDays)})
-	}
-	writeResponse(w, http.StatusOK, resp)
+	writeResponse(w, http.StatusNoContent, nil)
 }
 
-func (c *Controller) SetGarbageCollectionRules(w http.ResponseWriter, r *http.Request, body SetGarbageCollectionRulesJSONRequestBody, repository string) {
+func (c *Controller) CreateBranchProtectionRule(w http.ResponseWriter, r *http.Request, body CreateBranchProtectionRuleJSONRequestBody, repository string) {
 	if !c.authorize(w, r, permissions.Node{
 		Permission: permissions.Permission{
-			Action:   permissions.SetGarbageCollectionRulesAction,
+			Action:   permissions.SetBranchProtectionRulesAction,
 			Resource: permissions.RepoArn(repository),
 		},
 	}) {
 		return
 	}
 	ctx := r.Context()
-	rules := &graveler.GarbageCollectionRules{
-		DefaultRetentionDays: int32(body.DefaultRetentionDays),
-		BranchRetentionDays:  make(map[string]int32),
-	}
-	for _, rule := range body.Branches {
-		rules.BranchRetentionDays[rule.BranchId] = int32(rule.RetentionDays)
+	// protected branches block staging writes and commits unless a set of blocked actions is given
+	blockedActions := []graveler.BranchProtectionBlockedAction{graveler.BranchProtectionBlockedAction_STAGING_WRITE, graveler.BranchProtectionBlockedAction_COMMIT}
+	if body.BlockedActions != nil {
+		blockedActions = make([]graveler.BranchProtectionBlockedAction, 0, len(*body.BlockedActions))
+		for _, action := range *body.BlockedActions {
+			value, ok := graveler.BranchProtectionBlockedAction_value[strings.ToUpper(action)]
+			if !ok {
+				writeError(w, http.StatusBadRequest, fmt.Sprintf("unknown blocked action: %s", action))
+				return
+			}
+			blockedActions = append(blockedActions, graveler.BranchProtectionBlockedAction(value))
+		}
 	}
-	err := c.Catalog.SetGarbageCollectionRules(ctx, repository, rules)
+	err := c.Catalog.CreateBranchProtectionRule(ctx, repository, body.Pattern, blockedActions, StringValue(body.MergeSourcePattern), swag.BoolValue(body.RequireSignedCommits))
 	if handleAPIError(w, err) {
 		return
 	}
 	writeResponse(w, http.StatusNoContent, nil)
 }
 
-func (c *Controller) PrepareGarbageCollectionCommits(w http.ResponseWriter, r *http.Request, body PrepareGarbageCollectionCommitsJSONRequestBody, repository string) {
+func (c *Controller) GetTagProtectionRules(w http.ResponseWriter, r *http.Request, repository string) {
 	if !c.authorize(w, r, permissions.Node{
 		Permission: permissions.Permission{
-			Action:   permissions.PrepareGarbageCollectionCommitsAction,
+			Action:   permissions.GetTagProtectionRulesAction,
 			Resource: permissions.RepoArn(repository),
 		},
 	}) {
 		return
 	}
 	ctx := r.Context()
-	c.LogAction(ctx, "prepare_garbage_collection_commits")
-	gcRUnMetadata, err := c.Catalog.PrepareExpiredCommits(ctx, repository, swag.StringValue(body.PreviousRunId))
+	rules, err := c.Catalog.GetTagProtectionRules(ctx, repository)
 	if handleAPIError(w, err) {
 		return
 	}
-	writeResponse(w, http.StatusCreated, GarbageCollectionPrepareResponse{
-		GcCommitsLocation:   gcRUnMetadata.CommitsCsvLocation,
-		GcAddressesLocation: gcRUnMetadata.AddressLocation,
-		RunId:               gcRUnMetadata.RunId,
-	})
+	resp := make([]*TagProtectionRule, 0, len(rules.Patterns))
+	for _, pattern := range rules.Patterns {
+		resp = append(resp, &TagProtectionRule{Pattern: pattern})
+	}
+	writeResponse(w, http.StatusOK, resp)
 }
 
-func (c *Controller) GetBranchProtectionRules(w http.ResponseWriter, r *http.Request, repository string) {
+func (c *Controller) DeleteTagProtectionRule(w http.ResponseWriter, r *http.Request, body DeleteTagProtectionRuleJSONRequestBody, repository string) {
 	if !c.authorize(w, r, permissions.Node{
 		Permission: permissions.Permission{
-			Action:   permissions.GetBranchProtectionRulesAction,
+			Action:   permissions.SetTagProtectionRulesAction,
 			Resource: permissions.RepoArn(repository),
 		},
 	}) {
 		return
 	}
 	ctx := r.Context()
-	rules, err := c.Catalog.GetBranchProtectionRules(ctx, repository)
+	err := c.Catalog.DeleteTagProtectionRule(ctx, repository, body.Pattern)
 	if handleAPIError(w, err) {
 		return
 	}
-	resp := make([]*BranchProtectionRule, 0, len(rules.BranchPatternToBlockedActions))
-	for pattern := range rules.BranchPatternToBlockedActions {
-		resp = append(resp, &BranchProtectionRule{
-			Pattern: pattern,
-		})
-	}
-	writeResponse(w, http.StatusOK, resp)
+	writeResponse(w, http.StatusNoContent, nil)
 }
 
-func (c *Controller) DeleteBranchProtectionRule(w http.ResponseWriter, r *http.Request, body DeleteBranchProtectionRuleJSONRequestBody, repository string) {
+func (c *Controller) CreateTagProtectionRule(w http.ResponseWriter, r *http.Request, body CreateTagProtectionRuleJSONRequestBody, repository string) {
 	if !c.authorize(w, r, permissions.Node{
 		Permission: permissions.Permission{
-			Action:   permissions.SetBranchProtectionRulesAction,
+			Action:   permissions.SetTagProtectionRulesAction,
 			Resource: permissions.RepoArn(repository),
 		},
 	}) {
 		return
 	}
 	ctx := r.Context()
-	err := c.Catalog.DeleteBranchProtectionRule(ctx, repository, body.Pattern)
+	err := c.Catalog.CreateTagProtectionRule(ctx, repository, body.Pattern)
 	if handleAPIError(w, err) {
 		return
 	}
 	writeResponse(w, http.StatusNoContent, nil)
 }
 
-func (c *Controller) CreateBranchProtectionRule(w http.ResponseWriter, r *http.Request, body CreateBranchProtectionRuleJSONRequestBody, repository string) {
+func (c *Controller) GetMergeStrategyRules(w http.ResponseWriter, r *http.Request, repository string) {
 	if !c.authorize(w, r, permissions.Node{
 		Permission: permissions.Permission{
-			Action:   permissions.SetBranchProtectionRulesAction,
+			Action:   permissions.GetMergeStrategyRulesAction,
 			Resource: permissions.RepoArn(repository),
 		},
 	}) {
 		return
 	}
 	ctx := r.Context()
-	// For now, all protected branches use the same default set of blocked actions. In the future this set will be user configurable.
-	blockedActions := []graveler.BranchProtectionBlockedAction{graveler.BranchProtectionBlockedAction_STAGING_WRITE, graveler.BranchProtectionBlockedAction_COMMIT}
-	err := c.Catalog.CreateBranchProtectionRule(ctx, repository, body.Pattern, blockedActions)
+	rules, err := c.Catalog.GetMergeStrategyRules(ctx, repository)
+	if handleAPIError(w, err) {
+		return
+	}
+	resp := MergeStrategyRules{Rules: make([]MergeStrategyRule, 0, len(rules.GetRules()))}
+	for _, rule := range rules.GetRules() {
+		resp.Rules = append(resp.Rules, MergeStrategyRule{Pattern: rule.Pattern, Strategy: rule.Strategy})
+	}
+	writeResponse(w, http.StatusOK, resp)
+}
+
+func (c *Controller) SetMergeStrategyRules(w http.ResponseWriter, r *http.Request, body SetMergeStrategyRulesJSONRequestBody, repository string) {
+	if !c.authorize(w, r, permissions.Node{
+		Permission: permissions.Permission{
+			Action:   permissions.SetMergeStrategyRulesAction,
+			Resource: permissions.RepoArn(repository),
+		},
+	}) {
+		return
+	}
+	ctx := r.Context()
+	c.LogAction(ctx, "set_merge_strategy_rules")
+	rules := &graveler.MergeStrategyRules{Rules: newGravelerMergeStrategyRules(&body.Rules)}
+	err := c.Catalog.SetMergeStrategyRules(ctx, repository, rules)
 	if handleAPIError(w, err) {
 		return
 	}
@@ -2514,6 +3421,44 @@ func (c *Controller) DiffRefs(w http.ResponseWriter, r *http.Request, repository
 	writeResponse(w, http.StatusOK, response)
 }
 
+func (c *Controller) DiffSummary(w http.ResponseWriter, r *http.Request, repository string, leftRef string, rightRef string, params DiffSummaryParams) {
+	if !c.authorize(w, r, permissions.Node{
+		Permission: permissions.Permission{
+			Action:   permissions.ListObjectsAction,
+			Resource: permissions.RepoArn(repository),
+		},
+	}) {
+		return
+	}
+	ctx := r.Context()
+	c.LogAction(ctx, "diff_summary")
+
+	summaryParams := catalog.DiffSummaryParams{
+		Depth:    1,
+		ThreeDot: params.Type == nil || *params.Type != "two_dot", // default diff type is three-dot
+	}
+	if params.Depth != nil {
+		summaryParams.Depth = *params.Depth
+	}
+	summary, err := c.Catalog.DiffSummary(ctx, repository, leftRef, rightRef, summaryParams)
+	if handleAPIError(w, err) {
+		return
+	}
+	results := make([]PrefixDiffSummary, 0, len(summary))
+	for _, s := range summary {
+		results = append(results, PrefixDiffSummary{
+			Prefix:       s.Prefix,
+			Added:        s.Added,
+			Removed:      s.Removed,
+			Changed:      s.Changed,
+			AddedBytes:   s.AddedBytes,
+			RemovedBytes: s.RemovedBytes,
+			ChangedBytes: s.ChangedBytes,
+		})
+	}
+	writeResponse(w, http.StatusOK, DiffSummaryList{Results: results})
+}
+
 // LogBranchCommits deprecated replaced by LogCommits
 func (c *Controller) LogBranchCommits(w http.ResponseWriter, r *http.Request, repository string, branch string, params LogBranchCommitsParams) {
 	c.logCommitsHelper(w, r, repository, branch, LogCommitsParams{
@@ -2548,6 +3493,10 @@ func (c *Controller) logCommitsHelper(w http.ResponseWriter, r *http.Request, re
 		return
 	}
 
+	writeResponse(w, http.StatusOK, serializeCommitList(commitLog, hasMore))
+}
+
+func serializeCommitList(commitLog []*catalog.CommitLog, hasMore bool) CommitList {
 	serializedCommits := make([]Commit, 0, len(commitLog))
 	for _, commit := range commitLog {
 		metadata := Commit_Metadata{
@@ -2561,14 +3510,58 @@ func (c *Controller) logCommitsHelper(w http.ResponseWriter, r *http.Request, re
 			Metadata:     &metadata,
 			MetaRangeId:  commit.MetaRangeID,
 			Parents:      commit.Parents,
+			Signature:    serializeCommitSignature(commit.Signature),
 		})
 	}
-
-	response := CommitList{
+	return CommitList{
 		Pagination: paginationFor(hasMore, serializedCommits, "Id"),
 		Results:    serializedCommits,
 	}
-	writeResponse(w, http.StatusOK, response)
+}
+
+func (c *Controller) SearchCommits(w http.ResponseWriter, r *http.Request, repository string, params SearchCommitsParams) {
+	if !c.authorize(w, r, permissions.Node{
+		Permission: permissions.Permission{
+			Action:   permissions.ReadCommitAction,
+			Resource: permissions.RepoArn(repository),
+		},
+	}) {
+		return
+	}
+	ctx := r.Context()
+	c.LogAction(ctx, "search_commits")
+
+	searchParams := catalog.SearchCommitsParams{
+		Committer: StringValue(params.Committer),
+		Message:   StringValue(params.Message),
+		After:     paginationAfter(params.After),
+		Limit:     paginationAmount(params.Amount),
+	}
+	if params.Metadata != nil {
+		searchParams.Metadata = make(map[string]string, len(*params.Metadata))
+		for _, kv := range *params.Metadata {
+			const keyValueParts = 2
+			parts := strings.SplitN(kv, "=", keyValueParts)
+			if len(parts) != keyValueParts || parts[0] == "" {
+				writeError(w, http.StatusBadRequest, fmt.Sprintf("metadata '%s' is not in the form key=value", kv))
+				return
+			}
+			searchParams.Metadata[parts[0]] = parts[1]
+		}
+	}
+	if params.Since != nil {
+		since := time.Unix(*params.Since, 0)
+		searchParams.Since = &since
+	}
+	if params.Until != nil {
+		until := time.Unix(*params.Until, 0)
+		searchParams.Until = &until
+	}
+	commitLog, hasMore, err := c.Catalog.SearchCommits(ctx, repository, searchParams)
+	if handleAPIError(w, err) {
+		return
+	}
+	writeResponse(w, http.StatusOK, serializeCommitList(commitLog, hasMore))
 }
 
 func (c *Controller) GetObject(w http.ResponseWriter, r *http.Request, repository string, ref string, params GetObjectParams) {
@@ -2751,6 +3744,71 @@ func (c *Controller) StatObject(w http.ResponseWriter, r *http.Request, reposito
 	writeResponse(w, code, objStat)
 }
 
+func (c *Controller) ObjectHistory(w http.ResponseWriter, r *http.Request, repository string, ref string, params ObjectHistoryParams) {
+	if !c.authorize(w, r, permissions.Node{
+		Type: permissions.NodeTypeAnd,
+		Nodes: []permissions.Node{
+			{
+				Permission: permissions.Permission{
+					Action:   permissions.ReadObjectAction,
+					Resource: permissions.ObjectArn(repository, params.Path)},
+			},
+			{
+				Permission: permissions.Permission{
+					Action:   permissions.ReadCommitAction,
+					Resource: permissions.RepoArn(repository)},
+			},
+		}}) {
+		return
+	}
+	ctx := r.Context()
+	c.LogAction(ctx, "object_history")
+
+	repo, err := c.Catalog.GetRepository(ctx, repository)
+	if handleAPIError(w, err) {
+		return
+	}
+	versions, hasMore, err := c.Catalog.ObjectHistory(ctx, repository, ref, params.Path, catalog.ObjectHistoryParams{
+		FirstParent: params.FirstParent != nil && *params.FirstParent,
+		After:       paginationAfter(params.After),
+		Limit:       paginationAmount(params.Amount),
+	})
+	if handleAPIError(w, err) {
+		return
+	}
+	results := make([]ObjectVersion, 0, len(versions))
+	for _, version := range versions {
+		objVersion := ObjectVersion{
+			CommitId:     version.CommitID,
+			CreationDate: version.CreationDate.Unix(),
+			Committer:    version.Committer,
+			Type:         transformDifferenceTypeToString(version.Type),
+		}
+		if version.Entry != nil {
+			qk, err := block.ResolveNamespace(repo.StorageNamespace, version.Entry.PhysicalAddress, version.Entry.AddressType.ToIdentifierType())
+			if handleAPIError(w, err) {
+				return
+			}
+			objVersion.PhysicalAddress = StringPtr(qk.Format())
+			objVersion.Checksum = StringPtr(version.Entry.Checksum)
+			objVersion.SizeBytes = Int64Ptr(version.Entry.Size)
+		}
+		results = append(results, objVersion)
+	}
+	response := ObjectVersionList{
+		Pagination: Pagination{
+			HasMore:    hasMore,
+			MaxPerPage: DefaultMaxPerPage,
+			Results:    len(results),
+		},
+		Results: results,
+	}
+	if hasMore && len(results) > 0 {
+		response.Pagination.NextOffset = results[len(results)-1].CommitId
+	}
+	writeResponse(w, http.StatusOK, response)
+}
+
 func (c *Controller) GetUnderlyingProperties(w http.ResponseWriter, r *http.Request, repository string, ref string, params GetUnderlyingPropertiesParams) {
 	if !c.authorize(w, r, permissions.Node{
 		Permission: permissions.Permission{
@@ -2812,7 +3870,9 @@ func (c *Controller) MergeIntoBranch(w http.ResponseWriter, r *http.Request, bod
 		user.Username,
 		StringValue(body.Message),
 		metadata,
-		StringValue(body.Strategy))
+		StringValue(body.Strategy),
+		newGravelerMergeStrategyRules(body.StrategyRules),
+		StringValue(body.Mode))
 
 	var hookAbortErr *graveler.HookAbortError
 	switch {
@@ -2821,7 +3881,15 @@ func (c *Controller) MergeIntoBranch(w http.ResponseWriter, r *http.Request, bod
 		writeError(w, http.StatusPreconditionFailed, err)
 		return
 	case errors.Is(err, catalog.ErrConflictFound) || errors.Is(err, graveler.ErrConflictFound):
-		writeResponse(w, http.StatusConflict, MergeResult{Reference: res})
+		result := MergeResult{Reference: res}
+		var conflictsErr *catalog.MergeConflictsError
+		if errors.As(err, &conflictsErr) {
+			conflicts := newMergeConflicts(conflictsErr.Conflicts)
+			result.Conflicts = &conflicts
+			result.ConflictsTruncated = swag.Bool(conflictsErr.Truncated)
+			result.Summary.Conflict = len(conflicts)
+		}
+		writeResponse(w, http.StatusConflict, result)
 		return
 	}
 	if handleAPIError(w, err) {
@@ -2830,6 +3898,170 @@ func (c *Controller) MergeIntoBranch(w http.ResponseWriter, r *http.Request, bod
 	writeResponse(w, http.StatusOK, MergeResult{Reference: res})
 }
 
+func (c *Controller) MergePreview(w http.ResponseWriter, r *http.Request, repository string, sourceRef string, destinationBranch string, params MergePreviewParams) {
+	if !c.authorize(w, r, permissions.Node{
+		Permission: permissions.Permission{
+			Action:   permissions.ListObjectsAction,
+			Resource: permissions.RepoArn(repository),
+		},
+	}) {
+		return
+	}
+	ctx := r.Context()
+	c.LogAction(ctx, "merge_preview")
+	var strategyRules []*graveler.MergeStrategyRule
+	if params.StrategyRule != nil {
+		for _, rule := range *params.StrategyRule {
+			idx := strings.LastIndex(rule, "=")
+			if idx < 0 {
+				writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid strategy rule '%s', expected <pattern>=<strategy>", rule))
+				return
+			}
+			strategyRules = append(strategyRules, &graveler.MergeStrategyRule{Pattern: rule[:idx], Strategy: rule[idx+1:]})
+		}
+	}
+	preview, err := c.Catalog.MergePreview(ctx, repository, destinationBranch, sourceRef, StringValue(params.Strategy), strategyRules)
+	if handleAPIError(w, err) {
+		return
+	}
+	summary := make([]MergePreviewSummary, 0, len(preview.Summary))
+	for _, s := range preview.Summary {
+		summary = append(summary, MergePreviewSummary{
+			Prefix:   s.Prefix,
+			Added:    s.Added,
+			Removed:  s.Removed,
+			Changed:  s.Changed,
+			Conflict: s.Conflict,
+		})
+	}
+	response := MergePreview{
+		SourceCommitId:      preview.SourceCommitID,
+		DestinationCommitId: preview.DestinationCommitID,
+		BaseCommitId:        preview.BaseCommitID,
+		FastForward:         preview.FastForward,
+		Summary:             summary,
+		Conflicts:           newMergeConflicts(preview.Conflicts),
+		ConflictsTruncated:  swag.Bool(preview.ConflictsTruncated),
+	}
+	writeResponse(w, http.StatusOK, response)
+}
+
+func (c *Controller) CompareBranches(w http.ResponseWriter, r *http.Request, repository string, baseRef string, branch string) {
+	if !c.authorize(w, r, permissions.Node{
+		Type: permissions.NodeTypeAnd,
+		Nodes: []permissions.Node{
+			{
+				Permission: permissions.Permission{
+					Action:   permissions.ReadBranchAction,
+					Resource: permissions.BranchArn(repository, branch)},
+			},
+			{
+				Permission: permissions.Permission{
+					Action:   permissions.ListObjectsAction,
+					Resource: permissions.RepoArn(repository)},
+			},
+		}}) {
+		return
+	}
+	ctx := r.Context()
+	c.LogAction(ctx, "compare_branches")
+
+	comparison, err := c.Catalog.CompareBranch(ctx, repository, branch, baseRef)
+	if handleAPIError(w, err) {
+		return
+	}
+	writeResponse(w, http.StatusOK, serializeBranchComparison(comparison))
+}
+
+func (c *Controller) CompareAllBranches(w http.ResponseWriter, r *http.Request, repository string, baseRef string, params CompareAllBranchesParams) {
+	if !c.authorize(w, r, permissions.Node{
+		Type: permissions.NodeTypeAnd,
+		Nodes: []permissions.Node{
+			{
+				Permission: permissions.Permission{
+					Action:   permissions.ListBranchesAction,
+					Resource: permissions.RepoArn(repository)},
+			},
+			{
+				Permission: permissions.Permission{
+					Action:   permissions.ListObjectsAction,
+					Resource: permissions.RepoArn(repository)},
+			},
+		}}) {
+		return
+	}
+	ctx := r.Context()
+	c.LogAction(ctx, "compare_all_branches")
+
+	res, hasMore, err := c.Catalog.CompareBranches(ctx, repository, baseRef, paginationPrefix(params.Prefix), paginationAmount(params.Amount), paginationAfter(params.After))
+	if handleAPIError(w, err) {
+		return
+	}
+	comparisons := make([]BranchComparison, 0, len(res))
+	for _, comparison := range res {
+		comparisons = append(comparisons, serializeBranchComparison(comparison))
+	}
+	response := BranchComparisonList{
+		Results:    comparisons,
+		Pagination: paginationFor(hasMore, comparisons, "Branch"),
+	}
+	response.Pagination.MaxPerPage = catalog.CompareBranchesLimitMax
+	writeResponse(w, http.StatusOK, response)
+}
+
+func serializeBranchComparison(comparison *catalog.BranchComparison) BranchComparison {
+	res := BranchComparison{
+		Branch:       comparison.Branch,
+		CommitId:     comparison.CommitID,
+		BaseCommitId: comparison.BaseCommitID,
+		Ahead:        comparison.Ahead,
+		Behind:       comparison.Behind,
+		Added:        comparison.Added,
+		Removed:      comparison.Removed,
+		Changed:      comparison.Changed,
+	}
+	if comparison.MergeBaseID != "" {
+		res.MergeBaseId = StringPtr(comparison.MergeBaseID)
+	}
+	return res
+}
+
+func newMergeConflicts(catalogConflicts []catalog.MergeConflict) []MergeConflict {
+	conflicts := make([]MergeConflict, 0, len(catalogConflicts))
+	for _, conflict := range catalogConflicts {
+		conflicts = append(conflicts, MergeConflict{
+			Path:        conflict.Path,
+			Source:      newMergeConflictEntry(conflict.Source),
+			Destination: newMergeConflictEntry(conflict.Destination),
+			Base:        newMergeConflictEntry(conflict.Base),
+		})
+	}
+	return conflicts
+}
+
+func newGravelerMergeStrategyRules(rules *[]MergeStrategyRule) []*graveler.MergeStrategyRule {
+	if rules == nil {
+		return nil
+	}
+	strategyRules := make([]*graveler.MergeStrategyRule, 0, len(*rules))
+	for _, rule := range *rules {
+		strategyRules = append(strategyRules, &graveler.MergeStrategyRule{Pattern: rule.Pattern, Strategy: rule.Strategy})
+	}
+	return strategyRules
+}
+
+func newMergeConflictEntry(entry *catalog.MergeConflictEntry) *MergeConflictEntry {
+	if entry == nil {
+		return nil
+	}
+	return &MergeConflictEntry{
+		Identity:        entry.Identity,
+		PhysicalAddress: swag.String(entry.PhysicalAddress),
+		Checksum:        swag.String(entry.Checksum),
+		SizeBytes:       swag.Int64(entry.Size),
+	}
+}
+
 func (c *Controller) ListTags(w http.ResponseWriter, r *http.Request, repository string, params ListTagsParams) {
 	if !c.authorize(w, r, permissions.Node{
 		Permission: permissions.Permission{
@@ -2847,14 +4079,11 @@ func (c *Controller) ListTags(w http.ResponseWriter, r *http.Request, repository
 		return
 	}
 
-	results := make([]Ref, 0, len(res))
+	results := make([]Tag, 0, len(res))
 	for _, tag := range res {
-		results = append(results, Ref{
-			CommitId: tag.CommitID,
-			Id:       tag.ID,
-		})
+		results = append(results, serializeTag(tag))
 	}
-	response := RefList{
+	response := TagList{
 		Results:    results,
 		Pagination: paginationFor(hasMore, results, "Id"),
 	}
@@ -2873,15 +4102,29 @@ func (c *Controller) CreateTag(w http.ResponseWriter, r *http.Request, body Crea
 	ctx := r.Context()
 	c.LogAction(ctx, "create_tag")
 
-	commitID, err := c.Catalog.CreateTag(ctx, repository, body.Id, body.Ref)
+	var err error
+	if body.Message == nil && body.Metadata == nil {
+		_, err = c.Catalog.CreateTag(ctx, repository, body.Id, body.Ref)
+	} else {
+		user, ok := ctx.Value(UserContextKey).(*model.User)
+		if !ok {
+			writeError(w, http.StatusUnauthorized, "missing user")
+			return
+		}
+		var metadata map[string]string
+		if body.Metadata != nil {
+			metadata = body.Metadata.AdditionalProperties
+		}
+		_, err = c.Catalog.CreateAnnotatedTag(ctx, repository, body.Id, body.Ref, StringValue(body.Message), user.Username, metadata)
+	}
 	if handleAPIError(w, err) {
 		return
 	}
-	response := Ref{
-		CommitId: commitID,
-		Id:       body.Id,
+	tag, err := c.Catalog.GetTag(ctx, repository, body.Id)
+	if handleAPIError(w, err) {
+		return
 	}
-	writeResponse(w, http.StatusCreated, response)
+	writeResponse(w, http.StatusCreated, serializeTag(tag))
 }
 
 func (c *Controller) DeleteTag(w http.ResponseWriter, r *http.Request, repository string, tag string) {
@@ -2913,15 +4156,27 @@ func (c *Controller) GetTag(w http.ResponseWriter, r *http.Request, repository s
 	}
 	ctx := r.Context()
 	c.LogAction(ctx, "get_tag")
-	reference, err := c.Catalog.GetTag(ctx, repository, tag)
+	res, err := c.Catalog.GetTag(ctx, repository, tag)
 	if handleAPIError(w, err) {
 		return
 	}
-	response := Ref{
-		CommitId: reference,
-		Id:       tag,
+	writeResponse(w, http.StatusOK, serializeTag(res))
+}
+
+func serializeTag(tag *catalog.Tag) Tag {
+	response := Tag{
+		CommitId: tag.CommitID,
+		Id:       tag.ID,
+	}
+	if tag.Annotation != nil {
+		response.Annotation = &TagAnnotation{
+			CreationDate: tag.Annotation.CreationDate.Unix(),
+			Message:      tag.Annotation.Message,
+			Metadata:     &TagAnnotation_Metadata{AdditionalProperties: tag.Annotation.Metadata},
+			Tagger:       tag.Annotation.Tagger,
+		}
 	}
-	writeResponse(w, http.StatusOK, response)
+	return response
 }
 
 func (c *Controller) GetSetupState(w http.ResponseWriter, r *http.Request) {
@@ -3,6 +3,8 @@ package api_test
 import (
 	"bytes"
 	"context"
+	"crypto/ed25519"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
@@ -23,6 +25,7 @@ import (
 	nanoid "github.com/matoous/go-nanoid/v2"
 	"github.com/stretchr/testify/require"
 	"github.com/treeverse/lakefs/pkg/api"
+	"github.com/treeverse/lakefs/pkg/auth/keys"
 	"github.com/treeverse/lakefs/pkg/block"
 	"github.com/treeverse/lakefs/pkg/catalog"
 	"github.com/treeverse/lakefs/pkg/graveler"
@@ -30,6 +33,7 @@ import (
 	"github.com/treeverse/lakefs/pkg/stats"
 	"github.com/treeverse/lakefs/pkg/testutil"
 	"github.com/treeverse/lakefs/pkg/upload"
+	"golang.org/x/crypto/ssh"
 )
 
 const (
@@ -216,7 +220,7 @@ func testCommitEntries(t *testing.T, ctx context.Context, cat catalog.Interface,
 			})
 		testutil.MustDo(t, "create entry "+p, err)
 	}
-	commit, err := cat.Commit(ctx, params.repo, params.branch, "commit"+params.commitName, params.user, nil, nil)
+	commit, err := cat.Commit(ctx, params.repo, params.branch, "commit"+params.commitName, params.user, nil, nil, nil)
 	testutil.MustDo(t, "commit", err)
 	return commit.Reference
 }
@@ -246,7 +250,7 @@ func TestController_CommitsGetBranchCommitLogHandler(t *testing.T) {
 			p := "foo/bar" + n
 			err := deps.catalog.CreateEntry(ctx, "repo2", "main", catalog.DBEntry{Path: p, PhysicalAddress: onBlock(deps, "bar"+n+"addr"), CreationDate: time.Now(), Size: int64(i) + 1, Checksum: "cksum" + n})
 			testutil.MustDo(t, "create entry "+p, err)
-			_, err = deps.catalog.Commit(ctx, "repo2", "main", "commit"+n, "some_user", nil, nil)
+			_, err = deps.catalog.Commit(ctx, "repo2", "main", "commit"+n, "some_user", nil, nil, nil)
 			testutil.MustDo(t, "commit "+p, err)
 		}
 		resp, err := clt.LogCommitsWithResponse(ctx, "repo2", "main", &api.LogCommitsParams{})
@@ -360,7 +364,7 @@ func TestController_CommitsGetBranchCommitLogByPath(t *testing.T) {
 		user:         "user3",
 		commitName:   "P",
 	})
-	mergeCommit, err := deps.catalog.Merge(ctx, "repo3", "main", "branch-b", "user3", "commitR", nil, "")
+	mergeCommit, err := deps.catalog.Merge(ctx, "repo3", "main", "branch-b", "user3", "commitR", nil, "", nil, "")
 	testutil.Must(t, err)
 	commitsMap["commitR"] = mergeCommit
 	commitsMap["commitM"] = testCommitEntries(t, ctx, deps.catalog, deps, commitEntriesParams{
@@ -371,7 +375,7 @@ func TestController_CommitsGetBranchCommitLogByPath(t *testing.T) {
 		user:         "user2",
 		commitName:   "M",
 	})
-	mergeCommit, err = deps.catalog.Merge(ctx, "repo3", "main", "branch-a", "user2", "commitN", nil, "")
+	mergeCommit, err = deps.catalog.Merge(ctx, "repo3", "main", "branch-a", "user2", "commitN", nil, "", nil, "")
 	testutil.Must(t, err)
 	commitsMap["commitN"] = mergeCommit
 	commitsMap["commitX"] = testCommitEntries(t, ctx, deps.catalog, deps, commitEntriesParams{
@@ -469,14 +473,14 @@ func TestController_GetCommitHandler(t *testing.T) {
 		_, err := deps.catalog.CreateRepository(ctx, "foo1", onBlock(deps, "foo1"), "main")
 		testutil.Must(t, err)
 		testutil.MustDo(t, "create entry bar1", deps.catalog.CreateEntry(ctx, "foo1", "main", catalog.DBEntry{Path: "foo/bar1", PhysicalAddress: "bar1addr", CreationDate: time.Now(), Size: 1, Checksum: "cksum1"}))
-		commit1, err := deps.catalog.Commit(ctx, "foo1", "main", "some message", DefaultUserID, nil, nil)
+		commit1, err := deps.catalog.Commit(ctx, "foo1", "main", "some message", DefaultUserID, nil, nil, nil)
 		testutil.Must(t, err)
 		reference1, err := deps.catalog.GetBranchReference(ctx, "foo1", "main")
 		if err != nil {
 			t.Fatal(err)
 		}
 		if reference1 != commit1.Reference {
-			t.Fatalf("Commit reference %s, not equals to branch reference %s", commit1, reference1)
+			t.Fatalf("Commit reference %s, not equals to branch reference %s", commit1.Reference, reference1)
 		}
 		resp, err := clt.GetCommitWithResponse(ctx, "foo1", commit1.Reference)
 		verifyResponseOK(t, resp, err)
@@ -488,6 +492,53 @@ func TestController_GetCommitHandler(t *testing.T) {
 	})
 }
 
+func TestController_SearchCommits(t *testing.T) {
+	clt, deps := setupClientWithAdmin(t)
+	ctx := context.Background()
+	_, err := deps.catalog.CreateRepository(ctx, "repo1", onBlock(deps, "foo1"), "main")
+	testutil.Must(t, err)
+	var commitIDs []string
+	for i, jobID := range []string{"1", "2", "2"} {
+		testutil.Must(t, deps.catalog.CreateEntry(ctx, "repo1", "main", catalog.DBEntry{Path: fmt.Sprintf("foo/bar%d", i), PhysicalAddress: "addr", CreationDate: time.Now(), Size: 1, Checksum: "cksum"}))
+		commit, err := deps.catalog.Commit(ctx, "repo1", "main", fmt.Sprintf("load %d", i), DefaultUserID, catalog.Metadata{"job_id": jobID}, api.Int64Ptr(int64(i+1)), nil)
+		testutil.Must(t, err)
+		commitIDs = append(commitIDs, commit.Reference)
+	}
+
+	t.Run("metadata", func(t *testing.T) {
+		resp, err := clt.SearchCommitsWithResponse(ctx, "repo1", &api.SearchCommitsParams{Metadata: &[]string{"job_id=2"}})
+		verifyResponseOK(t, resp, err)
+		var ids []string
+		for _, commit := range resp.JSON200.Results {
+			ids = append(ids, commit.Id)
+		}
+		if diff := deep.Equal(ids, []string{commitIDs[2], commitIDs[1]}); diff != nil {
+			t.Fatal("SearchCommits found unexpected commits", diff)
+		}
+	})
+
+	t.Run("message and pagination", func(t *testing.T) {
+		resp, err := clt.SearchCommitsWithResponse(ctx, "repo1", &api.SearchCommitsParams{
+			Message: api.StringPtr("LOAD"),
+			Amount:  api.PaginationAmountPtr(1),
+			After:   api.PaginationAfterPtr(commitIDs[2]),
+		})
+		verifyResponseOK(t, resp, err)
+		results := resp.JSON200.Results
+		if len(results) != 1 || results[0].Id != commitIDs[1] || !resp.JSON200.Pagination.HasMore {
+			t.Fatalf("SearchCommits %+v, expected %s with more results", resp.JSON200, commitIDs[1])
+		}
+	})
+
+	t.Run("invalid metadata", func(t *testing.T) {
+		resp, err := clt.SearchCommitsWithResponse(ctx, "repo1", &api.SearchCommitsParams{Metadata: &[]string{"job_id"}})
+		testutil.Must(t, err)
+		if resp.JSON400 == nil {
+			t.Fatalf("SearchCommits with invalid metadata status %d, expected 400", resp.StatusCode())
+		}
+	})
+}
+
 func TestController_CommitHandler(t *testing.T) {
 	clt, deps := setupClientWithAdmin(t)
 	ctx := context.Background()
@@ -530,6 +581,24 @@ func TestController_CommitHandler(t *testing.T) {
 			t.Errorf("creation date expected %d, got: %d", date, resp.JSON201.CreationDate)
 		}
 	})
+
+	t.Run("commit success - with prefixes", func(t *testing.T) {
+		_, err := deps.catalog.CreateRepository(ctx, "foo3", onBlock(deps, "foo3"), "main")
+		testutil.MustDo(t, "create repo foo3", err)
+		for _, p := range []string{"foo/bar", "foo/baz", "other/bar"} {
+			testutil.MustDo(t, "create entry "+p, deps.catalog.CreateEntry(ctx, "foo3", "main", catalog.DBEntry{Path: p, PhysicalAddress: "pa", CreationDate: time.Now(), Size: 666, Checksum: "cs", Metadata: nil}))
+		}
+		resp, err := clt.CommitWithResponse(ctx, "foo3", "main", api.CommitJSONRequestBody{
+			Message:  "some message",
+			Prefixes: &[]string{"foo/"},
+		})
+		verifyResponseOK(t, resp, err)
+		diff, _, err := deps.catalog.DiffUncommitted(ctx, "foo3", "main", "", "", -1, "")
+		testutil.MustDo(t, "diff uncommitted", err)
+		if len(diff) != 1 || diff[0].Path != "other/bar" {
+			t.Errorf("uncommitted changes after partial commit %+v, expected other/bar", diff)
+		}
+	})
 }
 
 func TestController_CreateRepositoryHandler(t *testing.T) {
@@ -634,6 +703,71 @@ func TestController_DeleteRepositoryHandler(t *testing.T) {
 	})
 }
 
+func TestController_RestoreRepositoryHandler(t *testing.T) {
+	clt, deps := setupClientWithAdmin(t)
+	ctx := context.Background()
+	repo := testUniqueRepoName()
+	_, err := deps.catalog.CreateRepository(ctx, repo, onBlock(deps, repo), "main")
+	testutil.Must(t, err)
+
+	resp, err := clt.RestoreRepositoryWithResponse(ctx, repo)
+	testutil.Must(t, err)
+	if resp.JSON404 == nil {
+		t.Fatalf("RestoreRepository of existing repository expected 404, got %d", resp.StatusCode())
+	}
+
+	deleteResp, err := clt.DeleteRepositoryWithResponse(ctx, repo)
+	verifyResponseOK(t, deleteResp, err)
+	resp, err = clt.RestoreRepositoryWithResponse(ctx, repo)
+	verifyResponseOK(t, resp, err)
+	if resp.JSON200.Id != repo {
+		t.Errorf("RestoreRepository id=%s, expected %s", resp.JSON200.Id, repo)
+	}
+	branchResp, err := clt.GetBranchWithResponse(ctx, repo, "main")
+	verifyResponseOK(t, branchResp, err)
+}
+
+func TestController_RenameRepositoryHandler(t *testing.T) {
+	clt, deps := setupClientWithAdmin(t)
+	ctx := context.Background()
+	repo := testUniqueRepoName()
+	_, err := deps.catalog.CreateRepository(ctx, repo, onBlock(deps, repo), "main")
+	testutil.Must(t, err)
+	otherRepo := testUniqueRepoName()
+	_, err = deps.catalog.CreateRepository(ctx, otherRepo, onBlock(deps, otherRepo), "main")
+	testutil.Must(t, err)
+
+	t.Run("existing name", func(t *testing.T) {
+		resp, err := clt.RenameRepositoryWithResponse(ctx, repo, api.RenameRepositoryJSONRequestBody{Name: otherRepo})
+		testutil.Must(t, err)
+		if resp.JSON409 == nil {
+			t.Fatalf("RenameRepository to existing name expected 409, got %d", resp.StatusCode())
+		}
+	})
+
+	t.Run("with alias", func(t *testing.T) {
+		newName := testUniqueRepoName()
+		aliasPeriod := int64(3600)
+		resp, err := clt.RenameRepositoryWithResponse(ctx, repo, api.RenameRepositoryJSONRequestBody{Name: newName, AliasPeriod: &aliasPeriod})
+		verifyResponseOK(t, resp, err)
+		if resp.JSON200.Id != newName {
+			t.Errorf("RenameRepository id=%s, expected %s", resp.JSON200.Id, newName)
+		}
+		getResp, err := clt.GetRepositoryWithResponse(ctx, repo)
+		testutil.Must(t, err)
+		if getResp.JSON404 == nil {
+			t.Errorf("GetRepository of previous name expected 404, got %d", getResp.StatusCode())
+		}
+		branchResp, err := clt.GetBranchWithResponse(ctx, newName, "main")
+		verifyResponseOK(t, branchResp, err)
+		aliased, err := deps.catalog.GetRepositoryByAlias(ctx, repo)
+		testutil.Must(t, err)
+		if aliased.Name != newName {
+			t.Errorf("GetRepositoryByAlias=%s, expected %s", aliased.Name, newName)
+		}
+	})
+}
+
 func TestController_ListBranchesHandler(t *testing.T) {
 	clt, deps := setupClientWithAdmin(t)
 	ctx := context.Background()
@@ -661,7 +795,7 @@ func TestController_ListBranchesHandler(t *testing.T) {
 
 		// create first dummy commit on main so that we can create branches from it
 		testutil.Must(t, deps.catalog.CreateEntry(ctx, "repo2", "main", catalog.DBEntry{Path: "a/b"}))
-		_, err = deps.catalog.Commit(ctx, "repo2", "main", "first commit", "test", nil, nil)
+		_, err = deps.catalog.Commit(ctx, "repo2", "main", "first commit", "test", nil, nil, nil)
 		testutil.Must(t, err)
 
 		for i := 0; i < 7; i++ {
@@ -715,10 +849,10 @@ func TestController_ListTagsHandler(t *testing.T) {
 	_, err := deps.catalog.CreateRepository(ctx, "repo1", onBlock(deps, "foo1"), "main")
 	testutil.Must(t, err)
 	testutil.Must(t, deps.catalog.CreateEntry(ctx, "repo1", "main", catalog.DBEntry{Path: "obj1"}))
-	commitLog, err := deps.catalog.Commit(ctx, "repo1", "main", "first commit", "test", nil, nil)
+	commitLog, err := deps.catalog.Commit(ctx, "repo1", "main", "first commit", "test", nil, nil, nil)
 	testutil.Must(t, err)
 	const createTagLen = 7
-	var createdTags []api.Ref
+	var createdTags []api.Tag
 	for i := 0; i < createTagLen; i++ {
 		tagID := "tag" + strconv.Itoa(i)
 		commitID := commitLog.Reference
@@ -727,7 +861,7 @@ func TestController_ListTagsHandler(t *testing.T) {
 			Ref: commitID,
 		})
 		testutil.Must(t, err)
-		createdTags = append(createdTags, api.Ref{
+		createdTags = append(createdTags, api.Tag{
 			Id:       tagID,
 			CommitId: commitID,
 		})
@@ -750,7 +884,7 @@ func TestController_ListTagsHandler(t *testing.T) {
 
 	t.Run("pagination", func(t *testing.T) {
 		const pageSize = 2
-		var results []api.Ref
+		var results []api.Tag
 		var after string
 		var calls int
 		for {
@@ -795,7 +929,7 @@ func TestController_GetBranchHandler(t *testing.T) {
 		testutil.Must(t, err)
 		// create first dummy commit on main so that we can create branches from it
 		testutil.Must(t, deps.catalog.CreateEntry(ctx, "repo1", testBranch, catalog.DBEntry{Path: "a/b"}))
-		_, err = deps.catalog.Commit(ctx, "repo1", testBranch, "first commit", "test", nil, nil)
+		_, err = deps.catalog.Commit(ctx, "repo1", testBranch, "first commit", "test", nil, nil, nil)
 		testutil.Must(t, err)
 
 		resp, err := clt.GetBranchWithResponse(ctx, "repo1", testBranch)
@@ -878,7 +1012,7 @@ func TestController_CreateBranchHandler(t *testing.T) {
 		_, err := deps.catalog.CreateRepository(ctx, "repo1", onBlock(deps, "foo1"), "main")
 		testutil.Must(t, err)
 		testutil.Must(t, deps.catalog.CreateEntry(ctx, "repo1", "main", catalog.DBEntry{Path: "a/b"}))
-		_, err = deps.catalog.Commit(ctx, "repo1", "main", "first commit", "test", nil, nil)
+		_, err = deps.catalog.Commit(ctx, "repo1", "main", "first commit", "test", nil, nil, nil)
 		testutil.Must(t, err)
 
 		const newBranchName = "main2"
@@ -897,7 +1031,7 @@ func TestController_CreateBranchHandler(t *testing.T) {
 		uploadResp, err := uploadObjectHelper(t, ctx, clt, path, strings.NewReader(content), "repo1", newBranchName)
 		verifyResponseOK(t, uploadResp, err)
 
-		if _, err := deps.catalog.Commit(ctx, "repo1", "main2", "commit 1", "some_user", nil, nil); err != nil {
+		if _, err := deps.catalog.Commit(ctx, "repo1", "main2", "commit 1", "some_user", nil, nil, nil); err != nil {
 			t.Fatalf("failed to commit 'repo1': %s", err)
 		}
 		resp2, err := clt.DiffRefsWithResponse(ctx, "repo1", "main", newBranchName, &api.DiffRefsParams{})
@@ -952,6 +1086,46 @@ func TestController_CreateBranchHandler(t *testing.T) {
 			t.Fatal("CreateBranch expected conflict")
 		}
 	})
+
+	t.Run("create ephemeral branch", func(t *testing.T) {
+		expiresAt := time.Now().Add(time.Hour).Unix()
+		resp, err := clt.CreateBranchWithResponse(ctx, "repo1", api.CreateBranchJSONRequestBody{
+			Name:      "ephemeral",
+			Source:    "main",
+			ExpiresAt: api.Int64Ptr(expiresAt),
+			IdleTtl:   api.Int64Ptr(600),
+		})
+		verifyResponseOK(t, resp, err)
+
+		getResp, err := clt.GetBranchWithResponse(ctx, "repo1", "ephemeral")
+		verifyResponseOK(t, getResp, err)
+		ref := getResp.JSON200
+		if ref.ExpiresAt == nil || *ref.ExpiresAt != expiresAt || ref.IdleTtl == nil || *ref.IdleTtl != 600 {
+			t.Fatalf("branch %+v, expected to expire at %d with idle TTL 600", ref, expiresAt)
+		}
+
+		prefix := api.PaginationPrefix("ephemeral")
+		listResp, err := clt.ListBranchesWithResponse(ctx, "repo1", &api.ListBranchesParams{Prefix: &prefix})
+		verifyResponseOK(t, listResp, err)
+		results := listResp.JSON200.Results
+		if len(results) != 1 || deep.Equal(results[0].ExpiresAt, ref.ExpiresAt) != nil || deep.Equal(results[0].IdleTtl, ref.IdleTtl) != nil {
+			t.Fatalf("ListBranches %+v, expected ephemeral branch with its expiry", results)
+		}
+	})
+
+	t.Run("create ephemeral branch negative idle ttl", func(t *testing.T) {
+		resp, err := clt.CreateBranchWithResponse(ctx, "repo1", api.CreateBranchJSONRequestBody{
+			Name:    "ephemeral2",
+			Source:  "main",
+			IdleTtl: api.Int64Ptr(-1),
+		})
+		if err != nil {
+			t.Fatal("CreateBranch failed with error:", err)
+		}
+		if resp.JSON400 == nil {
+			t.Fatalf("CreateBranch with negative idle TTL status %d, expected 400", resp.StatusCode())
+		}
+	})
 }
 
 func uploadObjectHelper(t testing.TB, ctx context.Context, clt api.ClientWithResponsesInterface, path string, reader io.Reader, repo, branch string) (*api.UploadObjectResponse, error) {
@@ -1068,7 +1242,7 @@ func TestController_UploadObject(t *testing.T) {
 		}
 
 		// commit
-		_, err = deps.catalog.Commit(ctx, "my-new-repo", "another-branch", "a commit!", "user1", nil, nil)
+		_, err = deps.catalog.Commit(ctx, "my-new-repo", "another-branch", "a commit!", "user1", nil, nil, nil)
 		testutil.Must(t, err)
 
 		// overwrite after commit
@@ -1110,7 +1284,7 @@ func TestController_DeleteBranchHandler(t *testing.T) {
 		_, err := deps.catalog.CreateRepository(ctx, "my-new-repo", onBlock(deps, "foo1"), "main")
 		testutil.Must(t, err)
 		testutil.Must(t, deps.catalog.CreateEntry(ctx, "my-new-repo", "main", catalog.DBEntry{Path: "a/b"}))
-		_, err = deps.catalog.Commit(ctx, "my-new-repo", "main", "first commit", "test", nil, nil)
+		_, err = deps.catalog.Commit(ctx, "my-new-repo", "main", "first commit", "test", nil, nil, nil)
 		testutil.Must(t, err)
 
 		_, err = deps.catalog.CreateBranch(ctx, "my-new-repo", "main2", "main")
@@ -1150,6 +1324,85 @@ func TestController_DeleteBranchHandler(t *testing.T) {
 	})
 }
 
+func TestController_ObjectHistory(t *testing.T) {
+	clt, deps := setupClientWithAdmin(t)
+	ctx := context.Background()
+	_, err := deps.catalog.CreateRepository(ctx, "repo1", onBlock(deps, "some-bucket"), "main")
+	testutil.Must(t, err)
+
+	commit := func(branch, path, address string) string {
+		t.Helper()
+		if address == "" {
+			testutil.Must(t, deps.catalog.DeleteEntry(ctx, "repo1", branch, path))
+		} else {
+			testutil.Must(t, deps.catalog.CreateEntry(ctx, "repo1", branch, catalog.DBEntry{Path: path, PhysicalAddress: address, CreationDate: time.Now(), Size: int64(len(address)), Checksum: "cksum_" + address}))
+		}
+		c, err := deps.catalog.Commit(ctx, "repo1", branch, "commit "+path, DefaultUserID, nil, nil, nil)
+		testutil.Must(t, err)
+		return c.Reference
+	}
+	added := commit("main", "foo/bar", "addr1")
+	commit("main", "foo/other", "addr2")
+	_, err = deps.catalog.CreateBranch(ctx, "repo1", "feature", "main")
+	testutil.Must(t, err)
+	changed := commit("feature", "foo/bar", "addr3")
+	commit("main", "foo/another", "addr4")
+	merged, err := deps.catalog.Merge(ctx, "repo1", "main", "feature", DefaultUserID, "merge feature", nil, "", nil, "")
+	testutil.Must(t, err)
+	removed := commit("main", "foo/bar", "")
+
+	type version struct {
+		CommitID string
+		Type     string
+		Address  string
+	}
+	history := func(params *api.ObjectHistoryParams) ([]version, bool) {
+		t.Helper()
+		resp, err := clt.ObjectHistoryWithResponse(ctx, "repo1", "main", params)
+		verifyResponseOK(t, resp, err)
+		var versions []version
+		for _, v := range resp.JSON200.Results {
+			versions = append(versions, version{CommitID: v.CommitId, Type: v.Type, Address: api.StringValue(v.PhysicalAddress)})
+		}
+		return versions, resp.JSON200.Pagination.HasMore
+	}
+
+	t.Run("full history", func(t *testing.T) {
+		versions, hasMore := history(&api.ObjectHistoryParams{Path: "foo/bar"})
+		expected := []version{
+			{CommitID: removed, Type: "removed"},
+			{CommitID: changed, Type: "changed", Address: onBlock(deps, "some-bucket/") + "addr3"},
+			{CommitID: added, Type: "added", Address: onBlock(deps, "some-bucket/") + "addr1"},
+		}
+		if diff := deep.Equal(versions, expected); diff != nil || hasMore {
+			t.Fatalf("ObjectHistory %+v (has more %t), diff %s", versions, hasMore, diff)
+		}
+	})
+
+	t.Run("first parent", func(t *testing.T) {
+		firstParent := true
+		versions, _ := history(&api.ObjectHistoryParams{Path: "foo/bar", FirstParent: &firstParent})
+		var ids []string
+		for _, v := range versions {
+			ids = append(ids, v.CommitID)
+		}
+		if diff := deep.Equal(ids, []string{removed, merged, added}); diff != nil {
+			t.Fatal("ObjectHistory with first parent found unexpected commits", diff)
+		}
+	})
+
+	t.Run("pagination", func(t *testing.T) {
+		versions, hasMore := history(&api.ObjectHistoryParams{
+			Path:   "foo/bar",
+			After:  api.PaginationAfterPtr(removed),
+			Amount: api.PaginationAmountPtr(1),
+		})
+		if len(versions) != 1 || versions[0].CommitID != changed || !hasMore {
+			t.Fatalf("ObjectHistory %+v (has more %t), expected %s with more results", versions, hasMore, changed)
+		}
+	})
+}
+
 func TestController_ObjectsStatObjectHandler(t *testing.T) {
 	clt, deps := setupClientWithAdmin(t)
 	ctx := context.Background()
@@ -1626,7 +1879,7 @@ func TestController_ObjectsDeleteObjectHandler(t *testing.T) {
 			resp, err := uploadObjectHelper(t, ctx, clt, p, strings.NewReader(content), repo, branch)
 			verifyResponseOK(t, resp, err)
 		}
-		err = deps.catalog.CreateBranchProtectionRule(ctx, repo, "*", []graveler.BranchProtectionBlockedAction{graveler.BranchProtectionBlockedAction_STAGING_WRITE})
+		err = deps.catalog.CreateBranchProtectionRule(ctx, repo, "*", []graveler.BranchProtectionBlockedAction{graveler.BranchProtectionBlockedAction_STAGING_WRITE}, "", false)
 		testutil.Must(t, err)
 
 		// delete objects
@@ -2008,7 +2261,7 @@ func TestController_MergeIntoExplicitBranch(t *testing.T) {
 	testutil.Must(t, err)
 	err = deps.catalog.CreateEntry(ctx, repo, "branch1", catalog.DBEntry{Path: "foo/bar1", PhysicalAddress: "bar1addr", CreationDate: time.Now(), Size: 1, Checksum: "cksum1"})
 	testutil.Must(t, err)
-	_, err = deps.catalog.Commit(ctx, repo, "branch1", "some message", DefaultUserID, nil, nil)
+	_, err = deps.catalog.Commit(ctx, repo, "branch1", "some message", DefaultUserID, nil, nil, nil)
 	testutil.Must(t, err)
 
 	// test branch with mods
@@ -2031,114 +2284,979 @@ func TestController_MergeIntoExplicitBranch(t *testing.T) {
 	}
 }
 
-func TestController_CreateTag(t *testing.T) {
+func TestController_MergeConflicts(t *testing.T) {
+	clt, deps := setupClientWithAdmin(t)
+	ctx := context.Background()
+
+	// setup env - same path changed on both branches
+	repo := testUniqueRepoName()
+	_, err := deps.catalog.CreateRepository(ctx, repo, onBlock(deps, repo), "main")
+	testutil.Must(t, err)
+	testutil.MustDo(t, "create entry on main", deps.catalog.CreateEntry(ctx, repo, "main", catalog.DBEntry{Path: "foo/bar1", PhysicalAddress: "bar1addr", CreationDate: time.Now(), Size: 1, Checksum: "cksum1"}))
+	_, err = deps.catalog.Commit(ctx, repo, "main", "add bar1", DefaultUserID, nil, nil, nil)
+	testutil.Must(t, err)
+	_, err = deps.catalog.CreateBranch(ctx, repo, "branch1", "main")
+	testutil.Must(t, err)
+	testutil.MustDo(t, "update entry on branch1", deps.catalog.CreateEntry(ctx, repo, "branch1", catalog.DBEntry{Path: "foo/bar1", PhysicalAddress: "bar1addr2", CreationDate: time.Now(), Size: 2, Checksum: "cksum2"}))
+	_, err = deps.catalog.Commit(ctx, repo, "branch1", "update bar1 on branch1", DefaultUserID, nil, nil, nil)
+	testutil.Must(t, err)
+	testutil.MustDo(t, "update entry on main", deps.catalog.CreateEntry(ctx, repo, "main", catalog.DBEntry{Path: "foo/bar1", PhysicalAddress: "bar1addr3", CreationDate: time.Now(), Size: 3, Checksum: "cksum3"}))
+	_, err = deps.catalog.Commit(ctx, repo, "main", "update bar1 on main", DefaultUserID, nil, nil, nil)
+	testutil.Must(t, err)
+
+	resp, err := clt.MergeIntoBranchWithResponse(ctx, repo, "branch1", "main", api.MergeIntoBranchJSONRequestBody{})
+	testutil.MustDo(t, "perform merge into branch", err)
+	if resp.JSON409 == nil {
+		t.Fatalf("merge with conflicts expected status %d, got code: %d", http.StatusConflict, resp.StatusCode())
+	}
+	if resp.JSON409.Conflicts == nil || len(*resp.JSON409.Conflicts) != 1 {
+		t.Fatalf("merge conflicts=%v, expected a single conflict", resp.JSON409.Conflicts)
+	}
+	conflict := (*resp.JSON409.Conflicts)[0]
+	if conflict.Path != "foo/bar1" {
+		t.Errorf("conflict path=%s, expected foo/bar1", conflict.Path)
+	}
+	if conflict.Source == nil || api.StringValue(conflict.Source.Checksum) != "cksum2" {
+		t.Errorf("conflict source=%+v, expected checksum cksum2", conflict.Source)
+	}
+	if conflict.Destination == nil || api.StringValue(conflict.Destination.Checksum) != "cksum3" {
+		t.Errorf("conflict destination=%+v, expected checksum cksum3", conflict.Destination)
+	}
+	if conflict.Base == nil || api.StringValue(conflict.Base.Checksum) != "cksum1" {
+		t.Errorf("conflict base=%+v, expected checksum cksum1", conflict.Base)
+	}
+}
+
+func TestController_MergePreview(t *testing.T) {
 	clt, deps := setupClientWithAdmin(t)
 	ctx := context.Background()
+
 	// setup env
 	repo := testUniqueRepoName()
 	_, err := deps.catalog.CreateRepository(ctx, repo, onBlock(deps, repo), "main")
 	testutil.Must(t, err)
-	testutil.MustDo(t, "create entry bar1", deps.catalog.CreateEntry(ctx, repo, "main", catalog.DBEntry{Path: "foo/bar1", PhysicalAddress: "bar1addr", CreationDate: time.Now(), Size: 1, Checksum: "cksum1"}))
-	commit1, err := deps.catalog.Commit(ctx, repo, "main", "some message", DefaultUserID, nil, nil)
+	_, err = deps.catalog.CreateBranch(ctx, repo, "branch1", "main")
+	testutil.Must(t, err)
+	testutil.MustDo(t, "create entry bar1", deps.catalog.CreateEntry(ctx, repo, "branch1", catalog.DBEntry{Path: "foo/bar1", PhysicalAddress: "bar1addr", CreationDate: time.Now(), Size: 1, Checksum: "cksum1"}))
+	testutil.MustDo(t, "create entry bar2", deps.catalog.CreateEntry(ctx, repo, "branch1", catalog.DBEntry{Path: "foo/bar2", PhysicalAddress: "bar2addr", CreationDate: time.Now(), Size: 1, Checksum: "cksum2"}))
+	testutil.MustDo(t, "create entry baz", deps.catalog.CreateEntry(ctx, repo, "branch1", catalog.DBEntry{Path: "baz", PhysicalAddress: "bazaddr", CreationDate: time.Now(), Size: 1, Checksum: "cksum3"}))
+	_, err = deps.catalog.Commit(ctx, repo, "branch1", "some message", DefaultUserID, nil, nil, nil)
 	testutil.Must(t, err)
 
-	t.Run("ref", func(t *testing.T) {
-		tagResp, err := clt.CreateTagWithResponse(ctx, repo, api.CreateTagJSONRequestBody{
-			Id:  "tag1",
-			Ref: commit1.Reference,
-		})
-		verifyResponseOK(t, tagResp, err)
-	})
+	resp, err := clt.MergePreviewWithResponse(ctx, repo, "branch1", "main", &api.MergePreviewParams{})
+	verifyResponseOK(t, resp, err)
+	preview := resp.JSON200
+	if !preview.FastForward {
+		t.Error("merge preview expected fast-forward")
+	}
+	if len(preview.Conflicts) != 0 {
+		t.Errorf("merge preview conflicts=%v, expected none", preview.Conflicts)
+	}
+	expectedSummary := []api.MergePreviewSummary{
+		{Prefix: "", Added: 1},
+		{Prefix: "foo/", Added: 2},
+	}
+	if diff := deep.Equal(preview.Summary, expectedSummary); diff != nil {
+		t.Error("merge preview unexpected summary:", diff)
+	}
 
-	t.Run("branch", func(t *testing.T) {
-		tagResp, err := clt.CreateTagWithResponse(ctx, repo, api.CreateTagJSONRequestBody{
-			Id:  "tag2",
-			Ref: "main",
-		})
-		verifyResponseOK(t, tagResp, err)
-	})
+	// preview should not merge
+	diffResp, err := clt.DiffRefsWithResponse(ctx, repo, "main", "branch1", &api.DiffRefsParams{})
+	verifyResponseOK(t, diffResp, err)
+	if len(diffResp.JSON200.Results) != 3 {
+		t.Errorf("got %d differences after merge preview, expected 3", len(diffResp.JSON200.Results))
+	}
+}
 
-	t.Run("branch_with_latest_modifier", func(t *testing.T) {
-		tagResp, err := clt.CreateTagWithResponse(ctx, repo, api.CreateTagJSONRequestBody{
-			Id:  "tag3",
-			Ref: "main@",
-		})
-		verifyResponseOK(t, tagResp, err)
-	})
+func TestController_DiffSummary(t *testing.T) {
+	clt, deps := setupClientWithAdmin(t)
+	ctx := context.Background()
 
-	t.Run("branch_with_staging_modifier", func(t *testing.T) {
-		tagResp, err := clt.CreateTagWithResponse(ctx, repo, api.CreateTagJSONRequestBody{
-			Id:  "tag4",
-			Ref: "main$",
-		})
-		testutil.Must(t, err)
-		if tagResp.JSONDefault == nil {
-			t.Errorf("Create tag to explicit stage should fail with error, got %v", tagResp)
+	// setup env
+	repo := testUniqueRepoName()
+	_, err := deps.catalog.CreateRepository(ctx, repo, onBlock(deps, repo), "main")
+	testutil.Must(t, err)
+	testutil.MustDo(t, "create entry a2", deps.catalog.CreateEntry(ctx, repo, "main", catalog.DBEntry{Path: "tables/a/2", PhysicalAddress: "a2addr", CreationDate: time.Now(), Size: 5, Checksum: "cksum1"}))
+	_, err = deps.catalog.Commit(ctx, repo, "main", "add a2", DefaultUserID, nil, nil, nil)
+	testutil.Must(t, err)
+	_, err = deps.catalog.CreateBranch(ctx, repo, "branch1", "main")
+	testutil.Must(t, err)
+	for _, entry := range []catalog.DBEntry{
+		{Path: "file", PhysicalAddress: "fileaddr", CreationDate: time.Now(), Size: 1, Checksum: "cksum2"},
+		{Path: "tables/a/1", PhysicalAddress: "a1addr", CreationDate: time.Now(), Size: 10, Checksum: "cksum3"},
+		{Path: "tables/a/2", PhysicalAddress: "a2addr2", CreationDate: time.Now(), Size: 2, Checksum: "cksum4"},
+		{Path: "tables/b/1", PhysicalAddress: "b1addr", CreationDate: time.Now(), Size: 3, Checksum: "cksum5"},
+	} {
+		testutil.MustDo(t, "create entry "+entry.Path, deps.catalog.CreateEntry(ctx, repo, "branch1", entry))
+	}
+	_, err = deps.catalog.Commit(ctx, repo, "branch1", "add tables", DefaultUserID, nil, nil, nil)
+	testutil.Must(t, err)
+
+	t.Run("by prefix", func(t *testing.T) {
+		depth := 2
+		resp, err := clt.DiffSummaryWithResponse(ctx, repo, "main", "branch1", &api.DiffSummaryParams{Depth: &depth})
+		verifyResponseOK(t, resp, err)
+		expected := []api.PrefixDiffSummary{
+			{Prefix: "", Added: 1, AddedBytes: 1},
+			{Prefix: "tables/a/", Added: 1, Changed: 1, AddedBytes: 10, ChangedBytes: -3},
+			{Prefix: "tables/b/", Added: 1, AddedBytes: 3},
+		}
+		if diff := deep.Equal(resp.JSON200.Results, expected); diff != nil {
+			t.Error("DiffSummary unexpected summary:", diff)
 		}
 	})
 
-	t.Run("tag_tag", func(t *testing.T) {
-		tagResp, err := clt.CreateTagWithResponse(ctx, repo, api.CreateTagJSONRequestBody{
-			Id:  "tag5",
-			Ref: "main",
-		})
-		verifyResponseOK(t, tagResp, err)
-		tagTagResp, err := clt.CreateTagWithResponse(ctx, repo, api.CreateTagJSONRequestBody{
-			Id:  "tag6",
-			Ref: "tag5",
-		})
-		verifyResponseOK(t, tagTagResp, err)
+	t.Run("total", func(t *testing.T) {
+		depth := 0
+		resp, err := clt.DiffSummaryWithResponse(ctx, repo, "branch1", "main", &api.DiffSummaryParams{Depth: &depth, Type: api.StringPtr("two_dot")})
+		verifyResponseOK(t, resp, err)
+		expected := []api.PrefixDiffSummary{
+			{Prefix: "", Removed: 3, Changed: 1, RemovedBytes: 14, ChangedBytes: 3},
+		}
+		if diff := deep.Equal(resp.JSON200.Results, expected); diff != nil {
+			t.Error("DiffSummary unexpected summary:", diff)
+		}
 	})
 
-	t.Run("not_exists", func(t *testing.T) {
-		tagResp, err := clt.CreateTagWithResponse(ctx, repo, api.CreateTagJSONRequestBody{
-			Id:  "tag6",
-			Ref: "unknown",
-		})
+	t.Run("invalid depth", func(t *testing.T) {
+		depth := -1
+		resp, err := clt.DiffSummaryWithResponse(ctx, repo, "main", "branch1", &api.DiffSummaryParams{Depth: &depth})
 		testutil.Must(t, err)
-		if tagResp.JSON404 == nil {
-			t.Errorf("Create tag to unknown ref expected 404, got %v", tagResp)
+		if resp.JSON400 == nil {
+			t.Fatalf("DiffSummary with negative depth status %d, expected 400", resp.StatusCode())
 		}
 	})
 }
 
-func testUniqueRepoName() string {
-	return "repo-" + nanoid.MustGenerate("abcdef1234567890", 8)
-}
-
-func TestController_Revert(t *testing.T) {
+func TestController_CompareBranches(t *testing.T) {
 	clt, deps := setupClientWithAdmin(t)
 	ctx := context.Background()
+
 	// setup env
 	repo := testUniqueRepoName()
 	_, err := deps.catalog.CreateRepository(ctx, repo, onBlock(deps, repo), "main")
 	testutil.Must(t, err)
-	testutil.MustDo(t, "create entry bar1", deps.catalog.CreateEntry(ctx, repo, "main", catalog.DBEntry{Path: "foo/bar1", PhysicalAddress: "bar1addr", CreationDate: time.Now(), Size: 1, Checksum: "cksum1"}))
-	_, err = deps.catalog.Commit(ctx, repo, "main", "some message", DefaultUserID, nil, nil)
+	branch2, err := deps.catalog.CreateBranch(ctx, repo, "branch2", "main")
+	testutil.Must(t, err)
+	_, err = deps.catalog.CreateBranch(ctx, repo, "branch1", "main")
+	testutil.Must(t, err)
+	testutil.MustDo(t, "create entry bar1", deps.catalog.CreateEntry(ctx, repo, "branch1", catalog.DBEntry{Path: "foo/bar1", PhysicalAddress: "bar1addr", CreationDate: time.Now(), Size: 1, Checksum: "cksum1"}))
+	testutil.MustDo(t, "create entry bar2", deps.catalog.CreateEntry(ctx, repo, "branch1", catalog.DBEntry{Path: "foo/bar2", PhysicalAddress: "bar2addr", CreationDate: time.Now(), Size: 1, Checksum: "cksum2"}))
+	_, err = deps.catalog.Commit(ctx, repo, "branch1", "add bars", DefaultUserID, nil, nil, nil)
+	testutil.Must(t, err)
+	testutil.MustDo(t, "update entry bar2", deps.catalog.CreateEntry(ctx, repo, "branch1", catalog.DBEntry{Path: "foo/bar2", PhysicalAddress: "bar2addr2", CreationDate: time.Now(), Size: 2, Checksum: "cksum22"}))
+	branch1Commit, err := deps.catalog.Commit(ctx, repo, "branch1", "update bar2", DefaultUserID, nil, nil, nil)
+	testutil.Must(t, err)
+	testutil.MustDo(t, "create entry baz", deps.catalog.CreateEntry(ctx, repo, "main", catalog.DBEntry{Path: "baz", PhysicalAddress: "bazaddr", CreationDate: time.Now(), Size: 1, Checksum: "cksum3"}))
+	mainCommit, err := deps.catalog.Commit(ctx, repo, "main", "add baz", DefaultUserID, nil, nil, nil)
 	testutil.Must(t, err)
 
-	t.Run("ref", func(t *testing.T) {
-		branchResp, err := clt.GetBranchWithResponse(ctx, repo, "main")
-		verifyResponseOK(t, branchResp, err)
-		revertResp, err := clt.RevertBranchWithResponse(ctx, repo, "main", api.RevertBranchJSONRequestBody{Ref: branchResp.JSON200.CommitId})
-		verifyResponseOK(t, revertResp, err)
-	})
+	expectedBranch1 := api.BranchComparison{
+		Branch:       "branch1",
+		CommitId:     branch1Commit.Reference,
+		BaseCommitId: mainCommit.Reference,
+		MergeBaseId:  api.StringPtr(branch2.Reference),
+		Ahead:        2,
+		Behind:       1,
+		Added:        2,
+	}
 
 	t.Run("branch", func(t *testing.T) {
-		revertResp, err := clt.RevertBranchWithResponse(ctx, repo, "main", api.RevertBranchJSONRequestBody{Ref: "main"})
-		verifyResponseOK(t, revertResp, err)
+		resp, err := clt.CompareBranchesWithResponse(ctx, repo, "main", "branch1")
+		verifyResponseOK(t, resp, err)
+		if diff := deep.Equal(*resp.JSON200, expectedBranch1); diff != nil {
+			t.Error("CompareBranches unexpected comparison:", diff)
+		}
 	})
 
-	t.Run("committed", func(t *testing.T) {
-		revertResp, err := clt.RevertBranchWithResponse(ctx, repo, "main", api.RevertBranchJSONRequestBody{Ref: "main@"})
-		verifyResponseOK(t, revertResp, err)
+	t.Run("all branches", func(t *testing.T) {
+		resp, err := clt.CompareAllBranchesWithResponse(ctx, repo, "main", &api.CompareAllBranchesParams{})
+		verifyResponseOK(t, resp, err)
+		expected := []api.BranchComparison{
+			expectedBranch1,
+			{Branch: "branch2", CommitId: branch2.Reference, BaseCommitId: mainCommit.Reference, MergeBaseId: api.StringPtr(branch2.Reference), Behind: 1},
+			{Branch: "main", CommitId: mainCommit.Reference, BaseCommitId: mainCommit.Reference, MergeBaseId: api.StringPtr(mainCommit.Reference)},
+		}
+		if diff := deep.Equal(resp.JSON200.Results, expected); diff != nil {
+			t.Error("CompareAllBranches unexpected comparisons:", diff)
+		}
 	})
 
-	t.Run("staging", func(t *testing.T) {
-		revertResp, err := clt.RevertBranchWithResponse(ctx, repo, "main", api.RevertBranchJSONRequestBody{Ref: "main$"})
+	t.Run("missing branch", func(t *testing.T) {
+		resp, err := clt.CompareBranchesWithResponse(ctx, repo, "main", "no-such-branch")
 		testutil.Must(t, err)
-		if revertResp.JSONDefault == nil {
-			t.Errorf("Revert to explicit staging should fail with error, got %v", revertResp)
+		if resp.JSON404 == nil {
+			t.Fatalf("CompareBranches of missing branch status %d, expected 404", resp.StatusCode())
+		}
+	})
+}
+
+func TestController_MergeModes(t *testing.T) {
+	clt, deps := setupClientWithAdmin(t)
+	ctx := context.Background()
+
+	// setup env
+	repo := testUniqueRepoName()
+	_, err := deps.catalog.CreateRepository(ctx, repo, onBlock(deps, repo), "main")
+	testutil.Must(t, err)
+	_, err = deps.catalog.CreateBranch(ctx, repo, "branch1", "main")
+	testutil.Must(t, err)
+	testutil.MustDo(t, "create entry bar1", deps.catalog.CreateEntry(ctx, repo, "branch1", catalog.DBEntry{Path: "foo/bar1", PhysicalAddress: "bar1addr", CreationDate: time.Now(), Size: 1, Checksum: "cksum1"}))
+	branchCommit, err := deps.catalog.Commit(ctx, repo, "branch1", "some message", DefaultUserID, nil, nil, nil)
+	testutil.Must(t, err)
+
+	t.Run("ff-only", func(t *testing.T) {
+		resp, err := clt.MergeIntoBranchWithResponse(ctx, repo, "branch1", "main", api.MergeIntoBranchJSONRequestBody{Mode: api.StringPtr("ff-only")})
+		verifyResponseOK(t, resp, err)
+		if resp.JSON200.Reference != branchCommit.Reference {
+			t.Errorf("fast-forward merge reference=%s, expected=%s", resp.JSON200.Reference, branchCommit.Reference)
+		}
+	})
+
+	testutil.MustDo(t, "create entry bar2", deps.catalog.CreateEntry(ctx, repo, "main", catalog.DBEntry{Path: "foo/bar2", PhysicalAddress: "bar2addr", CreationDate: time.Now(), Size: 1, Checksum: "cksum2"}))
+	mainCommit, err := deps.catalog.Commit(ctx, repo, "main", "some message", DefaultUserID, nil, nil, nil)
+	testutil.Must(t, err)
+	testutil.MustDo(t, "create entry bar3", deps.catalog.CreateEntry(ctx, repo, "branch1", catalog.DBEntry{Path: "foo/bar3", PhysicalAddress: "bar3addr", CreationDate: time.Now(), Size: 1, Checksum: "cksum3"}))
+	_, err = deps.catalog.Commit(ctx, repo, "branch1", "some message", DefaultUserID, nil, nil, nil)
+	testutil.Must(t, err)
+
+	t.Run("ff-only diverged", func(t *testing.T) {
+		resp, err := clt.MergeIntoBranchWithResponse(ctx, repo, "branch1", "main", api.MergeIntoBranchJSONRequestBody{Mode: api.StringPtr("ff-only")})
+		testutil.Must(t, err)
+		if resp.StatusCode() != http.StatusBadRequest {
+			t.Fatalf("fast-forward only merge of diverged branches status=%d, expected=%d", resp.StatusCode(), http.StatusBadRequest)
+		}
+	})
+
+	t.Run("squash", func(t *testing.T) {
+		resp, err := clt.MergeIntoBranchWithResponse(ctx, repo, "branch1", "main", api.MergeIntoBranchJSONRequestBody{Mode: api.StringPtr("squash")})
+		verifyResponseOK(t, resp, err)
+		commitResp, err := clt.GetCommitWithResponse(ctx, repo, resp.JSON200.Reference)
+		verifyResponseOK(t, commitResp, err)
+		if diff := deep.Equal(commitResp.JSON200.Parents, []string{mainCommit.Reference}); diff != nil {
+			t.Error("squash merge commit unexpected parents:", diff)
+		}
+	})
+}
+
+func TestController_MergeStrategyRules(t *testing.T) {
+	clt, deps := setupClientWithAdmin(t)
+	ctx := context.Background()
+
+	// setup env - both branches change the same path
+	repo := testUniqueRepoName()
+	_, err := deps.catalog.CreateRepository(ctx, repo, onBlock(deps, repo), "main")
+	testutil.Must(t, err)
+	_, err = deps.catalog.CreateBranch(ctx, repo, "branch1", "main")
+	testutil.Must(t, err)
+	testutil.MustDo(t, "create entry on main", deps.catalog.CreateEntry(ctx, repo, "main", catalog.DBEntry{Path: "foo/bar1", PhysicalAddress: "mainaddr", CreationDate: time.Now(), Size: 1, Checksum: "cksum1"}))
+	_, err = deps.catalog.Commit(ctx, repo, "main", "some message", DefaultUserID, nil, nil, nil)
+	testutil.Must(t, err)
+	testutil.MustDo(t, "create entry on branch1", deps.catalog.CreateEntry(ctx, repo, "branch1", catalog.DBEntry{Path: "foo/bar1", PhysicalAddress: "branchaddr", CreationDate: time.Now(), Size: 1, Checksum: "cksum2"}))
+	_, err = deps.catalog.Commit(ctx, repo, "branch1", "some message", DefaultUserID, nil, nil, nil)
+	testutil.Must(t, err)
+
+	t.Run("invalid", func(t *testing.T) {
+		resp, err := clt.SetMergeStrategyRulesWithResponse(ctx, repo, api.SetMergeStrategyRulesJSONRequestBody{
+			Rules: []api.MergeStrategyRule{{Pattern: "foo/[", Strategy: "source-wins"}},
+		})
+		testutil.Must(t, err)
+		if resp.StatusCode() != http.StatusBadRequest {
+			t.Fatalf("set invalid merge strategy rules status=%d, expected=%d", resp.StatusCode(), http.StatusBadRequest)
+		}
+	})
+
+	t.Run("set", func(t *testing.T) {
+		rules := []api.MergeStrategyRule{
+			{Pattern: "foo/", Strategy: "source-wins"},
+			{Pattern: "**/*.lock", Strategy: "dest-wins"},
+		}
+		resp, err := clt.SetMergeStrategyRulesWithResponse(ctx, repo, api.SetMergeStrategyRulesJSONRequestBody{Rules: rules})
+		verifyResponseOK(t, resp, err)
+		getResp, err := clt.GetMergeStrategyRulesWithResponse(ctx, repo)
+		verifyResponseOK(t, getResp, err)
+		if diff := deep.Equal(getResp.JSON200.Rules, rules); diff != nil {
+			t.Error("unexpected merge strategy rules:", diff)
+		}
+	})
+
+	t.Run("request rule first", func(t *testing.T) {
+		resp, err := clt.MergePreviewWithResponse(ctx, repo, "branch1", "main", &api.MergePreviewParams{
+			StrategyRule: &[]string{"foo/bar=dest-wins"},
+		})
+		verifyResponseOK(t, resp, err)
+		if len(resp.JSON200.Conflicts) != 0 {
+			t.Errorf("merge preview conflicts=%+v, expected none", resp.JSON200.Conflicts)
+		}
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		resp, err := clt.MergeIntoBranchWithResponse(ctx, repo, "branch1", "main", api.MergeIntoBranchJSONRequestBody{})
+		verifyResponseOK(t, resp, err)
+		entry, err := deps.catalog.GetEntry(ctx, repo, "main", "foo/bar1", catalog.GetEntryParams{})
+		testutil.Must(t, err)
+		if entry.PhysicalAddress != "branchaddr" {
+			t.Errorf("merged entry address=%s, expected source address", entry.PhysicalAddress)
+		}
+	})
+}
+
+func TestController_RebaseBranch(t *testing.T) {
+	clt, deps := setupClientWithAdmin(t)
+	ctx := context.Background()
+
+	// setup env - branch1 and main both commit after branch1 was created
+	repo := testUniqueRepoName()
+	_, err := deps.catalog.CreateRepository(ctx, repo, onBlock(deps, repo), "main")
+	testutil.Must(t, err)
+	_, err = deps.catalog.CreateBranch(ctx, repo, "branch1", "main")
+	testutil.Must(t, err)
+	testutil.MustDo(t, "create entry bar1", deps.catalog.CreateEntry(ctx, repo, "branch1", catalog.DBEntry{Path: "foo/bar1", PhysicalAddress: "bar1addr", CreationDate: time.Now(), Size: 1, Checksum: "cksum1"}))
+	_, err = deps.catalog.Commit(ctx, repo, "branch1", "first", DefaultUserID, nil, nil, nil)
+	testutil.Must(t, err)
+	testutil.MustDo(t, "create entry bar2", deps.catalog.CreateEntry(ctx, repo, "branch1", catalog.DBEntry{Path: "foo/bar2", PhysicalAddress: "bar2addr", CreationDate: time.Now(), Size: 1, Checksum: "cksum2"}))
+	_, err = deps.catalog.Commit(ctx, repo, "branch1", "second", DefaultUserID, nil, nil, nil)
+	testutil.Must(t, err)
+	testutil.MustDo(t, "create entry bar3", deps.catalog.CreateEntry(ctx, repo, "main", catalog.DBEntry{Path: "foo/bar3", PhysicalAddress: "bar3addr", CreationDate: time.Now(), Size: 1, Checksum: "cksum3"}))
+	mainCommit, err := deps.catalog.Commit(ctx, repo, "main", "main commit", DefaultUserID, nil, nil, nil)
+	testutil.Must(t, err)
+
+	t.Run("rebase", func(t *testing.T) {
+		resp, err := clt.RebaseBranchWithResponse(ctx, repo, "branch1", api.RebaseBranchJSONRequestBody{Onto: "main"})
+		verifyResponseOK(t, resp, err)
+		logResp, err := clt.LogCommitsWithResponse(ctx, repo, resp.JSON200.Reference, &api.LogCommitsParams{})
+		verifyResponseOK(t, logResp, err)
+		var messages []string
+		for _, commit := range logResp.JSON200.Results {
+			if len(commit.Parents) > 1 {
+				t.Errorf("rebased branch has merge commit %s", commit.Id)
+			}
+			messages = append(messages, commit.Message)
+		}
+		if len(messages) < 3 || messages[0] != "second" || messages[1] != "first" || logResp.JSON200.Results[2].Id != mainCommit.Reference {
+			t.Errorf("rebased branch log %v, expected second and first on top of main commit", messages)
+		}
+		_, err = deps.catalog.GetEntry(ctx, repo, "branch1", "foo/bar3", catalog.GetEntryParams{})
+		testutil.MustDo(t, "get entry from new base", err)
+	})
+
+	t.Run("conflict", func(t *testing.T) {
+		testutil.MustDo(t, "create entry on main", deps.catalog.CreateEntry(ctx, repo, "main", catalog.DBEntry{Path: "foo/bar1", PhysicalAddress: "mainaddr", CreationDate: time.Now(), Size: 1, Checksum: "cksum4"}))
+		_, err := deps.catalog.Commit(ctx, repo, "main", "conflicting commit", DefaultUserID, nil, nil, nil)
+		testutil.Must(t, err)
+		testutil.MustDo(t, "create entry on branch1", deps.catalog.CreateEntry(ctx, repo, "branch1", catalog.DBEntry{Path: "foo/bar1", PhysicalAddress: "branchaddr", CreationDate: time.Now(), Size: 1, Checksum: "cksum5"}))
+		branchCommit, err := deps.catalog.Commit(ctx, repo, "branch1", "conflicting change", DefaultUserID, nil, nil, nil)
+		testutil.Must(t, err)
+
+		resp, err := clt.RebaseBranchWithResponse(ctx, repo, "branch1", api.RebaseBranchJSONRequestBody{Onto: "main"})
+		testutil.Must(t, err)
+		if resp.JSON409 == nil {
+			t.Fatalf("rebase with conflict status=%d, expected=%d", resp.StatusCode(), http.StatusConflict)
+		}
+		if api.StringValue(resp.JSON409.ConflictCommitId) != branchCommit.Reference {
+			t.Errorf("rebase conflict commit=%s, expected=%s", api.StringValue(resp.JSON409.ConflictCommitId), branchCommit.Reference)
+		}
+		if resp.JSON409.Conflicts == nil || len(*resp.JSON409.Conflicts) != 1 || (*resp.JSON409.Conflicts)[0].Path != "foo/bar1" {
+			t.Errorf("rebase conflicts %+v, expected conflict on foo/bar1", resp.JSON409.Conflicts)
+		}
+		branchResp, err := clt.GetBranchWithResponse(ctx, repo, "branch1")
+		verifyResponseOK(t, branchResp, err)
+		if branchResp.JSON200.CommitId != branchCommit.Reference {
+			t.Errorf("branch after failed rebase at %s, expected unchanged %s", branchResp.JSON200.CommitId, branchCommit.Reference)
+		}
+	})
+}
+
+func TestController_BranchReflog(t *testing.T) {
+	clt, deps := setupClientWithAdmin(t)
+	ctx := context.Background()
+
+	// setup env - branch1 is created, committed to and deleted through the api
+	repo := testUniqueRepoName()
+	_, err := deps.catalog.CreateRepository(ctx, repo, onBlock(deps, repo), "main")
+	testutil.Must(t, err)
+	createResp, err := clt.CreateBranchWithResponse(ctx, repo, api.CreateBranchJSONRequestBody{Name: "branch1", Source: "main"})
+	verifyResponseOK(t, createResp, err)
+	mainBranch, err := deps.catalog.GetBranchReference(ctx, repo, "main")
+	testutil.Must(t, err)
+	testutil.MustDo(t, "create entry bar1", deps.catalog.CreateEntry(ctx, repo, "branch1", catalog.DBEntry{Path: "foo/bar1", PhysicalAddress: "bar1addr", CreationDate: time.Now(), Size: 1, Checksum: "cksum1"}))
+	commitResp, err := clt.CommitWithResponse(ctx, repo, "branch1", api.CommitJSONRequestBody{Message: "first"})
+	verifyResponseOK(t, commitResp, err)
+	deleteResp, err := clt.DeleteBranchWithResponse(ctx, repo, "branch1")
+	verifyResponseOK(t, deleteResp, err)
+
+	t.Run("list", func(t *testing.T) {
+		resp, err := clt.ListBranchReflogWithResponse(ctx, repo, "branch1", &api.ListBranchReflogParams{})
+		verifyResponseOK(t, resp, err)
+		expected := []struct {
+			operation string
+			old, new  string
+		}{
+			{operation: "delete", old: commitResp.JSON201.Id, new: ""},
+			{operation: "commit", old: mainBranch, new: commitResp.JSON201.Id},
+			{operation: "create", old: "", new: mainBranch},
+		}
+		results := resp.JSON200.Results
+		if len(results) != len(expected) {
+			t.Fatalf("ListBranchReflog got %d entries, expected %d", len(results), len(expected))
+		}
+		for i, entry := range results {
+			if entry.Index != i || entry.Operation != expected[i].operation || entry.OldCommitId != expected[i].old || entry.NewCommitId != expected[i].new {
+				t.Errorf("entry %d: %+v, expected %+v", i, entry, expected[i])
+			}
+			if entry.User == "" {
+				t.Errorf("entry %d has no user", i)
+			}
+		}
+	})
+
+	t.Run("paginate", func(t *testing.T) {
+		resp, err := clt.ListBranchReflogWithResponse(ctx, repo, "branch1", &api.ListBranchReflogParams{Amount: api.PaginationAmountPtr(1)})
+		verifyResponseOK(t, resp, err)
+		if len(resp.JSON200.Results) != 1 || !resp.JSON200.Pagination.HasMore || resp.JSON200.Pagination.NextOffset != "0" {
+			t.Fatalf("first page %+v, expected a single entry with next offset 0", resp.JSON200)
+		}
+		after := api.PaginationAfter(resp.JSON200.Pagination.NextOffset)
+		resp, err = clt.ListBranchReflogWithResponse(ctx, repo, "branch1", &api.ListBranchReflogParams{After: &after})
+		verifyResponseOK(t, resp, err)
+		if len(resp.JSON200.Results) != 2 || resp.JSON200.Results[0].Index != 1 {
+			t.Errorf("second page %+v, expected entries from index 1", resp.JSON200.Results)
+		}
+	})
+
+	t.Run("restore deleting entry", func(t *testing.T) {
+		resp, err := clt.RestoreBranchWithResponse(ctx, repo, "branch1", api.RestoreBranchJSONRequestBody{Index: 0})
+		testutil.Must(t, err)
+		if resp.StatusCode() != http.StatusBadRequest {
+			t.Errorf("restore to deleting entry status=%d, expected=%d", resp.StatusCode(), http.StatusBadRequest)
+		}
+	})
+
+	t.Run("restore deleted", func(t *testing.T) {
+		resp, err := clt.RestoreBranchWithResponse(ctx, repo, "branch1", api.RestoreBranchJSONRequestBody{Index: 1})
+		verifyResponseOK(t, resp, err)
+		if resp.JSON200.CommitId != commitResp.JSON201.Id {
+			t.Errorf("restored branch at %s, expected %s", resp.JSON200.CommitId, commitResp.JSON201.Id)
+		}
+		branchResp, err := clt.GetBranchWithResponse(ctx, repo, "branch1")
+		verifyResponseOK(t, branchResp, err)
+		if branchResp.JSON200.CommitId != commitResp.JSON201.Id {
+			t.Errorf("branch at %s, expected %s", branchResp.JSON200.CommitId, commitResp.JSON201.Id)
+		}
+	})
+
+	t.Run("restore back", func(t *testing.T) {
+		// reflog is now restore, delete, commit, create
+		resp, err := clt.RestoreBranchWithResponse(ctx, repo, "branch1", api.RestoreBranchJSONRequestBody{Index: 3})
+		verifyResponseOK(t, resp, err)
+		if resp.JSON200.CommitId != mainBranch {
+			t.Errorf("restored branch at %s, expected %s", resp.JSON200.CommitId, mainBranch)
+		}
+		logResp, err := clt.ListBranchReflogWithResponse(ctx, repo, "branch1", &api.ListBranchReflogParams{})
+		verifyResponseOK(t, logResp, err)
+		if len(logResp.JSON200.Results) != 5 || logResp.JSON200.Results[0].Operation != "restore" {
+			t.Errorf("reflog after restore %+v, expected a restore entry first", logResp.JSON200.Results)
+		}
+	})
+}
+
+func TestController_Stash(t *testing.T) {
+	clt, deps := setupClientWithAdmin(t)
+	ctx := context.Background()
+
+	repo := testUniqueRepoName()
+	_, err := deps.catalog.CreateRepository(ctx, repo, onBlock(deps, repo), "main")
+	testutil.Must(t, err)
+	testutil.MustDo(t, "create entry bar1", deps.catalog.CreateEntry(ctx, repo, "main", catalog.DBEntry{Path: "foo/bar1", PhysicalAddress: "bar1addr", CreationDate: time.Now(), Size: 1, Checksum: "cksum1"}))
+
+	pushResp, err := clt.StashPushWithResponse(ctx, repo, "main", api.StashPushJSONRequestBody{Id: "stash1"})
+	verifyResponseOK(t, pushResp, err)
+	statResp, err := clt.StatObjectWithResponse(ctx, repo, "main", &api.StatObjectParams{Path: "foo/bar1"})
+	testutil.Must(t, err)
+	if statResp.StatusCode() != http.StatusNotFound {
+		t.Fatalf("stat stashed object status=%d, expected=%d", statResp.StatusCode(), http.StatusNotFound)
+	}
+
+	t.Run("push nothing", func(t *testing.T) {
+		resp, err := clt.StashPushWithResponse(ctx, repo, "main", api.StashPushJSONRequestBody{Id: "stash2"})
+		testutil.Must(t, err)
+		if resp.StatusCode() != http.StatusBadRequest {
+			t.Errorf("push with no changes status=%d, expected=%d", resp.StatusCode(), http.StatusBadRequest)
+		}
+	})
+
+	t.Run("list", func(t *testing.T) {
+		resp, err := clt.ListStashesWithResponse(ctx, repo, "main", &api.ListStashesParams{})
+		verifyResponseOK(t, resp, err)
+		if len(resp.JSON200.Results) != 1 || resp.JSON200.Results[0].Id != "stash1" {
+			t.Errorf("ListStashes got %+v, expected stash1", resp.JSON200.Results)
+		}
+	})
+
+	t.Run("apply conflict", func(t *testing.T) {
+		testutil.MustDo(t, "create entry bar1", deps.catalog.CreateEntry(ctx, repo, "main", catalog.DBEntry{Path: "foo/bar1", PhysicalAddress: "bar1addr2", CreationDate: time.Now(), Size: 2, Checksum: "cksum2"}))
+		resp, err := clt.StashApplyWithResponse(ctx, repo, "main", "stash1")
+		testutil.Must(t, err)
+		if resp.JSON409 == nil {
+			t.Fatalf("apply with conflicts status=%d, expected=%d", resp.StatusCode(), http.StatusConflict)
+		}
+		if len(resp.JSON409.Conflicts) != 1 || resp.JSON409.Conflicts[0].Path != "foo/bar1" {
+			t.Errorf("apply conflicts %+v, expected foo/bar1", resp.JSON409.Conflicts)
+		}
+		testutil.MustDo(t, "reset bar1", deps.catalog.ResetEntry(ctx, repo, "main", "foo/bar1"))
+	})
+
+	t.Run("pop", func(t *testing.T) {
+		resp, err := clt.StashPopWithResponse(ctx, repo, "main", "stash1")
+		verifyResponseOK(t, resp, err)
+		statResp, err := clt.StatObjectWithResponse(ctx, repo, "main", &api.StatObjectParams{Path: "foo/bar1"})
+		verifyResponseOK(t, statResp, err)
+		listResp, err := clt.ListStashesWithResponse(ctx, repo, "main", &api.ListStashesParams{})
+		verifyResponseOK(t, listResp, err)
+		if len(listResp.JSON200.Results) != 0 {
+			t.Errorf("ListStashes after pop got %+v, expected none", listResp.JSON200.Results)
+		}
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		pushResp, err := clt.StashPushWithResponse(ctx, repo, "main", api.StashPushJSONRequestBody{Id: "stash2"})
+		verifyResponseOK(t, pushResp, err)
+		resp, err := clt.StashDropWithResponse(ctx, repo, "main", "stash2")
+		verifyResponseOK(t, resp, err)
+		applyResp, err := clt.StashApplyWithResponse(ctx, repo, "main", "stash2")
+		testutil.Must(t, err)
+		if applyResp.StatusCode() != http.StatusNotFound {
+			t.Errorf("apply dropped stash status=%d, expected=%d", applyResp.StatusCode(), http.StatusNotFound)
+		}
+	})
+}
+
+func TestController_LegalHolds(t *testing.T) {
+	clt, deps := setupClientWithAdmin(t)
+	ctx := context.Background()
+
+	repo := testUniqueRepoName()
+	_, err := deps.catalog.CreateRepository(ctx, repo, onBlock(deps, repo), "main")
+	testutil.Must(t, err)
+
+	createResp, err := clt.CreateLegalHoldWithResponse(ctx, repo, api.CreateLegalHoldJSONRequestBody{
+		Id:     "case-1",
+		Ref:    "main",
+		Path:   api.StringPtr("records/"),
+		Reason: "litigation",
+	})
+	verifyResponseOK(t, createResp, err)
+	if createResp.JSON201.Path != "records/" || createResp.JSON201.CreatedBy == "" {
+		t.Errorf("CreateLegalHold got %+v, expected path records/ and a creating user", createResp.JSON201)
+	}
+
+	t.Run("exists", func(t *testing.T) {
+		resp, err := clt.CreateLegalHoldWithResponse(ctx, repo, api.CreateLegalHoldJSONRequestBody{Id: "case-1", Ref: "main", Reason: "again"})
+		testutil.Must(t, err)
+		if resp.StatusCode() != http.StatusConflict {
+			t.Errorf("create existing legal hold status=%d, expected=%d", resp.StatusCode(), http.StatusConflict)
+		}
+	})
+
+	t.Run("list", func(t *testing.T) {
+		resp, err := clt.ListLegalHoldsWithResponse(ctx, repo)
+		verifyResponseOK(t, resp, err)
+		if len(*resp.JSON200) != 1 || (*resp.JSON200)[0].Id != "case-1" {
+			t.Errorf("ListLegalHolds got %+v, expected case-1", *resp.JSON200)
+		}
+	})
+
+	t.Run("release", func(t *testing.T) {
+		resp, err := clt.ReleaseLegalHoldWithResponse(ctx, repo, "case-1")
+		verifyResponseOK(t, resp, err)
+		getResp, err := clt.GetLegalHoldWithResponse(ctx, repo, "case-1")
+		testutil.Must(t, err)
+		if getResp.StatusCode() != http.StatusNotFound {
+			t.Errorf("get released legal hold status=%d, expected=%d", getResp.StatusCode(), http.StatusNotFound)
+		}
+	})
+
+	t.Run("log", func(t *testing.T) {
+		resp, err := clt.ListLegalHoldLogWithResponse(ctx, repo)
+		verifyResponseOK(t, resp, err)
+		var operations []string
+		for _, entry := range *resp.JSON200 {
+			operations = append(operations, entry.Operation)
+		}
+		if diff := deep.Equal(operations, []string{"release", "hold"}); diff != nil {
+			t.Error("ListLegalHoldLog operations diff", diff)
+		}
+	})
+}
+
+func TestController_Import(t *testing.T) {
+	clt, deps := setupClientWithAdmin(t)
+	ctx := context.Background()
+
+	repo := testUniqueRepoName()
+	_, err := deps.catalog.CreateRepository(ctx, repo, onBlock(deps, repo), "main")
+	testutil.Must(t, err)
+	source := onBlock(deps, "import-"+repo) + "/"
+	for _, identifier := range []string{"a/1", "b/2"} {
+		obj := block.ObjectPointer{StorageNamespace: source, Identifier: identifier, IdentifierType: block.IdentifierTypeRelative}
+		testutil.Must(t, deps.blocks.Put(ctx, obj, 4, strings.NewReader("data"), block.PutOpts{}))
+	}
+
+	startResp, err := clt.StartImportWithResponse(ctx, repo, "main", api.StartImportJSONRequestBody{
+		Source:      source,
+		Destination: "imported/",
+	})
+	verifyResponseOK(t, startResp, err)
+	importID := startResp.JSON202.Id
+
+	var status *api.ImportStatus
+	for i := 0; i < 100; i++ {
+		resp, err := clt.GetImportStatusWithResponse(ctx, repo, "main", importID)
+		verifyResponseOK(t, resp, err)
+		if status = resp.JSON200; status.Completed {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	if !status.Completed || status.Error != nil || status.CommitId == nil || status.ImportedObjects != 2 {
+		t.Fatalf("GetImportStatus got %+v, expected a completed import of 2 objects", status)
+	}
+	statResp, err := clt.StatObjectWithResponse(ctx, repo, "main", &api.StatObjectParams{Path: "imported/b/2"})
+	verifyResponseOK(t, statResp, err)
+	if statResp.JSON200.SizeBytes == nil || *statResp.JSON200.SizeBytes != 4 {
+		t.Errorf("StatObject of imported object got %+v, expected size 4", statResp.JSON200)
+	}
+
+	t.Run("cancel completed", func(t *testing.T) {
+		resp, err := clt.CancelImportWithResponse(ctx, repo, "main", importID)
+		testutil.Must(t, err)
+		if resp.StatusCode() != http.StatusConflict {
+			t.Errorf("cancel completed import status=%d, expected=%d", resp.StatusCode(), http.StatusConflict)
+		}
+	})
+
+	t.Run("unknown import", func(t *testing.T) {
+		resp, err := clt.GetImportStatusWithResponse(ctx, repo, "main", "unknown")
+		testutil.Must(t, err)
+		if resp.StatusCode() != http.StatusNotFound {
+			t.Errorf("get unknown import status=%d, expected=%d", resp.StatusCode(), http.StatusNotFound)
+		}
+	})
+}
+
+func TestController_CreateTag(t *testing.T) {
+	clt, deps := setupClientWithAdmin(t)
+	ctx := context.Background()
+	// setup env
+	repo := testUniqueRepoName()
+	_, err := deps.catalog.CreateRepository(ctx, repo, onBlock(deps, repo), "main")
+	testutil.Must(t, err)
+	testutil.MustDo(t, "create entry bar1", deps.catalog.CreateEntry(ctx, repo, "main", catalog.DBEntry{Path: "foo/bar1", PhysicalAddress: "bar1addr", CreationDate: time.Now(), Size: 1, Checksum: "cksum1"}))
+	commit1, err := deps.catalog.Commit(ctx, repo, "main", "some message", DefaultUserID, nil, nil, nil)
+	testutil.Must(t, err)
+
+	t.Run("ref", func(t *testing.T) {
+		tagResp, err := clt.CreateTagWithResponse(ctx, repo, api.CreateTagJSONRequestBody{
+			Id:  "tag1",
+			Ref: commit1.Reference,
+		})
+		verifyResponseOK(t, tagResp, err)
+	})
+
+	t.Run("branch", func(t *testing.T) {
+		tagResp, err := clt.CreateTagWithResponse(ctx, repo, api.CreateTagJSONRequestBody{
+			Id:  "tag2",
+			Ref: "main",
+		})
+		verifyResponseOK(t, tagResp, err)
+	})
+
+	t.Run("branch_with_latest_modifier", func(t *testing.T) {
+		tagResp, err := clt.CreateTagWithResponse(ctx, repo, api.CreateTagJSONRequestBody{
+			Id:  "tag3",
+			Ref: "main@",
+		})
+		verifyResponseOK(t, tagResp, err)
+	})
+
+	t.Run("branch_with_staging_modifier", func(t *testing.T) {
+		tagResp, err := clt.CreateTagWithResponse(ctx, repo, api.CreateTagJSONRequestBody{
+			Id:  "tag4",
+			Ref: "main$",
+		})
+		testutil.Must(t, err)
+		if tagResp.JSONDefault == nil {
+			t.Errorf("Create tag to explicit stage should fail with error, got %v", tagResp)
+		}
+	})
+
+	t.Run("tag_tag", func(t *testing.T) {
+		tagResp, err := clt.CreateTagWithResponse(ctx, repo, api.CreateTagJSONRequestBody{
+			Id:  "tag5",
+			Ref: "main",
+		})
+		verifyResponseOK(t, tagResp, err)
+		tagTagResp, err := clt.CreateTagWithResponse(ctx, repo, api.CreateTagJSONRequestBody{
+			Id:  "tag6",
+			Ref: "tag5",
+		})
+		verifyResponseOK(t, tagTagResp, err)
+	})
+
+	t.Run("not_exists", func(t *testing.T) {
+		tagResp, err := clt.CreateTagWithResponse(ctx, repo, api.CreateTagJSONRequestBody{
+			Id:  "tag6",
+			Ref: "unknown",
+		})
+		testutil.Must(t, err)
+		if tagResp.JSON404 == nil {
+			t.Errorf("Create tag to unknown ref expected 404, got %v", tagResp)
+		}
+	})
+
+	t.Run("annotated", func(t *testing.T) {
+		tagResp, err := clt.CreateTagWithResponse(ctx, repo, api.CreateTagJSONRequestBody{
+			Id:       "release1",
+			Ref:      commit1.Reference,
+			Message:  api.StringPtr("release 1"),
+			Metadata: &api.TagCreation_Metadata{AdditionalProperties: map[string]string{"ticket": "REL-1"}},
+		})
+		verifyResponseOK(t, tagResp, err)
+		getResp, err := clt.GetTagWithResponse(ctx, repo, "release1")
+		verifyResponseOK(t, getResp, err)
+		annotation := getResp.JSON200.Annotation
+		if annotation == nil {
+			t.Fatal("GetTag of annotated tag missing annotation")
+		}
+		if annotation.Message != "release 1" || annotation.Tagger == "" || annotation.CreationDate == 0 {
+			t.Errorf("GetTag annotation %+v, expected message 'release 1' with tagger and creation date", annotation)
+		}
+		if annotation.Metadata == nil || annotation.Metadata.AdditionalProperties["ticket"] != "REL-1" {
+			t.Errorf("GetTag annotation metadata %+v, expected ticket=REL-1", annotation.Metadata)
+		}
+
+		getResp, err = clt.GetTagWithResponse(ctx, repo, "tag1")
+		verifyResponseOK(t, getResp, err)
+		if getResp.JSON200.Annotation != nil {
+			t.Errorf("GetTag of lightweight tag annotation %+v, expected none", getResp.JSON200.Annotation)
+		}
+	})
+}
+
+func TestController_TagProtection(t *testing.T) {
+	clt, deps := setupClientWithAdmin(t)
+	ctx := context.Background()
+	// setup env
+	repo := testUniqueRepoName()
+	_, err := deps.catalog.CreateRepository(ctx, repo, onBlock(deps, repo), "main")
+	testutil.Must(t, err)
+	testutil.MustDo(t, "create entry bar1", deps.catalog.CreateEntry(ctx, repo, "main", catalog.DBEntry{Path: "foo/bar1", PhysicalAddress: "bar1addr", CreationDate: time.Now(), Size: 1, Checksum: "cksum1"}))
+	_, err = deps.catalog.Commit(ctx, repo, "main", "some message", DefaultUserID, nil, nil, nil)
+	testutil.Must(t, err)
+	for _, tagID := range []string{"v1.0", "nightly"} {
+		_, err = deps.catalog.CreateTag(ctx, repo, tagID, "main")
+		testutil.Must(t, err)
+	}
+
+	createResp, err := clt.CreateTagProtectionRuleWithResponse(ctx, repo, api.CreateTagProtectionRuleJSONRequestBody{Pattern: "v*"})
+	verifyResponseOK(t, createResp, err)
+	rulesResp, err := clt.GetTagProtectionRulesWithResponse(ctx, repo)
+	verifyResponseOK(t, rulesResp, err)
+	if rulesResp.JSON200 == nil || len(*rulesResp.JSON200) != 1 || (*rulesResp.JSON200)[0].Pattern != "v*" {
+		t.Fatalf("GetTagProtectionRules %v, expected a single rule for 'v*'", rulesResp.JSON200)
+	}
+
+	deleteResp, err := clt.DeleteTagWithResponse(ctx, repo, "v1.0")
+	testutil.Must(t, err)
+	if deleteResp.StatusCode() != http.StatusForbidden {
+		t.Errorf("DeleteTag of protected tag status=%d, expected %d", deleteResp.StatusCode(), http.StatusForbidden)
+	}
+	deleteResp, err = clt.DeleteTagWithResponse(ctx, repo, "nightly")
+	verifyResponseOK(t, deleteResp, err)
+
+	deleteRuleResp, err := clt.DeleteTagProtectionRuleWithResponse(ctx, repo, api.DeleteTagProtectionRuleJSONRequestBody{Pattern: "v*"})
+	verifyResponseOK(t, deleteRuleResp, err)
+	deleteResp, err = clt.DeleteTagWithResponse(ctx, repo, "v1.0")
+	verifyResponseOK(t, deleteResp, err)
+}
+
+func testUniqueRepoName() string {
+	return "repo-" + nanoid.MustGenerate("abcdef1234567890", 8)
+}
+
+func TestController_Revert(t *testing.T) {
+	clt, deps := setupClientWithAdmin(t)
+	ctx := context.Background()
+	// setup env
+	repo := testUniqueRepoName()
+	_, err := deps.catalog.CreateRepository(ctx, repo, onBlock(deps, repo), "main")
+	testutil.Must(t, err)
+	testutil.MustDo(t, "create entry bar1", deps.catalog.CreateEntry(ctx, repo, "main", catalog.DBEntry{Path: "foo/bar1", PhysicalAddress: "bar1addr", CreationDate: time.Now(), Size: 1, Checksum: "cksum1"}))
+	_, err = deps.catalog.Commit(ctx, repo, "main", "some message", DefaultUserID, nil, nil, nil)
+	testutil.Must(t, err)
+
+	t.Run("ref", func(t *testing.T) {
+		branchResp, err := clt.GetBranchWithResponse(ctx, repo, "main")
+		verifyResponseOK(t, branchResp, err)
+		revertResp, err := clt.RevertBranchWithResponse(ctx, repo, "main", api.RevertBranchJSONRequestBody{Ref: branchResp.JSON200.CommitId})
+		verifyResponseOK(t, revertResp, err)
+	})
+
+	t.Run("branch", func(t *testing.T) {
+		revertResp, err := clt.RevertBranchWithResponse(ctx, repo, "main", api.RevertBranchJSONRequestBody{Ref: "main"})
+		verifyResponseOK(t, revertResp, err)
+	})
+
+	t.Run("committed", func(t *testing.T) {
+		revertResp, err := clt.RevertBranchWithResponse(ctx, repo, "main", api.RevertBranchJSONRequestBody{Ref: "main@"})
+		verifyResponseOK(t, revertResp, err)
+	})
+
+	t.Run("staging", func(t *testing.T) {
+		revertResp, err := clt.RevertBranchWithResponse(ctx, repo, "main", api.RevertBranchJSONRequestBody{Ref: "main$"})
+		testutil.Must(t, err)
+		if revertResp.JSONDefault == nil {
+			t.Errorf("Revert to explicit staging should fail with error, got %v", revertResp)
+		}
+	})
+}
+
+func TestController_CherryPick(t *testing.T) {
+	clt, deps := setupClientWithAdmin(t)
+	ctx := context.Background()
+	// setup env
+	repo := testUniqueRepoName()
+	_, err := deps.catalog.CreateRepository(ctx, repo, onBlock(deps, repo), "main")
+	testutil.Must(t, err)
+	testutil.MustDo(t, "create entry bar1", deps.catalog.CreateEntry(ctx, repo, "main", catalog.DBEntry{Path: "foo/bar1", PhysicalAddress: "bar1addr", CreationDate: time.Now(), Size: 1, Checksum: "cksum1"}))
+	_, err = deps.catalog.Commit(ctx, repo, "main", "some message", DefaultUserID, nil, nil, nil)
+	testutil.Must(t, err)
+	_, err = deps.catalog.CreateBranch(ctx, repo, "feature", "main")
+	testutil.Must(t, err)
+	testutil.MustDo(t, "create entry bar2", deps.catalog.CreateEntry(ctx, repo, "feature", catalog.DBEntry{Path: "foo/bar2", PhysicalAddress: "bar2addr", CreationDate: time.Now(), Size: 2, Checksum: "cksum2"}))
+	featureCommit, err := deps.catalog.Commit(ctx, repo, "feature", "add bar2", DefaultUserID, catalog.Metadata{"key": "value"}, nil, nil)
+	testutil.Must(t, err)
+
+	t.Run("commit", func(t *testing.T) {
+		resp, err := clt.CherryPickWithResponse(ctx, repo, "main", api.CherryPickJSONRequestBody{Ref: featureCommit.Reference})
+		testutil.Must(t, err)
+		if resp.JSON201 == nil {
+			t.Fatalf("CherryPick expected 201, got %s", resp.Status())
+		}
+		if resp.JSON201.Message != "add bar2" {
+			t.Errorf("CherryPick commit message '%s', expected 'add bar2'", resp.JSON201.Message)
+		}
+		_, err = deps.catalog.GetEntry(ctx, repo, "main", "foo/bar2", catalog.GetEntryParams{})
+		testutil.MustDo(t, "get cherry-picked entry", err)
+	})
+
+	t.Run("unknown ref", func(t *testing.T) {
+		resp, err := clt.CherryPickWithResponse(ctx, repo, "main", api.CherryPickJSONRequestBody{Ref: "unknown"})
+		testutil.Must(t, err)
+		if resp.JSON404 == nil {
+			t.Errorf("CherryPick of unknown ref expected 404, got %s", resp.Status())
+		}
+	})
+
+	t.Run("staging", func(t *testing.T) {
+		resp, err := clt.CherryPickWithResponse(ctx, repo, "main", api.CherryPickJSONRequestBody{Ref: "feature$"})
+		testutil.Must(t, err)
+		if resp.JSONDefault == nil {
+			t.Errorf("CherryPick of explicit staging should fail with error, got %v", resp)
+		}
+	})
+}
+
+func TestController_SignCommit(t *testing.T) {
+	clt, deps := setupClientWithAdmin(t)
+	ctx := context.Background()
+	repo := testUniqueRepoName()
+	_, err := deps.catalog.CreateRepository(ctx, repo, onBlock(deps, repo), "main")
+	testutil.Must(t, err)
+	testutil.MustDo(t, "create entry bar1", deps.catalog.CreateEntry(ctx, repo, "main", catalog.DBEntry{Path: "foo/bar1", PhysicalAddress: "bar1addr", CreationDate: time.Now(), Size: 1, Checksum: "cksum1"}))
+	commitResp, err := clt.CommitWithResponse(ctx, repo, "main", api.CommitJSONRequestBody{Message: "some message"})
+	verifyResponseOK(t, commitResp, err)
+	commitID := commitResp.JSON201.Id
+
+	userResp, err := clt.GetCurrentUserWithResponse(ctx)
+	verifyResponseOK(t, userResp, err)
+	userID := userResp.JSON200.User.Id
+
+	_, privateKey, err := ed25519.GenerateKey(nil)
+	testutil.MustDo(t, "generate key", err)
+	signer, err := ssh.NewSignerFromKey(privateKey)
+	testutil.MustDo(t, "signer", err)
+	identity, err := hex.DecodeString(commitID)
+	testutil.MustDo(t, "commit identity", err)
+	signature, err := keys.Sign(signer, identity)
+	testutil.MustDo(t, "sign", err)
+	keyID := keys.SigningKeyID(signer.PublicKey())
+
+	t.Run("unregistered key", func(t *testing.T) {
+		resp, err := clt.SignCommitWithResponse(ctx, repo, commitID, api.SignCommitJSONRequestBody{KeyId: keyID, Signature: signature})
+		testutil.Must(t, err)
+		if resp.JSON400 == nil {
+			t.Fatalf("SignCommit with unregistered key expected 400, got %s", resp.Status())
+		}
+	})
+
+	t.Run("add signing key", func(t *testing.T) {
+		resp, err := clt.AddSigningKeyWithResponse(ctx, userID, api.AddSigningKeyJSONRequestBody{
+			KeyType:   keys.SigningKeyTypeSSH,
+			PublicKey: string(ssh.MarshalAuthorizedKey(signer.PublicKey())),
+		})
+		testutil.Must(t, err)
+		if resp.JSON201 == nil {
+			t.Fatalf("AddSigningKey expected 201, got %s", resp.Status())
+		}
+		if resp.JSON201.KeyId != keyID {
+			t.Fatalf("AddSigningKey key ID %s, expected %s", resp.JSON201.KeyId, keyID)
+		}
+		listResp, err := clt.ListUserSigningKeysWithResponse(ctx, userID, &api.ListUserSigningKeysParams{})
+		verifyResponseOK(t, listResp, err)
+		if len(listResp.JSON200.Results) != 1 || listResp.JSON200.Results[0].KeyId != keyID {
+			t.Fatalf("ListUserSigningKeys %+v, expected key %s", listResp.JSON200.Results, keyID)
+		}
+	})
+
+	t.Run("invalid signing key", func(t *testing.T) {
+		resp, err := clt.AddSigningKeyWithResponse(ctx, userID, api.AddSigningKeyJSONRequestBody{
+			KeyType:   keys.SigningKeyTypeEd25519,
+			PublicKey: "not a key",
+		})
+		testutil.Must(t, err)
+		if resp.JSON400 == nil {
+			t.Fatalf("AddSigningKey with invalid key expected 400, got %s", resp.Status())
+		}
+	})
+
+	t.Run("bad signature", func(t *testing.T) {
+		resp, err := clt.SignCommitWithResponse(ctx, repo, commitID, api.SignCommitJSONRequestBody{KeyId: keyID, Signature: []byte("bad signature")})
+		testutil.Must(t, err)
+		if resp.JSON400 == nil {
+			t.Fatalf("SignCommit with bad signature expected 400, got %s", resp.Status())
+		}
+	})
+
+	t.Run("sign", func(t *testing.T) {
+		resp, err := clt.SignCommitWithResponse(ctx, repo, commitID, api.SignCommitJSONRequestBody{KeyId: keyID, Signature: signature})
+		verifyResponseOK(t, resp, err)
+		getResp, err := clt.GetCommitWithResponse(ctx, repo, commitID)
+		verifyResponseOK(t, getResp, err)
+		commitSignature := getResp.JSON200.Signature
+		if commitSignature == nil || commitSignature.KeyId != keyID || !commitSignature.Verified {
+			t.Fatalf("GetCommit signature %+v, expected verified signature by %s", commitSignature, keyID)
+		}
+	})
+
+	t.Run("delete signing key", func(t *testing.T) {
+		resp, err := clt.DeleteSigningKeyWithResponse(ctx, userID, api.DeleteSigningKeyJSONRequestBody{KeyId: keyID})
+		verifyResponseOK(t, resp, err)
+		logResp, err := clt.LogCommitsWithResponse(ctx, repo, "main", &api.LogCommitsParams{})
+		verifyResponseOK(t, logResp, err)
+		commitSignature := logResp.JSON200.Results[0].Signature
+		if commitSignature == nil || commitSignature.Verified {
+			t.Fatalf("LogCommits signature %+v, expected unverified signature after the key was deleted", commitSignature)
 		}
 	})
 }
@@ -0,0 +1,85 @@
+package keys
+
+import (
+	"crypto/ed25519"
+	crand "crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	// SigningKeyTypeEd25519 is a raw Ed25519 public key encoded in base64
+	SigningKeyTypeEd25519 = "ed25519"
+	// SigningKeyTypeSSH is an SSH public key in authorized_keys format
+	SigningKeyTypeSSH = "ssh"
+)
+
+var (
+	ErrUnknownSigningKeyType = errors.New("unknown signing key type")
+	ErrInvalidSigningKey     = errors.New("invalid signing key")
+	ErrInvalidSignature      = errors.New("invalid signature")
+)
+
+// ParseSigningPublicKey parses a public key of the given signing key type
+func ParseSigningPublicKey(keyType, publicKey string) (ssh.PublicKey, error) {
+	switch keyType {
+	case SigningKeyTypeEd25519:
+		raw, err := base64.StdEncoding.DecodeString(strings.TrimSpace(publicKey))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidSigningKey, err)
+		}
+		if len(raw) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("%w: ed25519 public key of %d bytes", ErrInvalidSigningKey, len(raw))
+		}
+		return ssh.NewPublicKey(ed25519.PublicKey(raw))
+	case SigningKeyTypeSSH:
+		key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(publicKey))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidSigningKey, err)
+		}
+		return key, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownSigningKeyType, keyType)
+	}
+}
+
+// SigningKeyID returns the ID of a signing public key, its SHA256 fingerprint
+func SigningKeyID(key ssh.PublicKey) string {
+	return ssh.FingerprintSHA256(key)
+}
+
+// VerifySignature verifies a signature of data made by key. The signature is in SSH wire format, or a raw
+// signature of 64 bytes for Ed25519 keys.
+func VerifySignature(key ssh.PublicKey, data, signature []byte) error {
+	var sig ssh.Signature
+	if key.Type() == ssh.KeyAlgoED25519 && len(signature) == ed25519.SignatureSize {
+		sig = ssh.Signature{Format: ssh.KeyAlgoED25519, Blob: signature}
+	} else if err := ssh.Unmarshal(signature, &sig); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidSignature, err)
+	}
+	if err := key.Verify(data, &sig); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidSignature, err)
+	}
+	return nil
+}
+
+// Sign signs data with signer, returning the signature in SSH wire format
+func Sign(signer ssh.Signer, data []byte) ([]byte, error) {
+	var (
+		sig *ssh.Signature
+		err error
+	)
+	if algorithmSigner, ok := signer.(ssh.AlgorithmSigner); ok && signer.PublicKey().Type() == ssh.KeyAlgoRSA {
+		sig, err = algorithmSigner.SignWithAlgorithm(crand.Reader, data, ssh.SigAlgoRSASHA2256)
+	} else {
+		sig, err = signer.Sign(crand.Reader, data)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ssh.Marshal(sig), nil
+}
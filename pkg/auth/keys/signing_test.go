@@ -0,0 +1,83 @@
+package keys_test
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
+	"testing"
+
+	"github.com/treeverse/lakefs/pkg/auth/keys"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestVerifySignature(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal("generate key:", err)
+	}
+	signer, err := ssh.NewSignerFromKey(privateKey)
+	if err != nil {
+		t.Fatal("signer:", err)
+	}
+	data := []byte("commit identity")
+	sshSignature, err := keys.Sign(signer, data)
+	if err != nil {
+		t.Fatal("sign:", err)
+	}
+	rawSignature := ed25519.Sign(privateKey, data)
+
+	ed25519Key, err := keys.ParseSigningPublicKey(keys.SigningKeyTypeEd25519, base64.StdEncoding.EncodeToString(publicKey))
+	if err != nil {
+		t.Fatal("parse ed25519 key:", err)
+	}
+	sshKey, err := keys.ParseSigningPublicKey(keys.SigningKeyTypeSSH, string(ssh.MarshalAuthorizedKey(signer.PublicKey())))
+	if err != nil {
+		t.Fatal("parse ssh key:", err)
+	}
+	if keys.SigningKeyID(ed25519Key) != keys.SigningKeyID(sshKey) {
+		t.Errorf("key ID %s of ed25519 key, expected %s of the same ssh key", keys.SigningKeyID(ed25519Key), keys.SigningKeyID(sshKey))
+	}
+
+	tests := []struct {
+		name        string
+		key         ssh.PublicKey
+		data        []byte
+		signature   []byte
+		expectedErr error
+	}{
+		{name: "ssh signature", key: sshKey, data: data, signature: sshSignature},
+		{name: "raw signature", key: ed25519Key, data: data, signature: rawSignature},
+		{name: "other data", key: sshKey, data: []byte("other"), signature: sshSignature, expectedErr: keys.ErrInvalidSignature},
+		{name: "garbage", key: sshKey, data: data, signature: []byte("garbage"), expectedErr: keys.ErrInvalidSignature},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := keys.VerifySignature(tt.key, tt.data, tt.signature)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("VerifySignature err=%v, expected=%v", err, tt.expectedErr)
+			}
+		})
+	}
+}
+
+func TestParseSigningPublicKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		keyType     string
+		publicKey   string
+		expectedErr error
+	}{
+		{name: "unknown type", keyType: "pgp", publicKey: "key", expectedErr: keys.ErrUnknownSigningKeyType},
+		{name: "ed25519 not base64", keyType: keys.SigningKeyTypeEd25519, publicKey: "not base64!", expectedErr: keys.ErrInvalidSigningKey},
+		{name: "ed25519 short", keyType: keys.SigningKeyTypeEd25519, publicKey: "c2hvcnQ=", expectedErr: keys.ErrInvalidSigningKey},
+		{name: "ssh invalid", keyType: keys.SigningKeyTypeSSH, publicKey: "ssh-ed25519 invalid", expectedErr: keys.ErrInvalidSigningKey},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := keys.ParseSigningPublicKey(tt.keyType, tt.publicKey)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("ParseSigningPublicKey err=%v, expected=%v", err, tt.expectedErr)
+			}
+		})
+	}
+}
@@ -121,6 +121,15 @@ type Credential struct {
 	UserID                        int       `db:"user_id"`
 }
 
+// SigningKey is a public key registered to a user for verifying signatures made by the user
+type SigningKey struct {
+	KeyID     string    `db:"key_id"`
+	KeyType   string    `db:"key_type"`
+	PublicKey string    `db:"public_key"`
+	CreatedAt time.Time `db:"created_at"`
+	UserID    int       `db:"user_id"`
+}
+
 // For JSON serialization:
 type CredentialKeys struct {
 	AccessKeyID     string `json:"access_key_id"`
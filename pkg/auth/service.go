@@ -69,6 +69,13 @@ type Service interface {
 	GetCredentials(ctx context.Context, accessKeyID string) (*model.Credential, error)
 	ListUserCredentials(ctx context.Context, username string, params *model.PaginationParams) ([]*model.Credential, *model.Paginator, error)
 
+	// signing keys
+	AddSigningKey(ctx context.Context, username, keyType, publicKey string) (*model.SigningKey, error)
+	DeleteSigningKey(ctx context.Context, username, keyID string) error
+	ListUserSigningKeys(ctx context.Context, username string, params *model.PaginationParams) ([]*model.SigningKey, *model.Paginator, error)
+	// VerifySignature returns nil if signature is a valid signature of data made by the signing key keyID of the user
+	VerifySignature(ctx context.Context, username, keyID string, data, signature []byte) error
+
 	// policy<->user attachments
 	AttachPolicyToUser(ctx context.Context, policyDisplayName, username string) error
 	DetachPolicyFromUser(ctx context.Context, policyDisplayName, username string) error
@@ -773,6 +780,95 @@ func (s *DBAuthService) DeleteCredentials(ctx context.Context, username, accessK
 	return err
 }
 
+func (s *DBAuthService) AddSigningKey(ctx context.Context, username, keyType, publicKey string) (*model.SigningKey, error) {
+	key, err := keys.ParseSigningPublicKey(keyType, publicKey)
+	if err != nil {
+		return nil, err
+	}
+	signingKey, err := s.db.Transact(ctx, func(tx db.Tx) (interface{}, error) {
+		user, err := getUser(tx, username)
+		if err != nil {
+			return nil, err
+		}
+		k := &model.SigningKey{
+			KeyID:     keys.SigningKeyID(key),
+			KeyType:   keyType,
+			PublicKey: strings.TrimSpace(publicKey),
+			CreatedAt: time.Now(),
+			UserID:    user.ID,
+		}
+		_, err = tx.Exec(`
+			INSERT INTO auth_user_signing_keys (user_id, key_id, key_type, public_key, created_at)
+			VALUES ($1, $2, $3, $4, $5)`,
+			k.UserID,
+			k.KeyID,
+			k.KeyType,
+			k.PublicKey,
+			k.CreatedAt,
+		)
+		if errors.Is(err, db.ErrAlreadyExists) {
+			return nil, fmt.Errorf("signing key %s: %w", k.KeyID, ErrAlreadyExists)
+		}
+		return k, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return signingKey.(*model.SigningKey), nil
+}
+
+func (s *DBAuthService) DeleteSigningKey(ctx context.Context, username, keyID string) error {
+	_, err := s.db.Transact(ctx, func(tx db.Tx) (interface{}, error) {
+		return nil, deleteOrNotFound(tx, `
+			DELETE FROM auth_user_signing_keys USING auth_users
+			WHERE auth_user_signing_keys.user_id = auth_users.id
+				AND auth_users.display_name = $1
+				AND auth_user_signing_keys.key_id = $2`,
+			username, keyID)
+	})
+	return err
+}
+
+func (s *DBAuthService) ListUserSigningKeys(ctx context.Context, username string, params *model.PaginationParams) ([]*model.SigningKey, *model.Paginator, error) {
+	var signingKey model.SigningKey
+	slice, paginator, err := ListPaged(ctx, s.db, reflect.TypeOf(signingKey), params, "key_id", psql.Select("auth_user_signing_keys.*").
+		From("auth_user_signing_keys").
+		Join("auth_users ON (auth_user_signing_keys.user_id = auth_users.id)").
+		Where(sq.And{
+			sq.Eq{"auth_users.display_name": username},
+			sq.Like{"key_id": fmt.Sprint(params.Prefix, "%")},
+		}))
+	if slice == nil {
+		return nil, paginator, err
+	}
+	return slice.Interface().([]*model.SigningKey), paginator, err
+}
+
+func (s *DBAuthService) VerifySignature(ctx context.Context, username, keyID string, data, signature []byte) error {
+	res, err := s.db.Transact(ctx, func(tx db.Tx) (interface{}, error) {
+		signingKey := &model.SigningKey{}
+		err := tx.Get(signingKey, `
+			SELECT auth_user_signing_keys.*
+			FROM auth_user_signing_keys
+			INNER JOIN auth_users ON (auth_user_signing_keys.user_id = auth_users.id)
+			WHERE auth_user_signing_keys.key_id = $1
+				AND auth_users.display_name = $2`, keyID, username)
+		if err != nil {
+			return nil, err
+		}
+		return signingKey, nil
+	}, db.ReadOnly())
+	if err != nil {
+		return fmt.Errorf("signing key %s of %s: %w", keyID, username, err)
+	}
+	signingKey := res.(*model.SigningKey)
+	key, err := keys.ParseSigningPublicKey(signingKey.KeyType, signingKey.PublicKey)
+	if err != nil {
+		return err
+	}
+	return keys.VerifySignature(key, data, signature)
+}
+
 func (s *DBAuthService) AttachPolicyToGroup(ctx context.Context, policyDisplayName, groupDisplayName string) error {
 	_, err := s.db.Transact(ctx, func(tx db.Tx) (interface{}, error) {
 		if _, err := getGroup(tx, groupDisplayName); err != nil {
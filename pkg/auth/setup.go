@@ -126,6 +126,7 @@ func SetupBaseGroups(ctx context.Context, authService Service, ts time.Time) err
 						"ci:*",
 						"retention:*",
 						"branches:*",
+						"tags:*",
 						"fs:ReadConfig",
 					},
 					Resource: permissions.All,
@@ -142,6 +143,7 @@ func SetupBaseGroups(ctx context.Context, authService Service, ts time.Time) err
 						"ci:Read*",
 						"retention:Get*",
 						"branches:Get*",
+						"tags:Get*",
 						"fs:ReadConfig",
 					},
 					Resource: permissions.All,
@@ -4,6 +4,7 @@ import (
 	"context"
 	"io"
 	"net/http"
+	"time"
 )
 
 // MultipartPart single multipart information
@@ -116,13 +117,22 @@ type Properties struct {
 	StorageClass *string
 }
 
-// WalkFunc is called for each object visited by the Walk.
+// WalkEntry describes an object visited by Walk.  Adapters leave the attributes they cannot tell empty.
+type WalkEntry struct {
+	// Address is the full address of the object, such as s3://bucket/path/to/object
+	Address      string
+	Size         int64
+	ETag         string
+	LastModified time.Time
+}
+
+// WalkFunc is called for each object visited by the Walk, in lexicographic order of the object addresses.
 // The id argument contains the argument to Walk as a prefix; that is, if Walk is called with "test/data/",
 // which is a prefix containing the object "test/data/a", the walk function will be called with argument "test/data/a".
 // If there was a problem walking to the object, the incoming error will describe the problem and the function can decide
 // how to handle that error.
 // If an error is returned, processing stops.
-type WalkFunc func(id string) error
+type WalkFunc func(id string, entry WalkEntry) error
 
 type Adapter interface {
 	InventoryGenerator
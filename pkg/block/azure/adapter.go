@@ -231,7 +231,17 @@ func (a *Adapter) Walk(ctx context.Context, walkOpt block.WalkOpts, walkFn block
 		}
 		marker = listBlob.NextMarker
 		for _, blobInfo := range listBlob.Segment.BlobItems {
-			if err := walkFn(blobInfo.Name); err != nil {
+			var size int64
+			if blobInfo.Properties.ContentLength != nil {
+				size = *blobInfo.Properties.ContentLength
+			}
+			entry := block.WalkEntry{
+				Address:      qualifiedPrefix.ContainerURL + "/" + blobInfo.Name,
+				Size:         size,
+				ETag:         strings.Trim(string(blobInfo.Properties.Etag), `"`),
+				LastModified: blobInfo.Properties.LastModified,
+			}
+			if err := walkFn(blobInfo.Name, entry); err != nil {
 				return err
 			}
 		}
@@ -2,6 +2,7 @@ package gs
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
@@ -143,7 +144,17 @@ func (a *Adapter) Walk(ctx context.Context, walkOpt block.WalkOpts, walkFn block
 			return fmt.Errorf("bucket(%s).Objects(): %w", qualifiedPrefix.StorageNamespace, err)
 		}
 
-		if err := walkFn(attrs.Name); err != nil {
+		entry := block.WalkEntry{
+			Address: block.QualifiedKey{
+				StorageType:      block.StorageTypeGS,
+				StorageNamespace: qualifiedPrefix.StorageNamespace,
+				Key:              attrs.Name,
+			}.Format(),
+			Size:         attrs.Size,
+			ETag:         hex.EncodeToString(attrs.MD5),
+			LastModified: attrs.Updated,
+		}
+		if err := walkFn(attrs.Name, entry); err != nil {
 			return err
 		}
 	}
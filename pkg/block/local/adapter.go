@@ -256,14 +256,98 @@ func (l *Adapter) Get(_ context.Context, obj block.ObjectPointer, _ int64) (read
 }
 
 func (l *Adapter) Walk(_ context.Context, walkOpt block.WalkOpts, walkFn block.WalkFunc) error {
-	p := filepath.Clean(path.Join(l.path, walkOpt.StorageNamespace, walkOpt.Prefix))
-	return filepath.Walk(p, func(path string, info os.FileInfo, err error) error {
+	qualifiedPrefix, err := block.ResolveNamespacePrefix(walkOpt.StorageNamespace, walkOpt.Prefix)
+	if err != nil {
+		return err
+	}
+	if qualifiedPrefix.StorageType != block.StorageTypeLocal {
+		return block.ErrInvalidNamespace
+	}
+	root := filepath.Clean(path.Join(l.path, qualifiedPrefix.StorageNamespace))
+	p := filepath.Clean(path.Join(root, qualifiedPrefix.Prefix))
+	if err := l.verifyPath(p); err != nil {
+		return err
+	}
+
+	info, err := os.Stat(p)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return walkFile(p, info, func(filePath string, info os.FileInfo) error {
+		key := filepath.ToSlash(strings.TrimPrefix(filePath, root+string(filepath.Separator)))
+		etag, err := fileETag(filePath)
 		if err != nil {
 			return err
 		}
+		entry := block.WalkEntry{
+			Address: block.QualifiedKey{
+				StorageType:      block.StorageTypeLocal,
+				StorageNamespace: qualifiedPrefix.StorageNamespace,
+				Key:              key,
+			}.Format(),
+			Size:         info.Size(),
+			ETag:         etag,
+			LastModified: info.ModTime(),
+		}
+		return walkFn(key, entry)
+	})
+}
 
-		return walkFn(p)
+// walkFile calls fn with the files under filePath in lexicographic order of their paths.  Unlike filepath.Walk, it
+// visits a file before a sibling directory whose name the file name extends with a character sorting before the
+// separator, such as "dir.txt" before "dir/a".
+func walkFile(filePath string, info os.FileInfo, fn func(filePath string, info os.FileInfo) error) error {
+	if !info.IsDir() {
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		return fn(filePath, info)
+	}
+	entries, err := os.ReadDir(filePath)
+	if err != nil {
+		return err
+	}
+	sortName := func(entry os.DirEntry) string {
+		if entry.IsDir() {
+			return entry.Name() + string(filepath.Separator)
+		}
+		return entry.Name()
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return sortName(entries[i]) < sortName(entries[j])
 	})
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if os.IsNotExist(err) {
+			// removed since the directory was read
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if err := walkFile(filepath.Join(filePath, entry.Name()), info, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fileETag returns the ETag of the file at filePath, the MD5 digest of its content like the ETag of objects the
+// adapter writes
+func fileETag(filePath string) (string, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = f.Close() }()
+	h := md5.New() //nolint:gosec
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
 }
 
 func (l *Adapter) Exists(_ context.Context, obj block.ObjectPointer) (bool, error) {
@@ -2,6 +2,8 @@ package local_test
 
 import (
 	"context"
+	"crypto/md5" //nolint:gosec
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
@@ -146,6 +148,49 @@ func TestLocalCopy(t *testing.T) {
 	}
 }
 
+func TestLocalWalk(t *testing.T) {
+	a := makeAdapter(t)
+	ctx := context.Background()
+
+	for _, p := range []string{"dir/b", "dir.txt", "dir/a/x", "dir/a-1", "other"} {
+		testutil.MustDo(t, "Put", a.Put(ctx, makePointer(p), 0, strings.NewReader(p), block.PutOpts{}))
+	}
+	walk := func(prefix string) ([]string, []string) {
+		var ids, addresses []string
+		err := a.Walk(ctx, block.WalkOpts{StorageNamespace: testStorageNamespace, Prefix: prefix}, func(id string, entry block.WalkEntry) error {
+			ids = append(ids, id)
+			addresses = append(addresses, entry.Address)
+			if entry.Size != int64(len(id)) {
+				t.Errorf("Walk entry %s size %d, expected %d", id, entry.Size, len(id))
+			}
+			// the content of every object is its id
+			if etag := fmt.Sprintf("%x", md5.Sum([]byte(id))); entry.ETag != etag { //nolint:gosec
+				t.Errorf("Walk entry %s ETag %s, expected %s", id, entry.ETag, etag)
+			}
+			return nil
+		})
+		testutil.MustDo(t, "Walk", err)
+		return ids, addresses
+	}
+
+	// objects are visited in the order of their keys, files before sibling directories they prefix
+	ids, addresses := walk("")
+	if diff := deep.Equal(ids, []string{"dir.txt", "dir/a-1", "dir/a/x", "dir/b", "other"}); diff != nil {
+		t.Error("Walk ids diff", diff)
+	}
+	if diff := deep.Equal(addresses[0], testStorageNamespace+"/dir.txt"); diff != nil {
+		t.Error("Walk address diff", diff)
+	}
+	ids, _ = walk("dir")
+	if diff := deep.Equal(ids, []string{"dir/a-1", "dir/a/x", "dir/b"}); diff != nil {
+		t.Error("Walk with prefix ids diff", diff)
+	}
+	ids, _ = walk("missing")
+	if len(ids) != 0 {
+		t.Errorf("Walk of missing prefix got %v, expected none", ids)
+	}
+}
+
 func dumpPathTree(t testing.TB, root string) []string {
 	t.Helper()
 	tree := make([]string, 0)
@@ -288,15 +288,35 @@ func (a *Adapter) CompleteMultiPartUpload(_ context.Context, obj block.ObjectPoi
 }
 
 func (a *Adapter) Walk(_ context.Context, walkOpt block.WalkOpts, walkFn block.WalkFunc) error {
+	// objects are collected before they are visited, so walkFn can write to the adapter
+	fullPrefix := getPrefix(walkOpt)
 	a.mutex.RLock()
-	defer a.mutex.RUnlock()
+	var keys []string
+	entries := make(map[string]block.WalkEntry)
+	for k, data := range a.data {
+		if !strings.HasPrefix(k, fullPrefix) {
+			continue
+		}
+		identifier := strings.TrimPrefix(k, walkOpt.StorageNamespace+":")
+		qk, err := block.ResolveNamespace(walkOpt.StorageNamespace, identifier, block.IdentifierTypeUnknownDeprecated)
+		if err != nil {
+			a.mutex.RUnlock()
+			return err
+		}
+		h := sha256.Sum256(data)
+		keys = append(keys, k)
+		entries[k] = block.WalkEntry{
+			Address: qk.Format(),
+			Size:    int64(len(data)),
+			ETag:    hex.EncodeToString(h[:]),
+		}
+	}
+	a.mutex.RUnlock()
 
-	fullPrefix := getPrefix(walkOpt)
-	for k := range a.data {
-		if strings.HasPrefix(k, fullPrefix) {
-			if err := walkFn(k); err != nil {
-				return err
-			}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if err := walkFn(k, entries[k]); err != nil {
+			return err
 		}
 	}
 	return nil
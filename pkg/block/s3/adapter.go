@@ -367,7 +367,17 @@ func (a *Adapter) Walk(ctx context.Context, walkOpt block.WalkOpts, walkFn block
 		}
 
 		for _, obj := range listOutput.Contents {
-			if err := walkFn(*obj.Key); err != nil {
+			entry := block.WalkEntry{
+				Address: block.QualifiedKey{
+					StorageType:      block.StorageTypeS3,
+					StorageNamespace: qualifiedPrefix.StorageNamespace,
+					Key:              *obj.Key,
+				}.Format(),
+				Size:         aws.Int64Value(obj.Size),
+				ETag:         strings.Trim(aws.StringValue(obj.ETag), "\""),
+				LastModified: aws.TimeValue(obj.LastModified),
+			}
+			if err := walkFn(*obj.Key, entry); err != nil {
 				return err
 			}
 		}
@@ -376,8 +386,11 @@ func (a *Adapter) Walk(ctx context.Context, walkOpt block.WalkOpts, walkFn block
 			break
 		}
 
-		// start with the next marker
+		// start with the next marker, S3 returns one only when listing with a delimiter
 		listObjectInput.Marker = listOutput.NextMarker
+		if listObjectInput.Marker == nil && len(listOutput.Contents) > 0 {
+			listObjectInput.Marker = listOutput.Contents[len(listOutput.Contents)-1].Key
+		}
 	}
 
 	return nil
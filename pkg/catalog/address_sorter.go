@@ -0,0 +1,206 @@
+package catalog
+
+import (
+	"bufio"
+	"container/heap"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+)
+
+// referencedRule is the rule of the addresses of referenced objects, sorted before the addresses of the same
+// objects expired by any rule
+const referencedRule = -1
+
+// ruleAddress is the address of an object and the index of the garbage collection rule that expired it, or
+// referencedRule if the object is referenced
+type ruleAddress struct {
+	Address string
+	Rule    int
+}
+
+func (a ruleAddress) less(b ruleAddress) bool {
+	if a.Address != b.Address {
+		return a.Address < b.Address
+	}
+	return a.Rule < b.Rule
+}
+
+// ruleAddressSorter sorts rule addresses using bounded memory.  Every maxInMemory addresses added are sorted and
+// spilled to a temporary file, and the spilled runs are merged when the addresses are read.
+type ruleAddressSorter struct {
+	maxInMemory int
+	buffer      []ruleAddress
+	runs        []*os.File
+}
+
+func newRuleAddressSorter(maxInMemory int) *ruleAddressSorter {
+	return &ruleAddressSorter{maxInMemory: maxInMemory}
+}
+
+func (s *ruleAddressSorter) Add(address string, rule int) error {
+	s.buffer = append(s.buffer, ruleAddress{Address: address, Rule: rule})
+	if len(s.buffer) < s.maxInMemory {
+		return nil
+	}
+	return s.spill()
+}
+
+func (s *ruleAddressSorter) sortBuffer() {
+	sort.Slice(s.buffer, func(i, j int) bool { return s.buffer[i].less(s.buffer[j]) })
+}
+
+func (s *ruleAddressSorter) spill() error {
+	s.sortBuffer()
+	file, err := os.CreateTemp("", "lakefs-gc-addresses-")
+	if err != nil {
+		return fmt.Errorf("creating temporary file: %w", err)
+	}
+	s.runs = append(s.runs, file)
+	if err := os.Remove(file.Name()); err != nil {
+		return fmt.Errorf("removing file %s from directory: %w", file.Name(), err)
+	}
+	w := bufio.NewWriter(file)
+	for _, a := range s.buffer {
+		if err := writeRuleAddress(w, a); err != nil {
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	s.buffer = s.buffer[:0]
+	return nil
+}
+
+// Sorted calls cb with every address added, ordered by address and then by rule
+func (s *ruleAddressSorter) Sorted(cb func(ruleAddress) error) error {
+	s.sortBuffer()
+	sources := &ruleAddressSources{}
+	for _, run := range s.runs {
+		r := bufio.NewReader(run)
+		src := &ruleAddressSource{next: func() (ruleAddress, error) { return readRuleAddress(r) }}
+		if err := sources.push(src); err != nil {
+			return err
+		}
+	}
+	buffer := s.buffer
+	src := &ruleAddressSource{next: func() (ruleAddress, error) {
+		if len(buffer) == 0 {
+			return ruleAddress{}, io.EOF
+		}
+		a := buffer[0]
+		buffer = buffer[1:]
+		return a, nil
+	}}
+	if err := sources.push(src); err != nil {
+		return err
+	}
+	for sources.Len() > 0 {
+		src := (*sources)[0]
+		if err := cb(src.current); err != nil {
+			return err
+		}
+		if err := src.advance(); errors.Is(err, io.EOF) {
+			heap.Pop(sources)
+		} else if err != nil {
+			return err
+		} else {
+			heap.Fix(sources, 0)
+		}
+	}
+	return nil
+}
+
+// Close removes the spilled runs
+func (s *ruleAddressSorter) Close() {
+	for _, run := range s.runs {
+		_ = run.Close()
+	}
+	s.runs = nil
+	s.buffer = nil
+}
+
+func writeRuleAddress(w *bufio.Writer, a ruleAddress) error {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(buf[:], int64(a.Rule))
+	if _, err := w.Write(buf[:n]); err != nil {
+		return err
+	}
+	n = binary.PutUvarint(buf[:], uint64(len(a.Address)))
+	if _, err := w.Write(buf[:n]); err != nil {
+		return err
+	}
+	_, err := w.WriteString(a.Address)
+	return err
+}
+
+// readRuleAddress reads the next rule address written by writeRuleAddress, or returns io.EOF at the end of r
+func readRuleAddress(r *bufio.Reader) (ruleAddress, error) {
+	rule, err := binary.ReadVarint(r)
+	if err != nil {
+		return ruleAddress{}, err
+	}
+	length, err := binary.ReadUvarint(r)
+	if err != nil {
+		return ruleAddress{}, fmt.Errorf("reading address length: %w", err)
+	}
+	address := make([]byte, length)
+	if _, err := io.ReadFull(r, address); err != nil {
+		return ruleAddress{}, fmt.Errorf("reading address: %w", err)
+	}
+	return ruleAddress{Address: string(address), Rule: int(rule)}, nil
+}
+
+// ruleAddressSource is a sorted run of rule addresses being merged
+type ruleAddressSource struct {
+	current ruleAddress
+	next    func() (ruleAddress, error)
+}
+
+func (s *ruleAddressSource) advance() error {
+	a, err := s.next()
+	if err != nil {
+		return err
+	}
+	s.current = a
+	return nil
+}
+
+// ruleAddressSources is a min-heap of the sources being merged, by their current rule address
+type ruleAddressSources []*ruleAddressSource
+
+func (h ruleAddressSources) Len() int           { return len(h) }
+func (h ruleAddressSources) Less(i, j int) bool { return h[i].current.less(h[j].current) }
+func (h ruleAddressSources) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *ruleAddressSources) Push(x interface{}) {
+	*h = append(*h, x.(*ruleAddressSource))
+}
+
+func (h *ruleAddressSources) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
+// push adds src to the heap unless it is empty
+func (h *ruleAddressSources) push(src *ruleAddressSource) error {
+	err := src.advance()
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	heap.Push(h, src)
+	return nil
+}
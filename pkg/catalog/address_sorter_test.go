@@ -0,0 +1,40 @@
+package catalog
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/go-test/deep"
+)
+
+func TestRuleAddressSorter(t *testing.T) {
+	for _, maxInMemory := range []int{1, 3, 1000} {
+		t.Run(fmt.Sprintf("max_in_memory_%d", maxInMemory), func(t *testing.T) {
+			var added []ruleAddress
+			for i := 0; i < 100; i++ {
+				added = append(added, ruleAddress{Address: fmt.Sprintf("mem://bucket/data/%03d", rand.Intn(50)), Rule: rand.Intn(3) - 1}) //nolint:gosec
+			}
+			sorter := newRuleAddressSorter(maxInMemory)
+			defer sorter.Close()
+			for _, a := range added {
+				if err := sorter.Add(a.Address, a.Rule); err != nil {
+					t.Fatalf("Add() error = %v", err)
+				}
+			}
+			var sorted []ruleAddress
+			err := sorter.Sorted(func(a ruleAddress) error {
+				sorted = append(sorted, a)
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("Sorted() error = %v", err)
+			}
+			sort.Slice(added, func(i, j int) bool { return added[i].less(added[j]) })
+			if diff := deep.Equal(sorted, added); diff != nil {
+				t.Error("Sorted() diff", diff)
+			}
+		})
+	}
+}
@@ -8,10 +8,15 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"sort"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/cockroachdb/pebble"
 	"github.com/hashicorp/go-multierror"
+	"github.com/rs/xid"
 	"github.com/treeverse/lakefs/pkg/batch"
 	"github.com/treeverse/lakefs/pkg/block"
 	"github.com/treeverse/lakefs/pkg/block/factory"
@@ -20,15 +25,18 @@ import (
 	"github.com/treeverse/lakefs/pkg/graveler"
 	"github.com/treeverse/lakefs/pkg/graveler/branch"
 	"github.com/treeverse/lakefs/pkg/graveler/committed"
+	"github.com/treeverse/lakefs/pkg/graveler/merge"
 	"github.com/treeverse/lakefs/pkg/graveler/ref"
 	"github.com/treeverse/lakefs/pkg/graveler/retention"
 	"github.com/treeverse/lakefs/pkg/graveler/settings"
 	"github.com/treeverse/lakefs/pkg/graveler/sstable"
 	"github.com/treeverse/lakefs/pkg/graveler/staging"
+	"github.com/treeverse/lakefs/pkg/graveler/tag"
 	"github.com/treeverse/lakefs/pkg/ident"
 	"github.com/treeverse/lakefs/pkg/logging"
 	"github.com/treeverse/lakefs/pkg/pyramid"
 	"github.com/treeverse/lakefs/pkg/pyramid/params"
+	"github.com/treeverse/lakefs/pkg/stats"
 	"github.com/treeverse/lakefs/pkg/validator"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -104,6 +112,8 @@ type Config struct {
 	Config *config.Config
 	DB     db.Database
 	LockDB db.Database
+	// StatsCollector collects an event for every expired branch deleted, when set
+	StatsCollector stats.Collector
 }
 
 type Catalog struct {
@@ -111,12 +121,23 @@ type Catalog struct {
 	Store        Store
 	log          logging.Logger
 	managers     []io.Closer
+	// deletionRetention is the period during which deleted repositories can be restored
+	deletionRetention time.Duration
+	statsCollector    stats.Collector
+	// imports are the imports running on this server by their ID, created on the first import
+	importsMu sync.Mutex
+	imports   map[graveler.ImportID]*importTask
 }
 
 const (
 	ListRepositoriesLimitMax = 1000
 	ListBranchesLimitMax     = 1000
 	ListTagsLimitMax         = 1000
+	ListBranchReflogLimitMax = 1000
+	ListStashesLimitMax      = 1000
+	SearchCommitsLimitMax    = 1000
+	ObjectHistoryLimitMax    = 1000
+	CompareBranchesLimitMax  = 100
 	DiffLimitMax             = 1000
 	ListEntriesLimitMax      = 10000
 )
@@ -173,8 +194,9 @@ func New(ctx context.Context, cfg Config) (*Catalog, error) {
 
 	sstableManager := sstable.NewPebbleSSTableRangeManager(pebbleSSTableCache, rangeFS, hashAlg)
 	sstableMetaManager := sstable.NewPebbleSSTableRangeManager(pebbleSSTableCache, metaRangeFS, hashAlg)
+	committedParams := *cfg.Config.GetCommittedParams()
 	sstableMetaRangeManager, err := committed.NewMetaRangeManager(
-		*cfg.Config.GetCommittedParams(),
+		committedParams,
 		// TODO(ariels): Use separate range managers for metaranges and ranges
 		sstableMetaManager,
 		sstableManager,
@@ -183,7 +205,7 @@ func New(ctx context.Context, cfg Config) (*Catalog, error) {
 		cancelFn()
 		return nil, fmt.Errorf("create SSTable-based metarange manager: %w", err)
 	}
-	committedManager := committed.NewCommittedManager(sstableMetaRangeManager)
+	committedManager := committed.NewCommittedManager(sstableMetaRangeManager, committedParams)
 
 	executor := batch.NewExecutor(logging.Default())
 	go executor.Run(ctx)
@@ -194,20 +216,49 @@ func New(ctx context.Context, cfg Config) (*Catalog, error) {
 	stagingManager := staging.NewManager(cfg.DB)
 	settingManager := settings.NewManager(refManager, branchLocker, adapter, cfg.Config.GetCommittedBlockStoragePrefix())
 	protectedBranchesManager := branch.NewProtectionManager(settingManager)
-	store := graveler.NewGraveler(branchLocker, committedManager, stagingManager, refManager, gcManager, protectedBranchesManager)
-
-	return &Catalog{
-		BlockAdapter: tierFSParams.Adapter,
-		Store:        store,
-		log:          logging.Default().WithField("service_name", "entry_catalog"),
-		managers:     []io.Closer{sstableManager, sstableMetaManager, &ctxCloser{cancelFn}},
-	}, nil
+	mergeStrategyRulesManager := merge.NewStrategyRulesManager(settingManager)
+	protectedTagsManager := tag.NewProtectionManager(settingManager)
+	store := graveler.NewGraveler(branchLocker, committedManager, stagingManager, refManager, gcManager, protectedBranchesManager, mergeStrategyRulesManager, protectedTagsManager)
+
+	c := &Catalog{
+		BlockAdapter:      tierFSParams.Adapter,
+		Store:             store,
+		log:               logging.Default().WithField("service_name", "entry_catalog"),
+		managers:          []io.Closer{sstableManager, sstableMetaManager, &ctxCloser{cancelFn}},
+		deletionRetention: cfg.Config.GetRepositoryDeletionRetention(),
+		statsCollector:    cfg.StatsCollector,
+	}
+	return c, nil
+}
+
+// StartBackgroundTasks starts the periodic purge of deleted repositories, deletion of expired branches and removal
+// of uncommitted garbage configured by cfg.  Only the server runs them, the tasks stop once ctx is done or the
+// catalog is closed.
+func (c *Catalog) StartBackgroundTasks(ctx context.Context, cfg *config.Config) {
+	ctx, cancelFn := context.WithCancel(graveler.WithBranchReflogUser(ctx, graveler.BranchReflogSystemUser))
+	c.managers = append(c.managers, &ctxCloser{cancelFn})
+	if interval := cfg.GetRepositoryDeletionPurgeInterval(); interval > 0 {
+		go c.runRepositoryPurge(ctx, interval)
+	}
+	if interval := cfg.GetBranchExpiryCleanupInterval(); interval > 0 {
+		go c.runBranchExpiryCleanup(ctx, interval)
+	}
+	if interval := cfg.GetUncommittedGCInterval(); interval > 0 {
+		go c.runUncommittedGC(ctx, interval, UncommittedGCParams{
+			GracePeriod: cfg.GetUncommittedGCGracePeriod(),
+			Parallelism: uncommittedGCParallelism,
+		})
+	}
 }
 
 func (c *Catalog) SetHooksHandler(hooks graveler.HooksHandler) {
 	c.Store.SetHooksHandler(hooks)
 }
 
+func (c *Catalog) SetSignatureVerifier(verifier graveler.SignatureVerifier) {
+	c.Store.SetSignatureVerifier(verifier)
+}
+
 // CreateRepository create a new repository pointing to 'storageNamespace' (ex: s3://bucket1/repo) with default branch name 'branch'
 func (c *Catalog) CreateRepository(ctx context.Context, repository string, storageNamespace string, branch string) (*Repository, error) {
 	repositoryID := graveler.RepositoryID(repository)
@@ -277,7 +328,8 @@ func (c *Catalog) GetRepository(ctx context.Context, repository string) (*Reposi
 	return catalogRepository, nil
 }
 
-// DeleteRepository delete a repository
+// DeleteRepository delete a repository. The repository can be restored until the deletion retention period passes
+// and it is purged.
 func (c *Catalog) DeleteRepository(ctx context.Context, repository string) error {
 	repositoryID := graveler.RepositoryID(repository)
 	if err := validator.Validate([]validator.ValidateArg{
@@ -285,7 +337,157 @@ func (c *Catalog) DeleteRepository(ctx context.Context, repository string) error
 	}); err != nil {
 		return err
 	}
-	return c.Store.DeleteRepository(ctx, repositoryID)
+	if err := c.Store.DeleteRepository(ctx, repositoryID); err != nil {
+		return err
+	}
+	if c.deletionRetention <= 0 {
+		return c.Store.PurgeRepository(ctx, repositoryID)
+	}
+	return nil
+}
+
+// RestoreRepository restores a deleted repository that was not purged yet
+func (c *Catalog) RestoreRepository(ctx context.Context, repository string) (*Repository, error) {
+	repositoryID := graveler.RepositoryID(repository)
+	if err := validator.Validate([]validator.ValidateArg{
+		{Name: "repository", Value: repositoryID, Fn: graveler.ValidateRepositoryID},
+	}); err != nil {
+		return nil, err
+	}
+	if err := c.Store.RestoreRepository(ctx, repositoryID); err != nil {
+		return nil, err
+	}
+	return c.GetRepository(ctx, repository)
+}
+
+// RenameRepository renames a repository. A positive aliasPeriod keeps the current name resolving to the repository
+// through GetRepositoryByAlias for that period.
+func (c *Catalog) RenameRepository(ctx context.Context, repository string, newName string, aliasPeriod time.Duration) (*Repository, error) {
+	repositoryID := graveler.RepositoryID(repository)
+	newRepositoryID := graveler.RepositoryID(newName)
+	if err := validator.Validate([]validator.ValidateArg{
+		{Name: "repository", Value: repositoryID, Fn: graveler.ValidateRepositoryID},
+		{Name: "name", Value: newRepositoryID, Fn: graveler.ValidateRepositoryID},
+	}); err != nil {
+		return nil, err
+	}
+	if repositoryID == newRepositoryID {
+		return nil, fmt.Errorf("rename repository to its own name: %w", graveler.ErrInvalidValue)
+	}
+	var aliasExpiry time.Time
+	if aliasPeriod > 0 {
+		aliasExpiry = time.Now().Add(aliasPeriod)
+	}
+	if err := c.Store.RenameRepository(ctx, repositoryID, newRepositoryID, aliasExpiry); err != nil {
+		return nil, err
+	}
+	return c.GetRepository(ctx, newName)
+}
+
+// GetRepositoryByAlias returns the repository that a previous name of a renamed repository resolves to
+func (c *Catalog) GetRepositoryByAlias(ctx context.Context, alias string) (*Repository, error) {
+	aliasID := graveler.RepositoryID(alias)
+	if err := validator.Validate([]validator.ValidateArg{
+		{Name: "alias", Value: aliasID, Fn: graveler.ValidateRepositoryID},
+	}); err != nil {
+		return nil, err
+	}
+	repositoryID, err := c.Store.ResolveRepositoryAlias(ctx, aliasID)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetRepository(ctx, repositoryID.String())
+}
+
+// PurgeDeletedRepositories purges the repositories deleted before the deletion retention period, and deletes the
+// expired repository aliases
+func (c *Catalog) PurgeDeletedRepositories(ctx context.Context) error {
+	now := time.Now()
+	repositories, err := c.Store.ListDeletedRepositories(ctx)
+	if err != nil {
+		return err
+	}
+	for _, repository := range repositories {
+		if repository.DeletionDate.Add(c.deletionRetention).After(now) {
+			continue
+		}
+		err := c.Store.PurgeRepository(ctx, repository.RepositoryID)
+		// another instance may have purged the repository first
+		if errors.Is(err, graveler.ErrRepositoryNotFound) {
+			continue
+		}
+		// a legal hold placed while the repository was deleted keeps it until released
+		if errors.Is(err, graveler.ErrDeleteLegalHoldRepository) {
+			c.log.WithField("repository", repository.RepositoryID).Warn("Deleted repository has legal holds, not purged")
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("purge repository %s: %w", repository.RepositoryID, err)
+		}
+		c.log.WithField("repository", repository.RepositoryID).Info("Purged deleted repository")
+	}
+	return c.Store.DeleteExpiredRepositoryAliases(ctx, now)
+}
+
+func (c *Catalog) runRepositoryPurge(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := c.PurgeDeletedRepositories(ctx); err != nil {
+				c.log.WithError(err).Error("Failed to purge deleted repositories")
+			}
+		}
+	}
+}
+
+// DeleteExpiredBranches deletes the expired ephemeral branches of all repositories
+func (c *Catalog) DeleteExpiredBranches(ctx context.Context) error {
+	now := time.Now()
+	it, err := c.Store.ListRepositories(ctx)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	for it.Next() {
+		repositoryID := it.Value().RepositoryID
+		deleted, err := c.Store.DeleteExpiredBranches(ctx, repositoryID, now)
+		for _, branchID := range deleted {
+			c.log.WithFields(logging.Fields{
+				"repository": repositoryID,
+				"branch":     branchID,
+			}).Info("Deleted expired branch")
+			if c.statsCollector != nil {
+				c.statsCollector.CollectEvent("branch_expiry", "delete_expired_branch")
+			}
+		}
+		// another instance may have deleted the repository meanwhile
+		if errors.Is(err, graveler.ErrRepositoryNotFound) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("delete expired branches of %s: %w", repositoryID, err)
+		}
+	}
+	return it.Err()
+}
+
+func (c *Catalog) runBranchExpiryCleanup(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := c.DeleteExpiredBranches(ctx); err != nil {
+				c.log.WithError(err).Error("Failed to delete expired branches")
+			}
+		}
+	}
 }
 
 // ListRepositories list repositories information, the bool returned is true when more repositories can be listed.
@@ -367,6 +569,10 @@ func (c *Catalog) GetStagingToken(ctx context.Context, repository string, branch
 }
 
 func (c *Catalog) CreateBranch(ctx context.Context, repository string, branch string, sourceBranch string) (*CommitLog, error) {
+	return c.CreateEphemeralBranch(ctx, repository, branch, sourceBranch, nil, 0)
+}
+
+func (c *Catalog) CreateEphemeralBranch(ctx context.Context, repository string, branch string, sourceBranch string, expiresAt *time.Time, idleTTL time.Duration) (*CommitLog, error) {
 	repositoryID := graveler.RepositoryID(repository)
 	branchID := graveler.BranchID(branch)
 	sourceRef := graveler.Ref(sourceBranch)
@@ -377,7 +583,8 @@ func (c *Catalog) CreateBranch(ctx context.Context, repository string, branch st
 	}); err != nil {
 		return nil, err
 	}
-	newBranch, err := c.Store.CreateBranch(ctx, repositoryID, branchID, sourceRef)
+	expiry := graveler.BranchExpiry{ExpiresAt: expiresAt, IdleTTL: idleTTL}
+	newBranch, err := c.Store.CreateEphemeralBranch(ctx, repositoryID, branchID, sourceRef, expiry)
 	if err != nil {
 		return nil, err
 	}
@@ -446,6 +653,8 @@ func (c *Catalog) ListBranches(ctx context.Context, repository string, prefix st
 		branch := &Branch{
 			Name:      v.BranchID.String(),
 			Reference: v.CommitID.String(),
+			ExpiresAt: v.Expiry.ExpiresAt,
+			IdleTTL:   v.Expiry.IdleTTL,
 		}
 		branches = append(branches, branch)
 		if len(branches) >= limit+1 {
@@ -464,6 +673,190 @@ func (c *Catalog) ListBranches(ctx context.Context, repository string, prefix st
 	return branches, hasMore, nil
 }
 
+func (c *Catalog) ListBranchReflog(ctx context.Context, repository, branch string, limit, from int) ([]*BranchReflogEntry, bool, error) {
+	repositoryID := graveler.RepositoryID(repository)
+	branchID := graveler.BranchID(branch)
+	if err := validator.Validate([]validator.ValidateArg{
+		{Name: "repository", Value: repositoryID, Fn: graveler.ValidateRepositoryID},
+		{Name: "branch", Value: branchID, Fn: graveler.ValidateBranchID},
+		{Name: "from", Value: from, Fn: validator.ValidateNonNegativeInt},
+	}); err != nil {
+		return nil, false, err
+	}
+	// normalize limit
+	if limit < 0 || limit > ListBranchReflogLimitMax {
+		limit = ListBranchReflogLimitMax
+	}
+	it, err := c.Store.ListBranchReflog(ctx, repositoryID, branchID)
+	if err != nil {
+		return nil, false, err
+	}
+	defer it.Close()
+	it.SeekGE(from)
+	var entries []*BranchReflogEntry
+	for it.Next() {
+		v := it.Value()
+		entries = append(entries, &BranchReflogEntry{
+			Index:        v.Index,
+			OldReference: v.OldCommitID.String(),
+			NewReference: v.NewCommitID.String(),
+			Operation:    string(v.Operation),
+			User:         v.User,
+			CreationDate: v.CreationDate,
+		})
+		if len(entries) >= limit+1 {
+			break
+		}
+	}
+	if err := it.Err(); err != nil {
+		return nil, false, err
+	}
+	// return results (optional trimmed) and hasMore
+	hasMore := false
+	if len(entries) > limit {
+		hasMore = true
+		entries = entries[:limit]
+	}
+	return entries, hasMore, nil
+}
+
+func (c *Catalog) RestoreBranch(ctx context.Context, repository, branch string, index int) (string, error) {
+	repositoryID := graveler.RepositoryID(repository)
+	branchID := graveler.BranchID(branch)
+	if err := validator.Validate([]validator.ValidateArg{
+		{Name: "repository", Value: repositoryID, Fn: graveler.ValidateRepositoryID},
+		{Name: "branch", Value: branchID, Fn: graveler.ValidateBranchID},
+		{Name: "index", Value: index, Fn: validator.ValidateNonNegativeInt},
+	}); err != nil {
+		return "", err
+	}
+	restored, err := c.Store.RestoreBranch(ctx, repositoryID, branchID, index)
+	if err != nil {
+		return "", err
+	}
+	return restored.CommitID.String(), nil
+}
+
+func (c *Catalog) StashPush(ctx context.Context, repository, branch, stash string) (*Stash, error) {
+	repositoryID := graveler.RepositoryID(repository)
+	branchID := graveler.BranchID(branch)
+	stashID := graveler.StashID(stash)
+	if err := validator.Validate([]validator.ValidateArg{
+		{Name: "repository", Value: repositoryID, Fn: graveler.ValidateRepositoryID},
+		{Name: "branch", Value: branchID, Fn: graveler.ValidateBranchID},
+		{Name: "stash", Value: stashID, Fn: graveler.ValidateStashID},
+	}); err != nil {
+		return nil, err
+	}
+	s, err := c.Store.StashPush(ctx, repositoryID, branchID, stashID)
+	if err != nil {
+		return nil, err
+	}
+	return &Stash{
+		ID:           stash,
+		Reference:    s.CommitID.String(),
+		CreationDate: s.CreationDate,
+	}, nil
+}
+
+func (c *Catalog) StashApply(ctx context.Context, repository, branch, stash string) error {
+	repositoryID := graveler.RepositoryID(repository)
+	branchID := graveler.BranchID(branch)
+	stashID := graveler.StashID(stash)
+	if err := validator.Validate([]validator.ValidateArg{
+		{Name: "repository", Value: repositoryID, Fn: graveler.ValidateRepositoryID},
+		{Name: "branch", Value: branchID, Fn: graveler.ValidateBranchID},
+		{Name: "stash", Value: stashID, Fn: graveler.ValidateStashID},
+	}); err != nil {
+		return err
+	}
+	return stashConflictsError(c.Store.StashApply(ctx, repositoryID, branchID, stashID))
+}
+
+func (c *Catalog) StashPop(ctx context.Context, repository, branch, stash string) error {
+	repositoryID := graveler.RepositoryID(repository)
+	branchID := graveler.BranchID(branch)
+	stashID := graveler.StashID(stash)
+	if err := validator.Validate([]validator.ValidateArg{
+		{Name: "repository", Value: repositoryID, Fn: graveler.ValidateRepositoryID},
+		{Name: "branch", Value: branchID, Fn: graveler.ValidateBranchID},
+		{Name: "stash", Value: stashID, Fn: graveler.ValidateStashID},
+	}); err != nil {
+		return err
+	}
+	return stashConflictsError(c.Store.StashPop(ctx, repositoryID, branchID, stashID))
+}
+
+// stashConflictsError converts conflicts found applying a stash to catalog conflicts
+func stashConflictsError(err error) error {
+	var conflictsErr *graveler.MergeConflictsError
+	if errors.As(err, &conflictsErr) {
+		return newMergeConflictsError(conflictsErr)
+	}
+	return err
+}
+
+func (c *Catalog) StashDrop(ctx context.Context, repository, branch, stash string) error {
+	repositoryID := graveler.RepositoryID(repository)
+	branchID := graveler.BranchID(branch)
+	stashID := graveler.StashID(stash)
+	if err := validator.Validate([]validator.ValidateArg{
+		{Name: "repository", Value: repositoryID, Fn: graveler.ValidateRepositoryID},
+		{Name: "branch", Value: branchID, Fn: graveler.ValidateBranchID},
+		{Name: "stash", Value: stashID, Fn: graveler.ValidateStashID},
+	}); err != nil {
+		return err
+	}
+	return c.Store.StashDrop(ctx, repositoryID, branchID, stashID)
+}
+
+func (c *Catalog) ListStashes(ctx context.Context, repository, branch string, limit int, after string) ([]*Stash, bool, error) {
+	repositoryID := graveler.RepositoryID(repository)
+	branchID := graveler.BranchID(branch)
+	if err := validator.Validate([]validator.ValidateArg{
+		{Name: "repository", Value: repositoryID, Fn: graveler.ValidateRepositoryID},
+		{Name: "branch", Value: branchID, Fn: graveler.ValidateBranchID},
+	}); err != nil {
+		return nil, false, err
+	}
+	// normalize limit
+	if limit < 0 || limit > ListStashesLimitMax {
+		limit = ListStashesLimitMax
+	}
+	it, err := c.Store.ListStashes(ctx, repositoryID, branchID)
+	if err != nil {
+		return nil, false, err
+	}
+	defer it.Close()
+	afterStashID := graveler.StashID(after)
+	it.SeekGE(afterStashID)
+	var stashes []*Stash
+	for it.Next() {
+		v := it.Value()
+		if v.StashID == afterStashID {
+			continue
+		}
+		stashes = append(stashes, &Stash{
+			ID:           v.StashID.String(),
+			Reference:    v.CommitID.String(),
+			CreationDate: v.CreationDate,
+		})
+		if len(stashes) >= limit+1 {
+			break
+		}
+	}
+	if err := it.Err(); err != nil {
+		return nil, false, err
+	}
+	// return results (optional trimmed) and hasMore
+	hasMore := false
+	if len(stashes) > limit {
+		hasMore = true
+		stashes = stashes[:limit]
+	}
+	return stashes, hasMore, nil
+}
+
 func (c *Catalog) BranchExists(ctx context.Context, repository string, branch string) (bool, error) {
 	repositoryID := graveler.RepositoryID(repository)
 	branchID := graveler.BranchID(branch)
@@ -499,6 +892,27 @@ func (c *Catalog) GetBranchReference(ctx context.Context, repository string, bra
 	return string(b.CommitID), nil
 }
 
+func (c *Catalog) GetBranch(ctx context.Context, repository string, branch string) (*Branch, error) {
+	repositoryID := graveler.RepositoryID(repository)
+	branchID := graveler.BranchID(branch)
+	if err := validator.Validate([]validator.ValidateArg{
+		{Name: "repository", Value: repositoryID, Fn: graveler.ValidateRepositoryID},
+		{Name: "branch", Value: branchID, Fn: graveler.ValidateBranchID},
+	}); err != nil {
+		return nil, err
+	}
+	b, err := c.Store.GetBranch(ctx, repositoryID, branchID)
+	if err != nil {
+		return nil, err
+	}
+	return &Branch{
+		Name:      branch,
+		Reference: string(b.CommitID),
+		ExpiresAt: b.Expiry.ExpiresAt,
+		IdleTTL:   b.Expiry.IdleTTL,
+	}, nil
+}
+
 func (c *Catalog) ResetBranch(ctx context.Context, repository string, branch string) error {
 	repositoryID := graveler.RepositoryID(repository)
 	branchID := graveler.BranchID(branch)
@@ -512,6 +926,19 @@ func (c *Catalog) ResetBranch(ctx context.Context, repository string, branch str
 }
 
 func (c *Catalog) CreateTag(ctx context.Context, repository string, tagID string, ref string) (string, error) {
+	return c.createTag(ctx, repository, tagID, ref, nil)
+}
+
+func (c *Catalog) CreateAnnotatedTag(ctx context.Context, repository string, tagID string, ref string, message, tagger string, metadata Metadata) (string, error) {
+	return c.createTag(ctx, repository, tagID, ref, &graveler.TagAnnotation{
+		Message:      message,
+		Tagger:       tagger,
+		CreationDate: time.Now(),
+		Metadata:     graveler.Metadata(metadata),
+	})
+}
+
+func (c *Catalog) createTag(ctx context.Context, repository string, tagID string, ref string, annotation *graveler.TagAnnotation) (string, error) {
 	repositoryID := graveler.RepositoryID(repository)
 	tag := graveler.TagID(tagID)
 	if err := validator.Validate([]validator.ValidateArg{
@@ -524,7 +951,11 @@ func (c *Catalog) CreateTag(ctx context.Context, repository string, tagID string
 	if err != nil {
 		return "", err
 	}
-	err = c.Store.CreateTag(ctx, repositoryID, tag, commitID)
+	if annotation != nil {
+		err = c.Store.CreateAnnotatedTag(ctx, repositoryID, tag, commitID, *annotation)
+	} else {
+		err = c.Store.CreateTag(ctx, repositoryID, tag, commitID)
+	}
 	if err != nil {
 		return "", err
 	}
@@ -574,11 +1005,7 @@ func (c *Catalog) ListTags(ctx context.Context, repository string, prefix string
 		if !strings.HasPrefix(v.TagID.String(), prefix) {
 			break
 		}
-		tag := &Tag{
-			ID:       string(v.TagID),
-			CommitID: v.CommitID.String(),
-		}
-		tags = append(tags, tag)
+		tags = append(tags, newCatalogTag(v))
 		if len(tags) >= limit+1 {
 			break
 		}
@@ -595,20 +1022,36 @@ func (c *Catalog) ListTags(ctx context.Context, repository string, prefix string
 	return tags, hasMore, nil
 }
 
-func (c *Catalog) GetTag(ctx context.Context, repository string, tagID string) (string, error) {
+func (c *Catalog) GetTag(ctx context.Context, repository string, tagID string) (*Tag, error) {
 	repositoryID := graveler.RepositoryID(repository)
 	tag := graveler.TagID(tagID)
 	if err := validator.Validate([]validator.ValidateArg{
 		{Name: "name", Value: repositoryID, Fn: graveler.ValidateRepositoryID},
 		{Name: "tagID", Value: tag, Fn: graveler.ValidateTagID},
 	}); err != nil {
-		return "", err
+		return nil, err
 	}
-	commit, err := c.Store.GetTag(ctx, repositoryID, tag)
+	record, err := c.Store.GetTagRecord(ctx, repositoryID, tag)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+	return newCatalogTag(record), nil
+}
+
+func newCatalogTag(record *graveler.TagRecord) *Tag {
+	tag := &Tag{
+		ID:       string(record.TagID),
+		CommitID: record.CommitID.String(),
 	}
-	return commit.String(), nil
+	if record.Annotation != nil {
+		tag.Annotation = &TagAnnotation{
+			Message:      record.Annotation.Message,
+			Tagger:       record.Annotation.Tagger,
+			CreationDate: record.Annotation.CreationDate,
+			Metadata:     Metadata(record.Annotation.Metadata),
+		}
+	}
+	return tag
 }
 
 // GetEntry returns the current entry for path in repository branch reference.  Returns
@@ -691,6 +1134,10 @@ func (c *Catalog) CreateEntry(ctx context.Context, repository string, branch str
 	if err != nil {
 		return err
 	}
+	if ent.AddressType == Entry_RELATIVE {
+		// objects stored under the storage namespace are tracked, to remove them once they are no longer referenced
+		writeConditions = append([]graveler.WriteConditionOption{graveler.WithObjectAddress(ent.Address)}, writeConditions...)
+	}
 	return c.Store.Set(ctx, repositoryID, branchID, key, *value, writeConditions...)
 }
 
@@ -788,7 +1235,7 @@ func (c *Catalog) ResetEntries(ctx context.Context, repository string, branch st
 	return c.Store.ResetPrefix(ctx, repositoryID, branchID, keyPrefix)
 }
 
-func (c *Catalog) Commit(ctx context.Context, repository, branch, message, committer string, metadata Metadata, date *int64) (*CommitLog, error) {
+func (c *Catalog) Commit(ctx context.Context, repository, branch, message, committer string, metadata Metadata, date *int64, prefixes []string) (*CommitLog, error) {
 	repositoryID := graveler.RepositoryID(repository)
 	branchID := graveler.BranchID(branch)
 	if err := validator.Validate([]validator.ValidateArg{
@@ -797,11 +1244,16 @@ func (c *Catalog) Commit(ctx context.Context, repository, branch, message, commi
 	}); err != nil {
 		return nil, err
 	}
+	var prefixKeys []graveler.Key
+	for _, prefix := range prefixes {
+		prefixKeys = append(prefixKeys, graveler.Key(prefix))
+	}
 	commitID, err := c.Store.Commit(ctx, repositoryID, branchID, graveler.CommitParams{
 		Committer: committer,
 		Message:   message,
 		Date:      date,
 		Metadata:  map[string]string(metadata),
+		Prefixes:  prefixKeys,
 	})
 	if err != nil {
 		return nil, err
@@ -846,6 +1298,7 @@ func (c *Catalog) GetCommit(ctx context.Context, repository string, reference st
 		CreationDate: commit.CreationDate,
 		MetaRangeID:  string(commit.MetaRangeID),
 		Metadata:     Metadata(commit.Metadata),
+		Signature:    c.commitSignature(ctx, commitID, commit),
 	}
 	for _, parent := range commit.Parents {
 		catalogCommitLog.Parents = append(catalogCommitLog.Parents, string(parent))
@@ -853,16 +1306,44 @@ func (c *Catalog) GetCommit(ctx context.Context, repository string, reference st
 	return catalogCommitLog, nil
 }
 
-func (c *Catalog) ListCommits(ctx context.Context, repository string, branch string, params LogParams) ([]*CommitLog, bool, error) {
+// commitSignature returns the signature of the commit and whether it is verified, or nil if the commit is not signed
+func (c *Catalog) commitSignature(ctx context.Context, commitID graveler.CommitID, commit *graveler.Commit) *CommitSignature {
+	if len(commit.Signature) == 0 {
+		return nil
+	}
+	err := c.Store.VerifyCommitSignature(ctx, commitID, commit)
+	if err != nil {
+		c.log.WithError(err).WithField("commit_id", commitID).Debug("Commit signature not verified")
+	}
+	return &CommitSignature{
+		KeyID:    commit.SignatureKeyID,
+		Verified: err == nil,
+	}
+}
+
+func (c *Catalog) SignCommit(ctx context.Context, repository, commitID, keyID string, signature []byte) error {
 	repositoryID := graveler.RepositoryID(repository)
-	branchRef := graveler.BranchID(branch)
+	commitIDValue := graveler.CommitID(commitID)
 	if err := validator.Validate([]validator.ValidateArg{
 		{Name: "repository", Value: repositoryID, Fn: graveler.ValidateRepositoryID},
-		{Name: "branch", Value: branchRef, Fn: graveler.ValidateBranchID},
+		{Name: "commitID", Value: commitIDValue, Fn: graveler.ValidateCommitID},
+		{Name: "keyID", Value: keyID, Fn: validator.ValidateRequiredString},
 	}); err != nil {
-		return nil, false, err
+		return err
 	}
-	commitID, err := c.dereferenceCommitID(ctx, repositoryID, graveler.Ref(branchRef))
+	return c.Store.SignCommit(ctx, repositoryID, commitIDValue, keyID, signature)
+}
+
+func (c *Catalog) ListCommits(ctx context.Context, repository string, branch string, params LogParams) ([]*CommitLog, bool, error) {
+	repositoryID := graveler.RepositoryID(repository)
+	branchRef := graveler.BranchID(branch)
+	if err := validator.Validate([]validator.ValidateArg{
+		{Name: "repository", Value: repositoryID, Fn: graveler.ValidateRepositoryID},
+		{Name: "branch", Value: branchRef, Fn: graveler.ValidateBranchID},
+	}); err != nil {
+		return nil, false, err
+	}
+	commitID, err := c.dereferenceCommitID(ctx, repositoryID, graveler.Ref(branchRef))
 	if err != nil {
 		return nil, false, fmt.Errorf("branch ref: %w", err)
 	}
@@ -900,6 +1381,7 @@ func (c *Catalog) ListCommits(ctx context.Context, repository string, branch str
 			Metadata:     map[string]string(v.Metadata),
 			MetaRangeID:  string(v.MetaRangeID),
 			Parents:      make([]string, 0, len(v.Parents)),
+			Signature:    c.commitSignature(ctx, v.CommitID, v.Commit),
 		}
 		for _, parent := range v.Parents {
 			commit.Parents = append(commit.Parents, parent.String())
@@ -934,6 +1416,221 @@ func (c *Catalog) ListCommits(ctx context.Context, repository string, branch str
 	return commits, hasMore, nil
 }
 
+func (c *Catalog) SearchCommits(ctx context.Context, repository string, params SearchCommitsParams) ([]*CommitLog, bool, error) {
+	repositoryID := graveler.RepositoryID(repository)
+	if err := validator.Validate([]validator.ValidateArg{
+		{Name: "repository", Value: repositoryID, Fn: graveler.ValidateRepositoryID},
+	}); err != nil {
+		return nil, false, err
+	}
+	// normalize limit
+	if params.Limit < 0 || params.Limit > SearchCommitsLimitMax {
+		params.Limit = SearchCommitsLimitMax
+	}
+	records, err := c.Store.SearchCommits(ctx, repositoryID, graveler.CommitSearchParams{
+		Metadata:  graveler.Metadata(params.Metadata),
+		Committer: params.Committer,
+		Message:   params.Message,
+		Since:     params.Since,
+		Until:     params.Until,
+		After:     graveler.CommitID(params.After),
+		Limit:     params.Limit + 1,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	hasMore := false
+	if len(records) > params.Limit {
+		hasMore = true
+		records = records[:params.Limit]
+	}
+	commits := make([]*CommitLog, 0, len(records))
+	for _, v := range records {
+		commit := &CommitLog{
+			Reference:    v.CommitID.String(),
+			Committer:    v.Committer,
+			Message:      v.Message,
+			CreationDate: v.CreationDate,
+			Metadata:     map[string]string(v.Metadata),
+			MetaRangeID:  string(v.MetaRangeID),
+			Parents:      make([]string, 0, len(v.Parents)),
+			Signature:    c.commitSignature(ctx, v.CommitID, v.Commit),
+		}
+		for _, parent := range v.Parents {
+			commit.Parents = append(commit.Parents, parent.String())
+		}
+		commits = append(commits, commit)
+	}
+	return commits, hasMore, nil
+}
+
+func (c *Catalog) ObjectHistory(ctx context.Context, repository, reference, path string, params ObjectHistoryParams) ([]*ObjectVersion, bool, error) {
+	repositoryID := graveler.RepositoryID(repository)
+	ref := graveler.Ref(reference)
+	if err := validator.Validate([]validator.ValidateArg{
+		{Name: "repository", Value: repositoryID, Fn: graveler.ValidateRepositoryID},
+		{Name: "ref", Value: ref, Fn: graveler.ValidateRef},
+		{Name: "path", Value: Path(path), Fn: ValidatePath},
+	}); err != nil {
+		return nil, false, err
+	}
+	// normalize limit
+	if params.Limit < 0 || params.Limit > ObjectHistoryLimitMax {
+		params.Limit = ObjectHistoryLimitMax
+	}
+	commitID, err := c.dereferenceCommitID(ctx, repositoryID, ref)
+	if err != nil {
+		return nil, false, err
+	}
+	history := &objectHistory{
+		catalog:      c,
+		repositoryID: repositoryID,
+		key:          graveler.Key(path),
+		values:       make(map[graveler.CommitID]*graveler.Value),
+	}
+	var (
+		versions []*ObjectVersion
+		skipping = params.After != ""
+	)
+	// collect adds the version set by commit, if any, until there are enough versions for the page
+	collect := func(commit *graveler.CommitRecord, firstParent bool) (bool, error) {
+		version, err := history.version(ctx, commit, firstParent)
+		if err != nil || version == nil {
+			return false, err
+		}
+		if skipping {
+			skipping = version.CommitID != params.After
+			return false, nil
+		}
+		versions = append(versions, version)
+		return len(versions) > params.Limit, nil
+	}
+	if params.FirstParent {
+		for commitID != "" {
+			commit, err := c.Store.GetCommit(ctx, repositoryID, commitID)
+			if err != nil {
+				return nil, false, err
+			}
+			done, err := collect(&graveler.CommitRecord{CommitID: commitID, Commit: commit}, true)
+			if err != nil {
+				return nil, false, err
+			}
+			if done || len(commit.Parents) == 0 {
+				break
+			}
+			commitID = commit.Parents[0]
+		}
+	} else {
+		it, err := c.Store.Log(ctx, repositoryID, commitID)
+		if err != nil {
+			return nil, false, err
+		}
+		defer it.Close()
+		for it.Next() {
+			done, err := collect(it.Value(), false)
+			if err != nil {
+				return nil, false, err
+			}
+			if done {
+				break
+			}
+		}
+		if err := it.Err(); err != nil {
+			return nil, false, err
+		}
+	}
+	hasMore := false
+	if len(versions) > params.Limit {
+		hasMore = true
+		versions = versions[:params.Limit]
+	}
+	return versions, hasMore, nil
+}
+
+// objectHistory finds the changes commits made to the object at key, caching the object value of each commit
+type objectHistory struct {
+	catalog      *Catalog
+	repositoryID graveler.RepositoryID
+	key          graveler.Key
+	values       map[graveler.CommitID]*graveler.Value
+}
+
+// valueAt returns the value of the object in commitID, or nil if the object does not exist in it
+func (h *objectHistory) valueAt(ctx context.Context, commitID graveler.CommitID) (*graveler.Value, error) {
+	if value, ok := h.values[commitID]; ok {
+		return value, nil
+	}
+	value, err := h.catalog.Store.Get(ctx, h.repositoryID, graveler.Ref(commitID), h.key)
+	if errors.Is(err, graveler.ErrNotFound) {
+		value, err = nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	h.values[commitID] = value
+	return value, nil
+}
+
+// version returns the version of the object set by commit compared to its first parent, or nil if the commit did
+// not change the object. Unless firstParent is set, a merge commit only sets a version when the object differs from
+// all its parents, changes merged as is are reported by the commits that made them.
+func (h *objectHistory) version(ctx context.Context, commit *graveler.CommitRecord, firstParent bool) (*ObjectVersion, error) {
+	value, err := h.valueAt(ctx, commit.CommitID)
+	if err != nil {
+		return nil, err
+	}
+	parents := commit.Parents
+	if firstParent && len(parents) > 1 {
+		parents = parents[:1]
+	}
+	var parentValue *graveler.Value
+	for i, parent := range parents {
+		v, err := h.valueAt(ctx, parent)
+		if err != nil {
+			return nil, err
+		}
+		if sameValue(v, value) {
+			return nil, nil
+		}
+		if i == 0 {
+			parentValue = v
+		}
+	}
+	if value == nil && parentValue == nil {
+		// the object does not exist in the commit or in its first parent, nor was it merged in
+		return nil, nil
+	}
+	version := &ObjectVersion{
+		CommitID:     commit.CommitID.String(),
+		CreationDate: commit.CreationDate,
+		Committer:    commit.Committer,
+	}
+	switch {
+	case value == nil:
+		version.Type = DifferenceTypeRemoved
+	case parentValue == nil:
+		version.Type = DifferenceTypeAdded
+	default:
+		version.Type = DifferenceTypeChanged
+	}
+	if value != nil {
+		ent, err := ValueToEntry(value)
+		if err != nil {
+			return nil, err
+		}
+		entry := newCatalogEntryFromEntry(false, h.key.String(), ent)
+		version.Entry = &entry
+	}
+	return version, nil
+}
+
+func sameValue(a, b *graveler.Value) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return bytes.Equal(a.Identity, b.Identity)
+}
+
 func (c *Catalog) pathInCommit(ctx context.Context, repositoryID graveler.RepositoryID, commit *graveler.CommitRecord, params LogParams) (bool, error) {
 	// this function checks whether the given commmit contains changes to a list of paths.
 	// it searches the path in the diff between the commit and it's parent, but do so only to commits
@@ -991,6 +1688,66 @@ func (c *Catalog) Revert(ctx context.Context, repository string, branch string,
 	return err
 }
 
+func (c *Catalog) CherryPick(ctx context.Context, repository string, branch string, params CherryPickParams) (*CommitLog, error) {
+	repositoryID := graveler.RepositoryID(repository)
+	branchID := graveler.BranchID(branch)
+	reference := graveler.Ref(params.Reference)
+	parentNumber := params.ParentNumber
+	if err := validator.Validate([]validator.ValidateArg{
+		{Name: "repository", Value: repositoryID, Fn: graveler.ValidateRepositoryID},
+		{Name: "branch", Value: branchID, Fn: graveler.ValidateBranchID},
+		{Name: "ref", Value: reference, Fn: graveler.ValidateRef},
+		{Name: "committer", Value: params.Committer, Fn: validator.ValidateRequiredString},
+		{Name: "parentNumber", Value: parentNumber, Fn: validator.ValidateNonNegativeInt},
+	}); err != nil {
+		return nil, err
+	}
+	commitID, err := c.Store.CherryPick(ctx, repositoryID, branchID, reference, parentNumber, graveler.CommitParams{
+		Committer: params.Committer,
+	})
+	if err != nil {
+		return nil, err
+	}
+	commit, err := c.Store.GetCommit(ctx, repositoryID, commitID)
+	if err != nil {
+		return nil, err
+	}
+	catalogCommitLog := &CommitLog{
+		Reference:    commitID.String(),
+		Committer:    commit.Committer,
+		Message:      commit.Message,
+		CreationDate: commit.CreationDate.UTC(),
+		MetaRangeID:  string(commit.MetaRangeID),
+		Metadata:     Metadata(commit.Metadata),
+	}
+	for _, parent := range commit.Parents {
+		catalogCommitLog.Parents = append(catalogCommitLog.Parents, parent.String())
+	}
+	return catalogCommitLog, nil
+}
+
+func (c *Catalog) Rebase(ctx context.Context, repository, branch, onto string) (string, error) {
+	repositoryID := graveler.RepositoryID(repository)
+	branchID := graveler.BranchID(branch)
+	ontoRef := graveler.Ref(onto)
+	if err := validator.Validate([]validator.ValidateArg{
+		{Name: "repository", Value: repositoryID, Fn: graveler.ValidateRepositoryID},
+		{Name: "branch", Value: branchID, Fn: graveler.ValidateBranchID},
+		{Name: "onto", Value: ontoRef, Fn: graveler.ValidateRef},
+	}); err != nil {
+		return "", err
+	}
+	commitID, err := c.Store.Rebase(ctx, repositoryID, branchID, ontoRef)
+	var rebaseConflictErr *graveler.RebaseConflictError
+	if errors.As(err, &rebaseConflictErr) {
+		return "", newRebaseConflictError(rebaseConflictErr)
+	}
+	if err != nil {
+		return "", err
+	}
+	return commitID.String(), nil
+}
+
 func (c *Catalog) Diff(ctx context.Context, repository string, leftReference string, rightReference string, params DiffParams) (Differences, bool, error) {
 	repositoryID := graveler.RepositoryID(repository)
 	left := graveler.Ref(leftReference)
@@ -1031,6 +1788,55 @@ func (c *Catalog) Compare(ctx context.Context, repository, leftReference string,
 	return listDiffHelper(it, params.Prefix, params.Delimiter, params.Limit, params.After)
 }
 
+func (c *Catalog) DiffSummary(ctx context.Context, repository, leftReference, rightReference string, params DiffSummaryParams) ([]PrefixDiffSummary, error) {
+	repositoryID := graveler.RepositoryID(repository)
+	left := graveler.Ref(leftReference)
+	right := graveler.Ref(rightReference)
+	if err := validator.Validate([]validator.ValidateArg{
+		{Name: "repository", Value: repositoryID, Fn: graveler.ValidateRepositoryID},
+		{Name: "left", Value: left, Fn: graveler.ValidateRef},
+		{Name: "right", Value: right, Fn: graveler.ValidateRef},
+	}); err != nil {
+		return nil, err
+	}
+	if params.Depth < 0 {
+		return nil, fmt.Errorf("depth %d: %w", params.Depth, graveler.ErrInvalidValue)
+	}
+	summary, err := c.Store.DiffSummary(ctx, repositoryID, left, right, graveler.DiffSummaryParams{
+		Depth:     params.Depth,
+		ThreeDot:  params.ThreeDot,
+		ValueSize: entrySize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]PrefixDiffSummary, 0, len(summary))
+	for prefix, diffSummary := range summary {
+		res = append(res, PrefixDiffSummary{
+			Prefix:       prefix,
+			Added:        diffSummary.Count[graveler.DiffTypeAdded],
+			Removed:      diffSummary.Count[graveler.DiffTypeRemoved],
+			Changed:      diffSummary.Count[graveler.DiffTypeChanged],
+			AddedBytes:   diffSummary.SizeDelta[graveler.DiffTypeAdded],
+			RemovedBytes: -diffSummary.SizeDelta[graveler.DiffTypeRemoved],
+			ChangedBytes: diffSummary.SizeDelta[graveler.DiffTypeChanged],
+		})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Prefix < res[j].Prefix
+	})
+	return res, nil
+}
+
+// entrySize returns the size of the object of a value
+func entrySize(value *graveler.Value) (int64, error) {
+	ent, err := ValueToEntry(value)
+	if err != nil {
+		return 0, err
+	}
+	return ent.Size, nil
+}
+
 func (c *Catalog) DiffUncommitted(ctx context.Context, repository, branch, prefix, delimiter string, limit int, after string) (Differences, bool, error) {
 	repositoryID := graveler.RepositoryID(repository)
 	branchID := graveler.BranchID(branch)
@@ -1138,10 +1944,11 @@ func listDiffHelper(it EntryDiffIterator, prefix, delimiter string, limit int, a
 	return diffs, hasMore, nil
 }
 
-func (c *Catalog) Merge(ctx context.Context, repository string, destinationBranch string, sourceRef string, committer string, message string, metadata Metadata, strategy string) (string, error) {
+func (c *Catalog) Merge(ctx context.Context, repository string, destinationBranch string, sourceRef string, committer string, message string, metadata Metadata, strategy string, strategyRules []*graveler.MergeStrategyRule, mode string) (string, error) {
 	repositoryID := graveler.RepositoryID(repository)
 	destination := graveler.BranchID(destinationBranch)
 	source := graveler.Ref(sourceRef)
+	mergeMode := graveler.MergeMode(mode)
 	meta := graveler.Metadata(metadata)
 	commitParams := graveler.CommitParams{
 		Committer: committer,
@@ -1149,7 +1956,11 @@ func (c *Catalog) Merge(ctx context.Context, repository string, destinationBranc
 		Metadata:  meta,
 	}
 	if commitParams.Message == "" {
-		commitParams.Message = fmt.Sprintf("Merge '%s' into '%s'", source, destination)
+		if mergeMode == graveler.MergeModeSquash {
+			commitParams.Message = fmt.Sprintf("Squash merge '%s' into '%s'", source, destination)
+		} else {
+			commitParams.Message = fmt.Sprintf("Merge '%s' into '%s'", source, destination)
+		}
 	}
 	if err := validator.Validate([]validator.ValidateArg{
 		{Name: "repository", Value: repositoryID, Fn: graveler.ValidateRepositoryID},
@@ -1158,10 +1969,16 @@ func (c *Catalog) Merge(ctx context.Context, repository string, destinationBranc
 		{Name: "committer", Value: commitParams.Committer, Fn: validator.ValidateRequiredString},
 		{Name: "message", Value: commitParams.Message, Fn: validator.ValidateRequiredString},
 		{Name: "strategy", Value: strategy, Fn: graveler.ValidateRequiredStrategy},
+		{Name: "strategyRules", Value: strategyRules, Fn: graveler.ValidateMergeStrategyRules},
+		{Name: "mode", Value: mergeMode, Fn: graveler.ValidateMergeMode},
 	}); err != nil {
 		return "", err
 	}
-	commitID, err := c.Store.Merge(ctx, repositoryID, destination, source, commitParams, strategy)
+	commitID, err := c.Store.Merge(ctx, repositoryID, destination, source, commitParams, strategy, strategyRules, mergeMode)
+	var conflictsErr *graveler.MergeConflictsError
+	if errors.As(err, &conflictsErr) {
+		return "", newMergeConflictsError(conflictsErr)
+	}
 	if errors.Is(err, graveler.ErrConflictFound) {
 		// for compatibility with old Catalog
 		return "", err
@@ -1172,6 +1989,107 @@ func (c *Catalog) Merge(ctx context.Context, repository string, destinationBranc
 	return commitID.String(), nil
 }
 
+func (c *Catalog) MergePreview(ctx context.Context, repository, destinationBranch, sourceRef, strategy string, strategyRules []*graveler.MergeStrategyRule) (*MergePreview, error) {
+	repositoryID := graveler.RepositoryID(repository)
+	destination := graveler.BranchID(destinationBranch)
+	source := graveler.Ref(sourceRef)
+	if err := validator.Validate([]validator.ValidateArg{
+		{Name: "repository", Value: repositoryID, Fn: graveler.ValidateRepositoryID},
+		{Name: "destination", Value: destination, Fn: graveler.ValidateBranchID},
+		{Name: "source", Value: source, Fn: graveler.ValidateRef},
+		{Name: "strategy", Value: strategy, Fn: graveler.ValidateRequiredStrategy},
+		{Name: "strategyRules", Value: strategyRules, Fn: graveler.ValidateMergeStrategyRules},
+	}); err != nil {
+		return nil, err
+	}
+	preview, err := c.Store.MergePreview(ctx, repositoryID, destination, source, strategy, strategyRules)
+	if err != nil {
+		return nil, err
+	}
+	summary := make([]MergePreviewSummary, 0, len(preview.Summary))
+	for prefix, diffSummary := range preview.Summary {
+		summary = append(summary, MergePreviewSummary{
+			Prefix:   prefix,
+			Added:    diffSummary.Count[graveler.DiffTypeAdded],
+			Removed:  diffSummary.Count[graveler.DiffTypeRemoved],
+			Changed:  diffSummary.Count[graveler.DiffTypeChanged],
+			Conflict: diffSummary.Count[graveler.DiffTypeConflict],
+		})
+	}
+	sort.Slice(summary, func(i, j int) bool {
+		return summary[i].Prefix < summary[j].Prefix
+	})
+	return &MergePreview{
+		SourceCommitID:      preview.SourceCommitID.String(),
+		DestinationCommitID: preview.DestinationCommitID.String(),
+		BaseCommitID:        preview.BaseCommitID.String(),
+		FastForward:         preview.FastForward,
+		Summary:             summary,
+		Conflicts:           newMergeConflicts(preview.Conflicts),
+		ConflictsTruncated:  preview.ConflictsTruncated,
+	}, nil
+}
+
+func (c *Catalog) CompareBranch(ctx context.Context, repository, branch, baseRef string) (*BranchComparison, error) {
+	repositoryID := graveler.RepositoryID(repository)
+	branchID := graveler.BranchID(branch)
+	base := graveler.Ref(baseRef)
+	if err := validator.Validate([]validator.ValidateArg{
+		{Name: "repository", Value: repositoryID, Fn: graveler.ValidateRepositoryID},
+		{Name: "branch", Value: branchID, Fn: graveler.ValidateBranchID},
+		{Name: "base", Value: base, Fn: graveler.ValidateRef},
+	}); err != nil {
+		return nil, err
+	}
+	return c.compareBranch(ctx, repositoryID, branchID, base)
+}
+
+func (c *Catalog) CompareBranches(ctx context.Context, repository, baseRef, prefix string, limit int, after string) ([]*BranchComparison, bool, error) {
+	repositoryID := graveler.RepositoryID(repository)
+	base := graveler.Ref(baseRef)
+	if err := validator.Validate([]validator.ValidateArg{
+		{Name: "repository", Value: repositoryID, Fn: graveler.ValidateRepositoryID},
+		{Name: "base", Value: base, Fn: graveler.ValidateRef},
+	}); err != nil {
+		return nil, false, err
+	}
+	// normalize limit
+	if limit < 0 || limit > CompareBranchesLimitMax {
+		limit = CompareBranchesLimitMax
+	}
+	branches, hasMore, err := c.ListBranches(ctx, repository, prefix, limit, after)
+	if err != nil {
+		return nil, false, err
+	}
+	comparisons := make([]*BranchComparison, 0, len(branches))
+	for _, branch := range branches {
+		comparison, err := c.compareBranch(ctx, repositoryID, graveler.BranchID(branch.Name), base)
+		if err != nil {
+			return nil, false, fmt.Errorf("compare branch %s: %w", branch.Name, err)
+		}
+		comparisons = append(comparisons, comparison)
+	}
+	return comparisons, hasMore, nil
+}
+
+func (c *Catalog) compareBranch(ctx context.Context, repositoryID graveler.RepositoryID, branchID graveler.BranchID, base graveler.Ref) (*BranchComparison, error) {
+	comparison, err := c.Store.CompareBranch(ctx, repositoryID, branchID, base)
+	if err != nil {
+		return nil, err
+	}
+	return &BranchComparison{
+		Branch:       comparison.BranchID.String(),
+		CommitID:     comparison.CommitID.String(),
+		BaseCommitID: comparison.BaseCommitID.String(),
+		MergeBaseID:  comparison.MergeBaseID.String(),
+		Ahead:        comparison.Ahead,
+		Behind:       comparison.Behind,
+		Added:        comparison.Summary.Count[graveler.DiffTypeAdded],
+		Removed:      comparison.Summary.Count[graveler.DiffTypeRemoved],
+		Changed:      comparison.Summary.Count[graveler.DiffTypeChanged],
+	}, nil
+}
+
 func (c *Catalog) DumpCommits(ctx context.Context, repositoryID string) (string, error) {
 	metaRangeID, err := c.Store.DumpCommits(ctx, graveler.RepositoryID(repositoryID))
 	if err != nil {
@@ -1221,10 +2139,499 @@ func (c *Catalog) GetGarbageCollectionRules(ctx context.Context, repositoryID st
 }
 
 func (c *Catalog) SetGarbageCollectionRules(ctx context.Context, repositoryID string, rules *graveler.GarbageCollectionRules) error {
+	if err := validator.Validate([]validator.ValidateArg{
+		{Name: "rules", Value: rules, Fn: graveler.ValidateGarbageCollectionRules},
+	}); err != nil {
+		return err
+	}
 	return c.Store.SetGarbageCollectionRules(ctx, graveler.RepositoryID(repositoryID), rules)
 }
 
-func (c *Catalog) GetBranchProtectionRules(ctx context.Context, repositoryID string) (*graveler.BranchProtectionRules, error) {
+func (c *Catalog) PreviewGarbageCollectionRules(ctx context.Context, repository string, rules *graveler.GarbageCollectionRules) ([]GarbageCollectionRulePreview, error) {
+	repositoryID := graveler.RepositoryID(repository)
+	if err := validator.Validate([]validator.ValidateArg{
+		{Name: "repository", Value: repositoryID, Fn: graveler.ValidateRepositoryID},
+		{Name: "rules", Value: rules, Fn: graveler.ValidateGarbageCollectionRules},
+	}); err != nil {
+		return nil, err
+	}
+	repo, err := c.Store.GetRepository(ctx, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	values, err := c.Store.PreviewGarbageCollectionValues(ctx, repositoryID, rules)
+	if err != nil {
+		return nil, err
+	}
+	ruleAddresses, err := c.listRuleUnreferencedAddresses(ctx, repositoryID, repo.StorageNamespace, values)
+	if err != nil {
+		return nil, err
+	}
+	previews := make([]GarbageCollectionRulePreview, 0, len(values))
+	for _, v := range values {
+		retentionDays := rules.DefaultRetentionDays
+		if v.Prefix != "" {
+			retentionDays = rules.PrefixRetentionDays[v.Prefix]
+		}
+		previews = append(previews, GarbageCollectionRulePreview{
+			Prefix:         v.Prefix,
+			RetentionDays:  int(retentionDays),
+			ExpiredObjects: len(ruleAddresses[v.Prefix]),
+		})
+	}
+	return previews, nil
+}
+
+func (c *Catalog) CreateLegalHold(ctx context.Context, repository, holdID, ref, path, reason, user string) (*LegalHold, error) {
+	repositoryID := graveler.RepositoryID(repository)
+	legalHoldID := graveler.LegalHoldID(holdID)
+	if err := validator.Validate([]validator.ValidateArg{
+		{Name: "repository", Value: repositoryID, Fn: graveler.ValidateRepositoryID},
+		{Name: "hold", Value: legalHoldID, Fn: graveler.ValidateLegalHoldID},
+		{Name: "ref", Value: graveler.Ref(ref), Fn: graveler.ValidateRef},
+	}); err != nil {
+		return nil, err
+	}
+	commitID, err := c.dereferenceCommitID(ctx, repositoryID, graveler.Ref(ref))
+	if err != nil {
+		return nil, err
+	}
+	hold := graveler.LegalHold{
+		CommitID:     commitID,
+		Path:         path,
+		Reason:       reason,
+		CreatedBy:    user,
+		CreationDate: time.Now(),
+	}
+	if err := c.Store.CreateLegalHold(ctx, repositoryID, legalHoldID, hold); err != nil {
+		return nil, err
+	}
+	c.log.WithContext(ctx).WithFields(logging.Fields{
+		"repository": repository,
+		"hold":       holdID,
+		"commit_id":  commitID,
+		"path":       path,
+		"reason":     reason,
+		"user":       user,
+	}).Info("Legal hold placed")
+	return newLegalHold(legalHoldID, &hold), nil
+}
+
+func (c *Catalog) ReleaseLegalHold(ctx context.Context, repository, holdID, user string) error {
+	repositoryID := graveler.RepositoryID(repository)
+	legalHoldID := graveler.LegalHoldID(holdID)
+	if err := validator.Validate([]validator.ValidateArg{
+		{Name: "repository", Value: repositoryID, Fn: graveler.ValidateRepositoryID},
+		{Name: "hold", Value: legalHoldID, Fn: graveler.ValidateLegalHoldID},
+	}); err != nil {
+		return err
+	}
+	if err := c.Store.ReleaseLegalHold(ctx, repositoryID, legalHoldID, user); err != nil {
+		return err
+	}
+	c.log.WithContext(ctx).WithFields(logging.Fields{
+		"repository": repository,
+		"hold":       holdID,
+		"user":       user,
+	}).Info("Legal hold released")
+	return nil
+}
+
+func (c *Catalog) GetLegalHold(ctx context.Context, repository, holdID string) (*LegalHold, error) {
+	repositoryID := graveler.RepositoryID(repository)
+	legalHoldID := graveler.LegalHoldID(holdID)
+	if err := validator.Validate([]validator.ValidateArg{
+		{Name: "repository", Value: repositoryID, Fn: graveler.ValidateRepositoryID},
+		{Name: "hold", Value: legalHoldID, Fn: graveler.ValidateLegalHoldID},
+	}); err != nil {
+		return nil, err
+	}
+	hold, err := c.Store.GetLegalHold(ctx, repositoryID, legalHoldID)
+	if err != nil {
+		return nil, err
+	}
+	return newLegalHold(legalHoldID, hold), nil
+}
+
+func (c *Catalog) ListLegalHolds(ctx context.Context, repository string) ([]*LegalHold, error) {
+	repositoryID := graveler.RepositoryID(repository)
+	if err := validator.Validate([]validator.ValidateArg{
+		{Name: "repository", Value: repositoryID, Fn: graveler.ValidateRepositoryID},
+	}); err != nil {
+		return nil, err
+	}
+	records, err := c.Store.ListLegalHolds(ctx, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	holds := make([]*LegalHold, 0, len(records))
+	for _, rec := range records {
+		holds = append(holds, newLegalHold(rec.LegalHoldID, rec.LegalHold))
+	}
+	return holds, nil
+}
+
+func (c *Catalog) ListLegalHoldLog(ctx context.Context, repository string) ([]*LegalHoldLogEntry, error) {
+	repositoryID := graveler.RepositoryID(repository)
+	if err := validator.Validate([]validator.ValidateArg{
+		{Name: "repository", Value: repositoryID, Fn: graveler.ValidateRepositoryID},
+	}); err != nil {
+		return nil, err
+	}
+	entries, err := c.Store.ListLegalHoldLog(ctx, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*LegalHoldLogEntry, 0, len(entries))
+	for _, entry := range entries {
+		res = append(res, &LegalHoldLogEntry{
+			ID:           entry.LegalHoldID.String(),
+			Operation:    string(entry.Operation),
+			CommitID:     entry.CommitID.String(),
+			Path:         entry.Path,
+			Reason:       entry.Reason,
+			User:         entry.User,
+			CreationDate: entry.CreationDate,
+		})
+	}
+	return res, nil
+}
+
+func newLegalHold(holdID graveler.LegalHoldID, hold *graveler.LegalHold) *LegalHold {
+	return &LegalHold{
+		ID:           holdID.String(),
+		CommitID:     hold.CommitID.String(),
+		Path:         hold.Path,
+		Reason:       hold.Reason,
+		CreatedBy:    hold.CreatedBy,
+		CreationDate: hold.CreationDate,
+	}
+}
+
+const (
+	// importStatusRetention is the time an import is kept after it was last updated
+	importStatusRetention = 24 * time.Hour
+	// importProgressInterval is the interval at which a running import records its progress, and checks whether
+	// canceling it was requested
+	importProgressInterval = 5 * time.Second
+	// importLostTimeout is the time after which an import that did not record its progress is reported failed,
+	// the server running it stopped
+	importLostTimeout = 12 * importProgressInterval
+	// importDefaultCommitter is the committer of imports started without one
+	importDefaultCommitter = "lakefs"
+	// importCommitAttempts is the number of times an import is committed onto the branch head before giving up on a
+	// branch that keeps moving
+	importCommitAttempts = 5
+)
+
+// importTask is an import running on this server in the background
+type importTask struct {
+	repositoryID graveler.RepositoryID
+	branchID     graveler.BranchID
+	importID     graveler.ImportID
+	cancel       context.CancelFunc
+	// imported is the number of objects listed so far, updated atomically
+	imported int64
+}
+
+func (c *Catalog) Import(ctx context.Context, repository, branch string, params ImportParams) (string, error) {
+	repositoryID := graveler.RepositoryID(repository)
+	branchID := graveler.BranchID(branch)
+	if err := validator.Validate([]validator.ValidateArg{
+		{Name: "repository", Value: repositoryID, Fn: graveler.ValidateRepositoryID},
+		{Name: "branch", Value: branchID, Fn: graveler.ValidateBranchID},
+	}); err != nil {
+		return "", err
+	}
+	if params.Destination == "" {
+		return "", fmt.Errorf("destination: %w", graveler.ErrInvalidValue)
+	}
+	source := params.Source
+	if !strings.HasSuffix(source, DefaultPathDelimiter) {
+		source += DefaultPathDelimiter
+	}
+	qualifiedPrefix, err := block.ResolveNamespacePrefix(source, "")
+	if err != nil {
+		return "", fmt.Errorf("source %s: %w", params.Source, graveler.ErrInvalidValue)
+	}
+	if qualifiedPrefix.StorageType.BlockstoreType() != c.BlockAdapter.BlockstoreType() {
+		return "", fmt.Errorf("source %s is not on the %s blockstore: %w", params.Source, c.BlockAdapter.BlockstoreType(), graveler.ErrInvalidValue)
+	}
+	destination := params.Destination
+	if !strings.HasSuffix(destination, DefaultPathDelimiter) {
+		destination += DefaultPathDelimiter
+	}
+	if err := c.checkImportDestination(ctx, repositoryID, branchID, destination); err != nil {
+		return "", err
+	}
+	if params.Message == "" {
+		params.Message = "Import objects from " + source
+	}
+	if params.Committer == "" {
+		params.Committer = importDefaultCommitter
+	}
+
+	now := time.Now()
+	if err := c.Store.DeleteExpiredImports(ctx, repositoryID, now.Add(-importStatusRetention)); err != nil {
+		return "", err
+	}
+	importID := graveler.ImportID(xid.New().String())
+	if err := c.Store.CreateImport(ctx, repositoryID, importID, graveler.Import{BranchID: branchID, CreationDate: now, UpdateTime: now}); err != nil {
+		return "", err
+	}
+	// the import outlives the request that started it, its commit is recorded as made by the user who started it
+	reflogUser := graveler.BranchReflogUserFromContext(ctx)
+	if reflogUser == "" {
+		reflogUser = graveler.BranchReflogSystemUser
+	}
+	importCtx, cancel := context.WithCancel(graveler.WithBranchReflogUser(context.Background(), reflogUser))
+	task := &importTask{
+		repositoryID: repositoryID,
+		branchID:     branchID,
+		importID:     importID,
+		cancel:       cancel,
+	}
+	c.importsMu.Lock()
+	if c.imports == nil {
+		c.imports = make(map[graveler.ImportID]*importTask)
+	}
+	c.imports[importID] = task
+	c.importsMu.Unlock()
+
+	go c.runImport(importCtx, task, source, destination, params)
+	return importID.String(), nil
+}
+
+func (c *Catalog) runImport(ctx context.Context, task *importTask, source, destination string, params ImportParams) {
+	defer func() {
+		task.cancel()
+		c.importsMu.Lock()
+		delete(c.imports, task.importID)
+		c.importsMu.Unlock()
+	}()
+	log := c.log.WithFields(logging.Fields{
+		"repository": task.repositoryID,
+		"branch":     task.branchID,
+		"import_id":  task.importID,
+		"source":     source,
+	})
+	log.Info("Import started")
+	done := make(chan struct{})
+	go c.trackImportProgress(ctx, task, done, log)
+	metaRangeID, commitID, err := c.importObjects(ctx, task, source, destination, params)
+	close(done)
+
+	imp := graveler.Import{
+		ImportedObjects: atomic.LoadInt64(&task.imported),
+		Completed:       true,
+		MetaRangeID:     metaRangeID,
+		CommitID:        commitID,
+		UpdateTime:      time.Now(),
+	}
+	if err != nil {
+		imp.Error = err.Error()
+	}
+	// the outcome of a canceled import is recorded too
+	if _, updateErr := c.Store.UpdateImport(context.Background(), task.repositoryID, task.importID, imp); updateErr != nil {
+		log.WithError(updateErr).Error("Failed to record import outcome")
+	}
+	if err != nil {
+		log.WithError(err).Error("Import failed")
+		return
+	}
+	log.WithFields(logging.Fields{
+		"imported":  imp.ImportedObjects,
+		"commit_id": commitID,
+	}).Info("Import completed")
+}
+
+// trackImportProgress records the progress of the import every importProgressInterval until done is closed, and
+// cancels the import once canceling it was requested through any server
+func (c *Catalog) trackImportProgress(ctx context.Context, task *importTask, done <-chan struct{}, log logging.Logger) {
+	ticker := time.NewTicker(importProgressInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+		imp, err := c.Store.UpdateImport(ctx, task.repositoryID, task.importID, graveler.Import{
+			ImportedObjects: atomic.LoadInt64(&task.imported),
+			UpdateTime:      time.Now(),
+		})
+		if err != nil {
+			log.WithError(err).Warn("Failed to record import progress")
+			continue
+		}
+		if imp.Canceled {
+			log.Info("Import canceled")
+			task.cancel()
+			return
+		}
+	}
+}
+
+// importObjects writes a metarange of the objects under source, and commits the branch head with the objects under
+// destination replaced by them.  The branch head may move while the objects are listed, the imported objects are
+// then committed onto the new head.
+func (c *Catalog) importObjects(ctx context.Context, task *importTask, source, destination string, params ImportParams) (graveler.MetaRangeID, graveler.CommitID, error) {
+	walkIt, err := newWalkValueIterator(ctx, c.BlockAdapter, source, destination, &task.imported)
+	if err != nil {
+		return "", "", err
+	}
+	importMetaRangeID, err := c.Store.WriteMetaRange(ctx, task.repositoryID, walkIt)
+	walkIt.Close()
+	if err != nil {
+		return "", "", fmt.Errorf("write imported metarange: %w", err)
+	}
+	// an empty source would only remove the objects under destination
+	if atomic.LoadInt64(&task.imported) == 0 {
+		return "", "", fmt.Errorf("%s: %w", source, ErrNoImportedObject)
+	}
+	for attempt := 1; ; attempt++ {
+		metaRangeID, commitID, err := c.commitImport(ctx, task, *importMetaRangeID, destination, params)
+		if !errors.Is(err, graveler.ErrCommitNotHeadBranch) || attempt == importCommitAttempts {
+			return metaRangeID, commitID, err
+		}
+		c.log.WithFields(logging.Fields{
+			"repository": task.repositoryID,
+			"branch":     task.branchID,
+			"import_id":  task.importID,
+			"attempt":    attempt,
+		}).Debug("Branch head moved during import, committing onto the new head")
+	}
+}
+
+// commitImport commits the branch head with the objects under destination replaced by the values of the imported
+// metarange.  It returns graveler.ErrCommitNotHeadBranch if the branch head moved before the commit was added.
+func (c *Catalog) commitImport(ctx context.Context, task *importTask, importMetaRangeID graveler.MetaRangeID, destination string, params ImportParams) (graveler.MetaRangeID, graveler.CommitID, error) {
+	// staged changes under destination would shadow the imported objects
+	if err := c.checkImportDestination(ctx, task.repositoryID, task.branchID, destination); err != nil {
+		return "", "", err
+	}
+	branch, err := c.Store.GetBranch(ctx, task.repositoryID, task.branchID)
+	if err != nil {
+		return "", "", err
+	}
+	head, err := c.Store.GetCommit(ctx, task.repositoryID, branch.CommitID)
+	if err != nil {
+		return "", "", err
+	}
+	base, err := c.Store.List(ctx, task.repositoryID, graveler.Ref(branch.CommitID))
+	if err != nil {
+		return "", "", err
+	}
+	imported, err := c.Store.ListMetaRange(ctx, task.repositoryID, importMetaRangeID)
+	if err != nil {
+		base.Close()
+		return "", "", err
+	}
+	it := newPrefixReplaceIterator(base, imported, graveler.Key(destination))
+	metaRangeID, err := c.Store.WriteMetaRange(ctx, task.repositoryID, it)
+	it.Close()
+	if err != nil {
+		return "", "", fmt.Errorf("write metarange: %w", err)
+	}
+
+	commit := graveler.NewCommit()
+	commit.Committer = params.Committer
+	commit.Message = params.Message
+	commit.MetaRangeID = *metaRangeID
+	commit.Metadata = graveler.Metadata(params.Metadata)
+	commit.Parents = graveler.CommitParents{branch.CommitID}
+	commit.Generation = head.Generation + 1
+	commitID, err := c.Store.AddCommitToBranchHead(ctx, task.repositoryID, task.branchID, commit)
+	if err != nil {
+		return *metaRangeID, "", fmt.Errorf("commit metarange %s: %w", *metaRangeID, err)
+	}
+	return *metaRangeID, commitID, nil
+}
+
+// checkImportDestination returns ErrImportDirtyDestination if the branch has uncommitted changes under destination
+func (c *Catalog) checkImportDestination(ctx context.Context, repositoryID graveler.RepositoryID, branchID graveler.BranchID, destination string) error {
+	it, err := c.Store.DiffUncommitted(ctx, repositoryID, branchID)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	it.SeekGE(graveler.Key(destination))
+	if it.Next() && strings.HasPrefix(it.Value().Key.String(), destination) {
+		return fmt.Errorf("%s: %w", destination, ErrImportDirtyDestination)
+	}
+	return it.Err()
+}
+
+// getImport returns the import importID of the branch
+func (c *Catalog) getImport(ctx context.Context, repository, branch, importID string) (*graveler.Import, error) {
+	imp, err := c.Store.GetImport(ctx, graveler.RepositoryID(repository), graveler.ImportID(importID))
+	if errors.Is(err, graveler.ErrImportNotFound) || (err == nil && imp.BranchID.String() != branch) {
+		return nil, fmt.Errorf("%s: %w", importID, ErrImportNotFound)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return imp, nil
+}
+
+// runningImport returns the import importID if it is running on this server, or nil
+func (c *Catalog) runningImport(importID string) *importTask {
+	c.importsMu.Lock()
+	defer c.importsMu.Unlock()
+	return c.imports[graveler.ImportID(importID)]
+}
+
+func (c *Catalog) GetImportStatus(ctx context.Context, repository, branch, importID string) (*ImportStatus, error) {
+	imp, err := c.getImport(ctx, repository, branch, importID)
+	if err != nil {
+		return nil, err
+	}
+	status := &ImportStatus{
+		ID:              importID,
+		Completed:       imp.Completed,
+		ImportedObjects: imp.ImportedObjects,
+		MetaRangeID:     string(imp.MetaRangeID),
+		CommitID:        imp.CommitID.String(),
+		UpdateTime:      imp.UpdateTime,
+	}
+	if imp.Error != "" {
+		status.Error = errors.New(imp.Error)
+	}
+	if imp.Completed {
+		return status, nil
+	}
+	if task := c.runningImport(importID); task != nil {
+		status.ImportedObjects = atomic.LoadInt64(&task.imported)
+		status.UpdateTime = time.Now()
+	} else if time.Since(imp.UpdateTime) > importLostTimeout {
+		status.Completed = true
+		status.Error = ErrImportLost
+	}
+	return status, nil
+}
+
+func (c *Catalog) CancelImport(ctx context.Context, repository, branch, importID string) error {
+	status, err := c.GetImportStatus(ctx, repository, branch, importID)
+	if err != nil {
+		return err
+	}
+	if status.Completed {
+		return fmt.Errorf("import %s: %w", importID, ErrImportCompleted)
+	}
+	err = c.Store.CancelImport(ctx, graveler.RepositoryID(repository), graveler.ImportID(importID))
+	if err != nil {
+		return err
+	}
+	// an import running on another server is canceled once that server records its progress
+	if task := c.runningImport(importID); task != nil {
+		task.cancel()
+	}
+	return nil
+}
+
+func (c *Catalog) GetBranchProtectionRules(ctx context.Context, repositoryID string) (*graveler.BranchProtectionRules, error) {
 	return c.Store.GetBranchProtectionRules(ctx, graveler.RepositoryID(repositoryID))
 }
 
@@ -1232,8 +2639,33 @@ func (c *Catalog) DeleteBranchProtectionRule(ctx context.Context, repositoryID s
 	return c.Store.DeleteBranchProtectionRule(ctx, graveler.RepositoryID(repositoryID), pattern)
 }
 
-func (c *Catalog) CreateBranchProtectionRule(ctx context.Context, repositoryID string, pattern string, blockedActions []graveler.BranchProtectionBlockedAction) error {
-	return c.Store.CreateBranchProtectionRule(ctx, graveler.RepositoryID(repositoryID), pattern, blockedActions)
+func (c *Catalog) CreateBranchProtectionRule(ctx context.Context, repositoryID string, pattern string, blockedActions []graveler.BranchProtectionBlockedAction, mergeSourcePattern string, requireSignedCommits bool) error {
+	return c.Store.CreateBranchProtectionRule(ctx, graveler.RepositoryID(repositoryID), pattern, blockedActions, mergeSourcePattern, requireSignedCommits)
+}
+
+func (c *Catalog) GetTagProtectionRules(ctx context.Context, repositoryID string) (*graveler.TagProtectionRules, error) {
+	return c.Store.GetTagProtectionRules(ctx, graveler.RepositoryID(repositoryID))
+}
+
+func (c *Catalog) DeleteTagProtectionRule(ctx context.Context, repositoryID string, pattern string) error {
+	return c.Store.DeleteTagProtectionRule(ctx, graveler.RepositoryID(repositoryID), pattern)
+}
+
+func (c *Catalog) CreateTagProtectionRule(ctx context.Context, repositoryID string, pattern string) error {
+	return c.Store.CreateTagProtectionRule(ctx, graveler.RepositoryID(repositoryID), pattern)
+}
+
+func (c *Catalog) GetMergeStrategyRules(ctx context.Context, repositoryID string) (*graveler.MergeStrategyRules, error) {
+	return c.Store.GetMergeStrategyRules(ctx, graveler.RepositoryID(repositoryID))
+}
+
+func (c *Catalog) SetMergeStrategyRules(ctx context.Context, repositoryID string, rules *graveler.MergeStrategyRules) error {
+	if err := validator.Validate([]validator.ValidateArg{
+		{Name: "rules", Value: rules.GetRules(), Fn: graveler.ValidateMergeStrategyRules},
+	}); err != nil {
+		return err
+	}
+	return c.Store.SetMergeStrategyRules(ctx, graveler.RepositoryID(repositoryID), rules)
 }
 
 func (c *Catalog) PrepareExpiredCommits(ctx context.Context, repository string, previousRunID string) (*graveler.GarbageCollectionRunMetadata, error) {
@@ -1243,10 +2675,528 @@ func (c *Catalog) PrepareExpiredCommits(ctx context.Context, repository string,
 	}); err != nil {
 		return nil, err
 	}
+	// the Spark job reads the commits merged across rules, it would retain objects under a prefix by the longest
+	// retention of any rule rather than by the rule of their prefix
+	rules, err := c.Store.GetGarbageCollectionRules(ctx, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	if len(rules.PrefixRetentionDays) > 0 {
+		return nil, ErrSparkPrefixRules
+	}
 	return c.Store.SaveGarbageCollectionCommits(ctx, repositoryID, previousRunID)
 }
 
+func (c *Catalog) RunGarbageCollection(ctx context.Context, repository string, params GarbageCollectionParams) (*GarbageCollectionResult, error) {
+	repositoryID := graveler.RepositoryID(repository)
+	if err := validator.Validate([]validator.ValidateArg{
+		{Name: "repository", Value: repositoryID, Fn: graveler.ValidateRepositoryID},
+	}); err != nil {
+		return nil, err
+	}
+	if params.Parallelism < 1 {
+		return nil, fmt.Errorf("parallelism %d: %w", params.Parallelism, graveler.ErrInvalidValue)
+	}
+	repo, err := c.Store.GetRepository(ctx, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	runID := params.RunID
+	if runID == "" {
+		runMetadata, err := c.Store.SaveGarbageCollectionCommits(ctx, repositoryID, params.PreviousRunID)
+		if err != nil {
+			return nil, err
+		}
+		runID = runMetadata.RunId
+	}
+	log := c.log.WithFields(logging.Fields{"repository": repository, "run_id": runID})
+
+	// a resumed run removes the addresses it saved, so objects it already removed are not looked for again
+	addresses, location, err := c.Store.GetGarbageCollectionAddresses(ctx, repositoryID, runID)
+	if errors.Is(err, graveler.ErrNotFound) {
+		addresses, err = c.listUnreferencedAddresses(ctx, repositoryID, repo.StorageNamespace, runID)
+		if err != nil {
+			return nil, err
+		}
+		location, err = c.Store.SaveGarbageCollectionAddresses(ctx, repositoryID, runID, addresses)
+	}
+	if err != nil {
+		return nil, err
+	}
+	log.WithFields(logging.Fields{"addresses": len(addresses), "location": location}).Info("Garbage collection addresses saved")
+
+	res := &GarbageCollectionResult{
+		RunID:             runID,
+		AddressesLocation: location,
+		Addresses:         len(addresses),
+	}
+	if params.DryRun {
+		return res, nil
+	}
+	// objects staged, reachable from a branch head or held since the addresses were saved are kept
+	addresses, err = c.dropReferencedAddresses(ctx, repositoryID, repo.StorageNamespace, addresses)
+	if err != nil {
+		return res, err
+	}
+	res.Removed, err = c.removeObjects(ctx, addresses, params.Parallelism)
+	log.WithField("removed", res.Removed).Info("Garbage collection objects removed")
+	return res, err
+}
+
+// listUnreferencedAddresses returns the sorted full addresses of the objects in storageNamespace that the expired
+// commits of garbage collection run runID reference, and that neither its active commits nor any branch staging
+// area reference.
+func (c *Catalog) listUnreferencedAddresses(ctx context.Context, repositoryID graveler.RepositoryID, storageNamespace graveler.StorageNamespace, runID string) ([]string, error) {
+	values, err := c.Store.ListGarbageCollectionValues(ctx, repositoryID, runID)
+	if err != nil {
+		return nil, err
+	}
+	ruleAddresses, err := c.listRuleUnreferencedAddresses(ctx, repositoryID, storageNamespace, values)
+	if err != nil {
+		return nil, err
+	}
+	var addresses []string
+	for _, prefixAddresses := range ruleAddresses {
+		addresses = append(addresses, prefixAddresses...)
+	}
+	sort.Strings(addresses)
+	return addresses, nil
+}
+
+// listRuleUnreferencedAddresses returns the sorted full addresses of the unreferenced objects in storageNamespace
+// by the prefix of the rule that expired them, and closes values.  An object is owned by the rule of the longest
+// prefix of its path; it is unreferenced if an expired commit of its rule references it, and neither an active
+// commit of the rule owning any other path of it, any branch staging area nor any legal hold references it.
+func (c *Catalog) listRuleUnreferencedAddresses(ctx context.Context, repositoryID graveler.RepositoryID, storageNamespace graveler.StorageNamespace, values []graveler.GarbageCollectionValues) (map[string][]string, error) {
+	prefixes := make([]string, 0, len(values))
+	for _, v := range values {
+		defer v.Expired.Close()
+		defer v.Active.Close()
+		if v.Prefix != "" {
+			prefixes = append(prefixes, v.Prefix)
+		}
+	}
+	ownerPrefix := func(key graveler.Key) string {
+		owner := ""
+		for _, prefix := range prefixes {
+			if len(prefix) > len(owner) && strings.HasPrefix(string(key), prefix) {
+				owner = prefix
+			}
+		}
+		return owner
+	}
+
+	// the addresses of the referenced and expired objects may not fit in memory, they are sorted together so an
+	// expired object is found unreferenced if no referenced address precedes it
+	sorter := newRuleAddressSorter(gcAddressesInMemory)
+	defer sorter.Close()
+	for _, v := range values {
+		for v.Active.Next() {
+			record := v.Active.Value()
+			if ownerPrefix(record.Key) != v.Prefix {
+				continue
+			}
+			address, _, err := valueAddress(storageNamespace, record.Value)
+			if err != nil {
+				return nil, err
+			}
+			if err := sorter.Add(address, referencedRule); err != nil {
+				return nil, err
+			}
+		}
+		if err := v.Active.Err(); err != nil {
+			return nil, err
+		}
+	}
+	for _, listValues := range []func(context.Context, graveler.RepositoryID) (graveler.ValueIterator, error){
+		c.Store.ListStagedValues,
+		c.Store.ListHeldValues,
+	} {
+		err := c.forEachValueAddress(ctx, repositoryID, storageNamespace, listValues, func(address string) error {
+			return sorter.Add(address, referencedRule)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	for i, v := range values {
+		for v.Expired.Next() {
+			record := v.Expired.Value()
+			if ownerPrefix(record.Key) != v.Prefix {
+				continue
+			}
+			address, inNamespace, err := valueAddress(storageNamespace, record.Value)
+			if err != nil {
+				return nil, err
+			}
+			// objects outside the storage namespace, such as imported ones, are not owned by the repository
+			if !inNamespace {
+				continue
+			}
+			if err := sorter.Add(address, i); err != nil {
+				return nil, err
+			}
+		}
+		if err := v.Expired.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	ruleAddresses := make(map[string][]string, len(values))
+	previous := ""
+	err := sorter.Sorted(func(a ruleAddress) error {
+		// only the first rule of an address counts: the address is referenced, or listed once by the first rule
+		// expiring it
+		if a.Address == previous {
+			return nil
+		}
+		previous = a.Address
+		if a.Rule != referencedRule {
+			prefix := values[a.Rule].Prefix
+			ruleAddresses[prefix] = append(ruleAddresses[prefix], a.Address)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ruleAddresses, nil
+}
+
+// forEachValueAddress calls cb with the address of the object of every value that listValues lists
+func (c *Catalog) forEachValueAddress(ctx context.Context, repositoryID graveler.RepositoryID, storageNamespace graveler.StorageNamespace, listValues func(context.Context, graveler.RepositoryID) (graveler.ValueIterator, error), cb func(address string) error) error {
+	it, err := listValues(ctx, repositoryID)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	for it.Next() {
+		address, _, err := valueAddress(storageNamespace, it.Value().Value)
+		if err != nil {
+			return err
+		}
+		if err := cb(address); err != nil {
+			return err
+		}
+	}
+	return it.Err()
+}
+
+// dropReferencedAddresses returns addresses without the addresses of the objects currently staged on any branch or
+// stash, referenced by the head commit of any branch or held by any legal hold of the repository
+func (c *Catalog) dropReferencedAddresses(ctx context.Context, repositoryID graveler.RepositoryID, storageNamespace graveler.StorageNamespace, addresses []string) ([]string, error) {
+	unreferenced := make(map[string]struct{}, len(addresses))
+	for _, address := range addresses {
+		unreferenced[address] = struct{}{}
+	}
+	for _, listValues := range []func(context.Context, graveler.RepositoryID) (graveler.ValueIterator, error){
+		c.Store.ListStagedValues,
+		c.Store.ListBranchHeadValues,
+		c.Store.ListHeldValues,
+	} {
+		err := c.forEachValueAddress(ctx, repositoryID, storageNamespace, listValues, func(address string) error {
+			delete(unreferenced, address)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	kept := make([]string, 0, len(unreferenced))
+	for _, address := range addresses {
+		if _, ok := unreferenced[address]; ok {
+			kept = append(kept, address)
+		}
+	}
+	return kept, nil
+}
+
+// valueAddress returns the full address of the object of the entry stored in value, and whether it is stored under
+// storageNamespace
+func valueAddress(storageNamespace graveler.StorageNamespace, value *graveler.Value) (string, bool, error) {
+	ent, err := ValueToEntry(value)
+	if err != nil {
+		return "", false, err
+	}
+	qk, err := block.ResolveNamespace(storageNamespace.String(), ent.Address, addressTypeToCatalog(ent.AddressType).ToIdentifierType())
+	if err != nil {
+		return "", false, err
+	}
+	address := qk.Format()
+	return address, strings.HasPrefix(address, strings.TrimSuffix(storageNamespace.String(), "/")+"/"), nil
+}
+
+const (
+	// gcAddressesInMemory is the number of addresses garbage collection sorts in memory before it spills them to
+	// temporary files
+	gcAddressesInMemory = 1_000_000
+	// forgetWrittenBatchSize is the number of written values forgotten at once
+	forgetWrittenBatchSize = 1000
+	// uncommittedGCParallelism is the number of objects the background uncommitted garbage collection removes
+	// concurrently
+	uncommittedGCParallelism = 10
+)
+
+func (c *Catalog) RemoveUncommittedGarbage(ctx context.Context, repository string, params UncommittedGCParams) (*UncommittedGCResult, error) {
+	repositoryID := graveler.RepositoryID(repository)
+	if err := validator.Validate([]validator.ValidateArg{
+		{Name: "repository", Value: repositoryID, Fn: graveler.ValidateRepositoryID},
+	}); err != nil {
+		return nil, err
+	}
+	if params.Parallelism < 1 {
+		return nil, fmt.Errorf("parallelism %d: %w", params.Parallelism, graveler.ErrInvalidValue)
+	}
+	repo, err := c.Store.GetRepository(ctx, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	before := time.Now().Add(-params.GracePeriod)
+
+	// values written to staging, by the address of their object
+	candidates := make(map[string][]*graveler.WrittenValue)
+	writtenIt, err := c.Store.ListWrittenValues(ctx, repositoryID, before)
+	if err != nil {
+		return nil, err
+	}
+	defer writtenIt.Close()
+	for writtenIt.Next() {
+		written := writtenIt.Value()
+		address, err := writtenAddress(repo.StorageNamespace, written)
+		if err != nil {
+			return nil, err
+		}
+		candidates[address] = append(candidates[address], written)
+	}
+	if err := writtenIt.Err(); err != nil {
+		return nil, err
+	}
+	res := &UncommittedGCResult{Candidates: len(candidates)}
+	if len(candidates) > 0 {
+		// staging areas are read before commits, so an object committed meanwhile is found in the commits
+		if err := c.dropStagedCandidates(ctx, repositoryID, repo.StorageNamespace, candidates); err != nil {
+			return nil, err
+		}
+	}
+	// values that need no more tracking: their object is committed
+	var done []*graveler.WrittenValue
+	if len(candidates) > 0 {
+		done, err = c.dropCommittedCandidates(ctx, repositoryID, repo.StorageNamespace, candidates)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res.Unreferenced = len(candidates)
+	if params.DryRun {
+		return res, nil
+	}
+	if _, err := c.forgetWrittenValues(ctx, repositoryID, done, before); err != nil {
+		return nil, err
+	}
+
+	var unreferenced []*graveler.WrittenValue
+	for _, values := range candidates {
+		unreferenced = append(unreferenced, values...)
+	}
+	// an object is only removed if none of its values was staged again since the run started
+	forgotten, err := c.forgetWrittenValues(ctx, repositoryID, unreferenced, before)
+	if err != nil {
+		return nil, err
+	}
+	forgottenCount := make(map[string]int, len(candidates))
+	for _, value := range forgotten {
+		address, err := writtenAddress(repo.StorageNamespace, value)
+		if err != nil {
+			return nil, err
+		}
+		forgottenCount[address]++
+	}
+	addresses := make([]string, 0, len(candidates))
+	for address, values := range candidates {
+		if forgottenCount[address] == len(values) {
+			addresses = append(addresses, address)
+		}
+	}
+	sort.Strings(addresses)
+	res.Removed, err = c.removeObjects(ctx, addresses, params.Parallelism)
+	c.log.WithFields(logging.Fields{
+		"repository":   repository,
+		"candidates":   res.Candidates,
+		"unreferenced": res.Unreferenced,
+		"removed":      res.Removed,
+	}).Info("Uncommitted garbage removed")
+	return res, err
+}
+
+// writtenAddress returns the full address of the object of a written value, tracked relative to storageNamespace
+func writtenAddress(storageNamespace graveler.StorageNamespace, written *graveler.WrittenValue) (string, error) {
+	qk, err := block.ResolveNamespace(storageNamespace.String(), written.Address, block.IdentifierTypeRelative)
+	if err != nil {
+		return "", err
+	}
+	return qk.Format(), nil
+}
+
+// dropStagedCandidates deletes the addresses staged on any branch or stash of the repository from candidates
+func (c *Catalog) dropStagedCandidates(ctx context.Context, repositoryID graveler.RepositoryID, storageNamespace graveler.StorageNamespace, candidates map[string][]*graveler.WrittenValue) error {
+	it, err := c.Store.ListStagedValues(ctx, repositoryID)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	for it.Next() {
+		address, _, err := valueAddress(storageNamespace, it.Value().Value)
+		if err != nil {
+			return err
+		}
+		delete(candidates, address)
+	}
+	return it.Err()
+}
+
+// dropCommittedCandidates deletes the addresses that any commit of the repository references from candidates, and
+// returns their written values
+func (c *Catalog) dropCommittedCandidates(ctx context.Context, repositoryID graveler.RepositoryID, storageNamespace graveler.StorageNamespace, candidates map[string][]*graveler.WrittenValue) ([]*graveler.WrittenValue, error) {
+	it, err := c.Store.ListCommittedValues(ctx, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	var committed []*graveler.WrittenValue
+	for it.Next() {
+		address, _, err := valueAddress(storageNamespace, it.Value().Value)
+		if err != nil {
+			return nil, err
+		}
+		if values, ok := candidates[address]; ok {
+			committed = append(committed, values...)
+			delete(candidates, address)
+		}
+	}
+	return committed, it.Err()
+}
+
+// forgetWrittenValues forgets the given written values in batches, and returns the values forgotten
+func (c *Catalog) forgetWrittenValues(ctx context.Context, repositoryID graveler.RepositoryID, values []*graveler.WrittenValue, before time.Time) ([]*graveler.WrittenValue, error) {
+	var forgotten []*graveler.WrittenValue
+	for len(values) > 0 {
+		n := len(values)
+		if n > forgetWrittenBatchSize {
+			n = forgetWrittenBatchSize
+		}
+		batch, err := c.Store.ForgetWrittenValues(ctx, repositoryID, values[:n], before)
+		if err != nil {
+			return nil, err
+		}
+		forgotten = append(forgotten, batch...)
+		values = values[n:]
+	}
+	return forgotten, nil
+}
+
+// RemoveAllUncommittedGarbage removes the unreferenced staged objects of all repositories
+func (c *Catalog) RemoveAllUncommittedGarbage(ctx context.Context, params UncommittedGCParams) error {
+	it, err := c.Store.ListRepositories(ctx)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	for it.Next() {
+		repositoryID := it.Value().RepositoryID
+		_, err := c.RemoveUncommittedGarbage(ctx, repositoryID.String(), params)
+		// another instance may have deleted the repository meanwhile
+		if errors.Is(err, graveler.ErrRepositoryNotFound) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("remove uncommitted garbage of %s: %w", repositoryID, err)
+		}
+	}
+	return it.Err()
+}
+
+func (c *Catalog) runUncommittedGC(ctx context.Context, interval time.Duration, params UncommittedGCParams) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := c.RemoveAllUncommittedGarbage(ctx, params); err != nil {
+				c.log.WithError(err).Error("Failed to remove uncommitted garbage")
+			}
+		}
+	}
+}
+
+// removeObjects removes the objects at addresses using parallelism workers.  It stops at the first error, and
+// returns the number of objects removed.
+func (c *Catalog) removeObjects(ctx context.Context, addresses []string, parallelism int) (int, error) {
+	var (
+		wg sync.WaitGroup
+		// mu protects removed and firstErr
+		mu       sync.Mutex
+		removed  int
+		firstErr error
+	)
+	ch := make(chan string)
+	wg.Add(parallelism)
+	for i := 0; i < parallelism; i++ {
+		go func() {
+			defer wg.Done()
+			for address := range ch {
+				err := c.removeObject(ctx, address)
+				mu.Lock()
+				if err == nil {
+					removed++
+				} else if firstErr == nil {
+					firstErr = err
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	for _, address := range addresses {
+		mu.Lock()
+		failed := firstErr != nil
+		mu.Unlock()
+		if failed || ctx.Err() != nil {
+			break
+		}
+		ch <- address
+	}
+	close(ch)
+	wg.Wait()
+	if firstErr == nil {
+		firstErr = ctx.Err()
+	}
+	return removed, firstErr
+}
+
+func (c *Catalog) removeObject(ctx context.Context, address string) error {
+	obj := block.ObjectPointer{
+		Identifier:     address,
+		IdentifierType: block.IdentifierTypeFull,
+	}
+	err := c.BlockAdapter.Remove(ctx, obj)
+	if err == nil {
+		return nil
+	}
+	// an object removed by an earlier attempt of the run is done
+	if exists, existsErr := c.BlockAdapter.Exists(ctx, obj); existsErr == nil && !exists {
+		return nil
+	}
+	return fmt.Errorf("remove %s: %w", address, err)
+}
+
 func (c *Catalog) Close() error {
+	c.importsMu.Lock()
+	for _, task := range c.imports {
+		task.cancel()
+	}
+	c.importsMu.Unlock()
+
 	var errs error
 	for _, manager := range c.managers {
 		err := manager.Close()
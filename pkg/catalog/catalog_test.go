@@ -2,12 +2,17 @@ package catalog
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/go-test/deep"
+	"github.com/treeverse/lakefs/pkg/block"
+	"github.com/treeverse/lakefs/pkg/block/mem"
 	"github.com/treeverse/lakefs/pkg/graveler"
 	"github.com/treeverse/lakefs/pkg/graveler/testutil"
+	"github.com/treeverse/lakefs/pkg/logging"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -510,3 +515,635 @@ func TestCatalog_ListEntries(t *testing.T) {
 		})
 	}
 }
+
+func TestCatalog_ObjectHistory(t *testing.T) {
+	now := time.Now()
+	// c1 adds the object, c3 changes it on a branch merged by m5 and c6 removes it:
+	// c1 - c2 - c4 - m5 - c6
+	//        \       /
+	//         c3 ---
+	commits := []*graveler.CommitRecord{
+		{CommitID: "c6", Commit: &graveler.Commit{Committer: "user6", CreationDate: now.Add(-1 * time.Minute), Parents: graveler.CommitParents{"m5"}}},
+		{CommitID: "m5", Commit: &graveler.Commit{Committer: "user5", CreationDate: now.Add(-2 * time.Minute), Parents: graveler.CommitParents{"c4", "c3"}}},
+		{CommitID: "c4", Commit: &graveler.Commit{Committer: "user4", CreationDate: now.Add(-3 * time.Minute), Parents: graveler.CommitParents{"c2"}}},
+		{CommitID: "c3", Commit: &graveler.Commit{Committer: "user3", CreationDate: now.Add(-4 * time.Minute), Parents: graveler.CommitParents{"c2"}}},
+		{CommitID: "c2", Commit: &graveler.Commit{Committer: "user2", CreationDate: now.Add(-5 * time.Minute), Parents: graveler.CommitParents{"c1"}}},
+		{CommitID: "c1", Commit: &graveler.Commit{Committer: "user1", CreationDate: now.Add(-6 * time.Minute)}},
+	}
+	first := &Entry{Address: "addr1", LastModified: timestamppb.New(now), Size: 1, ETag: "01"}
+	second := &Entry{Address: "addr2", LastModified: timestamppb.New(now), Size: 2, ETag: "02"}
+	keyValue := map[string]*graveler.Value{}
+	for commitID, entry := range map[string]*Entry{"c1": first, "c2": first, "c3": second, "c4": first, "m5": second} {
+		keyValue[fakeGravelerBuildKey("repo", graveler.Ref(commitID), graveler.Key("file"))] = MustEntryToValue(entry)
+	}
+	version := func(commitID string, typ DifferenceType, entry *Entry) *ObjectVersion {
+		var commit *graveler.CommitRecord
+		for _, c := range commits {
+			if c.CommitID.String() == commitID {
+				commit = c
+			}
+		}
+		v := &ObjectVersion{CommitID: commitID, CreationDate: commit.CreationDate, Committer: commit.Committer, Type: typ}
+		if entry != nil {
+			ent := newCatalogEntryFromEntry(false, "file", entry)
+			v.Entry = &ent
+		}
+		return v
+	}
+
+	tests := []struct {
+		name        string
+		params      ObjectHistoryParams
+		want        []*ObjectVersion
+		wantHasMore bool
+	}{
+		{
+			name:   "all",
+			params: ObjectHistoryParams{Limit: -1},
+			want: []*ObjectVersion{
+				version("c6", DifferenceTypeRemoved, nil),
+				version("c3", DifferenceTypeChanged, second),
+				version("c1", DifferenceTypeAdded, first),
+			},
+		},
+		{
+			name:   "first parent",
+			params: ObjectHistoryParams{FirstParent: true, Limit: -1},
+			want: []*ObjectVersion{
+				version("c6", DifferenceTypeRemoved, nil),
+				version("m5", DifferenceTypeChanged, second),
+				version("c1", DifferenceTypeAdded, first),
+			},
+		},
+		{
+			name:   "first",
+			params: ObjectHistoryParams{Limit: 1},
+			want: []*ObjectVersion{
+				version("c6", DifferenceTypeRemoved, nil),
+			},
+			wantHasMore: true,
+		},
+		{
+			name:   "after",
+			params: ObjectHistoryParams{After: "c6", Limit: 1},
+			want: []*ObjectVersion{
+				version("c3", DifferenceTypeChanged, second),
+			},
+			wantHasMore: true,
+		},
+		{
+			name:   "last",
+			params: ObjectHistoryParams{After: "c3", Limit: 10},
+			want: []*ObjectVersion{
+				version("c1", DifferenceTypeAdded, first),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gravelerMock := &FakeGraveler{
+				KeyValue: keyValue,
+				Commits:  commits,
+			}
+			c := &Catalog{
+				Store: gravelerMock,
+			}
+			ctx := context.Background()
+			got, hasMore, err := c.ObjectHistory(ctx, "repo", "c6", "file", tt.params)
+			if err != nil {
+				t.Fatalf("ObjectHistory() error = %v", err)
+			}
+			if diff := deep.Equal(got, tt.want); diff != nil {
+				t.Error("ObjectHistory() found diff", diff)
+			}
+			if hasMore != tt.wantHasMore {
+				t.Errorf("ObjectHistory() hasMore = %t, want %t", hasMore, tt.wantHasMore)
+			}
+		})
+	}
+}
+
+func TestCatalog_DiffSummary(t *testing.T) {
+	gravelerMock := &FakeGraveler{
+		DiffSummaryResult: map[string]graveler.DiffSummary{
+			"tables/b/": {
+				Count:     map[graveler.DiffType]int{graveler.DiffTypeRemoved: 2, graveler.DiffTypeChanged: 1},
+				SizeDelta: map[graveler.DiffType]int64{graveler.DiffTypeRemoved: -20, graveler.DiffTypeChanged: -5},
+			},
+			"tables/a/": {
+				Count:     map[graveler.DiffType]int{graveler.DiffTypeAdded: 3},
+				SizeDelta: map[graveler.DiffType]int64{graveler.DiffTypeAdded: 30},
+			},
+		},
+	}
+	c := &Catalog{
+		Store: gravelerMock,
+	}
+	ctx := context.Background()
+	got, err := c.DiffSummary(ctx, "repo", "main", "dev", DiffSummaryParams{Depth: 2})
+	if err != nil {
+		t.Fatalf("DiffSummary() error = %v", err)
+	}
+	expected := []PrefixDiffSummary{
+		{Prefix: "tables/a/", Added: 3, AddedBytes: 30},
+		{Prefix: "tables/b/", Removed: 2, Changed: 1, RemovedBytes: 20, ChangedBytes: -5},
+	}
+	if diff := deep.Equal(got, expected); diff != nil {
+		t.Error("DiffSummary() found diff", diff)
+	}
+
+	if _, err := c.DiffSummary(ctx, "repo", "main", "dev", DiffSummaryParams{Depth: -1}); !errors.Is(err, graveler.ErrInvalidValue) {
+		t.Errorf("DiffSummary() with negative depth error = %v, expected %v", err, graveler.ErrInvalidValue)
+	}
+}
+
+func TestCatalog_RunGarbageCollection(t *testing.T) {
+	const storageNamespace = "mem://bucket"
+	entryValue := func(address string, addressType Entry_AddressType) *graveler.Value {
+		v, err := EntryToValue(&Entry{Address: address, AddressType: addressType, LastModified: timestamppb.Now()})
+		if err != nil {
+			t.Fatalf("EntryToValue() error = %v", err)
+		}
+		return v
+	}
+	gravelerMock := &FakeGraveler{
+		Repository: &graveler.Repository{StorageNamespace: storageNamespace},
+		ExpiredValues: []graveler.ValueRecord{
+			{Key: graveler.Key("a"), Value: entryValue("data/a", Entry_RELATIVE)},
+			{Key: graveler.Key("b"), Value: entryValue("data/b", Entry_RELATIVE)},
+			{Key: graveler.Key("c"), Value: entryValue("s3://imported/c", Entry_FULL)},
+			{Key: graveler.Key("d"), Value: entryValue("data/d", Entry_RELATIVE)},
+			{Key: graveler.Key("e"), Value: entryValue("data/e", Entry_RELATIVE)},
+		},
+		ActiveValues: []graveler.ValueRecord{
+			{Key: graveler.Key("b"), Value: entryValue(storageNamespace+"/data/b", Entry_FULL)},
+		},
+		// staged on a branch or a stash
+		StagedValues: []graveler.ValueRecord{
+			{Key: graveler.Key("d"), Value: entryValue("data/d", Entry_RELATIVE)},
+		},
+		GarbageCollectionAddresses: make(map[string][]string),
+	}
+	adapter := mem.New()
+	c := &Catalog{
+		Store:        gravelerMock,
+		BlockAdapter: adapter,
+		log:          logging.Default(),
+	}
+	ctx := context.Background()
+	objectExists := func(address string) bool {
+		exists, err := adapter.Exists(ctx, block.ObjectPointer{Identifier: address, IdentifierType: block.IdentifierTypeFull})
+		if err != nil {
+			t.Fatalf("Exists(%s) error = %v", address, err)
+		}
+		return exists
+	}
+	for _, name := range []string{"a", "b", "d", "e"} {
+		address := storageNamespace + "/data/" + name
+		err := adapter.Put(ctx, block.ObjectPointer{Identifier: address, IdentifierType: block.IdentifierTypeFull}, 4, strings.NewReader("data"), block.PutOpts{})
+		if err != nil {
+			t.Fatalf("Put(%s) error = %v", address, err)
+		}
+	}
+
+	res, err := c.RunGarbageCollection(ctx, "repo", GarbageCollectionParams{DryRun: true, Parallelism: 2})
+	if err != nil {
+		t.Fatalf("RunGarbageCollection() dry run error = %v", err)
+	}
+	if res.Addresses != 2 || res.Removed != 0 {
+		t.Errorf("RunGarbageCollection() dry run found %d addresses and removed %d, expected 2 and 0", res.Addresses, res.Removed)
+	}
+	if diff := deep.Equal(gravelerMock.GarbageCollectionAddresses[res.RunID], []string{storageNamespace + "/data/a", storageNamespace + "/data/e"}); diff != nil {
+		t.Error("RunGarbageCollection() saved addresses diff", diff)
+	}
+	if !objectExists(storageNamespace + "/data/a") {
+		t.Error("RunGarbageCollection() dry run removed an object")
+	}
+
+	// resume the run to remove the addresses it saved, after a branch was reset to a commit referencing one of them
+	gravelerMock.ExpiredValues = nil
+	gravelerMock.HeadValues = []graveler.ValueRecord{
+		{Key: graveler.Key("e"), Value: entryValue("data/e", Entry_RELATIVE)},
+	}
+	res, err = c.RunGarbageCollection(ctx, "repo", GarbageCollectionParams{RunID: res.RunID, Parallelism: 2})
+	if err != nil {
+		t.Fatalf("RunGarbageCollection() error = %v", err)
+	}
+	if res.Addresses != 2 || res.Removed != 1 {
+		t.Errorf("RunGarbageCollection() found %d addresses and removed %d, expected 2 and 1", res.Addresses, res.Removed)
+	}
+	if objectExists(storageNamespace + "/data/a") {
+		t.Error("RunGarbageCollection() did not remove unreferenced object")
+	}
+	for _, name := range []string{"b", "d", "e"} {
+		if !objectExists(storageNamespace + "/data/" + name) {
+			t.Errorf("RunGarbageCollection() removed referenced object %s", name)
+		}
+	}
+
+	if _, err := c.RunGarbageCollection(ctx, "repo", GarbageCollectionParams{}); !errors.Is(err, graveler.ErrInvalidValue) {
+		t.Errorf("RunGarbageCollection() without parallelism error = %v, expected %v", err, graveler.ErrInvalidValue)
+	}
+}
+
+func TestCatalog_PrepareExpiredCommits(t *testing.T) {
+	gravelerMock := &FakeGraveler{
+		GarbageCollectionRules:     &graveler.GarbageCollectionRules{DefaultRetentionDays: 21},
+		GarbageCollectionAddresses: make(map[string][]string),
+	}
+	c := &Catalog{
+		Store: gravelerMock,
+		log:   logging.Default(),
+	}
+	ctx := context.Background()
+	if _, err := c.PrepareExpiredCommits(ctx, "repo", ""); err != nil {
+		t.Fatalf("PrepareExpiredCommits() error = %v", err)
+	}
+	// the Spark job cannot apply prefix rules
+	gravelerMock.GarbageCollectionRules.PrefixRetentionDays = map[string]int32{"raw/": 7}
+	if _, err := c.PrepareExpiredCommits(ctx, "repo", ""); !errors.Is(err, ErrSparkPrefixRules) {
+		t.Errorf("PrepareExpiredCommits() with prefix rules error = %v, expected %v", err, ErrSparkPrefixRules)
+	}
+}
+
+func TestCatalog_RunGarbageCollectionLegalHold(t *testing.T) {
+	const storageNamespace = "mem://bucket"
+	entryValue := func(key, address string) graveler.ValueRecord {
+		v, err := EntryToValue(&Entry{Address: address, AddressType: Entry_RELATIVE, LastModified: timestamppb.Now()})
+		if err != nil {
+			t.Fatalf("EntryToValue() error = %v", err)
+		}
+		return graveler.ValueRecord{Key: graveler.Key(key), Value: v}
+	}
+	gravelerMock := &FakeGraveler{
+		Repository: &graveler.Repository{StorageNamespace: storageNamespace},
+		ExpiredValues: []graveler.ValueRecord{
+			entryValue("a", "data/a"),
+			entryValue("b", "data/b"),
+		},
+		HeldValues: []graveler.ValueRecord{
+			entryValue("held/b", "data/b"),
+		},
+		BranchIteratorFactory:      testutil.NewFakeBranchIteratorFactory(nil),
+		GarbageCollectionAddresses: make(map[string][]string),
+	}
+	adapter := mem.New()
+	c := &Catalog{
+		Store:        gravelerMock,
+		BlockAdapter: adapter,
+		log:          logging.Default(),
+	}
+	ctx := context.Background()
+	for _, name := range []string{"a", "b"} {
+		address := storageNamespace + "/data/" + name
+		err := adapter.Put(ctx, block.ObjectPointer{Identifier: address, IdentifierType: block.IdentifierTypeFull}, 4, strings.NewReader("data"), block.PutOpts{})
+		if err != nil {
+			t.Fatalf("Put(%s) error = %v", address, err)
+		}
+	}
+
+	res, err := c.RunGarbageCollection(ctx, "repo", GarbageCollectionParams{DryRun: true, Parallelism: 2})
+	if err != nil {
+		t.Fatalf("RunGarbageCollection() dry run error = %v", err)
+	}
+	if diff := deep.Equal(gravelerMock.GarbageCollectionAddresses[res.RunID], []string{storageNamespace + "/data/a"}); diff != nil {
+		t.Error("RunGarbageCollection() saved addresses diff", diff)
+	}
+
+	// objects held after the run saved their addresses are kept when it is resumed
+	gravelerMock.HeldValues = append(gravelerMock.HeldValues, entryValue("held/a", "data/a"))
+	res, err = c.RunGarbageCollection(ctx, "repo", GarbageCollectionParams{RunID: res.RunID, Parallelism: 2})
+	if err != nil {
+		t.Fatalf("RunGarbageCollection() error = %v", err)
+	}
+	if res.Removed != 0 {
+		t.Errorf("RunGarbageCollection() removed %d objects, expected 0", res.Removed)
+	}
+	exists, err := adapter.Exists(ctx, block.ObjectPointer{Identifier: storageNamespace + "/data/a", IdentifierType: block.IdentifierTypeFull})
+	if err != nil {
+		t.Fatalf("Exists() error = %v", err)
+	}
+	if !exists {
+		t.Error("RunGarbageCollection() removed a held object")
+	}
+}
+
+func TestCatalog_PreviewGarbageCollectionRules(t *testing.T) {
+	const storageNamespace = "mem://bucket"
+	entryValue := func(key, address string) graveler.ValueRecord {
+		v, err := EntryToValue(&Entry{Address: address, AddressType: Entry_RELATIVE, LastModified: timestamppb.Now()})
+		if err != nil {
+			t.Fatalf("EntryToValue() error = %v", err)
+		}
+		return graveler.ValueRecord{Key: graveler.Key(key), Value: v}
+	}
+	gravelerMock := &FakeGraveler{
+		Repository: &graveler.Repository{StorageNamespace: storageNamespace},
+		ExpiredValues: []graveler.ValueRecord{
+			entryValue("a", "data/a"),
+			// owned by the raw/ rule
+			entryValue("raw/b", "data/b"),
+		},
+		ActiveValues: []graveler.ValueRecord{
+			// owned by the raw/ rule, so the default rule keeping it does not reference the object
+			entryValue("raw/c", "data/c"),
+		},
+		PrefixExpiredValues: map[string][]graveler.ValueRecord{
+			"raw/": {
+				entryValue("raw/b", "data/b"),
+				entryValue("raw/c", "data/c"),
+				entryValue("raw/d", "data/d"),
+				// owned by the default rule
+				entryValue("e", "data/e"),
+			},
+		},
+		PrefixActiveValues: map[string][]graveler.ValueRecord{
+			"raw/": {entryValue("raw/d", "data/d")},
+		},
+		BranchIteratorFactory: testutil.NewFakeBranchIteratorFactory(nil),
+	}
+	c := &Catalog{
+		Store: gravelerMock,
+		log:   logging.Default(),
+	}
+	ctx := context.Background()
+	rules := &graveler.GarbageCollectionRules{
+		DefaultRetentionDays: 21,
+		PrefixRetentionDays:  map[string]int32{"raw/": 7},
+	}
+	previews, err := c.PreviewGarbageCollectionRules(ctx, "repo", rules)
+	if err != nil {
+		t.Fatalf("PreviewGarbageCollectionRules() error = %v", err)
+	}
+	expected := []GarbageCollectionRulePreview{
+		{Prefix: "", RetentionDays: 21, ExpiredObjects: 1},
+		{Prefix: "raw/", RetentionDays: 7, ExpiredObjects: 2},
+	}
+	if diff := deep.Equal(previews, expected); diff != nil {
+		t.Error("PreviewGarbageCollectionRules() diff", diff)
+	}
+
+	rules.PinnedTagPatterns = []string{"release-[a"}
+	if _, err := c.PreviewGarbageCollectionRules(ctx, "repo", rules); !errors.Is(err, graveler.ErrInvalidGarbageCollectionRule) {
+		t.Errorf("PreviewGarbageCollectionRules() with invalid pattern error = %v, expected %v", err, graveler.ErrInvalidGarbageCollectionRule)
+	}
+}
+
+func TestCatalog_CreateEntryTracksObjects(t *testing.T) {
+	gravelerMock := &FakeGraveler{KeyValue: make(map[string]*graveler.Value)}
+	c := &Catalog{
+		Store: gravelerMock,
+		log:   logging.Default(),
+	}
+	ctx := context.Background()
+	for _, entry := range []DBEntry{
+		{Path: "staged", PhysicalAddress: "data/a", AddressType: AddressTypeRelative},
+		{Path: "linked", PhysicalAddress: "s3://bucket/b", AddressType: AddressTypeFull},
+	} {
+		if err := c.CreateEntry(ctx, "repo", "main", entry); err != nil {
+			t.Fatalf("CreateEntry(%s) error = %v", entry.Path, err)
+		}
+	}
+	// only objects stored under the storage namespace are tracked
+	if len(gravelerMock.WrittenValues) != 1 || gravelerMock.WrittenValues[0].Address != "data/a" {
+		t.Errorf("CreateEntry() tracked %+v, expected the relative address only", gravelerMock.WrittenValues)
+	}
+}
+
+func TestCatalog_RemoveUncommittedGarbage(t *testing.T) {
+	const storageNamespace = "mem://bucket"
+	writtenValue := func(identity, address string) *graveler.WrittenValue {
+		return &graveler.WrittenValue{
+			Key:        graveler.Key(identity),
+			Identity:   []byte(identity),
+			DataDigest: "digest-" + address,
+			Address:    address,
+		}
+	}
+	entryValue := func(identity, address string, addressType Entry_AddressType) graveler.ValueRecord {
+		v, err := EntryToValue(&Entry{Address: address, AddressType: addressType, LastModified: timestamppb.Now()})
+		if err != nil {
+			t.Fatalf("EntryToValue() error = %v", err)
+		}
+		v.Identity = []byte(identity)
+		return graveler.ValueRecord{Key: graveler.Key(identity), Value: v}
+	}
+	gravelerMock := &FakeGraveler{
+		Repository: &graveler.Repository{StorageNamespace: storageNamespace},
+		WrittenValues: []*graveler.WrittenValue{
+			writtenValue("unreferenced", "data/a"),
+			writtenValue("staged", "data/b"),
+			writtenValue("committed", "data/c"),
+			// same content as the staged object, at another address
+			writtenValue("staged", "data/e"),
+		},
+		StagedValues: []graveler.ValueRecord{
+			entryValue("on-branch", "data/b", Entry_RELATIVE),
+		},
+		CommittedValues: []graveler.ValueRecord{
+			entryValue("in-commit", storageNamespace+"/data/c", Entry_FULL),
+		},
+	}
+	adapter := mem.New()
+	c := &Catalog{
+		Store:        gravelerMock,
+		BlockAdapter: adapter,
+		log:          logging.Default(),
+	}
+	ctx := context.Background()
+	objectExists := func(address string) bool {
+		exists, err := adapter.Exists(ctx, block.ObjectPointer{Identifier: address, IdentifierType: block.IdentifierTypeFull})
+		if err != nil {
+			t.Fatalf("Exists(%s) error = %v", address, err)
+		}
+		return exists
+	}
+	for _, name := range []string{"a", "b", "c", "e"} {
+		address := storageNamespace + "/data/" + name
+		err := adapter.Put(ctx, block.ObjectPointer{Identifier: address, IdentifierType: block.IdentifierTypeFull}, 4, strings.NewReader("data"), block.PutOpts{})
+		if err != nil {
+			t.Fatalf("Put(%s) error = %v", address, err)
+		}
+	}
+
+	res, err := c.RemoveUncommittedGarbage(ctx, "repo", UncommittedGCParams{DryRun: true, Parallelism: 2})
+	if err != nil {
+		t.Fatalf("RemoveUncommittedGarbage() dry run error = %v", err)
+	}
+	if diff := deep.Equal(res, &UncommittedGCResult{Candidates: 4, Unreferenced: 2}); diff != nil {
+		t.Error("RemoveUncommittedGarbage() dry run result diff", diff)
+	}
+	if len(gravelerMock.WrittenValues) != 4 || !objectExists(storageNamespace+"/data/a") {
+		t.Error("RemoveUncommittedGarbage() dry run forgot values or removed objects")
+	}
+
+	res, err = c.RemoveUncommittedGarbage(ctx, "repo", UncommittedGCParams{Parallelism: 2})
+	if err != nil {
+		t.Fatalf("RemoveUncommittedGarbage() error = %v", err)
+	}
+	if diff := deep.Equal(res, &UncommittedGCResult{Candidates: 4, Unreferenced: 2, Removed: 2}); diff != nil {
+		t.Error("RemoveUncommittedGarbage() result diff", diff)
+	}
+	for _, name := range []string{"a", "e"} {
+		if objectExists(storageNamespace + "/data/" + name) {
+			t.Errorf("RemoveUncommittedGarbage() did not remove unreferenced object %s", name)
+		}
+	}
+	for _, name := range []string{"b", "c"} {
+		if !objectExists(storageNamespace + "/data/" + name) {
+			t.Errorf("RemoveUncommittedGarbage() removed referenced object %s", name)
+		}
+	}
+	// only the staged value is still tracked, it may become unreferenced later
+	var tracked []string
+	for _, value := range gravelerMock.WrittenValues {
+		tracked = append(tracked, string(value.Identity))
+	}
+	if diff := deep.Equal(tracked, []string{"staged"}); diff != nil {
+		t.Error("RemoveUncommittedGarbage() tracked values diff", diff)
+	}
+
+	if _, err := c.RemoveUncommittedGarbage(ctx, "repo", UncommittedGCParams{}); !errors.Is(err, graveler.ErrInvalidValue) {
+		t.Errorf("RemoveUncommittedGarbage() without parallelism error = %v, expected %v", err, graveler.ErrInvalidValue)
+	}
+}
+
+func TestCatalog_Import(t *testing.T) {
+	const source = "mem://source/import/"
+	entryValue := func(key, address string) *graveler.ValueRecord {
+		v, err := EntryToValue(&Entry{Address: address, AddressType: Entry_RELATIVE, LastModified: timestamppb.Now()})
+		if err != nil {
+			t.Fatalf("EntryToValue() error = %v", err)
+		}
+		return &graveler.ValueRecord{Key: graveler.Key(key), Value: v}
+	}
+	gravelerMock := &FakeGraveler{
+		BranchIteratorFactory: testutil.NewFakeBranchIteratorFactory([]*graveler.BranchRecord{
+			{BranchID: "main", Branch: &graveler.Branch{CommitID: "head"}},
+		}),
+		Commits: []*graveler.CommitRecord{
+			{CommitID: "head", Commit: &graveler.Commit{Generation: 3}},
+		},
+		ListIteratorFactory: NewFakeValueIteratorFactory([]*graveler.ValueRecord{
+			entryValue("a", "data/a"),
+			entryValue("tables/", "data/tables"),
+			entryValue("tables/old", "data/old"),
+			entryValue("tables0", "data/tables0"),
+		}),
+		// uncommitted changes outside the destination do not block the import
+		DiffIteratorFactory: NewFakeDiffIteratorFactory([]*graveler.Diff{
+			{Key: graveler.Key("a"), Type: graveler.DiffTypeChanged},
+			{Key: graveler.Key("tables0"), Type: graveler.DiffTypeRemoved},
+		}),
+		// the branch head moves once while importing
+		BranchHeadErrs: []error{graveler.ErrCommitNotHeadBranch},
+	}
+	adapter := mem.New()
+	c := &Catalog{
+		Store:        gravelerMock,
+		BlockAdapter: adapter,
+		log:          logging.Default(),
+	}
+	ctx := context.Background()
+	for _, identifier := range []string{"2021/x", "2021/", "2022/y"} {
+		obj := block.ObjectPointer{StorageNamespace: source, Identifier: identifier, IdentifierType: block.IdentifierTypeRelative}
+		if err := adapter.Put(ctx, obj, 4, strings.NewReader("data"), block.PutOpts{}); err != nil {
+			t.Fatalf("Put(%s) error = %v", identifier, err)
+		}
+	}
+	waitForImport := func(importID string) *ImportStatus {
+		for {
+			status, err := c.GetImportStatus(ctx, "repo", "main", importID)
+			if err != nil {
+				t.Fatalf("GetImportStatus() error = %v", err)
+			}
+			if status.Completed {
+				return status
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+
+	// the import commit is recorded as made by the user who started it
+	importID, err := c.Import(graveler.WithBranchReflogUser(ctx, "importer"), "repo", "main", ImportParams{Source: source, Destination: "tables", Message: "import"})
+	if err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+	status := waitForImport(importID)
+	if status.Error != nil {
+		t.Fatalf("Import() failed with error = %v", status.Error)
+	}
+	// the imported objects, the metarange of the commit onto the moved head and the metarange committed
+	if status.ImportedObjects != 2 || status.CommitID != "commit1" || status.MetaRangeID != "metarange3" {
+		t.Errorf("Import() status = %+v, expected 2 imported objects and commit1 of metarange3", status)
+	}
+	// the imported objects replace the objects under the destination, directory markers are skipped
+	var keys []string
+	for _, record := range gravelerMock.MetaRangeValues {
+		keys = append(keys, record.Key.String())
+	}
+	if diff := deep.Equal(keys, []string{"a", "tables/2021/x", "tables/2022/y", "tables0"}); diff != nil {
+		t.Error("Import() metarange keys diff", diff)
+	}
+	ent, err := ValueToEntry(gravelerMock.MetaRangeValues[1].Value)
+	if err != nil {
+		t.Fatalf("ValueToEntry() error = %v", err)
+	}
+	if ent.Address != "mem://source/import/2021/x" || ent.AddressType != Entry_FULL || ent.Size != 4 {
+		t.Errorf("Import() entry = %+v, expected full address of the source object with its size", ent)
+	}
+	commit := gravelerMock.BranchHeadCommits[0]
+	if diff := deep.Equal(commit.Parents, graveler.CommitParents{"head"}); diff != nil || commit.Generation != 4 || commit.Message != "import" {
+		t.Errorf("Import() commit = %+v, expected child of head with message import", commit)
+	}
+	if diff := deep.Equal(gravelerMock.BranchHeadUsers, []string{"importer"}); diff != nil {
+		t.Error("Import() reflog users diff", diff)
+	}
+
+	if err := c.CancelImport(ctx, "repo", "main", importID); !errors.Is(err, ErrImportCompleted) {
+		t.Errorf("CancelImport() of completed import error = %v, expected %v", err, ErrImportCompleted)
+	}
+	if _, err := c.GetImportStatus(ctx, "repo", "other", importID); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetImportStatus() of another branch error = %v, expected %v", err, ErrNotFound)
+	}
+	if _, err := c.GetImportStatus(ctx, "repo", "main", "unknown"); !errors.Is(err, ErrImportNotFound) {
+		t.Errorf("GetImportStatus() of unknown import error = %v, expected %v", err, ErrImportNotFound)
+	}
+
+	// an import that its server stopped updating is lost
+	lastUpdate := time.Now().Add(-time.Hour)
+	if err := gravelerMock.CreateImport(ctx, "repo", "lost", graveler.Import{BranchID: "main", CreationDate: lastUpdate, UpdateTime: lastUpdate}); err != nil {
+		t.Fatalf("CreateImport() error = %v", err)
+	}
+	if status, err := c.GetImportStatus(ctx, "repo", "main", "lost"); err != nil || !status.Completed || !errors.Is(status.Error, ErrImportLost) {
+		t.Errorf("GetImportStatus() of lost import = %+v, %v, expected completed with %v", status, err, ErrImportLost)
+	}
+	if err := c.CancelImport(ctx, "repo", "main", "lost"); !errors.Is(err, ErrImportCompleted) {
+		t.Errorf("CancelImport() of lost import error = %v, expected %v", err, ErrImportCompleted)
+	}
+
+	// an empty source is not committed, it would only remove the objects under the destination
+	importID, err = c.Import(ctx, "repo", "main", ImportParams{Source: "mem://source/empty/", Destination: "tables/"})
+	if err != nil {
+		t.Fatalf("Import() of empty source error = %v", err)
+	}
+	if status := waitForImport(importID); status.Error == nil || !strings.Contains(status.Error.Error(), ErrNoImportedObject.Error()) {
+		t.Errorf("Import() of empty source error = %v, expected %v", status.Error, ErrNoImportedObject)
+	}
+	if len(gravelerMock.BranchHeadCommits) != 1 {
+		t.Error("Import() of empty source committed")
+	}
+
+	if _, err := c.Import(ctx, "repo", "main", ImportParams{Source: "s3://bucket/path/", Destination: "tables/"}); !errors.Is(err, graveler.ErrInvalidValue) {
+		t.Errorf("Import() from another blockstore error = %v, expected %v", err, graveler.ErrInvalidValue)
+	}
+
+	// staged changes under the destination would shadow the imported objects
+	gravelerMock.DiffIteratorFactory = NewFakeDiffIteratorFactory([]*graveler.Diff{
+		{Key: graveler.Key("a"), Type: graveler.DiffTypeChanged},
+		{Key: graveler.Key("tables/new"), Type: graveler.DiffTypeAdded},
+	})
+	if _, err := c.Import(ctx, "repo", "main", ImportParams{Source: source, Destination: "tables"}); !errors.Is(err, graveler.ErrDirtyBranch) {
+		t.Errorf("Import() to a dirty destination error = %v, expected %v", err, graveler.ErrDirtyBranch)
+	}
+}
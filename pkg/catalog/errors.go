@@ -1,10 +1,12 @@
 package catalog
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
 
 	"github.com/treeverse/lakefs/pkg/db"
+	"github.com/treeverse/lakefs/pkg/graveler"
 )
 
 var (
@@ -22,4 +24,93 @@ var (
 	ErrNoDifferenceWasFound     = errors.New("no difference was found")
 	ErrConflictFound            = errors.New("conflict found")
 	ErrInvalidRef               = errors.New("invalid ref")
+	ErrImportCompleted          = graveler.ErrImportCompleted
+	ErrImportLost               = errors.New("import lost, the server running it stopped before it completed")
+	ErrSparkPrefixRules         = fmt.Errorf("prefix rules are not applied by the Spark garbage collection, run lakefs gc run instead: %w", graveler.ErrInvalidGarbageCollectionRule)
+	ErrImportDirtyDestination   = fmt.Errorf("uncommitted changes under the import destination: %w", graveler.ErrDirtyBranch)
+	ErrImportNotFound           = fmt.Errorf("import %w", ErrNotFound)
 )
+
+// MergeConflictsError is returned by Merge when conflicts are found, holds the conflicting paths.
+type MergeConflictsError struct {
+	Conflicts []MergeConflict
+	// Truncated is set when there may be more conflicts than the ones listed
+	Truncated bool
+	err       error
+}
+
+func (e *MergeConflictsError) Error() string {
+	return e.err.Error()
+}
+
+func (e *MergeConflictsError) Unwrap() error {
+	return e.err
+}
+
+func newMergeConflictsError(err *graveler.MergeConflictsError) *MergeConflictsError {
+	return &MergeConflictsError{
+		Conflicts: newMergeConflicts(err.Conflicts),
+		Truncated: err.Truncated,
+		err:       err,
+	}
+}
+
+// RebaseConflictError is returned by Rebase when a replayed commit conflicts, holds the commit and the conflicting
+// paths.
+type RebaseConflictError struct {
+	CommitID  string
+	Conflicts []MergeConflict
+	// Truncated is set when there may be more conflicts than the ones listed
+	Truncated bool
+	err       error
+}
+
+func (e *RebaseConflictError) Error() string {
+	return e.err.Error()
+}
+
+func (e *RebaseConflictError) Unwrap() error {
+	return e.err
+}
+
+func newRebaseConflictError(err *graveler.RebaseConflictError) *RebaseConflictError {
+	rebaseErr := &RebaseConflictError{
+		CommitID: err.CommitID.String(),
+		err:      err,
+	}
+	var conflictsErr *graveler.MergeConflictsError
+	if errors.As(err.Err, &conflictsErr) {
+		rebaseErr.Conflicts = newMergeConflicts(conflictsErr.Conflicts)
+		rebaseErr.Truncated = conflictsErr.Truncated
+	}
+	return rebaseErr
+}
+
+func newMergeConflicts(gravelerConflicts []graveler.MergeConflict) []MergeConflict {
+	conflicts := make([]MergeConflict, 0, len(gravelerConflicts))
+	for _, c := range gravelerConflicts {
+		conflicts = append(conflicts, MergeConflict{
+			Path:        c.Key.String(),
+			Source:      newMergeConflictEntry(c.Source),
+			Destination: newMergeConflictEntry(c.Destination),
+			Base:        newMergeConflictEntry(c.Base),
+		})
+	}
+	return conflicts
+}
+
+func newMergeConflictEntry(value *graveler.Value) *MergeConflictEntry {
+	if value == nil {
+		return nil
+	}
+	conflictEntry := &MergeConflictEntry{
+		Identity: hex.EncodeToString(value.Identity),
+	}
+	// entry details are best effort, the identity is enough to identify the conflicting value
+	if ent, err := ValueToEntry(value); err == nil && ent != nil {
+		conflictEntry.PhysicalAddress = ent.Address
+		conflictEntry.Checksum = ent.ETag
+		conflictEntry.Size = ent.Size
+	}
+	return conflictEntry
+}
@@ -3,9 +3,14 @@ package catalog
 import (
 	"bytes"
 	"context"
+	"fmt"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/treeverse/lakefs/pkg/graveler"
+	"github.com/treeverse/lakefs/pkg/graveler/testutil"
 )
 
 type FakeGraveler struct {
@@ -17,7 +22,36 @@ type FakeGraveler struct {
 	RepositoryIteratorFactory func() graveler.RepositoryIterator
 	BranchIteratorFactory     func() graveler.BranchIterator
 	TagIteratorFactory        func() graveler.TagIterator
-	hooks                     graveler.HooksHandler
+	Commits                   []*graveler.CommitRecord
+	DiffSummaryResult         map[string]graveler.DiffSummary
+	Repository                *graveler.Repository
+	// ExpiredValues and ActiveValues are listed by the default rule of any garbage collection run, and
+	// PrefixExpiredValues and PrefixActiveValues by the rule of every prefix
+	ExpiredValues              []graveler.ValueRecord
+	ActiveValues               []graveler.ValueRecord
+	PrefixExpiredValues        map[string][]graveler.ValueRecord
+	PrefixActiveValues         map[string][]graveler.ValueRecord
+	GarbageCollectionAddresses map[string][]string
+	GarbageCollectionRules     *graveler.GarbageCollectionRules
+	// WrittenValues are tracked staged writes, removed once forgotten
+	WrittenValues   []*graveler.WrittenValue
+	StagedValues    []graveler.ValueRecord
+	CommittedValues []graveler.ValueRecord
+	// HeldValues are the values held by legal holds, and HeadValues the values of branch head commits
+	HeldValues []graveler.ValueRecord
+	HeadValues []graveler.ValueRecord
+	// MetaRanges are the values of the metaranges written by their ID and MetaRangeValues the values of the last
+	// one, BranchHeadCommits the commits added to branch heads and BranchHeadUsers the reflog users that added
+	// them.  BranchHeadErrs are returned by the next additions of commits to branch heads.
+	MetaRanges        map[graveler.MetaRangeID][]graveler.ValueRecord
+	MetaRangeValues   []graveler.ValueRecord
+	BranchHeadCommits []graveler.Commit
+	BranchHeadUsers   []string
+	BranchHeadErrs    []error
+	// Imports are the imports by their ID, importsMu protects them from the imports running in the background
+	Imports   map[graveler.ImportID]*graveler.Import
+	importsMu sync.Mutex
+	hooks     graveler.HooksHandler
 }
 
 func (g *FakeGraveler) ParseRef(ref graveler.Ref) (graveler.RawRef, error) {
@@ -29,11 +63,124 @@ func (g *FakeGraveler) ResolveRawRef(ctx context.Context, repositoryID graveler.
 }
 
 func (g *FakeGraveler) SaveGarbageCollectionCommits(ctx context.Context, repositoryID graveler.RepositoryID, previousRunID string) (garbageCollectionRunMetadata *graveler.GarbageCollectionRunMetadata, err error) {
-	panic("implement me")
+	if g.Err != nil {
+		return nil, g.Err
+	}
+	return &graveler.GarbageCollectionRunMetadata{RunId: fmt.Sprintf("run-%d", len(g.GarbageCollectionAddresses))}, nil
 }
 
-func (g *FakeGraveler) GetGarbageCollectionRules(ctx context.Context, repositoryID graveler.RepositoryID) (*graveler.GarbageCollectionRules, error) {
-	panic("implement me")
+func (g *FakeGraveler) ListGarbageCollectionValues(_ context.Context, _ graveler.RepositoryID, _ string) ([]graveler.GarbageCollectionValues, error) {
+	if g.Err != nil {
+		return nil, g.Err
+	}
+	return g.garbageCollectionValues(), nil
+}
+
+func (g *FakeGraveler) PreviewGarbageCollectionValues(_ context.Context, _ graveler.RepositoryID, _ *graveler.GarbageCollectionRules) ([]graveler.GarbageCollectionValues, error) {
+	if g.Err != nil {
+		return nil, g.Err
+	}
+	return g.garbageCollectionValues(), nil
+}
+
+func (g *FakeGraveler) garbageCollectionValues() []graveler.GarbageCollectionValues {
+	values := []graveler.GarbageCollectionValues{{
+		Expired: testutil.NewValueIteratorFake(g.ExpiredValues),
+		Active:  testutil.NewValueIteratorFake(g.ActiveValues),
+	}}
+	prefixes := make([]string, 0, len(g.PrefixExpiredValues))
+	for prefix := range g.PrefixExpiredValues {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+	for _, prefix := range prefixes {
+		values = append(values, graveler.GarbageCollectionValues{
+			Prefix:  prefix,
+			Expired: testutil.NewValueIteratorFake(g.PrefixExpiredValues[prefix]),
+			Active:  testutil.NewValueIteratorFake(g.PrefixActiveValues[prefix]),
+		})
+	}
+	return values
+}
+
+func (g *FakeGraveler) SaveGarbageCollectionAddresses(_ context.Context, _ graveler.RepositoryID, runID string, addresses []string) (string, error) {
+	if g.Err != nil {
+		return "", g.Err
+	}
+	g.GarbageCollectionAddresses[runID] = addresses
+	return "addresses/" + runID, nil
+}
+
+func (g *FakeGraveler) GetGarbageCollectionAddresses(_ context.Context, _ graveler.RepositoryID, runID string) ([]string, string, error) {
+	addresses, ok := g.GarbageCollectionAddresses[runID]
+	if !ok {
+		return nil, "", graveler.ErrNotFound
+	}
+	return addresses, "addresses/" + runID, nil
+}
+
+func (g *FakeGraveler) ListWrittenValues(_ context.Context, _ graveler.RepositoryID, _ time.Time) (graveler.WrittenValueIterator, error) {
+	if g.Err != nil {
+		return nil, g.Err
+	}
+	return testutil.NewWrittenValueIteratorFake(g.WrittenValues), nil
+}
+
+func (g *FakeGraveler) ForgetWrittenValues(_ context.Context, _ graveler.RepositoryID, values []*graveler.WrittenValue, _ time.Time) ([]*graveler.WrittenValue, error) {
+	if g.Err != nil {
+		return nil, g.Err
+	}
+	type writtenKey struct{ identity, digest string }
+	forget := make(map[writtenKey]struct{}, len(values))
+	for _, value := range values {
+		forget[writtenKey{identity: string(value.Identity), digest: value.DataDigest}] = struct{}{}
+	}
+	var forgotten []*graveler.WrittenValue
+	written := g.WrittenValues[:0]
+	for _, value := range g.WrittenValues {
+		if _, ok := forget[writtenKey{identity: string(value.Identity), digest: value.DataDigest}]; ok {
+			forgotten = append(forgotten, value)
+			continue
+		}
+		written = append(written, value)
+	}
+	g.WrittenValues = written
+	return forgotten, nil
+}
+
+func (g *FakeGraveler) ListStagedValues(_ context.Context, _ graveler.RepositoryID) (graveler.ValueIterator, error) {
+	if g.Err != nil {
+		return nil, g.Err
+	}
+	return testutil.NewValueIteratorFake(g.StagedValues), nil
+}
+
+func (g *FakeGraveler) ListCommittedValues(_ context.Context, _ graveler.RepositoryID) (graveler.ValueIterator, error) {
+	if g.Err != nil {
+		return nil, g.Err
+	}
+	return testutil.NewValueIteratorFake(g.CommittedValues), nil
+}
+
+func (g *FakeGraveler) ListBranchHeadValues(_ context.Context, _ graveler.RepositoryID) (graveler.ValueIterator, error) {
+	if g.Err != nil {
+		return nil, g.Err
+	}
+	return testutil.NewValueIteratorFake(g.HeadValues), nil
+}
+
+func (g *FakeGraveler) ListHeldValues(_ context.Context, _ graveler.RepositoryID) (graveler.ValueIterator, error) {
+	if g.Err != nil {
+		return nil, g.Err
+	}
+	return testutil.NewValueIteratorFake(g.HeldValues), nil
+}
+
+func (g *FakeGraveler) GetGarbageCollectionRules(_ context.Context, _ graveler.RepositoryID) (*graveler.GarbageCollectionRules, error) {
+	if g.Err != nil {
+		return nil, g.Err
+	}
+	return g.GarbageCollectionRules, nil
 }
 
 func (g *FakeGraveler) SetGarbageCollectionRules(ctx context.Context, repositoryID graveler.RepositoryID, rules *graveler.GarbageCollectionRules) error {
@@ -72,6 +219,17 @@ func (g *FakeGraveler) GetMetaRange(ctx context.Context, repositoryID graveler.R
 	panic("implement me")
 }
 
+func (g *FakeGraveler) ListMetaRange(_ context.Context, _ graveler.RepositoryID, metaRangeID graveler.MetaRangeID) (graveler.ValueIterator, error) {
+	if g.Err != nil {
+		return nil, g.Err
+	}
+	values, ok := g.MetaRanges[metaRangeID]
+	if !ok {
+		return nil, graveler.ErrNotFound
+	}
+	return testutil.NewValueIteratorFake(values), nil
+}
+
 func (g *FakeGraveler) GetRange(ctx context.Context, repositoryID graveler.RepositoryID, rangeID graveler.RangeID) (graveler.RangeInfo, error) {
 	panic("implement me")
 }
@@ -92,10 +250,17 @@ func (g *FakeGraveler) Get(_ context.Context, repositoryID graveler.RepositoryID
 	return v, nil
 }
 
-func (g *FakeGraveler) Set(_ context.Context, repositoryID graveler.RepositoryID, branchID graveler.BranchID, key graveler.Key, value graveler.Value, _ ...graveler.WriteConditionOption) error {
+func (g *FakeGraveler) Set(_ context.Context, repositoryID graveler.RepositoryID, branchID graveler.BranchID, key graveler.Key, value graveler.Value, writeConditions ...graveler.WriteConditionOption) error {
 	if g.Err != nil {
 		return g.Err
 	}
+	writeCondition := &graveler.WriteCondition{}
+	for _, cond := range writeConditions {
+		cond(writeCondition)
+	}
+	if writeCondition.ObjectAddress != "" {
+		g.WrittenValues = append(g.WrittenValues, &graveler.WrittenValue{Key: key, Identity: value.Identity, Address: writeCondition.ObjectAddress})
+	}
 	k := fakeGravelerBuildKey(repositoryID, graveler.Ref(branchID.String()), key)
 	g.KeyValue[k] = &value
 	return nil
@@ -113,7 +278,10 @@ func (g *FakeGraveler) List(_ context.Context, _ graveler.RepositoryID, _ gravel
 }
 
 func (g *FakeGraveler) GetRepository(ctx context.Context, repositoryID graveler.RepositoryID) (*graveler.Repository, error) {
-	panic("implement me")
+	if g.Repository == nil {
+		panic("implement me")
+	}
+	return g.Repository, nil
 }
 
 func (g *FakeGraveler) CreateRepository(ctx context.Context, repositoryID graveler.RepositoryID, storageNamespace graveler.StorageNamespace, branchID graveler.BranchID) (*graveler.Repository, error) {
@@ -163,10 +331,18 @@ func (g *FakeGraveler) GetTag(ctx context.Context, repositoryID graveler.Reposit
 	panic("implement me")
 }
 
+func (g *FakeGraveler) GetTagRecord(ctx context.Context, repositoryID graveler.RepositoryID, tagID graveler.TagID) (*graveler.TagRecord, error) {
+	panic("implement me")
+}
+
 func (g *FakeGraveler) CreateTag(ctx context.Context, repositoryID graveler.RepositoryID, tagID graveler.TagID, commitID graveler.CommitID) error {
 	panic("implement me")
 }
 
+func (g *FakeGraveler) CreateAnnotatedTag(ctx context.Context, repositoryID graveler.RepositoryID, tagID graveler.TagID, commitID graveler.CommitID, annotation graveler.TagAnnotation) error {
+	panic("implement me")
+}
+
 func (g *FakeGraveler) DeleteTag(ctx context.Context, repositoryID graveler.RepositoryID, tagID graveler.TagID) error {
 	panic("implement me")
 }
@@ -178,8 +354,17 @@ func (g *FakeGraveler) ListTags(ctx context.Context, repositoryID graveler.Repos
 	return g.TagIteratorFactory(), nil
 }
 
-func (g *FakeGraveler) Log(ctx context.Context, repositoryID graveler.RepositoryID, commitID graveler.CommitID) (graveler.CommitIterator, error) {
-	panic("implement me")
+func (g *FakeGraveler) Log(_ context.Context, _ graveler.RepositoryID, commitID graveler.CommitID) (graveler.CommitIterator, error) {
+	if g.Err != nil {
+		return nil, g.Err
+	}
+	// fake log lists the commits from commitID in the order they were set
+	for i, commit := range g.Commits {
+		if commit.CommitID == commitID {
+			return testutil.NewFakeCommitIterator(g.Commits[i:]), nil
+		}
+	}
+	return nil, graveler.ErrNotFound
 }
 
 func (g *FakeGraveler) ListBranches(_ context.Context, _ graveler.RepositoryID) (graveler.BranchIterator, error) {
@@ -197,14 +382,41 @@ func (g *FakeGraveler) Commit(ctx context.Context, repositoryID graveler.Reposit
 	panic("implement me")
 }
 
-func (g *FakeGraveler) GetCommit(ctx context.Context, repositoryID graveler.RepositoryID, commitID graveler.CommitID) (*graveler.Commit, error) {
+func (g *FakeGraveler) GetCommit(_ context.Context, _ graveler.RepositoryID, commitID graveler.CommitID) (*graveler.Commit, error) {
+	if g.Err != nil {
+		return nil, g.Err
+	}
+	for _, commit := range g.Commits {
+		if commit.CommitID == commitID {
+			return commit.Commit, nil
+		}
+	}
+	return nil, graveler.ErrNotFound
+}
+
+func (g *FakeGraveler) SignCommit(ctx context.Context, repositoryID graveler.RepositoryID, commitID graveler.CommitID, keyID string, signature []byte) error {
 	panic("implement me")
 }
 
-func (g *FakeGraveler) Dereference(ctx context.Context, repositoryID graveler.RepositoryID, ref graveler.Ref) (*graveler.ResolvedRef, error) {
+func (g *FakeGraveler) VerifyCommitSignature(ctx context.Context, commitID graveler.CommitID, commit *graveler.Commit) error {
 	panic("implement me")
 }
 
+func (g *FakeGraveler) DiffSummary(_ context.Context, _ graveler.RepositoryID, _, _ graveler.Ref, _ graveler.DiffSummaryParams) (map[string]graveler.DiffSummary, error) {
+	if g.Err != nil {
+		return nil, g.Err
+	}
+	return g.DiffSummaryResult, nil
+}
+
+func (g *FakeGraveler) Dereference(_ context.Context, _ graveler.RepositoryID, ref graveler.Ref) (*graveler.ResolvedRef, error) {
+	if g.Err != nil {
+		return nil, g.Err
+	}
+	// fake references are commit IDs
+	return &graveler.ResolvedRef{Type: graveler.ReferenceTypeCommit, CommitID: graveler.CommitID(ref)}, nil
+}
+
 func (g *FakeGraveler) Reset(ctx context.Context, repositoryID graveler.RepositoryID, branchID graveler.BranchID) error {
 	panic("implement me")
 }
@@ -221,7 +433,47 @@ func (g *FakeGraveler) Revert(_ context.Context, _ graveler.RepositoryID, _ grav
 	panic("implement me")
 }
 
-func (g *FakeGraveler) Merge(ctx context.Context, repositoryID graveler.RepositoryID, destination graveler.BranchID, source graveler.Ref, _ graveler.CommitParams, strategy string) (graveler.CommitID, error) {
+func (g *FakeGraveler) CherryPick(_ context.Context, _ graveler.RepositoryID, _ graveler.BranchID, _ graveler.Ref, _ int, _ graveler.CommitParams) (graveler.CommitID, error) {
+	panic("implement me")
+}
+
+func (g *FakeGraveler) ListBranchReflog(_ context.Context, _ graveler.RepositoryID, _ graveler.BranchID) (graveler.BranchReflogIterator, error) {
+	panic("implement me")
+}
+
+func (g *FakeGraveler) RestoreBranch(_ context.Context, _ graveler.RepositoryID, _ graveler.BranchID, _ int) (*graveler.Branch, error) {
+	panic("implement me")
+}
+
+func (g *FakeGraveler) StashPush(_ context.Context, _ graveler.RepositoryID, _ graveler.BranchID, _ graveler.StashID) (*graveler.Stash, error) {
+	panic("implement me")
+}
+
+func (g *FakeGraveler) StashApply(_ context.Context, _ graveler.RepositoryID, _ graveler.BranchID, _ graveler.StashID) error {
+	panic("implement me")
+}
+
+func (g *FakeGraveler) StashPop(_ context.Context, _ graveler.RepositoryID, _ graveler.BranchID, _ graveler.StashID) error {
+	panic("implement me")
+}
+
+func (g *FakeGraveler) StashDrop(_ context.Context, _ graveler.RepositoryID, _ graveler.BranchID, _ graveler.StashID) error {
+	panic("implement me")
+}
+
+func (g *FakeGraveler) ListStashes(_ context.Context, _ graveler.RepositoryID, _ graveler.BranchID) (graveler.StashIterator, error) {
+	panic("implement me")
+}
+
+func (g *FakeGraveler) Rebase(_ context.Context, _ graveler.RepositoryID, _ graveler.BranchID, _ graveler.Ref) (graveler.CommitID, error) {
+	panic("implement me")
+}
+
+func (g *FakeGraveler) Merge(ctx context.Context, repositoryID graveler.RepositoryID, destination graveler.BranchID, source graveler.Ref, _ graveler.CommitParams, strategy string, _ []*graveler.MergeStrategyRule, _ graveler.MergeMode) (graveler.CommitID, error) {
+	panic("implement me")
+}
+
+func (g *FakeGraveler) MergePreview(ctx context.Context, repositoryID graveler.RepositoryID, destination graveler.BranchID, source graveler.Ref, strategy string, _ []*graveler.MergeStrategyRule) (*graveler.MergePreview, error) {
 	panic("implement me")
 }
 
@@ -250,10 +502,91 @@ func (g *FakeGraveler) SetHooksHandler(handler graveler.HooksHandler) {
 	g.hooks = handler
 }
 
-func (g *FakeGraveler) AddCommitToBranchHead(ctx context.Context, repositoryID graveler.RepositoryID, branchID graveler.BranchID, commit graveler.Commit) (graveler.CommitID, error) {
+func (g *FakeGraveler) SetSignatureVerifier(verifier graveler.SignatureVerifier) {
 	panic("implement me")
 }
 
+func (g *FakeGraveler) AddCommitToBranchHead(ctx context.Context, _ graveler.RepositoryID, _ graveler.BranchID, commit graveler.Commit) (graveler.CommitID, error) {
+	if g.Err != nil {
+		return "", g.Err
+	}
+	if len(g.BranchHeadErrs) > 0 {
+		err := g.BranchHeadErrs[0]
+		g.BranchHeadErrs = g.BranchHeadErrs[1:]
+		return "", err
+	}
+	g.BranchHeadCommits = append(g.BranchHeadCommits, commit)
+	g.BranchHeadUsers = append(g.BranchHeadUsers, graveler.BranchReflogUserFromContext(ctx))
+	return graveler.CommitID(fmt.Sprintf("commit%d", len(g.BranchHeadCommits))), nil
+}
+
+func (g *FakeGraveler) CreateImport(_ context.Context, _ graveler.RepositoryID, importID graveler.ImportID, imp graveler.Import) error {
+	g.importsMu.Lock()
+	defer g.importsMu.Unlock()
+	if g.Imports == nil {
+		g.Imports = make(map[graveler.ImportID]*graveler.Import)
+	}
+	if _, ok := g.Imports[importID]; ok {
+		return graveler.ErrImportExists
+	}
+	g.Imports[importID] = &imp
+	return nil
+}
+
+func (g *FakeGraveler) GetImport(_ context.Context, _ graveler.RepositoryID, importID graveler.ImportID) (*graveler.Import, error) {
+	g.importsMu.Lock()
+	defer g.importsMu.Unlock()
+	imp, ok := g.Imports[importID]
+	if !ok {
+		return nil, graveler.ErrImportNotFound
+	}
+	result := *imp
+	return &result, nil
+}
+
+func (g *FakeGraveler) UpdateImport(_ context.Context, _ graveler.RepositoryID, importID graveler.ImportID, imp graveler.Import) (*graveler.Import, error) {
+	g.importsMu.Lock()
+	defer g.importsMu.Unlock()
+	current, ok := g.Imports[importID]
+	if !ok {
+		return nil, graveler.ErrImportNotFound
+	}
+	if current.Completed {
+		return nil, graveler.ErrImportCompleted
+	}
+	imp.BranchID = current.BranchID
+	imp.Canceled = current.Canceled
+	imp.CreationDate = current.CreationDate
+	g.Imports[importID] = &imp
+	result := imp
+	return &result, nil
+}
+
+func (g *FakeGraveler) CancelImport(_ context.Context, _ graveler.RepositoryID, importID graveler.ImportID) error {
+	g.importsMu.Lock()
+	defer g.importsMu.Unlock()
+	imp, ok := g.Imports[importID]
+	if !ok {
+		return graveler.ErrImportNotFound
+	}
+	if imp.Completed {
+		return graveler.ErrImportCompleted
+	}
+	imp.Canceled = true
+	return nil
+}
+
+func (g *FakeGraveler) DeleteExpiredImports(_ context.Context, _ graveler.RepositoryID, before time.Time) error {
+	g.importsMu.Lock()
+	defer g.importsMu.Unlock()
+	for id, imp := range g.Imports {
+		if imp.UpdateTime.Before(before) {
+			delete(g.Imports, id)
+		}
+	}
+	return nil
+}
+
 func (g *FakeGraveler) AddCommit(ctx context.Context, repositoryID graveler.RepositoryID, commit graveler.Commit) (graveler.CommitID, error) {
 	panic("implement me")
 }
@@ -262,8 +595,23 @@ func (g *FakeGraveler) AddCommitNoLock(_ context.Context, _ graveler.RepositoryI
 	panic("implement me")
 }
 
-func (g *FakeGraveler) WriteMetaRange(_ context.Context, _ graveler.RepositoryID, _ graveler.ValueIterator) (*graveler.MetaRangeID, error) {
-	panic("implement me")
+func (g *FakeGraveler) WriteMetaRange(_ context.Context, _ graveler.RepositoryID, it graveler.ValueIterator) (*graveler.MetaRangeID, error) {
+	if g.Err != nil {
+		return nil, g.Err
+	}
+	g.MetaRangeValues = nil
+	for it.Next() {
+		g.MetaRangeValues = append(g.MetaRangeValues, *it.Value())
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+	if g.MetaRanges == nil {
+		g.MetaRanges = make(map[graveler.MetaRangeID][]graveler.ValueRecord)
+	}
+	metaRangeID := graveler.MetaRangeID(fmt.Sprintf("metarange%d", len(g.MetaRanges)+1))
+	g.MetaRanges[metaRangeID] = g.MetaRangeValues
+	return &metaRangeID, nil
 }
 
 func (g *FakeGraveler) GetStagingToken(_ context.Context, _ graveler.RepositoryID, _ graveler.BranchID) (*graveler.StagingToken, error) {
@@ -337,8 +685,8 @@ func (m *FakeDiffIterator) Next() bool {
 	return m.Index < len(m.Data)
 }
 
-func (m *FakeDiffIterator) SeekGE(_ graveler.Key) {
-	panic("implement me")
+func (m *FakeDiffIterator) SeekGE(id graveler.Key) {
+	m.Index = sort.Search(len(m.Data), func(i int) bool { return bytes.Compare(m.Data[i].Key, id) >= 0 }) - 1
 }
 
 func (m *FakeDiffIterator) Value() *graveler.Diff {
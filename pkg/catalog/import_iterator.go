@@ -0,0 +1,210 @@
+package catalog
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"github.com/treeverse/lakefs/pkg/block"
+	"github.com/treeverse/lakefs/pkg/graveler"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const walkIteratorBufferSize = 1000
+
+var (
+	ErrUnsortedWalk     = errors.New("object store listing is not sorted")
+	ErrImportSeek       = errors.New("seek is not supported by the import iterator")
+	ErrOutsideOfPrefix  = errors.New("object is outside of the import source")
+	ErrNoImportedObject = errors.New("no objects found under the import source")
+)
+
+// walkValueIterator is a graveler.ValueIterator over the objects under a source prefix of the block adapter.  The
+// key of every object is its path under the source prefix, with a destination prefix prepended.
+type walkValueIterator struct {
+	ch     chan *graveler.ValueRecord
+	cancel context.CancelFunc
+	// walkErr is the error of the walk, set before ch is closed
+	walkErr error
+	value   *graveler.ValueRecord
+	err     error
+	// imported counts the values returned by the iterator
+	imported *int64
+}
+
+// newWalkValueIterator starts walking the objects under source, which must end with a path delimiter, and returns
+// an iterator over their values keyed under destination.  Close the iterator to stop the walk.
+func newWalkValueIterator(ctx context.Context, adapter block.Adapter, source, destination string, imported *int64) (*walkValueIterator, error) {
+	qualifiedPrefix, err := block.ResolveNamespacePrefix(source, "")
+	if err != nil {
+		return nil, fmt.Errorf("source %s: %w", source, graveler.ErrInvalidValue)
+	}
+	sourcePrefix := block.QualifiedKey{
+		StorageType:      qualifiedPrefix.StorageType,
+		StorageNamespace: qualifiedPrefix.StorageNamespace,
+		Key:              qualifiedPrefix.Prefix,
+	}.Format()
+
+	ctx, cancel := context.WithCancel(ctx)
+	it := &walkValueIterator{
+		ch:       make(chan *graveler.ValueRecord, walkIteratorBufferSize),
+		cancel:   cancel,
+		imported: imported,
+	}
+	go func() {
+		defer close(it.ch)
+		it.walkErr = adapter.Walk(ctx, block.WalkOpts{StorageNamespace: source}, func(_ string, entry block.WalkEntry) error {
+			if !strings.HasPrefix(entry.Address, sourcePrefix) {
+				return fmt.Errorf("%s: %w", entry.Address, ErrOutsideOfPrefix)
+			}
+			relativePath := entry.Address[len(sourcePrefix):]
+			// objects ending with a delimiter are directory markers, not data
+			if relativePath == "" || strings.HasSuffix(relativePath, DefaultPathDelimiter) {
+				return nil
+			}
+			ent := &Entry{
+				Address:      entry.Address,
+				AddressType:  Entry_FULL,
+				LastModified: timestamppb.New(entry.LastModified),
+				Size:         entry.Size,
+				ETag:         entry.ETag,
+			}
+			value, err := EntryToValue(ent)
+			if err != nil {
+				return err
+			}
+			record := &graveler.ValueRecord{
+				Key:   graveler.Key(destination + relativePath),
+				Value: value,
+			}
+			select {
+			case it.ch <- record:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+	}()
+	return it, nil
+}
+
+func (w *walkValueIterator) Next() bool {
+	if w.err != nil {
+		return false
+	}
+	record, ok := <-w.ch
+	if !ok {
+		w.value = nil
+		w.err = w.walkErr
+		return false
+	}
+	if w.value != nil && bytes.Compare(record.Key, w.value.Key) <= 0 {
+		w.err = fmt.Errorf("%s after %s: %w", record.Key, w.value.Key, ErrUnsortedWalk)
+		w.value = nil
+		return false
+	}
+	w.value = record
+	atomic.AddInt64(w.imported, 1)
+	return true
+}
+
+func (w *walkValueIterator) SeekGE(graveler.Key) {
+	w.value = nil
+	w.err = ErrImportSeek
+}
+
+func (w *walkValueIterator) Value() *graveler.ValueRecord {
+	return w.value
+}
+
+func (w *walkValueIterator) Err() error {
+	return w.err
+}
+
+func (w *walkValueIterator) Close() {
+	w.cancel()
+}
+
+// prefixReplaceIterator iterates over the values of base, with the values under prefix replaced by the values of
+// replacement.  The keys of replacement must all be under prefix.
+type prefixReplaceIterator struct {
+	base        graveler.ValueIterator
+	replacement graveler.ValueIterator
+	prefix      graveler.Key
+	// replacing is set once base passed the values before prefix, replaced once replacement is done, and done
+	// once no values are left
+	replacing bool
+	replaced  bool
+	done      bool
+	value     *graveler.ValueRecord
+	err       error
+}
+
+func newPrefixReplaceIterator(base, replacement graveler.ValueIterator, prefix graveler.Key) *prefixReplaceIterator {
+	return &prefixReplaceIterator{
+		base:        base,
+		replacement: replacement,
+		prefix:      prefix,
+	}
+}
+
+func (p *prefixReplaceIterator) Next() bool {
+	if p.err != nil || p.done {
+		return false
+	}
+	p.value = nil
+	if !p.replacing {
+		if p.base.Next() && bytes.Compare(p.base.Value().Key, p.prefix) < 0 {
+			p.value = p.base.Value()
+			return true
+		}
+		if p.err = p.base.Err(); p.err != nil {
+			return false
+		}
+		p.replacing = true
+	}
+	if !p.replaced {
+		if p.replacement.Next() {
+			p.value = p.replacement.Value()
+			return true
+		}
+		if p.err = p.replacement.Err(); p.err != nil {
+			return false
+		}
+		p.replaced = true
+		upperBound := graveler.UpperBoundForPrefix(p.prefix)
+		if upperBound == nil {
+			// no key sorts after the values under prefix
+			p.done = true
+			return false
+		}
+		p.base.SeekGE(upperBound)
+	}
+	if p.base.Next() {
+		p.value = p.base.Value()
+		return true
+	}
+	p.err = p.base.Err()
+	return false
+}
+
+func (p *prefixReplaceIterator) SeekGE(graveler.Key) {
+	p.value = nil
+	p.err = ErrImportSeek
+}
+
+func (p *prefixReplaceIterator) Value() *graveler.ValueRecord {
+	return p.value
+}
+
+func (p *prefixReplaceIterator) Err() error {
+	return p.err
+}
+
+func (p *prefixReplaceIterator) Close() {
+	p.base.Close()
+	p.replacement.Close()
+}
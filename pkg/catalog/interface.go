@@ -3,6 +3,7 @@ package catalog
 import (
 	"context"
 	"io"
+	"time"
 
 	"github.com/treeverse/lakefs/pkg/graveler"
 )
@@ -25,6 +26,12 @@ type RevertParams struct {
 	Committer    string
 }
 
+type CherryPickParams struct {
+	Reference    string // the commit to pick
+	ParentNumber int    // if picking a merge commit, the change will be taken relative to this parent number (1-based).
+	Committer    string
+}
+
 type PathRecord struct {
 	Path     Path
 	IsPrefix bool
@@ -36,6 +43,73 @@ type LogParams struct {
 	Limit         int
 }
 
+// DiffSummaryParams selects how DiffSummary aggregates changes
+type DiffSummaryParams struct {
+	// Depth is the number of path elements of the prefixes changes are aggregated by, 0 aggregates all of them
+	Depth int
+	// ThreeDot compares rightReference to the merge base of both references
+	ThreeDot bool
+}
+
+// GarbageCollectionParams controls a garbage collection run
+type GarbageCollectionParams struct {
+	// RunID resumes the run with this ID.  When empty a new run starts, expiring commits according to the
+	// repository garbage collection rules.
+	RunID string
+	// PreviousRunID is the ID of the last completed run, commits it expired are not expired again
+	PreviousRunID string
+	// DryRun saves the addresses of the objects to remove without removing them
+	DryRun bool
+	// Parallelism is the number of objects removed concurrently
+	Parallelism int
+}
+
+// UncommittedGCParams controls a run removing objects that were staged and are no longer referenced
+type UncommittedGCParams struct {
+	// GracePeriod is the time after an object was staged during which it is not removed
+	GracePeriod time.Duration
+	// DryRun counts the objects to remove without removing them
+	DryRun bool
+	// Parallelism is the number of objects removed concurrently
+	Parallelism int
+}
+
+// ImportParams selects the objects Import adds to a branch, and the commit that adds them
+type ImportParams struct {
+	// Source is the object store prefix to import from, e.g. s3://bucket/path/
+	Source string
+	// Destination is the path the objects under Source are imported to on the branch, it replaces the objects
+	// under it
+	Destination string
+	Message     string
+	Committer   string
+	Metadata    Metadata
+}
+
+// ObjectHistoryParams selects the object versions returned by ObjectHistory
+type ObjectHistoryParams struct {
+	// FirstParent follows only the first parent of merge commits
+	FirstParent bool
+	// After skips the versions up to and including the one set by this commit ID
+	After string
+	Limit int
+}
+
+// SearchCommitsParams selects the commits returned by SearchCommits, zero fields match all commits
+type SearchCommitsParams struct {
+	// Metadata matches commits with all of these metadata key/value pairs
+	Metadata  map[string]string
+	Committer string
+	// Message matches commits whose message contains it, ignoring case
+	Message string
+	// Since and Until match commits created at or after Since and before Until
+	Since *time.Time
+	Until *time.Time
+	// After skips the matching commits up to and including this commit ID
+	After string
+	Limit int
+}
+
 type ExpireResult struct {
 	Repository        string
 	Branch            string
@@ -71,9 +145,18 @@ type Interface interface {
 	// GetRepository get repository information
 	GetRepository(ctx context.Context, repository string) (*Repository, error)
 
-	// DeleteRepository delete a repository
+	// DeleteRepository delete a repository, which can be restored until it is purged
 	DeleteRepository(ctx context.Context, repository string) error
 
+	// RestoreRepository restores a deleted repository that was not purged yet
+	RestoreRepository(ctx context.Context, repository string) (*Repository, error)
+
+	// RenameRepository renames a repository, keeping the current name as an alias for aliasPeriod if positive
+	RenameRepository(ctx context.Context, repository string, newName string, aliasPeriod time.Duration) (*Repository, error)
+
+	// GetRepositoryByAlias returns the repository that a previous name of a renamed repository resolves to
+	GetRepositoryByAlias(ctx context.Context, alias string) (*Repository, error)
+
 	// ListRepositories list repositories information, the bool returned is true when more repositories can be listed.
 	// In this case pass the last repository name as 'after' on the next call to ListRepositories
 	ListRepositories(ctx context.Context, limit int, prefix, after string) ([]*Repository, bool, error)
@@ -81,16 +164,40 @@ type Interface interface {
 	GetStagingToken(ctx context.Context, repository string, branch string) (*string, error)
 
 	CreateBranch(ctx context.Context, repository, branch string, sourceRef string) (*CommitLog, error)
+	// CreateEphemeralBranch creates a branch that is deleted once it expires at expiresAt if not nil, or once its
+	// head was not changed for idleTTL if positive
+	CreateEphemeralBranch(ctx context.Context, repository, branch string, sourceRef string, expiresAt *time.Time, idleTTL time.Duration) (*CommitLog, error)
 	DeleteBranch(ctx context.Context, repository, branch string) error
 	ListBranches(ctx context.Context, repository string, prefix string, limit int, after string) ([]*Branch, bool, error)
 	BranchExists(ctx context.Context, repository string, branch string) (bool, error)
 	GetBranchReference(ctx context.Context, repository, branch string) (string, error)
+	GetBranch(ctx context.Context, repository, branch string) (*Branch, error)
 	ResetBranch(ctx context.Context, repository, branch string) error
 
+	// ListBranchReflog lists the changes of the branch head latest first, starting at index 'from'.
+	// The bool returned is true when more entries can be listed.
+	ListBranchReflog(ctx context.Context, repository, branch string, limit, from int) ([]*BranchReflogEntry, bool, error)
+
+	// RestoreBranch points the branch back at its head after the reflog entry at 'index', recreating it when it was
+	// deleted, and returns the commit the branch points to
+	RestoreBranch(ctx context.Context, repository, branch string, index int) (string, error)
+
+	// StashPush moves the uncommitted changes of the branch aside under the stash 'stash'
+	StashPush(ctx context.Context, repository, branch, stash string) (*Stash, error)
+	// StashApply stages the changes of the stash on the branch, returns MergeConflictsError when paths were changed
+	// on the branch since
+	StashApply(ctx context.Context, repository, branch, stash string) error
+	// StashPop applies the stash and drops it
+	StashPop(ctx context.Context, repository, branch, stash string) error
+	StashDrop(ctx context.Context, repository, branch, stash string) error
+	ListStashes(ctx context.Context, repository, branch string, limit int, after string) ([]*Stash, bool, error)
+
 	CreateTag(ctx context.Context, repository, tagID string, ref string) (string, error)
+	// CreateAnnotatedTag creates a tag with a message, tagger and metadata, its creation date is the current time
+	CreateAnnotatedTag(ctx context.Context, repository, tagID string, ref string, message, tagger string, metadata Metadata) (string, error)
 	DeleteTag(ctx context.Context, repository, tagID string) error
 	ListTags(ctx context.Context, repository string, prefix string, limit int, after string) ([]*Tag, bool, error)
-	GetTag(ctx context.Context, repository, tagID string) (string, error)
+	GetTag(ctx context.Context, repository, tagID string) (*Tag, error)
 
 	// GetEntry returns the current entry for path in repository branch reference.  Returns
 	// the entry with ExpiredError if it has expired from underlying storage.
@@ -101,18 +208,47 @@ type Interface interface {
 	ResetEntry(ctx context.Context, repository, branch string, path string) error
 	ResetEntries(ctx context.Context, repository, branch string, prefix string) error
 
-	Commit(ctx context.Context, repository, branch, message, committer string, metadata Metadata, date *int64) (*CommitLog, error)
+	// Commit commits the uncommitted changes of the branch, only the changes under 'prefixes' when any are given
+	Commit(ctx context.Context, repository, branch, message, committer string, metadata Metadata, date *int64, prefixes []string) (*CommitLog, error)
 	GetCommit(ctx context.Context, repository, reference string) (*CommitLog, error)
 	ListCommits(ctx context.Context, repository, branch string, params LogParams) ([]*CommitLog, bool, error)
+	// SearchCommits returns the commits of the repository matching params, latest first. The bool returned is true
+	// when more commits match, pass the last commit ID as params.After to get them.
+	SearchCommits(ctx context.Context, repository string, params SearchCommitsParams) ([]*CommitLog, bool, error)
+	// ObjectHistory returns the versions of the object at path set by the commits in the history of reference,
+	// latest first. The bool returned is true when more versions exist, pass the last commit ID as params.After
+	// to get them.
+	ObjectHistory(ctx context.Context, repository, reference, path string, params ObjectHistoryParams) ([]*ObjectVersion, bool, error)
+
+	// SignCommit stores a signature over the identity of the commit made by the signing key keyID of its committer
+	SignCommit(ctx context.Context, repository, commitID, keyID string, signature []byte) error
 
 	// Revert creates a reverse patch to the given commit, and applies it as a new commit on the given branch.
 	Revert(ctx context.Context, repository, branch string, params RevertParams) error
 
+	// CherryPick applies the changes of the given commit as a new commit on the given branch.
+	CherryPick(ctx context.Context, repository, branch string, params CherryPickParams) (*CommitLog, error)
+
+	// Rebase replays the commits of the given branch that are not found on onto on top of onto, and returns the
+	// commit the branch points to after the rebase.
+	Rebase(ctx context.Context, repository, branch, onto string) (string, error)
+
 	Diff(ctx context.Context, repository, leftReference string, rightReference string, params DiffParams) (Differences, bool, error)
 	Compare(ctx context.Context, repository, leftReference string, rightReference string, params DiffParams) (Differences, bool, error)
+	// DiffSummary counts the committed changes between leftReference and rightReference by path prefix, cut after
+	// depth path elements
+	DiffSummary(ctx context.Context, repository, leftReference, rightReference string, params DiffSummaryParams) ([]PrefixDiffSummary, error)
 	DiffUncommitted(ctx context.Context, repository, branch, prefix, delimiter string, limit int, after string) (Differences, bool, error)
 
-	Merge(ctx context.Context, repository, destinationBranch, sourceRef, committer, message string, metadata Metadata, strategy string) (string, error)
+	Merge(ctx context.Context, repository, destinationBranch, sourceRef, committer, message string, metadata Metadata, strategy string, strategyRules []*graveler.MergeStrategyRule, mode string) (string, error)
+
+	// MergePreview returns the expected outcome of merging sourceRef into destinationBranch, without merging
+	MergePreview(ctx context.Context, repository, destinationBranch, sourceRef, strategy string, strategyRules []*graveler.MergeStrategyRule) (*MergePreview, error)
+
+	// CompareBranch compares branch to baseRef
+	CompareBranch(ctx context.Context, repository, branch, baseRef string) (*BranchComparison, error)
+	// CompareBranches compares each of the branches listed by prefix, limit and after to baseRef
+	CompareBranches(ctx context.Context, repository, baseRef, prefix string, limit int, after string) ([]*BranchComparison, bool, error)
 
 	// dump/load metadata
 	DumpCommits(ctx context.Context, repositoryID string) (string, error)
@@ -129,10 +265,38 @@ type Interface interface {
 	GetGarbageCollectionRules(ctx context.Context, repositoryID string) (*graveler.GarbageCollectionRules, error)
 	SetGarbageCollectionRules(ctx context.Context, repositoryID string, rules *graveler.GarbageCollectionRules) error
 	PrepareExpiredCommits(ctx context.Context, repositoryID string, previousRunID string) (*graveler.GarbageCollectionRunMetadata, error)
+	// RunGarbageCollection removes the objects in the repository storage namespace that only expired commits
+	// reference
+	RunGarbageCollection(ctx context.Context, repositoryID string, params GarbageCollectionParams) (*GarbageCollectionResult, error)
+	// PreviewGarbageCollectionRules estimates the number of objects the default rule and the rule of every prefix
+	// of rules would expire, without expiring them
+	PreviewGarbageCollectionRules(ctx context.Context, repositoryID string, rules *graveler.GarbageCollectionRules) ([]GarbageCollectionRulePreview, error)
+	// CreateLegalHold places the legal hold 'holdID' on the commit of 'ref', or only on the paths under 'path' in
+	// it when it is not empty.  Garbage collection keeps the held objects until the hold is released.
+	CreateLegalHold(ctx context.Context, repositoryID, holdID, ref, path, reason, user string) (*LegalHold, error)
+	ReleaseLegalHold(ctx context.Context, repositoryID, holdID, user string) error
+	GetLegalHold(ctx context.Context, repositoryID, holdID string) (*LegalHold, error)
+	ListLegalHolds(ctx context.Context, repositoryID string) ([]*LegalHold, error)
+	// ListLegalHoldLog lists the legal holds placed and released, latest first
+	ListLegalHoldLog(ctx context.Context, repositoryID string) ([]*LegalHoldLogEntry, error)
+	// Import starts importing the objects under params.Source into the branch and returns the import ID.  The import
+	// runs in the background, and commits the objects under params.Destination once they are all listed.
+	Import(ctx context.Context, repositoryID, branch string, params ImportParams) (string, error)
+	GetImportStatus(ctx context.Context, repositoryID, branch, importID string) (*ImportStatus, error)
+	// CancelImport stops an import that did not complete yet, its objects are not committed
+	CancelImport(ctx context.Context, repositoryID, branch, importID string) error
+	// RemoveUncommittedGarbage removes the objects in the repository storage namespace that were staged, and
+	// that no staging area or commit references
+	RemoveUncommittedGarbage(ctx context.Context, repositoryID string, params UncommittedGCParams) (*UncommittedGCResult, error)
 
 	GetBranchProtectionRules(ctx context.Context, repositoryID string) (*graveler.BranchProtectionRules, error)
 	DeleteBranchProtectionRule(ctx context.Context, repositoryID string, pattern string) error
-	CreateBranchProtectionRule(ctx context.Context, repositoryID string, pattern string, blockedActions []graveler.BranchProtectionBlockedAction) error
+	CreateBranchProtectionRule(ctx context.Context, repositoryID string, pattern string, blockedActions []graveler.BranchProtectionBlockedAction, mergeSourcePattern string, requireSignedCommits bool) error
+	GetTagProtectionRules(ctx context.Context, repositoryID string) (*graveler.TagProtectionRules, error)
+	DeleteTagProtectionRule(ctx context.Context, repositoryID string, pattern string) error
+	CreateTagProtectionRule(ctx context.Context, repositoryID string, pattern string) error
+	GetMergeStrategyRules(ctx context.Context, repositoryID string) (*graveler.MergeStrategyRules, error)
+	SetMergeStrategyRules(ctx context.Context, repositoryID string, rules *graveler.MergeStrategyRules) error
 
 	io.Closer
 }
@@ -43,16 +43,189 @@ type CommitLog struct {
 	Metadata     Metadata  `db:"metadata"`
 	MetaRangeID  string    `db:"meta_range_id"`
 	Parents      []string
+	// Signature is nil for commits that are not signed
+	Signature *CommitSignature
+}
+
+// ObjectVersion is the version of an object set by a commit. Entry is nil when the commit removed the object.
+type ObjectVersion struct {
+	CommitID     string
+	CreationDate time.Time
+	Committer    string
+	Type         DifferenceType
+	Entry        *DBEntry
+}
+
+// CommitSignature is the signing key of a commit signature and whether the signature was verified against it
+type CommitSignature struct {
+	KeyID    string
+	Verified bool
 }
 
 type Branch struct {
 	Name      string `db:"name"`
 	Reference string
+	// ExpiresAt and IdleTTL are set on ephemeral branches, which expire at ExpiresAt or once their head was not
+	// changed for IdleTTL
+	ExpiresAt *time.Time
+	IdleTTL   time.Duration
+}
+
+// BranchReflogEntry is a change of a branch head, OldReference is empty when the change created the branch and
+// NewReference is empty when it deleted the branch
+type BranchReflogEntry struct {
+	Index        int
+	OldReference string
+	NewReference string
+	Operation    string
+	User         string
+	CreationDate time.Time
+}
+
+// Stash holds uncommitted changes of a branch, Reference is the branch head when they were stashed
+type Stash struct {
+	ID           string
+	Reference    string
+	CreationDate time.Time
 }
 
 type Tag struct {
 	ID       string
 	CommitID string
+	// Annotation is nil for lightweight tags
+	Annotation *TagAnnotation
+}
+
+// TagAnnotation is the release information of an annotated tag
+type TagAnnotation struct {
+	Message      string
+	Tagger       string
+	CreationDate time.Time
+	Metadata     Metadata
+}
+
+// MergePreview describes the expected outcome of a merge.  Summary is sorted by prefix.
+type MergePreview struct {
+	SourceCommitID      string
+	DestinationCommitID string
+	BaseCommitID        string
+	FastForward         bool
+	Summary             []MergePreviewSummary
+	Conflicts           []MergeConflict
+	ConflictsTruncated  bool
+}
+
+// MergePreviewSummary counts the changes a merge applies on the destination under a top-level prefix
+type MergePreviewSummary struct {
+	Prefix   string
+	Added    int
+	Removed  int
+	Changed  int
+	Conflict int
+}
+
+// PrefixDiffSummary counts the changes under a path prefix.  AddedBytes and RemovedBytes are the total size of the
+// added and removed objects, ChangedBytes is the size difference of the changed objects.
+type PrefixDiffSummary struct {
+	Prefix       string
+	Added        int
+	Removed      int
+	Changed      int
+	AddedBytes   int64
+	RemovedBytes int64
+	ChangedBytes int64
+}
+
+// GarbageCollectionResult describes a garbage collection run.  Addresses is the number of unreferenced objects
+// the run found, whose addresses are saved at AddressesLocation, and Removed the number of them removed.
+type GarbageCollectionResult struct {
+	RunID             string
+	AddressesLocation string
+	Addresses         int
+	Removed           int
+}
+
+// GarbageCollectionRulePreview estimates the objects that the retention rule of Prefix would expire.  The rule of
+// the empty Prefix is the default rule.
+type GarbageCollectionRulePreview struct {
+	Prefix         string
+	RetentionDays  int
+	ExpiredObjects int
+}
+
+// LegalHold keeps the objects of a commit, or of the paths under Path in the commit when it is not empty, from
+// garbage collection until it is released
+type LegalHold struct {
+	ID           string
+	CommitID     string
+	Path         string
+	Reason       string
+	CreatedBy    string
+	CreationDate time.Time
+}
+
+// LegalHoldLogEntry records a legal hold placed or released by User, Operation is "hold" or "release"
+type LegalHoldLogEntry struct {
+	ID           string
+	Operation    string
+	CommitID     string
+	Path         string
+	Reason       string
+	User         string
+	CreationDate time.Time
+}
+
+// ImportStatus describes an import of the objects under an object store prefix.  ImportedObjects is the number of
+// objects listed so far.  Once Completed, CommitID is the commit that added the objects to the branch, or Error is
+// set when the import failed or was canceled.
+type ImportStatus struct {
+	ID              string
+	Completed       bool
+	ImportedObjects int64
+	MetaRangeID     string
+	CommitID        string
+	Error           error
+	UpdateTime      time.Time
+}
+
+// UncommittedGCResult describes a run removing unreferenced staged objects.  Candidates is the number of objects
+// staged before the grace period that were checked, Unreferenced the number of them that no staging area or commit
+// references, and Removed the number of those removed.
+type UncommittedGCResult struct {
+	Candidates   int
+	Unreferenced int
+	Removed      int
+}
+
+// BranchComparison compares the last commit of a branch to the commit of a base reference.  Added, Removed and
+// Changed count the committed changes on the branch since the merge base.
+type BranchComparison struct {
+	Branch       string
+	CommitID     string
+	BaseCommitID string
+	// MergeBaseID is empty when the branch and the base share no history
+	MergeBaseID string
+	Ahead       int
+	Behind      int
+	Added       int
+	Removed     int
+	Changed     int
+}
+
+// MergeConflict describes a path that could not be merged.  Each side holds the entry found
+// on it, or nil if the path does not exist there.
+type MergeConflict struct {
+	Path        string
+	Source      *MergeConflictEntry
+	Destination *MergeConflictEntry
+	Base        *MergeConflictEntry
+}
+
+type MergeConflictEntry struct {
+	Identity        string
+	PhysicalAddress string
+	Checksum        string
+	Size            int64
 }
 
 // AddressType is the type of an entry address
@@ -42,6 +42,12 @@ const (
 	DefaultCommittedPermanentMinRangeSizeBytes      = 0
 	DefaultCommittedPermanentMaxRangeSizeBytes      = 20 * 1024 * 1024
 	DefaultCommittedPermanentRangeRaggednessEntries = 50_000
+	DefaultCommittedMergeMaxConflicts               = 100
+
+	DefaultGravelerRepositoryDeletionRetention     = 7 * 24 * time.Hour
+	DefaultGravelerRepositoryDeletionPurgeInterval = time.Hour
+	DefaultGravelerBranchExpiryCleanupInterval     = 5 * time.Minute
+	DefaultGravelerUncommittedGCGracePeriod        = 24 * time.Hour
 
 	DefaultBlockStoreGSS3Endpoint = "https://storage.googleapis.com"
 
@@ -147,6 +153,14 @@ const (
 
 	CommittedPebbleSSTableCacheSizeBytesKey = "committed.sstable.memory.cache_size_bytes"
 
+	CommittedMergeMaxConflictsKey = "committed.merge.max_conflicts"
+
+	GravelerRepositoryDeletionRetentionKey     = "graveler.repository_deletion.retention"
+	GravelerRepositoryDeletionPurgeIntervalKey = "graveler.repository_deletion.purge_interval"
+	GravelerBranchExpiryCleanupIntervalKey     = "graveler.branch_expiry.cleanup_interval"
+	GravelerUncommittedGCIntervalKey           = "graveler.uncommitted_gc.interval"
+	GravelerUncommittedGCGracePeriodKey        = "graveler.uncommitted_gc.grace_period"
+
 	GatewaysS3DomainNamesKey = "gateways.s3.domain_name"
 	GatewaysS3RegionKey      = "gateways.s3.region"
 
@@ -197,6 +211,12 @@ func setDefaults() {
 	viper.SetDefault(CommittedPermanentStorageMaxRangeSizeKey, DefaultCommittedPermanentMaxRangeSizeBytes)
 	viper.SetDefault(CommittedPermanentStorageRangeRaggednessKey, DefaultCommittedPermanentRangeRaggednessEntries)
 	viper.SetDefault(CommittedPebbleSSTableCacheSizeBytesKey, DefaultCommittedPebbleSSTableCacheSizeBytes)
+	viper.SetDefault(CommittedMergeMaxConflictsKey, DefaultCommittedMergeMaxConflicts)
+
+	viper.SetDefault(GravelerRepositoryDeletionRetentionKey, DefaultGravelerRepositoryDeletionRetention)
+	viper.SetDefault(GravelerRepositoryDeletionPurgeIntervalKey, DefaultGravelerRepositoryDeletionPurgeInterval)
+	viper.SetDefault(GravelerBranchExpiryCleanupIntervalKey, DefaultGravelerBranchExpiryCleanupInterval)
+	viper.SetDefault(GravelerUncommittedGCGracePeriodKey, DefaultGravelerUncommittedGCGracePeriod)
 
 	viper.SetDefault(GatewaysS3DomainNamesKey, DefaultS3GatewayDomainName)
 	viper.SetDefault(GatewaysS3RegionKey, DefaultS3GatewayRegion)
@@ -443,12 +463,38 @@ func (c *Config) GetCommittedTierFSParams(adapter block.Adapter) (*pyramidparams
 	}, nil
 }
 
+// GetRepositoryDeletionRetention returns how long deleted repositories can be restored before they are purged
+func (c *Config) GetRepositoryDeletionRetention() time.Duration {
+	return c.values.Graveler.RepositoryDeletion.Retention
+}
+
+func (c *Config) GetRepositoryDeletionPurgeInterval() time.Duration {
+	return c.values.Graveler.RepositoryDeletion.PurgeInterval
+}
+
+// GetBranchExpiryCleanupInterval returns the interval between runs deleting expired ephemeral branches
+func (c *Config) GetBranchExpiryCleanupInterval() time.Duration {
+	return c.values.Graveler.BranchExpiry.CleanupInterval
+}
+
+// GetUncommittedGCInterval returns the interval between runs removing staged objects that are no longer
+// referenced, 0 when they should not run in the background
+func (c *Config) GetUncommittedGCInterval() time.Duration {
+	return c.values.Graveler.UncommittedGC.Interval
+}
+
+// GetUncommittedGCGracePeriod returns how long after an object is staged it is kept even if it is not referenced
+func (c *Config) GetUncommittedGCGracePeriod() time.Duration {
+	return c.values.Graveler.UncommittedGC.GracePeriod
+}
+
 func (c *Config) GetCommittedParams() *committed.Params {
 	return &committed.Params{
 		MinRangeSizeBytes:          c.values.Committed.Permanent.MinRangeSizeBytes,
 		MaxRangeSizeBytes:          c.values.Committed.Permanent.MaxRangeSizeBytes,
 		RangeSizeEntriesRaggedness: c.values.Committed.Permanent.RangeRaggednessEntries,
 		MaxUploaders:               c.values.Committed.LocalCache.MaxUploadersPerWriter,
+		MaxMergeConflicts:          c.values.Committed.Merge.MaxConflicts,
 	}
 }
 
@@ -157,6 +157,22 @@ type configuration struct {
 				CacheSizeBytes int64 `mapstructure:"cache_size_bytes"`
 			}
 		}
+		Merge struct {
+			MaxConflicts int `mapstructure:"max_conflicts"`
+		}
+	}
+	Graveler struct {
+		RepositoryDeletion struct {
+			Retention     time.Duration
+			PurgeInterval time.Duration `mapstructure:"purge_interval"`
+		} `mapstructure:"repository_deletion"`
+		BranchExpiry struct {
+			CleanupInterval time.Duration `mapstructure:"cleanup_interval"`
+		} `mapstructure:"branch_expiry"`
+		UncommittedGC struct {
+			Interval    time.Duration
+			GracePeriod time.Duration `mapstructure:"grace_period"`
+		} `mapstructure:"uncommitted_gc"`
 	}
 	Gateways struct {
 		S3 struct {
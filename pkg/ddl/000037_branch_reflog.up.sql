@@ -0,0 +1,18 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS graveler_branch_reflog
+(
+    repository_id text        NOT NULL,
+    branch_id     text        NOT NULL,
+    id            bigserial   NOT NULL,
+
+    old_commit_id text        NOT NULL,
+    new_commit_id text        NOT NULL,
+    operation     text        NOT NULL,
+    user_id       text        NOT NULL,
+    creation_date timestamptz NOT NULL,
+
+    PRIMARY KEY (repository_id, branch_id, id)
+);
+
+COMMIT;
@@ -0,0 +1,16 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS graveler_branch_stashes
+(
+    repository_id text        NOT NULL,
+    branch_id     text        NOT NULL,
+    id            text        NOT NULL,
+
+    staging_token text        NOT NULL,
+    commit_id     text        NOT NULL,
+    creation_date timestamptz NOT NULL,
+
+    PRIMARY KEY (repository_id, branch_id, id)
+);
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+DROP TABLE IF EXISTS auth_user_signing_keys;
+ALTER TABLE graveler_commits
+    DROP COLUMN IF EXISTS signature_key_id,
+    DROP COLUMN IF EXISTS signature;
+COMMIT;
@@ -0,0 +1,19 @@
+BEGIN;
+
+ALTER TABLE graveler_commits
+    ADD COLUMN IF NOT EXISTS signature_key_id text NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS signature bytea;
+
+CREATE TABLE IF NOT EXISTS auth_user_signing_keys
+(
+    user_id    integer     NOT NULL REFERENCES auth_users (id) ON DELETE CASCADE,
+    key_id     text        NOT NULL,
+
+    key_type   text        NOT NULL,
+    public_key text        NOT NULL,
+    created_at timestamptz NOT NULL,
+
+    PRIMARY KEY (user_id, key_id)
+);
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+ALTER TABLE graveler_tags
+    DROP COLUMN IF EXISTS message,
+    DROP COLUMN IF EXISTS tagger,
+    DROP COLUMN IF EXISTS creation_date,
+    DROP COLUMN IF EXISTS metadata;
+COMMIT;
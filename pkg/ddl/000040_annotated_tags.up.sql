@@ -0,0 +1,10 @@
+BEGIN;
+
+-- annotated tags have a creation date, lightweight tags leave the annotation columns empty
+ALTER TABLE graveler_tags
+    ADD COLUMN IF NOT EXISTS message text NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS tagger text NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS creation_date timestamptz,
+    ADD COLUMN IF NOT EXISTS metadata jsonb;
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+DROP TABLE IF EXISTS graveler_repository_aliases;
+DELETE FROM graveler_repositories WHERE deleted_at IS NOT NULL;
+ALTER TABLE graveler_repositories
+    DROP COLUMN IF EXISTS deleted_at;
+COMMIT;
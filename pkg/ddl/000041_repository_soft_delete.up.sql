@@ -0,0 +1,20 @@
+BEGIN;
+
+-- soft-deleted repositories are hidden until restored or purged
+ALTER TABLE graveler_repositories
+    ADD COLUMN IF NOT EXISTS deleted_at timestamptz;
+
+-- previous names of renamed repositories, resolving to the repository until expired
+CREATE TABLE IF NOT EXISTS graveler_repository_aliases
+(
+    alias         text        NOT NULL,
+    repository_id text        NOT NULL,
+    expires_at    timestamptz NOT NULL,
+
+    PRIMARY KEY (alias)
+);
+
+CREATE INDEX IF NOT EXISTS graveler_repository_aliases_repository_id_idx
+    ON graveler_repository_aliases (repository_id);
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+ALTER TABLE graveler_branches
+    DROP COLUMN IF EXISTS expires_at,
+    DROP COLUMN IF EXISTS idle_ttl;
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+
+-- ephemeral branches expire at expires_at, or once their head was not changed for idle_ttl seconds
+ALTER TABLE graveler_branches
+    ADD COLUMN IF NOT EXISTS expires_at timestamptz,
+    ADD COLUMN IF NOT EXISTS idle_ttl   bigint;
+
+COMMIT;
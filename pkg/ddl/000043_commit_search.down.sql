@@ -0,0 +1,6 @@
+BEGIN;
+DROP INDEX IF EXISTS graveler_commits_idx_committer;
+DROP INDEX IF EXISTS graveler_commits_idx_creation_date;
+DROP INDEX IF EXISTS graveler_commit_metadata_uidx;
+DROP TABLE IF EXISTS graveler_commit_metadata;
+COMMIT;
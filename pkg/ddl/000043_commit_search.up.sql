@@ -0,0 +1,24 @@
+BEGIN;
+
+-- metadata key/value pairs of commits, indexed for searching commits by metadata
+CREATE TABLE IF NOT EXISTS graveler_commit_metadata
+(
+    repository_id text NOT NULL,
+    commit_id     text NOT NULL,
+    key           text NOT NULL,
+    value         text NOT NULL
+);
+
+-- keys and values are indexed by their hash, they may exceed the maximal size of an index row
+CREATE UNIQUE INDEX IF NOT EXISTS graveler_commit_metadata_uidx ON graveler_commit_metadata (repository_id, md5(key), md5(value), commit_id);
+
+INSERT INTO graveler_commit_metadata (repository_id, commit_id, key, value)
+SELECT c.repository_id, c.id, m.key, m.value
+FROM graveler_commits c, jsonb_each_text(c.metadata) m
+WHERE jsonb_typeof(c.metadata) = 'object'
+ON CONFLICT DO NOTHING;
+
+CREATE INDEX IF NOT EXISTS graveler_commits_idx_creation_date ON graveler_commits (repository_id, creation_date);
+CREATE INDEX IF NOT EXISTS graveler_commits_idx_committer ON graveler_commits (repository_id, committer);
+
+COMMIT;
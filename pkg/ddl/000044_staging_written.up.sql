@@ -0,0 +1,19 @@
+BEGIN;
+
+-- values written to the staging areas of a repository, kept to find objects that were staged but are no longer
+-- referenced by any staging area or commit
+CREATE TABLE IF NOT EXISTS graveler_staging_written
+(
+    repository_id text        NOT NULL,
+    identity      bytea       NOT NULL,
+    -- values of the same identity differ in their data, e.g. the same content stored at different addresses
+    data_md5      text        NOT NULL,
+    key           bytea       NOT NULL,
+    -- physical address of the object of the value
+    address       text        NOT NULL,
+    written_at    timestamptz NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (repository_id, identity, data_md5)
+);
+
+COMMIT;
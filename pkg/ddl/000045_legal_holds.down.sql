@@ -0,0 +1,4 @@
+BEGIN;
+DROP TABLE IF EXISTS graveler_legal_hold_log;
+DROP TABLE IF EXISTS graveler_legal_holds;
+COMMIT;
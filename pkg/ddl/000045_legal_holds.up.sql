@@ -0,0 +1,35 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS graveler_legal_holds
+(
+    repository_id text        NOT NULL,
+    id            text        NOT NULL,
+
+    commit_id     text        NOT NULL,
+    -- prefix of the held paths, empty to hold the entire commit
+    path          text        NOT NULL,
+    reason        text        NOT NULL,
+    created_by    text        NOT NULL,
+    creation_date timestamptz NOT NULL,
+
+    PRIMARY KEY (repository_id, id)
+);
+
+-- audit log of holds placed and released, kept after holds are released
+CREATE TABLE IF NOT EXISTS graveler_legal_hold_log
+(
+    repository_id text        NOT NULL,
+    id            bigserial   NOT NULL,
+
+    hold_id       text        NOT NULL,
+    operation     text        NOT NULL,
+    commit_id     text        NOT NULL,
+    path          text        NOT NULL,
+    reason        text        NOT NULL,
+    user_id       text        NOT NULL,
+    creation_date timestamptz NOT NULL,
+
+    PRIMARY KEY (repository_id, id)
+);
+
+COMMIT;
@@ -0,0 +1,23 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS graveler_imports
+(
+    repository_id    text        NOT NULL,
+    id               text        NOT NULL,
+
+    branch_id        text        NOT NULL,
+    imported_objects bigint      NOT NULL,
+    completed        boolean     NOT NULL,
+    meta_range_id    text        NOT NULL,
+    commit_id        text        NOT NULL,
+    -- why the import failed, empty unless it completed with an error
+    error            text        NOT NULL,
+    -- set when canceling is requested, the server running the import cancels it
+    canceled         boolean     NOT NULL,
+    creation_date    timestamptz NOT NULL,
+    update_time      timestamptz NOT NULL,
+
+    PRIMARY KEY (repository_id, id)
+);
+
+COMMIT;
@@ -207,7 +207,7 @@ func (c *Collector) rangesStats(ctx context.Context, writer *zip.Writer) error {
 
 	for _, repo := range repos {
 		count := 0
-		counter := func(id string) error {
+		counter := func(string, block.WalkEntry) error {
 			count++
 			return nil
 		}
@@ -17,6 +17,7 @@ import (
 	"github.com/treeverse/lakefs/pkg/gateway/path"
 	"github.com/treeverse/lakefs/pkg/gateway/sig"
 	"github.com/treeverse/lakefs/pkg/gateway/simulator"
+	"github.com/treeverse/lakefs/pkg/graveler"
 	"github.com/treeverse/lakefs/pkg/httputil"
 	"github.com/treeverse/lakefs/pkg/logging"
 	"github.com/treeverse/lakefs/pkg/permissions"
@@ -64,6 +65,8 @@ func AuthenticationHandler(authService simulator.GatewayAuthService, next http.H
 		ctx = logging.AddFields(ctx, logging.Fields{logging.UserFieldKey: user.Username})
 		ctx = context.WithValue(ctx, ContextKeyUser, user)
 		ctx = context.WithValue(ctx, ContextKeyAuthContext, authContext)
+		// record the user on the branch head changes made by the request
+		ctx = graveler.WithBranchReflogUser(ctx, user.Username)
 		req = req.WithContext(ctx)
 		next.ServeHTTP(w, req)
 	})
@@ -144,6 +147,10 @@ func EnrichWithRepositoryOrFallback(c catalog.Interface, authService simulator.G
 			return
 		}
 		repo, err := c.GetRepository(ctx, repoID)
+		if errors.Is(err, catalog.ErrNotFound) {
+			// a previous name of a renamed repository keeps resolving during its grace period
+			repo, err = c.GetRepositoryByAlias(ctx, repoID)
+		}
 		if errors.Is(err, catalog.ErrNotFound) {
 			authResp, authErr := authService.Authorize(ctx, &auth.AuthorizationRequest{
 				Username: username,
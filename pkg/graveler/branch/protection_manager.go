@@ -36,11 +36,17 @@ func NewProtectionManager(settingManager *settings.Manager) *ProtectionManager {
 	return &ProtectionManager{settingManager: settingManager, matchers: cache.NewCache(matcherCacheSize, matcherCacheExpiry, cache.NewJitterFn(matcherCacheJitter))}
 }
 
-func (m *ProtectionManager) Add(ctx context.Context, repositoryID graveler.RepositoryID, branchNamePattern string, blockedActions []graveler.BranchProtectionBlockedAction) error {
+func (m *ProtectionManager) Add(ctx context.Context, repositoryID graveler.RepositoryID, branchNamePattern string, blockedActions []graveler.BranchProtectionBlockedAction, mergeSourcePattern string, requireSignedCommits bool) error {
 	_, err := syntax.Parse(branchNamePattern)
 	if err != nil {
 		return fmt.Errorf("invalid branch pattern syntax: %w", err)
 	}
+	if mergeSourcePattern != "" {
+		_, err = syntax.Parse(mergeSourcePattern)
+		if err != nil {
+			return fmt.Errorf("invalid merge source pattern syntax: %w", err)
+		}
+	}
 	return m.settingManager.UpdateWithLock(ctx, repositoryID, ProtectionSettingKey, &graveler.BranchProtectionRules{}, func(message proto.Message) error {
 		rules := message.(*graveler.BranchProtectionRules)
 		if rules.BranchPatternToBlockedActions == nil {
@@ -49,7 +55,11 @@ func (m *ProtectionManager) Add(ctx context.Context, repositoryID graveler.Repos
 		if _, ok := rules.BranchPatternToBlockedActions[branchNamePattern]; ok {
 			return ErrRuleAlreadyExists
 		}
-		rules.BranchPatternToBlockedActions[branchNamePattern] = &graveler.BranchProtectionBlockedActions{Value: blockedActions}
+		rules.BranchPatternToBlockedActions[branchNamePattern] = &graveler.BranchProtectionBlockedActions{
+			Value:                blockedActions,
+			MergeSourcePattern:   mergeSourcePattern,
+			RequireSignedCommits: requireSignedCommits,
+		}
 		return nil
 	})
 }
@@ -103,21 +113,94 @@ func (m *ProtectionManager) IsBlocked(ctx context.Context, repositoryID graveler
 		return false, err
 	}
 	for pattern, blockedActions := range rules.(*graveler.BranchProtectionRules).BranchPatternToBlockedActions {
-		pattern := pattern
-		matcher, err := m.matchers.GetOrSet(pattern, func() (v interface{}, err error) {
-			return glob.Compile(pattern)
-		})
+		match, err := m.match(pattern, branchID)
+		if err != nil {
+			return false, err
+		}
+		if match && isActionBlocked(blockedActions, action) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *ProtectionManager) IsMergeBlocked(ctx context.Context, repositoryID graveler.RepositoryID, branchID graveler.BranchID, sourceBranchID graveler.BranchID) (bool, error) {
+	rules, err := m.settingManager.Get(ctx, repositoryID, ProtectionSettingKey, &graveler.BranchProtectionRules{})
+	if errors.Is(err, graveler.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	for pattern, blockedActions := range rules.(*graveler.BranchProtectionRules).BranchPatternToBlockedActions {
+		match, err := m.match(pattern, branchID)
 		if err != nil {
 			return false, err
 		}
-		if !matcher.(glob.Glob).Match(string(branchID)) {
+		if !match {
+			continue
+		}
+		if isActionBlocked(blockedActions, graveler.BranchProtectionBlockedAction_MERGE) {
+			return true, nil
+		}
+		sourcePattern := blockedActions.GetMergeSourcePattern()
+		if sourcePattern == "" {
 			continue
 		}
-		for _, c := range blockedActions.GetValue() {
-			if c == action {
-				return true, nil
-			}
+		// merges from a source that is not a branch never match the source pattern
+		if sourceBranchID == "" {
+			return true, nil
+		}
+		match, err = m.match(sourcePattern, sourceBranchID)
+		if err != nil {
+			return false, err
+		}
+		if !match {
+			return true, nil
 		}
 	}
 	return false, nil
 }
+
+func (m *ProtectionManager) IsSignatureRequired(ctx context.Context, repositoryID graveler.RepositoryID, branchID graveler.BranchID) (bool, error) {
+	rules, err := m.settingManager.Get(ctx, repositoryID, ProtectionSettingKey, &graveler.BranchProtectionRules{})
+	if errors.Is(err, graveler.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	for pattern, blockedActions := range rules.(*graveler.BranchProtectionRules).BranchPatternToBlockedActions {
+		if !blockedActions.GetRequireSignedCommits() {
+			continue
+		}
+		match, err := m.match(pattern, branchID)
+		if err != nil {
+			return false, err
+		}
+		if match {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// match returns whether the branch name matches the pattern, caching the compiled pattern
+func (m *ProtectionManager) match(pattern string, branchID graveler.BranchID) (bool, error) {
+	matcher, err := m.matchers.GetOrSet(pattern, func() (v interface{}, err error) {
+		return glob.Compile(pattern)
+	})
+	if err != nil {
+		return false, err
+	}
+	return matcher.(glob.Glob).Match(string(branchID)), nil
+}
+
+func isActionBlocked(blockedActions *graveler.BranchProtectionBlockedActions, action graveler.BranchProtectionBlockedAction) bool {
+	for _, c := range blockedActions.GetValue() {
+		if c == action {
+			return true
+		}
+	}
+	return false
+}
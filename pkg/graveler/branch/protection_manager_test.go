@@ -23,7 +23,7 @@ func TestGet(t *testing.T) {
 	if rule != nil {
 		t.Fatalf("expected nil rule, got %v", rule)
 	}
-	testutil.Must(t, bpm.Add(ctx, "example-repo", "main*", []graveler.BranchProtectionBlockedAction{graveler.BranchProtectionBlockedAction_STAGING_WRITE}))
+	testutil.Must(t, bpm.Add(ctx, "example-repo", "main*", []graveler.BranchProtectionBlockedAction{graveler.BranchProtectionBlockedAction_STAGING_WRITE}, "", false))
 	rule, err = bpm.Get(ctx, "example-repo", "main*")
 	testutil.Must(t, err)
 	if diff := deep.Equal([]graveler.BranchProtectionBlockedAction{graveler.BranchProtectionBlockedAction_STAGING_WRITE}, rule); diff != nil {
@@ -39,8 +39,8 @@ func TestGet(t *testing.T) {
 func TestAddAlreadyExists(t *testing.T) {
 	ctx := context.Background()
 	bpm := prepareTest(t, ctx)
-	testutil.Must(t, bpm.Add(ctx, "example-repo", "main*", []graveler.BranchProtectionBlockedAction{graveler.BranchProtectionBlockedAction_STAGING_WRITE}))
-	err := bpm.Add(ctx, "example-repo", "main*", []graveler.BranchProtectionBlockedAction{graveler.BranchProtectionBlockedAction_COMMIT})
+	testutil.Must(t, bpm.Add(ctx, "example-repo", "main*", []graveler.BranchProtectionBlockedAction{graveler.BranchProtectionBlockedAction_STAGING_WRITE}, "", false))
+	err := bpm.Add(ctx, "example-repo", "main*", []graveler.BranchProtectionBlockedAction{graveler.BranchProtectionBlockedAction_COMMIT}, "", false)
 	if !errors.Is(err, branch.ErrRuleAlreadyExists) {
 		t.Fatalf("expected ErrRuleAlreadyExists, got %v", err)
 	}
@@ -53,7 +53,7 @@ func TestDelete(t *testing.T) {
 	if !errors.Is(err, branch.ErrRuleNotExists) {
 		t.Fatalf("expected ErrRuleNotExists, got %v", err)
 	}
-	testutil.Must(t, bpm.Add(ctx, "example-repo", "main*", []graveler.BranchProtectionBlockedAction{graveler.BranchProtectionBlockedAction_STAGING_WRITE}))
+	testutil.Must(t, bpm.Add(ctx, "example-repo", "main*", []graveler.BranchProtectionBlockedAction{graveler.BranchProtectionBlockedAction_STAGING_WRITE}, "", false))
 	rule, err := bpm.Get(ctx, "example-repo", "main*")
 	testutil.Must(t, err)
 	if diff := deep.Equal([]graveler.BranchProtectionBlockedAction{graveler.BranchProtectionBlockedAction_STAGING_WRITE}, rule); diff != nil {
@@ -104,7 +104,7 @@ func TestIsBlocked(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			bpm := prepareTest(t, ctx)
 			for pattern, blockedActions := range tst.patternToBlockedActions {
-				testutil.Must(t, bpm.Add(ctx, "example-repo", pattern, blockedActions))
+				testutil.Must(t, bpm.Add(ctx, "example-repo", pattern, blockedActions, "", false))
 			}
 			for branchID, expectedBlockedActions := range tst.expectedBlockedActions {
 				for _, action := range expectedBlockedActions {
@@ -129,6 +129,60 @@ func TestIsBlocked(t *testing.T) {
 
 }
 
+func TestIsMergeBlocked(t *testing.T) {
+	ctx := context.Background()
+	bpm := prepareTest(t, ctx)
+	testutil.Must(t, bpm.Add(ctx, "example-repo", "main", []graveler.BranchProtectionBlockedAction{graveler.BranchProtectionBlockedAction_COMMIT}, "release-*", false))
+	testutil.Must(t, bpm.Add(ctx, "example-repo", "frozen*", []graveler.BranchProtectionBlockedAction{graveler.BranchProtectionBlockedAction_MERGE}, "", false))
+	testutil.Must(t, bpm.Add(ctx, "example-repo", "dev", []graveler.BranchProtectionBlockedAction{graveler.BranchProtectionBlockedAction_COMMIT}, "", false))
+	if err := bpm.Add(ctx, "example-repo", "bad", nil, "[", false); err == nil {
+		t.Error("expected an error adding a rule with an invalid merge source pattern")
+	}
+
+	tests := []struct {
+		branch   graveler.BranchID
+		source   graveler.BranchID
+		expected bool
+	}{
+		{branch: "main", source: "release-1", expected: false},
+		{branch: "main", source: "feature", expected: true},
+		{branch: "main", source: "", expected: true},
+		{branch: "frozen1", source: "release-1", expected: true},
+		{branch: "dev", source: "feature", expected: false},
+		{branch: "dev", source: "", expected: false},
+		{branch: "other", source: "feature", expected: false},
+	}
+	for _, tt := range tests {
+		blocked, err := bpm.IsMergeBlocked(ctx, "example-repo", tt.branch, tt.source)
+		testutil.Must(t, err)
+		if blocked != tt.expected {
+			t.Errorf("merge from '%s' into %s blocked=%t, expected=%t", tt.source, tt.branch, blocked, tt.expected)
+		}
+	}
+}
+
+func TestIsSignatureRequired(t *testing.T) {
+	ctx := context.Background()
+	bpm := prepareTest(t, ctx)
+	testutil.Must(t, bpm.Add(ctx, "example-repo", "main", nil, "", true))
+	testutil.Must(t, bpm.Add(ctx, "example-repo", "*", []graveler.BranchProtectionBlockedAction{graveler.BranchProtectionBlockedAction_DELETE}, "", false))
+
+	tests := []struct {
+		branch   graveler.BranchID
+		expected bool
+	}{
+		{branch: "main", expected: true},
+		{branch: "dev", expected: false},
+	}
+	for _, tt := range tests {
+		required, err := bpm.IsSignatureRequired(ctx, "example-repo", tt.branch)
+		testutil.Must(t, err)
+		if required != tt.expected {
+			t.Errorf("branch %s signature required=%t, expected=%t", tt.branch, required, tt.expected)
+		}
+	}
+}
+
 func prepareTest(t *testing.T, ctx context.Context) *branch.ProtectionManager {
 	ctrl := gomock.NewController(t)
 	refManager := mock.NewMockRefManager(ctrl)
@@ -0,0 +1,80 @@
+package graveler
+
+import (
+	"context"
+	"time"
+)
+
+// BranchReflogOperation is the operation that moved a branch head
+type BranchReflogOperation string
+
+const (
+	BranchReflogOperationCreate     BranchReflogOperation = "create"
+	BranchReflogOperationDelete     BranchReflogOperation = "delete"
+	BranchReflogOperationUpdate     BranchReflogOperation = "update"
+	BranchReflogOperationCommit     BranchReflogOperation = "commit"
+	BranchReflogOperationMerge      BranchReflogOperation = "merge"
+	BranchReflogOperationRevert     BranchReflogOperation = "revert"
+	BranchReflogOperationCherryPick BranchReflogOperation = "cherry-pick"
+	BranchReflogOperationRebase     BranchReflogOperation = "rebase"
+	BranchReflogOperationRestore    BranchReflogOperation = "restore"
+	BranchReflogOperationLoad       BranchReflogOperation = "load"
+)
+
+// BranchReflogEntry records a single change of a branch head.
+// OldCommitID is empty for an entry that created the branch, NewCommitID is empty for an entry that deleted it.
+type BranchReflogEntry struct {
+	// Index is the position of the entry in the branch reflog, the latest entry is at index 0
+	Index        int
+	OldCommitID  CommitID
+	NewCommitID  CommitID
+	Operation    BranchReflogOperation
+	User         string
+	CreationDate time.Time
+}
+
+// BranchReflogIterator iterates over the entries of a branch reflog, latest entry first
+type BranchReflogIterator interface {
+	Next() bool
+	SeekGE(index int)
+	Value() *BranchReflogEntry
+	Err() error
+	Close()
+}
+
+type branchReflogContextKey int
+
+const (
+	branchReflogOperationKey branchReflogContextKey = iota
+	branchReflogUserKey
+)
+
+// WithBranchReflogOperation returns a context that records the branch head changes made using it under operation
+func WithBranchReflogOperation(ctx context.Context, operation BranchReflogOperation) context.Context {
+	return context.WithValue(ctx, branchReflogOperationKey, operation)
+}
+
+// BranchReflogOperationFromContext returns the operation set by WithBranchReflogOperation, or defaultOperation
+// when none was set
+func BranchReflogOperationFromContext(ctx context.Context, defaultOperation BranchReflogOperation) BranchReflogOperation {
+	operation, ok := ctx.Value(branchReflogOperationKey).(BranchReflogOperation)
+	if !ok {
+		return defaultOperation
+	}
+	return operation
+}
+
+// BranchReflogSystemUser is the user recorded on branch head changes made by lakeFS itself, such as the cleanup of
+// expired branches, rather than on behalf of a user
+const BranchReflogSystemUser = "lakefs"
+
+// WithBranchReflogUser returns a context that records the branch head changes made using it as made by user
+func WithBranchReflogUser(ctx context.Context, user string) context.Context {
+	return context.WithValue(ctx, branchReflogUserKey, user)
+}
+
+// BranchReflogUserFromContext returns the user set by WithBranchReflogUser, or an empty string when none was set
+func BranchReflogUserFromContext(ctx context.Context) string {
+	user, _ := ctx.Value(branchReflogUserKey).(string)
+	return user
+}
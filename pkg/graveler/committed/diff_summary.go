@@ -0,0 +1,102 @@
+package committed
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/treeverse/lakefs/pkg/graveler"
+)
+
+// SummarizeDiff counts the differences of it by key prefix, cut after params.Depth path elements.
+// Ranges that are the same on both sides are skipped by the diff.  When values are not sized, a range that was added
+// or removed as a whole is counted from its metadata, as long as all its keys share a prefix.
+func SummarizeDiff(it DiffIterator, params graveler.DiffSummaryParams) (map[string]graveler.DiffSummary, error) {
+	summary := make(map[string]graveler.DiffSummary)
+	prefixSummary := func(prefix string) graveler.DiffSummary {
+		s, ok := summary[prefix]
+		if !ok {
+			s = graveler.DiffSummary{Count: make(map[graveler.DiffType]int)}
+			if params.ValueSize != nil {
+				s.SizeDelta = make(map[graveler.DiffType]int64)
+			}
+			summary[prefix] = s
+		}
+		return s
+	}
+	hasNext := it.Next()
+	for hasNext {
+		diff, rangeDiff := it.Value()
+		if diff == nil {
+			if params.ValueSize == nil && rangeDiff != nil && rangeDiff.Type != graveler.DiffTypeChanged {
+				if prefix, ok := rangePrefix(rangeDiff.Range, params.Depth); ok {
+					prefixSummary(prefix).Count[rangeDiff.Type] += int(rangeDiff.Range.Count)
+					hasNext = it.NextRange()
+					continue
+				}
+			}
+			hasNext = it.Next()
+			continue
+		}
+		s := prefixSummary(keyPrefix(diff.Key, params.Depth))
+		s.Count[diff.Type]++
+		if params.ValueSize != nil {
+			delta, err := diffSizeDelta(diff, params.ValueSize)
+			if err != nil {
+				return nil, err
+			}
+			s.SizeDelta[diff.Type] += delta
+		}
+		hasNext = it.Next()
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
+
+func diffSizeDelta(diff *graveler.Diff, valueSize func(*graveler.Value) (int64, error)) (int64, error) {
+	size, err := valueSize(diff.Value)
+	if err != nil {
+		return 0, err
+	}
+	switch diff.Type {
+	case graveler.DiffTypeRemoved:
+		return -size, nil
+	case graveler.DiffTypeChanged:
+		if diff.LeftValue == nil {
+			return 0, nil
+		}
+		leftSize, err := valueSize(diff.LeftValue)
+		if err != nil {
+			return 0, err
+		}
+		return size - leftSize, nil
+	default:
+		return size, nil
+	}
+}
+
+// keyPrefix returns the first depth path elements of key, including their trailing delimiter.  Keys with fewer path
+// elements are aggregated by their parent.
+func keyPrefix(key graveler.Key, depth int) string {
+	end := 0
+	for i := 0; i < depth; i++ {
+		idx := bytes.IndexByte(key[end:], '/')
+		if idx < 0 {
+			break
+		}
+		end += idx + 1
+	}
+	return string(key[:end])
+}
+
+// rangePrefix returns the prefix of all the keys of rng, if they share one.  All the keys between the bounds of the
+// range share their prefix only if it is complete: a prefix of fewer than depth path elements may have keys of longer
+// prefixes between the bounds.
+func rangePrefix(rng *Range, depth int) (string, bool) {
+	prefix := keyPrefix(graveler.Key(rng.MinKey), depth)
+	if prefix != keyPrefix(graveler.Key(rng.MaxKey), depth) {
+		return "", false
+	}
+	return prefix, strings.Count(prefix, "/") == depth
+}
@@ -0,0 +1,79 @@
+package committed_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-test/deep"
+	"github.com/treeverse/lakefs/pkg/graveler"
+	"github.com/treeverse/lakefs/pkg/graveler/committed"
+)
+
+func TestSummarizeDiff(t *testing.T) {
+	const (
+		added   = graveler.DiffTypeAdded
+		removed = graveler.DiffTypeRemoved
+		changed = graveler.DiffTypeChanged
+	)
+	identitySize := func(v *graveler.Value) (int64, error) {
+		return int64(len(v.Identity)), nil
+	}
+	tests := map[string]struct {
+		depth              int
+		valueSize          func(*graveler.Value) (int64, error)
+		expected           map[string]graveler.DiffSummary
+		expectedRightReads []int
+	}{
+		"count by prefix": {
+			depth: 2,
+			expected: map[string]graveler.DiffSummary{
+				"b/":   {Count: map[graveler.DiffType]int{changed: 1}},
+				"c/1/": {Count: map[graveler.DiffType]int{added: 2}},
+				"d/":   {Count: map[graveler.DiffType]int{removed: 1}},
+				"d/2/": {Count: map[graveler.DiffType]int{removed: 1}},
+			},
+			// the added range is counted without reading it
+			expectedRightReads: []int{0, 1, 0},
+		},
+		"count all": {
+			depth: 0,
+			expected: map[string]graveler.DiffSummary{
+				"": {Count: map[graveler.DiffType]int{changed: 1, added: 2, removed: 2}},
+			},
+			expectedRightReads: []int{0, 1, 0},
+		},
+		"size by prefix": {
+			depth:     2,
+			valueSize: identitySize,
+			expected: map[string]graveler.DiffSummary{
+				"b/":   {Count: map[graveler.DiffType]int{changed: 1}, SizeDelta: map[graveler.DiffType]int64{changed: 2}},
+				"c/1/": {Count: map[graveler.DiffType]int{added: 2}, SizeDelta: map[graveler.DiffType]int64{added: 4}},
+				"d/":   {Count: map[graveler.DiffType]int{removed: 1}, SizeDelta: map[graveler.DiffType]int64{removed: -2}},
+				"d/2/": {Count: map[graveler.DiffType]int{removed: 1}, SizeDelta: map[graveler.DiffType]int64{removed: -2}},
+			},
+			expectedRightReads: []int{0, 1, 2},
+		},
+	}
+	for name, tst := range tests {
+		t.Run(name, func(t *testing.T) {
+			left := newFakeMetaRangeIterator(
+				[][]string{{"a/1/x", "a/1/y"}, {"b/1"}, {"d/1", "d/2/z"}},
+				[][]string{{"i1", "i2"}, {"i3"}, {"i7", "i8"}})
+			right := newFakeMetaRangeIterator(
+				[][]string{{"a/1/x", "a/1/y"}, {"b/1"}, {"c/1/p", "c/1/q"}},
+				[][]string{{"i1", "i2"}, {"i3ab"}, {"i5", "i6"}})
+			it := committed.NewDiffIterator(context.Background(), left, right)
+			defer it.Close()
+			summary, err := committed.SummarizeDiff(it, graveler.DiffSummaryParams{Depth: tst.depth, ValueSize: tst.valueSize})
+			if err != nil {
+				t.Fatalf("SummarizeDiff err=%v, expected none", err)
+			}
+			if diff := deep.Equal(summary, tst.expected); diff != nil {
+				t.Error("SummarizeDiff unexpected summary:", diff)
+			}
+			if diff := deep.Equal(right.ReadsByRange(), tst.expectedRightReads); diff != nil {
+				t.Error("SummarizeDiff unexpected reads of right ranges:", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package committed
+
+import (
+	"context"
+	"errors"
+
+	"github.com/treeverse/lakefs/pkg/graveler"
+)
+
+var ErrSeekUnsupported = errors.New("seek is not supported")
+
+// distinctIterator iterates over the values of a sequence of metaranges, reading every range once.
+// Values are ordered by key within each metarange, but not across metaranges.
+type distinctIterator struct {
+	ctx          context.Context
+	manager      MetaRangeManager
+	ns           graveler.StorageNamespace
+	metaRangeIDs []graveler.MetaRangeID
+	skip         map[ID]struct{}
+	it           Iterator
+	value        *graveler.ValueRecord
+	err          error
+}
+
+// NewDistinctIterator returns a ValueIterator over the values of metaRangeIDs that skips ranges it already
+// read, and ranges of the excluded metaranges.
+func NewDistinctIterator(ctx context.Context, manager MetaRangeManager, ns graveler.StorageNamespace, metaRangeIDs, excluded []graveler.MetaRangeID) (graveler.ValueIterator, error) {
+	skip := make(map[ID]struct{})
+	for _, id := range excluded {
+		it, err := manager.NewMetaRangeIterator(ctx, ns, id)
+		if err != nil {
+			return nil, err
+		}
+		// the values of excluded ranges are never needed, only their headers
+		for hasNext := it.Next(); hasNext; hasNext = it.NextRange() {
+			_, rng := it.Value()
+			skip[rng.ID] = struct{}{}
+		}
+		err = it.Err()
+		it.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &distinctIterator{
+		ctx:          ctx,
+		manager:      manager,
+		ns:           ns,
+		metaRangeIDs: metaRangeIDs,
+		skip:         skip,
+	}, nil
+}
+
+func (d *distinctIterator) Next() bool {
+	if d.err != nil {
+		return false
+	}
+	d.value = nil
+	for {
+		if d.it == nil {
+			if len(d.metaRangeIDs) == 0 {
+				return false
+			}
+			d.it, d.err = d.manager.NewMetaRangeIterator(d.ctx, d.ns, d.metaRangeIDs[0])
+			if d.err != nil {
+				return false
+			}
+			d.metaRangeIDs = d.metaRangeIDs[1:]
+		}
+		hasNext := d.it.Next()
+		for hasNext {
+			value, rng := d.it.Value()
+			if value != nil {
+				d.value = value
+				return true
+			}
+			if _, ok := d.skip[rng.ID]; ok {
+				hasNext = d.it.NextRange()
+				continue
+			}
+			d.skip[rng.ID] = struct{}{}
+			hasNext = d.it.Next()
+		}
+		d.err = d.it.Err()
+		d.it.Close()
+		d.it = nil
+		if d.err != nil {
+			return false
+		}
+	}
+}
+
+func (d *distinctIterator) SeekGE(graveler.Key) {
+	d.err = ErrSeekUnsupported
+}
+
+func (d *distinctIterator) Value() *graveler.ValueRecord {
+	return d.value
+}
+
+func (d *distinctIterator) Err() error {
+	return d.err
+}
+
+func (d *distinctIterator) Close() {
+	if d.it != nil {
+		d.it.Close()
+		d.it = nil
+	}
+}
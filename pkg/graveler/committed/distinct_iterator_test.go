@@ -0,0 +1,55 @@
+package committed_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-test/deep"
+	"github.com/golang/mock/gomock"
+	"github.com/treeverse/lakefs/pkg/graveler"
+	"github.com/treeverse/lakefs/pkg/graveler/committed"
+	"github.com/treeverse/lakefs/pkg/graveler/committed/mock"
+	"github.com/treeverse/lakefs/pkg/graveler/testutil"
+)
+
+func TestDistinctIterator(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	iterators := map[graveler.MetaRangeID]*testutil.FakeIterator{
+		"first": newFakeMetaRangeIterator(
+			[][]string{{"a", "b"}, {"c"}},
+			[][]string{{"a1", "b1"}, {"c1"}}),
+		"second": newFakeMetaRangeIterator(
+			[][]string{{"a", "b"}, {"c"}, {"d"}},
+			[][]string{{"a1", "b1"}, {"c2"}, {"d1"}}),
+		"excluded": newFakeMetaRangeIterator(
+			[][]string{{"c"}},
+			[][]string{{"c1"}}),
+	}
+	metaRangeManager := mock.NewMockMetaRangeManager(ctrl)
+	for id, it := range iterators {
+		metaRangeManager.EXPECT().NewMetaRangeIterator(gomock.Any(), gomock.Any(), id).Return(it, nil)
+	}
+
+	it, err := committed.NewDistinctIterator(ctx, metaRangeManager, "ns", []graveler.MetaRangeID{"first", "second"}, []graveler.MetaRangeID{"excluded"})
+	if err != nil {
+		t.Fatalf("NewDistinctIterator err=%v, expected none", err)
+	}
+	defer it.Close()
+	var identities []string
+	for it.Next() {
+		identities = append(identities, string(it.Value().Identity))
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("DistinctIterator err=%v, expected none", err)
+	}
+	// the range shared by both metaranges is read once, and the excluded range is skipped
+	if diff := deep.Equal(identities, []string{"a1", "b1", "c2", "d1"}); diff != nil {
+		t.Error("DistinctIterator unexpected values:", diff)
+	}
+	if diff := deep.Equal(iterators["second"].ReadsByRange(), []int{0, 1, 1}); diff != nil {
+		t.Error("DistinctIterator unexpected reads of second ranges:", diff)
+	}
+}
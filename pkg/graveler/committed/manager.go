@@ -13,11 +13,12 @@ import (
 
 type committedManager struct {
 	metaRangeManager MetaRangeManager
+	params           Params
 	logger           logging.Logger
 }
 
-func NewCommittedManager(m MetaRangeManager) graveler.CommittedManager {
-	return &committedManager{metaRangeManager: m, logger: logging.Default()}
+func NewCommittedManager(m MetaRangeManager, p Params) graveler.CommittedManager {
+	return &committedManager{metaRangeManager: m, params: p, logger: logging.Default()}
 }
 
 func (c *committedManager) Exists(ctx context.Context, ns graveler.StorageNamespace, id graveler.MetaRangeID) (bool, error) {
@@ -56,6 +57,10 @@ func (c *committedManager) List(ctx context.Context, ns graveler.StorageNamespac
 	return NewValueIterator(it), nil
 }
 
+func (c *committedManager) ListDistinct(ctx context.Context, ns graveler.StorageNamespace, metaRangeIDs, excluded []graveler.MetaRangeID) (graveler.ValueIterator, error) {
+	return NewDistinctIterator(ctx, c.metaRangeManager, ns, metaRangeIDs, excluded)
+}
+
 func (c *committedManager) WriteMetaRange(ctx context.Context, ns graveler.StorageNamespace, it graveler.ValueIterator, metadata graveler.Metadata) (*graveler.MetaRangeID, error) {
 	writer := c.metaRangeManager.NewWriter(ctx, ns, metadata)
 	defer func() {
@@ -92,7 +97,22 @@ func (c *committedManager) Diff(ctx context.Context, ns graveler.StorageNamespac
 	return NewDiffValueIterator(ctx, leftIt, rightIt), nil
 }
 
-func (c *committedManager) Merge(ctx context.Context, ns graveler.StorageNamespace, destination, source, base graveler.MetaRangeID, strategy graveler.MergeStrategy) (graveler.MetaRangeID, error) {
+func (c *committedManager) SummarizeDiff(ctx context.Context, ns graveler.StorageNamespace, left, right graveler.MetaRangeID, params graveler.DiffSummaryParams) (map[string]graveler.DiffSummary, error) {
+	leftIt, err := c.metaRangeManager.NewMetaRangeIterator(ctx, ns, left)
+	if err != nil {
+		return nil, err
+	}
+	rightIt, err := c.metaRangeManager.NewMetaRangeIterator(ctx, ns, right)
+	if err != nil {
+		leftIt.Close()
+		return nil, err
+	}
+	it := NewDiffIterator(ctx, leftIt, rightIt)
+	defer it.Close()
+	return SummarizeDiff(it, params)
+}
+
+func (c *committedManager) Merge(ctx context.Context, ns graveler.StorageNamespace, destination, source, base graveler.MetaRangeID, strategy graveler.MergeStrategy, rules []*graveler.MergeStrategyRule) (graveler.MetaRangeID, error) {
 	if source == base {
 		// no changes on source
 		return "", nil
@@ -101,24 +121,6 @@ func (c *committedManager) Merge(ctx context.Context, ns graveler.StorageNamespa
 		// changes introduced only on source
 		return source, nil
 	}
-	baseIt, err := c.metaRangeManager.NewMetaRangeIterator(ctx, ns, base)
-	if err != nil {
-		return "", fmt.Errorf("get base iterator: %w", err)
-	}
-	defer baseIt.Close()
-
-	sourceIt, err := c.metaRangeManager.NewMetaRangeIterator(ctx, ns, source)
-	if err != nil {
-		return "", fmt.Errorf("get source iterator: %w", err)
-	}
-	defer sourceIt.Close()
-
-	destIt, err := c.metaRangeManager.NewMetaRangeIterator(ctx, ns, destination)
-	if err != nil {
-		return "", fmt.Errorf("get destination iterator: %w", err)
-	}
-	defer destIt.Close()
-
 	mwWriter := c.metaRangeManager.NewWriter(ctx, ns, nil)
 	defer func() {
 		err := mwWriter.Abort()
@@ -127,11 +129,8 @@ func (c *committedManager) Merge(ctx context.Context, ns graveler.StorageNamespa
 		}
 	}()
 
-	err = Merge(ctx, mwWriter, baseIt, sourceIt, destIt, strategy)
+	err := c.merge(ctx, ns, mwWriter, destination, source, base, strategy, rules)
 	if err != nil {
-		if !errors.Is(err, graveler.ErrUserVisible) {
-			err = fmt.Errorf("merge ns=%s id=%s: %w", ns, destination, err)
-		}
 		return "", err
 	}
 	newID, err := mwWriter.Close()
@@ -141,6 +140,40 @@ func (c *committedManager) Merge(ctx context.Context, ns graveler.StorageNamespa
 	return *newID, err
 }
 
+func (c *committedManager) CheckMerge(ctx context.Context, ns graveler.StorageNamespace, destination, source, base graveler.MetaRangeID, strategy graveler.MergeStrategy, rules []*graveler.MergeStrategyRule) error {
+	if source == base || destination == base {
+		// no changes on one of the sides, nothing to conflict with
+		return nil
+	}
+	return c.merge(ctx, ns, &discardMetaRangeWriter{}, destination, source, base, strategy, rules)
+}
+
+func (c *committedManager) merge(ctx context.Context, ns graveler.StorageNamespace, writer MetaRangeWriter, destination, source, base graveler.MetaRangeID, strategy graveler.MergeStrategy, rules []*graveler.MergeStrategyRule) error {
+	baseIt, err := c.metaRangeManager.NewMetaRangeIterator(ctx, ns, base)
+	if err != nil {
+		return fmt.Errorf("get base iterator: %w", err)
+	}
+	defer baseIt.Close()
+
+	sourceIt, err := c.metaRangeManager.NewMetaRangeIterator(ctx, ns, source)
+	if err != nil {
+		return fmt.Errorf("get source iterator: %w", err)
+	}
+	defer sourceIt.Close()
+
+	destIt, err := c.metaRangeManager.NewMetaRangeIterator(ctx, ns, destination)
+	if err != nil {
+		return fmt.Errorf("get destination iterator: %w", err)
+	}
+	defer destIt.Close()
+
+	err = Merge(ctx, writer, baseIt, sourceIt, destIt, strategy, rules, c.params.MaxMergeConflicts)
+	if err != nil && !errors.Is(err, graveler.ErrUserVisible) {
+		err = fmt.Errorf("merge ns=%s id=%s: %w", ns, destination, err)
+	}
+	return err
+}
+
 func (c *committedManager) Commit(ctx context.Context, ns graveler.StorageNamespace, baseMetaRangeID graveler.MetaRangeID, changes graveler.ValueIterator) (graveler.MetaRangeID, graveler.DiffSummary, error) {
 	mwWriter := c.metaRangeManager.NewWriter(ctx, ns, nil)
 	defer func() {
@@ -18,7 +18,9 @@ type merger struct {
 	source               Iterator
 	dest                 Iterator
 	haveSource, haveDest bool
-	strategy             graveler.MergeStrategy
+	strategies           *graveler.MergeStrategyMatcher
+	maxConflicts         int
+	conflicts            []graveler.MergeConflict
 }
 
 // getNextGEKey moves base iterator from its current position to the next greater equal value
@@ -67,8 +69,38 @@ func (m *merger) getNextOverlappingFromBase(rangeToOverlap *Range) (*Range, erro
 	return nil, m.base.Err()
 }
 
+// addConflict records a conflict found on key.  Once a conflict is found the merge result is
+// discarded, and the merge continues only to collect more conflicts - until a conflict is found after
+// maxConflicts were collected.
+func (m *merger) addConflict(key graveler.Key, sourceValue, destValue, baseValue *graveler.Value) error {
+	if len(m.conflicts) >= m.maxConflicts {
+		return &graveler.MergeConflictsError{Conflicts: m.conflicts, Truncated: true}
+	}
+	// values point into the buffers of the iterators, which are released once the merge returns
+	m.conflicts = append(m.conflicts, graveler.MergeConflict{
+		Key:         key.Copy(),
+		Source:      copyValue(sourceValue),
+		Destination: copyValue(destValue),
+		Base:        copyValue(baseValue),
+	})
+	return nil
+}
+
+func copyValue(value *graveler.Value) *graveler.Value {
+	if value == nil {
+		return nil
+	}
+	return &graveler.Value{
+		Identity: append([]byte(nil), value.Identity...),
+		Data:     append([]byte(nil), value.Data...),
+	}
+}
+
 // writeRange writes Range using writer
 func (m *merger) writeRange(writeRange *Range) error {
+	if len(m.conflicts) > 0 {
+		return nil
+	}
 	if m.logger.IsTracing() {
 		m.logger.WithFields(logging.Fields{
 			"from": string(writeRange.MinKey),
@@ -84,6 +116,9 @@ func (m *merger) writeRange(writeRange *Range) error {
 
 // writeRecord writes graveler.ValueRecord using writer
 func (m *merger) writeRecord(writeValue *graveler.ValueRecord) error {
+	if len(m.conflicts) > 0 {
+		return nil
+	}
 	if m.logger.IsTracing() {
 		m.logger.WithFields(logging.Fields{
 			"key": string(writeValue.Key),
@@ -105,14 +140,18 @@ func (m *merger) destBeforeSource(destValue *graveler.ValueRecord) error {
 		m.haveDest = m.dest.Next()
 	} else {
 		if baseValue != nil && bytes.Equal(destValue.Key, baseValue.Key) { // deleted by source changed by dest
-			switch m.strategy {
+			switch m.strategies.StrategyFor(destValue.Key) {
 			case graveler.MergeStrategyDest:
 				break
 			case graveler.MergeStrategySource:
 				m.haveDest = m.dest.Next()
 				return nil
 			default: // graveler.MergeStrategyNone
-				return graveler.ErrConflictFound
+				if err := m.addConflict(destValue.Key, nil, destValue.Value, baseValue.Value); err != nil {
+					return err
+				}
+				m.haveDest = m.dest.Next()
+				return nil
 			}
 		}
 		// dest added this record
@@ -134,14 +173,18 @@ func (m *merger) sourceBeforeDest(sourceValue *graveler.ValueRecord) error {
 		m.haveSource = m.source.Next()
 	} else {
 		if baseValue != nil && bytes.Equal(sourceValue.Key, baseValue.Key) { // deleted by dest and changed by source
-			switch m.strategy {
+			switch m.strategies.StrategyFor(sourceValue.Key) {
 			case graveler.MergeStrategyDest:
 				m.haveSource = m.source.Next()
 				return nil
 			case graveler.MergeStrategySource:
 				break
 			default: // graveler.MergeStrategyNone
-				return graveler.ErrConflictFound
+				if err := m.addConflict(sourceValue.Key, sourceValue.Value, nil, baseValue.Value); err != nil {
+					return err
+				}
+				m.haveSource = m.source.Next()
+				return nil
 			}
 		}
 		// source added this record
@@ -157,10 +200,10 @@ func (m *merger) sourceBeforeDest(sourceValue *graveler.ValueRecord) error {
 // handleAll handles the case where only one Iterator from source or dest remains
 // Since the iterator can be for either the source ot the dest range, the function
 // receives a graveler.MergeStrategy parameter - strategyToInclude - to indicate
-// which strategy favors the given range. In case of a conflict, the configured m.strategy
-// is compared to the given strategyToInclude, and if they match - the conflict will
+// which strategy favors the given range. In case of a conflict, the strategy configured
+// for the key is compared to the given strategyToInclude, and if they match - the conflict will
 // be resolved by taking the value from the given range. If not and the configured
-// m.strategy is other than MergeStrategyNone, the record is ignored. If m.strategy is
+// strategy is other than MergeStrategyNone, the record is ignored. If the strategy is
 // MergeStrategyNone - a conflict will be reported
 func (m *merger) handleAll(iter Iterator, strategyToInclude graveler.MergeStrategy) error {
 	for {
@@ -195,12 +238,21 @@ func (m *merger) handleAll(iter Iterator, strategyToInclude graveler.MergeStrate
 			if baseValue == nil || !bytes.Equal(baseValue.Identity, iterValue.Identity) {
 				shouldWrietRecord := true
 				if baseValue != nil && bytes.Equal(baseValue.Key, iterValue.Key) { // deleted by one changed by iter
-					if m.strategy == graveler.MergeStrategyNone { // conflict is only reported if no strategy is selected
-						return graveler.ErrConflictFound
+					strategy := m.strategies.StrategyFor(iterValue.Key)
+					if strategy == graveler.MergeStrategyNone { // conflict is only reported if no strategy is selected
+						var err error
+						if strategyToInclude == graveler.MergeStrategySource {
+							err = m.addConflict(iterValue.Key, iterValue.Value, nil, baseValue.Value)
+						} else {
+							err = m.addConflict(iterValue.Key, nil, iterValue.Value, baseValue.Value)
+						}
+						if err != nil {
+							return err
+						}
 					}
 					// In case of conflict, if the strategy favors the given iter we
 					// still want to write the record. Otherwise it will be ignored.
-					if m.strategy != strategyToInclude {
+					if strategy != strategyToInclude {
 						shouldWrietRecord = false
 					}
 				}
@@ -299,8 +351,8 @@ func (m *merger) handleBothRanges(sourceRange *Range, destRange *Range) error {
 	return nil
 }
 
-func (m *merger) handleConflict(sourceValue *graveler.ValueRecord, destValue *graveler.ValueRecord) error {
-	switch m.strategy {
+func (m *merger) handleConflict(sourceValue *graveler.ValueRecord, destValue *graveler.ValueRecord, baseValue *graveler.ValueRecord) error {
+	switch m.strategies.StrategyFor(sourceValue.Key) {
 	case graveler.MergeStrategyDest:
 		err := m.writeRecord(destValue)
 		if err != nil {
@@ -312,7 +364,13 @@ func (m *merger) handleConflict(sourceValue *graveler.ValueRecord, destValue *gr
 			return fmt.Errorf("write record: %w", err)
 		}
 	default: // graveler.MergeStrategyNone
-		return graveler.ErrConflictFound
+		var base *graveler.Value
+		if baseValue != nil && bytes.Equal(baseValue.Key, sourceValue.Key) {
+			base = baseValue.Value
+		}
+		if err := m.addConflict(sourceValue.Key, sourceValue.Value, destValue.Value, base); err != nil {
+			return err
+		}
 	}
 	m.haveSource = m.source.Next()
 	m.haveDest = m.dest.Next()
@@ -341,7 +399,7 @@ func (m *merger) handleBothKeys(sourceValue *graveler.ValueRecord, destValue *gr
 				case bytes.Equal(destValue.Identity, baseValue.Identity):
 					err = m.writeRecord(sourceValue)
 				default: // both changed the same key
-					return m.handleConflict(sourceValue, destValue)
+					return m.handleConflict(sourceValue, destValue, baseValue)
 				}
 				if err != nil {
 					return fmt.Errorf("write record: %w", err)
@@ -350,7 +408,7 @@ func (m *merger) handleBothKeys(sourceValue *graveler.ValueRecord, destValue *gr
 				m.haveDest = m.dest.Next()
 				return nil
 			} else { // both added the same key with different identity
-				return m.handleConflict(sourceValue, destValue)
+				return m.handleConflict(sourceValue, destValue, nil)
 			}
 		}
 		// record hasn't changed or both added the same record
@@ -477,18 +535,44 @@ func (m *merger) merge() error {
 			return err
 		}
 	}
+	if len(m.conflicts) > 0 {
+		return &graveler.MergeConflictsError{Conflicts: m.conflicts}
+	}
 	return nil
 }
 
-func Merge(ctx context.Context, writer MetaRangeWriter, base Iterator, source Iterator, destination Iterator, strategy graveler.MergeStrategy) error {
+// discardMetaRangeWriter is a MetaRangeWriter that drops everything written to it, used to run a
+// merge only for its conflicts.
+type discardMetaRangeWriter struct{}
+
+func (*discardMetaRangeWriter) WriteRecord(graveler.ValueRecord) error { return nil }
+
+func (*discardMetaRangeWriter) WriteRange(Range) error { return nil }
+
+func (*discardMetaRangeWriter) Close() (*graveler.MetaRangeID, error) { return nil, nil }
+
+func (*discardMetaRangeWriter) Abort() error { return nil }
+
+// Merge merges source into destination using base as the merge base, writing the result to writer.
+// A conflict on a key is resolved by the strategy of the first of rules that matches the key, or else by strategy.
+// On conflicts it returns a *graveler.MergeConflictsError holding up to maxConflicts of the conflicts found.
+func Merge(ctx context.Context, writer MetaRangeWriter, base Iterator, source Iterator, destination Iterator, strategy graveler.MergeStrategy, rules []*graveler.MergeStrategyRule, maxConflicts int) error {
+	if maxConflicts < 1 {
+		maxConflicts = 1
+	}
+	strategies, err := graveler.NewMergeStrategyMatcher(strategy, rules)
+	if err != nil {
+		return err
+	}
 	m := merger{
-		ctx:      ctx,
-		logger:   logging.FromContext(ctx),
-		writer:   writer,
-		base:     base,
-		source:   source,
-		dest:     destination,
-		strategy: strategy,
+		ctx:          ctx,
+		logger:       logging.FromContext(ctx),
+		writer:       writer,
+		base:         base,
+		source:       source,
+		dest:         destination,
+		strategies:   strategies,
+		maxConflicts: maxConflicts,
 	}
 	return m.merge()
 }
@@ -1635,9 +1635,9 @@ func runMergeTests(tests testCases, t *testing.T) {
 					writer.EXPECT().Abort()
 					metaRangeId := graveler.MetaRangeID("merge")
 					writer.EXPECT().Close().Return(&metaRangeId, nil).AnyTimes()
-					committedManager := committed.NewCommittedManager(metaRangeManager)
-					_, err := committedManager.Merge(ctx, "ns", "dest", "source", "base", graveler.MergeStrategy(mergeStrategy))
-					if err != expectedResult.expectedErr {
+					committedManager := committed.NewCommittedManager(metaRangeManager, committed.Params{MaxMergeConflicts: 10})
+					_, err := committedManager.Merge(ctx, "ns", "dest", "source", "base", graveler.MergeStrategy(mergeStrategy), nil)
+					if !errors.Is(err, expectedResult.expectedErr) {
 						t.Fatal(err)
 					}
 				})
@@ -1661,7 +1661,7 @@ func TestMergeCancelContext(t *testing.T) {
 		writer := mock.NewMockMetaRangeWriter(ctrl)
 		ctx, cancel := context.WithCancel(context.Background())
 		cancel()
-		err := committed.Merge(ctx, writer, base, source, destination, graveler.MergeStrategyNone)
+		err := committed.Merge(ctx, writer, base, source, destination, graveler.MergeStrategyNone, nil, 1)
 		assert.True(t, errors.Is(err, context.Canceled), "context canceled error")
 	})
 
@@ -1676,7 +1676,7 @@ func TestMergeCancelContext(t *testing.T) {
 		writer := mock.NewMockMetaRangeWriter(ctrl)
 		ctx, cancel := context.WithCancel(context.Background())
 		cancel()
-		err := committed.Merge(ctx, writer, base, source, destination, graveler.MergeStrategyNone)
+		err := committed.Merge(ctx, writer, base, source, destination, graveler.MergeStrategyNone, nil, 1)
 		assert.True(t, errors.Is(err, context.Canceled), "context canceled error")
 	})
 
@@ -1693,7 +1693,7 @@ func TestMergeCancelContext(t *testing.T) {
 		writer := mock.NewMockMetaRangeWriter(ctrl)
 		ctx, cancel := context.WithCancel(context.Background())
 		cancel()
-		err := committed.Merge(ctx, writer, base, source, destination, graveler.MergeStrategyNone)
+		err := committed.Merge(ctx, writer, base, source, destination, graveler.MergeStrategyNone, nil, 1)
 		assert.True(t, errors.Is(err, context.Canceled), "context canceled error")
 	})
 
@@ -1708,7 +1708,183 @@ func TestMergeCancelContext(t *testing.T) {
 		writer := mock.NewMockMetaRangeWriter(ctrl)
 		ctx, cancel := context.WithCancel(context.Background())
 		cancel()
-		err := committed.Merge(ctx, writer, base, source, destination, graveler.MergeStrategyNone)
+		err := committed.Merge(ctx, writer, base, source, destination, graveler.MergeStrategyNone, nil, 1)
 		assert.True(t, errors.Is(err, context.Canceled), "context canceled error")
 	})
 }
+
+func TestMergeConflicts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	newIterators := func() (committed.Iterator, committed.Iterator, committed.Iterator) {
+		base := testutil.NewFakeIterator().
+			AddRange(&committed.Range{ID: "base", MinKey: committed.Key("a"), MaxKey: committed.Key("d"), Count: 4}).
+			AddValueRecords(makeV("a", "base:a"), makeV("b", "base:b"), makeV("c", "base:c"), makeV("d", "base:d"))
+		source := testutil.NewFakeIterator().
+			AddRange(&committed.Range{ID: "source", MinKey: committed.Key("a"), MaxKey: committed.Key("e"), Count: 4}).
+			AddValueRecords(makeV("a", "source:a"), makeV("b", "base:b"), makeV("c", "source:c"), makeV("e", "source:e"))
+		destination := testutil.NewFakeIterator().
+			AddRange(&committed.Range{ID: "dest", MinKey: committed.Key("a"), MaxKey: committed.Key("e"), Count: 3}).
+			AddValueRecords(makeV("a", "dest:a"), makeV("b", "dest:b"), makeV("e", "dest:e"))
+		return base, source, destination
+	}
+	mergeConflicts := func(maxConflicts int) error {
+		base, source, destination := newIterators()
+		writer := mock.NewMockMetaRangeWriter(ctrl)
+		writer.EXPECT().WriteRecord(gomock.Any()).AnyTimes()
+		writer.EXPECT().WriteRange(gomock.Any()).AnyTimes()
+		return committed.Merge(context.Background(), writer, base, source, destination, graveler.MergeStrategyNone, nil, maxConflicts)
+	}
+
+	t.Run("all", func(t *testing.T) {
+		err := mergeConflicts(10)
+		var conflictsErr *graveler.MergeConflictsError
+		if !errors.As(err, &conflictsErr) {
+			t.Fatalf("Merge() err=%v, expected conflicts error", err)
+		}
+		if !errors.Is(err, graveler.ErrConflictFound) {
+			t.Errorf("Merge() err=%v, expected to wrap %s", err, graveler.ErrConflictFound)
+		}
+		if conflictsErr.Truncated {
+			t.Error("Merge() reported truncated conflicts")
+		}
+		expected := []struct {
+			key, source, dest, base string
+		}{
+			{key: "a", source: "source:a", dest: "dest:a", base: "base:a"},
+			{key: "c", source: "source:c", base: "base:c"},
+			{key: "e", source: "source:e", dest: "dest:e"},
+		}
+		if len(conflictsErr.Conflicts) != len(expected) {
+			t.Fatalf("Merge() got %d conflicts, expected %d: %+v", len(conflictsErr.Conflicts), len(expected), conflictsErr.Conflicts)
+		}
+		identity := func(v *graveler.Value) string {
+			if v == nil {
+				return ""
+			}
+			return string(v.Identity)
+		}
+		for i, e := range expected {
+			c := conflictsErr.Conflicts[i]
+			if string(c.Key) != e.key {
+				t.Errorf("conflict %d key=%s, expected %s", i, c.Key, e.key)
+			}
+			if identity(c.Source) != e.source || identity(c.Destination) != e.dest || identity(c.Base) != e.base {
+				t.Errorf("conflict %d on %s source=%s dest=%s base=%s, expected source=%s dest=%s base=%s", i, c.Key,
+					identity(c.Source), identity(c.Destination), identity(c.Base), e.source, e.dest, e.base)
+			}
+		}
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		err := mergeConflicts(2)
+		var conflictsErr *graveler.MergeConflictsError
+		if !errors.As(err, &conflictsErr) {
+			t.Fatalf("Merge() err=%v, expected conflicts error", err)
+		}
+		if !conflictsErr.Truncated {
+			t.Error("Merge() expected truncated conflicts")
+		}
+		if len(conflictsErr.Conflicts) != 2 {
+			t.Fatalf("Merge() got %d conflicts, expected 2", len(conflictsErr.Conflicts))
+		}
+	})
+
+	t.Run("exact_limit", func(t *testing.T) {
+		err := mergeConflicts(3)
+		var conflictsErr *graveler.MergeConflictsError
+		if !errors.As(err, &conflictsErr) {
+			t.Fatalf("Merge() err=%v, expected conflicts error", err)
+		}
+		if conflictsErr.Truncated {
+			t.Error("Merge() reported truncated conflicts when all conflicts fit the limit")
+		}
+		if len(conflictsErr.Conflicts) != 3 {
+			t.Fatalf("Merge() got %d conflicts, expected 3", len(conflictsErr.Conflicts))
+		}
+	})
+}
+
+func TestMergeStrategyRules(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// conflicts on 'data/a' (changed on both) and 'logs/c' (changed on source, deleted on dest), 'data/b' changed only on dest
+	newIterators := func() (committed.Iterator, committed.Iterator, committed.Iterator) {
+		base := testutil.NewFakeIterator().
+			AddRange(&committed.Range{ID: "base", MinKey: committed.Key("data/a"), MaxKey: committed.Key("logs/c"), Count: 3}).
+			AddValueRecords(makeV("data/a", "base:data/a"), makeV("data/b", "base:data/b"), makeV("logs/c", "base:logs/c"))
+		source := testutil.NewFakeIterator().
+			AddRange(&committed.Range{ID: "source", MinKey: committed.Key("data/a"), MaxKey: committed.Key("logs/c"), Count: 3}).
+			AddValueRecords(makeV("data/a", "source:data/a"), makeV("data/b", "base:data/b"), makeV("logs/c", "source:logs/c"))
+		destination := testutil.NewFakeIterator().
+			AddRange(&committed.Range{ID: "dest", MinKey: committed.Key("data/a"), MaxKey: committed.Key("data/b"), Count: 2}).
+			AddValueRecords(makeV("data/a", "dest:data/a"), makeV("data/b", "dest:data/b"))
+		return base, source, destination
+	}
+
+	t.Run("prefix_and_glob", func(t *testing.T) {
+		base, source, destination := newIterators()
+		writer := mock.NewMockMetaRangeWriter(ctrl)
+		gomock.InOrder(
+			writer.EXPECT().WriteRecord(newRecordMatcher("data/a", "source:data/a")),
+			writer.EXPECT().WriteRecord(newRecordMatcher("data/b", "dest:data/b")),
+		)
+		rules := []*graveler.MergeStrategyRule{
+			{Pattern: "data/", Strategy: "source-wins"},
+			{Pattern: "logs/*", Strategy: "dest-wins"},
+		}
+		err := committed.Merge(context.Background(), writer, base, source, destination, graveler.MergeStrategyNone, rules, 10)
+		if err != nil {
+			t.Fatalf("Merge() err=%v, expected no error", err)
+		}
+	})
+
+	t.Run("first_rule_wins", func(t *testing.T) {
+		base, source, destination := newIterators()
+		writer := mock.NewMockMetaRangeWriter(ctrl)
+		gomock.InOrder(
+			writer.EXPECT().WriteRecord(newRecordMatcher("data/a", "dest:data/a")),
+			writer.EXPECT().WriteRecord(newRecordMatcher("data/b", "dest:data/b")),
+			writer.EXPECT().WriteRecord(newRecordMatcher("logs/c", "source:logs/c")),
+		)
+		rules := []*graveler.MergeStrategyRule{
+			{Pattern: "data/*", Strategy: "dest-wins"},
+			{Pattern: "data/", Strategy: "source-wins"},
+		}
+		err := committed.Merge(context.Background(), writer, base, source, destination, graveler.MergeStrategySource, rules, 10)
+		if err != nil {
+			t.Fatalf("Merge() err=%v, expected no error", err)
+		}
+	})
+
+	t.Run("unmatched_conflict", func(t *testing.T) {
+		base, source, destination := newIterators()
+		writer := mock.NewMockMetaRangeWriter(ctrl)
+		writer.EXPECT().WriteRecord(gomock.Any()).AnyTimes()
+		rules := []*graveler.MergeStrategyRule{
+			{Pattern: "data/", Strategy: "dest-wins"},
+		}
+		err := committed.Merge(context.Background(), writer, base, source, destination, graveler.MergeStrategyNone, rules, 10)
+		var conflictsErr *graveler.MergeConflictsError
+		if !errors.As(err, &conflictsErr) {
+			t.Fatalf("Merge() err=%v, expected conflicts error", err)
+		}
+		if len(conflictsErr.Conflicts) != 1 || string(conflictsErr.Conflicts[0].Key) != "logs/c" {
+			t.Fatalf("Merge() got conflicts %+v, expected only on logs/c", conflictsErr.Conflicts)
+		}
+	})
+
+	t.Run("invalid_rule", func(t *testing.T) {
+		base, source, destination := newIterators()
+		writer := mock.NewMockMetaRangeWriter(ctrl)
+		rules := []*graveler.MergeStrategyRule{
+			{Pattern: "data/", Strategy: "theirs"},
+		}
+		err := committed.Merge(context.Background(), writer, base, source, destination, graveler.MergeStrategyNone, rules, 10)
+		if !errors.Is(err, graveler.ErrInvalidMergeStrategyRule) {
+			t.Fatalf("Merge() err=%v, expected %s", err, graveler.ErrInvalidMergeStrategyRule)
+		}
+	})
+}
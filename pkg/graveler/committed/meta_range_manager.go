@@ -22,6 +22,9 @@ type Params struct {
 	RangeSizeEntriesRaggedness float64
 	// MaxUploaders is the maximal number of uploaders to use in a single metarange writer.
 	MaxUploaders int
+	// MaxMergeConflicts is the maximal number of conflicts to collect and report when a
+	// merge fails.
+	MaxMergeConflicts int
 }
 
 type metaRangeManager struct {
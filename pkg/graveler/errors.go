@@ -18,6 +18,14 @@ var (
 	ErrPreconditionFailed           = errors.New("precondition failed")
 	ErrWriteToProtectedBranch       = wrapError(ErrUserVisible, "cannot write to protected branch")
 	ErrCommitToProtectedBranch      = wrapError(ErrUserVisible, "cannot commit to protected branch")
+	ErrDeleteProtectedBranch        = wrapError(ErrUserVisible, "cannot delete protected branch")
+	ErrResetProtectedBranch         = wrapError(ErrUserVisible, "cannot reset protected branch")
+	ErrRevertProtectedBranch        = wrapError(ErrUserVisible, "cannot revert on protected branch")
+	ErrUpdateProtectedBranch        = wrapError(ErrUserVisible, "cannot update protected branch")
+	ErrMergeToProtectedBranch       = wrapError(ErrUserVisible, "cannot merge to protected branch")
+	ErrDeleteProtectedTag           = wrapError(ErrUserVisible, "cannot delete protected tag")
+	ErrUnsignedToProtectedBranch    = wrapError(ErrUserVisible, "protected branch requires commits with a verified signature")
+	ErrCommitNotSigned              = wrapError(ErrUserVisible, "commit is not signed")
 	ErrInvalidValue                 = fmt.Errorf("invalid value: %w", ErrInvalid)
 	ErrInvalidMergeBase             = fmt.Errorf("only 2 commits allowed in FindMergeBase: %w", ErrInvalidValue)
 	ErrNoMergeBase                  = errors.New("no merge base")
@@ -25,21 +33,37 @@ var (
 	ErrInvalidCommitID              = fmt.Errorf("commit id: %w", ErrInvalidValue)
 	ErrInvalidBranchID              = fmt.Errorf("branch id: %w", ErrInvalidValue)
 	ErrInvalidTagID                 = fmt.Errorf("tag id: %w", ErrInvalidValue)
+	ErrInvalidStashID               = fmt.Errorf("stash id: %w", ErrInvalidValue)
+	ErrInvalidCommitSignature       = fmt.Errorf("commit signature: %w", ErrInvalidValue)
 	ErrInvalid                      = errors.New("validation error")
 	ErrInvalidType                  = fmt.Errorf("invalid type: %w", ErrInvalid)
 	ErrInvalidRepositoryID          = fmt.Errorf("repository id: %w", ErrInvalidValue)
+	ErrInvalidMergeStrategyRule     = fmt.Errorf("merge strategy rule: %w", ErrInvalidValue)
+	ErrInvalidGarbageCollectionRule = fmt.Errorf("garbage collection rule: %w", ErrInvalidValue)
 	ErrRequiredValue                = fmt.Errorf("required value: %w", ErrInvalid)
 	ErrCommitNotFound               = fmt.Errorf("commit %w", ErrNotFound)
 	ErrCreateBranchNoCommit         = fmt.Errorf("can't create a branch without commit")
 	ErrRepositoryNotFound           = fmt.Errorf("repository %w", ErrNotFound)
 	ErrBranchNotFound               = fmt.Errorf("branch %w", ErrNotFound)
 	ErrTagNotFound                  = fmt.Errorf("tag %w", ErrNotFound)
+	ErrBranchReflogEntryNotFound    = fmt.Errorf("branch reflog entry %w", ErrNotFound)
+	ErrRestoreDeletedBranch         = fmt.Errorf("branch reflog entry deleted the branch: %w", ErrInvalidValue)
+	ErrStashNotFound                = fmt.Errorf("stash %w", ErrNotFound)
+	ErrLegalHoldNotFound            = fmt.Errorf("legal hold %w", ErrNotFound)
+	ErrLegalHoldExists              = fmt.Errorf("legal hold already exists: %w", ErrNotUnique)
+	ErrInvalidLegalHoldID           = fmt.Errorf("legal hold id: %w", ErrInvalidValue)
+	ErrDeleteLegalHoldRepository    = wrapError(ErrUserVisible, "cannot delete repository with active legal holds")
+	ErrImportNotFound               = fmt.Errorf("import %w", ErrNotFound)
+	ErrImportExists                 = fmt.Errorf("import already exists: %w", ErrNotUnique)
+	ErrImportCompleted              = wrapError(ErrUserVisible, "import already completed")
 	ErrRefAmbiguous                 = fmt.Errorf("reference is ambiguous: %w", ErrNotFound)
 	ErrNoChanges                    = wrapError(ErrUserVisible, "no changes")
 	ErrConflictFound                = wrapError(ErrUserVisible, "conflict found")
+	ErrNotFastForward               = wrapError(ErrUserVisible, "cannot fast-forward, destination is not an ancestor of source")
 	ErrCommitNotHeadBranch          = wrapError(ErrUserVisible, "commit is not head of branch")
 	ErrBranchExists                 = fmt.Errorf("branch already exists: %w", ErrNotUnique)
 	ErrTagAlreadyExists             = fmt.Errorf("tag already exists: %w", ErrNotUnique)
+	ErrStashExists                  = fmt.Errorf("stash already exists: %w", ErrNotUnique)
 	ErrDirtyBranch                  = wrapError(ErrUserVisible, "uncommitted changes (dirty branch)")
 	ErrMetaRangeNotFound            = errors.New("metarange not found")
 	ErrLockNotAcquired              = errors.New("lock not acquired")
@@ -47,6 +71,8 @@ var (
 	ErrAddCommitNoParent            = errors.New("added commit must have a parent")
 	ErrMultipleParents              = errors.New("cannot have more than a single parent")
 	ErrRevertParentOutOfRange       = errors.New("given commit does not have the given parent number")
+	ErrCherryPickMergeNoParent      = wrapError(ErrUserVisible, "must specify 1-based parent number for cherry-picking merge commit")
+	ErrCherryPickParentOutOfRange   = wrapError(ErrUserVisible, "given commit does not have the given parent number")
 	ErrDereferenceCommitWithStaging = wrapError(ErrUserVisible, "reference to staging area with $ is not a commit")
 	ErrDeleteDefaultBranch          = wrapError(ErrUserVisible, "cannot delete repository default branch")
 )
@@ -71,6 +97,21 @@ func wrapError(err error, msg string) error {
 	return &wrappedError{err: err, msg: msg}
 }
 
+// RebaseConflictError is returned when a rebase stops on a commit whose changes conflict with the new base, holds
+// the commit and the merge error with its conflicting keys.
+type RebaseConflictError struct {
+	CommitID CommitID
+	Err      error
+}
+
+func (e *RebaseConflictError) Error() string {
+	return fmt.Sprintf("rebase commit %s: %s", e.CommitID, e.Err)
+}
+
+func (e *RebaseConflictError) Unwrap() error {
+	return e.Err
+}
+
 // HookAbortError abort by hook error, holds the event type with the run id to trace back the run
 type HookAbortError struct {
 	EventType EventType
@@ -85,3 +126,22 @@ func (e *HookAbortError) Error() string {
 func (e *HookAbortError) Unwrap() error {
 	return e.Err
 }
+
+// MergeConflictsError is returned when a merge fails due to conflicts, holds the conflicting keys found.
+// Truncated is set when the merge stopped collecting conflicts before scanning all the changes.
+type MergeConflictsError struct {
+	Conflicts []MergeConflict
+	Truncated bool
+}
+
+func (e *MergeConflictsError) Error() string {
+	more := ""
+	if e.Truncated {
+		more = " (or more)"
+	}
+	return fmt.Sprintf("%s: %d conflicting keys%s", ErrConflictFound, len(e.Conflicts), more)
+}
+
+func (e *MergeConflictsError) Unwrap() error {
+	return ErrConflictFound
+}
@@ -1,9 +1,11 @@
 package graveler
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -38,26 +40,48 @@ const (
 	RefModTypeCaret  RefModType = '^'
 	RefModTypeAt     RefModType = '@'
 	RefModTypeDollar RefModType = '$'
+	// RefModTypeTime is the '@{<timestamp>}' modifier
+	RefModTypeTime RefModType = '{'
+	// RefModTypeReflog is the '@{<n>}' modifier of a branch, the head of the branch after entry n of its reflog
+	RefModTypeReflog RefModType = '#'
 )
 
 type RefModifier struct {
 	Type  RefModType
 	Value int
+	// Time is the timestamp of a RefModTypeTime modifier
+	Time time.Time
 }
 
 // RawRef is a parsed Ref that includes 'BaseRef' that holds the branch/tag/hash and a list of
 //   ordered modifiers that applied to the reference.
 // Example: master~2 will be parsed into {BaseRef:"master", Modifiers:[{Type:RefModTypeTilde, Value:2}]}
+//   and master@{2021-06-01T00:00:00Z} into {BaseRef:"master", Modifiers:[{Type:RefModTypeTime, Time:2021-06-01T00:00:00Z}]}
+//   and master@{1} into {BaseRef:"master", Modifiers:[{Type:RefModTypeReflog, Value:1}]}
 type RawRef struct {
 	BaseRef   string
 	Modifiers []RefModifier
 }
 
 type DiffSummary struct {
-	Count      map[DiffType]int
+	Count map[DiffType]int
+	// SizeDelta is the change in the total size of the values of each DiffType: the size of added values, minus the
+	// size of removed values, and the size difference of changed values. Only set when values are sized.
+	SizeDelta  map[DiffType]int64
 	Incomplete bool // true when Diff summary has missing Information (could happen when skipping ranges with same bounds)
 }
 
+// DiffSummaryParams selects how DiffSummary aggregates differences
+type DiffSummaryParams struct {
+	// Depth is the number of path elements of the prefixes differences are aggregated by, 0 aggregates all of them
+	Depth int
+	// ThreeDot compares right to the merge base of left and right, similar to a three-dot (left...right) diff in git
+	ThreeDot bool
+	// ValueSize returns the size of a value. When nil, values are not sized, and ranges that were added or removed as
+	// a whole are counted without reading them.
+	ValueSize func(*Value) (int64, error)
+}
+
 // ReferenceType represents the type of the reference
 type ReferenceType uint8
 
@@ -100,6 +124,62 @@ const (
 	MergeStrategySource
 )
 
+// MergeMode controls the commit that a merge adds to the destination branch
+type MergeMode string
+
+const (
+	// MergeModeDefault always creates a merge commit with the destination and source as parents
+	MergeModeDefault MergeMode = ""
+	// MergeModeFastForward moves the destination to the source commit when the destination is an ancestor of
+	// the source, otherwise creates a merge commit
+	MergeModeFastForward MergeMode = "ff"
+	// MergeModeFastForwardOnly moves the destination to the source commit, fails if that is not possible
+	MergeModeFastForwardOnly MergeMode = "ff-only"
+	// MergeModeSquash creates a commit with the merged content and the destination as its only parent
+	MergeModeSquash MergeMode = "squash"
+)
+
+func (m MergeMode) String() string {
+	return string(m)
+}
+
+// MergePreview describes the expected outcome of a merge
+type MergePreview struct {
+	SourceCommitID      CommitID
+	DestinationCommitID CommitID
+	BaseCommitID        CommitID
+	// FastForward is set when the destination is an ancestor of the source
+	FastForward bool
+	// Summary counts the changes that the merge applies on the destination, by top-level prefix
+	Summary            map[string]DiffSummary
+	Conflicts          []MergeConflict
+	ConflictsTruncated bool
+}
+
+// BranchComparison compares the last commit of a branch to the commit of a base reference
+type BranchComparison struct {
+	BranchID     BranchID
+	CommitID     CommitID
+	BaseCommitID CommitID
+	// MergeBaseID is the best common ancestor of the branch and the base, empty when they share no history
+	MergeBaseID CommitID
+	// Ahead counts the commits of the branch that are not in the base
+	Ahead int
+	// Behind counts the commits of the base that are not in the branch
+	Behind int
+	// Summary counts the committed changes on the branch since the merge base
+	Summary DiffSummary
+}
+
+// MergeConflict describes a key that was changed differently on the source and destination of a merge.
+// A nil value means that the key does not exist on that side (deleted, or never added).
+type MergeConflict struct {
+	Key         Key
+	Source      *Value
+	Destination *Value
+	Base        *Value
+}
+
 type MetaRangeInfo struct {
 	// URI of metarange file.
 	Address string
@@ -112,6 +192,8 @@ type RangeInfo struct {
 
 type WriteCondition struct {
 	IfAbsent bool
+	// ObjectAddress is the physical address of the object the written value describes
+	ObjectAddress string
 }
 
 type WriteConditionOption func(condition *WriteCondition)
@@ -122,6 +204,14 @@ func IfAbsent(v bool) WriteConditionOption {
 	}
 }
 
+// WithObjectAddress tracks the written value as describing the object stored at address, so that the object can be
+// found once no staging area or commit references the value
+func WithObjectAddress(address string) WriteConditionOption {
+	return func(condition *WriteCondition) {
+		condition.ObjectAddress = address
+	}
+}
+
 // function/methods receiving the following basic types could assume they passed validation
 
 // StorageNamespace is the URI to the storage location
@@ -171,6 +261,13 @@ type RepositoryRecord struct {
 	*Repository
 }
 
+// DeletedRepositoryRecord is a deleted repository, which can be restored until it is purged
+type DeletedRepositoryRecord struct {
+	RepositoryID RepositoryID `db:"id"`
+	DeletionDate time.Time    `db:"deleted_at"`
+	*Repository
+}
+
 // Value represents metadata or a given object (modified date, physical address, etc)
 type Value struct {
 	Identity []byte `db:"identity"`
@@ -183,6 +280,17 @@ type ValueRecord struct {
 	*Value
 }
 
+// WrittenValue is a value written to a staging area with the address of its object.  It is tracked by the identity
+// of the value and the digest of its data, not by the data itself.
+type WrittenValue struct {
+	Key      Key
+	Identity []byte
+	// DataDigest is the hex encoded MD5 digest of the data of the value
+	DataDigest string
+	// Address is the physical address of the object of the value
+	Address string
+}
+
 func (v *ValueRecord) IsTombstone() bool {
 	return v.Value == nil
 }
@@ -239,6 +347,10 @@ type Commit struct {
 	Parents      CommitParents `db:"parents"`
 	Metadata     Metadata      `db:"metadata"`
 	Generation   int           `db:"generation"`
+	// SignatureKeyID and Signature hold a signature over the commit identity by a signing key of the committer.
+	// They are not part of the commit identity.
+	SignatureKeyID string `db:"signature_key_id"`
+	Signature      []byte `db:"signature"`
 }
 
 func NewCommit() Commit {
@@ -270,6 +382,19 @@ type CommitRecord struct {
 type Branch struct {
 	CommitID     CommitID
 	StagingToken StagingToken
+	// Expiry is kept when the branch is set, and is zero unless the branch was created as an ephemeral branch
+	Expiry BranchExpiry
+}
+
+// BranchExpiry is when an ephemeral branch expires: at ExpiresAt, or once its head was not changed for IdleTTL.
+// The zero value never expires.
+type BranchExpiry struct {
+	ExpiresAt *time.Time
+	IdleTTL   time.Duration
+}
+
+func (e BranchExpiry) IsZero() bool {
+	return e.ExpiresAt == nil && e.IdleTTL == 0
 }
 
 // BranchRecord holds BranchID with the associated Branch data
@@ -278,10 +403,20 @@ type BranchRecord struct {
 	*Branch
 }
 
+// TagAnnotation holds the message, tagger identity, creation time and metadata of an annotated tag
+type TagAnnotation struct {
+	Message      string
+	Tagger       string
+	CreationDate time.Time
+	Metadata     Metadata
+}
+
 // TagRecord holds TagID with the associated Tag data
 type TagRecord struct {
 	TagID    TagID
 	CommitID CommitID
+	// Annotation is nil for lightweight tags
+	Annotation *TagAnnotation
 }
 
 // Diff represents a change in value based on key
@@ -290,6 +425,8 @@ type Diff struct {
 	Key          Key
 	Value        *Value
 	LeftIdentity []byte // the Identity of the value on the left side of the diff
+	// LeftValue is the value on the left side of a changed diff, set by diffs of committed metaranges
+	LeftValue *Value
 }
 
 func (d *Diff) Copy() *Diff {
@@ -298,6 +435,7 @@ func (d *Diff) Copy() *Diff {
 		Key:          d.Key.Copy(),
 		Value:        d.Value,
 		LeftIdentity: append([]byte(nil), d.LeftIdentity...),
+		LeftValue:    d.LeftValue,
 	}
 }
 
@@ -307,6 +445,24 @@ type CommitParams struct {
 	// Date (Unix Epoch in seconds) is used to override commits creation date
 	Date     *int64
 	Metadata Metadata
+	// Prefixes limits the commit to staged entries with keys under any of them, the rest stay staged.
+	// All staged entries are committed when empty.
+	Prefixes []Key
+}
+
+// CommitSearchParams selects the commits returned by SearchCommits, zero fields match all commits
+type CommitSearchParams struct {
+	// Metadata matches commits with all of these metadata key/value pairs
+	Metadata  Metadata
+	Committer string
+	// Message matches commits whose message contains it, ignoring case
+	Message string
+	// Since and Until match commits created at or after Since and before Until
+	Since *time.Time
+	Until *time.Time
+	// After skips the matching commits up to and including this commit
+	After CommitID
+	Limit int
 }
 
 type KeyValueStore interface {
@@ -337,12 +493,39 @@ type VersionController interface {
 	// ListRepositories returns iterator to scan repositories
 	ListRepositories(ctx context.Context) (RepositoryIterator, error)
 
-	// DeleteRepository deletes the repository
+	// DeleteRepository deletes the repository. A deleted repository is hidden, and can be restored using
+	// RestoreRepository until it is purged.  A repository with legal holds cannot be deleted.
 	DeleteRepository(ctx context.Context, repositoryID RepositoryID) error
 
+	// RestoreRepository restores a deleted repository that was not purged yet
+	RestoreRepository(ctx context.Context, repositoryID RepositoryID) error
+
+	// ListDeletedRepositories lists the deleted repositories that were not purged yet
+	ListDeletedRepositories(ctx context.Context) ([]*DeletedRepositoryRecord, error)
+
+	// PurgeRepository permanently deletes the ref metadata of the repository
+	PurgeRepository(ctx context.Context, repositoryID RepositoryID) error
+
+	// RenameRepository renames the repository to newRepositoryID. A non-zero aliasExpiry keeps the current name
+	// resolving to the repository through ResolveRepositoryAlias until that time.
+	RenameRepository(ctx context.Context, repositoryID RepositoryID, newRepositoryID RepositoryID, aliasExpiry time.Time) error
+
+	// ResolveRepositoryAlias returns the repository a previous name of a renamed repository resolves to
+	ResolveRepositoryAlias(ctx context.Context, alias RepositoryID) (RepositoryID, error)
+
+	// DeleteExpiredRepositoryAliases deletes the repository aliases that expired before the given time
+	DeleteExpiredRepositoryAliases(ctx context.Context, before time.Time) error
+
 	// CreateBranch creates branch on repository pointing to ref
 	CreateBranch(ctx context.Context, repositoryID RepositoryID, branchID BranchID, ref Ref) (*Branch, error)
 
+	// CreateEphemeralBranch creates branch on repository pointing to ref, which expires according to expiry
+	CreateEphemeralBranch(ctx context.Context, repositoryID RepositoryID, branchID BranchID, ref Ref, expiry BranchExpiry) (*Branch, error)
+
+	// DeleteExpiredBranches deletes the ephemeral branches of the repository that expired by now, skipping the
+	// default branch and branches protected from deletion. It returns the deleted branches.
+	DeleteExpiredBranches(ctx context.Context, repositoryID RepositoryID, now time.Time) ([]BranchID, error)
+
 	// UpdateBranch updates branch on repository pointing to ref
 	UpdateBranch(ctx context.Context, repositoryID RepositoryID, branchID BranchID, ref Ref) (*Branch, error)
 
@@ -352,9 +535,15 @@ type VersionController interface {
 	// GetTag gets tag's commit id
 	GetTag(ctx context.Context, repositoryID RepositoryID, tagID TagID) (*CommitID, error)
 
+	// GetTagRecord gets tag's commit id and annotation
+	GetTagRecord(ctx context.Context, repositoryID RepositoryID, tagID TagID) (*TagRecord, error)
+
 	// CreateTag creates tag on a repository pointing to a commit id
 	CreateTag(ctx context.Context, repositoryID RepositoryID, tagID TagID, commitID CommitID) error
 
+	// CreateAnnotatedTag creates tag on a repository pointing to a commit id, annotated with a message, tagger and metadata
+	CreateAnnotatedTag(ctx context.Context, repositoryID RepositoryID, tagID TagID, commitID CommitID, annotation TagAnnotation) error
+
 	// DeleteTag remove tag from a repository
 	DeleteTag(ctx context.Context, repositoryID RepositoryID, tagID TagID) error
 
@@ -364,12 +553,22 @@ type VersionController interface {
 	// Log returns an iterator starting at commit ID up to repository root
 	Log(ctx context.Context, repositoryID RepositoryID, commitID CommitID) (CommitIterator, error)
 
+	// SearchCommits returns the commits of the repository matching params, latest first
+	SearchCommits(ctx context.Context, repositoryID RepositoryID, params CommitSearchParams) ([]*CommitRecord, error)
+
 	// ListBranches lists branches on repositories
 	ListBranches(ctx context.Context, repositoryID RepositoryID) (BranchIterator, error)
 
 	// DeleteBranch deletes branch from repository
 	DeleteBranch(ctx context.Context, repositoryID RepositoryID, branchID BranchID) error
 
+	// ListBranchReflog lists the changes of the branch head, latest first. The reflog of a deleted branch is kept.
+	ListBranchReflog(ctx context.Context, repositoryID RepositoryID, branchID BranchID) (BranchReflogIterator, error)
+
+	// RestoreBranch points the branch back at the commit it pointed to after the reflog entry at 'index', creating
+	// the branch when it was deleted. An existing branch must not have uncommitted changes.
+	RestoreBranch(ctx context.Context, repositoryID RepositoryID, branchID BranchID, index int) (*Branch, error)
+
 	// Commit the staged data and returns a commit ID that references that change
 	//   ErrNothingToCommit in case there is no data in stage
 	Commit(ctx context.Context, repositoryID RepositoryID, branchID BranchID, commitParams CommitParams) (CommitID, error)
@@ -390,6 +589,14 @@ type VersionController interface {
 	// GetCommit returns the Commit metadata object for the given CommitID
 	GetCommit(ctx context.Context, repositoryID RepositoryID, commitID CommitID) (*Commit, error)
 
+	// SignCommit stores a signature over the identity of the commit made by the signing key keyID of its committer.
+	// Returns ErrInvalidCommitSignature if the signature cannot be verified.
+	SignCommit(ctx context.Context, repositoryID RepositoryID, commitID CommitID, keyID string, signature []byte) error
+
+	// VerifyCommitSignature returns nil if the commit carries a verified signature, ErrCommitNotSigned if it is
+	// not signed, or ErrInvalidCommitSignature if its signature cannot be verified.
+	VerifyCommitSignature(ctx context.Context, commitID CommitID, commit *Commit) error
+
 	// Dereference returns the resolved ref information based on 'ref' reference
 	Dereference(ctx context.Context, repositoryID RepositoryID, ref Ref) (*ResolvedRef, error)
 
@@ -408,11 +615,50 @@ type VersionController interface {
 	// ResetPrefix throws all staged data starting with the given prefix on the repository / branch
 	ResetPrefix(ctx context.Context, repositoryID RepositoryID, branchID BranchID, key Key) error
 
+	// StashPush sets the staged data of the branch aside under the given stash id, leaving the branch with no staged data.
+	//   ErrNoChanges in case there is no data in stage
+	StashPush(ctx context.Context, repositoryID RepositoryID, branchID BranchID, stashID StashID) (*Stash, error)
+
+	// StashApply stages the data of the given stash on the branch, keeping the stash. The stash is not applied when
+	// any of its keys is staged on the branch, or committed on it since the stash was pushed, with a different value.
+	// It then returns a MergeConflictsError where the stashed value is the source and the branch value is the
+	// destination.
+	StashApply(ctx context.Context, repositoryID RepositoryID, branchID BranchID, stashID StashID) error
+
+	// StashPop applies the given stash like StashApply and drops it
+	StashPop(ctx context.Context, repositoryID RepositoryID, branchID BranchID, stashID StashID) error
+
+	// StashDrop deletes the given stash with its data
+	StashDrop(ctx context.Context, repositoryID RepositoryID, branchID BranchID, stashID StashID) error
+
+	// ListStashes lists the stashes of the branch
+	ListStashes(ctx context.Context, repositoryID RepositoryID, branchID BranchID) (StashIterator, error)
+
 	// Revert creates a reverse patch to the commit given as 'ref', and applies it as a new commit on the given branch.
 	Revert(ctx context.Context, repositoryID RepositoryID, branchID BranchID, ref Ref, parentNumber int, commitParams CommitParams) (CommitID, error)
 
-	// Merge merges 'source' into 'destination' and returns the commit id for the created merge commit.
-	Merge(ctx context.Context, repositoryID RepositoryID, destination BranchID, source Ref, commitParams CommitParams, strategy string) (CommitID, error)
+	// CherryPick applies the changes introduced by the commit given as 'ref' on top of the given branch, as a new commit.
+	CherryPick(ctx context.Context, repositoryID RepositoryID, branchID BranchID, ref Ref, parentNumber int, commitParams CommitParams) (CommitID, error)
+
+	// Rebase replays the commits of the given branch that are not reachable from 'onto' on top of 'onto', in order,
+	// and returns the commit id the branch points to after the rebase. Merge commits are not replayed, producing
+	// linear history. The rebase stops with a RebaseConflictError on the first conflicting commit, leaving the branch
+	// unchanged.
+	Rebase(ctx context.Context, repositoryID RepositoryID, branchID BranchID, onto Ref) (CommitID, error)
+
+	// Merge merges 'source' into 'destination' and returns the commit id that 'destination' points to after the merge.
+	// Conflicts on keys matching 'strategyRules', or else the repository merge strategy rules, are resolved by the
+	// strategy of the first matching rule, other conflicts by 'strategy'.
+	// 'mode' selects between a merge commit, a fast-forward of the branch or a squashed commit.
+	Merge(ctx context.Context, repositoryID RepositoryID, destination BranchID, source Ref, commitParams CommitParams, strategy string, strategyRules []*MergeStrategyRule, mode MergeMode) (CommitID, error)
+
+	// MergePreview returns the expected outcome of merging 'source' into 'destination', without creating a commit
+	// or updating the branch.
+	MergePreview(ctx context.Context, repositoryID RepositoryID, destination BranchID, source Ref, strategy string, strategyRules []*MergeStrategyRule) (*MergePreview, error)
+
+	// CompareBranch compares the last commit of branchID to the commit of base: their merge base, how many commits
+	// each has that the other does not, and the committed changes on the branch since the merge base.
+	CompareBranch(ctx context.Context, repositoryID RepositoryID, branchID BranchID, base Ref) (*BranchComparison, error)
 
 	// DiffUncommitted returns iterator to scan the changes made on the branch
 	DiffUncommitted(ctx context.Context, repositoryID RepositoryID, branchID BranchID) (DiffIterator, error)
@@ -425,9 +671,16 @@ type VersionController interface {
 	// This is similar to a three-dot (from...to) diff in git.
 	Compare(ctx context.Context, repositoryID RepositoryID, left, right Ref) (DiffIterator, error)
 
+	// DiffSummary counts the changes between the commits of 'left' and 'right' by key prefix. Uncommitted changes are
+	// not counted.
+	DiffSummary(ctx context.Context, repositoryID RepositoryID, left, right Ref, params DiffSummaryParams) (map[string]DiffSummary, error)
+
 	// SetHooksHandler set handler for all graveler hooks
 	SetHooksHandler(handler HooksHandler)
 
+	// SetSignatureVerifier set verifier of commit signatures
+	SetSignatureVerifier(verifier SignatureVerifier)
+
 	// GetStagingToken returns the token identifying current staging for branchID of
 	// repositoryID.
 	GetStagingToken(ctx context.Context, repositoryID RepositoryID, branchID BranchID) (*StagingToken, error)
@@ -445,6 +698,81 @@ type VersionController interface {
 	// Note: Ancestors of previously expired commits may still be considered if they can be reached from a non-expired commit.
 	SaveGarbageCollectionCommits(ctx context.Context, repositoryID RepositoryID, previousRunID string) (garbageCollectionRunMetadata *GarbageCollectionRunMetadata, err error)
 
+	// ListGarbageCollectionValues returns, for the default rule and the rule of every prefix, the values of the
+	// commits garbage collection run runID expired according to the rule, except those stored in ranges that an
+	// active commit of the rule also holds, and the values of the active commits of the rule.  Every range is read
+	// once per rule, so values are not ordered by key.
+	ListGarbageCollectionValues(ctx context.Context, repositoryID RepositoryID, runID string) ([]GarbageCollectionValues, error)
+
+	// PreviewGarbageCollectionValues returns the values ListGarbageCollectionValues would return for a run applying
+	// rules, without saving the run.  Commits expired by previous runs are expired again.
+	PreviewGarbageCollectionValues(ctx context.Context, repositoryID RepositoryID, rules *GarbageCollectionRules) ([]GarbageCollectionValues, error)
+
+	// SaveGarbageCollectionAddresses saves the physical addresses that garbage collection run runID found
+	// unreferenced, and returns the location where they were saved.
+	SaveGarbageCollectionAddresses(ctx context.Context, repositoryID RepositoryID, runID string, addresses []string) (string, error)
+
+	// GetGarbageCollectionAddresses returns the physical addresses saved by garbage collection run runID and
+	// their location, or ErrNotFound if the run did not save them yet.
+	GetGarbageCollectionAddresses(ctx context.Context, repositoryID RepositoryID, runID string) ([]string, string, error)
+
+	// ListWrittenValues returns the values written to staging areas of the repository before 'before' that were
+	// not forgotten.  Every value set with an object address is tracked until it is forgotten, even after it is
+	// dropped from staging.
+	ListWrittenValues(ctx context.Context, repositoryID RepositoryID, before time.Time) (WrittenValueIterator, error)
+
+	// ForgetWrittenValues stops tracking the given written values, unless they were written again since 'before',
+	// and returns the values it forgot.
+	ForgetWrittenValues(ctx context.Context, repositoryID RepositoryID, values []*WrittenValue, before time.Time) ([]*WrittenValue, error)
+
+	// ListStagedValues returns the values staged on the branches and stashes of the repository
+	ListStagedValues(ctx context.Context, repositoryID RepositoryID) (ValueIterator, error)
+
+	// ListCommittedValues returns the values of all commits of the repository.  Every range is read once, so
+	// values are not ordered by key.
+	ListCommittedValues(ctx context.Context, repositoryID RepositoryID) (ValueIterator, error)
+
+	// ListBranchHeadValues returns the values of the head commits of the branches of the repository.  Every range
+	// is read once, so values are not ordered by key.
+	ListBranchHeadValues(ctx context.Context, repositoryID RepositoryID) (ValueIterator, error)
+
+	// CreateLegalHold places a legal hold on the data of a commit, or of the objects under a path in the commit,
+	// or returns ErrLegalHoldExists if there is already a hold with the same ID.
+	CreateLegalHold(ctx context.Context, repositoryID RepositoryID, holdID LegalHoldID, hold LegalHold) error
+
+	// ReleaseLegalHold releases the legal hold, recording the user releasing it in the legal hold log
+	ReleaseLegalHold(ctx context.Context, repositoryID RepositoryID, holdID LegalHoldID, user string) error
+
+	// GetLegalHold returns the legal hold, or ErrLegalHoldNotFound
+	GetLegalHold(ctx context.Context, repositoryID RepositoryID, holdID LegalHoldID) (*LegalHold, error)
+
+	// ListLegalHolds lists the legal holds of the repository ordered by ID
+	ListLegalHolds(ctx context.Context, repositoryID RepositoryID) ([]*LegalHoldRecord, error)
+
+	// ListLegalHoldLog lists the legal holds placed and released in the repository, latest first
+	ListLegalHoldLog(ctx context.Context, repositoryID RepositoryID) ([]*LegalHoldLogEntry, error)
+
+	// CreateImport records an import started on the branch of the import, or returns ErrImportExists
+	CreateImport(ctx context.Context, repositoryID RepositoryID, importID ImportID, imp Import) error
+
+	// GetImport returns the import, or ErrImportNotFound
+	GetImport(ctx context.Context, repositoryID RepositoryID, importID ImportID) (*Import, error)
+
+	// UpdateImport updates the progress and the outcome of an import, keeping whether it was canceled, and returns
+	// the updated import.  Returns ErrImportCompleted if the import already completed.
+	UpdateImport(ctx context.Context, repositoryID RepositoryID, importID ImportID, imp Import) (*Import, error)
+
+	// CancelImport requests to cancel the import, the server running it cancels it once it updates it.  Returns
+	// ErrImportCompleted if the import already completed.
+	CancelImport(ctx context.Context, repositoryID RepositoryID, importID ImportID) error
+
+	// DeleteExpiredImports deletes the imports of the repository last updated before 'before'
+	DeleteExpiredImports(ctx context.Context, repositoryID RepositoryID, before time.Time) error
+
+	// ListHeldValues returns the values held by the legal holds of the repository.  Values held by more than one
+	// hold are returned once for each hold.
+	ListHeldValues(ctx context.Context, repositoryID RepositoryID) (ValueIterator, error)
+
 	// GetBranchProtectionRules return all branch protection rules for the repository
 	GetBranchProtectionRules(ctx context.Context, repositoryID RepositoryID) (*BranchProtectionRules, error)
 
@@ -454,7 +782,26 @@ type VersionController interface {
 
 	// CreateBranchProtectionRule creates a rule for the given name pattern,
 	// or returns ErrRuleAlreadyExists if there is already a rule for the pattern.
-	CreateBranchProtectionRule(ctx context.Context, repositoryID RepositoryID, pattern string, blockedActions []BranchProtectionBlockedAction) error
+	// A non-empty mergeSourcePattern permits merges into matching branches only from source branches matching it.
+	// requireSignedCommits permits matching branches to point only to commits with a verified signature.
+	CreateBranchProtectionRule(ctx context.Context, repositoryID RepositoryID, pattern string, blockedActions []BranchProtectionBlockedAction, mergeSourcePattern string, requireSignedCommits bool) error
+
+	// GetTagProtectionRules return all tag protection rules for the repository
+	GetTagProtectionRules(ctx context.Context, repositoryID RepositoryID) (*TagProtectionRules, error)
+
+	// DeleteTagProtectionRule deletes the tag protection rule for the given pattern,
+	// or return ErrRuleNotExists if no such rule exists.
+	DeleteTagProtectionRule(ctx context.Context, repositoryID RepositoryID, pattern string) error
+
+	// CreateTagProtectionRule creates a rule for the given tag name pattern, blocking deletion of matching tags,
+	// or returns ErrRuleAlreadyExists if there is already a rule for the pattern.
+	CreateTagProtectionRule(ctx context.Context, repositoryID RepositoryID, pattern string) error
+
+	// GetMergeStrategyRules returns the merge strategy rules applied by default to merges in the repository
+	GetMergeStrategyRules(ctx context.Context, repositoryID RepositoryID) (*MergeStrategyRules, error)
+
+	// SetMergeStrategyRules replaces the merge strategy rules applied by default to merges in the repository
+	SetMergeStrategyRules(ctx context.Context, repositoryID RepositoryID, rules *MergeStrategyRules) error
 }
 
 // Plumbing includes commands for fiddling more directly with graveler implementation
@@ -462,6 +809,9 @@ type VersionController interface {
 type Plumbing interface {
 	// GetMetaRange returns information where metarangeID is stored.
 	GetMetaRange(ctx context.Context, repositoryID RepositoryID, metaRangeID MetaRangeID) (MetaRangeInfo, error)
+	// ListMetaRange returns an iterator over the values of metaRangeID, such as one written by WriteMetaRange and
+	// not committed yet.
+	ListMetaRange(ctx context.Context, repositoryID RepositoryID, metaRangeID MetaRangeID) (ValueIterator, error)
 	// GetRange returns information where rangeID is stored.
 	GetRange(ctx context.Context, repositoryID RepositoryID, rangeID RangeID) (RangeInfo, error)
 }
@@ -521,6 +871,14 @@ type ValueIterator interface {
 	Close()
 }
 
+// WrittenValueIterator iterates over written values, ordered by identity and data digest
+type WrittenValueIterator interface {
+	Next() bool
+	Value() *WrittenValue
+	Err() error
+	Close()
+}
+
 type DiffIterator interface {
 	Next() bool
 	SeekGE(id Key)
@@ -570,9 +928,30 @@ type RefManager interface {
 	// ListRepositories lists repositories
 	ListRepositories(ctx context.Context) (RepositoryIterator, error)
 
-	// DeleteRepository deletes the repository
+	// DeleteRepository permanently deletes the repository and its ref metadata, keeping its legal hold log.  It
+	// returns ErrDeleteLegalHoldRepository if the repository has legal holds.
 	DeleteRepository(ctx context.Context, repositoryID RepositoryID) error
 
+	// SoftDeleteRepository hides the repository, keeping its ref metadata until it is permanently deleted
+	SoftDeleteRepository(ctx context.Context, repositoryID RepositoryID) error
+
+	// RestoreRepository restores a soft-deleted repository
+	RestoreRepository(ctx context.Context, repositoryID RepositoryID) error
+
+	// ListDeletedRepositories lists the soft-deleted repositories
+	ListDeletedRepositories(ctx context.Context) ([]*DeletedRepositoryRecord, error)
+
+	// RenameRepository renames the repository to newRepositoryID, adding an alias of the current name until aliasExpiry
+	// unless it is zero
+	RenameRepository(ctx context.Context, repositoryID RepositoryID, newRepositoryID RepositoryID, aliasExpiry time.Time) error
+
+	// ResolveRepositoryAlias returns the repository the alias resolves to, or ErrRepositoryNotFound if there is
+	// no such alias or it has expired
+	ResolveRepositoryAlias(ctx context.Context, alias RepositoryID) (RepositoryID, error)
+
+	// DeleteExpiredRepositoryAliases deletes the repository aliases that expired before the given time
+	DeleteExpiredRepositoryAliases(ctx context.Context, before time.Time) error
+
 	// ParseRef returns parsed 'ref' information as RawRef
 	ParseRef(ref Ref) (RawRef, error)
 
@@ -594,12 +973,70 @@ type RefManager interface {
 	// ListBranches lists branches
 	ListBranches(ctx context.Context, repositoryID RepositoryID) (BranchIterator, error)
 
+	// ListExpiredBranches lists the branches whose expiry passed by now. The idle TTL of a branch counts from the
+	// latest change of its head recorded in its reflog.
+	ListExpiredBranches(ctx context.Context, repositoryID RepositoryID, now time.Time) ([]*BranchRecord, error)
+
+	// ListBranchReflog lists the changes of the branch head recorded by CreateBranch, SetBranch and DeleteBranch,
+	// latest first. Each change is recorded with the operation and user set on the context of the call.
+	ListBranchReflog(ctx context.Context, repositoryID RepositoryID, branchID BranchID) (BranchReflogIterator, error)
+
+	// CreateStash sets the staging token of the branch aside under stashID, together with the branch commit, and
+	// sets the branch staging token to stagingToken
+	CreateStash(ctx context.Context, repositoryID RepositoryID, branchID BranchID, stashID StashID, stagingToken StagingToken) (*Stash, error)
+
+	// GetStash returns the Stash metadata object for the given StashID
+	GetStash(ctx context.Context, repositoryID RepositoryID, branchID BranchID, stashID StashID) (*Stash, error)
+
+	// DeleteStash deletes the stash, leaving its staging token data in place
+	DeleteStash(ctx context.Context, repositoryID RepositoryID, branchID BranchID, stashID StashID) error
+
+	// ListStashes lists the stashes of the branch
+	ListStashes(ctx context.Context, repositoryID RepositoryID, branchID BranchID) (StashIterator, error)
+
+	// CreateLegalHold creates the legal hold and records it in the legal hold log, or returns ErrLegalHoldExists
+	CreateLegalHold(ctx context.Context, repositoryID RepositoryID, holdID LegalHoldID, hold LegalHold) error
+
+	// DeleteLegalHold deletes the legal hold and records its release by user in the legal hold log
+	DeleteLegalHold(ctx context.Context, repositoryID RepositoryID, holdID LegalHoldID, user string) error
+
+	// GetLegalHold returns the legal hold, or ErrLegalHoldNotFound
+	GetLegalHold(ctx context.Context, repositoryID RepositoryID, holdID LegalHoldID) (*LegalHold, error)
+
+	// ListLegalHolds lists the legal holds of the repository ordered by ID
+	ListLegalHolds(ctx context.Context, repositoryID RepositoryID) ([]*LegalHoldRecord, error)
+
+	// ListLegalHoldLog lists the legal hold log entries of the repository, latest first
+	ListLegalHoldLog(ctx context.Context, repositoryID RepositoryID) ([]*LegalHoldLogEntry, error)
+
+	// CreateImport creates the import, or returns ErrImportExists
+	CreateImport(ctx context.Context, repositoryID RepositoryID, importID ImportID, imp Import) error
+
+	// GetImport returns the import, or ErrImportNotFound
+	GetImport(ctx context.Context, repositoryID RepositoryID, importID ImportID) (*Import, error)
+
+	// UpdateImport updates the import unless it completed, keeping its branch, creation date and whether it was
+	// canceled, and returns the updated import, or ErrImportCompleted
+	UpdateImport(ctx context.Context, repositoryID RepositoryID, importID ImportID, imp Import) (*Import, error)
+
+	// CancelImport marks the import canceled unless it completed, or returns ErrImportCompleted
+	CancelImport(ctx context.Context, repositoryID RepositoryID, importID ImportID) error
+
+	// DeleteExpiredImports deletes the imports of the repository last updated before 'before'
+	DeleteExpiredImports(ctx context.Context, repositoryID RepositoryID, before time.Time) error
+
 	// GetTag returns the Tag metadata object for the given TagID
 	GetTag(ctx context.Context, repositoryID RepositoryID, tagID TagID) (*CommitID, error)
 
+	// GetTagRecord returns the commit ID and annotation of the given TagID
+	GetTagRecord(ctx context.Context, repositoryID RepositoryID, tagID TagID) (*TagRecord, error)
+
 	// CreateTag create a given tag pointing to a commit
 	CreateTag(ctx context.Context, repositoryID RepositoryID, tagID TagID, commitID CommitID) error
 
+	// CreateAnnotatedTag create a given tag pointing to a commit, with an annotation
+	CreateAnnotatedTag(ctx context.Context, repositoryID RepositoryID, tagID TagID, commitID CommitID, annotation TagAnnotation) error
+
 	// DeleteTag deletes the tag
 	DeleteTag(ctx context.Context, repositoryID RepositoryID, tagID TagID) error
 
@@ -612,14 +1049,25 @@ type RefManager interface {
 	// AddCommit stores the Commit object, returning its ID
 	AddCommit(ctx context.Context, repositoryID RepositoryID, commit Commit) (CommitID, error)
 
+	// SetCommitSignature stores the signature of an existing commit
+	SetCommitSignature(ctx context.Context, repositoryID RepositoryID, commitID CommitID, keyID string, signature []byte) error
+
 	// FindMergeBase returns the merge-base for the given CommitIDs
 	// see: https://git-scm.com/docs/git-merge-base
 	// and internally: https://github.com/treeverse/lakeFS/blob/09954804baeb36ada74fa17d8fdc13a38552394e/index/dag/commits.go
 	FindMergeBase(ctx context.Context, repositoryID RepositoryID, commitIDs ...CommitID) (*Commit, error)
 
+	// CountAheadBehind returns the number of commits reachable from left and not from right (ahead), and the number
+	// of commits reachable from right and not from left (behind)
+	CountAheadBehind(ctx context.Context, repositoryID RepositoryID, left, right CommitID) (int, int, error)
+
 	// Log returns an iterator starting at commit ID up to repository root
 	Log(ctx context.Context, repositoryID RepositoryID, commitID CommitID) (CommitIterator, error)
 
+	// SearchCommits returns the commits of the repository matching params ordered by creation date, latest first.
+	// Commits are found by metadata through an index of commit metadata maintained by AddCommit.
+	SearchCommits(ctx context.Context, repositoryID RepositoryID, params CommitSearchParams) ([]*CommitRecord, error)
+
 	// ListCommits returns an iterator over all known commits, ordered by their commit ID
 	ListCommits(ctx context.Context, repositoryID RepositoryID) (CommitIterator, error)
 
@@ -651,10 +1099,23 @@ type CommittedManager interface {
 	// This is similar to a three-dot diff in git.
 	Compare(ctx context.Context, ns StorageNamespace, destination, source, base MetaRangeID) (DiffIterator, error)
 
+	// SummarizeDiff counts the differences between two metaRanges by key prefix, comparing ranges as a whole
+	// where possible.
+	SummarizeDiff(ctx context.Context, ns StorageNamespace, left, right MetaRangeID, params DiffSummaryParams) (map[string]DiffSummary, error)
+
+	// ListDistinct returns a ValueIterator over the values of the given metaRanges that reads every range
+	// once, and skips the ranges of the excluded metaRanges.  Values are not ordered across metaRanges.
+	ListDistinct(ctx context.Context, ns StorageNamespace, metaRangeIDs, excluded []MetaRangeID) (ValueIterator, error)
+
 	// Merge applies changes from 'source' to 'destination', relative to a merge base 'base' and
 	// returns the ID of the new metarange. This is similar to a git merge operation.
+	// Conflicts are resolved by the first of 'rules' that matches the key, or by 'strategy'.
 	// The resulting tree is expected to be immediately addressable.
-	Merge(ctx context.Context, ns StorageNamespace, destination, source, base MetaRangeID, strategy MergeStrategy) (MetaRangeID, error)
+	Merge(ctx context.Context, ns StorageNamespace, destination, source, base MetaRangeID, strategy MergeStrategy, rules []*MergeStrategyRule) (MetaRangeID, error)
+
+	// CheckMerge runs the merge of 'source' into 'destination' relative to 'base' without writing its result.
+	// It returns a *MergeConflictsError when the merge would fail due to conflicts.
+	CheckMerge(ctx context.Context, ns StorageNamespace, destination, source, base MetaRangeID, strategy MergeStrategy, rules []*MergeStrategyRule) error
 
 	// Commit is the act of taking an existing metaRange (snapshot) and applying a set of changes to it.
 	// A change is either an entity to write/overwrite, or a tombstone to mark a deletion
@@ -687,6 +1148,18 @@ type StagingManager interface {
 
 	// DropByPrefix drops all keys starting with the given prefix, from the given staging area
 	DropByPrefix(ctx context.Context, st StagingToken, prefix Key) error
+
+	// TrackWritten records value, written to key of the repository, as describing the object stored at address.
+	// Values are tracked by both identity and the digest of their data.
+	TrackWritten(ctx context.Context, repositoryID RepositoryID, key Key, value *Value, address string) error
+
+	// ListWritten returns the values tracked as written to the repository before 'before', and that were not
+	// forgotten since, ordered by identity.
+	ListWritten(ctx context.Context, repositoryID RepositoryID, before time.Time) (WrittenValueIterator, error)
+
+	// ForgetWritten stops tracking the given written values, unless they were written again since 'before', and
+	// returns the values it forgot.
+	ForgetWritten(ctx context.Context, repositoryID RepositoryID, values []*WrittenValue, before time.Time) ([]*WrittenValue, error)
 }
 
 // BranchLockerFunc callback function when branch is locked for operation (ex: writer or metadata updater)
@@ -739,26 +1212,31 @@ func (id TagID) String() string {
 }
 
 type Graveler struct {
-	CommittedManager         CommittedManager
-	StagingManager           StagingManager
-	RefManager               RefManager
-	branchLocker             BranchLocker
-	hooks                    HooksHandler
-	garbageCollectionManager GarbageCollectionManager
-	protectedBranchesManager ProtectedBranchesManager
-	log                      logging.Logger
-}
-
-func NewGraveler(branchLocker BranchLocker, committedManager CommittedManager, stagingManager StagingManager, refManager RefManager, gcManager GarbageCollectionManager, protectedBranchesManager ProtectedBranchesManager) *Graveler {
+	CommittedManager          CommittedManager
+	StagingManager            StagingManager
+	RefManager                RefManager
+	branchLocker              BranchLocker
+	hooks                     HooksHandler
+	garbageCollectionManager  GarbageCollectionManager
+	protectedBranchesManager  ProtectedBranchesManager
+	mergeStrategyRulesManager MergeStrategyRulesManager
+	protectedTagsManager      ProtectedTagsManager
+	signatureVerifier         SignatureVerifier
+	log                       logging.Logger
+}
+
+func NewGraveler(branchLocker BranchLocker, committedManager CommittedManager, stagingManager StagingManager, refManager RefManager, gcManager GarbageCollectionManager, protectedBranchesManager ProtectedBranchesManager, mergeStrategyRulesManager MergeStrategyRulesManager, protectedTagsManager ProtectedTagsManager) *Graveler {
 	return &Graveler{
-		CommittedManager:         committedManager,
-		StagingManager:           stagingManager,
-		RefManager:               refManager,
-		branchLocker:             branchLocker,
-		hooks:                    &HooksNoOp{},
-		garbageCollectionManager: gcManager,
-		protectedBranchesManager: protectedBranchesManager,
-		log:                      logging.Default().WithField("service_name", "graveler_graveler"),
+		CommittedManager:          committedManager,
+		StagingManager:            stagingManager,
+		RefManager:                refManager,
+		branchLocker:              branchLocker,
+		hooks:                     &HooksNoOp{},
+		garbageCollectionManager:  gcManager,
+		protectedBranchesManager:  protectedBranchesManager,
+		mergeStrategyRulesManager: mergeStrategyRulesManager,
+		protectedTagsManager:      protectedTagsManager,
+		log:                       logging.Default().WithField("service_name", "graveler_graveler"),
 	}
 }
 
@@ -806,9 +1284,40 @@ func (g *Graveler) WriteMetaRange(ctx context.Context, repositoryID RepositoryID
 }
 
 func (g *Graveler) DeleteRepository(ctx context.Context, repositoryID RepositoryID) error {
+	holds, err := g.RefManager.ListLegalHolds(ctx, repositoryID)
+	if err != nil {
+		return err
+	}
+	if len(holds) > 0 {
+		return fmt.Errorf("%s: %w", repositoryID, ErrDeleteLegalHoldRepository)
+	}
+	return g.RefManager.SoftDeleteRepository(ctx, repositoryID)
+}
+
+func (g *Graveler) RestoreRepository(ctx context.Context, repositoryID RepositoryID) error {
+	return g.RefManager.RestoreRepository(ctx, repositoryID)
+}
+
+func (g *Graveler) ListDeletedRepositories(ctx context.Context) ([]*DeletedRepositoryRecord, error) {
+	return g.RefManager.ListDeletedRepositories(ctx)
+}
+
+func (g *Graveler) PurgeRepository(ctx context.Context, repositoryID RepositoryID) error {
 	return g.RefManager.DeleteRepository(ctx, repositoryID)
 }
 
+func (g *Graveler) RenameRepository(ctx context.Context, repositoryID RepositoryID, newRepositoryID RepositoryID, aliasExpiry time.Time) error {
+	return g.RefManager.RenameRepository(ctx, repositoryID, newRepositoryID, aliasExpiry)
+}
+
+func (g *Graveler) ResolveRepositoryAlias(ctx context.Context, alias RepositoryID) (RepositoryID, error) {
+	return g.RefManager.ResolveRepositoryAlias(ctx, alias)
+}
+
+func (g *Graveler) DeleteExpiredRepositoryAliases(ctx context.Context, before time.Time) error {
+	return g.RefManager.DeleteExpiredRepositoryAliases(ctx, before)
+}
+
 func (g *Graveler) GetCommit(ctx context.Context, repositoryID RepositoryID, commitID CommitID) (*Commit, error) {
 	return g.RefManager.GetCommit(ctx, repositoryID, commitID)
 }
@@ -819,6 +1328,13 @@ func generateStagingToken(repositoryID RepositoryID, branchID BranchID) StagingT
 }
 
 func (g *Graveler) CreateBranch(ctx context.Context, repositoryID RepositoryID, branchID BranchID, ref Ref) (*Branch, error) {
+	return g.CreateEphemeralBranch(ctx, repositoryID, branchID, ref, BranchExpiry{})
+}
+
+func (g *Graveler) CreateEphemeralBranch(ctx context.Context, repositoryID RepositoryID, branchID BranchID, ref Ref, expiry BranchExpiry) (*Branch, error) {
+	if expiry.IdleTTL < 0 {
+		return nil, fmt.Errorf("idle TTL %s: %w", expiry.IdleTTL, ErrInvalidValue)
+	}
 	reference, err := g.Dereference(ctx, repositoryID, ref)
 	if err != nil {
 		return nil, fmt.Errorf("source reference '%s': %w", ref, err)
@@ -829,6 +1345,7 @@ func (g *Graveler) CreateBranch(ctx context.Context, repositoryID RepositoryID,
 	newBranch := Branch{
 		CommitID:     reference.CommitID,
 		StagingToken: generateStagingToken(repositoryID, branchID),
+		Expiry:       expiry,
 	}
 	err = g.RefManager.CreateBranch(ctx, repositoryID, branchID, newBranch)
 	if err != nil {
@@ -839,6 +1356,20 @@ func (g *Graveler) CreateBranch(ctx context.Context, repositoryID RepositoryID,
 
 func (g *Graveler) UpdateBranch(ctx context.Context, repositoryID RepositoryID, branchID BranchID, ref Ref) (*Branch, error) {
 	res, err := g.branchLocker.MetadataUpdater(ctx, repositoryID, branchID, func() (interface{}, error) {
+		isProtected, err := g.protectedBranchesManager.IsBlocked(ctx, repositoryID, branchID, BranchProtectionBlockedAction_UPDATE_POINTER)
+		if err != nil {
+			return nil, err
+		}
+		if isProtected {
+			return nil, ErrUpdateProtectedBranch
+		}
+		reference, err := g.Dereference(ctx, repositoryID, ref)
+		if err != nil {
+			return nil, err
+		}
+		if err := g.checkSignedCommits(ctx, repositoryID, branchID, reference.CommitID); err != nil {
+			return nil, err
+		}
 		return g.updateBranchNoLock(ctx, repositoryID, branchID, ref)
 	})
 	if err != nil {
@@ -890,11 +1421,26 @@ func (g *Graveler) GetTag(ctx context.Context, repositoryID RepositoryID, tagID
 	return g.RefManager.GetTag(ctx, repositoryID, tagID)
 }
 
+func (g *Graveler) GetTagRecord(ctx context.Context, repositoryID RepositoryID, tagID TagID) (*TagRecord, error) {
+	return g.RefManager.GetTagRecord(ctx, repositoryID, tagID)
+}
+
 func (g *Graveler) CreateTag(ctx context.Context, repositoryID RepositoryID, tagID TagID, commitID CommitID) error {
 	return g.RefManager.CreateTag(ctx, repositoryID, tagID, commitID)
 }
 
+func (g *Graveler) CreateAnnotatedTag(ctx context.Context, repositoryID RepositoryID, tagID TagID, commitID CommitID, annotation TagAnnotation) error {
+	return g.RefManager.CreateAnnotatedTag(ctx, repositoryID, tagID, commitID, annotation)
+}
+
 func (g *Graveler) DeleteTag(ctx context.Context, repositoryID RepositoryID, tagID TagID) error {
+	isProtected, err := g.protectedTagsManager.IsBlocked(ctx, repositoryID, tagID)
+	if err != nil {
+		return err
+	}
+	if isProtected {
+		return ErrDeleteProtectedTag
+	}
 	return g.RefManager.DeleteTag(ctx, repositoryID, tagID)
 }
 
@@ -922,6 +1468,10 @@ func (g *Graveler) Log(ctx context.Context, repositoryID RepositoryID, commitID
 	return g.RefManager.Log(ctx, repositoryID, commitID)
 }
 
+func (g *Graveler) SearchCommits(ctx context.Context, repositoryID RepositoryID, params CommitSearchParams) ([]*CommitRecord, error) {
+	return g.RefManager.SearchCommits(ctx, repositoryID, params)
+}
+
 func (g *Graveler) ListBranches(ctx context.Context, repositoryID RepositoryID) (BranchIterator, error) {
 	_, err := g.GetRepository(ctx, repositoryID)
 	if err != nil {
@@ -932,6 +1482,13 @@ func (g *Graveler) ListBranches(ctx context.Context, repositoryID RepositoryID)
 
 func (g *Graveler) DeleteBranch(ctx context.Context, repositoryID RepositoryID, branchID BranchID) error {
 	_, err := g.branchLocker.MetadataUpdater(ctx, repositoryID, branchID, func() (interface{}, error) {
+		isProtected, err := g.protectedBranchesManager.IsBlocked(ctx, repositoryID, branchID, BranchProtectionBlockedAction_DELETE)
+		if err != nil {
+			return nil, err
+		}
+		if isProtected {
+			return nil, ErrDeleteProtectedBranch
+		}
 		repo, err := g.RefManager.GetRepository(ctx, repositoryID)
 		if err != nil {
 			return nil, err
@@ -947,11 +1504,155 @@ func (g *Graveler) DeleteBranch(ctx context.Context, repositoryID RepositoryID,
 		if err != nil && !errors.Is(err, ErrNotFound) {
 			return nil, err
 		}
+		if err := g.dropBranchStashesNoLock(ctx, repositoryID, branchID); err != nil {
+			return nil, err
+		}
 		return nil, g.RefManager.DeleteBranch(ctx, repositoryID, branchID)
 	})
 	return err
 }
 
+func (g *Graveler) DeleteExpiredBranches(ctx context.Context, repositoryID RepositoryID, now time.Time) ([]BranchID, error) {
+	repo, err := g.RefManager.GetRepository(ctx, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	expired, err := g.RefManager.ListExpiredBranches(ctx, repositoryID, now)
+	if err != nil {
+		return nil, err
+	}
+	var deleted []BranchID
+	for _, rec := range expired {
+		if rec.BranchID == repo.DefaultBranchID {
+			continue
+		}
+		isProtected, err := g.protectedBranchesManager.IsBlocked(ctx, repositoryID, rec.BranchID, BranchProtectionBlockedAction_DELETE)
+		if err != nil {
+			return deleted, err
+		}
+		if isProtected {
+			continue
+		}
+		res, err := g.branchLocker.MetadataUpdater(ctx, repositoryID, rec.BranchID, func() (interface{}, error) {
+			branch, err := g.RefManager.GetBranch(ctx, repositoryID, rec.BranchID)
+			if errors.Is(err, ErrBranchNotFound) {
+				return false, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+			// the branch head moved since it was listed, which may have renewed its idle TTL
+			if branch.CommitID != rec.CommitID {
+				return false, nil
+			}
+			err = g.StagingManager.Drop(ctx, branch.StagingToken)
+			if err != nil && !errors.Is(err, ErrNotFound) {
+				return nil, err
+			}
+			if err := g.dropBranchStashesNoLock(ctx, repositoryID, rec.BranchID); err != nil {
+				return nil, err
+			}
+			if err := g.RefManager.DeleteBranch(ctx, repositoryID, rec.BranchID); err != nil {
+				return nil, err
+			}
+			return true, nil
+		})
+		if err != nil {
+			return deleted, fmt.Errorf("delete expired branch %s: %w", rec.BranchID, err)
+		}
+		if res.(bool) {
+			deleted = append(deleted, rec.BranchID)
+		}
+	}
+	return deleted, nil
+}
+
+func (g *Graveler) ListBranchReflog(ctx context.Context, repositoryID RepositoryID, branchID BranchID) (BranchReflogIterator, error) {
+	return g.RefManager.ListBranchReflog(ctx, repositoryID, branchID)
+}
+
+func (g *Graveler) RestoreBranch(ctx context.Context, repositoryID RepositoryID, branchID BranchID, index int) (*Branch, error) {
+	ctx = WithBranchReflogOperation(ctx, BranchReflogOperationRestore)
+	res, err := g.branchLocker.MetadataUpdater(ctx, repositoryID, branchID, func() (interface{}, error) {
+		isProtected, err := g.protectedBranchesManager.IsBlocked(ctx, repositoryID, branchID, BranchProtectionBlockedAction_COMMIT)
+		if err != nil {
+			return nil, err
+		}
+		if isProtected {
+			return nil, ErrCommitToProtectedBranch
+		}
+		isProtected, err = g.protectedBranchesManager.IsBlocked(ctx, repositoryID, branchID, BranchProtectionBlockedAction_UPDATE_POINTER)
+		if err != nil {
+			return nil, err
+		}
+		if isProtected {
+			return nil, ErrUpdateProtectedBranch
+		}
+		entry, err := g.getBranchReflogEntry(ctx, repositoryID, branchID, index)
+		if err != nil {
+			return nil, err
+		}
+		if entry.NewCommitID == "" {
+			return nil, fmt.Errorf("%s@{%d}: %w", branchID, index, ErrRestoreDeletedBranch)
+		}
+		if err := g.checkSignedCommits(ctx, repositoryID, branchID, entry.NewCommitID); err != nil {
+			return nil, err
+		}
+		branch, err := g.RefManager.GetBranch(ctx, repositoryID, branchID)
+		if errors.Is(err, ErrBranchNotFound) {
+			newBranch := Branch{
+				CommitID:     entry.NewCommitID,
+				StagingToken: generateStagingToken(repositoryID, branchID),
+			}
+			if err := g.RefManager.CreateBranch(ctx, repositoryID, branchID, newBranch); err != nil {
+				return nil, fmt.Errorf("create branch %s: %w", branchID, err)
+			}
+			return &newBranch, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("get branch %s: %w", branchID, err)
+		}
+		if empty, err := g.stagingEmpty(ctx, branch); err != nil {
+			return nil, err
+		} else if !empty {
+			return nil, fmt.Errorf("%s: %w", branchID, ErrDirtyBranch)
+		}
+		newBranch := Branch{
+			CommitID:     entry.NewCommitID,
+			StagingToken: branch.StagingToken,
+		}
+		if err := g.RefManager.SetBranch(ctx, repositoryID, branchID, newBranch); err != nil {
+			return nil, fmt.Errorf("set branch %s: %w", branchID, err)
+		}
+		return &newBranch, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*Branch), nil
+}
+
+// getBranchReflogEntry returns the entry at index of the branch reflog
+func (g *Graveler) getBranchReflogEntry(ctx context.Context, repositoryID RepositoryID, branchID BranchID, index int) (*BranchReflogEntry, error) {
+	it, err := g.RefManager.ListBranchReflog(ctx, repositoryID, branchID)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	it.SeekGE(index)
+	if !it.Next() {
+		if err := it.Err(); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%s@{%d}: %w", branchID, index, ErrBranchReflogEntryNotFound)
+	}
+	entry := it.Value()
+	if entry.Index != index {
+		return nil, fmt.Errorf("%s@{%d}: %w", branchID, index, ErrBranchReflogEntryNotFound)
+	}
+	return entry, nil
+}
+
 func (g *Graveler) GetStagingToken(ctx context.Context, repositoryID RepositoryID, branchID BranchID) (*StagingToken, error) {
 	branch, err := g.RefManager.GetBranch(ctx, repositoryID, branchID)
 	if err != nil {
@@ -1010,7 +1711,385 @@ func (g *Graveler) SaveGarbageCollectionCommits(ctx context.Context, repositoryI
 	}, err
 }
 
-func (g *Graveler) GetBranchProtectionRules(ctx context.Context, repositoryID RepositoryID) (*BranchProtectionRules, error) {
+func (g *Graveler) ListGarbageCollectionValues(ctx context.Context, repositoryID RepositoryID, runID string) ([]GarbageCollectionValues, error) {
+	repo, err := g.RefManager.GetRepository(ctx, repositoryID)
+	if err != nil {
+		return nil, fmt.Errorf("get repository: %w", err)
+	}
+	ruleCommits, err := g.garbageCollectionManager.GetRunRuleCommits(ctx, repo.StorageNamespace, runID)
+	if err != nil {
+		return nil, fmt.Errorf("get run commits: %w", err)
+	}
+	return g.listRuleValues(ctx, repositoryID, repo.StorageNamespace, ruleCommits)
+}
+
+func (g *Graveler) PreviewGarbageCollectionValues(ctx context.Context, repositoryID RepositoryID, rules *GarbageCollectionRules) ([]GarbageCollectionValues, error) {
+	repo, err := g.RefManager.GetRepository(ctx, repositoryID)
+	if err != nil {
+		return nil, fmt.Errorf("get repository: %w", err)
+	}
+	ruleCommits, err := g.garbageCollectionManager.GetRuleCommits(ctx, repositoryID, rules)
+	if err != nil {
+		return nil, fmt.Errorf("get rule commits: %w", err)
+	}
+	return g.listRuleValues(ctx, repositoryID, repo.StorageNamespace, ruleCommits)
+}
+
+// listRuleValues returns the values of the expired and active commits of every rule
+func (g *Graveler) listRuleValues(ctx context.Context, repositoryID RepositoryID, storageNamespace StorageNamespace, ruleCommits []GarbageCollectionRuleCommits) ([]GarbageCollectionValues, error) {
+	values := make([]GarbageCollectionValues, 0, len(ruleCommits))
+	closeValues := func() {
+		for _, v := range values {
+			v.Expired.Close()
+			v.Active.Close()
+		}
+	}
+	for _, commits := range ruleCommits {
+		expired, err := g.commitsMetaRangeIDs(ctx, repositoryID, commits.Expired)
+		if err != nil {
+			closeValues()
+			return nil, err
+		}
+		active, err := g.commitsMetaRangeIDs(ctx, repositoryID, commits.Active)
+		if err != nil {
+			closeValues()
+			return nil, err
+		}
+		expiredIt, err := g.CommittedManager.ListDistinct(ctx, storageNamespace, expired, active)
+		if err != nil {
+			closeValues()
+			return nil, err
+		}
+		activeIt, err := g.CommittedManager.ListDistinct(ctx, storageNamespace, active, nil)
+		if err != nil {
+			expiredIt.Close()
+			closeValues()
+			return nil, err
+		}
+		values = append(values, GarbageCollectionValues{Prefix: commits.Prefix, Expired: expiredIt, Active: activeIt})
+	}
+	return values, nil
+}
+
+func (g *Graveler) SaveGarbageCollectionAddresses(ctx context.Context, repositoryID RepositoryID, runID string, addresses []string) (string, error) {
+	repo, err := g.RefManager.GetRepository(ctx, repositoryID)
+	if err != nil {
+		return "", fmt.Errorf("get repository: %w", err)
+	}
+	return g.garbageCollectionManager.SaveRunAddresses(ctx, repo.StorageNamespace, runID, addresses)
+}
+
+func (g *Graveler) GetGarbageCollectionAddresses(ctx context.Context, repositoryID RepositoryID, runID string) ([]string, string, error) {
+	repo, err := g.RefManager.GetRepository(ctx, repositoryID)
+	if err != nil {
+		return nil, "", fmt.Errorf("get repository: %w", err)
+	}
+	return g.garbageCollectionManager.GetRunAddresses(ctx, repo.StorageNamespace, runID)
+}
+
+func (g *Graveler) ListWrittenValues(ctx context.Context, repositoryID RepositoryID, before time.Time) (WrittenValueIterator, error) {
+	return g.StagingManager.ListWritten(ctx, repositoryID, before)
+}
+
+func (g *Graveler) ForgetWrittenValues(ctx context.Context, repositoryID RepositoryID, values []*WrittenValue, before time.Time) ([]*WrittenValue, error) {
+	return g.StagingManager.ForgetWritten(ctx, repositoryID, values, before)
+}
+
+func (g *Graveler) ListStagedValues(ctx context.Context, repositoryID RepositoryID) (ValueIterator, error) {
+	branchIt, err := g.RefManager.ListBranches(ctx, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	defer branchIt.Close()
+	var tokens []StagingToken
+	for branchIt.Next() {
+		branch := branchIt.Value()
+		tokens = append(tokens, branch.StagingToken)
+		stashIt, err := g.RefManager.ListStashes(ctx, repositoryID, branch.BranchID)
+		if err != nil {
+			return nil, err
+		}
+		for stashIt.Next() {
+			tokens = append(tokens, stashIt.Value().StagingToken)
+		}
+		err = stashIt.Err()
+		stashIt.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := branchIt.Err(); err != nil {
+		return nil, err
+	}
+	return &stagedValueIterator{ctx: ctx, manager: g.StagingManager, tokens: tokens}, nil
+}
+
+func (g *Graveler) ListCommittedValues(ctx context.Context, repositoryID RepositoryID) (ValueIterator, error) {
+	repo, err := g.RefManager.GetRepository(ctx, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	commitIt, err := g.RefManager.ListCommits(ctx, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	defer commitIt.Close()
+	seen := make(map[MetaRangeID]struct{})
+	var metaRangeIDs []MetaRangeID
+	for commitIt.Next() {
+		metaRangeID := commitIt.Value().MetaRangeID
+		if _, ok := seen[metaRangeID]; ok || metaRangeID == "" {
+			continue
+		}
+		seen[metaRangeID] = struct{}{}
+		metaRangeIDs = append(metaRangeIDs, metaRangeID)
+	}
+	if err := commitIt.Err(); err != nil {
+		return nil, err
+	}
+	return g.CommittedManager.ListDistinct(ctx, repo.StorageNamespace, metaRangeIDs, nil)
+}
+
+func (g *Graveler) ListBranchHeadValues(ctx context.Context, repositoryID RepositoryID) (ValueIterator, error) {
+	repo, err := g.RefManager.GetRepository(ctx, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	branchIt, err := g.RefManager.ListBranches(ctx, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	defer branchIt.Close()
+	seen := make(map[CommitID]struct{})
+	var metaRangeIDs []MetaRangeID
+	for branchIt.Next() {
+		commitID := branchIt.Value().CommitID
+		if _, ok := seen[commitID]; ok {
+			continue
+		}
+		seen[commitID] = struct{}{}
+		commit, err := g.RefManager.GetCommit(ctx, repositoryID, commitID)
+		if err != nil {
+			return nil, err
+		}
+		if commit.MetaRangeID != "" {
+			metaRangeIDs = append(metaRangeIDs, commit.MetaRangeID)
+		}
+	}
+	if err := branchIt.Err(); err != nil {
+		return nil, err
+	}
+	return g.CommittedManager.ListDistinct(ctx, repo.StorageNamespace, metaRangeIDs, nil)
+}
+
+func (g *Graveler) CreateLegalHold(ctx context.Context, repositoryID RepositoryID, holdID LegalHoldID, hold LegalHold) error {
+	if _, err := g.RefManager.GetCommit(ctx, repositoryID, hold.CommitID); err != nil {
+		return err
+	}
+	return g.RefManager.CreateLegalHold(ctx, repositoryID, holdID, hold)
+}
+
+func (g *Graveler) ReleaseLegalHold(ctx context.Context, repositoryID RepositoryID, holdID LegalHoldID, user string) error {
+	return g.RefManager.DeleteLegalHold(ctx, repositoryID, holdID, user)
+}
+
+func (g *Graveler) GetLegalHold(ctx context.Context, repositoryID RepositoryID, holdID LegalHoldID) (*LegalHold, error) {
+	return g.RefManager.GetLegalHold(ctx, repositoryID, holdID)
+}
+
+func (g *Graveler) ListLegalHolds(ctx context.Context, repositoryID RepositoryID) ([]*LegalHoldRecord, error) {
+	return g.RefManager.ListLegalHolds(ctx, repositoryID)
+}
+
+func (g *Graveler) ListLegalHoldLog(ctx context.Context, repositoryID RepositoryID) ([]*LegalHoldLogEntry, error) {
+	return g.RefManager.ListLegalHoldLog(ctx, repositoryID)
+}
+
+func (g *Graveler) CreateImport(ctx context.Context, repositoryID RepositoryID, importID ImportID, imp Import) error {
+	return g.RefManager.CreateImport(ctx, repositoryID, importID, imp)
+}
+
+func (g *Graveler) GetImport(ctx context.Context, repositoryID RepositoryID, importID ImportID) (*Import, error) {
+	return g.RefManager.GetImport(ctx, repositoryID, importID)
+}
+
+func (g *Graveler) UpdateImport(ctx context.Context, repositoryID RepositoryID, importID ImportID, imp Import) (*Import, error) {
+	return g.RefManager.UpdateImport(ctx, repositoryID, importID, imp)
+}
+
+func (g *Graveler) CancelImport(ctx context.Context, repositoryID RepositoryID, importID ImportID) error {
+	return g.RefManager.CancelImport(ctx, repositoryID, importID)
+}
+
+func (g *Graveler) DeleteExpiredImports(ctx context.Context, repositoryID RepositoryID, before time.Time) error {
+	return g.RefManager.DeleteExpiredImports(ctx, repositoryID, before)
+}
+
+func (g *Graveler) ListHeldValues(ctx context.Context, repositoryID RepositoryID) (ValueIterator, error) {
+	repo, err := g.RefManager.GetRepository(ctx, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	holds, err := g.RefManager.ListLegalHolds(ctx, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	heldValues := &heldValueIterator{ctx: ctx, manager: g.CommittedManager, ns: repo.StorageNamespace}
+	for _, hold := range holds {
+		commit, err := g.RefManager.GetCommit(ctx, repositoryID, hold.CommitID)
+		if err != nil {
+			return nil, fmt.Errorf("get commit %s of legal hold %s: %w", hold.CommitID, hold.LegalHoldID, err)
+		}
+		if commit.MetaRangeID == "" {
+			continue
+		}
+		heldValues.holds = append(heldValues.holds, heldMetaRange{metaRangeID: commit.MetaRangeID, path: hold.Path})
+	}
+	return heldValues, nil
+}
+
+// heldMetaRange is the metarange of a held commit, and the path of the held objects in it
+type heldMetaRange struct {
+	metaRangeID MetaRangeID
+	path        string
+}
+
+// heldValueIterator iterates over the values held by a sequence of legal holds, one after the other
+type heldValueIterator struct {
+	ctx     context.Context
+	manager CommittedManager
+	ns      StorageNamespace
+	holds   []heldMetaRange
+	it      ValueIterator
+	err     error
+}
+
+func (h *heldValueIterator) Next() bool {
+	for h.err == nil {
+		if h.it == nil {
+			if len(h.holds) == 0 {
+				return false
+			}
+			hold := h.holds[0]
+			h.it, h.err = h.manager.List(h.ctx, h.ns, hold.metaRangeID)
+			if h.err != nil {
+				return false
+			}
+			if hold.path != "" {
+				h.it = NewPrefixesIterator(h.it, []Key{Key(hold.path)})
+			}
+			h.holds = h.holds[1:]
+		}
+		if h.it.Next() {
+			return true
+		}
+		h.err = h.it.Err()
+		h.it.Close()
+		h.it = nil
+	}
+	return false
+}
+
+func (h *heldValueIterator) SeekGE(Key) {
+	h.err = ErrInvalidValue
+}
+
+func (h *heldValueIterator) Value() *ValueRecord {
+	if h.it == nil {
+		return nil
+	}
+	return h.it.Value()
+}
+
+func (h *heldValueIterator) Err() error {
+	return h.err
+}
+
+func (h *heldValueIterator) Close() {
+	if h.it != nil {
+		h.it.Close()
+		h.it = nil
+	}
+}
+
+// stagedValueIterator iterates over the values staged on a sequence of staging tokens, one after the other,
+// skipping tombstones
+type stagedValueIterator struct {
+	ctx     context.Context
+	manager StagingManager
+	tokens  []StagingToken
+	it      ValueIterator
+	err     error
+}
+
+func (s *stagedValueIterator) Next() bool {
+	for s.err == nil {
+		if s.it == nil {
+			if len(s.tokens) == 0 {
+				return false
+			}
+			s.it, s.err = s.manager.List(s.ctx, s.tokens[0], ListingDefaultBatchSize)
+			if s.err != nil {
+				return false
+			}
+			s.tokens = s.tokens[1:]
+		}
+		for s.it.Next() {
+			if s.it.Value().Value != nil {
+				return true
+			}
+		}
+		s.err = s.it.Err()
+		s.it.Close()
+		s.it = nil
+	}
+	return false
+}
+
+func (s *stagedValueIterator) SeekGE(Key) {
+	s.err = ErrInvalidValue
+}
+
+func (s *stagedValueIterator) Value() *ValueRecord {
+	if s.it == nil {
+		return nil
+	}
+	return s.it.Value()
+}
+
+func (s *stagedValueIterator) Err() error {
+	return s.err
+}
+
+func (s *stagedValueIterator) Close() {
+	if s.it != nil {
+		s.it.Close()
+		s.it = nil
+	}
+}
+
+// commitsMetaRangeIDs returns the distinct metaranges of the given commits
+func (g *Graveler) commitsMetaRangeIDs(ctx context.Context, repositoryID RepositoryID, commitIDs []CommitID) ([]MetaRangeID, error) {
+	seen := make(map[MetaRangeID]struct{})
+	var res []MetaRangeID
+	for _, commitID := range commitIDs {
+		commit, err := g.RefManager.GetCommit(ctx, repositoryID, commitID)
+		if err != nil {
+			return nil, fmt.Errorf("get commit %s: %w", commitID, err)
+		}
+		if commit.MetaRangeID == "" {
+			continue
+		}
+		if _, ok := seen[commit.MetaRangeID]; ok {
+			continue
+		}
+		seen[commit.MetaRangeID] = struct{}{}
+		res = append(res, commit.MetaRangeID)
+	}
+	return res, nil
+}
+
+func (g *Graveler) GetBranchProtectionRules(ctx context.Context, repositoryID RepositoryID) (*BranchProtectionRules, error) {
 	return g.protectedBranchesManager.GetRules(ctx, repositoryID)
 }
 
@@ -1018,8 +2097,28 @@ func (g *Graveler) DeleteBranchProtectionRule(ctx context.Context, repositoryID
 	return g.protectedBranchesManager.Delete(ctx, repositoryID, pattern)
 }
 
-func (g *Graveler) CreateBranchProtectionRule(ctx context.Context, repositoryID RepositoryID, pattern string, blockedActions []BranchProtectionBlockedAction) error {
-	return g.protectedBranchesManager.Add(ctx, repositoryID, pattern, blockedActions)
+func (g *Graveler) CreateBranchProtectionRule(ctx context.Context, repositoryID RepositoryID, pattern string, blockedActions []BranchProtectionBlockedAction, mergeSourcePattern string, requireSignedCommits bool) error {
+	return g.protectedBranchesManager.Add(ctx, repositoryID, pattern, blockedActions, mergeSourcePattern, requireSignedCommits)
+}
+
+func (g *Graveler) GetTagProtectionRules(ctx context.Context, repositoryID RepositoryID) (*TagProtectionRules, error) {
+	return g.protectedTagsManager.GetRules(ctx, repositoryID)
+}
+
+func (g *Graveler) DeleteTagProtectionRule(ctx context.Context, repositoryID RepositoryID, pattern string) error {
+	return g.protectedTagsManager.Delete(ctx, repositoryID, pattern)
+}
+
+func (g *Graveler) CreateTagProtectionRule(ctx context.Context, repositoryID RepositoryID, pattern string) error {
+	return g.protectedTagsManager.Add(ctx, repositoryID, pattern)
+}
+
+func (g *Graveler) GetMergeStrategyRules(ctx context.Context, repositoryID RepositoryID) (*MergeStrategyRules, error) {
+	return g.mergeStrategyRulesManager.GetRules(ctx, repositoryID)
+}
+
+func (g *Graveler) SetMergeStrategyRules(ctx context.Context, repositoryID RepositoryID, rules *MergeStrategyRules) error {
+	return g.mergeStrategyRulesManager.SetRules(ctx, repositoryID, rules)
 }
 
 func (g *Graveler) Get(ctx context.Context, repositoryID RepositoryID, ref Ref, key Key) (*Value, error) {
@@ -1070,6 +2169,13 @@ func (g *Graveler) Set(ctx context.Context, repositoryID RepositoryID, branchID
 		for _, cond := range writeConditions {
 			cond(writeCondition)
 		}
+		if writeCondition.ObjectAddress != "" {
+			// tracked before it is staged: the object of a value that fails to stage is unreferenced as well
+			err := g.StagingManager.TrackWritten(ctx, repositoryID, key, &value, writeCondition.ObjectAddress)
+			if err != nil {
+				return nil, err
+			}
+		}
 
 		if writeCondition.IfAbsent {
 			// Ensure the given key doesn't exist in the underlying commit first
@@ -1188,6 +2294,7 @@ func (g *Graveler) List(ctx context.Context, repositoryID RepositoryID, ref Ref)
 }
 
 func (g *Graveler) Commit(ctx context.Context, repositoryID RepositoryID, branchID BranchID, params CommitParams) (CommitID, error) {
+	ctx = WithBranchReflogOperation(ctx, BranchReflogOperationCommit)
 	var preRunID string
 	var commit Commit
 	var storageNamespace StorageNamespace
@@ -1199,6 +2306,9 @@ func (g *Graveler) Commit(ctx context.Context, repositoryID RepositoryID, branch
 		if isProtected {
 			return nil, ErrCommitToProtectedBranch
 		}
+		if err := g.checkSignedCommits(ctx, repositoryID, branchID, ""); err != nil {
+			return nil, err
+		}
 		repo, err := g.RefManager.GetRepository(ctx, repositoryID)
 		if err != nil {
 			return "", fmt.Errorf("get repository: %w", err)
@@ -1232,6 +2342,7 @@ func (g *Graveler) Commit(ctx context.Context, repositoryID RepositoryID, branch
 			StorageNamespace: storageNamespace,
 			BranchID:         branchID,
 			Commit:           commit,
+			Prefixes:         params.Prefixes,
 		})
 		if err != nil {
 			return "", &HookAbortError{
@@ -1252,10 +2363,14 @@ func (g *Graveler) Commit(ctx context.Context, repositoryID RepositoryID, branch
 			parentGeneration = commit.Generation
 		}
 		commit.Generation = parentGeneration + 1
-		changes, err := g.StagingManager.List(ctx, branch.StagingToken, ListingMaxBatchSize)
+		var changes ValueIterator
+		changes, err = g.StagingManager.List(ctx, branch.StagingToken, ListingMaxBatchSize)
 		if err != nil {
 			return "", fmt.Errorf("staging list: %w", err)
 		}
+		if len(params.Prefixes) > 0 {
+			changes = NewPrefixesIterator(changes, params.Prefixes)
+		}
 		defer changes.Close()
 
 		commit.MetaRangeID, _, err = g.CommittedManager.Commit(ctx, storageNamespace, branchMetaRangeID, changes)
@@ -1268,6 +2383,18 @@ func (g *Graveler) Commit(ctx context.Context, repositoryID RepositoryID, branch
 		if err != nil {
 			return "", fmt.Errorf("add commit: %w", err)
 		}
+		if len(params.Prefixes) > 0 {
+			// partial commit - keep the staging token with the entries that were not committed
+			err = g.RefManager.SetBranch(ctx, repositoryID, branchID, Branch{
+				CommitID:     newCommit,
+				StagingToken: branch.StagingToken,
+			})
+			if err != nil {
+				return "", fmt.Errorf("set branch commit %s: %w", newCommit, err)
+			}
+			g.dropCommittedPrefixes(ctx, repositoryID, branchID, branch.StagingToken, params.Prefixes)
+			return newCommit, nil
+		}
 		err = g.RefManager.SetBranch(ctx, repositoryID, branchID, Branch{
 			CommitID:     newCommit,
 			StagingToken: newStagingToken(repositoryID, branchID),
@@ -1312,6 +2439,22 @@ func (g *Graveler) Commit(ctx context.Context, repositoryID RepositoryID, branch
 	return newCommitID, nil
 }
 
+// dropCommittedPrefixes drops the entries of a partial commit from the staging token it was committed from.
+// The entries match the committed ones, failing to drop them leaves no visible changes on the branch.
+func (g *Graveler) dropCommittedPrefixes(ctx context.Context, repositoryID RepositoryID, branchID BranchID, stagingToken StagingToken, prefixes []Key) {
+	for _, prefix := range prefixes {
+		err := g.StagingManager.DropByPrefix(ctx, stagingToken, prefix)
+		if err != nil {
+			g.log.WithContext(ctx).WithError(err).WithFields(logging.Fields{
+				"repository_id": repositoryID,
+				"branch_id":     branchID,
+				"staging_token": stagingToken,
+				"prefix":        prefix,
+			}).Error("Failed to drop committed staging data")
+		}
+	}
+}
+
 func newStagingToken(repositoryID RepositoryID, branchID BranchID) StagingToken {
 	v := strings.Join([]string{repositoryID.String(), branchID.String(), uuid.New().String()}, "-")
 	return StagingToken(v)
@@ -1346,7 +2489,18 @@ func (g *Graveler) isCommitExist(ctx context.Context, repositoryID RepositoryID,
 }
 
 func (g *Graveler) AddCommitToBranchHead(ctx context.Context, repositoryID RepositoryID, branchID BranchID, commit Commit) (CommitID, error) {
+	ctx = WithBranchReflogOperation(ctx, BranchReflogOperationCommit)
 	res, err := g.branchLocker.MetadataUpdater(ctx, repositoryID, branchID, func() (interface{}, error) {
+		isProtected, err := g.protectedBranchesManager.IsBlocked(ctx, repositoryID, branchID, BranchProtectionBlockedAction_COMMIT)
+		if err != nil {
+			return nil, err
+		}
+		if isProtected {
+			return nil, ErrCommitToProtectedBranch
+		}
+		if err := g.checkSignedCommits(ctx, repositoryID, branchID, ""); err != nil {
+			return nil, err
+		}
 		// parentCommitID should always match the HEAD of the branch.
 		// Empty parentCommitID matches first commit of the branch.
 		parentCommitID, err := g.validateCommitParent(ctx, repositoryID, commit)
@@ -1460,6 +2614,13 @@ func (g *Graveler) Reset(ctx context.Context, repositoryID RepositoryID, branchI
 		if isProtected {
 			return nil, ErrWriteToProtectedBranch
 		}
+		isProtected, err = g.protectedBranchesManager.IsBlocked(ctx, repositoryID, branchID, BranchProtectionBlockedAction_RESET)
+		if err != nil {
+			return nil, err
+		}
+		if isProtected {
+			return nil, ErrResetProtectedBranch
+		}
 		branch, err := g.RefManager.GetBranch(ctx, repositoryID, branchID)
 		if err != nil {
 			return nil, err
@@ -1469,134 +2630,724 @@ func (g *Graveler) Reset(ctx context.Context, repositoryID RepositoryID, branchI
 	return err
 }
 
-func (g *Graveler) ResetKey(ctx context.Context, repositoryID RepositoryID, branchID BranchID, key Key) error {
-	_, err := g.branchLocker.Writer(ctx, repositoryID, branchID, func() (interface{}, error) {
-		isProtected, err := g.protectedBranchesManager.IsBlocked(ctx, repositoryID, branchID, BranchProtectionBlockedAction_STAGING_WRITE)
+func (g *Graveler) ResetKey(ctx context.Context, repositoryID RepositoryID, branchID BranchID, key Key) error {
+	_, err := g.branchLocker.Writer(ctx, repositoryID, branchID, func() (interface{}, error) {
+		isProtected, err := g.protectedBranchesManager.IsBlocked(ctx, repositoryID, branchID, BranchProtectionBlockedAction_STAGING_WRITE)
+		if err != nil {
+			return nil, err
+		}
+		if isProtected {
+			return nil, ErrWriteToProtectedBranch
+		}
+		isProtected, err = g.protectedBranchesManager.IsBlocked(ctx, repositoryID, branchID, BranchProtectionBlockedAction_RESET)
+		if err != nil {
+			return nil, err
+		}
+		if isProtected {
+			return nil, ErrResetProtectedBranch
+		}
+		branch, err := g.RefManager.GetBranch(ctx, repositoryID, branchID)
+		if err != nil {
+			return nil, err
+		}
+		return nil, g.StagingManager.DropKey(ctx, branch.StagingToken, key)
+	})
+	return err
+}
+
+func (g *Graveler) ResetPrefix(ctx context.Context, repositoryID RepositoryID, branchID BranchID, key Key) error {
+	_, err := g.branchLocker.Writer(ctx, repositoryID, branchID, func() (interface{}, error) {
+		isProtected, err := g.protectedBranchesManager.IsBlocked(ctx, repositoryID, branchID, BranchProtectionBlockedAction_STAGING_WRITE)
+		if err != nil {
+			return nil, err
+		}
+		if isProtected {
+			return nil, ErrWriteToProtectedBranch
+		}
+		isProtected, err = g.protectedBranchesManager.IsBlocked(ctx, repositoryID, branchID, BranchProtectionBlockedAction_RESET)
+		if err != nil {
+			return nil, err
+		}
+		if isProtected {
+			return nil, ErrResetProtectedBranch
+		}
+		branch, err := g.RefManager.GetBranch(ctx, repositoryID, branchID)
+		if err != nil {
+			return nil, err
+		}
+		return nil, g.StagingManager.DropByPrefix(ctx, branch.StagingToken, key)
+	})
+	return err
+}
+
+func (g *Graveler) StashPush(ctx context.Context, repositoryID RepositoryID, branchID BranchID, stashID StashID) (*Stash, error) {
+	res, err := g.branchLocker.MetadataUpdater(ctx, repositoryID, branchID, func() (interface{}, error) {
+		isProtected, err := g.protectedBranchesManager.IsBlocked(ctx, repositoryID, branchID, BranchProtectionBlockedAction_STAGING_WRITE)
+		if err != nil {
+			return nil, err
+		}
+		if isProtected {
+			return nil, ErrWriteToProtectedBranch
+		}
+		isProtected, err = g.protectedBranchesManager.IsBlocked(ctx, repositoryID, branchID, BranchProtectionBlockedAction_RESET)
+		if err != nil {
+			return nil, err
+		}
+		if isProtected {
+			return nil, ErrResetProtectedBranch
+		}
+		branch, err := g.RefManager.GetBranch(ctx, repositoryID, branchID)
+		if err != nil {
+			return nil, err
+		}
+		if empty, err := g.stagingEmpty(ctx, branch); err != nil {
+			return nil, err
+		} else if empty {
+			return nil, fmt.Errorf("stash %s: %w", stashID, ErrNoChanges)
+		}
+		return g.RefManager.CreateStash(ctx, repositoryID, branchID, stashID, newStagingToken(repositoryID, branchID))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*Stash), nil
+}
+
+func (g *Graveler) StashApply(ctx context.Context, repositoryID RepositoryID, branchID BranchID, stashID StashID) error {
+	_, err := g.branchLocker.Writer(ctx, repositoryID, branchID, func() (interface{}, error) {
+		return nil, g.stashApplyNoLock(ctx, repositoryID, branchID, stashID)
+	})
+	return err
+}
+
+func (g *Graveler) StashPop(ctx context.Context, repositoryID RepositoryID, branchID BranchID, stashID StashID) error {
+	_, err := g.branchLocker.MetadataUpdater(ctx, repositoryID, branchID, func() (interface{}, error) {
+		if err := g.stashApplyNoLock(ctx, repositoryID, branchID, stashID); err != nil {
+			return nil, err
+		}
+		return nil, g.stashDropNoLock(ctx, repositoryID, branchID, stashID)
+	})
+	return err
+}
+
+func (g *Graveler) StashDrop(ctx context.Context, repositoryID RepositoryID, branchID BranchID, stashID StashID) error {
+	_, err := g.branchLocker.MetadataUpdater(ctx, repositoryID, branchID, func() (interface{}, error) {
+		return nil, g.stashDropNoLock(ctx, repositoryID, branchID, stashID)
+	})
+	return err
+}
+
+func (g *Graveler) ListStashes(ctx context.Context, repositoryID RepositoryID, branchID BranchID) (StashIterator, error) {
+	return g.RefManager.ListStashes(ctx, repositoryID, branchID)
+}
+
+// stashApplyNoLock stages the data of the stash on the branch. All the stashed keys are checked for conflicts
+// before staging any of them, so a conflicting stash leaves the branch staged data unchanged.  A stashed key
+// conflicts with a different value staged on the branch, or committed on the branch since the stash was pushed.
+func (g *Graveler) stashApplyNoLock(ctx context.Context, repositoryID RepositoryID, branchID BranchID, stashID StashID) error {
+	isProtected, err := g.protectedBranchesManager.IsBlocked(ctx, repositoryID, branchID, BranchProtectionBlockedAction_STAGING_WRITE)
+	if err != nil {
+		return err
+	}
+	if isProtected {
+		return ErrWriteToProtectedBranch
+	}
+	branch, err := g.RefManager.GetBranch(ctx, repositoryID, branchID)
+	if err != nil {
+		return err
+	}
+	stash, err := g.RefManager.GetStash(ctx, repositoryID, branchID, stashID)
+	if err != nil {
+		return err
+	}
+	var committed DiffIterator
+	if stash.CommitID != branch.CommitID {
+		committed, err = g.committedSince(ctx, repositoryID, stash.CommitID, branch.CommitID)
+		if err != nil {
+			return err
+		}
+		defer committed.Close()
+	}
+	if err := g.stashConflicts(ctx, branch.StagingToken, stash.StagingToken, committed); err != nil {
+		return err
+	}
+	it, err := g.StagingManager.List(ctx, stash.StagingToken, ListingDefaultBatchSize)
+	if err != nil {
+		return fmt.Errorf("staging list (token %s): %w", stash.StagingToken, err)
+	}
+	defer it.Close()
+	for it.Next() {
+		record := it.Value()
+		if err := g.StagingManager.Set(ctx, branch.StagingToken, record.Key, record.Value, true); err != nil {
+			return fmt.Errorf("stage %s: %w", record.Key, err)
+		}
+	}
+	return it.Err()
+}
+
+// committedSince returns the changes committed between the commits fromCommitID and toCommitID
+func (g *Graveler) committedSince(ctx context.Context, repositoryID RepositoryID, fromCommitID, toCommitID CommitID) (DiffIterator, error) {
+	repo, err := g.RefManager.GetRepository(ctx, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	fromCommit, err := g.RefManager.GetCommit(ctx, repositoryID, fromCommitID)
+	if err != nil {
+		return nil, err
+	}
+	toCommit, err := g.RefManager.GetCommit(ctx, repositoryID, toCommitID)
+	if err != nil {
+		return nil, err
+	}
+	return g.CommittedManager.Diff(ctx, repo.StorageNamespace, fromCommit.MetaRangeID, toCommit.MetaRangeID)
+}
+
+// stashConflicts returns a MergeConflictsError holding the keys of stashToken that are staged on stagingToken with
+// a different value.  Keys of stashToken that are not staged on stagingToken conflict with a different value on
+// committed, the changes committed since the stash was pushed, when it is set.
+func (g *Graveler) stashConflicts(ctx context.Context, stagingToken, stashToken StagingToken, committed DiffIterator) error {
+	it, err := g.StagingManager.List(ctx, stashToken, ListingDefaultBatchSize)
+	if err != nil {
+		return fmt.Errorf("staging list (token %s): %w", stashToken, err)
+	}
+	defer it.Close()
+	haveCommitted := committed != nil && committed.Next()
+	var conflicts []MergeConflict
+	for it.Next() {
+		record := it.Value()
+		for haveCommitted && bytes.Compare(committed.Value().Key, record.Key) < 0 {
+			haveCommitted = committed.Next()
+		}
+		destination, err := g.StagingManager.Get(ctx, stagingToken, record.Key)
+		if errors.Is(err, ErrNotFound) {
+			if !haveCommitted || !bytes.Equal(committed.Value().Key, record.Key) {
+				continue
+			}
+			// the key changed on the branch since the stash was pushed
+			destination = nil
+			if change := committed.Value(); change.Type != DiffTypeRemoved {
+				destination = change.Value
+			}
+		} else if err != nil {
+			return err
+		}
+		if destination == nil && record.Value == nil ||
+			destination != nil && record.Value != nil && bytes.Equal(destination.Identity, record.Value.Identity) {
+			continue
+		}
+		if len(conflicts) >= stashApplyMaxConflicts {
+			return &MergeConflictsError{Conflicts: conflicts, Truncated: true}
+		}
+		conflicts = append(conflicts, MergeConflict{
+			Key:         record.Key.Copy(),
+			Source:      record.Value,
+			Destination: destination,
+		})
+	}
+	if err := it.Err(); err != nil {
+		return err
+	}
+	if committed != nil {
+		if err := committed.Err(); err != nil {
+			return err
+		}
+	}
+	if len(conflicts) > 0 {
+		return &MergeConflictsError{Conflicts: conflicts}
+	}
+	return nil
+}
+
+func (g *Graveler) stashDropNoLock(ctx context.Context, repositoryID RepositoryID, branchID BranchID, stashID StashID) error {
+	stash, err := g.RefManager.GetStash(ctx, repositoryID, branchID, stashID)
+	if err != nil {
+		return err
+	}
+	if err := g.RefManager.DeleteStash(ctx, repositoryID, branchID, stashID); err != nil {
+		return err
+	}
+	if err := g.StagingManager.Drop(ctx, stash.StagingToken); err != nil {
+		g.log.WithContext(ctx).WithFields(logging.Fields{
+			"repository_id": repositoryID,
+			"branch_id":     branchID,
+			"stash_id":      stashID,
+			"staging_token": stash.StagingToken,
+		}).Error("Failed to drop stash staging data")
+	}
+	return nil
+}
+
+// dropBranchStashesNoLock drops all the stashes of the branch together with their staged data
+func (g *Graveler) dropBranchStashesNoLock(ctx context.Context, repositoryID RepositoryID, branchID BranchID) error {
+	it, err := g.RefManager.ListStashes(ctx, repositoryID, branchID)
+	if err != nil {
+		return err
+	}
+	var stashIDs []StashID
+	for it.Next() {
+		stashIDs = append(stashIDs, it.Value().StashID)
+	}
+	err = it.Err()
+	it.Close()
+	if err != nil {
+		return err
+	}
+	for _, stashID := range stashIDs {
+		err := g.stashDropNoLock(ctx, repositoryID, branchID, stashID)
+		if err != nil && !errors.Is(err, ErrStashNotFound) {
+			return err
+		}
+	}
+	return nil
+}
+
+type CommitIDAndSummary struct {
+	ID      CommitID
+	Summary DiffSummary
+}
+
+// Revert creates a reverse patch to the commit given as 'ref', and applies it as a new commit on the given branch.
+// This is implemented by merging the parent of 'ref' into the branch, with 'ref' as the merge base.
+// Example: consider the following tree: C1 -> C2 -> C3, with the branch pointing at C3.
+// To revert C2, we merge C1 into the branch, with C2 as the merge base.
+// That is, try to apply the diff from C2 to C1 on the tip of the branch.
+// If the commit is a merge commit, 'parentNumber' is the parent number (1-based) relative to which the revert is done.
+func (g *Graveler) Revert(ctx context.Context, repositoryID RepositoryID, branchID BranchID, ref Ref, parentNumber int, commitParams CommitParams) (CommitID, error) {
+	ctx = WithBranchReflogOperation(ctx, BranchReflogOperationRevert)
+	commitRecord, err := g.dereferenceCommit(ctx, repositoryID, ref)
+	if err != nil {
+		return "", fmt.Errorf("get commit from ref %s: %w", ref, err)
+	}
+	if len(commitRecord.Parents) > 1 && parentNumber <= 0 {
+		// if commit has more than one parent, must explicitly specify parent number
+		return "", ErrRevertMergeNoParent
+	}
+	if parentNumber > 0 {
+		// validate parent is in range:
+		if parentNumber > len(commitRecord.Parents) { // parent number is 1-based
+			return "", fmt.Errorf("%w: parent %d", ErrRevertParentOutOfRange, parentNumber)
+		}
+		parentNumber--
+	}
+	res, err := g.branchLocker.MetadataUpdater(ctx, repositoryID, branchID, func() (interface{}, error) {
+		isProtected, err := g.protectedBranchesManager.IsBlocked(ctx, repositoryID, branchID, BranchProtectionBlockedAction_REVERT)
+		if err != nil {
+			return nil, err
+		}
+		if isProtected {
+			return nil, ErrRevertProtectedBranch
+		}
+		if err := g.checkSignedCommits(ctx, repositoryID, branchID, ""); err != nil {
+			return nil, err
+		}
+		repo, err := g.RefManager.GetRepository(ctx, repositoryID)
+		if err != nil {
+			return nil, fmt.Errorf("get repo %s: %w", repositoryID, err)
+		}
+		branch, err := g.RefManager.GetBranch(ctx, repositoryID, branchID)
+		if err != nil {
+			return "", fmt.Errorf("get branch %s: %w", branchID, err)
+		}
+		if empty, err := g.stagingEmpty(ctx, branch); err != nil {
+			return "", err
+		} else if !empty {
+			return "", fmt.Errorf("%s: %w", branchID, ErrDirtyBranch)
+		}
+		var parentMetaRangeID MetaRangeID
+		if len(commitRecord.Parents) > 0 {
+			parentCommit, err := g.dereferenceCommit(ctx, repositoryID, commitRecord.Parents[parentNumber].Ref())
+			if err != nil {
+				return "", fmt.Errorf("get commit from ref %s: %w", commitRecord.Parents[parentNumber], err)
+			}
+			parentMetaRangeID = parentCommit.MetaRangeID
+		}
+		branchCommit, err := g.dereferenceCommit(ctx, repositoryID, branch.CommitID.Ref())
+		if err != nil {
+			return "", fmt.Errorf("get commit from ref %s: %w", branch.CommitID, err)
+		}
+		// merge from the parent to the top of the branch, with the given ref as the merge base:
+		metaRangeID, err := g.CommittedManager.Merge(ctx, repo.StorageNamespace, branchCommit.MetaRangeID, parentMetaRangeID, commitRecord.MetaRangeID, MergeStrategyNone, nil)
+		if err != nil {
+			if !errors.Is(err, ErrUserVisible) {
+				err = fmt.Errorf("merge: %w", err)
+			}
+			return "", err
+		}
+		commit := NewCommit()
+		commit.Committer = commitParams.Committer
+		commit.Message = commitParams.Message
+		commit.MetaRangeID = metaRangeID
+		commit.Parents = []CommitID{branch.CommitID}
+		commit.Metadata = commitParams.Metadata
+		commit.Generation = branchCommit.Generation + 1
+		commitID, err := g.RefManager.AddCommit(ctx, repositoryID, commit)
+		if err != nil {
+			return "", fmt.Errorf("add commit: %w", err)
+		}
+		err = g.RefManager.SetBranch(ctx, repositoryID, branchID, Branch{
+			CommitID:     commitID,
+			StagingToken: branch.StagingToken,
+		})
+		if err != nil {
+			return "", fmt.Errorf("set branch: %w", err)
+		}
+		return commitID, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return res.(CommitID), nil
+}
+
+// CherryPick applies the changes introduced by the commit given as 'ref' on top of the given branch, as a new commit.
+// This is implemented by merging 'ref' into the branch, with the parent of 'ref' as the merge base - the forward
+// counterpart of Revert.
+// Example: consider the following tree: C1 -> C2 -> C3, with the branch pointing at C3.
+// To cherry-pick C2, we merge C2 into the branch, with C1 as the merge base.
+// That is, try to apply the diff from C1 to C2 on the tip of the branch.
+// If the commit is a merge commit, 'parentNumber' is the parent number (1-based) relative to which the changes are taken.
+// Commit message and metadata are taken from the cherry-picked commit unless set in 'commitParams'.
+func (g *Graveler) CherryPick(ctx context.Context, repositoryID RepositoryID, branchID BranchID, ref Ref, parentNumber int, commitParams CommitParams) (CommitID, error) {
+	ctx = WithBranchReflogOperation(ctx, BranchReflogOperationCherryPick)
+	commitRecord, err := g.dereferenceCommit(ctx, repositoryID, ref)
+	if err != nil {
+		return "", fmt.Errorf("get commit from ref %s: %w", ref, err)
+	}
+	if len(commitRecord.Parents) > 1 && parentNumber <= 0 {
+		// if commit has more than one parent, must explicitly specify parent number
+		return "", ErrCherryPickMergeNoParent
+	}
+	if parentNumber > 0 {
+		// validate parent is in range:
+		if parentNumber > len(commitRecord.Parents) { // parent number is 1-based
+			return "", fmt.Errorf("%w: parent %d", ErrCherryPickParentOutOfRange, parentNumber)
+		}
+		parentNumber--
+	}
+	var preRunID string
+	var storageNamespace StorageNamespace
+	var commit Commit
+	res, err := g.branchLocker.MetadataUpdater(ctx, repositoryID, branchID, func() (interface{}, error) {
+		isProtected, err := g.protectedBranchesManager.IsBlocked(ctx, repositoryID, branchID, BranchProtectionBlockedAction_COMMIT)
+		if err != nil {
+			return nil, err
+		}
+		if isProtected {
+			return nil, ErrCommitToProtectedBranch
+		}
+		if err := g.checkSignedCommits(ctx, repositoryID, branchID, ""); err != nil {
+			return nil, err
+		}
+		repo, err := g.RefManager.GetRepository(ctx, repositoryID)
+		if err != nil {
+			return nil, fmt.Errorf("get repo %s: %w", repositoryID, err)
+		}
+		storageNamespace = repo.StorageNamespace
+		branch, err := g.RefManager.GetBranch(ctx, repositoryID, branchID)
+		if err != nil {
+			return nil, fmt.Errorf("get branch %s: %w", branchID, err)
+		}
+		if empty, err := g.stagingEmpty(ctx, branch); err != nil {
+			return nil, err
+		} else if !empty {
+			return nil, fmt.Errorf("%s: %w", branchID, ErrDirtyBranch)
+		}
+		var parentMetaRangeID MetaRangeID
+		if len(commitRecord.Parents) > 0 {
+			parentCommit, err := g.dereferenceCommit(ctx, repositoryID, commitRecord.Parents[parentNumber].Ref())
+			if err != nil {
+				return nil, fmt.Errorf("get commit from ref %s: %w", commitRecord.Parents[parentNumber], err)
+			}
+			parentMetaRangeID = parentCommit.MetaRangeID
+		}
+		branchCommit, err := g.dereferenceCommit(ctx, repositoryID, branch.CommitID.Ref())
+		if err != nil {
+			return nil, fmt.Errorf("get commit from ref %s: %w", branch.CommitID, err)
+		}
+		// merge from the commit to the top of the branch, with the commit's parent as the merge base.  A root commit
+		// has the empty metarange as its merge base, all of its content is picked:
+		metaRangeID, err := g.CommittedManager.Merge(ctx, storageNamespace, branchCommit.MetaRangeID, commitRecord.MetaRangeID, parentMetaRangeID, MergeStrategyNone, nil)
+		if err != nil {
+			if !errors.Is(err, ErrUserVisible) {
+				err = fmt.Errorf("merge: %w", err)
+			}
+			return nil, err
+		}
+		if metaRangeID == "" {
+			// the picked commit introduced no changes relative to its parent
+			return nil, fmt.Errorf("cherry-pick %s: %w", commitRecord.CommitID, ErrNoChanges)
+		}
+		if metaRangeID == branchCommit.MetaRangeID {
+			// the branch already contains the changes of the picked commit
+			return nil, fmt.Errorf("cherry-pick %s: %w", commitRecord.CommitID, ErrNoChanges)
+		}
+		commit = NewCommit()
+		commit.Committer = commitParams.Committer
+		commit.Message = commitParams.Message
+		if commit.Message == "" {
+			commit.Message = commitRecord.Message
+		}
+		commit.Metadata = commitParams.Metadata
+		if commit.Metadata == nil {
+			commit.Metadata = commitRecord.Metadata
+		}
+		commit.MetaRangeID = metaRangeID
+		commit.Parents = []CommitID{branch.CommitID}
+		commit.Generation = branchCommit.Generation + 1
+
+		preRunID = NewRunID()
+		err = g.hooks.PreCommitHook(ctx, HookRecord{
+			RunID:            preRunID,
+			EventType:        EventTypePreCommit,
+			SourceRef:        commitRecord.CommitID.Ref(),
+			RepositoryID:     repositoryID,
+			StorageNamespace: storageNamespace,
+			BranchID:         branchID,
+			Commit:           commit,
+		})
+		if err != nil {
+			return nil, &HookAbortError{
+				EventType: EventTypePreCommit,
+				RunID:     preRunID,
+				Err:       err,
+			}
+		}
+
+		commitID, err := g.RefManager.AddCommit(ctx, repositoryID, commit)
+		if err != nil {
+			return nil, fmt.Errorf("add commit: %w", err)
+		}
+		err = g.RefManager.SetBranch(ctx, repositoryID, branchID, Branch{
+			CommitID:     commitID,
+			StagingToken: branch.StagingToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("set branch: %w", err)
+		}
+		return commitID, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	newCommitID := res.(CommitID)
+	postRunID := NewRunID()
+	err = g.hooks.PostCommitHook(ctx, HookRecord{
+		EventType:        EventTypePostCommit,
+		RunID:            postRunID,
+		RepositoryID:     repositoryID,
+		StorageNamespace: storageNamespace,
+		SourceRef:        commitRecord.CommitID.Ref(),
+		BranchID:         branchID,
+		Commit:           commit,
+		CommitID:         newCommitID,
+		PreRunID:         preRunID,
+	})
+	if err != nil {
+		g.log.WithError(err).
+			WithField("run_id", postRunID).
+			WithField("pre_run_id", preRunID).
+			Error("Post-commit hook failed")
+	}
+	return newCommitID, nil
+}
+
+func (g *Graveler) Rebase(ctx context.Context, repositoryID RepositoryID, branchID BranchID, onto Ref) (CommitID, error) {
+	ctx = WithBranchReflogOperation(ctx, BranchReflogOperationRebase)
+	ontoCommit, err := g.dereferenceCommit(ctx, repositoryID, onto)
+	if err != nil {
+		return "", fmt.Errorf("get commit from ref %s: %w", onto, err)
+	}
+	res, err := g.branchLocker.MetadataUpdater(ctx, repositoryID, branchID, func() (interface{}, error) {
+		isProtected, err := g.protectedBranchesManager.IsBlocked(ctx, repositoryID, branchID, BranchProtectionBlockedAction_COMMIT)
 		if err != nil {
 			return nil, err
 		}
 		if isProtected {
-			return nil, ErrWriteToProtectedBranch
-		}
-		branch, err := g.RefManager.GetBranch(ctx, repositoryID, branchID)
-		if err != nil {
-			return nil, err
+			return nil, ErrCommitToProtectedBranch
 		}
-		return nil, g.StagingManager.DropKey(ctx, branch.StagingToken, key)
-	})
-	return err
-}
-
-func (g *Graveler) ResetPrefix(ctx context.Context, repositoryID RepositoryID, branchID BranchID, key Key) error {
-	_, err := g.branchLocker.Writer(ctx, repositoryID, branchID, func() (interface{}, error) {
-		isProtected, err := g.protectedBranchesManager.IsBlocked(ctx, repositoryID, branchID, BranchProtectionBlockedAction_STAGING_WRITE)
+		isProtected, err = g.protectedBranchesManager.IsBlocked(ctx, repositoryID, branchID, BranchProtectionBlockedAction_UPDATE_POINTER)
 		if err != nil {
 			return nil, err
 		}
 		if isProtected {
-			return nil, ErrWriteToProtectedBranch
+			return nil, ErrUpdateProtectedBranch
 		}
-		branch, err := g.RefManager.GetBranch(ctx, repositoryID, branchID)
-		if err != nil {
+		if err := g.checkSignedCommits(ctx, repositoryID, branchID, ""); err != nil {
 			return nil, err
 		}
-		return nil, g.StagingManager.DropByPrefix(ctx, branch.StagingToken, key)
-	})
-	return err
-}
-
-type CommitIDAndSummary struct {
-	ID      CommitID
-	Summary DiffSummary
-}
-
-// Revert creates a reverse patch to the commit given as 'ref', and applies it as a new commit on the given branch.
-// This is implemented by merging the parent of 'ref' into the branch, with 'ref' as the merge base.
-// Example: consider the following tree: C1 -> C2 -> C3, with the branch pointing at C3.
-// To revert C2, we merge C1 into the branch, with C2 as the merge base.
-// That is, try to apply the diff from C2 to C1 on the tip of the branch.
-// If the commit is a merge commit, 'parentNumber' is the parent number (1-based) relative to which the revert is done.
-func (g *Graveler) Revert(ctx context.Context, repositoryID RepositoryID, branchID BranchID, ref Ref, parentNumber int, commitParams CommitParams) (CommitID, error) {
-	commitRecord, err := g.dereferenceCommit(ctx, repositoryID, ref)
-	if err != nil {
-		return "", fmt.Errorf("get commit from ref %s: %w", ref, err)
-	}
-	if len(commitRecord.Parents) > 1 && parentNumber <= 0 {
-		// if commit has more than one parent, must explicitly specify parent number
-		return "", ErrRevertMergeNoParent
-	}
-	if parentNumber > 0 {
-		// validate parent is in range:
-		if parentNumber > len(commitRecord.Parents) { // parent number is 1-based
-			return "", fmt.Errorf("%w: parent %d", ErrRevertParentOutOfRange, parentNumber)
-		}
-		parentNumber--
-	}
-	res, err := g.branchLocker.MetadataUpdater(ctx, repositoryID, branchID, func() (interface{}, error) {
 		repo, err := g.RefManager.GetRepository(ctx, repositoryID)
 		if err != nil {
 			return nil, fmt.Errorf("get repo %s: %w", repositoryID, err)
 		}
 		branch, err := g.RefManager.GetBranch(ctx, repositoryID, branchID)
 		if err != nil {
-			return "", fmt.Errorf("get branch %s: %w", branchID, err)
+			return nil, fmt.Errorf("get branch %s: %w", branchID, err)
 		}
 		if empty, err := g.stagingEmpty(ctx, branch); err != nil {
-			return "", err
+			return nil, err
 		} else if !empty {
-			return "", fmt.Errorf("%s: %w", branchID, ErrDirtyBranch)
+			return nil, fmt.Errorf("%s: %w", branchID, ErrDirtyBranch)
 		}
-		var parentMetaRangeID MetaRangeID
-		if len(commitRecord.Parents) > 0 {
-			parentCommit, err := g.dereferenceCommit(ctx, repositoryID, commitRecord.Parents[parentNumber].Ref())
+		commits, upToDate, err := g.rebaseCommits(ctx, repositoryID, branch.CommitID, ontoCommit.CommitID)
+		if err != nil {
+			return nil, err
+		}
+		if upToDate {
+			return branch.CommitID, nil
+		}
+		head := ontoCommit
+		for _, commitRecord := range commits {
+			var parentMetaRangeID MetaRangeID
+			if len(commitRecord.Parents) > 0 {
+				parentCommit, err := g.RefManager.GetCommit(ctx, repositoryID, commitRecord.Parents[0])
+				if err != nil {
+					return nil, fmt.Errorf("get commit %s: %w", commitRecord.Parents[0], err)
+				}
+				parentMetaRangeID = parentCommit.MetaRangeID
+			}
+			// merge from the commit to the rebased head, with the commit's parent as the merge base:
+			metaRangeID, err := g.CommittedManager.Merge(ctx, repo.StorageNamespace, head.MetaRangeID, commitRecord.MetaRangeID, parentMetaRangeID, MergeStrategyNone, nil)
+			if errors.Is(err, ErrConflictFound) {
+				return nil, &RebaseConflictError{CommitID: commitRecord.CommitID, Err: err}
+			}
 			if err != nil {
-				return "", fmt.Errorf("get commit from ref %s: %w", commitRecord.Parents[parentNumber], err)
+				if !errors.Is(err, ErrUserVisible) {
+					err = fmt.Errorf("merge: %w", err)
+				}
+				return nil, err
 			}
-			parentMetaRangeID = parentCommit.MetaRangeID
+			if metaRangeID == "" || metaRangeID == head.MetaRangeID {
+				// the commit is empty, or its changes are already found on the new base
+				continue
+			}
+			commit := NewCommit()
+			commit.Committer = commitRecord.Committer
+			commit.Message = commitRecord.Message
+			commit.Metadata = commitRecord.Metadata
+			commit.MetaRangeID = metaRangeID
+			commit.Parents = []CommitID{head.CommitID}
+			commit.Generation = head.Generation + 1
+			commitID, err := g.RefManager.AddCommit(ctx, repositoryID, commit)
+			if err != nil {
+				return nil, fmt.Errorf("add commit: %w", err)
+			}
+			head = &CommitRecord{CommitID: commitID, Commit: &commit}
 		}
-		branchCommit, err := g.dereferenceCommit(ctx, repositoryID, branch.CommitID.Ref())
+		err = g.RefManager.SetBranch(ctx, repositoryID, branchID, Branch{
+			CommitID:     head.CommitID,
+			StagingToken: branch.StagingToken,
+		})
 		if err != nil {
-			return "", fmt.Errorf("get commit from ref %s: %w", branch.CommitID, err)
+			return nil, fmt.Errorf("set branch: %w", err)
 		}
-		// merge from the parent to the top of the branch, with the given ref as the merge base:
-		metaRangeID, err := g.CommittedManager.Merge(ctx, repo.StorageNamespace, branchCommit.MetaRangeID, parentMetaRangeID, commitRecord.MetaRangeID, MergeStrategyNone)
+		return head.CommitID, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return res.(CommitID), nil
+}
+
+// rebaseCommits returns the commits reachable from 'head' but not from 'onto' that a rebase of 'head' on 'onto'
+// replays, ordered so that each commit follows its ancestors. Merge commits are skipped.
+// upToDate is set when 'onto' is an ancestor of 'head' and the commits above it are already linear.
+func (g *Graveler) rebaseCommits(ctx context.Context, repositoryID RepositoryID, head, onto CommitID) ([]*CommitRecord, bool, error) {
+	addressProvider := ident.NewHexAddressProvider()
+	baseCommit, err := g.RefManager.FindMergeBase(ctx, repositoryID, head, onto)
+	if err != nil {
+		return nil, false, fmt.Errorf("find merge base: %w", err)
+	}
+	if baseCommit == nil {
+		return nil, false, ErrNoMergeBase
+	}
+	baseCommitID := CommitID(addressProvider.ContentAddress(baseCommit))
+
+	// a commit is reachable from 'onto' when it is the merge base of itself and 'onto'
+	reachable := map[CommitID]bool{baseCommitID: true, onto: true}
+	isReachable := func(commitID CommitID) (bool, error) {
+		if r, ok := reachable[commitID]; ok {
+			return r, nil
+		}
+		commonCommit, err := g.RefManager.FindMergeBase(ctx, repositoryID, commitID, onto)
 		if err != nil {
-			if !errors.Is(err, ErrUserVisible) {
-				err = fmt.Errorf("merge: %w", err)
-			}
-			return "", err
+			return false, fmt.Errorf("find merge base: %w", err)
 		}
-		commit := NewCommit()
-		commit.Committer = commitParams.Committer
-		commit.Message = commitParams.Message
-		commit.MetaRangeID = metaRangeID
-		commit.Parents = []CommitID{branch.CommitID}
-		commit.Metadata = commitParams.Metadata
-		commit.Generation = branchCommit.Generation + 1
-		commitID, err := g.RefManager.AddCommit(ctx, repositoryID, commit)
+		r := commonCommit != nil && CommitID(addressProvider.ContentAddress(commonCommit)) == commitID
+		reachable[commitID] = r
+		return r, nil
+	}
+
+	var commits []*CommitRecord
+	hasMerges := false
+	visited := make(map[CommitID]struct{})
+	queue := []CommitID{head}
+	for len(queue) > 0 {
+		commitID := queue[0]
+		queue = queue[1:]
+		if _, ok := visited[commitID]; ok {
+			continue
+		}
+		visited[commitID] = struct{}{}
+		r, err := isReachable(commitID)
 		if err != nil {
-			return "", fmt.Errorf("add commit: %w", err)
+			return nil, false, err
 		}
-		err = g.RefManager.SetBranch(ctx, repositoryID, branchID, Branch{
-			CommitID:     commitID,
-			StagingToken: branch.StagingToken,
-		})
+		if r {
+			continue
+		}
+		commit, err := g.RefManager.GetCommit(ctx, repositoryID, commitID)
 		if err != nil {
-			return "", fmt.Errorf("set branch: %w", err)
+			return nil, false, fmt.Errorf("get commit %s: %w", commitID, err)
 		}
-		return commitID, nil
+		queue = append(queue, commit.Parents...)
+		if len(commit.Parents) > 1 {
+			hasMerges = true
+			continue
+		}
+		commits = append(commits, &CommitRecord{CommitID: commitID, Commit: commit})
+	}
+	if baseCommitID == onto && !hasMerges {
+		return nil, true, nil
+	}
+	sort.SliceStable(commits, func(i, j int) bool {
+		if commits[i].Generation != commits[j].Generation {
+			return commits[i].Generation < commits[j].Generation
+		}
+		return commits[i].CreationDate.Before(commits[j].CreationDate)
 	})
+	return commits, false, nil
+}
+
+// mergeSourceBranch returns the branch a merge source reference resolves to, or an empty BranchID if the
+// reference does not resolve to a branch
+func (g *Graveler) mergeSourceBranch(ctx context.Context, repositoryID RepositoryID, source Ref) (BranchID, error) {
+	reference, err := g.Dereference(ctx, repositoryID, source)
 	if err != nil {
 		return "", err
 	}
-	return res.(CommitID), nil
+	if reference.Type != ReferenceTypeBranch {
+		return "", nil
+	}
+	return reference.BranchID, nil
 }
 
-func (g *Graveler) Merge(ctx context.Context, repositoryID RepositoryID, destination BranchID, source Ref, commitParams CommitParams, strategy string) (CommitID, error) {
+func (g *Graveler) Merge(ctx context.Context, repositoryID RepositoryID, destination BranchID, source Ref, commitParams CommitParams, strategy string, strategyRules []*MergeStrategyRule, mode MergeMode) (CommitID, error) {
+	ctx = WithBranchReflogOperation(ctx, BranchReflogOperationMerge)
 	var preRunID string
 	var storageNamespace StorageNamespace
 	var commit Commit
 	res, err := g.branchLocker.MetadataUpdater(ctx, repositoryID, destination, func() (interface{}, error) {
+		sourceBranchID, err := g.mergeSourceBranch(ctx, repositoryID, source)
+		if err != nil {
+			return nil, err
+		}
+		isProtected, err := g.protectedBranchesManager.IsMergeBlocked(ctx, repositoryID, destination, sourceBranchID)
+		if err != nil {
+			return nil, err
+		}
+		if isProtected {
+			return nil, ErrMergeToProtectedBranch
+		}
 		repo, err := g.RefManager.GetRepository(ctx, repositoryID)
 		if err != nil {
 			return nil, err
@@ -1625,32 +3376,63 @@ func (g *Graveler) Merge(ctx context.Context, repositoryID RepositoryID, destina
 			"source_meta_range":      fromCommit.MetaRangeID,
 			"destination_meta_range": toCommit.MetaRangeID,
 			"base_meta_range":        baseCommit.MetaRangeID,
+			"mode":                   mode,
 		}).Trace("Merge")
-		mergeStrategy := MergeStrategyNone
-		if strategy == "dest-wins" {
-			mergeStrategy = MergeStrategyDest
+		baseCommitID := CommitID(ident.NewHexAddressProvider().ContentAddress(baseCommit))
+		if baseCommitID == fromCommit.CommitID {
+			// destination already contains source, there is nothing to merge
+			return nil, fmt.Errorf("%s: %w", destination, ErrNoChanges)
 		}
-		if strategy == "source-wins" {
-			mergeStrategy = MergeStrategySource
+		fastForward := false
+		if mode == MergeModeFastForward || mode == MergeModeFastForwardOnly {
+			fastForward = baseCommitID == toCommit.CommitID && fromCommit.CommitID != toCommit.CommitID
 		}
-		metaRangeID, err := g.CommittedManager.Merge(ctx, storageNamespace, toCommit.MetaRangeID, fromCommit.MetaRangeID, baseCommit.MetaRangeID, mergeStrategy)
-		if err != nil {
-			if !errors.Is(err, ErrUserVisible) {
-				err = fmt.Errorf("merge in CommitManager: %w", err)
-			}
+		if mode == MergeModeFastForwardOnly && !fastForward {
+			return nil, fmt.Errorf("%s: %w", destination, ErrNotFastForward)
+		}
+		// only a fast-forward merge keeps the destination pointing to a commit that may be signed
+		var signedCommitID CommitID
+		if fastForward {
+			signedCommitID = fromCommit.CommitID
+		}
+		if err := g.checkSignedCommits(ctx, repositoryID, destination, signedCommitID); err != nil {
 			return nil, err
 		}
-		commit = NewCommit()
-		commit.Committer = commitParams.Committer
-		commit.Message = commitParams.Message
-		commit.MetaRangeID = metaRangeID
-		commit.Parents = []CommitID{toCommit.CommitID, fromCommit.CommitID}
-		if toCommit.Generation > fromCommit.Generation {
-			commit.Generation = toCommit.Generation + 1
+		if fastForward {
+			commit = *fromCommit.Commit
 		} else {
-			commit.Generation = fromCommit.Generation + 1
+			rules, err := g.mergeStrategyRules(ctx, repositoryID, strategy, strategyRules)
+			if err != nil {
+				return nil, err
+			}
+			metaRangeID, err := g.CommittedManager.Merge(ctx, storageNamespace, toCommit.MetaRangeID, fromCommit.MetaRangeID, baseCommit.MetaRangeID, mergeStrategyFromString(strategy), rules)
+			if err != nil {
+				if !errors.Is(err, ErrUserVisible) {
+					err = fmt.Errorf("merge in CommitManager: %w", err)
+				}
+				return nil, err
+			}
+			if metaRangeID == "" {
+				// source commits added no changes since the merge base
+				return nil, fmt.Errorf("%s: %w", destination, ErrNoChanges)
+			}
+			commit = NewCommit()
+			commit.Committer = commitParams.Committer
+			commit.Message = commitParams.Message
+			commit.MetaRangeID = metaRangeID
+			switch {
+			case mode == MergeModeSquash:
+				commit.Parents = []CommitID{toCommit.CommitID}
+				commit.Generation = toCommit.Generation + 1
+			case toCommit.Generation > fromCommit.Generation:
+				commit.Parents = []CommitID{toCommit.CommitID, fromCommit.CommitID}
+				commit.Generation = toCommit.Generation + 1
+			default:
+				commit.Parents = []CommitID{toCommit.CommitID, fromCommit.CommitID}
+				commit.Generation = fromCommit.Generation + 1
+			}
+			commit.Metadata = commitParams.Metadata
 		}
-		commit.Metadata = commitParams.Metadata
 		preRunID = NewRunID()
 		err = g.hooks.PreMergeHook(ctx, HookRecord{
 			EventType:        EventTypePreMerge,
@@ -1668,9 +3450,12 @@ func (g *Graveler) Merge(ctx context.Context, repositoryID RepositoryID, destina
 				Err:       err,
 			}
 		}
-		commitID, err := g.RefManager.AddCommit(ctx, repositoryID, commit)
-		if err != nil {
-			return nil, fmt.Errorf("add commit: %w", err)
+		commitID := fromCommit.CommitID
+		if !fastForward {
+			commitID, err = g.RefManager.AddCommit(ctx, repositoryID, commit)
+			if err != nil {
+				return nil, fmt.Errorf("add commit: %w", err)
+			}
 		}
 		branch.CommitID = commitID
 		err = g.RefManager.SetBranch(ctx, repositoryID, destination, *branch)
@@ -1704,6 +3489,160 @@ func (g *Graveler) Merge(ctx context.Context, repositoryID RepositoryID, destina
 	return res.(CommitID), nil
 }
 
+// MergePreview returns the expected outcome of Merge without performing it: the commits involved, a summary of
+// the changes applied on the destination grouped by top-level prefix, and the conflicts that fail the merge.
+func (g *Graveler) MergePreview(ctx context.Context, repositoryID RepositoryID, destination BranchID, source Ref, strategy string, strategyRules []*MergeStrategyRule) (*MergePreview, error) {
+	repo, err := g.RefManager.GetRepository(ctx, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	branch, err := g.GetBranch(ctx, repositoryID, destination)
+	if err != nil {
+		return nil, fmt.Errorf("get branch: %w", err)
+	}
+	empty, err := g.stagingEmpty(ctx, branch)
+	if err != nil {
+		return nil, fmt.Errorf("check if staging empty: %w", err)
+	}
+	if !empty {
+		return nil, fmt.Errorf("%s: %w", destination, ErrDirtyBranch)
+	}
+	fromCommit, toCommit, baseCommit, err := g.getCommitsForMerge(ctx, repositoryID, source, Ref(destination))
+	if err != nil {
+		return nil, err
+	}
+	baseCommitID := CommitID(ident.NewHexAddressProvider().ContentAddress(baseCommit))
+	preview := &MergePreview{
+		SourceCommitID:      fromCommit.CommitID,
+		DestinationCommitID: toCommit.CommitID,
+		BaseCommitID:        baseCommitID,
+		FastForward:         baseCommitID == toCommit.CommitID && fromCommit.CommitID != toCommit.CommitID,
+		Summary:             make(map[string]DiffSummary),
+	}
+
+	rules, err := g.mergeStrategyRules(ctx, repositoryID, strategy, strategyRules)
+	if err != nil {
+		return nil, err
+	}
+	err = g.CommittedManager.CheckMerge(ctx, repo.StorageNamespace, toCommit.MetaRangeID, fromCommit.MetaRangeID, baseCommit.MetaRangeID, mergeStrategyFromString(strategy), rules)
+	var conflictsErr *MergeConflictsError
+	switch {
+	case errors.As(err, &conflictsErr):
+		preview.Conflicts = conflictsErr.Conflicts
+		preview.ConflictsTruncated = conflictsErr.Truncated
+	case err != nil:
+		return nil, fmt.Errorf("check merge: %w", err)
+	}
+
+	it, err := g.CommittedManager.Compare(ctx, repo.StorageNamespace, toCommit.MetaRangeID, fromCommit.MetaRangeID, baseCommit.MetaRangeID)
+	if err != nil {
+		return nil, fmt.Errorf("compare: %w", err)
+	}
+	defer it.Close()
+	for it.Next() {
+		diff := it.Value()
+		prefix := topLevelPrefix(diff.Key)
+		summary, ok := preview.Summary[prefix]
+		if !ok {
+			summary = DiffSummary{Count: make(map[DiffType]int)}
+			preview.Summary[prefix] = summary
+		}
+		summary.Count[diff.Type]++
+	}
+	if err := it.Err(); err != nil {
+		return nil, fmt.Errorf("compare: %w", err)
+	}
+	return preview, nil
+}
+
+func (g *Graveler) CompareBranch(ctx context.Context, repositoryID RepositoryID, branchID BranchID, base Ref) (*BranchComparison, error) {
+	repo, err := g.RefManager.GetRepository(ctx, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	branch, err := g.RefManager.GetBranch(ctx, repositoryID, branchID)
+	if err != nil {
+		return nil, err
+	}
+	baseCommit, err := g.dereferenceCommit(ctx, repositoryID, base)
+	if err != nil {
+		return nil, fmt.Errorf("get commit by ref %s: %w", base, err)
+	}
+	branchCommit, err := g.RefManager.GetCommit(ctx, repositoryID, branch.CommitID)
+	if err != nil {
+		return nil, err
+	}
+	comparison := &BranchComparison{
+		BranchID:     branchID,
+		CommitID:     branch.CommitID,
+		BaseCommitID: baseCommit.CommitID,
+		Summary:      DiffSummary{Count: make(map[DiffType]int)},
+	}
+	mergeBase, err := g.RefManager.FindMergeBase(ctx, repositoryID, branch.CommitID, baseCommit.CommitID)
+	if err != nil {
+		return nil, fmt.Errorf("find merge base: %w", err)
+	}
+	// without a merge base, all the objects of the branch are changes
+	var mergeBaseMetaRangeID MetaRangeID
+	if mergeBase != nil {
+		comparison.MergeBaseID = CommitID(ident.NewHexAddressProvider().ContentAddress(mergeBase))
+		mergeBaseMetaRangeID = mergeBase.MetaRangeID
+	}
+	comparison.Ahead, comparison.Behind, err = g.RefManager.CountAheadBehind(ctx, repositoryID, branch.CommitID, baseCommit.CommitID)
+	if err != nil {
+		return nil, fmt.Errorf("count commits: %w", err)
+	}
+	it, err := g.CommittedManager.Diff(ctx, repo.StorageNamespace, mergeBaseMetaRangeID, branchCommit.MetaRangeID)
+	if err != nil {
+		return nil, fmt.Errorf("diff: %w", err)
+	}
+	defer it.Close()
+	for it.Next() {
+		comparison.Summary.Count[it.Value().Type]++
+	}
+	if err := it.Err(); err != nil {
+		return nil, fmt.Errorf("diff: %w", err)
+	}
+	return comparison, nil
+}
+
+// topLevelPrefix returns the first path element of key, including its trailing delimiter, or an
+// empty prefix for keys that have no delimiter.
+func topLevelPrefix(key Key) string {
+	idx := bytes.IndexByte(key, '/')
+	if idx < 0 {
+		return ""
+	}
+	return string(key[:idx+1])
+}
+
+// mergeStrategyRules returns the rules that resolve conflicts of a merge: the rules given for the merge, followed by
+// the merge strategy rules of the repository.  A strategy given for the merge resolves every other conflict, so it
+// takes precedence over the repository rules and they are not used.
+func (g *Graveler) mergeStrategyRules(ctx context.Context, repositoryID RepositoryID, strategy string, strategyRules []*MergeStrategyRule) ([]*MergeStrategyRule, error) {
+	if mergeStrategyFromString(strategy) != MergeStrategyNone {
+		return strategyRules, nil
+	}
+	repositoryRules, err := g.mergeStrategyRulesManager.GetRules(ctx, repositoryID)
+	if err != nil {
+		return nil, fmt.Errorf("get merge strategy rules: %w", err)
+	}
+	rules := make([]*MergeStrategyRule, 0, len(strategyRules)+len(repositoryRules.GetRules()))
+	rules = append(rules, strategyRules...)
+	return append(rules, repositoryRules.GetRules()...), nil
+}
+
+func mergeStrategyFromString(strategy string) MergeStrategy {
+	switch strategy {
+	case mergeStrategyDestWins:
+		return MergeStrategyDest
+	case mergeStrategySourceWins:
+		return MergeStrategySource
+	default:
+		return MergeStrategyNone
+	}
+}
+
 func (g *Graveler) DiffUncommitted(ctx context.Context, repositoryID RepositoryID, branchID BranchID) (DiffIterator, error) {
 	repo, err := g.RefManager.GetRepository(ctx, repositoryID)
 	if err != nil {
@@ -1807,6 +3746,32 @@ func (g *Graveler) Compare(ctx context.Context, repositoryID RepositoryID, left,
 	return g.CommittedManager.Compare(ctx, repo.StorageNamespace, toCommit.MetaRangeID, fromCommit.MetaRangeID, baseCommit.MetaRangeID)
 }
 
+func (g *Graveler) DiffSummary(ctx context.Context, repositoryID RepositoryID, left, right Ref, params DiffSummaryParams) (map[string]DiffSummary, error) {
+	repo, err := g.RefManager.GetRepository(ctx, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	rightCommit, err := g.dereferenceCommit(ctx, repositoryID, right)
+	if err != nil {
+		return nil, err
+	}
+	var leftMetaRangeID MetaRangeID
+	if params.ThreeDot {
+		_, _, baseCommit, err := g.getCommitsForMerge(ctx, repositoryID, left, right)
+		if err != nil {
+			return nil, err
+		}
+		leftMetaRangeID = baseCommit.MetaRangeID
+	} else {
+		leftCommit, err := g.dereferenceCommit(ctx, repositoryID, left)
+		if err != nil {
+			return nil, err
+		}
+		leftMetaRangeID = leftCommit.MetaRangeID
+	}
+	return g.CommittedManager.SummarizeDiff(ctx, repo.StorageNamespace, leftMetaRangeID, rightCommit.MetaRangeID, params)
+}
+
 func (g *Graveler) SetHooksHandler(handler HooksHandler) {
 	if handler == nil {
 		g.hooks = &HooksNoOp{}
@@ -1860,14 +3825,16 @@ func (g *Graveler) LoadCommits(ctx context.Context, repositoryID RepositoryID, m
 			missingGenerations = true
 		}
 		commitID, err := g.RefManager.AddCommit(ctx, repositoryID, Commit{
-			Version:      CommitVersion(commit.Version),
-			Committer:    commit.GetCommitter(),
-			Message:      commit.GetMessage(),
-			MetaRangeID:  MetaRangeID(commit.GetMetaRangeId()),
-			CreationDate: commit.GetCreationDate().AsTime(),
-			Parents:      parents,
-			Metadata:     commit.GetMetadata(),
-			Generation:   int(commit.GetGeneration()),
+			Version:        CommitVersion(commit.Version),
+			Committer:      commit.GetCommitter(),
+			Message:        commit.GetMessage(),
+			MetaRangeID:    MetaRangeID(commit.GetMetaRangeId()),
+			CreationDate:   commit.GetCreationDate().AsTime(),
+			Parents:        parents,
+			Metadata:       commit.GetMetadata(),
+			Generation:     int(commit.GetGeneration()),
+			SignatureKeyID: commit.GetSignatureKeyId(),
+			Signature:      commit.GetSignature(),
 		})
 		if err != nil {
 			return err
@@ -1889,6 +3856,7 @@ func (g *Graveler) LoadCommits(ctx context.Context, repositoryID RepositoryID, m
 }
 
 func (g *Graveler) LoadBranches(ctx context.Context, repositoryID RepositoryID, metaRangeID MetaRangeID) error {
+	ctx = WithBranchReflogOperation(ctx, BranchReflogOperationLoad)
 	repo, err := g.GetRepository(ctx, repositoryID)
 	if err != nil {
 		return err
@@ -1938,7 +3906,16 @@ func (g *Graveler) LoadTags(ctx context.Context, repositoryID RepositoryID, meta
 			return err
 		}
 		tagID := TagID(tag.Id)
-		err = g.RefManager.CreateTag(ctx, repositoryID, tagID, CommitID(tag.CommitId))
+		if tag.CreationDate != nil {
+			err = g.RefManager.CreateAnnotatedTag(ctx, repositoryID, tagID, CommitID(tag.CommitId), TagAnnotation{
+				Message:      tag.Message,
+				Tagger:       tag.Tagger,
+				CreationDate: tag.CreationDate.AsTime(),
+				Metadata:     tag.Metadata,
+			})
+		} else {
+			err = g.RefManager.CreateTag(ctx, repositoryID, tagID, CommitID(tag.CommitId))
+		}
 		if err != nil {
 			return err
 		}
@@ -1957,6 +3934,14 @@ func (g *Graveler) GetMetaRange(ctx context.Context, repositoryID RepositoryID,
 	return g.CommittedManager.GetMetaRange(ctx, repo.StorageNamespace, metaRangeID)
 }
 
+func (g *Graveler) ListMetaRange(ctx context.Context, repositoryID RepositoryID, metaRangeID MetaRangeID) (ValueIterator, error) {
+	repo, err := g.RefManager.GetRepository(ctx, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	return g.CommittedManager.List(ctx, repo.StorageNamespace, metaRangeID)
+}
+
 func (g *Graveler) GetRange(ctx context.Context, repositoryID RepositoryID, rangeID RangeID) (RangeInfo, error) {
 	repo, err := g.RefManager.GetRepository(ctx, repositoryID)
 	if err != nil {
@@ -2061,10 +4046,17 @@ func (t *tagValueIterator) setValue() bool {
 		return false
 	}
 	tag := t.src.Value()
-	data, err := proto.Marshal(&TagData{
+	tagData := &TagData{
 		Id:       string(tag.TagID),
 		CommitId: string(tag.CommitID),
-	})
+	}
+	if tag.Annotation != nil {
+		tagData.Message = tag.Annotation.Message
+		tagData.Tagger = tag.Annotation.Tagger
+		tagData.CreationDate = timestamppb.New(tag.Annotation.CreationDate)
+		tagData.Metadata = tag.Annotation.Metadata
+	}
+	data, err := proto.Marshal(tagData)
 	if err != nil {
 		t.err = err
 		return false
@@ -2188,15 +4180,17 @@ func (c *commitValueIterator) setValue() bool {
 	}
 	commit := c.src.Value()
 	data, err := proto.Marshal(&CommitData{
-		Version:      int32(commit.Version),
-		Id:           string(commit.CommitID),
-		Committer:    commit.Committer,
-		Message:      commit.Message,
-		CreationDate: timestamppb.New(commit.CreationDate),
-		MetaRangeId:  string(commit.MetaRangeID),
-		Metadata:     commit.Metadata,
-		Parents:      commit.Parents.AsStringSlice(),
-		Generation:   int32(commit.Generation),
+		Version:        int32(commit.Version),
+		Id:             string(commit.CommitID),
+		Committer:      commit.Committer,
+		Message:        commit.Message,
+		CreationDate:   timestamppb.New(commit.CreationDate),
+		MetaRangeId:    string(commit.MetaRangeID),
+		Metadata:       commit.Metadata,
+		Parents:        commit.Parents.AsStringSlice(),
+		Generation:     int32(commit.Generation),
+		SignatureKeyId: commit.SignatureKeyID,
+		Signature:      commit.Signature,
 	})
 	if err != nil {
 		c.err = err
@@ -2233,20 +4227,65 @@ func (c *commitValueIterator) Close() {
 	c.src.Close()
 }
 
+// GarbageCollectionRuleCommits are the commits expired and kept according to the retention rule of Prefix.  The
+// rule of the empty Prefix applies to the objects under no prefix rule.
+type GarbageCollectionRuleCommits struct {
+	Prefix  string
+	Expired []CommitID
+	Active  []CommitID
+}
+
+// GarbageCollectionValues are the values of the commits expired and kept according to the retention rule of Prefix
+type GarbageCollectionValues struct {
+	Prefix  string
+	Expired ValueIterator
+	Active  ValueIterator
+}
+
 type GarbageCollectionManager interface {
 	GetRules(ctx context.Context, storageNamespace StorageNamespace) (*GarbageCollectionRules, error)
 	SaveRules(ctx context.Context, storageNamespace StorageNamespace, rules *GarbageCollectionRules) error
 
 	SaveGarbageCollectionCommits(ctx context.Context, storageNamespace StorageNamespace, repositoryID RepositoryID, rules *GarbageCollectionRules, previouslyExpiredCommits []CommitID) (string, error)
 	GetRunExpiredCommits(ctx context.Context, storageNamespace StorageNamespace, runID string) ([]CommitID, error)
+	GetRunCommits(ctx context.Context, storageNamespace StorageNamespace, runID string) (expired []CommitID, active []CommitID, err error)
+	// GetRunRuleCommits returns the commits run runID expired and kept according to the default rule and the
+	// rule of every prefix
+	GetRunRuleCommits(ctx context.Context, storageNamespace StorageNamespace, runID string) ([]GarbageCollectionRuleCommits, error)
+	// GetRuleCommits returns the commits expired and kept according to the default rule and the rule of every
+	// prefix of rules, without saving them
+	GetRuleCommits(ctx context.Context, repositoryID RepositoryID, rules *GarbageCollectionRules) ([]GarbageCollectionRuleCommits, error)
 	GetCommitsCSVLocation(runID string, sn StorageNamespace) (string, error)
 	GetAddressesLocation(sn StorageNamespace) (string, error)
+	SaveRunAddresses(ctx context.Context, storageNamespace StorageNamespace, runID string, addresses []string) (string, error)
+	GetRunAddresses(ctx context.Context, storageNamespace StorageNamespace, runID string) ([]string, string, error)
+}
+
+// MergeStrategyRulesManager stores the merge strategy rules that apply by default to merges in a repository
+type MergeStrategyRulesManager interface {
+	// GetRules returns the merge strategy rules of the repository, in the order they are applied
+	GetRules(ctx context.Context, repositoryID RepositoryID) (*MergeStrategyRules, error)
+	// SetRules replaces the merge strategy rules of the repository
+	SetRules(ctx context.Context, repositoryID RepositoryID, rules *MergeStrategyRules) error
+}
+
+type ProtectedTagsManager interface {
+	// Add creates a rule for the given tag name pattern, or returns ErrRuleAlreadyExists if there is already a rule for it.
+	Add(ctx context.Context, repositoryID RepositoryID, tagNamePattern string) error
+	// Delete deletes the rule for the given name pattern, or returns ErrRuleNotExists if there is no such rule.
+	Delete(ctx context.Context, repositoryID RepositoryID, tagNamePattern string) error
+	// GetRules returns all tag protection rules for the repository
+	GetRules(ctx context.Context, repositoryID RepositoryID) (*TagProtectionRules, error)
+	// IsBlocked returns whether deleting the tag is blocked by any tag protection rule matching it.
+	IsBlocked(ctx context.Context, repositoryID RepositoryID, tagID TagID) (bool, error)
 }
 
 type ProtectedBranchesManager interface {
 	// Add creates a rule for the given name pattern, blocking the given actions.
+	// A non-empty mergeSourcePattern limits merges into matching branches to source branches matching it.
+	// requireSignedCommits limits matching branches to point only to commits with a verified signature.
 	// Returns ErrRuleAlreadyExists if there is already a rule for the given pattern.
-	Add(ctx context.Context, repositoryID RepositoryID, branchNamePattern string, blockedActions []BranchProtectionBlockedAction) error
+	Add(ctx context.Context, repositoryID RepositoryID, branchNamePattern string, blockedActions []BranchProtectionBlockedAction, mergeSourcePattern string, requireSignedCommits bool) error
 	// Delete deletes the rule for the given name pattern, or returns ErrRuleNotExists if there is no such rule.
 	Delete(ctx context.Context, repositoryID RepositoryID, branchNamePattern string) error
 	// Get returns the list of blocked actions for the given name pattern, or nil if no rule was defined for the pattern.
@@ -2255,4 +4294,9 @@ type ProtectedBranchesManager interface {
 	GetRules(ctx context.Context, repositoryID RepositoryID) (*BranchProtectionRules, error)
 	// IsBlocked returns whether the action is blocked by any branch protection rule matching the given branch.
 	IsBlocked(ctx context.Context, repositoryID RepositoryID, branchID BranchID, action BranchProtectionBlockedAction) (bool, error)
+	// IsMergeBlocked returns whether merging sourceBranchID into branchID is blocked by any branch protection rule
+	// matching the given branch. sourceBranchID is empty when the merge source is not a branch.
+	IsMergeBlocked(ctx context.Context, repositoryID RepositoryID, branchID BranchID, sourceBranchID BranchID) (bool, error)
+	// IsSignatureRequired returns whether any branch protection rule matching the given branch requires signed commits.
+	IsSignatureRequired(ctx context.Context, repositoryID RepositoryID, branchID BranchID) (bool, error)
 }
@@ -5,11 +5,13 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/go-test/deep"
 	"github.com/treeverse/lakefs/pkg/graveler"
 	"github.com/treeverse/lakefs/pkg/graveler/ref"
 	"github.com/treeverse/lakefs/pkg/graveler/testutil"
+	"github.com/treeverse/lakefs/pkg/ident"
 	tu "github.com/treeverse/lakefs/pkg/testutil"
 )
 
@@ -23,6 +25,7 @@ type Hooks struct {
 	SourceRef        graveler.Ref
 	CommitID         graveler.CommitID
 	Commit           graveler.Commit
+	Prefixes         []graveler.Key
 }
 
 func (h *Hooks) PreCommitHook(_ context.Context, record graveler.HookRecord) error {
@@ -30,7 +33,9 @@ func (h *Hooks) PreCommitHook(_ context.Context, record graveler.HookRecord) err
 	h.RepositoryID = record.RepositoryID
 	h.StorageNamespace = record.StorageNamespace
 	h.BranchID = record.BranchID
+	h.SourceRef = record.SourceRef
 	h.Commit = record.Commit
+	h.Prefixes = record.Prefixes
 	return h.Err
 }
 
@@ -77,7 +82,7 @@ func TestGraveler_List(t *testing.T) {
 			name: "one committed one staged no paths",
 			r: graveler.NewGraveler(branchLocker, &testutil.CommittedFake{ValueIterator: testutil.NewValueIteratorFake([]graveler.ValueRecord{{Key: graveler.Key("foo"), Value: &graveler.Value{}}})},
 				&testutil.StagingFake{ValueIterator: testutil.NewValueIteratorFake([]graveler.ValueRecord{{Key: graveler.Key("bar"), Value: &graveler.Value{}}})},
-				&testutil.RefsFake{RefType: graveler.ReferenceTypeBranch, StagingToken: "token", Commits: map[graveler.CommitID]*graveler.Commit{"": {}}}, nil, testutil.NewProtectedBranchesManagerFake(),
+				&testutil.RefsFake{RefType: graveler.ReferenceTypeBranch, StagingToken: "token", Commits: map[graveler.CommitID]*graveler.Commit{"": {}}}, nil, testutil.NewProtectedBranchesManagerFake(), nil, nil,
 			),
 			expected: []*graveler.ValueRecord{{Key: graveler.Key("bar"), Value: &graveler.Value{}}, {Key: graveler.Key("foo"), Value: &graveler.Value{}}},
 		},
@@ -85,7 +90,7 @@ func TestGraveler_List(t *testing.T) {
 			name: "same path different file",
 			r: graveler.NewGraveler(branchLocker, &testutil.CommittedFake{ValueIterator: testutil.NewValueIteratorFake([]graveler.ValueRecord{{Key: graveler.Key("foo"), Value: &graveler.Value{Identity: []byte("original")}}})},
 				&testutil.StagingFake{ValueIterator: testutil.NewValueIteratorFake([]graveler.ValueRecord{{Key: graveler.Key("foo"), Value: &graveler.Value{Identity: []byte("other")}}})},
-				&testutil.RefsFake{RefType: graveler.ReferenceTypeBranch, StagingToken: "token", Commits: map[graveler.CommitID]*graveler.Commit{"": {}}}, nil, testutil.NewProtectedBranchesManagerFake(),
+				&testutil.RefsFake{RefType: graveler.ReferenceTypeBranch, StagingToken: "token", Commits: map[graveler.CommitID]*graveler.Commit{"": {}}}, nil, testutil.NewProtectedBranchesManagerFake(), nil, nil,
 			),
 			expected: []*graveler.ValueRecord{{Key: graveler.Key("foo"), Value: &graveler.Value{Identity: []byte("other")}}},
 		},
@@ -93,7 +98,7 @@ func TestGraveler_List(t *testing.T) {
 			name: "one committed one staged no paths - with prefix",
 			r: graveler.NewGraveler(branchLocker, &testutil.CommittedFake{ValueIterator: testutil.NewValueIteratorFake([]graveler.ValueRecord{{Key: graveler.Key("prefix/foo"), Value: &graveler.Value{}}})},
 				&testutil.StagingFake{ValueIterator: testutil.NewValueIteratorFake([]graveler.ValueRecord{{Key: graveler.Key("prefix/bar"), Value: &graveler.Value{}}})},
-				&testutil.RefsFake{RefType: graveler.ReferenceTypeBranch, StagingToken: "token", Commits: map[graveler.CommitID]*graveler.Commit{"": {}}}, nil, testutil.NewProtectedBranchesManagerFake(),
+				&testutil.RefsFake{RefType: graveler.ReferenceTypeBranch, StagingToken: "token", Commits: map[graveler.CommitID]*graveler.Commit{"": {}}}, nil, testutil.NewProtectedBranchesManagerFake(), nil, nil,
 			),
 			expected: []*graveler.ValueRecord{{Key: graveler.Key("prefix/bar"), Value: &graveler.Value{}}, {Key: graveler.Key("prefix/foo"), Value: &graveler.Value{}}},
 		},
@@ -133,54 +138,54 @@ func TestGraveler_Get(t *testing.T) {
 		{
 			name: "commit - exists",
 			r: graveler.NewGraveler(branchLocker, &testutil.CommittedFake{ValuesByKey: map[string]*graveler.Value{"key": {Identity: []byte("committed")}}}, nil,
-				&testutil.RefsFake{RefType: graveler.ReferenceTypeCommit, Commits: map[graveler.CommitID]*graveler.Commit{"": {}}}, nil, testutil.NewProtectedBranchesManagerFake(),
+				&testutil.RefsFake{RefType: graveler.ReferenceTypeCommit, Commits: map[graveler.CommitID]*graveler.Commit{"": {}}}, nil, testutil.NewProtectedBranchesManagerFake(), nil, nil,
 			),
 			expectedValueResult: graveler.Value{Identity: []byte("committed")},
 		},
 		{
 			name: "commit - not found",
 			r: graveler.NewGraveler(branchLocker, &testutil.CommittedFake{Err: graveler.ErrNotFound}, nil,
-				&testutil.RefsFake{RefType: graveler.ReferenceTypeCommit, Commits: map[graveler.CommitID]*graveler.Commit{"": {}}}, nil, testutil.NewProtectedBranchesManagerFake(),
+				&testutil.RefsFake{RefType: graveler.ReferenceTypeCommit, Commits: map[graveler.CommitID]*graveler.Commit{"": {}}}, nil, testutil.NewProtectedBranchesManagerFake(), nil, nil,
 			), expectedErr: graveler.ErrNotFound,
 		},
 		{
 			name: "commit - error",
 			r: graveler.NewGraveler(branchLocker, &testutil.CommittedFake{Err: errTest}, nil,
-				&testutil.RefsFake{RefType: graveler.ReferenceTypeCommit, Commits: map[graveler.CommitID]*graveler.Commit{"": {}}}, nil, testutil.NewProtectedBranchesManagerFake(),
+				&testutil.RefsFake{RefType: graveler.ReferenceTypeCommit, Commits: map[graveler.CommitID]*graveler.Commit{"": {}}}, nil, testutil.NewProtectedBranchesManagerFake(), nil, nil,
 			), expectedErr: errTest,
 		},
 		{
 			name: "branch - only staged",
 			r: graveler.NewGraveler(branchLocker, &testutil.CommittedFake{Err: graveler.ErrNotFound}, &testutil.StagingFake{Value: &graveler.Value{Identity: []byte("staged")}},
-				&testutil.RefsFake{RefType: graveler.ReferenceTypeBranch, StagingToken: "token1", Commits: map[graveler.CommitID]*graveler.Commit{"": {}}}, nil, testutil.NewProtectedBranchesManagerFake(),
+				&testutil.RefsFake{RefType: graveler.ReferenceTypeBranch, StagingToken: "token1", Commits: map[graveler.CommitID]*graveler.Commit{"": {}}}, nil, testutil.NewProtectedBranchesManagerFake(), nil, nil,
 			),
 			expectedValueResult: graveler.Value{Identity: []byte("staged")},
 		},
 		{
 			name: "branch - committed and staged",
 			r: graveler.NewGraveler(branchLocker, &testutil.CommittedFake{ValuesByKey: map[string]*graveler.Value{"key": {Identity: []byte("committed")}}}, &testutil.StagingFake{Value: &graveler.Value{Identity: []byte("staged")}},
-				&testutil.RefsFake{RefType: graveler.ReferenceTypeBranch, StagingToken: "token1", Commits: map[graveler.CommitID]*graveler.Commit{"": {}}}, nil, testutil.NewProtectedBranchesManagerFake(),
+				&testutil.RefsFake{RefType: graveler.ReferenceTypeBranch, StagingToken: "token1", Commits: map[graveler.CommitID]*graveler.Commit{"": {}}}, nil, testutil.NewProtectedBranchesManagerFake(), nil, nil,
 			),
 			expectedValueResult: graveler.Value{Identity: []byte("staged")},
 		},
 		{
 			name: "branch - only committed",
 			r: graveler.NewGraveler(branchLocker, &testutil.CommittedFake{ValuesByKey: map[string]*graveler.Value{"key": {Identity: []byte("committed")}}}, &testutil.StagingFake{Err: graveler.ErrNotFound},
-				&testutil.RefsFake{RefType: graveler.ReferenceTypeBranch, Commits: map[graveler.CommitID]*graveler.Commit{"": {}}}, nil, testutil.NewProtectedBranchesManagerFake(),
+				&testutil.RefsFake{RefType: graveler.ReferenceTypeBranch, Commits: map[graveler.CommitID]*graveler.Commit{"": {}}}, nil, testutil.NewProtectedBranchesManagerFake(), nil, nil,
 			),
 			expectedValueResult: graveler.Value{Identity: []byte("committed")},
 		},
 		{
 			name: "branch - tombstone",
 			r: graveler.NewGraveler(branchLocker, &testutil.CommittedFake{ValuesByKey: map[string]*graveler.Value{"key": {Identity: []byte("committed")}}}, &testutil.StagingFake{Value: nil},
-				&testutil.RefsFake{RefType: graveler.ReferenceTypeBranch, StagingToken: "token1", Commits: map[graveler.CommitID]*graveler.Commit{"": {}}}, nil, testutil.NewProtectedBranchesManagerFake(),
+				&testutil.RefsFake{RefType: graveler.ReferenceTypeBranch, StagingToken: "token1", Commits: map[graveler.CommitID]*graveler.Commit{"": {}}}, nil, testutil.NewProtectedBranchesManagerFake(), nil, nil,
 			),
 			expectedErr: graveler.ErrNotFound,
 		},
 		{
 			name: "branch - staged return error",
 			r: graveler.NewGraveler(branchLocker, &testutil.CommittedFake{}, &testutil.StagingFake{Err: errTest},
-				&testutil.RefsFake{RefType: graveler.ReferenceTypeBranch, StagingToken: "token1", Commits: map[graveler.CommitID]*graveler.Commit{"": {}}}, nil, testutil.NewProtectedBranchesManagerFake(),
+				&testutil.RefsFake{RefType: graveler.ReferenceTypeBranch, StagingToken: "token1", Commits: map[graveler.CommitID]*graveler.Commit{"": {}}}, nil, testutil.NewProtectedBranchesManagerFake(), nil, nil,
 			),
 			expectedErr: errTest,
 		},
@@ -216,7 +221,7 @@ func TestGraveler_DiffUncommitted(t *testing.T) {
 			name: "no changes",
 			r: graveler.NewGraveler(branchLocker, &testutil.CommittedFake{ValueIterator: testutil.NewValueIteratorFake([]graveler.ValueRecord{{Key: graveler.Key("foo/one"), Value: &graveler.Value{}}})},
 				&testutil.StagingFake{ValueIterator: testutil.NewValueIteratorFake([]graveler.ValueRecord{})},
-				&testutil.RefsFake{Branch: &graveler.Branch{CommitID: "c1"}, Commits: map[graveler.CommitID]*graveler.Commit{"c1": {MetaRangeID: "mri1"}}}, nil, testutil.NewProtectedBranchesManagerFake(),
+				&testutil.RefsFake{Branch: &graveler.Branch{CommitID: "c1"}, Commits: map[graveler.CommitID]*graveler.Commit{"c1": {MetaRangeID: "mri1"}}}, nil, testutil.NewProtectedBranchesManagerFake(), nil, nil,
 			),
 			amount:       10,
 			expectedDiff: testutil.NewDiffIter([]graveler.Diff{}),
@@ -225,7 +230,7 @@ func TestGraveler_DiffUncommitted(t *testing.T) {
 			name: "added one",
 			r: graveler.NewGraveler(branchLocker, &testutil.CommittedFake{ValueIterator: testutil.NewValueIteratorFake([]graveler.ValueRecord{})},
 				&testutil.StagingFake{ValueIterator: testutil.NewValueIteratorFake([]graveler.ValueRecord{{Key: graveler.Key("foo/one"), Value: &graveler.Value{}}})},
-				&testutil.RefsFake{Branch: &graveler.Branch{CommitID: "c1"}, Commits: map[graveler.CommitID]*graveler.Commit{"c1": {MetaRangeID: "mri1"}}}, nil, testutil.NewProtectedBranchesManagerFake(),
+				&testutil.RefsFake{Branch: &graveler.Branch{CommitID: "c1"}, Commits: map[graveler.CommitID]*graveler.Commit{"c1": {MetaRangeID: "mri1"}}}, nil, testutil.NewProtectedBranchesManagerFake(), nil, nil,
 			),
 			amount: 10,
 			expectedDiff: testutil.NewDiffIter([]graveler.Diff{{
@@ -238,7 +243,7 @@ func TestGraveler_DiffUncommitted(t *testing.T) {
 			name: "changed one",
 			r: graveler.NewGraveler(branchLocker, &testutil.CommittedFake{ValueIterator: testutil.NewValueIteratorFake([]graveler.ValueRecord{{Key: graveler.Key("foo/one"), Value: &graveler.Value{Identity: []byte("one")}}}), ValuesByKey: map[string]*graveler.Value{"foo/one": {Identity: []byte("one")}}},
 				&testutil.StagingFake{ValueIterator: testutil.NewValueIteratorFake([]graveler.ValueRecord{{Key: graveler.Key("foo/one"), Value: &graveler.Value{Identity: []byte("one_changed")}}})},
-				&testutil.RefsFake{Branch: &graveler.Branch{CommitID: "c1"}, Commits: map[graveler.CommitID]*graveler.Commit{"c1": {MetaRangeID: "mri1"}}}, nil, testutil.NewProtectedBranchesManagerFake(),
+				&testutil.RefsFake{Branch: &graveler.Branch{CommitID: "c1"}, Commits: map[graveler.CommitID]*graveler.Commit{"c1": {MetaRangeID: "mri1"}}}, nil, testutil.NewProtectedBranchesManagerFake(), nil, nil,
 			),
 			amount: 10,
 			expectedDiff: testutil.NewDiffIter([]graveler.Diff{{
@@ -251,7 +256,7 @@ func TestGraveler_DiffUncommitted(t *testing.T) {
 			name: "removed one",
 			r: graveler.NewGraveler(branchLocker, &testutil.CommittedFake{ValueIterator: testutil.NewValueIteratorFake([]graveler.ValueRecord{{Key: graveler.Key("foo/one"), Value: &graveler.Value{}}})},
 				&testutil.StagingFake{ValueIterator: testutil.NewValueIteratorFake([]graveler.ValueRecord{{Key: graveler.Key("foo/one"), Value: nil}})},
-				&testutil.RefsFake{Branch: &graveler.Branch{CommitID: "c1"}, Commits: map[graveler.CommitID]*graveler.Commit{"c1": {MetaRangeID: "mri1"}}}, nil, testutil.NewProtectedBranchesManagerFake(),
+				&testutil.RefsFake{Branch: &graveler.Branch{CommitID: "c1"}, Commits: map[graveler.CommitID]*graveler.Commit{"c1": {MetaRangeID: "mri1"}}}, nil, testutil.NewProtectedBranchesManagerFake(), nil, nil,
 			),
 			amount: 10,
 			expectedDiff: testutil.NewDiffIter([]graveler.Diff{{
@@ -296,7 +301,7 @@ func TestGraveler_CreateBranch(t *testing.T) {
 		&testutil.RefsFake{
 			Err:      graveler.ErrNotFound,
 			CommitID: "8888888798e3aeface8e62d1c7072a965314b4",
-		}, nil, nil,
+		}, nil, nil, nil, nil,
 	)
 	_, err := gravel.CreateBranch(context.Background(), "", "", "")
 	if err != nil {
@@ -307,7 +312,7 @@ func TestGraveler_CreateBranch(t *testing.T) {
 		nil,
 		&testutil.RefsFake{
 			Branch: &graveler.Branch{},
-		}, nil, nil,
+		}, nil, nil, nil, nil,
 	)
 	_, err = gravel.CreateBranch(context.Background(), "", "", "")
 	if !errors.Is(err, graveler.ErrBranchExists) {
@@ -315,12 +320,55 @@ func TestGraveler_CreateBranch(t *testing.T) {
 	}
 }
 
+func TestGraveler_CreateEphemeralBranch(t *testing.T) {
+	conn, _ := tu.GetDB(t, databaseURI)
+	branchLocker := ref.NewBranchLocker(conn)
+	refs := &testutil.RefsFake{
+		Err:      graveler.ErrNotFound,
+		CommitID: "8888888798e3aeface8e62d1c7072a965314b4",
+	}
+	gravel := graveler.NewGraveler(branchLocker, nil, nil, refs, nil, nil, nil, nil)
+	expiry := graveler.BranchExpiry{IdleTTL: time.Hour}
+	branch, err := gravel.CreateEphemeralBranch(context.Background(), "", "", "", expiry)
+	if err != nil {
+		t.Fatal("unexpected error on create ephemeral branch", err)
+	}
+	if branch.Expiry != expiry || refs.Branch.Expiry != expiry {
+		t.Fatalf("branch expiry %+v, created %+v, expected %+v", branch.Expiry, refs.Branch.Expiry, expiry)
+	}
+	_, err = gravel.CreateEphemeralBranch(context.Background(), "", "", "", graveler.BranchExpiry{IdleTTL: -time.Hour})
+	if !errors.Is(err, graveler.ErrInvalidValue) {
+		t.Fatalf("CreateEphemeralBranch with negative idle TTL err=%v, expected ErrInvalidValue", err)
+	}
+}
+
+func TestGraveler_DeleteExpiredBranches(t *testing.T) {
+	conn, _ := tu.GetDB(t, databaseURI)
+	branchLocker := ref.NewBranchLocker(conn)
+	refs := &testutil.RefsFake{
+		Branch: &graveler.Branch{CommitID: "c1"},
+		ExpiredBranches: []*graveler.BranchRecord{
+			{BranchID: "ephemeral", Branch: &graveler.Branch{CommitID: "c1"}},
+			{BranchID: "protected", Branch: &graveler.Branch{CommitID: "c1"}},
+			{BranchID: "moved", Branch: &graveler.Branch{CommitID: "c0"}},
+		},
+	}
+	gravel := graveler.NewGraveler(branchLocker, nil, &testutil.StagingFake{}, refs, nil, testutil.NewProtectedBranchesManagerFake("protected"), nil, nil)
+	deleted, err := gravel.DeleteExpiredBranches(context.Background(), "", time.Now())
+	if err != nil {
+		t.Fatal("unexpected error on delete expired branches", err)
+	}
+	if diff := deep.Equal(deleted, []graveler.BranchID{"ephemeral"}); diff != nil {
+		t.Fatal("unexpected deleted branches", diff)
+	}
+}
+
 func TestGraveler_UpdateBranch(t *testing.T) {
 	conn, _ := tu.GetDB(t, databaseURI)
 	branchLocker := ref.NewBranchLocker(conn)
 	gravel := graveler.NewGraveler(branchLocker, nil,
 		&testutil.StagingFake{ValueIterator: testutil.NewValueIteratorFake([]graveler.ValueRecord{{Key: graveler.Key("foo/one"), Value: &graveler.Value{}}})},
-		&testutil.RefsFake{Branch: &graveler.Branch{}}, nil, nil,
+		&testutil.RefsFake{Branch: &graveler.Branch{}}, nil, testutil.NewProtectedBranchesManagerFake(), nil, nil,
 	)
 	_, err := gravel.UpdateBranch(context.Background(), "", "", "")
 	if !errors.Is(err, graveler.ErrConflictFound) {
@@ -328,12 +376,158 @@ func TestGraveler_UpdateBranch(t *testing.T) {
 	}
 	gravel = graveler.NewGraveler(branchLocker, nil,
 		&testutil.StagingFake{ValueIterator: testutil.NewValueIteratorFake([]graveler.ValueRecord{})},
-		&testutil.RefsFake{Branch: &graveler.Branch{}}, nil, nil,
+		&testutil.RefsFake{Branch: &graveler.Branch{}}, nil, testutil.NewProtectedBranchesManagerFake(), nil, nil,
 	)
 	_, err = gravel.UpdateBranch(context.Background(), "", "", "")
 	if err != nil {
 		t.Fatal("did not expect to get error")
 	}
+	gravel = graveler.NewGraveler(branchLocker, nil,
+		&testutil.StagingFake{ValueIterator: testutil.NewValueIteratorFake([]graveler.ValueRecord{})},
+		&testutil.RefsFake{Branch: &graveler.Branch{}}, nil, testutil.NewProtectedBranchesManagerFake("branch"), nil, nil,
+	)
+	_, err = gravel.UpdateBranch(context.Background(), "", "branch", "")
+	if !errors.Is(err, graveler.ErrUpdateProtectedBranch) {
+		t.Fatalf("UpdateBranch err=%v, expected ErrUpdateProtectedBranch", err)
+	}
+}
+
+// signatureVerifierFake accepts signatures that are the signed data itself, made by the committer's key "key"
+type signatureVerifierFake struct{}
+
+func (signatureVerifierFake) VerifySignature(_ context.Context, username, keyID string, data, signature []byte) error {
+	if username != "committer" || keyID != "key" || !bytes.Equal(data, signature) {
+		return errors.New("bad signature")
+	}
+	return nil
+}
+
+func TestGraveler_SignCommit(t *testing.T) {
+	conn, _ := tu.GetDB(t, databaseURI)
+	branchLocker := ref.NewBranchLocker(conn)
+	ctx := context.Background()
+	commit := &graveler.Commit{Committer: "committer", Message: "message", MetaRangeID: "mri1"}
+	refManager := &testutil.RefsFake{
+		Branch:   &graveler.Branch{CommitID: "c0"},
+		CommitID: "c1",
+		Commits:  map[graveler.CommitID]*graveler.Commit{"c1": commit},
+	}
+	protectedBranchesManager := testutil.NewProtectedBranchesManagerFake()
+	protectedBranchesManager.SignedBranches = []string{"signed"}
+	g := graveler.NewGraveler(branchLocker, nil,
+		&testutil.StagingFake{ValueIterator: testutil.NewValueIteratorFake([]graveler.ValueRecord{})},
+		refManager, nil, protectedBranchesManager, nil, nil)
+	g.SetSignatureVerifier(signatureVerifierFake{})
+
+	// unsigned commits cannot be pointed to by a branch requiring signed commits
+	_, err := g.UpdateBranch(ctx, "", "signed", "c1")
+	if !errors.Is(err, graveler.ErrUnsignedToProtectedBranch) {
+		t.Fatalf("UpdateBranch with unsigned commit err=%v, expected ErrUnsignedToProtectedBranch", err)
+	}
+
+	err = g.SignCommit(ctx, "", "c1", "key", []byte("bad signature"))
+	if !errors.Is(err, graveler.ErrInvalidCommitSignature) {
+		t.Fatalf("SignCommit with bad signature err=%v, expected ErrInvalidCommitSignature", err)
+	}
+	err = g.SignCommit(ctx, "", "c2", "key", commit.Identity())
+	if !errors.Is(err, graveler.ErrCommitNotFound) {
+		t.Fatalf("SignCommit of missing commit err=%v, expected ErrCommitNotFound", err)
+	}
+	err = g.SignCommit(ctx, "", "c1", "key", commit.Identity())
+	if err != nil {
+		t.Fatalf("SignCommit unexpected error: %v", err)
+	}
+	if commit.SignatureKeyID != "key" || !bytes.Equal(commit.Signature, commit.Identity()) {
+		t.Fatalf("SignCommit stored key %s signature %x", commit.SignatureKeyID, commit.Signature)
+	}
+	if err := g.VerifyCommitSignature(ctx, "c1", commit); err != nil {
+		t.Fatalf("VerifyCommitSignature unexpected error: %v", err)
+	}
+
+	_, err = g.UpdateBranch(ctx, "", "signed", "c1")
+	if err != nil {
+		t.Fatalf("UpdateBranch with signed commit unexpected error: %v", err)
+	}
+
+	// new commits created by the server are not signed
+	_, err = g.Commit(ctx, "", "signed", graveler.CommitParams{Committer: "committer", Message: "message"})
+	if !errors.Is(err, graveler.ErrUnsignedToProtectedBranch) {
+		t.Fatalf("Commit err=%v, expected ErrUnsignedToProtectedBranch", err)
+	}
+	_, err = g.AddCommitToBranchHead(ctx, "", "signed", graveler.Commit{Committer: "committer", Message: "import", MetaRangeID: "mri2", Parents: graveler.CommitParents{"c1"}})
+	if !errors.Is(err, graveler.ErrUnsignedToProtectedBranch) {
+		t.Fatalf("AddCommitToBranchHead err=%v, expected ErrUnsignedToProtectedBranch", err)
+	}
+}
+
+func TestGraveler_ProtectedBranchActions(t *testing.T) {
+	conn, _ := tu.GetDB(t, databaseURI)
+	branchLocker := ref.NewBranchLocker(conn)
+	const (
+		repositoryID = graveler.RepositoryID("repo")
+		branchID     = graveler.BranchID("branch")
+		commitID     = graveler.CommitID("c1")
+	)
+	ctx := context.Background()
+	refManager := &testutil.RefsFake{
+		RefType:  graveler.ReferenceTypeBranch,
+		CommitID: commitID,
+		Branch:   &graveler.Branch{CommitID: commitID},
+		Commits:  map[graveler.CommitID]*graveler.Commit{commitID: {Parents: graveler.CommitParents{"c0"}}},
+	}
+	g := graveler.NewGraveler(branchLocker, &testutil.CommittedFake{}, &testutil.StagingFake{}, refManager, nil,
+		testutil.NewProtectedBranchesManagerFake(string(branchID)), testutil.NewMergeStrategyRulesManagerFake(), nil)
+
+	tests := []struct {
+		name        string
+		action      func() error
+		expectedErr error
+	}{
+		{
+			name:        "delete",
+			action:      func() error { return g.DeleteBranch(ctx, repositoryID, branchID) },
+			expectedErr: graveler.ErrDeleteProtectedBranch,
+		},
+		{
+			name: "revert",
+			action: func() error {
+				_, err := g.Revert(ctx, repositoryID, branchID, graveler.Ref(commitID), 0, graveler.CommitParams{})
+				return err
+			},
+			expectedErr: graveler.ErrRevertProtectedBranch,
+		},
+		{
+			name: "merge",
+			action: func() error {
+				_, err := g.Merge(ctx, repositoryID, branchID, "source", graveler.CommitParams{}, "", nil, graveler.MergeModeDefault)
+				return err
+			},
+			expectedErr: graveler.ErrMergeToProtectedBranch,
+		},
+		{
+			name: "merge ff-only",
+			action: func() error {
+				_, err := g.Merge(ctx, repositoryID, branchID, "source", graveler.CommitParams{}, "", nil, graveler.MergeModeFastForwardOnly)
+				return err
+			},
+			expectedErr: graveler.ErrMergeToProtectedBranch,
+		},
+		{
+			name: "merge squash",
+			action: func() error {
+				_, err := g.Merge(ctx, repositoryID, branchID, "source", graveler.CommitParams{}, "", nil, graveler.MergeModeSquash)
+				return err
+			},
+			expectedErr: graveler.ErrMergeToProtectedBranch,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.action(); !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("err=%v, expected %v", err, tt.expectedErr)
+			}
+		})
+	}
 }
 
 func TestGraveler_Commit(t *testing.T) {
@@ -493,7 +687,7 @@ func TestGraveler_Commit(t *testing.T) {
 			if tt.fields.ProtectedBranchesManager == nil {
 				tt.fields.ProtectedBranchesManager = testutil.NewProtectedBranchesManagerFake()
 			}
-			g := graveler.NewGraveler(branchLocker, tt.fields.CommittedManager, tt.fields.StagingManager, tt.fields.RefManager, nil, tt.fields.ProtectedBranchesManager)
+			g := graveler.NewGraveler(branchLocker, tt.fields.CommittedManager, tt.fields.StagingManager, tt.fields.RefManager, nil, tt.fields.ProtectedBranchesManager, nil, nil)
 
 			got, err := g.Commit(context.Background(), tt.args.repositoryID, tt.args.branchID, graveler.CommitParams{
 				Committer: tt.args.committer,
@@ -578,7 +772,7 @@ func TestGraveler_PreCommitHook(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			// setup
 			ctx := context.Background()
-			g := graveler.NewGraveler(branchLocker, committedManager, stagingManager, refManager, nil, testutil.NewProtectedBranchesManagerFake())
+			g := graveler.NewGraveler(branchLocker, committedManager, stagingManager, refManager, nil, testutil.NewProtectedBranchesManagerFake(), nil, nil)
 			h := &Hooks{Err: tt.err}
 			if tt.hook {
 				g.SetHooksHandler(h)
@@ -675,7 +869,7 @@ func TestGraveler_PreMergeHook(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			// setup
 			ctx := context.Background()
-			g := graveler.NewGraveler(branchLocker, committedManager, stagingManager, refManager, nil, testutil.NewProtectedBranchesManagerFake())
+			g := graveler.NewGraveler(branchLocker, committedManager, stagingManager, refManager, nil, testutil.NewProtectedBranchesManagerFake(), testutil.NewMergeStrategyRulesManagerFake(), nil)
 			h := &Hooks{Err: tt.err}
 			if tt.hook {
 				g.SetHooksHandler(h)
@@ -685,7 +879,7 @@ func TestGraveler_PreMergeHook(t *testing.T) {
 				Committer: commitCommitter,
 				Message:   mergeMessage,
 				Metadata:  mergeMetadata,
-			}, "")
+			}, "", nil, graveler.MergeModeDefault)
 			// verify we got an error
 			if !errors.Is(err, tt.err) {
 				t.Fatalf("Merge err=%v, pre-merge error expected=%v", err, tt.err)
@@ -730,6 +924,919 @@ func TestGraveler_PreMergeHook(t *testing.T) {
 	}
 }
 
+func TestGraveler_Rebase(t *testing.T) {
+	conn, _ := tu.GetDB(t, databaseURI)
+	branchLocker := ref.NewBranchLocker(conn)
+	const expectedCommitID = graveler.CommitID("expectedCommitID")
+	const branchID = graveler.BranchID("branchID")
+	addressProvider := ident.NewHexAddressProvider()
+	baseCommit := &graveler.Commit{MetaRangeID: "baseRangeID", Message: "base", Generation: 1}
+	baseCommitID := graveler.CommitID(addressProvider.ContentAddress(baseCommit))
+	ontoCommit := &graveler.Commit{MetaRangeID: "ontoRangeID", Message: "onto", Parents: graveler.CommitParents{baseCommitID}, Generation: 2}
+	ontoCommitID := graveler.CommitID(addressProvider.ContentAddress(ontoCommit))
+	const (
+		firstCommitID  = graveler.CommitID("firstCommitID")
+		secondCommitID = graveler.CommitID("secondCommitID")
+		mergeCommitID  = graveler.CommitID("mergeCommitID")
+		aheadCommitID  = graveler.CommitID("aheadCommitID")
+		emptyCommitID  = graveler.CommitID("emptyCommitID")
+	)
+	commits := map[graveler.CommitID]*graveler.Commit{
+		baseCommitID:   baseCommit,
+		ontoCommitID:   ontoCommit,
+		firstCommitID:  {MetaRangeID: "firstRangeID", Message: "first", Parents: graveler.CommitParents{baseCommitID}, Generation: 2},
+		secondCommitID: {MetaRangeID: "secondRangeID", Message: "second", Metadata: graveler.Metadata{"key": "value"}, Parents: graveler.CommitParents{firstCommitID}, Generation: 3},
+		mergeCommitID:  {MetaRangeID: "mergeRangeID", Message: "merge", Parents: graveler.CommitParents{firstCommitID, baseCommitID}, Generation: 3},
+		aheadCommitID:  {MetaRangeID: "aheadRangeID", Message: "ahead", Parents: graveler.CommitParents{ontoCommitID}, Generation: 3},
+		emptyCommitID:  {MetaRangeID: "firstRangeID", Message: "empty", Parents: graveler.CommitParents{firstCommitID}, Generation: 3},
+	}
+
+	tests := []struct {
+		name             string
+		head             graveler.CommitID
+		mergeBase        *graveler.Commit
+		staged           []graveler.ValueRecord
+		committedErr     error
+		expectedErr      error
+		expectedCommit   graveler.CommitID
+		expectedMessage  string
+		expectedParents  graveler.CommitParents
+		expectedMetadata graveler.Metadata
+	}{
+		{
+			name:             "replay in order",
+			head:             secondCommitID,
+			mergeBase:        baseCommit,
+			expectedCommit:   expectedCommitID,
+			expectedMessage:  "second",
+			expectedParents:  graveler.CommitParents{expectedCommitID},
+			expectedMetadata: graveler.Metadata{"key": "value"},
+		},
+		{
+			name:            "skip merge commits",
+			head:            mergeCommitID,
+			mergeBase:       baseCommit,
+			expectedCommit:  expectedCommitID,
+			expectedMessage: "first",
+			expectedParents: graveler.CommitParents{ontoCommitID},
+		},
+		{
+			name:            "skip empty commits",
+			head:            emptyCommitID,
+			mergeBase:       baseCommit,
+			expectedCommit:  expectedCommitID,
+			expectedMessage: "first",
+			expectedParents: graveler.CommitParents{ontoCommitID},
+		},
+		{
+			name:           "up to date",
+			head:           aheadCommitID,
+			mergeBase:      ontoCommit,
+			expectedCommit: aheadCommitID,
+		},
+		{
+			name:         "conflict",
+			head:         secondCommitID,
+			mergeBase:    baseCommit,
+			committedErr: graveler.ErrConflictFound,
+			expectedErr:  graveler.ErrConflictFound,
+		},
+		{
+			name:        "dirty branch",
+			head:        secondCommitID,
+			mergeBase:   baseCommit,
+			staged:      []graveler.ValueRecord{{Key: graveler.Key("foo"), Value: &graveler.Value{Identity: []byte("foo")}}},
+			expectedErr: graveler.ErrDirtyBranch,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			committedManager := &testutil.CommittedFake{
+				Err: tt.committedErr,
+				MergeSources: map[graveler.MetaRangeID]graveler.MetaRangeID{
+					"firstRangeID":  "rebasedFirstRangeID",
+					"secondRangeID": "rebasedSecondRangeID",
+				},
+			}
+			stagingManager := &testutil.StagingFake{ValueIterator: testutil.NewValueIteratorFake(tt.staged)}
+			refManager := &testutil.RefsFake{
+				CommitID: expectedCommitID,
+				Branch:   &graveler.Branch{CommitID: tt.head},
+				Refs: map[graveler.Ref]*graveler.ResolvedRef{
+					ontoCommitID.Ref(): {Type: graveler.ReferenceTypeCommit, CommitID: ontoCommitID},
+				},
+				Commits:   commits,
+				MergeBase: tt.mergeBase,
+			}
+			g := graveler.NewGraveler(branchLocker, committedManager, stagingManager, refManager, nil, testutil.NewProtectedBranchesManagerFake(), nil, nil)
+			commitID, err := g.Rebase(ctx, "repoID", branchID, ontoCommitID.Ref())
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("Rebase err=%v, expected=%v", err, tt.expectedErr)
+			}
+			if tt.committedErr != nil {
+				var rebaseConflictErr *graveler.RebaseConflictError
+				if !errors.As(err, &rebaseConflictErr) || rebaseConflictErr.CommitID != firstCommitID {
+					t.Fatalf("Rebase err=%v, expected conflict on commit %s", err, firstCommitID)
+				}
+			}
+			if err != nil {
+				return
+			}
+			if commitID != tt.expectedCommit {
+				t.Errorf("Rebase commit ID=%s, expected=%s", commitID, tt.expectedCommit)
+			}
+			added := refManager.AddedCommit
+			if added.Message != tt.expectedMessage {
+				t.Errorf("Rebase last added commit message='%s', expected='%s'", added.Message, tt.expectedMessage)
+			}
+			if diff := deep.Equal(added.Parents, tt.expectedParents); diff != nil {
+				t.Error("Rebase last added commit unexpected parents:", diff)
+			}
+			if diff := deep.Equal(added.Metadata, tt.expectedMetadata); diff != nil {
+				t.Error("Rebase last added commit unexpected metadata:", diff)
+			}
+		})
+	}
+}
+
+func TestGraveler_RestoreBranch(t *testing.T) {
+	conn, _ := tu.GetDB(t, databaseURI)
+	branchLocker := ref.NewBranchLocker(conn)
+	const branchID = graveler.BranchID("branchID")
+	reflog := []*graveler.BranchReflogEntry{
+		{Index: 0, OldCommitID: "c2", NewCommitID: "c3", Operation: graveler.BranchReflogOperationCommit},
+		{Index: 1, OldCommitID: "c1", NewCommitID: "c2", Operation: graveler.BranchReflogOperationMerge},
+		{Index: 2, OldCommitID: "", NewCommitID: "c1", Operation: graveler.BranchReflogOperationCreate},
+	}
+	deletedReflog := []*graveler.BranchReflogEntry{
+		{Index: 0, OldCommitID: "c2", NewCommitID: "", Operation: graveler.BranchReflogOperationDelete},
+		{Index: 1, OldCommitID: "c1", NewCommitID: "c2", Operation: graveler.BranchReflogOperationCommit},
+	}
+
+	tests := []struct {
+		name           string
+		branch         *graveler.Branch
+		branchErr      error
+		reflog         []*graveler.BranchReflogEntry
+		index          int
+		staged         []graveler.ValueRecord
+		expectedErr    error
+		expectedCommit graveler.CommitID
+	}{
+		{
+			name:           "move back",
+			branch:         &graveler.Branch{CommitID: "c3", StagingToken: "token"},
+			reflog:         reflog,
+			index:          1,
+			expectedCommit: "c2",
+		},
+		{
+			name:           "recreate deleted",
+			branchErr:      graveler.ErrBranchNotFound,
+			reflog:         deletedReflog,
+			index:          1,
+			expectedCommit: "c2",
+		},
+		{
+			name:        "deleting entry",
+			branchErr:   graveler.ErrBranchNotFound,
+			reflog:      deletedReflog,
+			index:       0,
+			expectedErr: graveler.ErrRestoreDeletedBranch,
+		},
+		{
+			name:        "missing entry",
+			branch:      &graveler.Branch{CommitID: "c3", StagingToken: "token"},
+			reflog:      reflog,
+			index:       3,
+			expectedErr: graveler.ErrBranchReflogEntryNotFound,
+		},
+		{
+			name:        "dirty branch",
+			branch:      &graveler.Branch{CommitID: "c3", StagingToken: "token"},
+			reflog:      reflog,
+			index:       1,
+			staged:      []graveler.ValueRecord{{Key: graveler.Key("foo"), Value: &graveler.Value{Identity: []byte("foo")}}},
+			expectedErr: graveler.ErrDirtyBranch,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			stagingManager := &testutil.StagingFake{ValueIterator: testutil.NewValueIteratorFake(tt.staged)}
+			refManager := &testutil.RefsFake{
+				Branch:       tt.branch,
+				Err:          tt.branchErr,
+				BranchReflog: tt.reflog,
+			}
+			g := graveler.NewGraveler(branchLocker, &testutil.CommittedFake{}, stagingManager, refManager, nil, testutil.NewProtectedBranchesManagerFake(), nil, nil)
+			branch, err := g.RestoreBranch(ctx, "repoID", branchID, tt.index)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("RestoreBranch err=%v, expected=%v", err, tt.expectedErr)
+			}
+			if err != nil {
+				return
+			}
+			if branch.CommitID != tt.expectedCommit {
+				t.Errorf("RestoreBranch commit ID=%s, expected=%s", branch.CommitID, tt.expectedCommit)
+			}
+			if branch.StagingToken == "" {
+				t.Error("RestoreBranch returned a branch without a staging token")
+			}
+			if refManager.BranchOperation != graveler.BranchReflogOperationRestore {
+				t.Errorf("RestoreBranch branch reflog operation=%s, expected=%s", refManager.BranchOperation, graveler.BranchReflogOperationRestore)
+			}
+		})
+	}
+}
+
+func TestGraveler_CommitPrefixes(t *testing.T) {
+	conn, _ := tu.GetDB(t, databaseURI)
+	branchLocker := ref.NewBranchLocker(conn)
+	ctx := context.Background()
+	value := &graveler.Value{Identity: []byte("v")}
+	committedManager := &testutil.CommittedFake{MetaRangeID: "mr1"}
+	stagingManager := testutil.NewStagingTokensFake()
+	stagingManager.Values["token1"] = map[string]*graveler.Value{"a/1": value, "a/2": nil, "b/1": value, "c/1": value}
+	refManager := &testutil.RefsFake{
+		CommitID: "c2",
+		Branch:   &graveler.Branch{CommitID: "c1", StagingToken: "token1"},
+		Commits:  map[graveler.CommitID]*graveler.Commit{"c1": {MetaRangeID: "mr0"}},
+	}
+	g := graveler.NewGraveler(branchLocker, committedManager, stagingManager, refManager, nil, testutil.NewProtectedBranchesManagerFake(), nil, nil)
+	h := &Hooks{}
+	g.SetHooksHandler(h)
+
+	prefixes := []graveler.Key{graveler.Key("c/1"), graveler.Key("a/")}
+	commitID, err := g.Commit(ctx, "repo", "branch", graveler.CommitParams{Committer: "committer", Message: "partial", Prefixes: prefixes})
+	if err != nil {
+		t.Fatal("unexpected error on partial commit", err)
+	}
+	if commitID != "c2" {
+		t.Errorf("commit ID=%s, expected=c2", commitID)
+	}
+	if diff := deep.Equal(h.Prefixes, prefixes); diff != nil {
+		t.Error("pre-commit hook unexpected prefixes:", diff)
+	}
+	var committed []string
+	for committedManager.AppliedData.Values.Next() {
+		committed = append(committed, committedManager.AppliedData.Values.Value().Key.String())
+	}
+	if diff := deep.Equal(committed, []string{"a/1", "a/2", "c/1"}); diff != nil {
+		t.Error("partial commit unexpected committed keys:", diff)
+	}
+	if diff := deep.Equal(refManager.UpdatedBranch, &graveler.Branch{CommitID: "c2", StagingToken: "token1"}); diff != nil {
+		t.Error("partial commit unexpected branch update:", diff)
+	}
+	if diff := deep.Equal(stagingManager.Values["token1"], map[string]*graveler.Value{"b/1": value}); diff != nil {
+		t.Error("partial commit unexpected staged values:", diff)
+	}
+}
+
+func TestGraveler_Stash(t *testing.T) {
+	conn, _ := tu.GetDB(t, databaseURI)
+	branchLocker := ref.NewBranchLocker(conn)
+	const branchID = graveler.BranchID("branchID")
+	value1 := &graveler.Value{Identity: []byte("v1")}
+	value2 := &graveler.Value{Identity: []byte("v2")}
+	value3 := &graveler.Value{Identity: []byte("v3")}
+
+	// setup returns a graveler whose branch has staged 'a' and deleted 'b', stashed as "stash1" unless staged is
+	// empty, and then staged the given values
+	setup := func(t *testing.T, staged map[string]*graveler.Value) (graveler.VersionController, *testutil.RefsFake, *testutil.StagingTokensFake, *testutil.CommittedFake) {
+		t.Helper()
+		ctx := context.Background()
+		stagingManager := testutil.NewStagingTokensFake()
+		refManager := &testutil.RefsFake{Branch: &graveler.Branch{CommitID: "c1", StagingToken: "token1"}}
+		committedManager := &testutil.CommittedFake{}
+		g := graveler.NewGraveler(branchLocker, committedManager, stagingManager, refManager, nil, testutil.NewProtectedBranchesManagerFake(), nil, nil)
+		stagingManager.Values["token1"] = map[string]*graveler.Value{"a": value1, "b": nil}
+		if _, err := g.StashPush(ctx, "repoID", branchID, "stash1"); err != nil {
+			t.Fatal("unexpected error on stash push", err)
+		}
+		if len(staged) > 0 {
+			stagingManager.Values[refManager.Branch.StagingToken] = staged
+		}
+		return g, refManager, stagingManager, committedManager
+	}
+
+	t.Run("push", func(t *testing.T) {
+		_, refManager, stagingManager, _ := setup(t, nil)
+		stash := refManager.Stashes["stash1"]
+		if stash == nil || stash.StagingToken != "token1" || stash.CommitID != "c1" {
+			t.Fatalf("stash %+v, expected token1 on c1", stash)
+		}
+		if refManager.Branch.StagingToken == "token1" {
+			t.Error("branch kept the stashed staging token")
+		}
+		if len(stagingManager.Values[refManager.Branch.StagingToken]) != 0 {
+			t.Error("branch has staged values after stash push")
+		}
+	})
+
+	t.Run("push nothing", func(t *testing.T) {
+		g, _, _, _ := setup(t, nil)
+		_, err := g.StashPush(context.Background(), "repoID", branchID, "stash2")
+		if !errors.Is(err, graveler.ErrNoChanges) {
+			t.Fatalf("StashPush err=%v, expected=%v", err, graveler.ErrNoChanges)
+		}
+	})
+
+	t.Run("push existing", func(t *testing.T) {
+		g, _, _, _ := setup(t, map[string]*graveler.Value{"c": value3})
+		_, err := g.StashPush(context.Background(), "repoID", branchID, "stash1")
+		if !errors.Is(err, graveler.ErrStashExists) {
+			t.Fatalf("StashPush err=%v, expected=%v", err, graveler.ErrStashExists)
+		}
+	})
+
+	t.Run("apply", func(t *testing.T) {
+		g, refManager, stagingManager, _ := setup(t, map[string]*graveler.Value{"a": value1, "c": value3})
+		if err := g.StashApply(context.Background(), "repoID", branchID, "stash1"); err != nil {
+			t.Fatal("unexpected error on stash apply", err)
+		}
+		expected := map[string]*graveler.Value{"a": value1, "b": nil, "c": value3}
+		if diff := deep.Equal(stagingManager.Values[refManager.Branch.StagingToken], expected); diff != nil {
+			t.Error("StashApply unexpected staged values:", diff)
+		}
+		if _, ok := refManager.Stashes["stash1"]; !ok {
+			t.Error("StashApply dropped the stash")
+		}
+	})
+
+	t.Run("apply conflict", func(t *testing.T) {
+		g, refManager, stagingManager, _ := setup(t, map[string]*graveler.Value{"a": value2})
+		err := g.StashApply(context.Background(), "repoID", branchID, "stash1")
+		var conflictsErr *graveler.MergeConflictsError
+		if !errors.As(err, &conflictsErr) {
+			t.Fatalf("StashApply err=%v, expected conflicts", err)
+		}
+		expectedConflicts := []graveler.MergeConflict{{Key: graveler.Key("a"), Source: value1, Destination: value2}}
+		if diff := deep.Equal(conflictsErr.Conflicts, expectedConflicts); diff != nil {
+			t.Error("StashApply unexpected conflicts:", diff)
+		}
+		if _, ok := stagingManager.Values[refManager.Branch.StagingToken]["b"]; ok {
+			t.Error("StashApply with conflicts staged 'b'")
+		}
+	})
+
+	t.Run("apply committed conflict", func(t *testing.T) {
+		g, refManager, stagingManager, committedManager := setup(t, nil)
+		// 'a' was committed with another value and 'b' was removed since the stash was pushed
+		refManager.Branch.CommitID = "c2"
+		refManager.Commits = map[graveler.CommitID]*graveler.Commit{"c1": {MetaRangeID: "mr1"}, "c2": {MetaRangeID: "mr2"}}
+		committedManager.DiffIterator = testutil.NewDiffIter([]graveler.Diff{
+			{Key: graveler.Key("a"), Type: graveler.DiffTypeChanged, Value: value2},
+			{Key: graveler.Key("b"), Type: graveler.DiffTypeRemoved},
+		})
+		err := g.StashApply(context.Background(), "repoID", branchID, "stash1")
+		var conflictsErr *graveler.MergeConflictsError
+		if !errors.As(err, &conflictsErr) {
+			t.Fatalf("StashApply err=%v, expected conflicts", err)
+		}
+		expectedConflicts := []graveler.MergeConflict{{Key: graveler.Key("a"), Source: value1, Destination: value2}}
+		if diff := deep.Equal(conflictsErr.Conflicts, expectedConflicts); diff != nil {
+			t.Error("StashApply unexpected conflicts:", diff)
+		}
+		if len(stagingManager.Values[refManager.Branch.StagingToken]) != 0 {
+			t.Errorf("StashApply with conflicts staged %v", stagingManager.Values[refManager.Branch.StagingToken])
+		}
+	})
+
+	t.Run("apply committed same", func(t *testing.T) {
+		g, refManager, stagingManager, committedManager := setup(t, nil)
+		refManager.Branch.CommitID = "c2"
+		refManager.Commits = map[graveler.CommitID]*graveler.Commit{"c1": {MetaRangeID: "mr1"}, "c2": {MetaRangeID: "mr2"}}
+		committedManager.DiffIterator = testutil.NewDiffIter([]graveler.Diff{
+			{Key: graveler.Key("a"), Type: graveler.DiffTypeChanged, Value: value1},
+			{Key: graveler.Key("c"), Type: graveler.DiffTypeAdded, Value: value3},
+		})
+		if err := g.StashApply(context.Background(), "repoID", branchID, "stash1"); err != nil {
+			t.Fatal("unexpected error on stash apply", err)
+		}
+		expected := map[string]*graveler.Value{"a": value1, "b": nil}
+		if diff := deep.Equal(stagingManager.Values[refManager.Branch.StagingToken], expected); diff != nil {
+			t.Error("StashApply unexpected staged values:", diff)
+		}
+	})
+
+	t.Run("pop", func(t *testing.T) {
+		g, refManager, stagingManager, _ := setup(t, nil)
+		if err := g.StashPop(context.Background(), "repoID", branchID, "stash1"); err != nil {
+			t.Fatal("unexpected error on stash pop", err)
+		}
+		if len(stagingManager.Values[refManager.Branch.StagingToken]) != 2 {
+			t.Errorf("StashPop staged %v, expected 'a' and 'b'", stagingManager.Values[refManager.Branch.StagingToken])
+		}
+		if _, ok := refManager.Stashes["stash1"]; ok {
+			t.Error("StashPop kept the stash")
+		}
+		if _, ok := stagingManager.Values["token1"]; ok {
+			t.Error("StashPop kept the stash staged values")
+		}
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		g, refManager, stagingManager, _ := setup(t, nil)
+		if err := g.StashDrop(context.Background(), "repoID", branchID, "stash1"); err != nil {
+			t.Fatal("unexpected error on stash drop", err)
+		}
+		if _, ok := refManager.Stashes["stash1"]; ok {
+			t.Error("StashDrop kept the stash")
+		}
+		if _, ok := stagingManager.Values["token1"]; ok {
+			t.Error("StashDrop kept the stash staged values")
+		}
+		err := g.StashApply(context.Background(), "repoID", branchID, "stash1")
+		if !errors.Is(err, graveler.ErrStashNotFound) {
+			t.Fatalf("StashApply of dropped stash err=%v, expected=%v", err, graveler.ErrStashNotFound)
+		}
+	})
+
+	t.Run("delete branch", func(t *testing.T) {
+		g, refManager, stagingManager, _ := setup(t, nil)
+		if err := g.DeleteBranch(context.Background(), "repoID", branchID); err != nil {
+			t.Fatal("unexpected error on delete branch", err)
+		}
+		if len(refManager.Stashes) != 0 {
+			t.Errorf("DeleteBranch kept stashes %v", refManager.Stashes)
+		}
+		if _, ok := stagingManager.Values["token1"]; ok {
+			t.Error("DeleteBranch kept the stash staged values")
+		}
+	})
+}
+
+func TestGraveler_MergeModes(t *testing.T) {
+	conn, _ := tu.GetDB(t, databaseURI)
+	branchLocker := ref.NewBranchLocker(conn)
+	const expectedRangeID = graveler.MetaRangeID("expectedRangeID")
+	const mergeCommitID = graveler.CommitID("mergeCommitID")
+	const mergeDestination = graveler.BranchID("destinationID")
+	sourceCommit := &graveler.Commit{MetaRangeID: "sourceRangeID", Generation: 2}
+	sourceCommitID := graveler.CommitID(ident.NewHexAddressProvider().ContentAddress(sourceCommit))
+	destinationCommit := &graveler.Commit{MetaRangeID: "destinationRangeID", Generation: 1}
+	destinationCommitID := graveler.CommitID(ident.NewHexAddressProvider().ContentAddress(destinationCommit))
+	otherBaseCommit := &graveler.Commit{MetaRangeID: "baseRangeID"}
+	// a merge base with the same content as source, source only added empty commits since
+	sourceRangeBaseCommit := &graveler.Commit{MetaRangeID: "sourceRangeID"}
+
+	tests := []struct {
+		name            string
+		mode            graveler.MergeMode
+		base            *graveler.Commit
+		expectedErr     error
+		expectedCommit  graveler.CommitID
+		expectedParents graveler.CommitParents
+	}{
+		{
+			name:            "default",
+			mode:            graveler.MergeModeDefault,
+			base:            destinationCommit,
+			expectedCommit:  mergeCommitID,
+			expectedParents: graveler.CommitParents{destinationCommitID, sourceCommitID},
+		},
+		{
+			name:           "ff",
+			mode:           graveler.MergeModeFastForward,
+			base:           destinationCommit,
+			expectedCommit: sourceCommitID,
+		},
+		{
+			name:            "ff diverged",
+			mode:            graveler.MergeModeFastForward,
+			base:            otherBaseCommit,
+			expectedCommit:  mergeCommitID,
+			expectedParents: graveler.CommitParents{destinationCommitID, sourceCommitID},
+		},
+		{
+			name:           "ff-only",
+			mode:           graveler.MergeModeFastForwardOnly,
+			base:           destinationCommit,
+			expectedCommit: sourceCommitID,
+		},
+		{
+			name:        "ff-only diverged",
+			mode:        graveler.MergeModeFastForwardOnly,
+			base:        otherBaseCommit,
+			expectedErr: graveler.ErrNotFastForward,
+		},
+		{
+			name:        "default up to date",
+			mode:        graveler.MergeModeDefault,
+			base:        sourceCommit,
+			expectedErr: graveler.ErrNoChanges,
+		},
+		{
+			name:        "default no source changes",
+			mode:        graveler.MergeModeDefault,
+			base:        sourceRangeBaseCommit,
+			expectedErr: graveler.ErrNoChanges,
+		},
+		{
+			name:        "ff up to date",
+			mode:        graveler.MergeModeFastForward,
+			base:        sourceCommit,
+			expectedErr: graveler.ErrNoChanges,
+		},
+		{
+			name:        "ff-only up to date",
+			mode:        graveler.MergeModeFastForwardOnly,
+			base:        sourceCommit,
+			expectedErr: graveler.ErrNoChanges,
+		},
+		{
+			name:        "squash up to date",
+			mode:        graveler.MergeModeSquash,
+			base:        sourceCommit,
+			expectedErr: graveler.ErrNoChanges,
+		},
+		{
+			name:            "squash",
+			mode:            graveler.MergeModeSquash,
+			base:            destinationCommit,
+			expectedCommit:  mergeCommitID,
+			expectedParents: graveler.CommitParents{destinationCommitID},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			committedManager := &testutil.CommittedFake{MergeSources: map[graveler.MetaRangeID]graveler.MetaRangeID{"sourceRangeID": expectedRangeID}}
+			stagingManager := &testutil.StagingFake{ValueIterator: testutil.NewValueIteratorFake(nil)}
+			refManager := &testutil.RefsFake{
+				CommitID: mergeCommitID,
+				Branch:   &graveler.Branch{CommitID: destinationCommitID},
+				Refs: map[graveler.Ref]*graveler.ResolvedRef{
+					graveler.Ref(mergeDestination): {
+						Type:     graveler.ReferenceTypeBranch,
+						BranchID: mergeDestination,
+						CommitID: destinationCommitID,
+					},
+					sourceCommitID.Ref(): {
+						Type:     graveler.ReferenceTypeCommit,
+						CommitID: sourceCommitID,
+					},
+				},
+				Commits: map[graveler.CommitID]*graveler.Commit{
+					sourceCommitID:      sourceCommit,
+					destinationCommitID: destinationCommit,
+				},
+				MergeBase: tt.base,
+			}
+			g := graveler.NewGraveler(branchLocker, committedManager, stagingManager, refManager, nil, testutil.NewProtectedBranchesManagerFake(), testutil.NewMergeStrategyRulesManagerFake(), nil)
+			commitID, err := g.Merge(ctx, "repoID", mergeDestination, sourceCommitID.Ref(), graveler.CommitParams{
+				Committer: "committer",
+				Message:   "message",
+			}, "", nil, tt.mode)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("Merge err=%v, expected=%v", err, tt.expectedErr)
+			}
+			if err != nil {
+				return
+			}
+			if commitID != tt.expectedCommit {
+				t.Errorf("Merge commit ID=%s, expected=%s", commitID, tt.expectedCommit)
+			}
+			if diff := deep.Equal(refManager.AddedCommit.Parents, tt.expectedParents); diff != nil {
+				t.Error("Merge unexpected parents:", diff)
+			}
+		})
+	}
+}
+
+func TestGraveler_MergePreview(t *testing.T) {
+	const sourceCommitID = graveler.CommitID("sourceCommitID")
+	const destinationCommitID = graveler.CommitID("destinationCommitID")
+	const destination = graveler.BranchID("destinationID")
+	committedManager := &testutil.CommittedFake{
+		DiffIterator: testutil.NewDiffIter([]graveler.Diff{
+			{Key: graveler.Key("file1"), Type: graveler.DiffTypeAdded},
+			{Key: graveler.Key("tables/a/1"), Type: graveler.DiffTypeAdded},
+			{Key: graveler.Key("tables/a/2"), Type: graveler.DiffTypeChanged},
+			{Key: graveler.Key("tables/b/1"), Type: graveler.DiffTypeRemoved},
+			{Key: graveler.Key("tables/b/2"), Type: graveler.DiffTypeConflict},
+			{Key: graveler.Key("views/c"), Type: graveler.DiffTypeAdded},
+		}),
+	}
+	stagingManager := &testutil.StagingFake{ValueIterator: testutil.NewValueIteratorFake(nil)}
+	refManager := &testutil.RefsFake{
+		Branch: &graveler.Branch{CommitID: destinationCommitID},
+		Refs: map[graveler.Ref]*graveler.ResolvedRef{
+			graveler.Ref(destination): {
+				Type:     graveler.ReferenceTypeBranch,
+				BranchID: destination,
+				CommitID: destinationCommitID,
+			},
+			sourceCommitID.Ref(): {
+				Type:     graveler.ReferenceTypeCommit,
+				CommitID: sourceCommitID,
+			},
+		},
+		Commits: map[graveler.CommitID]*graveler.Commit{
+			sourceCommitID:      {MetaRangeID: "sourceRangeID"},
+			destinationCommitID: {MetaRangeID: "destinationRangeID"},
+		},
+	}
+	ctx := context.Background()
+	g := graveler.NewGraveler(nil, committedManager, stagingManager, refManager, nil, testutil.NewProtectedBranchesManagerFake(), testutil.NewMergeStrategyRulesManagerFake(), nil)
+	preview, err := g.MergePreview(ctx, "repoID", destination, sourceCommitID.Ref(), "", nil)
+	if err != nil {
+		t.Fatalf("MergePreview err=%v, expected none", err)
+	}
+	if preview.SourceCommitID != sourceCommitID || preview.DestinationCommitID != destinationCommitID {
+		t.Errorf("MergePreview source=%s destination=%s, expected source=%s destination=%s",
+			preview.SourceCommitID, preview.DestinationCommitID, sourceCommitID, destinationCommitID)
+	}
+	if preview.FastForward {
+		t.Error("MergePreview expected no fast-forward")
+	}
+	expectedSummary := map[string]graveler.DiffSummary{
+		"":        {Count: map[graveler.DiffType]int{graveler.DiffTypeAdded: 1}},
+		"tables/": {Count: map[graveler.DiffType]int{graveler.DiffTypeAdded: 1, graveler.DiffTypeChanged: 1, graveler.DiffTypeRemoved: 1, graveler.DiffTypeConflict: 1}},
+		"views/":  {Count: map[graveler.DiffType]int{graveler.DiffTypeAdded: 1}},
+	}
+	if diff := deep.Equal(preview.Summary, expectedSummary); diff != nil {
+		t.Error("MergePreview unexpected summary:", diff)
+	}
+}
+
+func TestGraveler_MergeStrategyPrecedence(t *testing.T) {
+	const sourceCommitID = graveler.CommitID("sourceCommitID")
+	const destinationCommitID = graveler.CommitID("destinationCommitID")
+	const destination = graveler.BranchID("destinationID")
+	requestRule := &graveler.MergeStrategyRule{Pattern: "tables/", Strategy: "dest-wins"}
+	repositoryRule := &graveler.MergeStrategyRule{Pattern: "views/", Strategy: "dest-wins"}
+
+	tests := []struct {
+		name          string
+		strategy      string
+		expectedRules []*graveler.MergeStrategyRule
+	}{
+		{name: "no strategy", strategy: "", expectedRules: []*graveler.MergeStrategyRule{requestRule, repositoryRule}},
+		{name: "source-wins", strategy: "source-wins", expectedRules: []*graveler.MergeStrategyRule{requestRule}},
+		{name: "dest-wins", strategy: "dest-wins", expectedRules: []*graveler.MergeStrategyRule{requestRule}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			committedManager := &testutil.CommittedFake{DiffIterator: testutil.NewDiffIter(nil)}
+			refManager := &testutil.RefsFake{
+				Branch: &graveler.Branch{CommitID: destinationCommitID},
+				Refs: map[graveler.Ref]*graveler.ResolvedRef{
+					graveler.Ref(destination): {
+						Type:     graveler.ReferenceTypeBranch,
+						BranchID: destination,
+						CommitID: destinationCommitID,
+					},
+					sourceCommitID.Ref(): {
+						Type:     graveler.ReferenceTypeCommit,
+						CommitID: sourceCommitID,
+					},
+				},
+				Commits: map[graveler.CommitID]*graveler.Commit{
+					sourceCommitID:      {MetaRangeID: "sourceRangeID"},
+					destinationCommitID: {MetaRangeID: "destinationRangeID"},
+				},
+			}
+			stagingManager := &testutil.StagingFake{ValueIterator: testutil.NewValueIteratorFake(nil)}
+			g := graveler.NewGraveler(nil, committedManager, stagingManager, refManager, nil, testutil.NewProtectedBranchesManagerFake(), testutil.NewMergeStrategyRulesManagerFake(repositoryRule), nil)
+			_, err := g.MergePreview(context.Background(), "repoID", destination, sourceCommitID.Ref(), tt.strategy, []*graveler.MergeStrategyRule{requestRule})
+			if err != nil {
+				t.Fatalf("MergePreview err=%v, expected none", err)
+			}
+			if diff := deep.Equal(committedManager.MergeRules, tt.expectedRules); diff != nil {
+				t.Error("MergePreview unexpected merge strategy rules:", diff)
+			}
+		})
+	}
+}
+
+func TestGraveler_CompareBranch(t *testing.T) {
+	const branchCommitID = graveler.CommitID("branchCommitID")
+	const baseCommitID = graveler.CommitID("baseCommitID")
+	const branchID = graveler.BranchID("branchID")
+	committedManager := &testutil.CommittedFake{
+		DiffIterator: testutil.NewDiffIter([]graveler.Diff{
+			{Key: graveler.Key("file1"), Type: graveler.DiffTypeAdded},
+			{Key: graveler.Key("tables/a/1"), Type: graveler.DiffTypeAdded},
+			{Key: graveler.Key("tables/a/2"), Type: graveler.DiffTypeChanged},
+			{Key: graveler.Key("tables/b/1"), Type: graveler.DiffTypeRemoved},
+		}),
+	}
+	mergeBase := &graveler.Commit{MetaRangeID: "mergeBaseRangeID"}
+	refManager := &testutil.RefsFake{
+		Branch: &graveler.Branch{CommitID: branchCommitID},
+		Refs: map[graveler.Ref]*graveler.ResolvedRef{
+			"main": {
+				Type:     graveler.ReferenceTypeBranch,
+				BranchID: "main",
+				CommitID: baseCommitID,
+			},
+		},
+		Commits: map[graveler.CommitID]*graveler.Commit{
+			branchCommitID: {MetaRangeID: "branchRangeID"},
+			baseCommitID:   {MetaRangeID: "baseRangeID"},
+		},
+		MergeBase: mergeBase,
+		Ahead:     2,
+		Behind:    3,
+	}
+	ctx := context.Background()
+	g := graveler.NewGraveler(nil, committedManager, &testutil.StagingFake{}, refManager, nil, testutil.NewProtectedBranchesManagerFake(), nil, nil)
+	comparison, err := g.CompareBranch(ctx, "repoID", branchID, "main")
+	if err != nil {
+		t.Fatalf("CompareBranch err=%v, expected none", err)
+	}
+	expected := &graveler.BranchComparison{
+		BranchID:     branchID,
+		CommitID:     branchCommitID,
+		BaseCommitID: baseCommitID,
+		MergeBaseID:  graveler.CommitID(ident.NewHexAddressProvider().ContentAddress(mergeBase)),
+		Ahead:        2,
+		Behind:       3,
+		Summary: graveler.DiffSummary{Count: map[graveler.DiffType]int{
+			graveler.DiffTypeAdded:   2,
+			graveler.DiffTypeChanged: 1,
+			graveler.DiffTypeRemoved: 1,
+		}},
+	}
+	if diff := deep.Equal(comparison, expected); diff != nil {
+		t.Error("CompareBranch unexpected comparison:", diff)
+	}
+}
+
+func TestGraveler_DeleteTag(t *testing.T) {
+	ctx := context.Background()
+	g := graveler.NewGraveler(nil, &testutil.CommittedFake{}, &testutil.StagingFake{}, &testutil.RefsFake{}, nil, testutil.NewProtectedBranchesManagerFake(), nil, testutil.NewProtectedTagsManagerFake("v1.0"))
+	if err := g.DeleteTag(ctx, "repoID", "v1.0"); !errors.Is(err, graveler.ErrDeleteProtectedTag) {
+		t.Errorf("DeleteTag of protected tag err=%v, expected=%v", err, graveler.ErrDeleteProtectedTag)
+	}
+	if err := g.DeleteTag(ctx, "repoID", "v1.1"); err != nil {
+		t.Errorf("DeleteTag of unprotected tag err=%v, expected none", err)
+	}
+}
+
+func TestGraveler_DeleteRepositoryLegalHold(t *testing.T) {
+	ctx := context.Background()
+	refs := &testutil.RefsFake{
+		LegalHolds: []*graveler.LegalHoldRecord{{LegalHoldID: "hold1", LegalHold: &graveler.LegalHold{CommitID: "c1"}}},
+	}
+	g := graveler.NewGraveler(nil, &testutil.CommittedFake{}, &testutil.StagingFake{}, refs, nil, testutil.NewProtectedBranchesManagerFake(), nil, nil)
+	if err := g.DeleteRepository(ctx, "repoID"); !errors.Is(err, graveler.ErrDeleteLegalHoldRepository) {
+		t.Errorf("DeleteRepository with legal hold err=%v, expected=%v", err, graveler.ErrDeleteLegalHoldRepository)
+	}
+	refs.LegalHolds = nil
+	if err := g.DeleteRepository(ctx, "repoID"); err != nil {
+		t.Errorf("DeleteRepository without legal holds err=%v, expected none", err)
+	}
+}
+
+func TestGraveler_CherryPick(t *testing.T) {
+	// prepare graveler
+	conn, _ := tu.GetDB(t, databaseURI)
+	branchLocker := ref.NewBranchLocker(conn)
+	const expectedRangeID = graveler.MetaRangeID("expectedRangeID")
+	const expectedCommitID = graveler.CommitID("expectedCommitID")
+	const pickedCommitID = graveler.CommitID("pickedCommitID")
+	const pickedParentCommitID = graveler.CommitID("pickedParentCommitID")
+	const rootCommitID = graveler.CommitID("rootCommitID")
+	const emptyRootCommitID = graveler.CommitID("emptyRootCommitID")
+	const appliedCommitID = graveler.CommitID("appliedCommitID")
+	const branchCommitID = graveler.CommitID("branchCommitID")
+	const cherryPickBranchID = graveler.BranchID("branchID")
+	const pickedMessage = "picked message"
+	pickedMetadata := graveler.Metadata{"key1": "val1"}
+	committedManager := &testutil.CommittedFake{MergeSources: map[graveler.MetaRangeID]graveler.MetaRangeID{
+		"pickedRangeID": expectedRangeID,
+		"rootRangeID":   expectedRangeID,
+		// the changes of the applied commit are already on the branch
+		"appliedRangeID": "branchRangeID",
+	}}
+	stagingManager := &testutil.StagingFake{ValueIterator: testutil.NewValueIteratorFake(nil)}
+	refManager := &testutil.RefsFake{
+		CommitID: expectedCommitID,
+		Branch:   &graveler.Branch{CommitID: branchCommitID},
+		Refs: map[graveler.Ref]*graveler.ResolvedRef{
+			pickedCommitID.Ref():       {Type: graveler.ReferenceTypeCommit, CommitID: pickedCommitID},
+			pickedParentCommitID.Ref(): {Type: graveler.ReferenceTypeCommit, CommitID: pickedParentCommitID},
+			rootCommitID.Ref():         {Type: graveler.ReferenceTypeCommit, CommitID: rootCommitID},
+			emptyRootCommitID.Ref():    {Type: graveler.ReferenceTypeCommit, CommitID: emptyRootCommitID},
+			appliedCommitID.Ref():      {Type: graveler.ReferenceTypeCommit, CommitID: appliedCommitID},
+			branchCommitID.Ref():       {Type: graveler.ReferenceTypeCommit, CommitID: branchCommitID},
+		},
+		Commits: map[graveler.CommitID]*graveler.Commit{
+			pickedCommitID:       {MetaRangeID: "pickedRangeID", Message: pickedMessage, Metadata: pickedMetadata, Parents: graveler.CommitParents{pickedParentCommitID}},
+			pickedParentCommitID: {MetaRangeID: "parentRangeID"},
+			rootCommitID:         {MetaRangeID: "rootRangeID", Message: pickedMessage, Metadata: pickedMetadata},
+			emptyRootCommitID:    {MetaRangeID: ""},
+			appliedCommitID:      {MetaRangeID: "appliedRangeID", Parents: graveler.CommitParents{pickedParentCommitID}},
+			branchCommitID:       {MetaRangeID: "branchRangeID"},
+		},
+	}
+	// tests
+	errSomethingBad := errors.New("first error")
+	const repositoryID = "repoID"
+	const committer = "committer"
+	tests := []struct {
+		name         string
+		picked       graveler.CommitID
+		hook         bool
+		parentNumber int
+		err          error
+	}{
+		{
+			name: "without hook",
+			hook: false,
+			err:  nil,
+		},
+		{
+			name:   "root commit",
+			picked: rootCommitID,
+			err:    nil,
+		},
+		{
+			name:   "empty root commit",
+			picked: emptyRootCommitID,
+			err:    graveler.ErrNoChanges,
+		},
+		{
+			name:   "already applied",
+			picked: appliedCommitID,
+			err:    graveler.ErrNoChanges,
+		},
+		{
+			name:         "explicit parent",
+			hook:         false,
+			parentNumber: 1,
+			err:          nil,
+		},
+		{
+			name:         "parent out of range",
+			hook:         false,
+			parentNumber: 2,
+			err:          graveler.ErrCherryPickParentOutOfRange,
+		},
+		{
+			name: "hook no error",
+			hook: true,
+			err:  nil,
+		},
+		{
+			name: "hook error",
+			hook: true,
+			err:  errSomethingBad,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// setup
+			ctx := context.Background()
+			refManager.AddedCommit = testutil.AddedCommitData{}
+			g := graveler.NewGraveler(branchLocker, committedManager, stagingManager, refManager, nil, testutil.NewProtectedBranchesManagerFake(), nil, nil)
+			h := &Hooks{Err: tt.err}
+			if tt.hook {
+				g.SetHooksHandler(h)
+			}
+			picked := tt.picked
+			if picked == "" {
+				picked = pickedCommitID
+			}
+			commitID, err := g.CherryPick(ctx, repositoryID, cherryPickBranchID, picked.Ref(), tt.parentNumber, graveler.CommitParams{
+				Committer: committer,
+			})
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("CherryPick err=%v, expected=%v", err, tt.err)
+			}
+			if tt.hook != h.Called {
+				t.Fatalf("CherryPick hook h.Called=%t, expected=%t", h.Called, tt.hook)
+			}
+			if err != nil {
+				return
+			}
+			if commitID != expectedCommitID {
+				t.Errorf("CherryPick commit ID '%s', expected '%s'", commitID, expectedCommitID)
+			}
+			added := refManager.AddedCommit
+			if added.MetaRangeID != expectedRangeID {
+				t.Errorf("Added commit MetaRangeID '%s', expected '%s'", added.MetaRangeID, expectedRangeID)
+			}
+			if len(added.Parents) != 1 || added.Parents[0] != branchCommitID {
+				t.Errorf("Added commit parents %v, expected single parent %s", added.Parents, branchCommitID)
+			}
+			if added.Message != pickedMessage {
+				t.Errorf("Added commit message '%s', expected '%s'", added.Message, pickedMessage)
+			}
+			if diff := deep.Equal(added.Metadata, pickedMetadata); diff != nil {
+				t.Error("Added commit metadata diff:", diff)
+			}
+			if h.Called && h.SourceRef != picked.Ref() {
+				t.Errorf("Hook source '%s', expected '%s'", h.SourceRef, picked)
+			}
+		})
+	}
+}
+
 // TestGraveler_MergeInvalidRef test merge with invalid source reference in order
 func TestGraveler_MergeInvalidRef(t *testing.T) {
 	// prepare graveler
@@ -754,7 +1861,7 @@ func TestGraveler_MergeInvalidRef(t *testing.T) {
 			destinationCommitID: {MetaRangeID: expectedRangeID},
 		},
 	}
-	g := graveler.NewGraveler(branchLocker, committedManager, stagingManager, refManager, nil, testutil.NewProtectedBranchesManagerFake())
+	g := graveler.NewGraveler(branchLocker, committedManager, stagingManager, refManager, nil, testutil.NewProtectedBranchesManagerFake(), testutil.NewMergeStrategyRulesManagerFake(), nil)
 
 	// test merge invalid ref
 	ctx := context.Background()
@@ -765,7 +1872,7 @@ func TestGraveler_MergeInvalidRef(t *testing.T) {
 		Committer: commitCommitter,
 		Message:   mergeMessage,
 		Metadata:  graveler.Metadata{"key1": "val1"},
-	}, "")
+	}, "", nil, graveler.MergeModeDefault)
 	if !errors.Is(err, graveler.ErrInvalidRef) {
 		t.Fatalf("Merge failed with err=%v, expected ErrInvalidRef", err)
 	}
@@ -890,7 +1997,7 @@ func TestGraveler_AddCommitToBranchHead(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			g := graveler.NewGraveler(branchLocker, tt.fields.CommittedManager, tt.fields.StagingManager, tt.fields.RefManager, nil, testutil.NewProtectedBranchesManagerFake())
+			g := graveler.NewGraveler(branchLocker, tt.fields.CommittedManager, tt.fields.StagingManager, tt.fields.RefManager, nil, testutil.NewProtectedBranchesManagerFake(), nil, nil)
 			got, err := g.AddCommitToBranchHead(context.Background(), expectedRepositoryID, expectedBranchID, graveler.Commit{
 				Committer:   tt.args.committer,
 				Message:     tt.args.message,
@@ -1038,7 +2145,7 @@ func TestGraveler_AddCommit(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			g := graveler.NewGraveler(branchLocker, tt.fields.CommittedManager, tt.fields.StagingManager, tt.fields.RefManager, nil, testutil.NewProtectedBranchesManagerFake())
+			g := graveler.NewGraveler(branchLocker, tt.fields.CommittedManager, tt.fields.StagingManager, tt.fields.RefManager, nil, testutil.NewProtectedBranchesManagerFake(), nil, nil)
 			commit := graveler.Commit{
 				Committer:   tt.args.committer,
 				Message:     tt.args.message,
@@ -1218,7 +2325,7 @@ func TestGraveler_Delete(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			ctx := context.Background()
-			g := graveler.NewGraveler(branchLocker, tt.fields.CommittedManager, tt.fields.StagingManager, tt.fields.RefManager, nil, testutil.NewProtectedBranchesManagerFake())
+			g := graveler.NewGraveler(branchLocker, tt.fields.CommittedManager, tt.fields.StagingManager, tt.fields.RefManager, nil, testutil.NewProtectedBranchesManagerFake(), nil, nil)
 			if err := g.Delete(ctx, tt.args.repositoryID, tt.args.branchID, tt.args.key); !errors.Is(err, tt.expectedErr) {
 				t.Errorf("Delete() returned unexpected error. got = %v, expected %v", err, tt.expectedErr)
 			}
@@ -1233,3 +2340,24 @@ func TestGraveler_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestGraveler_SetTracksObjectAddress(t *testing.T) {
+	conn, _ := tu.GetDB(t, databaseURI)
+	branchLocker := ref.NewBranchLocker(conn)
+	ctx := context.Background()
+	stagingManager := &testutil.StagingFake{}
+	refManager := &testutil.RefsFake{Branch: &graveler.Branch{CommitID: "c1"}}
+	g := graveler.NewGraveler(branchLocker, &testutil.CommittedFake{}, stagingManager, refManager, nil, testutil.NewProtectedBranchesManagerFake(), nil, nil)
+
+	value := graveler.Value{Identity: []byte("identity"), Data: []byte("data")}
+	if err := g.Set(ctx, "repo", "main", []byte("untracked"), value); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+	if err := g.Set(ctx, "repo", "main", []byte("tracked"), value, graveler.WithObjectAddress("data/a")); err != nil {
+		t.Fatalf("Set() with object address error = %v", err)
+	}
+	expected := []*graveler.WrittenValue{{Key: []byte("tracked"), Identity: []byte("identity"), Address: "data/a"}}
+	if diff := deep.Equal(stagingManager.WrittenValues, expected); diff != nil {
+		t.Error("Set() unexpected tracked values", diff)
+	}
+}
@@ -26,6 +26,8 @@ type HookRecord struct {
 	Commit           Commit
 	CommitID         CommitID
 	PreRunID         string
+	// Prefixes limits a commit to the uncommitted changes under them, all changes are committed when empty
+	Prefixes []Key
 }
 
 type HooksHandler interface {
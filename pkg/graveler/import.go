@@ -0,0 +1,29 @@
+package graveler
+
+import (
+	"time"
+)
+
+// ImportID identifies an import in a repository
+type ImportID string
+
+func (id ImportID) String() string {
+	return string(id)
+}
+
+// Import is the state of an import of the objects under an object store prefix to a branch.  The server running
+// the import updates it until the import completes, any server reads it and requests to cancel it.
+type Import struct {
+	BranchID BranchID
+	// ImportedObjects is the number of objects listed so far
+	ImportedObjects int64
+	Completed       bool
+	MetaRangeID     MetaRangeID
+	CommitID        CommitID
+	// Error describes why the import failed, it is empty unless the import completed with an error
+	Error string
+	// Canceled is set once canceling the import was requested
+	Canceled     bool
+	CreationDate time.Time
+	UpdateTime   time.Time
+}
@@ -0,0 +1,48 @@
+package graveler
+
+import (
+	"time"
+)
+
+// LegalHoldID identifies a legal hold in a repository
+type LegalHoldID string
+
+func (id LegalHoldID) String() string {
+	return string(id)
+}
+
+// LegalHold freezes the data of a commit, or of the objects under Path in a commit.  Garbage collection never
+// removes held data, until the hold is released.
+type LegalHold struct {
+	CommitID CommitID
+	// Path is the prefix of the paths of the held objects, all objects of the commit are held when it is empty
+	Path         string
+	Reason       string
+	CreatedBy    string
+	CreationDate time.Time
+}
+
+type LegalHoldRecord struct {
+	LegalHoldID LegalHoldID
+	*LegalHold
+}
+
+// LegalHoldOperation is the operation a legal hold log entry records
+type LegalHoldOperation string
+
+const (
+	LegalHoldOperationHold    LegalHoldOperation = "hold"
+	LegalHoldOperationRelease LegalHoldOperation = "release"
+)
+
+// LegalHoldLogEntry records a hold placed or released.  The log of a repository is kept after its holds are
+// released.
+type LegalHoldLogEntry struct {
+	LegalHoldID  LegalHoldID
+	Operation    LegalHoldOperation
+	CommitID     CommitID
+	Path         string
+	Reason       string
+	User         string
+	CreationDate time.Time
+}
@@ -0,0 +1,35 @@
+package merge
+
+import (
+	"context"
+	"errors"
+
+	"github.com/treeverse/lakefs/pkg/graveler"
+	"github.com/treeverse/lakefs/pkg/graveler/settings"
+)
+
+const StrategyRulesSettingKey = "merge_strategy_rules"
+
+// StrategyRulesManager stores the merge strategy rules of a repository as a repository setting
+type StrategyRulesManager struct {
+	settingManager *settings.Manager
+}
+
+func NewStrategyRulesManager(settingManager *settings.Manager) *StrategyRulesManager {
+	return &StrategyRulesManager{settingManager: settingManager}
+}
+
+func (m *StrategyRulesManager) GetRules(ctx context.Context, repositoryID graveler.RepositoryID) (*graveler.MergeStrategyRules, error) {
+	rules, err := m.settingManager.GetLatest(ctx, repositoryID, StrategyRulesSettingKey, &graveler.MergeStrategyRules{})
+	if errors.Is(err, graveler.ErrNotFound) {
+		return &graveler.MergeStrategyRules{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return rules.(*graveler.MergeStrategyRules), nil
+}
+
+func (m *StrategyRulesManager) SetRules(ctx context.Context, repositoryID graveler.RepositoryID, rules *graveler.MergeStrategyRules) error {
+	return m.settingManager.Save(ctx, repositoryID, StrategyRulesSettingKey, rules)
+}
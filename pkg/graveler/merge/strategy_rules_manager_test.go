@@ -0,0 +1,66 @@
+package merge_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/treeverse/lakefs/pkg/block/mem"
+	"github.com/treeverse/lakefs/pkg/graveler"
+	"github.com/treeverse/lakefs/pkg/graveler/merge"
+	"github.com/treeverse/lakefs/pkg/graveler/mock"
+	"github.com/treeverse/lakefs/pkg/graveler/settings"
+	"github.com/treeverse/lakefs/pkg/testutil"
+)
+
+func TestStrategyRulesManager(t *testing.T) {
+	ctx := context.Background()
+	m := prepareTest(t, ctx)
+	rules, err := m.GetRules(ctx, "example-repo")
+	testutil.Must(t, err)
+	if len(rules.GetRules()) != 0 {
+		t.Fatalf("expected no rules, got %v", rules.GetRules())
+	}
+
+	testutil.Must(t, m.SetRules(ctx, "example-repo", &graveler.MergeStrategyRules{
+		Rules: []*graveler.MergeStrategyRule{
+			{Pattern: "data/", Strategy: "source-wins"},
+			{Pattern: "**/*.lock", Strategy: "dest-wins"},
+		},
+	}))
+	rules, err = m.GetRules(ctx, "example-repo")
+	testutil.Must(t, err)
+	expected := []string{"data/=source-wins", "**/*.lock=dest-wins"}
+	if len(rules.GetRules()) != len(expected) {
+		t.Fatalf("got %d rules, expected %d", len(rules.GetRules()), len(expected))
+	}
+	for i, rule := range rules.GetRules() {
+		if got := rule.GetPattern() + "=" + rule.GetStrategy(); got != expected[i] {
+			t.Errorf("rule %d is %s, expected %s", i, got, expected[i])
+		}
+	}
+
+	testutil.Must(t, m.SetRules(ctx, "example-repo", &graveler.MergeStrategyRules{}))
+	rules, err = m.GetRules(ctx, "example-repo")
+	testutil.Must(t, err)
+	if len(rules.GetRules()) != 0 {
+		t.Fatalf("expected no rules after clear, got %v", rules.GetRules())
+	}
+}
+
+func prepareTest(t *testing.T, ctx context.Context) *merge.StrategyRulesManager {
+	ctrl := gomock.NewController(t)
+	refManager := mock.NewMockRefManager(ctrl)
+	blockAdapter := mem.New()
+	branchLock := mock.NewMockBranchLocker(ctrl)
+	cb := func(_ context.Context, _ graveler.RepositoryID, _ graveler.BranchID, f func() (interface{}, error)) (interface{}, error) {
+		return f()
+	}
+	branchLock.EXPECT().MetadataUpdater(ctx, gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(cb).AnyTimes()
+	refManager.EXPECT().GetRepository(ctx, gomock.Any()).AnyTimes().Return(&graveler.Repository{
+		StorageNamespace: "mem://my-storage",
+		DefaultBranchID:  "main",
+	}, nil)
+	m := settings.NewManager(refManager, branchLock, blockAdapter, "_lakefs")
+	return merge.NewStrategyRulesManager(m)
+}
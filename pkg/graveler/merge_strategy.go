@@ -0,0 +1,94 @@
+package graveler
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/gobwas/glob"
+)
+
+const (
+	mergeStrategyDestWins   = "dest-wins"
+	mergeStrategySourceWins = "source-wins"
+
+	// globSpecialChars are the characters that make a merge strategy rule pattern a glob rather than a prefix
+	globSpecialChars = "*?[{"
+)
+
+type keyMatcher func(key Key) bool
+
+type mergeStrategyMatcherRule struct {
+	match    keyMatcher
+	strategy MergeStrategy
+}
+
+// MergeStrategyMatcher selects the merge strategy of each key: the strategy of the first rule whose pattern matches
+// the key, or the default strategy when no rule matches.
+type MergeStrategyMatcher struct {
+	defaultStrategy MergeStrategy
+	rules           []mergeStrategyMatcherRule
+}
+
+// NewMergeStrategyMatcher returns a matcher that applies rules in order, falling back to defaultStrategy.
+// A rule pattern that holds any of the glob special characters '*', '?', '[' or '{' is matched as a glob where '*'
+// does not cross a '/' and '**' does, any other pattern is matched as a key prefix.
+func NewMergeStrategyMatcher(defaultStrategy MergeStrategy, rules []*MergeStrategyRule) (*MergeStrategyMatcher, error) {
+	m := &MergeStrategyMatcher{
+		defaultStrategy: defaultStrategy,
+		rules:           make([]mergeStrategyMatcherRule, 0, len(rules)),
+	}
+	for _, rule := range rules {
+		strategy, err := parseMergeStrategyRuleStrategy(rule.GetStrategy())
+		if err != nil {
+			return nil, err
+		}
+		match, err := newKeyMatcher(rule.GetPattern())
+		if err != nil {
+			return nil, err
+		}
+		m.rules = append(m.rules, mergeStrategyMatcherRule{match: match, strategy: strategy})
+	}
+	return m, nil
+}
+
+// StrategyFor returns the merge strategy used to resolve a conflict on key
+func (m *MergeStrategyMatcher) StrategyFor(key Key) MergeStrategy {
+	for _, rule := range m.rules {
+		if rule.match(key) {
+			return rule.strategy
+		}
+	}
+	return m.defaultStrategy
+}
+
+func newKeyMatcher(pattern string) (keyMatcher, error) {
+	if pattern == "" {
+		return nil, fmt.Errorf("%w: empty pattern", ErrInvalidMergeStrategyRule)
+	}
+	if !strings.ContainsAny(pattern, globSpecialChars) {
+		prefix := []byte(pattern)
+		return func(key Key) bool {
+			return bytes.HasPrefix(key, prefix)
+		}, nil
+	}
+	g, err := glob.Compile(pattern, '/')
+	if err != nil {
+		return nil, fmt.Errorf("%w: pattern %s: %s", ErrInvalidMergeStrategyRule, pattern, err)
+	}
+	return func(key Key) bool {
+		return g.Match(string(key))
+	}, nil
+}
+
+func parseMergeStrategyRuleStrategy(strategy string) (MergeStrategy, error) {
+	switch strategy {
+	case mergeStrategyDestWins:
+		return MergeStrategyDest, nil
+	case mergeStrategySourceWins:
+		return MergeStrategySource, nil
+	default:
+		return MergeStrategyNone, fmt.Errorf("%w: strategy '%s', expected '%s' or '%s'",
+			ErrInvalidMergeStrategyRule, strategy, mergeStrategyDestWins, mergeStrategySourceWins)
+	}
+}
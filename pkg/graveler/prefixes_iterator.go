@@ -0,0 +1,96 @@
+package graveler
+
+import (
+	"bytes"
+	"sort"
+)
+
+// PrefixesIterator iterates over the values of an underlying iterator whose keys are under any of the given
+// prefixes, seeking the underlying iterator from one prefix to the next
+type PrefixesIterator struct {
+	it ValueIterator
+	// prefixes are sorted, none of them under another
+	prefixes []Key
+	// current is the index of the prefix being iterated, seeked is set once the iterator was moved to it
+	current int
+	seeked  bool
+	value   *ValueRecord
+	err     error
+}
+
+func NewPrefixesIterator(it ValueIterator, prefixes []Key) *PrefixesIterator {
+	sorted := make([]Key, len(prefixes))
+	copy(sorted, prefixes)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i], sorted[j]) < 0
+	})
+	// a prefix sorts before any key under it, drop prefixes already covered by a previous one
+	var normalized []Key
+	for _, prefix := range sorted {
+		if len(normalized) > 0 && bytes.HasPrefix(prefix, normalized[len(normalized)-1]) {
+			continue
+		}
+		normalized = append(normalized, prefix)
+	}
+	return &PrefixesIterator{
+		it:       it,
+		prefixes: normalized,
+	}
+}
+
+func (p *PrefixesIterator) Next() bool {
+	if p.err != nil {
+		return false
+	}
+	for p.current < len(p.prefixes) {
+		prefix := p.prefixes[p.current]
+		if !p.seeked {
+			p.it.SeekGE(prefix)
+			p.seeked = true
+		}
+		if !p.it.Next() {
+			p.err = p.it.Err()
+			p.current = len(p.prefixes)
+			break
+		}
+		value := p.it.Value()
+		if bytes.HasPrefix(value.Key, prefix) {
+			p.value = value
+			return true
+		}
+		// passed the current prefix
+		p.current++
+		p.seeked = false
+	}
+	p.value = nil
+	return false
+}
+
+func (p *PrefixesIterator) SeekGE(id Key) {
+	p.value = nil
+	p.err = nil
+	p.current = sort.Search(len(p.prefixes), func(i int) bool {
+		return bytes.Compare(p.prefixes[i], id) > 0 || bytes.HasPrefix(id, p.prefixes[i])
+	})
+	if p.current < len(p.prefixes) && bytes.HasPrefix(id, p.prefixes[p.current]) {
+		p.it.SeekGE(id)
+		p.seeked = true
+	} else {
+		p.seeked = false
+	}
+}
+
+func (p *PrefixesIterator) Value() *ValueRecord {
+	if p.err != nil {
+		return nil
+	}
+	return p.value
+}
+
+func (p *PrefixesIterator) Err() error {
+	return p.err
+}
+
+func (p *PrefixesIterator) Close() {
+	p.it.Close()
+}
@@ -0,0 +1,63 @@
+package graveler_test
+
+import (
+	"testing"
+
+	"github.com/go-test/deep"
+	"github.com/treeverse/lakefs/pkg/graveler"
+	"github.com/treeverse/lakefs/pkg/graveler/testutil"
+)
+
+func TestPrefixesIterator(t *testing.T) {
+	keys := []string{"a", "a/1", "a/2", "ab", "b/1", "b/2", "c", "c/1", "d/1"}
+	newIterator := func(prefixes ...string) *graveler.PrefixesIterator {
+		records := make([]graveler.ValueRecord, 0, len(keys))
+		for _, key := range keys {
+			records = append(records, graveler.ValueRecord{Key: graveler.Key(key), Value: &graveler.Value{Identity: []byte(key)}})
+		}
+		prefixKeys := make([]graveler.Key, 0, len(prefixes))
+		for _, prefix := range prefixes {
+			prefixKeys = append(prefixKeys, graveler.Key(prefix))
+		}
+		return graveler.NewPrefixesIterator(testutil.NewValueIteratorFake(records), prefixKeys)
+	}
+	readKeys := func(it graveler.ValueIterator) []string {
+		var result []string
+		for it.Next() {
+			result = append(result, it.Value().Key.String())
+		}
+		if err := it.Err(); err != nil {
+			t.Fatal("unexpected iterator error", err)
+		}
+		return result
+	}
+
+	tests := []struct {
+		name     string
+		prefixes []string
+		seek     string
+		expected []string
+	}{
+		{name: "single prefix", prefixes: []string{"a/"}, expected: []string{"a/1", "a/2"}},
+		{name: "unsorted prefixes", prefixes: []string{"c/", "a/"}, expected: []string{"a/1", "a/2", "c/1"}},
+		{name: "nested prefixes", prefixes: []string{"a/1", "a", "b/2"}, expected: []string{"a", "a/1", "a/2", "ab", "b/2"}},
+		{name: "path", prefixes: []string{"c", "d/1"}, expected: []string{"c", "c/1", "d/1"}},
+		{name: "no match", prefixes: []string{"e/", "0"}, expected: nil},
+		{name: "empty prefix", prefixes: []string{""}, expected: keys},
+		{name: "seek inside prefix", prefixes: []string{"a/", "c/"}, seek: "a/2", expected: []string{"a/2", "c/1"}},
+		{name: "seek between prefixes", prefixes: []string{"a/", "c/"}, seek: "b", expected: []string{"c/1"}},
+		{name: "seek past prefixes", prefixes: []string{"a/", "c/"}, seek: "d", expected: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			it := newIterator(tt.prefixes...)
+			defer it.Close()
+			if tt.seek != "" {
+				it.SeekGE(graveler.Key(tt.seek))
+			}
+			if diff := deep.Equal(readKeys(it), tt.expected); diff != nil {
+				t.Error("PrefixesIterator unexpected keys:", diff)
+			}
+		})
+	}
+}
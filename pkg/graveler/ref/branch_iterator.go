@@ -3,6 +3,7 @@ package ref
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/treeverse/lakefs/pkg/db"
 	"github.com/treeverse/lakefs/pkg/graveler"
@@ -33,6 +34,29 @@ type branchRecord struct {
 	BranchID     graveler.BranchID     `db:"id"`
 	CommitID     graveler.CommitID     `db:"commit_id"`
 	StagingToken graveler.StagingToken `db:"staging_token"`
+	ExpiresAt    *time.Time            `db:"expires_at"`
+	IdleTTL      *int64                `db:"idle_ttl"`
+}
+
+func (r *branchRecord) toBranch() *graveler.Branch {
+	branch := &graveler.Branch{
+		CommitID:     r.CommitID,
+		StagingToken: r.StagingToken,
+		Expiry:       graveler.BranchExpiry{ExpiresAt: r.ExpiresAt},
+	}
+	if r.IdleTTL != nil {
+		branch.Expiry.IdleTTL = time.Duration(*r.IdleTTL) * time.Second
+	}
+	return branch
+}
+
+// branchExpiryValues returns the expires_at and idle_ttl column values of expiry
+func branchExpiryValues(expiry graveler.BranchExpiry) (*time.Time, *int64) {
+	if expiry.IdleTTL == 0 {
+		return expiry.ExpiresAt, nil
+	}
+	idleTTL := int64(expiry.IdleTTL / time.Second)
+	return expiry.ExpiresAt, &idleTTL
 }
 
 func NewBranchIterator(ctx context.Context, db db.Database, repositoryID graveler.RepositoryID, prefetchSize int, opts ...BranchIteratorOption) *BranchIterator {
@@ -84,7 +108,7 @@ func (ri *BranchIterator) maybeFetch() {
 
 	var buf []*branchRecord
 	err := ri.db.Select(ri.ctx, &buf, `
-			SELECT id, staging_token, commit_id
+			SELECT id, staging_token, commit_id, expires_at, idle_ttl
 			FROM graveler_branches
 			WHERE repository_id = $1
 			AND id `+offsetCondition+` $2
@@ -100,10 +124,7 @@ func (ri *BranchIterator) maybeFetch() {
 	for _, b := range buf {
 		rec := &graveler.BranchRecord{
 			BranchID: b.BranchID,
-			Branch: &graveler.Branch{
-				CommitID:     b.CommitID,
-				StagingToken: b.StagingToken,
-			},
+			Branch:   b.toBranch(),
 		}
 		ri.buf = append(ri.buf, rec)
 	}
@@ -0,0 +1,142 @@
+package ref
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/treeverse/lakefs/pkg/db"
+	"github.com/treeverse/lakefs/pkg/graveler"
+)
+
+// BranchReflogIterator iterates over the reflog of a branch, latest entry first
+type BranchReflogIterator struct {
+	db           db.Database
+	ctx          context.Context
+	repositoryID graveler.RepositoryID
+	branchID     graveler.BranchID
+	value        *graveler.BranchReflogEntry
+	buf          []*graveler.BranchReflogEntry
+	// index is the index of the next entry to read, lastID is the id of the last entry read
+	index     int
+	lastID    int64
+	fetchSize int
+	err       error
+	state     iteratorState
+}
+
+type branchReflogRecord struct {
+	ID           int64                          `db:"id"`
+	OldCommitID  graveler.CommitID              `db:"old_commit_id"`
+	NewCommitID  graveler.CommitID              `db:"new_commit_id"`
+	Operation    graveler.BranchReflogOperation `db:"operation"`
+	UserID       string                         `db:"user_id"`
+	CreationDate time.Time                      `db:"creation_date"`
+}
+
+func NewBranchReflogIterator(ctx context.Context, db db.Database, repositoryID graveler.RepositoryID, branchID graveler.BranchID, prefetchSize int) *BranchReflogIterator {
+	return &BranchReflogIterator{
+		db:           db,
+		ctx:          ctx,
+		repositoryID: repositoryID,
+		branchID:     branchID,
+		fetchSize:    prefetchSize,
+		buf:          make([]*graveler.BranchReflogEntry, 0, prefetchSize),
+	}
+}
+
+func (ri *BranchReflogIterator) Next() bool {
+	if ri.err != nil {
+		return false
+	}
+	ri.maybeFetch()
+
+	// stage a value and increment offset
+	if len(ri.buf) == 0 {
+		return false
+	}
+	ri.value = ri.buf[0]
+	ri.buf = ri.buf[1:]
+	return true
+}
+
+func (ri *BranchReflogIterator) maybeFetch() {
+	if ri.state == iteratorStateDone {
+		return
+	}
+	if len(ri.buf) > 0 {
+		return
+	}
+
+	var buf []*branchReflogRecord
+	var err error
+	if ri.state == iteratorStateInit {
+		// entries are numbered from the latest one, skip to the requested index
+		err = ri.db.Select(ri.ctx, &buf, `
+			SELECT id, old_commit_id, new_commit_id, operation, user_id, creation_date
+			FROM graveler_branch_reflog
+			WHERE repository_id = $1 AND branch_id = $2
+			ORDER BY id DESC
+			OFFSET $3
+			LIMIT $4`, ri.repositoryID, ri.branchID, ri.index, ri.fetchSize)
+		ri.state = iteratorStateQuerying
+	} else {
+		err = ri.db.Select(ri.ctx, &buf, `
+			SELECT id, old_commit_id, new_commit_id, operation, user_id, creation_date
+			FROM graveler_branch_reflog
+			WHERE repository_id = $1 AND branch_id = $2
+			AND id < $3
+			ORDER BY id DESC
+			LIMIT $4`, ri.repositoryID, ri.branchID, ri.lastID, ri.fetchSize)
+	}
+	if err != nil {
+		ri.err = err
+		return
+	}
+	if len(buf) < ri.fetchSize {
+		ri.state = iteratorStateDone
+	}
+	for _, r := range buf {
+		ri.buf = append(ri.buf, &graveler.BranchReflogEntry{
+			Index:        ri.index,
+			OldCommitID:  r.OldCommitID,
+			NewCommitID:  r.NewCommitID,
+			Operation:    r.Operation,
+			User:         r.UserID,
+			CreationDate: r.CreationDate,
+		})
+		ri.index++
+		ri.lastID = r.ID
+	}
+}
+
+// SeekGE moves the iterator to the entry at index, counting from the latest entry at 0
+func (ri *BranchReflogIterator) SeekGE(index int) {
+	if errors.Is(ri.err, ErrIteratorClosed) {
+		return
+	}
+	if index < 0 {
+		index = 0
+	}
+	ri.index = index
+	ri.state = iteratorStateInit
+	ri.buf = ri.buf[:0]
+	ri.value = nil
+	ri.err = nil
+}
+
+func (ri *BranchReflogIterator) Value() *graveler.BranchReflogEntry {
+	if ri.err != nil {
+		return nil
+	}
+	return ri.value
+}
+
+func (ri *BranchReflogIterator) Err() error {
+	return ri.err
+}
+
+func (ri *BranchReflogIterator) Close() {
+	ri.err = ErrIteratorClosed
+	ri.buf = nil
+}
@@ -72,7 +72,7 @@ func NewCommitIterator(ctx context.Context, db db.Database, repositoryID gravele
 func (ci *CommitIterator) getCommitRecord(commitID graveler.CommitID) (*graveler.CommitRecord, error) {
 	var rec commitRecord
 	err := ci.db.
-		Get(ci.ctx, &rec, `SELECT id, committer, message, creation_date, parents, meta_range_id, metadata, version, generation
+		Get(ci.ctx, &rec, `SELECT id, committer, message, creation_date, parents, meta_range_id, metadata, version, generation, signature_key_id, signature
 			FROM graveler_commits
 			WHERE repository_id = $1 AND id = $2`,
 			ci.repositoryID, commitID)
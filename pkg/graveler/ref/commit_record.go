@@ -7,15 +7,17 @@ import (
 )
 
 type commitRecord struct {
-	Version      graveler.CommitVersion `db:"version"`
-	CommitID     string                 `db:"id"`
-	Committer    string                 `db:"committer"`
-	Message      string                 `db:"message"`
-	RangeID      string                 `db:"meta_range_id"`
-	CreationDate time.Time              `db:"creation_date"`
-	Parents      []string               `db:"parents"`
-	Metadata     map[string]string      `db:"metadata"`
-	Generation   int                    `db:"generation"`
+	Version        graveler.CommitVersion `db:"version"`
+	CommitID       string                 `db:"id"`
+	Committer      string                 `db:"committer"`
+	Message        string                 `db:"message"`
+	RangeID        string                 `db:"meta_range_id"`
+	CreationDate   time.Time              `db:"creation_date"`
+	Parents        []string               `db:"parents"`
+	Metadata       map[string]string      `db:"metadata"`
+	Generation     int                    `db:"generation"`
+	SignatureKeyID string                 `db:"signature_key_id"`
+	Signature      []byte                 `db:"signature"`
 }
 
 func (c *commitRecord) toGravelerCommit() *graveler.Commit {
@@ -24,14 +26,16 @@ func (c *commitRecord) toGravelerCommit() *graveler.Commit {
 		parents[i] = graveler.CommitID(c.Parents[i])
 	}
 	return &graveler.Commit{
-		Version:      c.Version,
-		Committer:    c.Committer,
-		Message:      c.Message,
-		MetaRangeID:  graveler.MetaRangeID(c.RangeID),
-		CreationDate: c.CreationDate,
-		Parents:      parents,
-		Metadata:     c.Metadata,
-		Generation:   c.Generation,
+		Version:        c.Version,
+		Committer:      c.Committer,
+		Message:        c.Message,
+		MetaRangeID:    graveler.MetaRangeID(c.RangeID),
+		CreationDate:   c.CreationDate,
+		Parents:        parents,
+		Metadata:       c.Metadata,
+		Generation:     c.Generation,
+		SignatureKeyID: c.SignatureKeyID,
+		Signature:      c.Signature,
 	}
 }
 
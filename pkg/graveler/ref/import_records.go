@@ -0,0 +1,33 @@
+package ref
+
+import (
+	"time"
+
+	"github.com/treeverse/lakefs/pkg/graveler"
+)
+
+type importRecord struct {
+	BranchID        graveler.BranchID    `db:"branch_id"`
+	ImportedObjects int64                `db:"imported_objects"`
+	Completed       bool                 `db:"completed"`
+	MetaRangeID     graveler.MetaRangeID `db:"meta_range_id"`
+	CommitID        graveler.CommitID    `db:"commit_id"`
+	Error           string               `db:"error"`
+	Canceled        bool                 `db:"canceled"`
+	CreationDate    time.Time            `db:"creation_date"`
+	UpdateTime      time.Time            `db:"update_time"`
+}
+
+func (r *importRecord) toGravelerImport() *graveler.Import {
+	return &graveler.Import{
+		BranchID:        r.BranchID,
+		ImportedObjects: r.ImportedObjects,
+		Completed:       r.Completed,
+		MetaRangeID:     r.MetaRangeID,
+		CommitID:        r.CommitID,
+		Error:           r.Error,
+		Canceled:        r.Canceled,
+		CreationDate:    r.CreationDate,
+		UpdateTime:      r.UpdateTime,
+	}
+}
@@ -0,0 +1,36 @@
+package ref
+
+import (
+	"time"
+
+	"github.com/treeverse/lakefs/pkg/graveler"
+)
+
+type legalHoldRecord struct {
+	LegalHoldID  graveler.LegalHoldID `db:"id"`
+	CommitID     graveler.CommitID    `db:"commit_id"`
+	Path         string               `db:"path"`
+	Reason       string               `db:"reason"`
+	CreatedBy    string               `db:"created_by"`
+	CreationDate time.Time            `db:"creation_date"`
+}
+
+func (r *legalHoldRecord) toGravelerLegalHold() *graveler.LegalHold {
+	return &graveler.LegalHold{
+		CommitID:     r.CommitID,
+		Path:         r.Path,
+		Reason:       r.Reason,
+		CreatedBy:    r.CreatedBy,
+		CreationDate: r.CreationDate,
+	}
+}
+
+type legalHoldLogRecord struct {
+	LegalHoldID  graveler.LegalHoldID        `db:"hold_id"`
+	Operation    graveler.LegalHoldOperation `db:"operation"`
+	CommitID     graveler.CommitID           `db:"commit_id"`
+	Path         string                      `db:"path"`
+	Reason       string                      `db:"reason"`
+	User         string                      `db:"user_id"`
+	CreationDate time.Time                   `db:"creation_date"`
+}
@@ -4,8 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
+	sq "github.com/Masterminds/squirrel"
 	"github.com/lib/pq"
 	"github.com/treeverse/lakefs/pkg/batch"
 	"github.com/treeverse/lakefs/pkg/db"
@@ -51,7 +54,7 @@ func (m *Manager) GetRepository(ctx context.Context, repositoryID graveler.Repos
 		return m.db.Transact(ctx, func(tx db.Tx) (interface{}, error) {
 			repository := &graveler.Repository{}
 			err := tx.Get(repository,
-				`SELECT storage_namespace, creation_date, default_branch FROM graveler_repositories WHERE id = $1`,
+				`SELECT storage_namespace, creation_date, default_branch FROM graveler_repositories WHERE id = $1 AND deleted_at IS NULL`,
 				repositoryID)
 			if err != nil {
 				return nil, err
@@ -103,6 +106,10 @@ func (m *Manager) CreateRepository(ctx context.Context, repositoryID graveler.Re
 			}
 			return nil, err
 		}
+		err = addBranchReflogEntry(ctx, tx, repositoryID, repository.DefaultBranchID, "", graveler.CommitID(commitID), graveler.BranchReflogOperationCreate)
+		if err != nil {
+			return nil, err
+		}
 
 		// Add a first empty commit to allow branching off the default branch immediately after repository creation
 		return nil, m.addCommit(tx, repositoryID, commitID, firstCommit)
@@ -123,11 +130,27 @@ func (m *Manager) ListRepositories(ctx context.Context) (graveler.RepositoryIter
 
 func (m *Manager) DeleteRepository(ctx context.Context, repositoryID graveler.RepositoryID) error {
 	_, err := m.db.Transact(ctx, func(tx db.Tx) (interface{}, error) {
-		var err error
+		var held bool
+		err := tx.Get(&held, `SELECT EXISTS (SELECT 1 FROM graveler_legal_holds WHERE repository_id = $1)`, repositoryID)
+		if err != nil {
+			return nil, err
+		}
+		if held {
+			return nil, fmt.Errorf("%s: %w", repositoryID, graveler.ErrDeleteLegalHoldRepository)
+		}
 		_, err = tx.Exec(`DELETE FROM graveler_branches WHERE repository_id = $1`, repositoryID)
 		if err != nil {
 			return nil, err
 		}
+		_, err = tx.Exec(`DELETE FROM graveler_branch_reflog WHERE repository_id = $1`, repositoryID)
+		if err != nil {
+			return nil, err
+		}
+		_, err = tx.Exec(`DELETE FROM graveler_branch_stashes WHERE repository_id = $1`, repositoryID)
+		if err != nil {
+			return nil, err
+		}
+		// the legal hold log is kept as an audit record of the repository
 		_, err = tx.Exec(`DELETE FROM graveler_tags WHERE repository_id = $1`, repositoryID)
 		if err != nil {
 			return nil, err
@@ -136,6 +159,22 @@ func (m *Manager) DeleteRepository(ctx context.Context, repositoryID graveler.Re
 		if err != nil {
 			return nil, err
 		}
+		_, err = tx.Exec(`DELETE FROM graveler_commit_metadata WHERE repository_id = $1`, repositoryID)
+		if err != nil {
+			return nil, err
+		}
+		_, err = tx.Exec(`DELETE FROM graveler_repository_aliases WHERE repository_id = $1`, repositoryID)
+		if err != nil {
+			return nil, err
+		}
+		_, err = tx.Exec(`DELETE FROM graveler_staging_written WHERE repository_id = $1`, repositoryID)
+		if err != nil {
+			return nil, err
+		}
+		_, err = tx.Exec(`DELETE FROM graveler_imports WHERE repository_id = $1`, repositoryID)
+		if err != nil {
+			return nil, err
+		}
 		r, err := tx.Exec(`DELETE FROM graveler_repositories WHERE id = $1`, repositoryID)
 		if err != nil {
 			return nil, err
@@ -151,6 +190,107 @@ func (m *Manager) DeleteRepository(ctx context.Context, repositoryID graveler.Re
 	return err
 }
 
+func (m *Manager) SoftDeleteRepository(ctx context.Context, repositoryID graveler.RepositoryID) error {
+	r, err := m.db.Exec(ctx, `UPDATE graveler_repositories SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`, repositoryID)
+	if err != nil {
+		return err
+	}
+	if r.RowsAffected() == 0 {
+		return graveler.ErrRepositoryNotFound
+	}
+	return nil
+}
+
+func (m *Manager) RestoreRepository(ctx context.Context, repositoryID graveler.RepositoryID) error {
+	r, err := m.db.Exec(ctx, `UPDATE graveler_repositories SET deleted_at = NULL WHERE id = $1 AND deleted_at IS NOT NULL`, repositoryID)
+	if err != nil {
+		return err
+	}
+	if r.RowsAffected() == 0 {
+		return graveler.ErrRepositoryNotFound
+	}
+	return nil
+}
+
+func (m *Manager) ListDeletedRepositories(ctx context.Context) ([]*graveler.DeletedRepositoryRecord, error) {
+	var repositories []*graveler.DeletedRepositoryRecord
+	err := m.db.Select(ctx, &repositories, `
+			SELECT id, storage_namespace, creation_date, default_branch, deleted_at
+			FROM graveler_repositories
+			WHERE deleted_at IS NOT NULL
+			ORDER BY id ASC`)
+	if err != nil {
+		return nil, err
+	}
+	return repositories, nil
+}
+
+func (m *Manager) RenameRepository(ctx context.Context, repositoryID graveler.RepositoryID, newRepositoryID graveler.RepositoryID, aliasExpiry time.Time) error {
+	_, err := m.db.Transact(ctx, func(tx db.Tx) (interface{}, error) {
+		r, err := tx.Exec(`
+				INSERT INTO graveler_repositories (id, storage_namespace, creation_date, default_branch)
+				SELECT $2, storage_namespace, creation_date, default_branch
+				FROM graveler_repositories
+				WHERE id = $1 AND deleted_at IS NULL`,
+			repositoryID, newRepositoryID)
+		if errors.Is(err, db.ErrAlreadyExists) {
+			return nil, graveler.ErrNotUnique
+		}
+		if err != nil {
+			return nil, err
+		}
+		if r.RowsAffected() == 0 {
+			return nil, graveler.ErrRepositoryNotFound
+		}
+		for _, table := range []string{"graveler_branches", "graveler_branch_reflog", "graveler_branch_stashes", "graveler_legal_holds", "graveler_legal_hold_log", "graveler_tags", "graveler_commits", "graveler_commit_metadata", "graveler_repository_aliases", "graveler_staging_written", "graveler_imports"} {
+			_, err = tx.Exec(`UPDATE `+table+` SET repository_id = $2 WHERE repository_id = $1`, repositoryID, newRepositoryID)
+			if err != nil {
+				return nil, err
+			}
+		}
+		_, err = tx.Exec(`DELETE FROM graveler_repositories WHERE id = $1`, repositoryID)
+		if err != nil {
+			return nil, err
+		}
+		// the new name no longer refers to a previous repository
+		_, err = tx.Exec(`DELETE FROM graveler_repository_aliases WHERE alias = $1`, newRepositoryID)
+		if err != nil {
+			return nil, err
+		}
+		if aliasExpiry.IsZero() {
+			return nil, nil
+		}
+		_, err = tx.Exec(`
+				INSERT INTO graveler_repository_aliases (alias, repository_id, expires_at)
+				VALUES ($1, $2, $3)
+				ON CONFLICT (alias) DO UPDATE SET repository_id = EXCLUDED.repository_id, expires_at = EXCLUDED.expires_at`,
+			repositoryID, newRepositoryID, aliasExpiry)
+		return nil, err
+	})
+	return err
+}
+
+func (m *Manager) ResolveRepositoryAlias(ctx context.Context, alias graveler.RepositoryID) (graveler.RepositoryID, error) {
+	var repositoryID graveler.RepositoryID
+	err := m.db.GetPrimitive(ctx, &repositoryID, `
+			SELECT a.repository_id
+			FROM graveler_repository_aliases a JOIN graveler_repositories r ON a.repository_id = r.id
+			WHERE a.alias = $1 AND a.expires_at > NOW() AND r.deleted_at IS NULL`,
+		alias)
+	if errors.Is(err, db.ErrNotFound) {
+		return "", graveler.ErrRepositoryNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+	return repositoryID, nil
+}
+
+func (m *Manager) DeleteExpiredRepositoryAliases(ctx context.Context, before time.Time) error {
+	_, err := m.db.Exec(ctx, `DELETE FROM graveler_repository_aliases WHERE expires_at < $1`, before)
+	return err
+}
+
 func (m *Manager) ParseRef(ref graveler.Ref) (graveler.RawRef, error) {
 	return ParseRef(ref)
 }
@@ -164,15 +304,12 @@ func (m *Manager) GetBranch(ctx context.Context, repositoryID graveler.Repositor
 	branch, err := m.batchExecutor.BatchFor(key, MaxBatchDelay, batch.BatchFn(func() (interface{}, error) {
 		return m.db.Transact(ctx, func(tx db.Tx) (interface{}, error) {
 			var rec branchRecord
-			err := tx.Get(&rec, `SELECT commit_id, staging_token FROM graveler_branches WHERE repository_id = $1 AND id = $2`,
+			err := tx.Get(&rec, `SELECT commit_id, staging_token, expires_at, idle_ttl FROM graveler_branches WHERE repository_id = $1 AND id = $2`,
 				repositoryID, branchID)
 			if err != nil {
 				return nil, err
 			}
-			return &graveler.Branch{
-				CommitID:     rec.CommitID,
-				StagingToken: rec.StagingToken,
-			}, nil
+			return rec.toBranch(), nil
 		}, db.ReadOnly())
 	}))
 	if errors.Is(err, db.ErrNotFound) {
@@ -185,12 +322,17 @@ func (m *Manager) GetBranch(ctx context.Context, repositoryID graveler.Repositor
 }
 
 func (m *Manager) CreateBranch(ctx context.Context, repositoryID graveler.RepositoryID, branchID graveler.BranchID, branch graveler.Branch) error {
+	expiresAt, idleTTL := branchExpiryValues(branch.Expiry)
 	_, err := m.db.Transact(ctx, func(tx db.Tx) (interface{}, error) {
 		_, err := tx.Exec(`
-			INSERT INTO graveler_branches (repository_id, id, staging_token, commit_id)
-			VALUES ($1, $2, $3, $4)`,
-			repositoryID, branchID, branch.StagingToken, branch.CommitID)
-		return nil, err
+			INSERT INTO graveler_branches (repository_id, id, staging_token, commit_id, expires_at, idle_ttl)
+			VALUES ($1, $2, $3, $4, $5, $6)`,
+			repositoryID, branchID, branch.StagingToken, branch.CommitID, expiresAt, idleTTL)
+		if err != nil {
+			return nil, err
+		}
+		return nil, addBranchReflogEntry(ctx, tx, repositoryID, branchID, "", branch.CommitID,
+			graveler.BranchReflogOperationFromContext(ctx, graveler.BranchReflogOperationCreate))
 	})
 	if errors.Is(err, db.ErrAlreadyExists) {
 		return graveler.ErrBranchExists
@@ -199,30 +341,45 @@ func (m *Manager) CreateBranch(ctx context.Context, repositoryID graveler.Reposi
 }
 
 func (m *Manager) SetBranch(ctx context.Context, repositoryID graveler.RepositoryID, branchID graveler.BranchID, branch graveler.Branch) error {
+	expiresAt, idleTTL := branchExpiryValues(branch.Expiry)
 	_, err := m.db.Transact(ctx, func(tx db.Tx) (interface{}, error) {
-		_, err := tx.Exec(`
-			INSERT INTO graveler_branches (repository_id, id, staging_token, commit_id)
-			VALUES ($1, $2, $3, $4)
+		var oldCommitID graveler.CommitID
+		err := tx.Get(&oldCommitID, `SELECT commit_id FROM graveler_branches WHERE repository_id = $1 AND id = $2 FOR UPDATE`,
+			repositoryID, branchID)
+		if err != nil && !errors.Is(err, db.ErrNotFound) {
+			return nil, err
+		}
+		// the expiry of an existing branch is kept
+		_, err = tx.Exec(`
+			INSERT INTO graveler_branches (repository_id, id, staging_token, commit_id, expires_at, idle_ttl)
+			VALUES ($1, $2, $3, $4, $5, $6)
 				ON CONFLICT (repository_id, id)
 				DO UPDATE SET staging_token = $3, commit_id = $4`,
-			repositoryID, branchID, branch.StagingToken, branch.CommitID)
-		return nil, err
+			repositoryID, branchID, branch.StagingToken, branch.CommitID, expiresAt, idleTTL)
+		if err != nil {
+			return nil, err
+		}
+		// a new staging token alone does not move the branch head
+		if oldCommitID == branch.CommitID {
+			return nil, nil
+		}
+		return nil, addBranchReflogEntry(ctx, tx, repositoryID, branchID, oldCommitID, branch.CommitID,
+			graveler.BranchReflogOperationFromContext(ctx, graveler.BranchReflogOperationUpdate))
 	})
 	return err
 }
 
 func (m *Manager) DeleteBranch(ctx context.Context, repositoryID graveler.RepositoryID, branchID graveler.BranchID) error {
 	_, err := m.db.Transact(ctx, func(tx db.Tx) (interface{}, error) {
-		r, err := tx.Exec(
-			`DELETE FROM graveler_branches WHERE repository_id = $1 AND id = $2`,
+		var oldCommitID graveler.CommitID
+		err := tx.Get(&oldCommitID,
+			`DELETE FROM graveler_branches WHERE repository_id = $1 AND id = $2 RETURNING commit_id`,
 			repositoryID, branchID)
 		if err != nil {
 			return nil, err
 		}
-		if r.RowsAffected() == 0 {
-			return nil, graveler.ErrNotFound
-		}
-		return nil, nil
+		return nil, addBranchReflogEntry(ctx, tx, repositoryID, branchID, oldCommitID, "",
+			graveler.BranchReflogOperationFromContext(ctx, graveler.BranchReflogOperationDelete))
 	})
 	if errors.Is(err, db.ErrNotFound) {
 		return graveler.ErrBranchNotFound
@@ -230,6 +387,49 @@ func (m *Manager) DeleteBranch(ctx context.Context, repositoryID graveler.Reposi
 	return err
 }
 
+// addBranchReflogEntry records a change of the branch head from oldCommitID to newCommitID, made by the user found
+// on ctx
+func addBranchReflogEntry(ctx context.Context, tx db.Tx, repositoryID graveler.RepositoryID, branchID graveler.BranchID, oldCommitID, newCommitID graveler.CommitID, operation graveler.BranchReflogOperation) error {
+	_, err := tx.Exec(`
+			INSERT INTO graveler_branch_reflog (repository_id, branch_id, old_commit_id, new_commit_id, operation, user_id, creation_date)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		repositoryID, branchID, oldCommitID, newCommitID, operation, graveler.BranchReflogUserFromContext(ctx), time.Now())
+	return err
+}
+
+func (m *Manager) ListExpiredBranches(ctx context.Context, repositoryID graveler.RepositoryID, now time.Time) ([]*graveler.BranchRecord, error) {
+	var recs []*branchRecord
+	err := m.db.Select(ctx, &recs, `
+			SELECT b.id, b.staging_token, b.commit_id, b.expires_at, b.idle_ttl
+			FROM graveler_branches b
+			WHERE b.repository_id = $1
+			AND (b.expires_at <= $2
+				OR b.idle_ttl IS NOT NULL AND (
+					SELECT MAX(r.creation_date) FROM graveler_branch_reflog r
+					WHERE r.repository_id = b.repository_id AND r.branch_id = b.id
+				) + b.idle_ttl * INTERVAL '1 second' <= $2)
+			ORDER BY b.id`, repositoryID, now)
+	if err != nil {
+		return nil, err
+	}
+	branches := make([]*graveler.BranchRecord, 0, len(recs))
+	for _, rec := range recs {
+		branches = append(branches, &graveler.BranchRecord{
+			BranchID: rec.BranchID,
+			Branch:   rec.toBranch(),
+		})
+	}
+	return branches, nil
+}
+
+func (m *Manager) ListBranchReflog(ctx context.Context, repositoryID graveler.RepositoryID, branchID graveler.BranchID) (graveler.BranchReflogIterator, error) {
+	_, err := m.GetRepository(ctx, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	return NewBranchReflogIterator(ctx, m.db, repositoryID, branchID, IteratorPrefetchSize), nil
+}
+
 func (m *Manager) ListBranches(ctx context.Context, repositoryID graveler.RepositoryID) (graveler.BranchIterator, error) {
 	_, err := m.GetRepository(ctx, repositoryID)
 	if err != nil {
@@ -238,6 +438,316 @@ func (m *Manager) ListBranches(ctx context.Context, repositoryID graveler.Reposi
 	return NewBranchIterator(ctx, m.db, repositoryID, IteratorPrefetchSize), nil
 }
 
+func (m *Manager) CreateStash(ctx context.Context, repositoryID graveler.RepositoryID, branchID graveler.BranchID, stashID graveler.StashID, stagingToken graveler.StagingToken) (*graveler.Stash, error) {
+	stash, err := m.db.Transact(ctx, func(tx db.Tx) (interface{}, error) {
+		var rec branchRecord
+		err := tx.Get(&rec, `SELECT commit_id, staging_token FROM graveler_branches WHERE repository_id = $1 AND id = $2 FOR UPDATE`,
+			repositoryID, branchID)
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, graveler.ErrBranchNotFound
+		}
+		if err != nil {
+			return nil, err
+		}
+		stash := &graveler.Stash{
+			StagingToken: rec.StagingToken,
+			CommitID:     rec.CommitID,
+			CreationDate: time.Now(),
+		}
+		res, err := tx.Exec(`
+			INSERT INTO graveler_branch_stashes (repository_id, branch_id, id, staging_token, commit_id, creation_date)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			ON CONFLICT DO NOTHING`,
+			repositoryID, branchID, stashID, stash.StagingToken, stash.CommitID, stash.CreationDate)
+		if err != nil {
+			return nil, err
+		}
+		if res.RowsAffected() == 0 {
+			return nil, graveler.ErrStashExists
+		}
+		_, err = tx.Exec(`UPDATE graveler_branches SET staging_token = $3 WHERE repository_id = $1 AND id = $2`,
+			repositoryID, branchID, stagingToken)
+		if err != nil {
+			return nil, err
+		}
+		return stash, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return stash.(*graveler.Stash), nil
+}
+
+func (m *Manager) GetStash(ctx context.Context, repositoryID graveler.RepositoryID, branchID graveler.BranchID, stashID graveler.StashID) (*graveler.Stash, error) {
+	stash, err := m.db.Transact(ctx, func(tx db.Tx) (interface{}, error) {
+		var rec stashRecord
+		err := tx.Get(&rec, `
+			SELECT id, staging_token, commit_id, creation_date
+			FROM graveler_branch_stashes
+			WHERE repository_id = $1 AND branch_id = $2 AND id = $3`,
+			repositoryID, branchID, stashID)
+		if err != nil {
+			return nil, err
+		}
+		return rec.toGravelerStash(), nil
+	}, db.ReadOnly())
+	if errors.Is(err, db.ErrNotFound) {
+		return nil, graveler.ErrStashNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return stash.(*graveler.Stash), nil
+}
+
+func (m *Manager) DeleteStash(ctx context.Context, repositoryID graveler.RepositoryID, branchID graveler.BranchID, stashID graveler.StashID) error {
+	_, err := m.db.Transact(ctx, func(tx db.Tx) (interface{}, error) {
+		r, err := tx.Exec(
+			`DELETE FROM graveler_branch_stashes WHERE repository_id = $1 AND branch_id = $2 AND id = $3`,
+			repositoryID, branchID, stashID)
+		if err != nil {
+			return nil, err
+		}
+		if r.RowsAffected() == 0 {
+			return nil, db.ErrNotFound
+		}
+		return nil, nil
+	})
+	if errors.Is(err, db.ErrNotFound) {
+		return graveler.ErrStashNotFound
+	}
+	return err
+}
+
+func (m *Manager) ListStashes(ctx context.Context, repositoryID graveler.RepositoryID, branchID graveler.BranchID) (graveler.StashIterator, error) {
+	_, err := m.GetRepository(ctx, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	return NewStashIterator(ctx, m.db, repositoryID, branchID, IteratorPrefetchSize), nil
+}
+
+func (m *Manager) CreateLegalHold(ctx context.Context, repositoryID graveler.RepositoryID, holdID graveler.LegalHoldID, hold graveler.LegalHold) error {
+	_, err := m.db.Transact(ctx, func(tx db.Tx) (interface{}, error) {
+		res, err := tx.Exec(`
+			INSERT INTO graveler_legal_holds (repository_id, id, commit_id, path, reason, created_by, creation_date)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+			ON CONFLICT DO NOTHING`,
+			repositoryID, holdID, hold.CommitID, hold.Path, hold.Reason, hold.CreatedBy, hold.CreationDate)
+		if err != nil {
+			return nil, err
+		}
+		if res.RowsAffected() == 0 {
+			return nil, graveler.ErrLegalHoldExists
+		}
+		return nil, addLegalHoldLogEntry(tx, repositoryID, &graveler.LegalHoldLogEntry{
+			LegalHoldID:  holdID,
+			Operation:    graveler.LegalHoldOperationHold,
+			CommitID:     hold.CommitID,
+			Path:         hold.Path,
+			Reason:       hold.Reason,
+			User:         hold.CreatedBy,
+			CreationDate: hold.CreationDate,
+		})
+	})
+	return err
+}
+
+func (m *Manager) DeleteLegalHold(ctx context.Context, repositoryID graveler.RepositoryID, holdID graveler.LegalHoldID, user string) error {
+	_, err := m.db.Transact(ctx, func(tx db.Tx) (interface{}, error) {
+		var rec legalHoldRecord
+		err := tx.Get(&rec, `
+			DELETE FROM graveler_legal_holds WHERE repository_id = $1 AND id = $2
+			RETURNING id, commit_id, path, reason, created_by, creation_date`,
+			repositoryID, holdID)
+		if err != nil {
+			return nil, err
+		}
+		return nil, addLegalHoldLogEntry(tx, repositoryID, &graveler.LegalHoldLogEntry{
+			LegalHoldID:  holdID,
+			Operation:    graveler.LegalHoldOperationRelease,
+			CommitID:     rec.CommitID,
+			Path:         rec.Path,
+			Reason:       rec.Reason,
+			User:         user,
+			CreationDate: time.Now(),
+		})
+	})
+	if errors.Is(err, db.ErrNotFound) {
+		return graveler.ErrLegalHoldNotFound
+	}
+	return err
+}
+
+// addLegalHoldLogEntry records a hold placed or released in the legal hold log of the repository
+func addLegalHoldLogEntry(tx db.Tx, repositoryID graveler.RepositoryID, entry *graveler.LegalHoldLogEntry) error {
+	_, err := tx.Exec(`
+			INSERT INTO graveler_legal_hold_log (repository_id, hold_id, operation, commit_id, path, reason, user_id, creation_date)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		repositoryID, entry.LegalHoldID, entry.Operation, entry.CommitID, entry.Path, entry.Reason, entry.User, entry.CreationDate)
+	return err
+}
+
+func (m *Manager) GetLegalHold(ctx context.Context, repositoryID graveler.RepositoryID, holdID graveler.LegalHoldID) (*graveler.LegalHold, error) {
+	var rec legalHoldRecord
+	err := m.db.Get(ctx, &rec, `
+			SELECT id, commit_id, path, reason, created_by, creation_date
+			FROM graveler_legal_holds
+			WHERE repository_id = $1 AND id = $2`,
+		repositoryID, holdID)
+	if errors.Is(err, db.ErrNotFound) {
+		return nil, graveler.ErrLegalHoldNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return rec.toGravelerLegalHold(), nil
+}
+
+func (m *Manager) ListLegalHolds(ctx context.Context, repositoryID graveler.RepositoryID) ([]*graveler.LegalHoldRecord, error) {
+	_, err := m.GetRepository(ctx, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	var recs []*legalHoldRecord
+	err = m.db.Select(ctx, &recs, `
+			SELECT id, commit_id, path, reason, created_by, creation_date
+			FROM graveler_legal_holds
+			WHERE repository_id = $1
+			ORDER BY id`, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	holds := make([]*graveler.LegalHoldRecord, 0, len(recs))
+	for _, rec := range recs {
+		holds = append(holds, &graveler.LegalHoldRecord{
+			LegalHoldID: rec.LegalHoldID,
+			LegalHold:   rec.toGravelerLegalHold(),
+		})
+	}
+	return holds, nil
+}
+
+func (m *Manager) ListLegalHoldLog(ctx context.Context, repositoryID graveler.RepositoryID) ([]*graveler.LegalHoldLogEntry, error) {
+	_, err := m.GetRepository(ctx, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	var recs []*legalHoldLogRecord
+	err = m.db.Select(ctx, &recs, `
+			SELECT hold_id, operation, commit_id, path, reason, user_id, creation_date
+			FROM graveler_legal_hold_log
+			WHERE repository_id = $1
+			ORDER BY id DESC`, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]*graveler.LegalHoldLogEntry, 0, len(recs))
+	for _, rec := range recs {
+		entries = append(entries, &graveler.LegalHoldLogEntry{
+			LegalHoldID:  rec.LegalHoldID,
+			Operation:    rec.Operation,
+			CommitID:     rec.CommitID,
+			Path:         rec.Path,
+			Reason:       rec.Reason,
+			User:         rec.User,
+			CreationDate: rec.CreationDate,
+		})
+	}
+	return entries, nil
+}
+
+func (m *Manager) CreateImport(ctx context.Context, repositoryID graveler.RepositoryID, importID graveler.ImportID, imp graveler.Import) error {
+	res, err := m.db.Exec(ctx, `
+			INSERT INTO graveler_imports (repository_id, id, branch_id, imported_objects, completed, meta_range_id, commit_id, error, canceled, creation_date, update_time)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+			ON CONFLICT DO NOTHING`,
+		repositoryID, importID, imp.BranchID, imp.ImportedObjects, imp.Completed, imp.MetaRangeID, imp.CommitID, imp.Error, imp.Canceled, imp.CreationDate, imp.UpdateTime)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return graveler.ErrImportExists
+	}
+	return nil
+}
+
+func (m *Manager) GetImport(ctx context.Context, repositoryID graveler.RepositoryID, importID graveler.ImportID) (*graveler.Import, error) {
+	var rec importRecord
+	err := m.db.Get(ctx, &rec, `
+			SELECT branch_id, imported_objects, completed, meta_range_id, commit_id, error, canceled, creation_date, update_time
+			FROM graveler_imports
+			WHERE repository_id = $1 AND id = $2`,
+		repositoryID, importID)
+	if errors.Is(err, db.ErrNotFound) {
+		return nil, graveler.ErrImportNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return rec.toGravelerImport(), nil
+}
+
+func (m *Manager) UpdateImport(ctx context.Context, repositoryID graveler.RepositoryID, importID graveler.ImportID, imp graveler.Import) (*graveler.Import, error) {
+	res, err := m.db.Transact(ctx, func(tx db.Tx) (interface{}, error) {
+		if err := lockIncompleteImport(tx, repositoryID, importID); err != nil {
+			return nil, err
+		}
+		var rec importRecord
+		err := tx.Get(&rec, `
+			UPDATE graveler_imports
+			SET imported_objects = $3, completed = $4, meta_range_id = $5, commit_id = $6, error = $7, update_time = $8
+			WHERE repository_id = $1 AND id = $2
+			RETURNING branch_id, imported_objects, completed, meta_range_id, commit_id, error, canceled, creation_date, update_time`,
+			repositoryID, importID, imp.ImportedObjects, imp.Completed, imp.MetaRangeID, imp.CommitID, imp.Error, imp.UpdateTime)
+		if err != nil {
+			return nil, err
+		}
+		return rec.toGravelerImport(), nil
+	})
+	if errors.Is(err, db.ErrNotFound) {
+		return nil, graveler.ErrImportNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return res.(*graveler.Import), nil
+}
+
+func (m *Manager) CancelImport(ctx context.Context, repositoryID graveler.RepositoryID, importID graveler.ImportID) error {
+	_, err := m.db.Transact(ctx, func(tx db.Tx) (interface{}, error) {
+		if err := lockIncompleteImport(tx, repositoryID, importID); err != nil {
+			return nil, err
+		}
+		_, err := tx.Exec(`UPDATE graveler_imports SET canceled = TRUE WHERE repository_id = $1 AND id = $2`,
+			repositoryID, importID)
+		return nil, err
+	})
+	if errors.Is(err, db.ErrNotFound) {
+		return graveler.ErrImportNotFound
+	}
+	return err
+}
+
+// lockIncompleteImport locks the import for update, or returns graveler.ErrImportCompleted if it completed
+func lockIncompleteImport(tx db.Tx, repositoryID graveler.RepositoryID, importID graveler.ImportID) error {
+	var completed bool
+	err := tx.GetPrimitive(&completed, `SELECT completed FROM graveler_imports WHERE repository_id = $1 AND id = $2 FOR UPDATE`,
+		repositoryID, importID)
+	if err != nil {
+		return err
+	}
+	if completed {
+		return graveler.ErrImportCompleted
+	}
+	return nil
+}
+
+func (m *Manager) DeleteExpiredImports(ctx context.Context, repositoryID graveler.RepositoryID, before time.Time) error {
+	_, err := m.db.Exec(ctx, `DELETE FROM graveler_imports WHERE repository_id = $1 AND update_time < $2`, repositoryID, before)
+	return err
+}
+
 func (m *Manager) GetTag(ctx context.Context, repositoryID graveler.RepositoryID, tagID graveler.TagID) (*graveler.CommitID, error) {
 	key := fmt.Sprintf("GetTag:%s:%s", repositoryID, tagID)
 	commitID, err := m.batchExecutor.BatchFor(key, MaxBatchDelay, batch.BatchFn(func() (interface{}, error) {
@@ -260,11 +770,52 @@ func (m *Manager) GetTag(ctx context.Context, repositoryID graveler.RepositoryID
 	return commitID.(*graveler.CommitID), nil
 }
 
+func (m *Manager) GetTagRecord(ctx context.Context, repositoryID graveler.RepositoryID, tagID graveler.TagID) (*graveler.TagRecord, error) {
+	record, err := m.db.Transact(ctx, func(tx db.Tx) (interface{}, error) {
+		var rec tagRecord
+		err := tx.Get(&rec, `SELECT id, commit_id, message, tagger, creation_date, metadata
+			FROM graveler_tags WHERE repository_id = $1 AND id = $2`,
+			repositoryID, tagID)
+		if err != nil {
+			return nil, err
+		}
+		return rec.toGravelerTagRecord(), nil
+	}, db.ReadOnly())
+	if errors.Is(err, db.ErrNotFound) {
+		return nil, graveler.ErrTagNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return record.(*graveler.TagRecord), nil
+}
+
 func (m *Manager) CreateTag(ctx context.Context, repositoryID graveler.RepositoryID, tagID graveler.TagID, commitID graveler.CommitID) error {
+	return m.createTag(ctx, repositoryID, tagID, commitID, nil)
+}
+
+func (m *Manager) CreateAnnotatedTag(ctx context.Context, repositoryID graveler.RepositoryID, tagID graveler.TagID, commitID graveler.CommitID, annotation graveler.TagAnnotation) error {
+	return m.createTag(ctx, repositoryID, tagID, commitID, &annotation)
+}
+
+func (m *Manager) createTag(ctx context.Context, repositoryID graveler.RepositoryID, tagID graveler.TagID, commitID graveler.CommitID, annotation *graveler.TagAnnotation) error {
+	var (
+		message, tagger string
+		creationDate    *time.Time
+		metadata        graveler.Metadata
+	)
+	if annotation != nil {
+		message = annotation.Message
+		tagger = annotation.Tagger
+		ts := annotation.CreationDate.UTC()
+		creationDate = &ts
+		metadata = annotation.Metadata
+	}
 	_, err := m.db.Transact(ctx, func(tx db.Tx) (interface{}, error) {
-		res, err := tx.Exec(`INSERT INTO graveler_tags (repository_id, id, commit_id) VALUES ($1, $2, $3)
+		res, err := tx.Exec(`INSERT INTO graveler_tags (repository_id, id, commit_id, message, tagger, creation_date, metadata)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
 			ON CONFLICT DO NOTHING`,
-			repositoryID, tagID, commitID)
+			repositoryID, tagID, commitID, message, tagger, creationDate, metadata)
 		if err != nil {
 			return nil, err
 		}
@@ -312,7 +863,7 @@ func (m *Manager) GetCommitByPrefix(ctx context.Context, repositoryID graveler.R
 			// LIMIT 2 is used to test if a truncated commit ID resolves to *one* commit.
 			// if we get 2 results that start with the truncated ID, that's enough to determine this prefix is not unique
 			err := tx.Select(&records, `
-					SELECT id, committer, message, creation_date, parents, meta_range_id, metadata, version, generation
+					SELECT id, committer, message, creation_date, parents, meta_range_id, metadata, version, generation, signature_key_id, signature
 					FROM graveler_commits
 					WHERE repository_id = $1 AND id LIKE $2 || '%'
 					LIMIT 2`,
@@ -347,7 +898,7 @@ func (m *Manager) GetCommit(ctx context.Context, repositoryID graveler.Repositor
 		return m.db.Transact(ctx, func(tx db.Tx) (interface{}, error) {
 			var rec commitRecord
 			err := tx.Get(&rec, `
-					SELECT committer, message, creation_date, parents, meta_range_id, metadata, version, generation
+					SELECT committer, message, creation_date, parents, meta_range_id, metadata, version, generation, signature_key_id, signature
 					FROM graveler_commits WHERE repository_id = $1 AND id = $2`,
 				repositoryID, commitID)
 			if err != nil {
@@ -387,12 +938,109 @@ func (m *Manager) addCommit(tx db.Tx, repositoryID graveler.RepositoryID, commit
 	// it will necessarily have the same attributes as the existing one, so no need to overwrite it
 	_, err := tx.Exec(`
 				INSERT INTO graveler_commits 
-				(repository_id, id, committer, message, creation_date, parents, meta_range_id, metadata, version, generation)
-				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+				(repository_id, id, committer, message, creation_date, parents, meta_range_id, metadata, version, generation, signature_key_id, signature)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 				ON CONFLICT DO NOTHING`,
 		repositoryID, commitID, commit.Committer, commit.Message,
-		commit.CreationDate.UTC(), parents, commit.MetaRangeID, commit.Metadata, commit.Version, commit.Generation)
+		commit.CreationDate.UTC(), parents, commit.MetaRangeID, commit.Metadata, commit.Version, commit.Generation,
+		commit.SignatureKeyID, commit.Signature)
+	if err != nil {
+		return err
+	}
+
+	// index the commit metadata for SearchCommits
+	for key, value := range commit.Metadata {
+		_, err = tx.Exec(`
+				INSERT INTO graveler_commit_metadata (repository_id, commit_id, key, value)
+				VALUES ($1, $2, $3, $4)
+				ON CONFLICT DO NOTHING`,
+			repositoryID, commitID, key, value)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
+func (m *Manager) SearchCommits(ctx context.Context, repositoryID graveler.RepositoryID, params graveler.CommitSearchParams) ([]*graveler.CommitRecord, error) {
+	_, err := m.GetRepository(ctx, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	q := psql.Select("id", "committer", "message", "creation_date", "meta_range_id", "parents", "metadata", "version", "generation", "signature_key_id", "signature").
+		From("graveler_commits").
+		Where(sq.Eq{"repository_id": repositoryID})
+	keys := make([]string, 0, len(params.Metadata))
+	for key := range params.Metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		q = q.Where(psql.Select("commit_id").
+			Prefix("id IN (").Suffix(")").
+			From("graveler_commit_metadata").
+			Where(sq.Eq{"repository_id": repositoryID, "key": key, "value": params.Metadata[key]}).
+			// match the hashes too, to use the index on them
+			Where("md5(key) = md5(?) AND md5(value) = md5(?)", key, params.Metadata[key]))
+	}
+	if params.Committer != "" {
+		q = q.Where(sq.Eq{"committer": params.Committer})
+	}
+	if params.Message != "" {
+		q = q.Where(sq.ILike{"message": "%" + escapeLikePattern(params.Message) + "%"})
+	}
+	if params.Since != nil {
+		q = q.Where(sq.GtOrEq{"creation_date": params.Since.UTC()})
+	}
+	if params.Until != nil {
+		q = q.Where(sq.Lt{"creation_date": params.Until.UTC()})
+	}
+	if params.After != "" {
+		q = q.Where(psql.Select("creation_date", "id").
+			Prefix("(creation_date, id) < (").Suffix(")").
+			From("graveler_commits").
+			Where(sq.Eq{"repository_id": repositoryID, "id": params.After}))
+	}
+	q = q.OrderBy("creation_date DESC", "id DESC")
+	if params.Limit > 0 {
+		q = q.Limit(uint64(params.Limit))
+	}
+	query, args, err := q.ToSql()
+	if err != nil {
+		return nil, err
+	}
+	var recs []*commitRecord
+	err = m.db.Select(ctx, &recs, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	commits := make([]*graveler.CommitRecord, 0, len(recs))
+	for _, rec := range recs {
+		commits = append(commits, rec.toGravelerCommitRecord())
+	}
+	return commits, nil
+}
+
+// escapeLikePattern escapes the wildcard characters of a LIKE pattern
+func escapeLikePattern(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+func (m *Manager) SetCommitSignature(ctx context.Context, repositoryID graveler.RepositoryID, commitID graveler.CommitID, keyID string, signature []byte) error {
+	_, err := m.db.Transact(ctx, func(tx db.Tx) (interface{}, error) {
+		res, err := tx.Exec(`
+			UPDATE graveler_commits SET signature_key_id = $3, signature = $4
+			WHERE repository_id = $1 AND id = $2`,
+			repositoryID, commitID, keyID, signature)
+		if err != nil {
+			return nil, err
+		}
+		if res.RowsAffected() == 0 {
+			return nil, graveler.ErrCommitNotFound
+		}
+		return nil, nil
+	})
 	return err
 }
 
@@ -429,6 +1077,10 @@ func (m *Manager) FindMergeBase(ctx context.Context, repositoryID graveler.Repos
 	return FindMergeBase(ctx, m, repositoryID, commitIDs[0], commitIDs[1])
 }
 
+func (m *Manager) CountAheadBehind(ctx context.Context, repositoryID graveler.RepositoryID, left, right graveler.CommitID) (int, int, error) {
+	return CountAheadBehind(ctx, m, repositoryID, left, right)
+}
+
 func (m *Manager) Log(ctx context.Context, repositoryID graveler.RepositoryID, from graveler.CommitID) (graveler.CommitIterator, error) {
 	_, err := m.GetRepository(ctx, repositoryID)
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	"reflect"
 	"sort"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -153,6 +154,86 @@ func TestManager_DeleteRepository(t *testing.T) {
 	})
 }
 
+func TestManager_SoftDeleteRepository(t *testing.T) {
+	r := testRefManager(t)
+	ctx := context.Background()
+	testutil.Must(t, r.CreateRepository(ctx, "example-repo", graveler.Repository{
+		StorageNamespace: "s3://foo",
+		CreationDate:     time.Now(),
+		DefaultBranchID:  "main",
+	}, ""))
+
+	testutil.Must(t, r.SoftDeleteRepository(ctx, "example-repo"))
+	if _, err := r.GetRepository(ctx, "example-repo"); !errors.Is(err, graveler.ErrRepositoryNotFound) {
+		t.Fatalf("GetRepository of deleted repository err=%v, expected=%v", err, graveler.ErrRepositoryNotFound)
+	}
+	iter, err := r.ListRepositories(ctx)
+	testutil.Must(t, err)
+	if iter.Next() {
+		t.Fatalf("ListRepositories returned deleted repository %s", iter.Value().RepositoryID)
+	}
+	iter.Close()
+	if err := r.SoftDeleteRepository(ctx, "example-repo"); !errors.Is(err, graveler.ErrRepositoryNotFound) {
+		t.Fatalf("SoftDeleteRepository of deleted repository err=%v, expected=%v", err, graveler.ErrRepositoryNotFound)
+	}
+	deleted, err := r.ListDeletedRepositories(ctx)
+	testutil.Must(t, err)
+	if len(deleted) != 1 || deleted[0].RepositoryID != "example-repo" || deleted[0].DeletionDate.IsZero() {
+		t.Fatalf("ListDeletedRepositories %+v, expected example-repo with deletion date", deleted)
+	}
+
+	testutil.Must(t, r.RestoreRepository(ctx, "example-repo"))
+	if _, err := r.GetBranch(ctx, "example-repo", "main"); err != nil {
+		t.Fatalf("GetBranch of restored repository err=%v, expected none", err)
+	}
+	if err := r.RestoreRepository(ctx, "example-repo"); !errors.Is(err, graveler.ErrRepositoryNotFound) {
+		t.Fatalf("RestoreRepository of existing repository err=%v, expected=%v", err, graveler.ErrRepositoryNotFound)
+	}
+}
+
+func TestManager_RenameRepository(t *testing.T) {
+	r := testRefManager(t)
+	ctx := context.Background()
+	for _, repositoryID := range []graveler.RepositoryID{"old-repo", "other-repo"} {
+		testutil.Must(t, r.CreateRepository(ctx, repositoryID, graveler.Repository{
+			StorageNamespace: "s3://foo",
+			CreationDate:     time.Now(),
+			DefaultBranchID:  "main",
+		}, ""))
+	}
+
+	if err := r.RenameRepository(ctx, "old-repo", "other-repo", time.Time{}); !errors.Is(err, graveler.ErrNotUnique) {
+		t.Fatalf("RenameRepository to existing repository err=%v, expected=%v", err, graveler.ErrNotUnique)
+	}
+	testutil.Must(t, r.RenameRepository(ctx, "old-repo", "new-repo", time.Now().Add(time.Hour)))
+	if _, err := r.GetRepository(ctx, "old-repo"); !errors.Is(err, graveler.ErrRepositoryNotFound) {
+		t.Fatalf("GetRepository of previous name err=%v, expected=%v", err, graveler.ErrRepositoryNotFound)
+	}
+	if _, err := r.GetBranch(ctx, "new-repo", "main"); err != nil {
+		t.Fatalf("GetBranch of renamed repository err=%v, expected none", err)
+	}
+	resolved, err := r.ResolveRepositoryAlias(ctx, "old-repo")
+	testutil.Must(t, err)
+	if resolved != "new-repo" {
+		t.Fatalf("ResolveRepositoryAlias=%s, expected new-repo", resolved)
+	}
+
+	// aliases follow further renames, and stop resolving once expired
+	testutil.Must(t, r.RenameRepository(ctx, "new-repo", "newer-repo", time.Now().Add(-time.Minute)))
+	resolved, err = r.ResolveRepositoryAlias(ctx, "old-repo")
+	testutil.Must(t, err)
+	if resolved != "newer-repo" {
+		t.Fatalf("ResolveRepositoryAlias=%s, expected newer-repo", resolved)
+	}
+	if _, err := r.ResolveRepositoryAlias(ctx, "new-repo"); !errors.Is(err, graveler.ErrRepositoryNotFound) {
+		t.Fatalf("ResolveRepositoryAlias of expired alias err=%v, expected=%v", err, graveler.ErrRepositoryNotFound)
+	}
+	testutil.Must(t, r.DeleteExpiredRepositoryAliases(ctx, time.Now()))
+	if _, err := r.ResolveRepositoryAlias(ctx, "old-repo"); err != nil {
+		t.Fatalf("ResolveRepositoryAlias of unexpired alias err=%v, expected none", err)
+	}
+}
+
 func TestManager_GetBranch(t *testing.T) {
 	r := testRefManager(t)
 	t.Run("get_branch_exists", func(t *testing.T) {
@@ -280,6 +361,157 @@ func TestManager_DeleteBranch(t *testing.T) {
 	}
 }
 
+func TestManager_ListExpiredBranches(t *testing.T) {
+	r := testRefManager(t)
+	ctx := context.Background()
+	testutil.Must(t, r.CreateRepository(ctx, "repo1", graveler.Repository{
+		StorageNamespace: "s3://",
+		CreationDate:     time.Now(),
+		DefaultBranchID:  "main",
+	}, ""))
+
+	now := time.Now().Truncate(time.Second)
+	past := now.Add(-time.Minute)
+	future := now.Add(time.Hour)
+	testutil.Must(t, r.CreateBranch(ctx, "repo1", "expired", graveler.Branch{
+		CommitID: "c1",
+		Expiry:   graveler.BranchExpiry{ExpiresAt: &past},
+	}))
+	testutil.Must(t, r.CreateBranch(ctx, "repo1", "not-expired", graveler.Branch{
+		CommitID: "c1",
+		Expiry:   graveler.BranchExpiry{ExpiresAt: &future, IdleTTL: time.Hour},
+	}))
+	testutil.Must(t, r.CreateBranch(ctx, "repo1", "idle", graveler.Branch{
+		CommitID: "c1",
+		Expiry:   graveler.BranchExpiry{IdleTTL: time.Second},
+	}))
+
+	// setting a branch keeps its expiry
+	testutil.Must(t, r.SetBranch(ctx, "repo1", "not-expired", graveler.Branch{CommitID: "c2"}))
+	b, err := r.GetBranch(ctx, "repo1", "not-expired")
+	testutil.Must(t, err)
+	if b.Expiry.ExpiresAt == nil || !b.Expiry.ExpiresAt.Equal(future) || b.Expiry.IdleTTL != time.Hour {
+		t.Fatalf("branch expiry %+v, expected expires at %s with idle TTL 1h", b.Expiry, future)
+	}
+
+	expired, err := r.ListExpiredBranches(ctx, "repo1", now)
+	testutil.Must(t, err)
+	if len(expired) != 1 || expired[0].BranchID != "expired" {
+		t.Fatalf("ListExpiredBranches %+v, expected only branch 'expired'", expired)
+	}
+	expired, err = r.ListExpiredBranches(ctx, "repo1", now.Add(time.Minute))
+	testutil.Must(t, err)
+	if len(expired) != 2 || expired[0].BranchID != "expired" || expired[1].BranchID != "idle" {
+		t.Fatalf("ListExpiredBranches %+v, expected branches 'expired' and 'idle'", expired)
+	}
+}
+
+func TestManager_ListBranchReflog(t *testing.T) {
+	r := testRefManager(t)
+	ctx := graveler.WithBranchReflogUser(context.Background(), "user1")
+	testutil.Must(t, r.CreateRepository(ctx, "repo1", graveler.Repository{
+		StorageNamespace: "s3://",
+		CreationDate:     time.Now(),
+		DefaultBranchID:  "main",
+	}, ""))
+
+	testutil.Must(t, r.CreateBranch(ctx, "repo1", "branch1", graveler.Branch{CommitID: "c1", StagingToken: "t1"}))
+	commitCtx := graveler.WithBranchReflogOperation(ctx, graveler.BranchReflogOperationCommit)
+	testutil.Must(t, r.SetBranch(commitCtx, "repo1", "branch1", graveler.Branch{CommitID: "c2", StagingToken: "t2"}))
+	// only the staging token changes, not recorded
+	testutil.Must(t, r.SetBranch(ctx, "repo1", "branch1", graveler.Branch{CommitID: "c2", StagingToken: "t3"}))
+	testutil.Must(t, r.DeleteBranch(ctx, "repo1", "branch1"))
+
+	it, err := r.ListBranchReflog(ctx, "repo1", "branch1")
+	testutil.MustDo(t, "list branch reflog", err)
+	defer it.Close()
+	var entries []graveler.BranchReflogEntry
+	for it.Next() {
+		entry := *it.Value()
+		if entry.User != "user1" {
+			t.Errorf("entry %d user=%s, expected user1", entry.Index, entry.User)
+		}
+		entry.User = ""
+		entry.CreationDate = time.Time{}
+		entries = append(entries, entry)
+	}
+	testutil.MustDo(t, "iterate branch reflog", it.Err())
+	expected := []graveler.BranchReflogEntry{
+		{Index: 0, OldCommitID: "c2", NewCommitID: "", Operation: graveler.BranchReflogOperationDelete},
+		{Index: 1, OldCommitID: "c1", NewCommitID: "c2", Operation: graveler.BranchReflogOperationCommit},
+		{Index: 2, OldCommitID: "", NewCommitID: "c1", Operation: graveler.BranchReflogOperationCreate},
+	}
+	if diff := deep.Equal(entries, expected); diff != nil {
+		t.Fatal("ListBranchReflog unexpected entries:", diff)
+	}
+
+	it.SeekGE(1)
+	if !it.Next() {
+		t.Fatalf("SeekGE(1) found no entry, err=%v", it.Err())
+	}
+	if it.Value().Index != 1 || it.Value().NewCommitID != "c2" {
+		t.Errorf("SeekGE(1) got entry %+v, expected index 1 moving to c2", it.Value())
+	}
+}
+
+func TestManager_Stashes(t *testing.T) {
+	r := testRefManager(t)
+	ctx := context.Background()
+	testutil.Must(t, r.CreateRepository(ctx, "repo1", graveler.Repository{
+		StorageNamespace: "s3://",
+		CreationDate:     time.Now(),
+		DefaultBranchID:  "main",
+	}, ""))
+	testutil.Must(t, r.CreateBranch(ctx, "repo1", "branch1", graveler.Branch{CommitID: "c1", StagingToken: "t1"}))
+
+	stash, err := r.CreateStash(ctx, "repo1", "branch1", "stash1", "t2")
+	testutil.MustDo(t, "create stash1", err)
+	if stash.StagingToken != "t1" || stash.CommitID != "c1" {
+		t.Errorf("created stash %+v, expected staging token t1 on c1", stash)
+	}
+	branch, err := r.GetBranch(ctx, "repo1", "branch1")
+	testutil.MustDo(t, "get branch", err)
+	if branch.StagingToken != "t2" || branch.CommitID != "c1" {
+		t.Errorf("branch after stash %+v, expected staging token t2 on c1", branch)
+	}
+	_, err = r.CreateStash(ctx, "repo1", "branch1", "stash1", "t3")
+	if !errors.Is(err, graveler.ErrStashExists) {
+		t.Errorf("create existing stash err=%v, expected=%v", err, graveler.ErrStashExists)
+	}
+	_, err = r.CreateStash(ctx, "repo1", "branch2", "stash1", "t3")
+	if !errors.Is(err, graveler.ErrBranchNotFound) {
+		t.Errorf("create stash on missing branch err=%v, expected=%v", err, graveler.ErrBranchNotFound)
+	}
+	_, err = r.CreateStash(ctx, "repo1", "branch1", "stash0", "t3")
+	testutil.MustDo(t, "create stash0", err)
+
+	stash, err = r.GetStash(ctx, "repo1", "branch1", "stash0")
+	testutil.MustDo(t, "get stash0", err)
+	if stash.StagingToken != "t2" {
+		t.Errorf("stash0 staging token=%s, expected t2", stash.StagingToken)
+	}
+
+	it, err := r.ListStashes(ctx, "repo1", "branch1")
+	testutil.MustDo(t, "list stashes", err)
+	var ids []graveler.StashID
+	for it.Next() {
+		ids = append(ids, it.Value().StashID)
+	}
+	testutil.MustDo(t, "iterate stashes", it.Err())
+	it.Close()
+	if diff := deep.Equal(ids, []graveler.StashID{"stash0", "stash1"}); diff != nil {
+		t.Error("ListStashes unexpected stashes:", diff)
+	}
+
+	testutil.Must(t, r.DeleteStash(ctx, "repo1", "branch1", "stash0"))
+	if _, err := r.GetStash(ctx, "repo1", "branch1", "stash0"); !errors.Is(err, graveler.ErrStashNotFound) {
+		t.Errorf("get deleted stash err=%v, expected=%v", err, graveler.ErrStashNotFound)
+	}
+	if err := r.DeleteStash(ctx, "repo1", "branch1", "stash0"); !errors.Is(err, graveler.ErrStashNotFound) {
+		t.Errorf("delete deleted stash err=%v, expected=%v", err, graveler.ErrStashNotFound)
+	}
+}
+
 func TestManager_ListBranches(t *testing.T) {
 	r := testRefManager(t)
 	testutil.Must(t, r.CreateRepository(context.Background(), "repo1", graveler.Repository{
@@ -388,6 +620,62 @@ func TestManager_CreateTag(t *testing.T) {
 	}
 }
 
+func TestManager_CreateAnnotatedTag(t *testing.T) {
+	r := testRefManager(t)
+	ctx := context.Background()
+	testutil.Must(t, r.CreateRepository(ctx, "repo1", graveler.Repository{
+		StorageNamespace: "s3://",
+		CreationDate:     time.Now(),
+		DefaultBranchID:  "main",
+	}, ""))
+
+	annotation := graveler.TagAnnotation{
+		Message:      "release v1",
+		Tagger:       "tagger",
+		CreationDate: time.Unix(1700000000, 0).UTC(),
+		Metadata:     graveler.Metadata{"model": "3"},
+	}
+	testutil.MustDo(t, "create annotated tag v1", r.CreateAnnotatedTag(ctx, "repo1", "v1", "c1", annotation))
+	testutil.MustDo(t, "create tag v2", r.CreateTag(ctx, "repo1", "v2", "c2"))
+
+	err := r.CreateAnnotatedTag(ctx, "repo1", "v1", "c2", annotation)
+	if !errors.Is(err, graveler.ErrTagAlreadyExists) {
+		t.Fatalf("CreateAnnotatedTag() err = %s, expected already exists", err)
+	}
+
+	record, err := r.GetTagRecord(ctx, "repo1", "v1")
+	testutil.MustDo(t, "get tag record v1", err)
+	expected := &graveler.TagRecord{TagID: "v1", CommitID: "c1", Annotation: &annotation}
+	if diff := deep.Equal(record, expected); diff != nil {
+		t.Fatalf("GetTagRecord(v1) diff: %s", diff)
+	}
+
+	record, err = r.GetTagRecord(ctx, "repo1", "v2")
+	testutil.MustDo(t, "get tag record v2", err)
+	if record.CommitID != "c2" || record.Annotation != nil {
+		t.Fatalf("GetTagRecord(v2) = %+v, expected lightweight tag on c2", record)
+	}
+
+	_, err = r.GetTagRecord(ctx, "repo1", "v3")
+	if !errors.Is(err, graveler.ErrTagNotFound) {
+		t.Fatalf("GetTagRecord(v3) err = %s, expected not found", err)
+	}
+
+	it, err := r.ListTags(ctx, "repo1")
+	testutil.MustDo(t, "list tags", err)
+	defer it.Close()
+	var annotated []graveler.TagID
+	for it.Next() {
+		if it.Value().Annotation != nil {
+			annotated = append(annotated, it.Value().TagID)
+		}
+	}
+	testutil.MustDo(t, "list tags iterator", it.Err())
+	if diff := deep.Equal(annotated, []graveler.TagID{"v1"}); diff != nil {
+		t.Fatalf("ListTags annotated tags diff: %s", diff)
+	}
+}
+
 func TestManager_DeleteTag(t *testing.T) {
 	r := testRefManager(t)
 	ctx := context.Background()
@@ -410,6 +698,119 @@ func TestManager_DeleteTag(t *testing.T) {
 	}
 }
 
+func TestManager_LegalHolds(t *testing.T) {
+	r := testRefManager(t)
+	ctx := context.Background()
+	testutil.Must(t, r.CreateRepository(ctx, "repo1", graveler.Repository{
+		StorageNamespace: "s3://",
+		CreationDate:     time.Now(),
+		DefaultBranchID:  "main",
+	}, ""))
+
+	hold := graveler.LegalHold{CommitID: "c1", Path: "records/", Reason: "case", CreatedBy: "alice", CreationDate: time.Now()}
+	testutil.MustDo(t, "create legal hold", r.CreateLegalHold(ctx, "repo1", "hold1", hold))
+	if err := r.CreateLegalHold(ctx, "repo1", "hold1", hold); !errors.Is(err, graveler.ErrLegalHoldExists) {
+		t.Errorf("create existing legal hold err=%v, expected=%v", err, graveler.ErrLegalHoldExists)
+	}
+	testutil.MustDo(t, "create legal hold", r.CreateLegalHold(ctx, "repo1", "hold0", graveler.LegalHold{CommitID: "c2", CreatedBy: "alice", CreationDate: time.Now()}))
+
+	got, err := r.GetLegalHold(ctx, "repo1", "hold1")
+	testutil.MustDo(t, "get legal hold", err)
+	if got.CommitID != "c1" || got.Path != "records/" || got.CreatedBy != "alice" {
+		t.Errorf("GetLegalHold got %+v, expected %+v", got, hold)
+	}
+	holds, err := r.ListLegalHolds(ctx, "repo1")
+	testutil.MustDo(t, "list legal holds", err)
+	var ids []graveler.LegalHoldID
+	for _, h := range holds {
+		ids = append(ids, h.LegalHoldID)
+	}
+	if diff := deep.Equal(ids, []graveler.LegalHoldID{"hold0", "hold1"}); diff != nil {
+		t.Error("ListLegalHolds found mismatch:", diff)
+	}
+
+	testutil.MustDo(t, "release legal hold", r.DeleteLegalHold(ctx, "repo1", "hold1", "bob"))
+	if _, err := r.GetLegalHold(ctx, "repo1", "hold1"); !errors.Is(err, graveler.ErrLegalHoldNotFound) {
+		t.Errorf("get released legal hold err=%v, expected=%v", err, graveler.ErrLegalHoldNotFound)
+	}
+	if err := r.DeleteLegalHold(ctx, "repo1", "hold1", "bob"); !errors.Is(err, graveler.ErrLegalHoldNotFound) {
+		t.Errorf("release released legal hold err=%v, expected=%v", err, graveler.ErrLegalHoldNotFound)
+	}
+
+	entries, err := r.ListLegalHoldLog(ctx, "repo1")
+	testutil.MustDo(t, "list legal hold log", err)
+	var log []string
+	for _, entry := range entries {
+		log = append(log, fmt.Sprintf("%s %s %s %s", entry.Operation, entry.LegalHoldID, entry.CommitID, entry.User))
+	}
+	expected := []string{"release hold1 c1 bob", "hold hold0 c2 alice", "hold hold1 c1 alice"}
+	if diff := deep.Equal(log, expected); diff != nil {
+		t.Error("ListLegalHoldLog found mismatch:", diff)
+	}
+
+	if err := r.DeleteRepository(ctx, "repo1"); !errors.Is(err, graveler.ErrDeleteLegalHoldRepository) {
+		t.Errorf("delete repository with legal hold err=%v, expected=%v", err, graveler.ErrDeleteLegalHoldRepository)
+	}
+	testutil.MustDo(t, "release legal hold", r.DeleteLegalHold(ctx, "repo1", "hold0", "bob"))
+	testutil.MustDo(t, "delete repository", r.DeleteRepository(ctx, "repo1"))
+	entries, err = r.ListLegalHoldLog(ctx, "repo1")
+	testutil.MustDo(t, "list legal hold log of deleted repository", err)
+	if len(entries) != len(expected)+1 {
+		t.Errorf("ListLegalHoldLog of deleted repository got %d entries, expected %d", len(entries), len(expected)+1)
+	}
+}
+
+func TestManager_Imports(t *testing.T) {
+	r := testRefManager(t)
+	ctx := context.Background()
+	testutil.Must(t, r.CreateRepository(ctx, "repo1", graveler.Repository{
+		StorageNamespace: "s3://",
+		CreationDate:     time.Now(),
+		DefaultBranchID:  "main",
+	}, ""))
+
+	start := time.Now().Add(-time.Hour)
+	imp := graveler.Import{BranchID: "main", CreationDate: start, UpdateTime: start}
+	testutil.MustDo(t, "create import", r.CreateImport(ctx, "repo1", "import1", imp))
+	if err := r.CreateImport(ctx, "repo1", "import1", imp); !errors.Is(err, graveler.ErrImportExists) {
+		t.Errorf("create existing import err=%v, expected=%v", err, graveler.ErrImportExists)
+	}
+	testutil.MustDo(t, "create import", r.CreateImport(ctx, "repo1", "import2", imp))
+
+	// canceling is kept while the import is updated
+	testutil.MustDo(t, "cancel import", r.CancelImport(ctx, "repo1", "import1"))
+	got, err := r.UpdateImport(ctx, "repo1", "import1", graveler.Import{ImportedObjects: 7, UpdateTime: time.Now()})
+	testutil.MustDo(t, "update import", err)
+	if got.BranchID != "main" || got.ImportedObjects != 7 || !got.Canceled || got.Completed {
+		t.Errorf("UpdateImport got %+v, expected canceled import of 7 objects to main", got)
+	}
+	_, err = r.UpdateImport(ctx, "repo1", "import1", graveler.Import{ImportedObjects: 7, Completed: true, Error: "context canceled", UpdateTime: time.Now()})
+	testutil.MustDo(t, "complete import", err)
+	got, err = r.GetImport(ctx, "repo1", "import1")
+	testutil.MustDo(t, "get import", err)
+	if !got.Completed || got.Error != "context canceled" || !got.CreationDate.Equal(start) {
+		t.Errorf("GetImport got %+v, expected import completed with an error", got)
+	}
+	if _, err := r.UpdateImport(ctx, "repo1", "import1", graveler.Import{UpdateTime: time.Now()}); !errors.Is(err, graveler.ErrImportCompleted) {
+		t.Errorf("update completed import err=%v, expected=%v", err, graveler.ErrImportCompleted)
+	}
+	if err := r.CancelImport(ctx, "repo1", "import1"); !errors.Is(err, graveler.ErrImportCompleted) {
+		t.Errorf("cancel completed import err=%v, expected=%v", err, graveler.ErrImportCompleted)
+	}
+	if err := r.CancelImport(ctx, "repo1", "unknown"); !errors.Is(err, graveler.ErrImportNotFound) {
+		t.Errorf("cancel unknown import err=%v, expected=%v", err, graveler.ErrImportNotFound)
+	}
+
+	// import2 was not updated since it started
+	testutil.MustDo(t, "delete expired imports", r.DeleteExpiredImports(ctx, "repo1", time.Now().Add(-time.Minute)))
+	if _, err := r.GetImport(ctx, "repo1", "import2"); !errors.Is(err, graveler.ErrImportNotFound) {
+		t.Errorf("get expired import err=%v, expected=%v", err, graveler.ErrImportNotFound)
+	}
+	if _, err := r.GetImport(ctx, "repo1", "import1"); err != nil {
+		t.Errorf("get recently updated import err=%v", err)
+	}
+}
+
 func TestManager_ListTags(t *testing.T) {
 	r := testRefManager(t)
 	ctx := context.Background()
@@ -487,6 +888,63 @@ func TestManager_AddCommit(t *testing.T) {
 	}
 }
 
+func TestManager_SearchCommits(t *testing.T) {
+	r := testRefManager(t)
+	ctx := context.Background()
+	testutil.Must(t, r.CreateRepository(ctx, "repo1", graveler.Repository{
+		StorageNamespace: "s3://",
+		CreationDate:     time.Now(),
+		DefaultBranchID:  "main",
+	}, ""))
+
+	ts := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	var commitIDs []graveler.CommitID
+	for i, c := range []graveler.Commit{
+		{Committer: "airflow", Message: "Load users", Metadata: graveler.Metadata{"job_id": "1", "source": "db"}},
+		{Committer: "airflow", Message: "load orders 100%", Metadata: graveler.Metadata{"job_id": "2", "source": "db"}},
+		{Committer: "someone", Message: "fix users", Metadata: graveler.Metadata{"job_id": "2"}},
+		{Committer: "spark", Message: "write schema", Metadata: graveler.Metadata{"schema": strings.Repeat("column ", 1000)}},
+	} {
+		c.MetaRangeID = "deadbeef"
+		c.CreationDate = ts.Add(time.Duration(i) * time.Hour)
+		commitID, err := r.AddCommit(ctx, "repo1", c)
+		testutil.Must(t, err)
+		commitIDs = append(commitIDs, commitID)
+	}
+
+	since := ts.Add(time.Hour)
+	until := ts.Add(2 * time.Hour)
+	tests := []struct {
+		name     string
+		params   graveler.CommitSearchParams
+		expected []graveler.CommitID
+	}{
+		{name: "all", params: graveler.CommitSearchParams{}, expected: []graveler.CommitID{commitIDs[3], commitIDs[2], commitIDs[1], commitIDs[0]}},
+		{name: "metadata", params: graveler.CommitSearchParams{Metadata: graveler.Metadata{"job_id": "2"}}, expected: []graveler.CommitID{commitIDs[2], commitIDs[1]}},
+		{name: "metadata large value", params: graveler.CommitSearchParams{Metadata: graveler.Metadata{"schema": strings.Repeat("column ", 1000)}}, expected: []graveler.CommitID{commitIDs[3]}},
+		{name: "metadata pairs", params: graveler.CommitSearchParams{Metadata: graveler.Metadata{"job_id": "2", "source": "db"}}, expected: []graveler.CommitID{commitIDs[1]}},
+		{name: "committer", params: graveler.CommitSearchParams{Committer: "airflow"}, expected: []graveler.CommitID{commitIDs[1], commitIDs[0]}},
+		{name: "message", params: graveler.CommitSearchParams{Message: "USERS"}, expected: []graveler.CommitID{commitIDs[2], commitIDs[0]}},
+		{name: "message wildcard", params: graveler.CommitSearchParams{Message: "1_0%"}, expected: nil},
+		{name: "message percent", params: graveler.CommitSearchParams{Message: "100%"}, expected: []graveler.CommitID{commitIDs[1]}},
+		{name: "date range", params: graveler.CommitSearchParams{Since: &since, Until: &until}, expected: []graveler.CommitID{commitIDs[1]}},
+		{name: "after", params: graveler.CommitSearchParams{After: commitIDs[2], Limit: 1}, expected: []graveler.CommitID{commitIDs[1]}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			commits, err := r.SearchCommits(ctx, "repo1", tt.params)
+			testutil.Must(t, err)
+			var ids []graveler.CommitID
+			for _, c := range commits {
+				ids = append(ids, c.CommitID)
+			}
+			if diff := deep.Equal(ids, tt.expected); diff != nil {
+				t.Fatalf("SearchCommits found %v, expected %v: %s", ids, tt.expected, diff)
+			}
+		})
+	}
+}
+
 func TestManager_Log(t *testing.T) {
 	r := testRefManager(t)
 	testutil.Must(t, r.CreateRepository(context.Background(), "repo1", graveler.Repository{
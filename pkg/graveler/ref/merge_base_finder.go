@@ -74,3 +74,54 @@ func getCommitAndEnqueue(ctx context.Context, getter CommitGetter, queue *Commit
 	heap.Push(queue, &graveler.CommitRecord{CommitID: commitID, Commit: commit})
 	return commit, nil
 }
+
+// CountAheadBehind counts the commits reachable from leftID that are not reachable from rightID (ahead), and the
+// commits reachable from rightID that are not reachable from leftID (behind).
+// Commits are visited by descending generation, so the flags of a commit are final by the time it is popped, and the
+// walk stops once every queued commit is reachable from both sides.
+func CountAheadBehind(ctx context.Context, getter CommitGetter, repositoryID graveler.RepositoryID, leftID, rightID graveler.CommitID) (int, int, error) {
+	if leftID == rightID {
+		return 0, 0, nil
+	}
+	const fromBoth = fromLeft | fromRight
+	queue := NewCommitsGenerationPriorityQueue()
+	reached := map[graveler.CommitID]reachedFlags{leftID: fromLeft, rightID: fromRight}
+	if _, err := getCommitAndEnqueue(ctx, getter, &queue, repositoryID, leftID); err != nil {
+		return 0, 0, err
+	}
+	if _, err := getCommitAndEnqueue(ctx, getter, &queue, repositoryID, rightID); err != nil {
+		return 0, 0, err
+	}
+	// pending counts the queued commits that are not reachable from both sides
+	pending := 2
+	var ahead, behind int
+	for queue.Len() > 0 && pending > 0 {
+		commitRecord := heap.Pop(&queue).(*graveler.CommitRecord)
+		commitFlags := reached[commitRecord.CommitID]
+		switch commitFlags {
+		case fromLeft:
+			ahead++
+		case fromRight:
+			behind++
+		}
+		if commitFlags != fromBoth {
+			pending--
+		}
+		for _, parent := range commitRecord.Parents {
+			parentFlags, exist := reached[parent]
+			reached[parent] = parentFlags | commitFlags
+			switch {
+			case !exist:
+				if _, err := getCommitAndEnqueue(ctx, getter, &queue, repositoryID, parent); err != nil {
+					return 0, 0, err
+				}
+				if commitFlags != fromBoth {
+					pending++
+				}
+			case parentFlags != fromBoth && parentFlags|commitFlags == fromBoth:
+				pending--
+			}
+		}
+	}
+	return ahead, behind, nil
+}
@@ -413,3 +413,47 @@ func verifyResult(t *testing.T, base *graveler.Commit, expected []string, visite
 	}
 	t.Fatalf("expected one of (%v) got (%v)", expected, base.Message)
 }
+
+func TestCountAheadBehind(t *testing.T) {
+	// E---D---C---B---A
+	// \"-_         \   \
+	//  \  `---------G   \
+	//   \                \
+	//    F----------------H
+	getter := func() *MockCommitGetter {
+		e := &graveler.Commit{Message: "e", Parents: []graveler.CommitID{}}
+		d := &graveler.Commit{Message: "d", Parents: []graveler.CommitID{"e"}}
+		f := &graveler.Commit{Message: "f", Parents: []graveler.CommitID{"e"}}
+		c := &graveler.Commit{Message: "c", Parents: []graveler.CommitID{"d"}}
+		b := &graveler.Commit{Message: "b", Parents: []graveler.CommitID{"c"}}
+		a := &graveler.Commit{Message: "a", Parents: []graveler.CommitID{"b"}}
+		g := &graveler.Commit{Message: "g", Parents: []graveler.CommitID{"b", "e"}}
+		h := &graveler.Commit{Message: "h", Parents: []graveler.CommitID{"a", "f"}}
+		return newReader(map[graveler.CommitID]*graveler.Commit{
+			"e": e, "d": d, "f": f, "c": c, "b": b, "a": a, "g": g, "h": h,
+		})
+	}
+	cases := []struct {
+		Name           string
+		Left           graveler.CommitID
+		Right          graveler.CommitID
+		ExpectedAhead  int
+		ExpectedBehind int
+	}{
+		{Name: "same_commit", Left: "b", Right: "b"},
+		{Name: "ancestor", Left: "c", Right: "a", ExpectedAhead: 0, ExpectedBehind: 2},
+		{Name: "descendant", Left: "a", Right: "c", ExpectedAhead: 2, ExpectedBehind: 0},
+		{Name: "diverged", Left: "g", Right: "h", ExpectedAhead: 1, ExpectedBehind: 3},
+		{Name: "diverged_from_root", Left: "f", Right: "d", ExpectedAhead: 1, ExpectedBehind: 1},
+	}
+	for _, tst := range cases {
+		t.Run(tst.Name, func(t *testing.T) {
+			ahead, behind, err := ref.CountAheadBehind(context.Background(), getter(), "", tst.Left, tst.Right)
+			testutil.Must(t, err)
+			if ahead != tst.ExpectedAhead || behind != tst.ExpectedBehind {
+				t.Fatalf("CountAheadBehind(%s, %s) = %d ahead, %d behind, expected %d ahead, %d behind",
+					tst.Left, tst.Right, ahead, behind, tst.ExpectedAhead, tst.ExpectedBehind)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"regexp"
 	"strconv"
+	"time"
 
 	"github.com/treeverse/lakefs/pkg/graveler"
 )
@@ -25,10 +26,10 @@ func parseRefModifier(buf string) (graveler.RefModifier, error) {
 			return graveler.RefModifier{}, graveler.ErrInvalidRef
 		}
 	case '@':
-		typ = graveler.RefModTypeAt
 		if len(buf) > 1 {
-			return graveler.RefModifier{}, graveler.ErrInvalidRef
+			return parseBraceRefModifier(buf)
 		}
+		typ = graveler.RefModTypeAt
 	default:
 		return graveler.RefModifier{}, graveler.ErrInvalidRef
 	}
@@ -46,6 +47,43 @@ func parseRefModifier(buf string) (graveler.RefModifier, error) {
 	}, nil
 }
 
+// parseBraceRefModifier parses a '@{<n>}' branch reflog modifier, or a '@{<timestamp>}' modifier where the
+// timestamp is in RFC3339 format
+func parseBraceRefModifier(buf string) (graveler.RefModifier, error) {
+	const minBraceModifierLen = len("@{}") + 1
+	if len(buf) < minBraceModifierLen || buf[1] != '{' || buf[len(buf)-1] != '}' {
+		return graveler.RefModifier{}, graveler.ErrInvalidRef
+	}
+	value := buf[2 : len(buf)-1]
+	if isReflogIndex(value) {
+		index, err := strconv.Atoi(value)
+		if err != nil {
+			return graveler.RefModifier{}, fmt.Errorf("could not parse modifier %s: %w", buf, graveler.ErrInvalidRef)
+		}
+		return graveler.RefModifier{
+			Type:  graveler.RefModTypeReflog,
+			Value: index,
+		}, nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return graveler.RefModifier{}, fmt.Errorf("could not parse modifier %s: %w", buf, graveler.ErrInvalidRef)
+	}
+	return graveler.RefModifier{
+		Type: graveler.RefModTypeTime,
+		Time: t,
+	}, nil
+}
+
+func isReflogIndex(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
 func ParseRef(r graveler.Ref) (graveler.RawRef, error) {
 	ref := string(r)
 	parts := modifiersRegexp.FindAllString(ref, -1)
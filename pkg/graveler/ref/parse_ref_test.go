@@ -3,6 +3,7 @@ package ref_test
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/treeverse/lakefs/pkg/graveler"
 	"github.com/treeverse/lakefs/pkg/graveler/ref"
@@ -122,6 +123,68 @@ func TestParseRef(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name:  "branch_time",
+			Input: "main@{2021-06-01T00:00:00Z}",
+			Expected: graveler.RawRef{
+				BaseRef: "main",
+				Modifiers: []graveler.RefModifier{
+					{
+						Type: graveler.RefModTypeTime,
+						Time: time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+		},
+		{
+			Name:  "branch_time_offset_tilde",
+			Input: "main@{2021-06-01T03:00:00+03:00}~1",
+			Expected: graveler.RawRef{
+				BaseRef: "main",
+				Modifiers: []graveler.RefModifier{
+					{
+						Type: graveler.RefModTypeTime,
+						Time: time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC),
+					},
+					{
+						Type:  graveler.RefModTypeTilde,
+						Value: 1,
+					},
+				},
+			},
+		},
+		{
+			Name:  "branch_reflog",
+			Input: "main@{12}~1",
+			Expected: graveler.RawRef{
+				BaseRef: "main",
+				Modifiers: []graveler.RefModifier{
+					{
+						Type:  graveler.RefModTypeReflog,
+						Value: 12,
+					},
+					{
+						Type:  graveler.RefModTypeTilde,
+						Value: 1,
+					},
+				},
+			},
+		},
+		{
+			Name:        "branch_invalid_time",
+			Input:       "main@{yesterday}",
+			ExpectedErr: graveler.ErrInvalidRef,
+		},
+		{
+			Name:        "branch_unclosed_time",
+			Input:       "main@{2021-06-01T00:00:00Z",
+			ExpectedErr: graveler.ErrInvalidRef,
+		},
+		{
+			Name:        "branch_empty_time",
+			Input:       "main@{}",
+			ExpectedErr: graveler.ErrInvalidRef,
+		},
 		{
 			Name:        "no_base",
 			Input:       "^^^3",
@@ -164,6 +227,10 @@ func TestParseRef(t *testing.T) {
 					t.Fatalf("unexpected modifier at index %d: expected value %d got %d",
 						i, cas.Expected.Modifiers[i].Value, m.Value)
 				}
+				if !m.Time.Equal(cas.Expected.Modifiers[i].Time) {
+					t.Fatalf("unexpected modifier at index %d: expected time %s got %s",
+						i, cas.Expected.Modifiers[i].Time, m.Time)
+				}
 			}
 		})
 	}
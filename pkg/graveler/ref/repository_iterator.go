@@ -63,7 +63,7 @@ func (ri *RepositoryIterator) maybeFetch() {
 	ri.err = ri.db.Select(ri.ctx, &ri.buf, `
 			SELECT id, storage_namespace, creation_date, default_branch
 			FROM graveler_repositories
-			WHERE id `+offsetCondition+` $1
+			WHERE id `+offsetCondition+` $1 AND deleted_at IS NULL
 			ORDER BY id ASC
 			LIMIT $2`, ri.offset, ri.fetchSize)
 	if ri.err != nil {
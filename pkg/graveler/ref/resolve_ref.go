@@ -3,6 +3,7 @@ package ref
 import (
 	"context"
 	"errors"
+	"fmt"
 	"regexp"
 
 	"github.com/treeverse/lakefs/pkg/graveler"
@@ -14,6 +15,7 @@ type Store interface {
 	GetTag(ctx context.Context, repositoryID graveler.RepositoryID, tagID graveler.TagID) (*graveler.CommitID, error)
 	GetCommitByPrefix(ctx context.Context, repositoryID graveler.RepositoryID, prefix graveler.CommitID) (*graveler.Commit, error)
 	GetCommit(ctx context.Context, repositoryID graveler.RepositoryID, prefix graveler.CommitID) (*graveler.Commit, error)
+	ListBranchReflog(ctx context.Context, repositoryID graveler.RepositoryID, branchID graveler.BranchID) (graveler.BranchReflogIterator, error)
 }
 
 type revResolverFunc func(context.Context, Store, ident.AddressProvider, graveler.RepositoryID, string) (*graveler.ResolvedRef, error)
@@ -49,7 +51,7 @@ func ResolveRawRef(ctx context.Context, store Store, addressProvider ident.Addre
 		return rr, nil
 	}
 	baseCommit := rr.CommitID
-	for _, mod := range rawRef.Modifiers {
+	for i, mod := range rawRef.Modifiers {
 		// lastly, apply modifier
 		switch mod.Type {
 		case graveler.RefModTypeAt:
@@ -101,6 +103,37 @@ func ResolveRawRef(ctx context.Context, store Store, addressProvider ident.Addre
 			}
 			baseCommit = c.Parents[mod.Value-1]
 
+		case graveler.RefModTypeReflog:
+			// only the head of a branch has a reflog
+			if rr.Type != graveler.ReferenceTypeBranch || i != 0 {
+				return nil, graveler.ErrInvalidRef
+			}
+			entry, err := getBranchReflogEntry(ctx, store, repositoryID, rr.BranchID, mod.Value)
+			if err != nil {
+				return nil, err
+			}
+			if entry.NewCommitID == "" {
+				// the entry deleted the branch
+				return nil, fmt.Errorf("%s@{%d}: %w", rr.BranchID, mod.Value, graveler.ErrNotFound)
+			}
+			baseCommit = entry.NewCommitID
+
+		case graveler.RefModTypeTime:
+			// follow first parents to the newest commit created at or before the given time
+			for {
+				commit, err := store.GetCommit(ctx, repositoryID, baseCommit)
+				if err != nil {
+					return nil, err
+				}
+				if !commit.CreationDate.After(mod.Time) {
+					break
+				}
+				if len(commit.Parents) == 0 {
+					return nil, graveler.ErrNotFound
+				}
+				baseCommit = commit.Parents[0]
+			}
+
 		default:
 			return nil, graveler.ErrInvalidRef
 		}
@@ -113,6 +146,27 @@ func ResolveRawRef(ctx context.Context, store Store, addressProvider ident.Addre
 	}, nil
 }
 
+// getBranchReflogEntry returns the entry of the branch reflog at index
+func getBranchReflogEntry(ctx context.Context, store Store, repositoryID graveler.RepositoryID, branchID graveler.BranchID, index int) (*graveler.BranchReflogEntry, error) {
+	it, err := store.ListBranchReflog(ctx, repositoryID, branchID)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	it.SeekGE(index)
+	if !it.Next() {
+		if err := it.Err(); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%s@{%d}: %w", branchID, index, graveler.ErrBranchReflogEntryNotFound)
+	}
+	entry := it.Value()
+	if entry.Index != index {
+		return nil, fmt.Errorf("%s@{%d}: %w", branchID, index, graveler.ErrBranchReflogEntryNotFound)
+	}
+	return entry, nil
+}
+
 func revResolveAHash(ctx context.Context, store Store, addressProvider ident.AddressProvider, repositoryID graveler.RepositoryID, rev string) (*graveler.ResolvedRef, error) {
 	if !isAHash(rev) {
 		return nil, nil
@@ -294,6 +294,122 @@ func TestResolveRef_SameDate(t *testing.T) {
 	}
 }
 
+func TestResolveRef_Time(t *testing.T) {
+	r := testRefManager(t)
+	ctx := context.Background()
+	testutil.Must(t, r.CreateRepository(ctx, "repo1", graveler.Repository{
+		StorageNamespace: "s3://",
+		CreationDate:     time.Now(),
+		DefaultBranchID:  "main",
+	}, ""))
+
+	ts, _ := time.Parse(time.RFC3339, "2021-06-01T00:00:00Z")
+	addCommit := func(message string, creationDate time.Time, parents ...graveler.CommitID) graveler.CommitID {
+		c := graveler.Commit{
+			Message:      message,
+			Committer:    "tester",
+			MetaRangeID:  "deadbeef1",
+			CreationDate: creationDate,
+			Parents:      parents,
+		}
+		cid, err := r.AddCommit(ctx, "repo1", c)
+		testutil.MustDo(t, "add commit", err)
+		return cid
+	}
+	// c3 merges s into c2, the first-parent history of c3 is c3, c2, c1
+	c1 := addCommit("c1", ts)
+	c2 := addCommit("c2", ts.Add(time.Hour), c1)
+	s := addCommit("s", ts.Add(30*time.Minute), c1)
+	c3 := addCommit("c3", ts.Add(2*time.Hour), c2, s)
+	testutil.Must(t, r.SetBranch(ctx, "repo1", "main", graveler.Branch{
+		CommitID:     c3,
+		StagingToken: "token1",
+	}))
+	testutil.Must(t, r.CreateTag(ctx, "repo1", "v1.0", c3))
+
+	table := []struct {
+		Name             string
+		Ref              graveler.Ref
+		ExpectedCommitID graveler.CommitID
+		ExpectedErr      error
+	}{
+		{Name: "head_at_time", Ref: "main@{2021-06-01T02:00:00Z}", ExpectedCommitID: c3},
+		{Name: "after_head", Ref: "main@{2022-01-01T00:00:00Z}", ExpectedCommitID: c3},
+		{Name: "between_commits", Ref: "main@{2021-06-01T01:30:00Z}", ExpectedCommitID: c2},
+		{Name: "first_parent_only", Ref: "main@{2021-06-01T00:45:00Z}", ExpectedCommitID: c1},
+		{Name: "time_offset", Ref: "main@{2021-06-01T04:30:00+03:00}", ExpectedCommitID: c2},
+		{Name: "time_then_tilde", Ref: "main@{2021-06-01T01:30:00Z}~1", ExpectedCommitID: c1},
+		{Name: "tag", Ref: "v1.0@{2021-06-01T01:30:00Z}", ExpectedCommitID: c2},
+		{Name: "commit", Ref: graveler.Ref(c3 + "@{2021-06-01T01:30:00Z}"), ExpectedCommitID: c2},
+		{Name: "before_history", Ref: "main@{2021-05-31T23:59:59Z}", ExpectedErr: graveler.ErrNotFound},
+		{Name: "invalid_time", Ref: "main@{yesterday}", ExpectedErr: graveler.ErrInvalidRef},
+	}
+	for _, cas := range table {
+		t.Run(cas.Name, func(t *testing.T) {
+			res, err := resolveRef(ctx, r, ident.NewHexAddressProvider(), "repo1", cas.Ref)
+			if !errors.Is(err, cas.ExpectedErr) {
+				t.Fatalf("resolve %s err=%v, expected=%v", cas.Ref, err, cas.ExpectedErr)
+			}
+			if err != nil {
+				return
+			}
+			if res.Type != graveler.ReferenceTypeCommit {
+				t.Errorf("resolve %s type=%d, expected commit", cas.Ref, res.Type)
+			}
+			if res.CommitID != cas.ExpectedCommitID {
+				t.Errorf("resolve %s commit=%s, expected=%s", cas.Ref, res.CommitID, cas.ExpectedCommitID)
+			}
+		})
+	}
+}
+
+func TestResolveRef_Reflog(t *testing.T) {
+	r := testRefManager(t)
+	ctx := context.Background()
+	testutil.Must(t, r.CreateRepository(ctx, "repo1", graveler.Repository{
+		StorageNamespace: "s3://",
+		CreationDate:     time.Now(),
+		DefaultBranchID:  "main",
+	}, ""))
+
+	ts, _ := time.Parse(time.RFC3339, "2021-06-01T00:00:00Z")
+	c1, err := r.AddCommit(ctx, "repo1", graveler.Commit{Message: "c1", MetaRangeID: "deadbeef1", CreationDate: ts})
+	testutil.MustDo(t, "add commit", err)
+	c2, err := r.AddCommit(ctx, "repo1", graveler.Commit{Message: "c2", MetaRangeID: "deadbeef1", CreationDate: ts.Add(time.Hour), Parents: graveler.CommitParents{c1}})
+	testutil.MustDo(t, "add commit", err)
+	testutil.Must(t, r.CreateBranch(ctx, "repo1", "feature", graveler.Branch{CommitID: c2, StagingToken: "token1"}))
+	testutil.Must(t, r.SetBranch(ctx, "repo1", "feature", graveler.Branch{CommitID: c1, StagingToken: "token1"}))
+	testutil.Must(t, r.CreateTag(ctx, "repo1", "v1.0", c2))
+
+	table := []struct {
+		Name             string
+		Ref              graveler.Ref
+		ExpectedCommitID graveler.CommitID
+		ExpectedErr      error
+	}{
+		{Name: "head", Ref: "feature@{0}", ExpectedCommitID: c1},
+		{Name: "previous", Ref: "feature@{1}", ExpectedCommitID: c2},
+		{Name: "previous_then_tilde", Ref: "feature@{1}~1", ExpectedCommitID: c1},
+		{Name: "missing_entry", Ref: "feature@{2}", ExpectedErr: graveler.ErrNotFound},
+		{Name: "tag", Ref: "v1.0@{0}", ExpectedErr: graveler.ErrInvalidRef},
+		{Name: "after_tilde", Ref: "feature~1@{0}", ExpectedErr: graveler.ErrInvalidRef},
+	}
+	for _, cas := range table {
+		t.Run(cas.Name, func(t *testing.T) {
+			res, err := resolveRef(ctx, r, ident.NewHexAddressProvider(), "repo1", cas.Ref)
+			if !errors.Is(err, cas.ExpectedErr) {
+				t.Fatalf("resolve %s err=%v, expected=%v", cas.Ref, err, cas.ExpectedErr)
+			}
+			if err != nil {
+				return
+			}
+			if res.Type != graveler.ReferenceTypeCommit || res.CommitID != cas.ExpectedCommitID {
+				t.Errorf("resolve %s type=%d commit=%s, expected commit %s", cas.Ref, res.Type, res.CommitID, cas.ExpectedCommitID)
+			}
+		})
+	}
+}
+
 func TestResolveRef_DereferenceWithGraph(t *testing.T) {
 	/*
 		This is taken from `git help rev-parse` - let's run these tests
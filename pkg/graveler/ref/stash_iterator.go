@@ -0,0 +1,131 @@
+package ref
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/treeverse/lakefs/pkg/db"
+	"github.com/treeverse/lakefs/pkg/graveler"
+)
+
+type StashIterator struct {
+	db           db.Database
+	ctx          context.Context
+	repositoryID graveler.RepositoryID
+	branchID     graveler.BranchID
+	value        *graveler.StashRecord
+	buf          []*graveler.StashRecord
+	offset       string
+	fetchSize    int
+	err          error
+	state        iteratorState
+}
+
+type stashRecord struct {
+	StashID      graveler.StashID      `db:"id"`
+	StagingToken graveler.StagingToken `db:"staging_token"`
+	CommitID     graveler.CommitID     `db:"commit_id"`
+	CreationDate time.Time             `db:"creation_date"`
+}
+
+func (s *stashRecord) toGravelerStash() *graveler.Stash {
+	return &graveler.Stash{
+		StagingToken: s.StagingToken,
+		CommitID:     s.CommitID,
+		CreationDate: s.CreationDate,
+	}
+}
+
+func NewStashIterator(ctx context.Context, db db.Database, repositoryID graveler.RepositoryID, branchID graveler.BranchID, prefetchSize int) *StashIterator {
+	return &StashIterator{
+		db:           db,
+		ctx:          ctx,
+		repositoryID: repositoryID,
+		branchID:     branchID,
+		fetchSize:    prefetchSize,
+		buf:          make([]*graveler.StashRecord, 0, prefetchSize),
+	}
+}
+
+func (si *StashIterator) Next() bool {
+	if si.err != nil {
+		return false
+	}
+	si.maybeFetch()
+
+	// stage a value and increment offset
+	if len(si.buf) == 0 {
+		return false
+	}
+	si.value = si.buf[0]
+	si.buf = si.buf[1:]
+	si.offset = string(si.value.StashID)
+	return true
+}
+
+func (si *StashIterator) maybeFetch() {
+	if si.state == iteratorStateDone {
+		return
+	}
+	if len(si.buf) > 0 {
+		return
+	}
+
+	var offsetCondition string
+	if si.state == iteratorStateInit {
+		offsetCondition = iteratorOffsetCondition(true)
+		si.state = iteratorStateQuerying
+	} else {
+		offsetCondition = iteratorOffsetCondition(false)
+	}
+
+	var buf []*stashRecord
+	err := si.db.Select(si.ctx, &buf, `
+			SELECT id, staging_token, commit_id, creation_date
+			FROM graveler_branch_stashes
+			WHERE repository_id = $1 AND branch_id = $2
+			AND id `+offsetCondition+` $3
+			ORDER BY id ASC
+			LIMIT $4`, si.repositoryID, si.branchID, si.offset, si.fetchSize)
+	if err != nil {
+		si.err = err
+		return
+	}
+	if len(buf) < si.fetchSize {
+		si.state = iteratorStateDone
+	}
+	for _, s := range buf {
+		si.buf = append(si.buf, &graveler.StashRecord{
+			StashID: s.StashID,
+			Stash:   s.toGravelerStash(),
+		})
+	}
+}
+
+func (si *StashIterator) SeekGE(id graveler.StashID) {
+	if errors.Is(si.err, ErrIteratorClosed) {
+		return
+	}
+	si.offset = string(id)
+	si.state = iteratorStateInit
+	si.buf = si.buf[:0]
+	si.value = nil
+	si.err = nil
+}
+
+func (si *StashIterator) Value() *graveler.StashRecord {
+	if si.err != nil {
+		return nil
+	}
+	return si.value
+}
+
+func (si *StashIterator) Err() error {
+	return si.err
+}
+
+func (si *StashIterator) Close() {
+	si.err = ErrIteratorClosed
+	si.buf = nil
+}
@@ -2,8 +2,10 @@ package retention
 
 import (
 	"context"
+	"sort"
 	"time"
 
+	"github.com/gobwas/glob"
 	"github.com/treeverse/lakefs/pkg/graveler"
 )
 
@@ -85,6 +87,108 @@ func GetGarbageCollectionCommits(ctx context.Context, startingPointIterator *GCS
 	return &GarbageCollectionCommits{active: commitSetToSlice(activeMap), expired: commitSetToSlice(expiredMap)}, nil
 }
 
+// GetPinnedCommits returns the commits reachable from the tags matching any of patterns.
+// Upon completion, the given tagIterator is closed.
+func GetPinnedCommits(ctx context.Context, tagIterator graveler.TagIterator, commitGetter *RepositoryCommitGetter, patterns []string) (map[graveler.CommitID]struct{}, error) {
+	defer tagIterator.Close()
+	matchers := make([]glob.Glob, 0, len(patterns))
+	for _, pattern := range patterns {
+		matcher, err := glob.Compile(pattern)
+		if err != nil {
+			return nil, err
+		}
+		matchers = append(matchers, matcher)
+	}
+	pinned := make(map[graveler.CommitID]struct{})
+	var queue []graveler.CommitID
+	for tagIterator.Next() {
+		tag := tagIterator.Value()
+		for _, matcher := range matchers {
+			if matcher.Match(string(tag.TagID)) {
+				queue = append(queue, tag.CommitID)
+				break
+			}
+		}
+	}
+	if err := tagIterator.Err(); err != nil {
+		return nil, err
+	}
+	for len(queue) > 0 {
+		commitID := queue[0]
+		queue = queue[1:]
+		if _, ok := pinned[commitID]; ok {
+			continue
+		}
+		pinned[commitID] = struct{}{}
+		commit, err := commitGetter.GetCommit(ctx, commitID)
+		if err != nil {
+			return nil, err
+		}
+		queue = append(queue, commit.Parents...)
+	}
+	return pinned, nil
+}
+
+// pin moves the pinned commits from the expired set to the active set
+func (g *GarbageCollectionCommits) pin(pinned map[graveler.CommitID]struct{}) {
+	expired := g.expired[:0]
+	for _, commitID := range g.expired {
+		if _, ok := pinned[commitID]; ok {
+			g.active = append(g.active, commitID)
+			continue
+		}
+		expired = append(expired, commitID)
+	}
+	g.expired = expired
+}
+
+// hold makes the held commits active, including held commits that no branch reaches and commits expired by
+// previous runs
+func (g *GarbageCollectionCommits) hold(held map[graveler.CommitID]struct{}) {
+	if len(held) == 0 {
+		return
+	}
+	expired := g.expired[:0]
+	for _, commitID := range g.expired {
+		if _, ok := held[commitID]; !ok {
+			expired = append(expired, commitID)
+		}
+	}
+	g.expired = expired
+	active := make(map[graveler.CommitID]struct{}, len(g.active))
+	for _, commitID := range g.active {
+		active[commitID] = struct{}{}
+	}
+	heldIDs := make([]graveler.CommitID, 0, len(held))
+	for commitID := range held {
+		if _, ok := active[commitID]; !ok {
+			heldIDs = append(heldIDs, commitID)
+		}
+	}
+	sort.Slice(heldIDs, func(i, j int) bool { return heldIDs[i] < heldIDs[j] })
+	g.active = append(g.active, heldIDs...)
+}
+
+// mergeRuleCommits returns the commits active according to any rule, and the other commits expired according to
+// any rule.  Objects of the expired commits are then referenced only by commits every rule expired.
+func mergeRuleCommits(ruleCommits []graveler.GarbageCollectionRuleCommits) *GarbageCollectionCommits {
+	activeMap := make(map[graveler.CommitID]struct{})
+	expiredMap := make(map[graveler.CommitID]struct{})
+	for _, commits := range ruleCommits {
+		for _, commitID := range commits.Active {
+			activeMap[commitID] = struct{}{}
+		}
+	}
+	for _, commits := range ruleCommits {
+		for _, commitID := range commits.Expired {
+			if _, ok := activeMap[commitID]; !ok {
+				expiredMap[commitID] = struct{}{}
+			}
+		}
+	}
+	return &GarbageCollectionCommits{active: commitSetToSlice(activeMap), expired: commitSetToSlice(expiredMap)}
+}
+
 func commitSetToSlice(commitMap map[graveler.CommitID]struct{}) []graveler.CommitID {
 	res := make([]graveler.CommitID, 0, len(commitMap))
 	for commitID := range commitMap {
@@ -316,6 +316,85 @@ func TestExpiredCommits(t *testing.T) {
 	}
 }
 
+func TestPinnedCommits(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	refManagerMock := mock.NewMockRefManager(ctrl)
+	ctx := context.Background()
+	commits := map[graveler.CommitID][]graveler.CommitID{
+		"root":  nil,
+		"a":     {"root"},
+		"b":     {"a"},
+		"side":  {"root"},
+		"merge": {"b", "side"},
+		"c":     {"a"},
+	}
+	for commitID, parents := range commits {
+		refManagerMock.EXPECT().GetCommit(ctx, graveler.RepositoryID("test"), commitID).Return(&graveler.Commit{Parents: parents}, nil).AnyTimes()
+	}
+	tags := []*graveler.TagRecord{
+		{TagID: "dev-1", CommitID: "c"},
+		{TagID: "release-1", CommitID: "merge"},
+	}
+	pinned, err := GetPinnedCommits(ctx, testutil.NewFakeTagIterator(tags), &RepositoryCommitGetter{
+		refManager:   refManagerMock,
+		repositoryID: "test",
+	}, []string{"release-*"})
+	if err != nil {
+		t.Fatalf("failed to find pinned commits: %v", err)
+	}
+	var pinnedIDs []string
+	for commitID := range pinned {
+		pinnedIDs = append(pinnedIDs, string(commitID))
+	}
+	sort.Strings(pinnedIDs)
+	if diff := deep.Equal(pinnedIDs, []string{"a", "b", "merge", "root", "side"}); diff != nil {
+		t.Errorf("pinned commits ids diff=%s", diff)
+	}
+
+	gcCommits := &GarbageCollectionCommits{
+		expired: []graveler.CommitID{"root", "c"},
+		active:  []graveler.CommitID{"d"},
+	}
+	gcCommits.pin(pinned)
+	if diff := deep.Equal(testToStringArray(gcCommits.expired), []string{"c"}); diff != nil {
+		t.Errorf("expired commits ids after pin diff=%s", diff)
+	}
+	if diff := deep.Equal(testToStringArray(gcCommits.active), []string{"d", "root"}); diff != nil {
+		t.Errorf("active commits ids after pin diff=%s", diff)
+	}
+}
+
+func TestMergeRuleCommits(t *testing.T) {
+	gcCommits := mergeRuleCommits([]graveler.GarbageCollectionRuleCommits{
+		{Expired: []graveler.CommitID{"a", "b"}, Active: []graveler.CommitID{"c"}},
+		{Prefix: "curated/", Expired: []graveler.CommitID{"a"}, Active: []graveler.CommitID{"b", "c"}},
+	})
+	// a commit any rule keeps is active
+	if diff := deep.Equal(testToStringArray(gcCommits.expired), []string{"a"}); diff != nil {
+		t.Errorf("expired commits ids diff=%s", diff)
+	}
+	active := testToStringArray(gcCommits.active)
+	sort.Strings(active)
+	if diff := deep.Equal(active, []string{"b", "c"}); diff != nil {
+		t.Errorf("active commits ids diff=%s", diff)
+	}
+}
+
+func TestHoldCommits(t *testing.T) {
+	gcCommits := &GarbageCollectionCommits{
+		expired: []graveler.CommitID{"a", "b"},
+		active:  []graveler.CommitID{"c"},
+	}
+	// "d" is held but was not found by the run, it is kept as well
+	gcCommits.hold(map[graveler.CommitID]struct{}{"b": {}, "c": {}, "d": {}})
+	if diff := deep.Equal(testToStringArray(gcCommits.expired), []string{"a"}); diff != nil {
+		t.Errorf("expired commits ids diff=%s", diff)
+	}
+	if diff := deep.Equal(testToStringArray(gcCommits.active), []string{"c", "b", "d"}); diff != nil {
+		t.Errorf("active commits ids diff=%s", diff)
+	}
+}
+
 func testToStringArray(commitIDs []graveler.CommitID) []string {
 	res := make([]string, len(commitIDs))
 	for i := range commitIDs {
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"sort"
 	"strings"
 
 	"github.com/google/uuid"
@@ -22,6 +23,9 @@ const (
 	configFileSuffixTemplate    = "/%s/retention/gc/rules/config.json"
 	addressesFilePrefixTemplate = "/%s/retention/gc/addresses/"
 	commitsFileSuffixTemplate   = "/%s/retention/gc/commits/run_id=%s/commits.csv"
+	addressesFileSuffixTemplate = "/%s/retention/gc/addresses/run_id=%s/addresses.csv"
+	// prefix commits are saved apart from the commits read by the Spark job
+	prefixCommitsFileSuffixTemplate = "/%s/retention/gc/prefix_commits/run_id=%s/commits.csv"
 )
 
 type GarbageCollectionManager struct {
@@ -49,6 +53,24 @@ func (m *GarbageCollectionManager) GetAddressesLocation(sn graveler.StorageNames
 	return qk.Format(), nil
 }
 
+func (m *GarbageCollectionManager) GetAddressesCSVLocation(runID string, sn graveler.StorageNamespace) (string, error) {
+	key := fmt.Sprintf(addressesFileSuffixTemplate, m.committedBlockStoragePrefix, runID)
+	qk, err := block.ResolveNamespace(sn.String(), key, block.IdentifierTypeRelative)
+	if err != nil {
+		return "", err
+	}
+	return qk.Format(), nil
+}
+
+func (m *GarbageCollectionManager) GetPrefixCommitsCSVLocation(runID string, sn graveler.StorageNamespace) (string, error) {
+	key := fmt.Sprintf(prefixCommitsFileSuffixTemplate, m.committedBlockStoragePrefix, runID)
+	qk, err := block.ResolveNamespace(sn.String(), key, block.IdentifierTypeRelative)
+	if err != nil {
+		return "", err
+	}
+	return qk.Format(), nil
+}
+
 type RepositoryCommitGetter struct {
 	refManager   graveler.RefManager
 	repositoryID graveler.RepositoryID
@@ -111,48 +133,167 @@ func (m *GarbageCollectionManager) GetRunExpiredCommits(ctx context.Context, sto
 	if runID == "" {
 		return nil, nil
 	}
+	expired, _, err := m.GetRunCommits(ctx, storageNamespace, runID)
+	return expired, err
+}
+
+// GetRunCommits returns the expired and active commits saved by run runID
+func (m *GarbageCollectionManager) GetRunCommits(ctx context.Context, storageNamespace graveler.StorageNamespace, runID string) ([]graveler.CommitID, []graveler.CommitID, error) {
 	csvLocation, err := m.GetCommitsCSVLocation(runID, storageNamespace)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	previousRunReader, err := m.blockAdapter.Get(ctx, block.ObjectPointer{
+	runReader, err := m.blockAdapter.Get(ctx, block.ObjectPointer{
 		Identifier:     csvLocation,
 		IdentifierType: block.IdentifierTypeFull,
 	}, -1)
+	if errors.Is(err, adapter.ErrDataNotFound) {
+		return nil, nil, graveler.ErrNotFound
+	}
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	csvReader := csv.NewReader(previousRunReader)
+	defer func() {
+		_ = runReader.Close()
+	}()
+	csvReader := csv.NewReader(runReader)
 	csvReader.ReuseRecord = true
-	var res []graveler.CommitID
+	var expired, active []graveler.CommitID
 	for {
 		commitRow, err := csvReader.Read()
 		if err == io.EOF {
 			break
 		}
+		if err != nil {
+			return nil, nil, err
+		}
+		switch commitRow[1] {
+		case "true":
+			expired = append(expired, graveler.CommitID(commitRow[0]))
+		case "false":
+			active = append(active, graveler.CommitID(commitRow[0]))
+		}
+	}
+	return expired, active, nil
+}
+
+// GetRunRuleCommits returns the commits run runID expired and kept according to the default rule and the rule of
+// every prefix.  Runs without prefix rules saved only the commits of the default rule.
+func (m *GarbageCollectionManager) GetRunRuleCommits(ctx context.Context, storageNamespace graveler.StorageNamespace, runID string) ([]graveler.GarbageCollectionRuleCommits, error) {
+	csvLocation, err := m.GetPrefixCommitsCSVLocation(runID, storageNamespace)
+	if err != nil {
+		return nil, err
+	}
+	reader, err := m.blockAdapter.Get(ctx, block.ObjectPointer{
+		Identifier:     csvLocation,
+		IdentifierType: block.IdentifierTypeFull,
+	}, -1)
+	if errors.Is(err, adapter.ErrDataNotFound) {
+		expired, active, err := m.GetRunCommits(ctx, storageNamespace, runID)
 		if err != nil {
 			return nil, err
 		}
-		if commitRow[1] == "true" {
-			res = append(res, graveler.CommitID(commitRow[0]))
+		return []graveler.GarbageCollectionRuleCommits{{Expired: expired, Active: active}}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = reader.Close()
+	}()
+	rows, err := csv.NewReader(reader).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	// every rule walks the same commits, so every rule has rows once any rule has
+	var ruleCommits []graveler.GarbageCollectionRuleCommits
+	for _, row := range rows[1:] { // skip headers
+		prefix, commitID := row[0], graveler.CommitID(row[1])
+		if len(ruleCommits) == 0 || ruleCommits[len(ruleCommits)-1].Prefix != prefix {
+			ruleCommits = append(ruleCommits, graveler.GarbageCollectionRuleCommits{Prefix: prefix})
+		}
+		commits := &ruleCommits[len(ruleCommits)-1]
+		if row[2] == "true" {
+			commits.Expired = append(commits.Expired, commitID)
+		} else {
+			commits.Active = append(commits.Active, commitID)
 		}
 	}
-	return res, nil
+	return ruleCommits, nil
 }
 
-func (m *GarbageCollectionManager) SaveGarbageCollectionCommits(ctx context.Context, storageNamespace graveler.StorageNamespace, repositoryID graveler.RepositoryID, rules *graveler.GarbageCollectionRules, previouslyExpiredCommits []graveler.CommitID) (string, error) {
+// GetRuleCommits returns the commits expired and kept according to the default rule and the rule of every prefix
+// of rules.  The rule of a prefix replaces the default and branch retention days for the objects under it.  Commits
+// reachable from pinned tags are kept by all rules.
+func (m *GarbageCollectionManager) GetRuleCommits(ctx context.Context, repositoryID graveler.RepositoryID, rules *graveler.GarbageCollectionRules) ([]graveler.GarbageCollectionRuleCommits, error) {
+	return m.getRuleCommits(ctx, repositoryID, rules, nil)
+}
+
+func (m *GarbageCollectionManager) getRuleCommits(ctx context.Context, repositoryID graveler.RepositoryID, rules *graveler.GarbageCollectionRules, previouslyExpiredCommits []graveler.CommitID) ([]graveler.GarbageCollectionRuleCommits, error) {
 	commitGetter := &RepositoryCommitGetter{
 		refManager:   m.refManager,
 		repositoryID: repositoryID,
 	}
-	branchIterator := ref.NewBranchIterator(ctx, m.db, repositoryID, 1000, ref.WithOrderByCommitID())
-	// get all commits that are not the first parent of any commit:
-	commitIterator := ref.NewOrderedCommitIterator(ctx, m.db, repositoryID, 1000, ref.WithOnlyAncestryLeaves())
-	startingPointIterator := NewGCStartingPointIterator(commitIterator, branchIterator)
-	gcCommits, err := GetGarbageCollectionCommits(ctx, startingPointIterator, commitGetter, rules, previouslyExpiredCommits)
+	var pinned map[graveler.CommitID]struct{}
+	if len(rules.PinnedTagPatterns) > 0 {
+		tagIterator, err := m.refManager.ListTags(ctx, repositoryID)
+		if err != nil {
+			return nil, err
+		}
+		pinned, err = GetPinnedCommits(ctx, tagIterator, commitGetter, rules.PinnedTagPatterns)
+		if err != nil {
+			return nil, fmt.Errorf("find pinned commits: %w", err)
+		}
+	}
+	// legal holds keep their commits whatever the rules, objects under the path of a hold are kept with the
+	// whole commit since the commits of a run are not path aware
+	holds, err := m.refManager.ListLegalHolds(ctx, repositoryID)
+	if err != nil {
+		return nil, fmt.Errorf("list legal holds: %w", err)
+	}
+	held := make(map[graveler.CommitID]struct{}, len(holds))
+	for _, hold := range holds {
+		held[hold.CommitID] = struct{}{}
+	}
+	prefixes := make([]string, 0, len(rules.PrefixRetentionDays))
+	for prefix := range rules.PrefixRetentionDays {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+	ruleCommits := make([]graveler.GarbageCollectionRuleCommits, 0, len(prefixes)+1)
+	for i := -1; i < len(prefixes); i++ {
+		prefix := ""
+		prefixRules := rules
+		if i >= 0 {
+			prefix = prefixes[i]
+			prefixRules = &graveler.GarbageCollectionRules{DefaultRetentionDays: rules.PrefixRetentionDays[prefix]}
+		}
+		branchIterator := ref.NewBranchIterator(ctx, m.db, repositoryID, 1000, ref.WithOrderByCommitID())
+		// get all commits that are not the first parent of any commit:
+		commitIterator := ref.NewOrderedCommitIterator(ctx, m.db, repositoryID, 1000, ref.WithOnlyAncestryLeaves())
+		startingPointIterator := NewGCStartingPointIterator(commitIterator, branchIterator)
+		gcCommits, err := GetGarbageCollectionCommits(ctx, startingPointIterator, commitGetter, prefixRules, previouslyExpiredCommits)
+		if err != nil {
+			return nil, fmt.Errorf("find expired commits: %w", err)
+		}
+		gcCommits.pin(pinned)
+		gcCommits.hold(held)
+		ruleCommits = append(ruleCommits, graveler.GarbageCollectionRuleCommits{
+			Prefix:  prefix,
+			Expired: gcCommits.expired,
+			Active:  gcCommits.active,
+		})
+	}
+	return ruleCommits, nil
+}
+
+func (m *GarbageCollectionManager) SaveGarbageCollectionCommits(ctx context.Context, storageNamespace graveler.StorageNamespace, repositoryID graveler.RepositoryID, rules *graveler.GarbageCollectionRules, previouslyExpiredCommits []graveler.CommitID) (string, error) {
+	ruleCommits, err := m.getRuleCommits(ctx, repositoryID, rules, previouslyExpiredCommits)
 	if err != nil {
-		return "", fmt.Errorf("find expired commits: %w", err)
+		return "", err
 	}
+	// the Spark job reads the merged commits, the catalog does not prepare Spark runs of rules with prefixes
+	gcCommits := mergeRuleCommits(ruleCommits)
 	b := &strings.Builder{}
 	csvWriter := csv.NewWriter(b)
 	err = csvWriter.Write([]string{"commit_id", "expired"}) // write headers
@@ -189,5 +330,110 @@ func (m *GarbageCollectionManager) SaveGarbageCollectionCommits(ctx context.Cont
 	if err != nil {
 		return "", err
 	}
+	if len(ruleCommits) > 1 {
+		if err := m.savePrefixCommits(ctx, storageNamespace, runID, ruleCommits); err != nil {
+			return "", err
+		}
+	}
 	return runID, nil
 }
+
+// savePrefixCommits saves the commits expired and kept according to every rule, grouped by rule prefix
+func (m *GarbageCollectionManager) savePrefixCommits(ctx context.Context, storageNamespace graveler.StorageNamespace, runID string, ruleCommits []graveler.GarbageCollectionRuleCommits) error {
+	b := &strings.Builder{}
+	csvWriter := csv.NewWriter(b)
+	err := csvWriter.Write([]string{"prefix", "commit_id", "expired"}) // write headers
+	if err != nil {
+		return err
+	}
+	for _, commits := range ruleCommits {
+		for _, commitID := range commits.Expired {
+			if err := csvWriter.Write([]string{commits.Prefix, string(commitID), "true"}); err != nil {
+				return err
+			}
+		}
+		for _, commitID := range commits.Active {
+			if err := csvWriter.Write([]string{commits.Prefix, string(commitID), "false"}); err != nil {
+				return err
+			}
+		}
+	}
+	csvWriter.Flush()
+	if err := csvWriter.Error(); err != nil {
+		return err
+	}
+	commitsStr := b.String()
+	csvLocation, err := m.GetPrefixCommitsCSVLocation(runID, storageNamespace)
+	if err != nil {
+		return err
+	}
+	return m.blockAdapter.Put(ctx, block.ObjectPointer{
+		Identifier:     csvLocation,
+		IdentifierType: block.IdentifierTypeFull,
+	}, int64(len(commitsStr)), strings.NewReader(commitsStr), block.PutOpts{})
+}
+
+// SaveRunAddresses saves the addresses that run runID found unreferenced, and returns the location they were saved to
+func (m *GarbageCollectionManager) SaveRunAddresses(ctx context.Context, storageNamespace graveler.StorageNamespace, runID string, addresses []string) (string, error) {
+	b := &strings.Builder{}
+	csvWriter := csv.NewWriter(b)
+	err := csvWriter.Write([]string{"address"}) // write headers
+	if err != nil {
+		return "", err
+	}
+	for _, address := range addresses {
+		err := csvWriter.Write([]string{address})
+		if err != nil {
+			return "", err
+		}
+	}
+	csvWriter.Flush()
+	err = csvWriter.Error()
+	if err != nil {
+		return "", err
+	}
+	addressesStr := b.String()
+	csvLocation, err := m.GetAddressesCSVLocation(runID, storageNamespace)
+	if err != nil {
+		return "", err
+	}
+	err = m.blockAdapter.Put(ctx, block.ObjectPointer{
+		Identifier:     csvLocation,
+		IdentifierType: block.IdentifierTypeFull,
+	}, int64(len(addressesStr)), strings.NewReader(addressesStr), block.PutOpts{})
+	if err != nil {
+		return "", err
+	}
+	return csvLocation, nil
+}
+
+// GetRunAddresses returns the addresses saved by run runID and their location, or ErrNotFound if it did not
+// save them
+func (m *GarbageCollectionManager) GetRunAddresses(ctx context.Context, storageNamespace graveler.StorageNamespace, runID string) ([]string, string, error) {
+	csvLocation, err := m.GetAddressesCSVLocation(runID, storageNamespace)
+	if err != nil {
+		return nil, "", err
+	}
+	reader, err := m.blockAdapter.Get(ctx, block.ObjectPointer{
+		Identifier:     csvLocation,
+		IdentifierType: block.IdentifierTypeFull,
+	}, -1)
+	if errors.Is(err, adapter.ErrDataNotFound) {
+		return nil, "", graveler.ErrNotFound
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	defer func() {
+		_ = reader.Close()
+	}()
+	rows, err := csv.NewReader(reader).ReadAll()
+	if err != nil {
+		return nil, "", err
+	}
+	addresses := make([]string, 0, len(rows))
+	for _, row := range rows[1:] { // skip headers
+		addresses = append(addresses, row[0])
+	}
+	return addresses, csvLocation, nil
+}
@@ -0,0 +1,69 @@
+package graveler
+
+import (
+	"context"
+	"fmt"
+)
+
+// SignatureVerifier verifies signatures made by signing keys registered to users
+type SignatureVerifier interface {
+	// VerifySignature returns nil if signature is a valid signature of data made by the signing key keyID of the user
+	VerifySignature(ctx context.Context, username, keyID string, data, signature []byte) error
+}
+
+func (g *Graveler) SetSignatureVerifier(verifier SignatureVerifier) {
+	g.signatureVerifier = verifier
+}
+
+func (g *Graveler) SignCommit(ctx context.Context, repositoryID RepositoryID, commitID CommitID, keyID string, signature []byte) error {
+	commit, err := g.RefManager.GetCommit(ctx, repositoryID, commitID)
+	if err != nil {
+		return err
+	}
+	if err := g.verifySignature(ctx, commit, keyID, signature); err != nil {
+		return err
+	}
+	return g.RefManager.SetCommitSignature(ctx, repositoryID, commitID, keyID, signature)
+}
+
+func (g *Graveler) VerifyCommitSignature(ctx context.Context, commitID CommitID, commit *Commit) error {
+	if len(commit.Signature) == 0 {
+		return fmt.Errorf("%s: %w", commitID, ErrCommitNotSigned)
+	}
+	return g.verifySignature(ctx, commit, commit.SignatureKeyID, commit.Signature)
+}
+
+// verifySignature verifies signature is a signature of the commit identity made by the signing key keyID of the committer
+func (g *Graveler) verifySignature(ctx context.Context, commit *Commit, keyID string, signature []byte) error {
+	if g.signatureVerifier == nil {
+		return fmt.Errorf("no signature verifier: %w", ErrInvalidCommitSignature)
+	}
+	if err := g.signatureVerifier.VerifySignature(ctx, commit.Committer, keyID, commit.Identity(), signature); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidCommitSignature, err)
+	}
+	return nil
+}
+
+// checkSignedCommits returns ErrUnsignedToProtectedBranch if a branch protection rule requires signed commits on the
+// branch and commitID is not a commit with a verified signature. An empty commitID stands for a new commit created on
+// the branch by the operation, which cannot be signed yet.
+func (g *Graveler) checkSignedCommits(ctx context.Context, repositoryID RepositoryID, branchID BranchID, commitID CommitID) error {
+	required, err := g.protectedBranchesManager.IsSignatureRequired(ctx, repositoryID, branchID)
+	if err != nil {
+		return err
+	}
+	if !required {
+		return nil
+	}
+	if commitID == "" {
+		return fmt.Errorf("%s: %w", branchID, ErrUnsignedToProtectedBranch)
+	}
+	commit, err := g.RefManager.GetCommit(ctx, repositoryID, commitID)
+	if err != nil {
+		return err
+	}
+	if err := g.VerifyCommitSignature(ctx, commitID, commit); err != nil {
+		return fmt.Errorf("%s: %w: %s", branchID, ErrUnsignedToProtectedBranch, err)
+	}
+	return nil
+}
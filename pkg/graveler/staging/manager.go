@@ -2,7 +2,10 @@ package staging
 
 import (
 	"context"
+	"crypto/md5" //nolint:gosec
+	"encoding/hex"
 	"errors"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/treeverse/lakefs/pkg/db"
@@ -60,18 +63,78 @@ func (p *Manager) Set(ctx context.Context, st graveler.StagingToken, key gravele
 			if res.RowsAffected() == 0 {
 				return nil, graveler.ErrPreconditionFailed
 			}
-			return res, err
+			return res, nil
 		}
-		return tx.Exec(`INSERT INTO graveler_staging_kv (staging_token, key, identity, data)
+		res, err := tx.Exec(`INSERT INTO graveler_staging_kv (staging_token, key, identity, data)
 								VALUES ($1, $2, $3, $4)
 								ON CONFLICT (staging_token, key) DO UPDATE
 									SET (staging_token, key, identity, data) =
 											(excluded.staging_token, excluded.key, excluded.identity, excluded.data)`,
 			st, key, value.Identity, value.Data)
+		return res, err
 	}, p.txOpts()...)
 	return err
 }
 
+// TrackWritten records value as written to key of the repository with the object at address, so the object can be
+// found once no staging area or commit references it.  Only the digest of the value data is kept.
+func (p *Manager) TrackWritten(ctx context.Context, repositoryID graveler.RepositoryID, key graveler.Key, value *graveler.Value, address string) error {
+	if value == nil || value.Identity == nil {
+		return graveler.ErrInvalidValue
+	}
+	dataDigest := md5.Sum(value.Data) //nolint:gosec
+	_, err := p.db.Transact(ctx, func(tx db.Tx) (interface{}, error) {
+		return tx.Exec(`INSERT INTO graveler_staging_written (repository_id, identity, data_md5, key, address)
+							VALUES ($1, $2, $3, $4, $5)
+							ON CONFLICT (repository_id, identity, data_md5) DO UPDATE
+								SET (key, address, written_at) = (excluded.key, excluded.address, NOW())`,
+			repositoryID, value.Identity, hex.EncodeToString(dataDigest[:]), key, address)
+	}, p.txOpts()...)
+	return err
+}
+
+// ListWritten returns an iterator over the values written to staging areas of the repository before 'before',
+// that were not forgotten
+func (p *Manager) ListWritten(ctx context.Context, repositoryID graveler.RepositoryID, before time.Time) (graveler.WrittenValueIterator, error) {
+	return NewWrittenIterator(ctx, p.db, p.log, repositoryID, before, graveler.ListingDefaultBatchSize), nil
+}
+
+// ForgetWritten stops tracking the given written values, unless they were written again since 'before'.  It
+// returns the values it forgot.
+func (p *Manager) ForgetWritten(ctx context.Context, repositoryID graveler.RepositoryID, values []*graveler.WrittenValue, before time.Time) ([]*graveler.WrittenValue, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+	written := make(sq.Or, 0, len(values))
+	for _, value := range values {
+		written = append(written, sq.Eq{"identity": value.Identity, "data_md5": value.DataDigest})
+	}
+	query, args, err := sq.Delete("graveler_staging_written").
+		Where(sq.Eq{"repository_id": repositoryID}).
+		Where(written).
+		Where(sq.Lt{"written_at": before}).
+		Suffix("RETURNING key, identity, data_md5, address").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+	res, err := p.db.Transact(ctx, func(tx db.Tx) (interface{}, error) {
+		var forgotten []*writtenRecord
+		err := tx.Select(&forgotten, query, args...)
+		return forgotten, err
+	}, p.txOpts()...)
+	if err != nil {
+		return nil, err
+	}
+	records := res.([]*writtenRecord)
+	forgotten := make([]*graveler.WrittenValue, len(records))
+	for i, rec := range records {
+		forgotten[i] = rec.writtenValue()
+	}
+	return forgotten, nil
+}
+
 func (p *Manager) DropKey(ctx context.Context, st graveler.StagingToken, key graveler.Key) error {
 	_, err := p.db.Transact(ctx, func(tx db.Tx) (interface{}, error) {
 		return tx.Exec("DELETE FROM graveler_staging_kv WHERE staging_token=$1 AND key=$2", st, key)
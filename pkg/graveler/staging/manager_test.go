@@ -5,8 +5,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"testing"
+	"time"
 
+	"github.com/go-test/deep"
 	"github.com/treeverse/lakefs/pkg/graveler"
 	"github.com/treeverse/lakefs/pkg/graveler/staging"
 	"github.com/treeverse/lakefs/pkg/testutil"
@@ -418,6 +421,61 @@ func TestDeleteAndTombstone(t *testing.T) {
 	}
 }
 
+func TestWritten(t *testing.T) {
+	conn, _ := testutil.GetDB(t, databaseURI)
+	ctx := context.Background()
+	s := staging.NewManager(conn)
+	testutil.Must(t, s.TrackWritten(ctx, "written-repo", []byte("a"), newTestValue("identity1", "value1"), "data/a"))
+	testutil.Must(t, s.TrackWritten(ctx, "written-repo", []byte("b"), newTestValue("identity2", "value2"), "data/b"))
+	// the same identity with different data is tracked separately
+	testutil.Must(t, s.TrackWritten(ctx, "written-repo", []byte("e"), newTestValue("identity2", "value2-copy"), "data/e"))
+	// writes are tracked per repository
+	testutil.Must(t, s.TrackWritten(ctx, "other-repo", []byte("d"), newTestValue("identity3", "value3"), "data/d"))
+	if err := s.TrackWritten(ctx, "written-repo", []byte("c"), nil, "data/c"); !errors.Is(err, graveler.ErrInvalidValue) {
+		t.Errorf("TrackWritten() of a tombstone error = %v, expected %v", err, graveler.ErrInvalidValue)
+	}
+
+	listWritten := func(before time.Time) []*graveler.WrittenValue {
+		it, err := s.ListWritten(ctx, "written-repo", before)
+		testutil.Must(t, err)
+		defer it.Close()
+		var values []*graveler.WrittenValue
+		for it.Next() {
+			values = append(values, it.Value())
+		}
+		testutil.Must(t, it.Err())
+		return values
+	}
+	if values := listWritten(time.Now().Add(-time.Hour)); len(values) != 0 {
+		t.Errorf("ListWritten() before writes got %v, expected none", values)
+	}
+	after := time.Now().Add(time.Hour)
+	written := listWritten(after)
+	var addresses []string
+	for _, value := range written {
+		addresses = append(addresses, value.Address)
+	}
+	sort.Strings(addresses)
+	if diff := deep.Equal(addresses, []string{"data/a", "data/b", "data/e"}); diff != nil {
+		t.Error("ListWritten() unexpected addresses", diff)
+	}
+
+	var forget []*graveler.WrittenValue
+	for _, value := range written {
+		if value.Address == "data/b" {
+			forget = append(forget, value)
+		}
+	}
+	forgotten, err := s.ForgetWritten(ctx, "written-repo", forget, after)
+	testutil.Must(t, err)
+	if diff := deep.Equal(forgotten, forget); diff != nil {
+		t.Error("ForgetWritten() unexpected values", diff)
+	}
+	if values := listWritten(after); len(values) != 2 {
+		t.Errorf("ListWritten() after ForgetWritten() got %d values, expected 2", len(values))
+	}
+}
+
 func newTestValue(identity, data string) *graveler.Value {
 	return &graveler.Value{
 		Identity: []byte(identity),
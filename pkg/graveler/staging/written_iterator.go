@@ -0,0 +1,115 @@
+package staging
+
+import (
+	"context"
+	"time"
+
+	"github.com/treeverse/lakefs/pkg/db"
+	"github.com/treeverse/lakefs/pkg/graveler"
+	"github.com/treeverse/lakefs/pkg/logging"
+)
+
+// WrittenIterator iterates over the values written to the staging areas of a repository, ordered by identity and
+// the hash of their data
+type WrittenIterator struct {
+	ctx          context.Context
+	db           db.Database
+	log          logging.Logger
+	repositoryID graveler.RepositoryID
+	before       time.Time
+	batchSize    int
+
+	idxInBuffer  int
+	buffer       []*graveler.WrittenValue
+	nextIdentity []byte
+	nextDataMD5  string
+	dbHasNext    bool
+	err          error
+}
+
+type writtenRecord struct {
+	Key      graveler.Key `db:"key"`
+	Identity []byte       `db:"identity"`
+	DataMD5  string       `db:"data_md5"`
+	Address  string       `db:"address"`
+}
+
+func (r *writtenRecord) writtenValue() *graveler.WrittenValue {
+	return &graveler.WrittenValue{
+		Key:        r.Key,
+		Identity:   r.Identity,
+		DataDigest: r.DataMD5,
+		Address:    r.Address,
+	}
+}
+
+// NewWrittenIterator returns an iterator over the values written to the staging areas of repositoryID before 'before'
+func NewWrittenIterator(ctx context.Context, db db.Database, log logging.Logger, repositoryID graveler.RepositoryID, before time.Time, batchSize int) *WrittenIterator {
+	return &WrittenIterator{
+		ctx:          ctx,
+		db:           db,
+		log:          log,
+		repositoryID: repositoryID,
+		before:       before,
+		batchSize:    batchSize,
+		idxInBuffer:  -1,
+		nextIdentity: make([]byte, 0),
+		dbHasNext:    true,
+	}
+}
+
+func (w *WrittenIterator) Next() bool {
+	if w.err != nil {
+		return false
+	}
+	w.idxInBuffer++
+	if w.idxInBuffer < len(w.buffer) {
+		return true
+	}
+	if !w.dbHasNext {
+		return false
+	}
+	return w.loadBuffer()
+}
+
+func (w *WrittenIterator) Value() *graveler.WrittenValue {
+	if w.err != nil || w.idxInBuffer < 0 || w.idxInBuffer >= len(w.buffer) {
+		return nil
+	}
+	return w.buffer[w.idxInBuffer]
+}
+
+func (w *WrittenIterator) Err() error {
+	return w.err
+}
+
+func (w *WrittenIterator) Close() {}
+
+func (w *WrittenIterator) loadBuffer() bool {
+	queryResult, err := w.db.Transact(w.ctx, func(tx db.Tx) (interface{}, error) {
+		var res []*writtenRecord
+		err := tx.Select(&res, `SELECT key, identity, data_md5, address FROM graveler_staging_written
+			WHERE repository_id=$1 AND written_at < $2 AND (identity, data_md5) >= ($3, $4)
+			ORDER BY identity, data_md5 LIMIT $5`, w.repositoryID, w.before, w.nextIdentity, w.nextDataMD5, w.batchSize+1)
+		return res, err
+	}, db.WithLogger(w.log), db.ReadOnly())
+	if err != nil {
+		w.err = err
+		return false
+	}
+	records := queryResult.([]*writtenRecord)
+	w.idxInBuffer = 0
+	if len(records) == w.batchSize+1 {
+		next := records[len(records)-1]
+		w.nextIdentity = next.Identity
+		w.nextDataMD5 = next.DataMD5
+		records = records[:len(records)-1]
+	} else {
+		w.dbHasNext = false
+	}
+	w.buffer = make([]*graveler.WrittenValue, len(records))
+	for i, rec := range records {
+		w.buffer[i] = rec.writtenValue()
+	}
+	return len(records) > 0
+}
@@ -0,0 +1,35 @@
+package graveler
+
+import "time"
+
+// stashApplyMaxConflicts is the maximal number of conflicting keys reported when applying a stash
+const stashApplyMaxConflicts = 1000
+
+// StashID identifies a stash of a branch
+type StashID string
+
+func (id StashID) String() string {
+	return string(id)
+}
+
+// Stash is a staging area set aside from a branch, together with the commit the branch pointed to when it was set
+// aside
+type Stash struct {
+	StagingToken StagingToken
+	CommitID     CommitID
+	CreationDate time.Time
+}
+
+// StashRecord holds StashID with the associated Stash data
+type StashRecord struct {
+	StashID StashID
+	*Stash
+}
+
+type StashIterator interface {
+	Next() bool
+	SeekGE(id StashID)
+	Value() *StashRecord
+	Err() error
+	Close()
+}
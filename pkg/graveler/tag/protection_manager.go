@@ -0,0 +1,100 @@
+package tag
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gobwas/glob"
+	"github.com/gobwas/glob/syntax"
+	"github.com/treeverse/lakefs/pkg/cache"
+	"github.com/treeverse/lakefs/pkg/graveler"
+	"github.com/treeverse/lakefs/pkg/graveler/settings"
+	"google.golang.org/protobuf/proto"
+)
+
+const ProtectionSettingKey = "protected_tags"
+
+const (
+	matcherCacheSize   = 100_000
+	matcherCacheExpiry = 1 * time.Hour
+	matcherCacheJitter = 1 * time.Minute
+)
+
+var (
+	ErrRuleAlreadyExists = errors.New("tag protection rule already exists")
+	ErrRuleNotExists     = errors.New("tag protection rule does not exist")
+)
+
+type ProtectionManager struct {
+	settingManager *settings.Manager
+	matchers       cache.Cache
+}
+
+func NewProtectionManager(settingManager *settings.Manager) *ProtectionManager {
+	return &ProtectionManager{settingManager: settingManager, matchers: cache.NewCache(matcherCacheSize, matcherCacheExpiry, cache.NewJitterFn(matcherCacheJitter))}
+}
+
+func (m *ProtectionManager) Add(ctx context.Context, repositoryID graveler.RepositoryID, tagNamePattern string) error {
+	_, err := syntax.Parse(tagNamePattern)
+	if err != nil {
+		return fmt.Errorf("invalid tag pattern syntax: %w", err)
+	}
+	return m.settingManager.UpdateWithLock(ctx, repositoryID, ProtectionSettingKey, &graveler.TagProtectionRules{}, func(message proto.Message) error {
+		rules := message.(*graveler.TagProtectionRules)
+		for _, pattern := range rules.Patterns {
+			if pattern == tagNamePattern {
+				return ErrRuleAlreadyExists
+			}
+		}
+		rules.Patterns = append(rules.Patterns, tagNamePattern)
+		return nil
+	})
+}
+
+func (m *ProtectionManager) Delete(ctx context.Context, repositoryID graveler.RepositoryID, tagNamePattern string) error {
+	return m.settingManager.UpdateWithLock(ctx, repositoryID, ProtectionSettingKey, &graveler.TagProtectionRules{}, func(message proto.Message) error {
+		rules := message.(*graveler.TagProtectionRules)
+		for i, pattern := range rules.Patterns {
+			if pattern == tagNamePattern {
+				rules.Patterns = append(rules.Patterns[:i], rules.Patterns[i+1:]...)
+				return nil
+			}
+		}
+		return ErrRuleNotExists
+	})
+}
+
+func (m *ProtectionManager) GetRules(ctx context.Context, repositoryID graveler.RepositoryID) (*graveler.TagProtectionRules, error) {
+	rules, err := m.settingManager.GetLatest(ctx, repositoryID, ProtectionSettingKey, &graveler.TagProtectionRules{})
+	if errors.Is(err, graveler.ErrNotFound) {
+		return &graveler.TagProtectionRules{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return rules.(*graveler.TagProtectionRules), nil
+}
+
+func (m *ProtectionManager) IsBlocked(ctx context.Context, repositoryID graveler.RepositoryID, tagID graveler.TagID) (bool, error) {
+	rules, err := m.settingManager.Get(ctx, repositoryID, ProtectionSettingKey, &graveler.TagProtectionRules{})
+	if errors.Is(err, graveler.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	for _, pattern := range rules.(*graveler.TagProtectionRules).Patterns {
+		matcher, err := m.matchers.GetOrSet(pattern, func() (v interface{}, err error) {
+			return glob.Compile(pattern)
+		})
+		if err != nil {
+			return false, err
+		}
+		if matcher.(glob.Glob).Match(string(tagID)) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
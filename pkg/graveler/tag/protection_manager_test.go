@@ -0,0 +1,109 @@
+package tag_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-test/deep"
+	"github.com/golang/mock/gomock"
+	"github.com/treeverse/lakefs/pkg/block/mem"
+	"github.com/treeverse/lakefs/pkg/graveler"
+	"github.com/treeverse/lakefs/pkg/graveler/mock"
+	"github.com/treeverse/lakefs/pkg/graveler/settings"
+	"github.com/treeverse/lakefs/pkg/graveler/tag"
+	"github.com/treeverse/lakefs/pkg/testutil"
+)
+
+func TestAddAlreadyExists(t *testing.T) {
+	ctx := context.Background()
+	tpm := prepareTest(t, ctx)
+	testutil.Must(t, tpm.Add(ctx, "example-repo", "v*"))
+	err := tpm.Add(ctx, "example-repo", "v*")
+	if !errors.Is(err, tag.ErrRuleAlreadyExists) {
+		t.Fatalf("expected ErrRuleAlreadyExists, got %v", err)
+	}
+	if err := tpm.Add(ctx, "example-repo", "["); err == nil {
+		t.Fatal("expected an error adding a rule with an invalid pattern")
+	}
+}
+
+func TestDelete(t *testing.T) {
+	ctx := context.Background()
+	tpm := prepareTest(t, ctx)
+	err := tpm.Delete(ctx, "example-repo", "v*")
+	if !errors.Is(err, tag.ErrRuleNotExists) {
+		t.Fatalf("expected ErrRuleNotExists, got %v", err)
+	}
+	testutil.Must(t, tpm.Add(ctx, "example-repo", "v*"))
+	testutil.Must(t, tpm.Add(ctx, "example-repo", "release-*"))
+	testutil.Must(t, tpm.Delete(ctx, "example-repo", "v*"))
+
+	rules, err := tpm.GetRules(ctx, "example-repo")
+	testutil.Must(t, err)
+	if diff := deep.Equal([]string{"release-*"}, rules.Patterns); diff != nil {
+		t.Fatalf("got unexpected rules after delete. diff=%s", diff)
+	}
+}
+
+func TestIsBlocked(t *testing.T) {
+	ctx := context.Background()
+	tests := map[string]struct {
+		patterns        []string
+		expectedBlocked []string
+		expectedAllowed []string
+	}{
+		"two_rules": {
+			patterns:        []string{"v*", "release-?"},
+			expectedBlocked: []string{"v1.0", "v", "release-1"},
+			expectedAllowed: []string{"release-10", "nightly"},
+		},
+		"exact": {
+			patterns:        []string{"stable"},
+			expectedBlocked: []string{"stable"},
+			expectedAllowed: []string{"stable1", "unstable"},
+		},
+		"no_rules": {
+			expectedAllowed: []string{"v1.0"},
+		},
+	}
+	for name, tst := range tests {
+		t.Run(name, func(t *testing.T) {
+			tpm := prepareTest(t, ctx)
+			for _, pattern := range tst.patterns {
+				testutil.Must(t, tpm.Add(ctx, "example-repo", pattern))
+			}
+			for _, tagID := range tst.expectedBlocked {
+				res, err := tpm.IsBlocked(ctx, "example-repo", graveler.TagID(tagID))
+				testutil.Must(t, err)
+				if !res {
+					t.Errorf("tag %s expected to be blocked, but was allowed", tagID)
+				}
+			}
+			for _, tagID := range tst.expectedAllowed {
+				res, err := tpm.IsBlocked(ctx, "example-repo", graveler.TagID(tagID))
+				testutil.Must(t, err)
+				if res {
+					t.Errorf("tag %s expected to be allowed, but was blocked", tagID)
+				}
+			}
+		})
+	}
+}
+
+func prepareTest(t *testing.T, ctx context.Context) *tag.ProtectionManager {
+	ctrl := gomock.NewController(t)
+	refManager := mock.NewMockRefManager(ctrl)
+	blockAdapter := mem.New()
+	branchLock := mock.NewMockBranchLocker(ctrl)
+	cb := func(_ context.Context, _ graveler.RepositoryID, _ graveler.BranchID, f func() (interface{}, error)) (interface{}, error) {
+		return f()
+	}
+	branchLock.EXPECT().MetadataUpdater(ctx, gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(cb).AnyTimes()
+	refManager.EXPECT().GetRepository(ctx, gomock.Any()).AnyTimes().Return(&graveler.Repository{
+		StorageNamespace: "mem://my-storage",
+		DefaultBranchID:  "main",
+	}, nil)
+	m := settings.NewManager(refManager, branchLock, blockAdapter, "_lakefs")
+	return tag.NewProtectionManager(m)
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"time"
 
 	"github.com/treeverse/lakefs/pkg/graveler"
 	"github.com/treeverse/lakefs/pkg/graveler/committed"
@@ -25,6 +26,12 @@ type CommittedFake struct {
 	MetaRangeID   graveler.MetaRangeID
 	DiffSummary   graveler.DiffSummary
 	AppliedData   AppliedData
+	// MergeSources maps the source of a merge to its resulting metarange, when set
+	MergeSources map[graveler.MetaRangeID]graveler.MetaRangeID
+	// SummarizedDiff is returned by SummarizeDiff
+	SummarizedDiff map[string]graveler.DiffSummary
+	// MergeRules are the merge strategy rules of the last Merge or CheckMerge
+	MergeRules []*graveler.MergeStrategyRule
 }
 
 type MetaRangeFake struct {
@@ -70,13 +77,40 @@ func (c *CommittedFake) Compare(context.Context, graveler.StorageNamespace, grav
 	return c.DiffIterator, nil
 }
 
-func (c *CommittedFake) Merge(_ context.Context, _ graveler.StorageNamespace, _, _, _ graveler.MetaRangeID, strategy graveler.MergeStrategy) (graveler.MetaRangeID, error) {
+func (c *CommittedFake) SummarizeDiff(context.Context, graveler.StorageNamespace, graveler.MetaRangeID, graveler.MetaRangeID, graveler.DiffSummaryParams) (map[string]graveler.DiffSummary, error) {
+	if c.Err != nil {
+		return nil, c.Err
+	}
+	return c.SummarizedDiff, nil
+}
+
+func (c *CommittedFake) ListDistinct(context.Context, graveler.StorageNamespace, []graveler.MetaRangeID, []graveler.MetaRangeID) (graveler.ValueIterator, error) {
+	if c.Err != nil {
+		return nil, c.Err
+	}
+	return c.ValueIterator, nil
+}
+
+func (c *CommittedFake) Merge(_ context.Context, _ graveler.StorageNamespace, _, source, base graveler.MetaRangeID, _ graveler.MergeStrategy, rules []*graveler.MergeStrategyRule) (graveler.MetaRangeID, error) {
+	c.MergeRules = rules
 	if c.Err != nil {
 		return "", c.Err
 	}
+	if c.MergeSources != nil {
+		if source == base {
+			// no changes on source
+			return "", nil
+		}
+		return c.MergeSources[source], nil
+	}
 	return c.MetaRangeID, nil
 }
 
+func (c *CommittedFake) CheckMerge(_ context.Context, _ graveler.StorageNamespace, _, _, _ graveler.MetaRangeID, _ graveler.MergeStrategy, rules []*graveler.MergeStrategyRule) error {
+	c.MergeRules = rules
+	return c.Err
+}
+
 func (c *CommittedFake) Commit(_ context.Context, _ graveler.StorageNamespace, baseMetaRangeID graveler.MetaRangeID, changes graveler.ValueIterator) (graveler.MetaRangeID, graveler.DiffSummary, error) {
 	if c.Err != nil {
 		return "", graveler.DiffSummary{}, c.Err
@@ -115,12 +149,36 @@ type StagingFake struct {
 	LastRemovedKey     graveler.Key
 	DropCalled         bool
 	SetErr             error
+	// WrittenValues are the values tracked by TrackWritten
+	WrittenValues []*graveler.WrittenValue
 }
 
 func (s *StagingFake) DropByPrefix(context.Context, graveler.StagingToken, graveler.Key) error {
 	return nil
 }
 
+func (s *StagingFake) TrackWritten(_ context.Context, _ graveler.RepositoryID, key graveler.Key, value *graveler.Value, address string) error {
+	if s.Err != nil {
+		return s.Err
+	}
+	s.WrittenValues = append(s.WrittenValues, &graveler.WrittenValue{Key: key, Identity: value.Identity, Address: address})
+	return nil
+}
+
+func (s *StagingFake) ListWritten(context.Context, graveler.RepositoryID, time.Time) (graveler.WrittenValueIterator, error) {
+	if s.Err != nil {
+		return nil, s.Err
+	}
+	return NewWrittenValueIteratorFake(s.WrittenValues), nil
+}
+
+func (s *StagingFake) ForgetWritten(_ context.Context, _ graveler.RepositoryID, values []*graveler.WrittenValue, _ time.Time) ([]*graveler.WrittenValue, error) {
+	if s.Err != nil {
+		return nil, s.Err
+	}
+	return values, nil
+}
+
 func (s *StagingFake) Drop(context.Context, graveler.StagingToken) error {
 	s.DropCalled = true
 	if s.DropErr != nil {
@@ -176,6 +234,75 @@ func (s *StagingFake) ListSnapshot(context.Context, graveler.StagingToken, grave
 	return s.ValueIterator, nil
 }
 
+// StagingTokensFake is a staging manager that keeps the values staged under each staging token, a nil value is a
+// staged deletion
+type StagingTokensFake struct {
+	Values map[graveler.StagingToken]map[string]*graveler.Value
+}
+
+func NewStagingTokensFake() *StagingTokensFake {
+	return &StagingTokensFake{Values: make(map[graveler.StagingToken]map[string]*graveler.Value)}
+}
+
+func (s *StagingTokensFake) Get(_ context.Context, st graveler.StagingToken, key graveler.Key) (*graveler.Value, error) {
+	value, ok := s.Values[st][string(key)]
+	if !ok {
+		return nil, graveler.ErrNotFound
+	}
+	return value, nil
+}
+
+func (s *StagingTokensFake) Set(_ context.Context, st graveler.StagingToken, key graveler.Key, value *graveler.Value, _ bool) error {
+	if s.Values[st] == nil {
+		s.Values[st] = make(map[string]*graveler.Value)
+	}
+	s.Values[st][string(key)] = value
+	return nil
+}
+
+func (s *StagingTokensFake) List(_ context.Context, st graveler.StagingToken, _ int) (graveler.ValueIterator, error) {
+	records := make([]graveler.ValueRecord, 0, len(s.Values[st]))
+	for key, value := range s.Values[st] {
+		records = append(records, graveler.ValueRecord{Key: graveler.Key(key), Value: value})
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return bytes.Compare(records[i].Key, records[j].Key) < 0
+	})
+	return NewValueIteratorFake(records), nil
+}
+
+func (s *StagingTokensFake) DropKey(_ context.Context, st graveler.StagingToken, key graveler.Key) error {
+	delete(s.Values[st], string(key))
+	return nil
+}
+
+func (s *StagingTokensFake) Drop(_ context.Context, st graveler.StagingToken) error {
+	delete(s.Values, st)
+	return nil
+}
+
+func (s *StagingTokensFake) DropByPrefix(_ context.Context, st graveler.StagingToken, prefix graveler.Key) error {
+	for key := range s.Values[st] {
+		if bytes.HasPrefix([]byte(key), prefix) {
+			delete(s.Values[st], key)
+		}
+	}
+	return nil
+}
+
+// TrackWritten does nothing, StagingTokensFake does not track written values
+func (s *StagingTokensFake) TrackWritten(context.Context, graveler.RepositoryID, graveler.Key, *graveler.Value, string) error {
+	return nil
+}
+
+func (s *StagingTokensFake) ListWritten(context.Context, graveler.RepositoryID, time.Time) (graveler.WrittenValueIterator, error) {
+	return NewWrittenValueIteratorFake(nil), nil
+}
+
+func (s *StagingTokensFake) ForgetWritten(context.Context, graveler.RepositoryID, []*graveler.WrittenValue, time.Time) ([]*graveler.WrittenValue, error) {
+	return nil, nil
+}
+
 type AddedCommitData struct {
 	Committer   string
 	Message     string
@@ -199,6 +326,20 @@ type RefsFake struct {
 	CommitID            graveler.CommitID
 	Commits             map[graveler.CommitID]*graveler.Commit
 	StagingToken        graveler.StagingToken
+	MergeBase           *graveler.Commit
+	BranchReflog        []*graveler.BranchReflogEntry
+	Stashes             map[graveler.StashID]*graveler.Stash
+	// BranchOperation is the branch reflog operation of the last CreateBranch or SetBranch
+	BranchOperation graveler.BranchReflogOperation
+	// UpdatedBranch is the branch passed to the last SetBranch
+	UpdatedBranch *graveler.Branch
+	// ExpiredBranches is returned by ListExpiredBranches
+	ExpiredBranches []*graveler.BranchRecord
+	// Ahead and Behind are returned by CountAheadBehind
+	Ahead  int
+	Behind int
+	// LegalHolds are returned by ListLegalHolds
+	LegalHolds []*graveler.LegalHoldRecord
 }
 
 func (m *RefsFake) CreateBranch(ctx context.Context, repositoryID graveler.RepositoryID, branchID graveler.BranchID, branch graveler.Branch) error {
@@ -208,7 +349,9 @@ func (m *RefsFake) CreateBranch(ctx context.Context, repositoryID graveler.Repos
 	m.Branch = &graveler.Branch{
 		CommitID:     branch.CommitID,
 		StagingToken: branch.StagingToken,
+		Expiry:       branch.Expiry,
 	}
+	m.BranchOperation = graveler.BranchReflogOperationFromContext(ctx, graveler.BranchReflogOperationCreate)
 	return nil
 }
 
@@ -273,11 +416,37 @@ func (m *RefsFake) DeleteRepository(context.Context, graveler.RepositoryID) erro
 	return nil
 }
 
+func (m *RefsFake) SoftDeleteRepository(context.Context, graveler.RepositoryID) error {
+	return nil
+}
+
+func (m *RefsFake) RestoreRepository(context.Context, graveler.RepositoryID) error {
+	return nil
+}
+
+func (m *RefsFake) ListDeletedRepositories(context.Context) ([]*graveler.DeletedRepositoryRecord, error) {
+	return nil, nil
+}
+
+func (m *RefsFake) RenameRepository(context.Context, graveler.RepositoryID, graveler.RepositoryID, time.Time) error {
+	return nil
+}
+
+func (m *RefsFake) ResolveRepositoryAlias(context.Context, graveler.RepositoryID) (graveler.RepositoryID, error) {
+	return "", graveler.ErrRepositoryNotFound
+}
+
+func (m *RefsFake) DeleteExpiredRepositoryAliases(context.Context, time.Time) error {
+	return nil
+}
+
 func (m *RefsFake) GetBranch(context.Context, graveler.RepositoryID, graveler.BranchID) (*graveler.Branch, error) {
 	return m.Branch, m.Err
 }
 
-func (m *RefsFake) SetBranch(context.Context, graveler.RepositoryID, graveler.BranchID, graveler.Branch) error {
+func (m *RefsFake) SetBranch(ctx context.Context, _ graveler.RepositoryID, _ graveler.BranchID, branch graveler.Branch) error {
+	m.BranchOperation = graveler.BranchReflogOperationFromContext(ctx, graveler.BranchReflogOperationUpdate)
+	m.UpdatedBranch = &branch
 	return nil
 }
 
@@ -289,14 +458,133 @@ func (m *RefsFake) ListBranches(context.Context, graveler.RepositoryID) (gravele
 	return m.ListBranchesRes, nil
 }
 
+func (m *RefsFake) ListExpiredBranches(context.Context, graveler.RepositoryID, time.Time) ([]*graveler.BranchRecord, error) {
+	return m.ExpiredBranches, nil
+}
+
+func (m *RefsFake) ListBranchReflog(context.Context, graveler.RepositoryID, graveler.BranchID) (graveler.BranchReflogIterator, error) {
+	return NewFakeBranchReflogIterator(m.BranchReflog), nil
+}
+
+func (m *RefsFake) CreateStash(_ context.Context, _ graveler.RepositoryID, _ graveler.BranchID, stashID graveler.StashID, stagingToken graveler.StagingToken) (*graveler.Stash, error) {
+	if _, ok := m.Stashes[stashID]; ok {
+		return nil, graveler.ErrStashExists
+	}
+	if m.Stashes == nil {
+		m.Stashes = make(map[graveler.StashID]*graveler.Stash)
+	}
+	stash := &graveler.Stash{
+		StagingToken: m.Branch.StagingToken,
+		CommitID:     m.Branch.CommitID,
+	}
+	m.Stashes[stashID] = stash
+	m.Branch.StagingToken = stagingToken
+	return stash, nil
+}
+
+func (m *RefsFake) GetStash(_ context.Context, _ graveler.RepositoryID, _ graveler.BranchID, stashID graveler.StashID) (*graveler.Stash, error) {
+	stash, ok := m.Stashes[stashID]
+	if !ok {
+		return nil, graveler.ErrStashNotFound
+	}
+	return stash, nil
+}
+
+func (m *RefsFake) DeleteStash(_ context.Context, _ graveler.RepositoryID, _ graveler.BranchID, stashID graveler.StashID) error {
+	if _, ok := m.Stashes[stashID]; !ok {
+		return graveler.ErrStashNotFound
+	}
+	delete(m.Stashes, stashID)
+	return nil
+}
+
+func (m *RefsFake) ListStashes(context.Context, graveler.RepositoryID, graveler.BranchID) (graveler.StashIterator, error) {
+	var data []*graveler.StashRecord
+	for stashID, stash := range m.Stashes {
+		data = append(data, &graveler.StashRecord{StashID: stashID, Stash: stash})
+	}
+	sort.Slice(data, func(i, j int) bool {
+		return data[i].StashID < data[j].StashID
+	})
+	return NewFakeStashIterator(data), nil
+}
+
+func (m *RefsFake) CreateLegalHold(_ context.Context, _ graveler.RepositoryID, holdID graveler.LegalHoldID, hold graveler.LegalHold) error {
+	for _, rec := range m.LegalHolds {
+		if rec.LegalHoldID == holdID {
+			return graveler.ErrLegalHoldExists
+		}
+	}
+	m.LegalHolds = append(m.LegalHolds, &graveler.LegalHoldRecord{LegalHoldID: holdID, LegalHold: &hold})
+	return nil
+}
+
+func (m *RefsFake) DeleteLegalHold(_ context.Context, _ graveler.RepositoryID, holdID graveler.LegalHoldID, _ string) error {
+	for i, rec := range m.LegalHolds {
+		if rec.LegalHoldID == holdID {
+			m.LegalHolds = append(m.LegalHolds[:i], m.LegalHolds[i+1:]...)
+			return nil
+		}
+	}
+	return graveler.ErrLegalHoldNotFound
+}
+
+func (m *RefsFake) GetLegalHold(_ context.Context, _ graveler.RepositoryID, holdID graveler.LegalHoldID) (*graveler.LegalHold, error) {
+	for _, rec := range m.LegalHolds {
+		if rec.LegalHoldID == holdID {
+			return rec.LegalHold, nil
+		}
+	}
+	return nil, graveler.ErrLegalHoldNotFound
+}
+
+func (m *RefsFake) ListLegalHolds(context.Context, graveler.RepositoryID) ([]*graveler.LegalHoldRecord, error) {
+	return m.LegalHolds, nil
+}
+
+func (m *RefsFake) ListLegalHoldLog(context.Context, graveler.RepositoryID) ([]*graveler.LegalHoldLogEntry, error) {
+	panic("implement me")
+}
+
+func (m *RefsFake) CreateImport(context.Context, graveler.RepositoryID, graveler.ImportID, graveler.Import) error {
+	panic("implement me")
+}
+
+func (m *RefsFake) GetImport(context.Context, graveler.RepositoryID, graveler.ImportID) (*graveler.Import, error) {
+	panic("implement me")
+}
+
+func (m *RefsFake) UpdateImport(context.Context, graveler.RepositoryID, graveler.ImportID, graveler.Import) (*graveler.Import, error) {
+	panic("implement me")
+}
+
+func (m *RefsFake) CancelImport(context.Context, graveler.RepositoryID, graveler.ImportID) error {
+	panic("implement me")
+}
+
+func (m *RefsFake) DeleteExpiredImports(context.Context, graveler.RepositoryID, time.Time) error {
+	panic("implement me")
+}
+
 func (m *RefsFake) GetTag(context.Context, graveler.RepositoryID, graveler.TagID) (*graveler.CommitID, error) {
 	return m.TagCommitID, m.Err
 }
 
+func (m *RefsFake) GetTagRecord(_ context.Context, _ graveler.RepositoryID, tagID graveler.TagID) (*graveler.TagRecord, error) {
+	if m.Err != nil {
+		return nil, m.Err
+	}
+	return &graveler.TagRecord{TagID: tagID, CommitID: *m.TagCommitID}, nil
+}
+
 func (m *RefsFake) CreateTag(context.Context, graveler.RepositoryID, graveler.TagID, graveler.CommitID) error {
 	return nil
 }
 
+func (m *RefsFake) CreateAnnotatedTag(context.Context, graveler.RepositoryID, graveler.TagID, graveler.CommitID, graveler.TagAnnotation) error {
+	return nil
+}
+
 func (m *RefsFake) DeleteTag(context.Context, graveler.RepositoryID, graveler.TagID) error {
 	return nil
 }
@@ -313,6 +601,16 @@ func (m *RefsFake) GetCommit(_ context.Context, _ graveler.RepositoryID, id grav
 	return nil, graveler.ErrCommitNotFound
 }
 
+func (m *RefsFake) SetCommitSignature(_ context.Context, _ graveler.RepositoryID, commitID graveler.CommitID, keyID string, signature []byte) error {
+	commit, ok := m.Commits[commitID]
+	if !ok {
+		return graveler.ErrCommitNotFound
+	}
+	commit.SignatureKeyID = keyID
+	commit.Signature = signature
+	return nil
+}
+
 func (m *RefsFake) AddCommit(_ context.Context, _ graveler.RepositoryID, commit graveler.Commit) (graveler.CommitID, error) {
 	if m.CommitErr != nil {
 		return "", m.CommitErr
@@ -327,7 +625,14 @@ func (m *RefsFake) AddCommit(_ context.Context, _ graveler.RepositoryID, commit
 	return m.CommitID, nil
 }
 
+func (m *RefsFake) CountAheadBehind(context.Context, graveler.RepositoryID, graveler.CommitID, graveler.CommitID) (int, int, error) {
+	return m.Ahead, m.Behind, nil
+}
+
 func (m *RefsFake) FindMergeBase(context.Context, graveler.RepositoryID, ...graveler.CommitID) (*graveler.Commit, error) {
+	if m.MergeBase != nil {
+		return m.MergeBase, nil
+	}
 	return &graveler.Commit{}, nil
 }
 
@@ -335,6 +640,10 @@ func (m *RefsFake) Log(context.Context, graveler.RepositoryID, graveler.CommitID
 	return m.CommitIter, nil
 }
 
+func (m *RefsFake) SearchCommits(context.Context, graveler.RepositoryID, graveler.CommitSearchParams) ([]*graveler.CommitRecord, error) {
+	panic("implement me")
+}
+
 type diffIter struct {
 	current int
 	records []graveler.Diff
@@ -407,6 +716,33 @@ func (r *valueIteratorFake) Err() error {
 
 func (r *valueIteratorFake) Close() {}
 
+type writtenValueIteratorFake struct {
+	current int
+	values  []*graveler.WrittenValue
+}
+
+func NewWrittenValueIteratorFake(values []*graveler.WrittenValue) graveler.WrittenValueIterator {
+	return &writtenValueIteratorFake{values: values, current: -1}
+}
+
+func (r *writtenValueIteratorFake) Next() bool {
+	r.current++
+	return r.current < len(r.values)
+}
+
+func (r *writtenValueIteratorFake) Value() *graveler.WrittenValue {
+	if r.current < 0 || r.current >= len(r.values) {
+		return nil
+	}
+	return r.values[r.current]
+}
+
+func (r *writtenValueIteratorFake) Err() error {
+	return nil
+}
+
+func (r *writtenValueIteratorFake) Close() {}
+
 type committedValueIteratorFake struct {
 	current int
 	records []committed.Record
@@ -759,9 +1095,122 @@ func (m *FakeCommitIterator) Err() error {
 
 func (m *FakeCommitIterator) Close() {}
 
+type FakeTagIterator struct {
+	Data  []*graveler.TagRecord
+	Index int
+}
+
+func NewFakeTagIterator(data []*graveler.TagRecord) *FakeTagIterator {
+	return &FakeTagIterator{Data: data, Index: -1}
+}
+
+func (m *FakeTagIterator) Next() bool {
+	if m.Index >= len(m.Data) {
+		return false
+	}
+	m.Index++
+	return m.Index < len(m.Data)
+}
+
+func (m *FakeTagIterator) SeekGE(id graveler.TagID) {
+	m.Index = len(m.Data)
+	for i, item := range m.Data {
+		if item.TagID >= id {
+			m.Index = i - 1
+			return
+		}
+	}
+}
+
+func (m *FakeTagIterator) Value() *graveler.TagRecord {
+	return m.Data[m.Index]
+}
+
+func (m *FakeTagIterator) Err() error {
+	return nil
+}
+
+func (m *FakeTagIterator) Close() {}
+
+type FakeBranchReflogIterator struct {
+	Data  []*graveler.BranchReflogEntry
+	Index int
+}
+
+func NewFakeBranchReflogIterator(data []*graveler.BranchReflogEntry) *FakeBranchReflogIterator {
+	return &FakeBranchReflogIterator{Data: data, Index: -1}
+}
+
+func (m *FakeBranchReflogIterator) Next() bool {
+	if m.Index >= len(m.Data) {
+		return false
+	}
+	m.Index++
+	return m.Index < len(m.Data)
+}
+
+func (m *FakeBranchReflogIterator) SeekGE(index int) {
+	m.Index = len(m.Data)
+	for i, item := range m.Data {
+		if item.Index >= index {
+			m.Index = i - 1
+			return
+		}
+	}
+}
+
+func (m *FakeBranchReflogIterator) Value() *graveler.BranchReflogEntry {
+	return m.Data[m.Index]
+}
+
+func (m *FakeBranchReflogIterator) Err() error {
+	return nil
+}
+
+func (m *FakeBranchReflogIterator) Close() {}
+
+type FakeStashIterator struct {
+	Data  []*graveler.StashRecord
+	Index int
+}
+
+func NewFakeStashIterator(data []*graveler.StashRecord) *FakeStashIterator {
+	return &FakeStashIterator{Data: data, Index: -1}
+}
+
+func (m *FakeStashIterator) Next() bool {
+	if m.Index >= len(m.Data) {
+		return false
+	}
+	m.Index++
+	return m.Index < len(m.Data)
+}
+
+func (m *FakeStashIterator) SeekGE(id graveler.StashID) {
+	m.Index = len(m.Data)
+	for i, item := range m.Data {
+		if item.StashID >= id {
+			m.Index = i - 1
+			return
+		}
+	}
+}
+
+func (m *FakeStashIterator) Value() *graveler.StashRecord {
+	return m.Data[m.Index]
+}
+
+func (m *FakeStashIterator) Err() error {
+	return nil
+}
+
+func (m *FakeStashIterator) Close() {}
+
 type ProtectedBranchesManagerFake struct {
 	graveler.ProtectedBranchesManager
 	protectedBranches []string
+	// SignedBranches are branches that require signed commits
+	SignedBranches []string
 }
 
 func NewProtectedBranchesManagerFake(protectedBranches ...string) *ProtectedBranchesManagerFake {
@@ -776,3 +1225,51 @@ func (p ProtectedBranchesManagerFake) IsBlocked(ctx context.Context, repositoryI
 	}
 	return false, nil
 }
+
+func (p ProtectedBranchesManagerFake) IsMergeBlocked(ctx context.Context, repositoryID graveler.RepositoryID, branchID graveler.BranchID, _ graveler.BranchID) (bool, error) {
+	return p.IsBlocked(ctx, repositoryID, branchID, graveler.BranchProtectionBlockedAction_MERGE)
+}
+
+func (p ProtectedBranchesManagerFake) IsSignatureRequired(_ context.Context, _ graveler.RepositoryID, branchID graveler.BranchID) (bool, error) {
+	for _, branch := range p.SignedBranches {
+		if branch == string(branchID) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+type MergeStrategyRulesManagerFake struct {
+	Rules *graveler.MergeStrategyRules
+}
+
+func NewMergeStrategyRulesManagerFake(rules ...*graveler.MergeStrategyRule) *MergeStrategyRulesManagerFake {
+	return &MergeStrategyRulesManagerFake{Rules: &graveler.MergeStrategyRules{Rules: rules}}
+}
+
+func (m *MergeStrategyRulesManagerFake) GetRules(context.Context, graveler.RepositoryID) (*graveler.MergeStrategyRules, error) {
+	return m.Rules, nil
+}
+
+func (m *MergeStrategyRulesManagerFake) SetRules(_ context.Context, _ graveler.RepositoryID, rules *graveler.MergeStrategyRules) error {
+	m.Rules = rules
+	return nil
+}
+
+type ProtectedTagsManagerFake struct {
+	graveler.ProtectedTagsManager
+	protectedTags []string
+}
+
+func NewProtectedTagsManagerFake(protectedTags ...string) *ProtectedTagsManagerFake {
+	return &ProtectedTagsManagerFake{protectedTags: protectedTags}
+}
+
+func (p ProtectedTagsManagerFake) IsBlocked(_ context.Context, _ graveler.RepositoryID, tagID graveler.TagID) (bool, error) {
+	for _, tag := range p.protectedTags {
+		if tag == string(tagID) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -1,8 +1,10 @@
 package graveler
 
 import (
+	"fmt"
 	"strings"
 
+	"github.com/gobwas/glob"
 	"github.com/treeverse/lakefs/pkg/ident"
 	"github.com/treeverse/lakefs/pkg/validator"
 )
@@ -47,6 +49,34 @@ func ValidateBranchID(v interface{}) error {
 	return nil
 }
 
+func ValidateStashID(v interface{}) error {
+	s, ok := v.(StashID)
+	if !ok {
+		panic(ErrInvalidType)
+	}
+	if len(s) == 0 {
+		return ErrRequiredValue
+	}
+	if !validator.ReValidBranchID.MatchString(s.String()) {
+		return ErrInvalidStashID
+	}
+	return nil
+}
+
+func ValidateLegalHoldID(v interface{}) error {
+	s, ok := v.(LegalHoldID)
+	if !ok {
+		panic(ErrInvalidType)
+	}
+	if len(s) == 0 {
+		return ErrRequiredValue
+	}
+	if !validator.ReValidBranchID.MatchString(s.String()) {
+		return ErrInvalidLegalHoldID
+	}
+	return nil
+}
+
 func ValidateTagID(v interface{}) error {
 	s, ok := v.(TagID)
 	if !ok {
@@ -127,4 +157,61 @@ func ValidateRequiredStrategy(v interface{}) error {
 	return nil
 }
 
+func ValidateMergeMode(v interface{}) error {
+	m, ok := v.(MergeMode)
+	if !ok {
+		panic(ErrInvalidType)
+	}
+
+	switch m {
+	case MergeModeDefault, MergeModeFastForward, MergeModeFastForwardOnly, MergeModeSquash:
+		return nil
+	default:
+		return ErrInvalidValue
+	}
+}
+
+func ValidateMergeStrategyRules(v interface{}) error {
+	rules, ok := v.([]*MergeStrategyRule)
+	if !ok {
+		panic(ErrInvalidType)
+	}
+
+	_, err := NewMergeStrategyMatcher(MergeStrategyNone, rules)
+	return err
+}
+
+func ValidateGarbageCollectionRules(v interface{}) error {
+	rules, ok := v.(*GarbageCollectionRules)
+	if !ok {
+		panic(ErrInvalidType)
+	}
+
+	if rules.DefaultRetentionDays < 0 {
+		return fmt.Errorf("%w: negative default retention days", ErrInvalidGarbageCollectionRule)
+	}
+	for branchID, days := range rules.BranchRetentionDays {
+		if days < 0 {
+			return fmt.Errorf("%w: negative retention days for branch %s", ErrInvalidGarbageCollectionRule, branchID)
+		}
+	}
+	for prefix, days := range rules.PrefixRetentionDays {
+		if prefix == "" {
+			return fmt.Errorf("%w: empty prefix", ErrInvalidGarbageCollectionRule)
+		}
+		if days < 0 {
+			return fmt.Errorf("%w: negative retention days for prefix %s", ErrInvalidGarbageCollectionRule, prefix)
+		}
+	}
+	for _, pattern := range rules.PinnedTagPatterns {
+		if pattern == "" {
+			return fmt.Errorf("%w: empty pinned tag pattern", ErrInvalidGarbageCollectionRule)
+		}
+		if _, err := glob.Compile(pattern); err != nil {
+			return fmt.Errorf("%w: pinned tag pattern %s: %s", ErrInvalidGarbageCollectionRule, pattern, err)
+		}
+	}
+	return nil
+}
+
 var ValidateTagIDOptional = validator.MakeValidateOptional(ValidateTagID)
@@ -83,3 +83,33 @@ func TestValidateBranchID(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateGarbageCollectionRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		rules   *GarbageCollectionRules
+		wantErr error
+	}{
+		{name: "empty", rules: &GarbageCollectionRules{}, wantErr: nil},
+		{name: "valid", rules: &GarbageCollectionRules{
+			DefaultRetentionDays: 21,
+			BranchRetentionDays:  map[string]int32{"main": 28},
+			PrefixRetentionDays:  map[string]int32{"raw/": 7, "curated/": 365},
+			PinnedTagPatterns:    []string{"release-*"},
+		}, wantErr: nil},
+		{name: "negative default", rules: &GarbageCollectionRules{DefaultRetentionDays: -1}, wantErr: ErrInvalidGarbageCollectionRule},
+		{name: "negative branch", rules: &GarbageCollectionRules{BranchRetentionDays: map[string]int32{"main": -1}}, wantErr: ErrInvalidGarbageCollectionRule},
+		{name: "negative prefix", rules: &GarbageCollectionRules{PrefixRetentionDays: map[string]int32{"raw/": -1}}, wantErr: ErrInvalidGarbageCollectionRule},
+		{name: "empty prefix", rules: &GarbageCollectionRules{PrefixRetentionDays: map[string]int32{"": 7}}, wantErr: ErrInvalidGarbageCollectionRule},
+		{name: "empty pattern", rules: &GarbageCollectionRules{PinnedTagPatterns: []string{""}}, wantErr: ErrInvalidGarbageCollectionRule},
+		{name: "invalid pattern", rules: &GarbageCollectionRules{PinnedTagPatterns: []string{"release-[a"}}, wantErr: ErrInvalidGarbageCollectionRule},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateGarbageCollectionRules(tt.rules)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ValidateGarbageCollectionRules() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
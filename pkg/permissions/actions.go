@@ -34,6 +34,7 @@ const (
 	ReadTagAction            = "fs:ReadTag"
 	ListTagsAction           = "fs:ListTags"
 	ReadStorageConfiguration = "fs:ReadConfig"
+	ImportFromStorageAction  = "fs:ImportFromStorage"
 
 	ReadUserAction          = "auth:ReadUser"
 	CreateUserAction        = "auth:CreateUser"
@@ -62,9 +63,17 @@ const (
 	PrepareGarbageCollectionCommitsAction = "retention:PrepareGarbageCollectionCommits"
 	GetGarbageCollectionRulesAction       = "retention:GetGarbageCollectionRules"
 	SetGarbageCollectionRulesAction       = "retention:SetGarbageCollectionRules"
+	CreateLegalHoldAction                 = "retention:CreateLegalHold"
+	ReleaseLegalHoldAction                = "retention:ReleaseLegalHold"
+	GetLegalHoldsAction                   = "retention:GetLegalHolds"
 
 	GetBranchProtectionRulesAction = "branches:GetBranchProtectionRules"
 	SetBranchProtectionRulesAction = "branches:SetBranchProtectionRules"
+	GetMergeStrategyRulesAction    = "branches:GetMergeStrategyRules"
+	SetMergeStrategyRulesAction    = "branches:SetMergeStrategyRules"
+
+	GetTagProtectionRulesAction = "tags:GetTagProtectionRules"
+	SetTagProtectionRulesAction = "tags:SetTagProtectionRules"
 )
 
 var serviceSet = map[string]struct{}{
@@ -73,6 +82,7 @@ var serviceSet = map[string]struct{}{
 	"ci":        {},
 	"retention": {},
 	"branches":  {},
+	"tags":      {},
 }
 
 func IsValidAction(name string) error {
@@ -85,6 +85,14 @@ func TestParse(t *testing.T) {
 				Ref:        "bar",
 			},
 		},
+		{
+			Input: "lakefs://foo/bar@{2021-06-01T00:00:00Z}/baz",
+			Expected: &uri.URI{
+				Repository: "foo",
+				Ref:        "bar@{2021-06-01T00:00:00Z}",
+				Path:       strp("baz"),
+			},
+		},
 		{
 			Input: "lakefs://foo@bar",
 			Err:   uri.ErrMalformedURI,
@@ -168,6 +176,27 @@ func TestIsValid(t *testing.T) {
 	}
 }
 
+func TestURI_IsRef(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected bool
+	}{
+		{"lakefs://foo/bar", true},
+		{"lakefs://foo/bar~2", true},
+		{"lakefs://foo/v1.0", true},
+		{"lakefs://foo/bar@{2021-06-01T00:00:00Z}", true},
+		{"lakefs://foo", false},
+		{"lakefs://foo/bar/baz", false},
+	}
+
+	for i, test := range cases {
+		u := uri.Must(uri.Parse(test.Input))
+		if u.IsRef() != test.Expected {
+			t.Fatalf("case (%d) - expected IsRef %v for '%s', got %v", i, test.Expected, test.Input, u.IsRef())
+		}
+	}
+}
+
 func TestMust(t *testing.T) {
 	// should not panic
 	u := uri.Must(uri.Parse("lakefs://foo/bar/baz"))